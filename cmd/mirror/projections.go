@@ -84,6 +84,7 @@ type ProjectionsConfig struct {
 	InternalAuthZ   internal_authz.Config
 	SystemDefaults  systemdefaults.SystemDefaults
 	Telemetry       *handlers.TelemetryPusherConfig
+	Notifications   handlers.WorkerConfig
 	Login           login.Config
 	OIDC            oidc.Config
 	WebAuthNName    string
@@ -198,6 +199,7 @@ func projections(
 		config.Projections.Customizations["notificationsquotas"],
 		config.Projections.Customizations["telemetry"],
 		*config.Telemetry,
+		config.Notifications,
 		config.ExternalDomain,
 		config.ExternalPort,
 		config.ExternalSecure,
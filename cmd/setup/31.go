@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 31.sql
+	addOAuthPKCE string
+)
+
+type IDPTemplate6OAuthPKCE struct {
+	dbClient *database.DB
+}
+
+func (mig *IDPTemplate6OAuthPKCE) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addOAuthPKCE)
+	return err
+}
+
+func (mig *IDPTemplate6OAuthPKCE) String() string {
+	return "31_idp_templates6_add_oauth_pkce"
+}
@@ -0,0 +1,3 @@
+ALTER TABLE IF EXISTS projections.idp_templates6_oauth2 ADD COLUMN IF NOT EXISTS use_pkce BOOLEAN DEFAULT FALSE;
+ALTER TABLE IF EXISTS projections.idp_templates6_oauth2 ADD COLUMN IF NOT EXISTS code_verifier_key JSONB;
+ALTER TABLE IF EXISTS projections.idp_templates6_oauth2 ALTER COLUMN client_secret DROP NOT NULL;
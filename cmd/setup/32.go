@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 32.sql
+	addSecurityAlerts string
+)
+
+type NotificationPolicySecurityAlerts struct {
+	dbClient *database.DB
+}
+
+func (mig *NotificationPolicySecurityAlerts) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addSecurityAlerts)
+	return err
+}
+
+func (mig *NotificationPolicySecurityAlerts) String() string {
+	return "32_notification_policies_add_security_alerts"
+}
@@ -0,0 +1 @@
+ALTER TABLE IF EXISTS projections.notification_policies ADD COLUMN IF NOT EXISTS security_alerts BOOLEAN DEFAULT FALSE;
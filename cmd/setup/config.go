@@ -48,6 +48,7 @@ type Config struct {
 	Login           login.Config
 	WebAuthNName    string
 	Telemetry       *handlers.TelemetryPusherConfig
+	Notifications   handlers.WorkerConfig
 	SystemAPIUsers  map[string]*internal_authz.SystemAPIUser
 }
 
@@ -114,6 +115,8 @@ type Steps struct {
 	s28AddFieldTable                       *AddFieldTable
 	s29FillFieldsForProjectGrant           *FillFieldsForProjectGrant
 	s30FillFieldsForOrgDomainVerified      *FillFieldsForOrgDomainVerified
+	s31IDPTemplate6OAuthPKCE               *IDPTemplate6OAuthPKCE
+	s32NotificationPolicySecurityAlerts    *NotificationPolicySecurityAlerts
 }
 
 func MustNewSteps(v *viper.Viper) *Steps {
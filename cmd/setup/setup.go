@@ -159,6 +159,8 @@ func Setup(ctx context.Context, config *Config, steps *Steps, masterKey string)
 	steps.s28AddFieldTable = &AddFieldTable{dbClient: esPusherDBClient}
 	steps.s29FillFieldsForProjectGrant = &FillFieldsForProjectGrant{eventstore: eventstoreClient}
 	steps.s30FillFieldsForOrgDomainVerified = &FillFieldsForOrgDomainVerified{eventstore: eventstoreClient}
+	steps.s31IDPTemplate6OAuthPKCE = &IDPTemplate6OAuthPKCE{dbClient: esPusherDBClient}
+	steps.s32NotificationPolicySecurityAlerts = &NotificationPolicySecurityAlerts{dbClient: esPusherDBClient}
 
 	err = projection.Create(ctx, projectionDBClient, eventstoreClient, config.Projections, nil, nil, nil)
 	logging.OnError(err).Fatal("unable to start projections")
@@ -214,6 +216,8 @@ func Setup(ctx context.Context, config *Config, steps *Steps, masterKey string)
 		steps.s21AddBlockFieldToLimits,
 		steps.s25User11AddLowerFieldsToVerifiedEmail,
 		steps.s27IDPTemplate6SAMLNameIDFormat,
+		steps.s31IDPTemplate6OAuthPKCE,
+		steps.s32NotificationPolicySecurityAlerts,
 	} {
 		mustExecuteMigration(ctx, eventstoreClient, step, "migration failed")
 	}
@@ -381,6 +385,7 @@ func initProjections(
 		config.Projections.Customizations["notificationsquotas"],
 		config.Projections.Customizations["telemetry"],
 		*config.Telemetry,
+		config.Notifications,
 		config.ExternalDomain,
 		config.ExternalPort,
 		config.ExternalSecure,
@@ -25,3 +25,14 @@ func getPermissionsFromRole(rolePermissionMappings []RoleMapping, role string) [
 	}
 	return nil
 }
+
+// withAdministratorRoles appends the custom roles of the instance to the roles of the config.
+// As getPermissionsFromRole returns the first match, the roles of the config can't be overwritten.
+func withAdministratorRoles(rolePermissionMappings, administratorRoles []RoleMapping) []RoleMapping {
+	if len(administratorRoles) == 0 {
+		return rolePermissionMappings
+	}
+	mappings := make([]RoleMapping, 0, len(rolePermissionMappings)+len(administratorRoles))
+	mappings = append(mappings, rolePermissionMappings...)
+	return append(mappings, administratorRoles...)
+}
@@ -27,6 +27,7 @@ type Instance interface {
 	Block() *bool
 	AuditLogRetention() *time.Duration
 	Features() feature.Features
+	AdministratorRoles() []RoleMapping
 }
 
 type InstanceVerifier interface {
@@ -96,6 +97,10 @@ func (i *instance) Features() feature.Features {
 	return i.features
 }
 
+func (i *instance) AdministratorRoles() []RoleMapping {
+	return nil
+}
+
 func GetInstance(ctx context.Context) Instance {
 	instance, ok := ctx.Value(instanceKey).(Instance)
 	if !ok {
@@ -94,6 +94,10 @@ func (m *mockInstance) AuditLogRetention() *time.Duration {
 	panic("shouldn't be called here")
 }
 
+func (m *mockInstance) AdministratorRoles() []RoleMapping {
+	return nil
+}
+
 func (m *mockInstance) InstanceID() string {
 	return "instanceID"
 }
@@ -29,6 +29,7 @@ func getUserPermissions(ctx context.Context, resolver MembershipsResolver, requi
 	if ctxData.IsZero() {
 		return nil, nil, zerrors.ThrowUnauthenticated(nil, "AUTH-rKLWEH", "context missing")
 	}
+	roleMappings = withAdministratorRoles(roleMappings, GetInstance(ctx).AdministratorRoles())
 
 	if ctxData.SystemMemberships != nil {
 		requestedPermissions, allPermissions = mapMembershipsToPermissions(requiredPerm, ctxData.SystemMemberships, roleMappings)
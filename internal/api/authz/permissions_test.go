@@ -137,6 +137,80 @@ func Test_GetUserPermissions(t *testing.T) {
 	}
 }
 
+type administratorRolesInstance struct {
+	Instance
+	roles []RoleMapping
+}
+
+func (i *administratorRolesInstance) AdministratorRoles() []RoleMapping {
+	return i.roles
+}
+
+func Test_GetUserPermissions_AdministratorRoles(t *testing.T) {
+	configRoles := []RoleMapping{
+		{
+			Role:        "ORG_OWNER",
+			Permissions: []string{"org.read", "user.read", "user.credential.write"},
+		},
+	}
+	tests := []struct {
+		name               string
+		administratorRoles []RoleMapping
+		memberRoles        []string
+		result             []string
+	}{
+		{
+			name:        "custom role not existing",
+			memberRoles: []string{"ORG_USER_SUPPORT"},
+			result:      []string{},
+		},
+		{
+			name: "custom role",
+			administratorRoles: []RoleMapping{
+				{
+					Role:        "ORG_USER_SUPPORT",
+					Permissions: []string{"user.read", "user.credential.write"},
+				},
+			},
+			memberRoles: []string{"ORG_USER_SUPPORT"},
+			result:      []string{"user.read", "user.credential.write"},
+		},
+		{
+			name: "config role not overwritten",
+			administratorRoles: []RoleMapping{
+				{
+					Role:        "ORG_OWNER",
+					Permissions: []string{"user.read"},
+				},
+			},
+			memberRoles: []string{"ORG_OWNER"},
+			result:      []string{"org.read", "user.read", "user.credential.write"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := WithInstance(context.Background(), &administratorRolesInstance{roles: tt.administratorRoles})
+			resolver := membershipsResolverFunc(func(ctx context.Context, orgID string, shouldTriggerBulk bool) ([]*Membership, error) {
+				return []*Membership{
+					{
+						AggregateID: "orgID",
+						ObjectID:    "orgID",
+						MemberType:  MemberTypeOrganization,
+						Roles:       tt.memberRoles,
+					},
+				}, nil
+			})
+			_, perms, err := getUserPermissions(ctx, resolver, "user.read", configRoles, CtxData{UserID: "userID", OrgID: "orgID"}, "orgID")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !equalStringArray(perms, tt.result) {
+				t.Errorf("got wrong result, expecting: %v, actual: %v ", tt.result, perms)
+			}
+		})
+	}
+}
+
 func Test_MapMembershipToPermissions(t *testing.T) {
 	type args struct {
 		requiredPerm string
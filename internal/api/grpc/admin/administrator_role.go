@@ -0,0 +1,49 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	admin_pb "github.com/zitadel/zitadel/pkg/grpc/admin"
+)
+
+func (s *Server) ListAdministratorRoles(ctx context.Context, req *admin_pb.ListAdministratorRolesRequest) (*admin_pb.ListAdministratorRolesResponse, error) {
+	roles, err := s.query.SearchAdministratorRoles(ctx, listAdministratorRolesRequestToQuery(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.ListAdministratorRolesResponse{
+		Details: object.ToListDetails(roles.Count, roles.Sequence, roles.LastRun),
+		Result:  administratorRolesToPb(roles.Roles),
+	}, nil
+}
+
+func (s *Server) AddAdministratorRole(ctx context.Context, req *admin_pb.AddAdministratorRoleRequest) (*admin_pb.AddAdministratorRoleResponse, error) {
+	details, err := s.command.AddAdministratorRole(ctx, addAdministratorRoleToDomain(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.AddAdministratorRoleResponse{
+		Details: object.DomainToAddDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) UpdateAdministratorRole(ctx context.Context, req *admin_pb.UpdateAdministratorRoleRequest) (*admin_pb.UpdateAdministratorRoleResponse, error) {
+	details, err := s.command.ChangeAdministratorRole(ctx, updateAdministratorRoleToDomain(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.UpdateAdministratorRoleResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) RemoveAdministratorRole(ctx context.Context, req *admin_pb.RemoveAdministratorRoleRequest) (*admin_pb.RemoveAdministratorRoleResponse, error) {
+	details, err := s.command.RemoveAdministratorRole(ctx, req.Key)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.RemoveAdministratorRoleResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
@@ -0,0 +1,49 @@
+package admin
+
+import (
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	admin_pb "github.com/zitadel/zitadel/pkg/grpc/admin"
+)
+
+func listAdministratorRolesRequestToQuery(req *admin_pb.ListAdministratorRolesRequest) *query.AdministratorRoleSearchQueries {
+	offset, limit, asc := object.ListQueryToModel(req.Query)
+	return &query.AdministratorRoleSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset:        offset,
+			Limit:         limit,
+			Asc:           asc,
+			SortingColumn: query.AdministratorRoleColumnKey,
+		},
+	}
+}
+
+func addAdministratorRoleToDomain(req *admin_pb.AddAdministratorRoleRequest) *domain.AdministratorRole {
+	return &domain.AdministratorRole{
+		Key:         req.Key,
+		DisplayName: req.DisplayName,
+		Permissions: req.Permissions,
+	}
+}
+
+func updateAdministratorRoleToDomain(req *admin_pb.UpdateAdministratorRoleRequest) *domain.AdministratorRole {
+	return &domain.AdministratorRole{
+		Key:         req.Key,
+		DisplayName: req.DisplayName,
+		Permissions: req.Permissions,
+	}
+}
+
+func administratorRolesToPb(roles []*query.AdministratorRole) []*admin_pb.AdministratorRole {
+	result := make([]*admin_pb.AdministratorRole, len(roles))
+	for i, role := range roles {
+		result[i] = &admin_pb.AdministratorRole{
+			Details:     object.ToViewDetailsPb(role.Sequence, role.CreationDate, role.ChangeDate, role.ResourceOwner),
+			Key:         role.Key,
+			DisplayName: role.DisplayName,
+			Permissions: role.Permissions,
+		}
+	}
+	return result
+}
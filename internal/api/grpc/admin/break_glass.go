@@ -0,0 +1,53 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	admin_pb "github.com/zitadel/zitadel/pkg/grpc/admin"
+)
+
+func (s *Server) GetBreakGlassPolicy(ctx context.Context, req *admin_pb.GetBreakGlassPolicyRequest) (*admin_pb.GetBreakGlassPolicyResponse, error) {
+	policy, err := s.query.BreakGlassPolicy(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.GetBreakGlassPolicyResponse{
+		Policy: breakGlassPolicyToPb(policy),
+	}, nil
+}
+
+func (s *Server) SetBreakGlassPolicy(ctx context.Context, req *admin_pb.SetBreakGlassPolicyRequest) (*admin_pb.SetBreakGlassPolicyResponse, error) {
+	details, err := s.command.SetBreakGlassPolicy(ctx, setBreakGlassPolicyToDomain(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.SetBreakGlassPolicyResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) SetUserBreakGlass(ctx context.Context, req *admin_pb.SetUserBreakGlassRequest) (*admin_pb.SetUserBreakGlassResponse, error) {
+	details, err := s.command.SetUserBreakGlass(ctx, req.UserId, "", req.BreakGlass)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.SetUserBreakGlassResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) ListBreakGlassSessions(ctx context.Context, req *admin_pb.ListBreakGlassSessionsRequest) (*admin_pb.ListBreakGlassSessionsResponse, error) {
+	queries, err := listBreakGlassSessionsRequestToQuery(req)
+	if err != nil {
+		return nil, err
+	}
+	sessions, err := s.query.SearchBreakGlassSessions(ctx, queries)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.ListBreakGlassSessionsResponse{
+		Details: object.ToListDetails(sessions.Count, sessions.Sequence, sessions.LastRun),
+		Result:  breakGlassSessionsToPb(sessions.Sessions),
+	}, nil
+}
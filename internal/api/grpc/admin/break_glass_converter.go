@@ -0,0 +1,71 @@
+package admin
+
+import (
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	admin_pb "github.com/zitadel/zitadel/pkg/grpc/admin"
+)
+
+func breakGlassPolicyToPb(policy *domain.BreakGlassPolicy) *admin_pb.BreakGlassPolicy {
+	return &admin_pb.BreakGlassPolicy{
+		RecipientUserIds: policy.RecipientUserIDs,
+		TargetIds:        policy.TargetIDs,
+		SessionLifetime:  durationpb.New(policy.SessionLifetime),
+	}
+}
+
+func setBreakGlassPolicyToDomain(req *admin_pb.SetBreakGlassPolicyRequest) *domain.BreakGlassPolicy {
+	return &domain.BreakGlassPolicy{
+		RecipientUserIDs: req.RecipientUserIds,
+		TargetIDs:        req.TargetIds,
+		SessionLifetime:  req.GetSessionLifetime().AsDuration(),
+	}
+}
+
+func listBreakGlassSessionsRequestToQuery(req *admin_pb.ListBreakGlassSessionsRequest) (*query.BreakGlassSessionSearchQueries, error) {
+	offset, limit, asc := object.ListQueryToModel(req.Query)
+	queries := make([]query.SearchQuery, 0, 2)
+	if req.UserId != "" {
+		userIDQuery, err := query.NewBreakGlassSessionUserIDSearchQuery(req.UserId)
+		if err != nil {
+			return nil, err
+		}
+		queries = append(queries, userIDQuery)
+	}
+	if req.ActiveOnly {
+		activeQuery, err := query.NewBreakGlassSessionActiveSearchQuery()
+		if err != nil {
+			return nil, err
+		}
+		queries = append(queries, activeQuery)
+	}
+	return &query.BreakGlassSessionSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset:        offset,
+			Limit:         limit,
+			Asc:           asc,
+			SortingColumn: query.BreakGlassSessionColumnCreationDate,
+		},
+		Queries: queries,
+	}, nil
+}
+
+func breakGlassSessionsToPb(sessions []*query.BreakGlassSession) []*admin_pb.BreakGlassSession {
+	result := make([]*admin_pb.BreakGlassSession, len(sessions))
+	for i, session := range sessions {
+		result[i] = &admin_pb.BreakGlassSession{
+			UserId:         session.UserID,
+			ResourceOwner:  session.ResourceOwner,
+			SessionId:      session.SessionID,
+			CreationDate:   timestamppb.New(session.CreationDate),
+			ExpirationDate: timestamppb.New(session.Expiration),
+			UserAgent:      session.UserAgent,
+			RemoteIp:       session.RemoteIP,
+		}
+	}
+	return result
+}
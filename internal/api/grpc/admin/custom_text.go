@@ -396,6 +396,54 @@ func (s *Server) ResetCustomPasswordChangeMessageTextToDefault(ctx context.Conte
 	}, nil
 }
 
+func (s *Server) GetDefaultSecurityAlertMessageText(ctx context.Context, req *admin_pb.GetDefaultSecurityAlertMessageTextRequest) (*admin_pb.GetDefaultSecurityAlertMessageTextResponse, error) {
+	msg, err := s.query.DefaultMessageTextByTypeAndLanguageFromFileSystem(ctx, text_grpc.SecurityAlertTypeToDomain(req.Type), req.Language)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.GetDefaultSecurityAlertMessageTextResponse{
+		CustomText: text_grpc.ModelCustomMessageTextToPb(msg),
+	}, nil
+}
+
+func (s *Server) GetCustomSecurityAlertMessageText(ctx context.Context, req *admin_pb.GetCustomSecurityAlertMessageTextRequest) (*admin_pb.GetCustomSecurityAlertMessageTextResponse, error) {
+	msg, err := s.query.CustomMessageTextByTypeAndLanguage(ctx, authz.GetInstance(ctx).InstanceID(), text_grpc.SecurityAlertTypeToDomain(req.Type), req.Language, false)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.GetCustomSecurityAlertMessageTextResponse{
+		CustomText: text_grpc.ModelCustomMessageTextToPb(msg),
+	}, nil
+}
+
+func (s *Server) SetDefaultSecurityAlertMessageText(ctx context.Context, req *admin_pb.SetDefaultSecurityAlertMessageTextRequest) (*admin_pb.SetDefaultSecurityAlertMessageTextResponse, error) {
+	result, err := s.command.SetDefaultMessageText(ctx, authz.GetInstance(ctx).InstanceID(), SetSecurityAlertCustomTextToDomain(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.SetDefaultSecurityAlertMessageTextResponse{
+		Details: object.ChangeToDetailsPb(
+			result.Sequence,
+			result.EventDate,
+			result.ResourceOwner,
+		),
+	}, nil
+}
+
+func (s *Server) ResetCustomSecurityAlertMessageTextToDefault(ctx context.Context, req *admin_pb.ResetCustomSecurityAlertMessageTextToDefaultRequest) (*admin_pb.ResetCustomSecurityAlertMessageTextToDefaultResponse, error) {
+	result, err := s.command.RemoveInstanceMessageTexts(ctx, text_grpc.SecurityAlertTypeToDomain(req.Type), language.Make(req.Language))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.ResetCustomSecurityAlertMessageTextToDefaultResponse{
+		Details: object.ChangeToDetailsPb(
+			result.Sequence,
+			result.EventDate,
+			result.ResourceOwner,
+		),
+	}, nil
+}
+
 func (s *Server) GetDefaultPasswordlessRegistrationMessageText(ctx context.Context, req *admin_pb.GetDefaultPasswordlessRegistrationMessageTextRequest) (*admin_pb.GetDefaultPasswordlessRegistrationMessageTextResponse, error) {
 	msg, err := s.query.DefaultMessageTextByTypeAndLanguageFromFileSystem(ctx, domain.PasswordlessRegistrationMessageType, req.Language)
 	if err != nil {
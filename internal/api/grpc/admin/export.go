@@ -816,6 +816,7 @@ func (s *Server) getProjectsAndApps(ctx context.Context, org string) ([]*v1_pb.D
 						ClockSkew:                durationpb.New(app.OIDCConfig.ClockSkew),
 						AdditionalOrigins:        app.OIDCConfig.AdditionalOrigins,
 						SkipNativeAppSuccessPage: app.OIDCConfig.SkipNativeAppSuccessPage,
+						RequiredLevelOfAssurance: app_pb.LevelOfAssurance(app.OIDCConfig.RequiredLevelOfAssurance),
 					},
 				})
 			}
@@ -10,7 +10,7 @@ import (
 )
 
 func (s *Server) ListIAMMemberRoles(ctx context.Context, req *admin_pb.ListIAMMemberRolesRequest) (*admin_pb.ListIAMMemberRolesResponse, error) {
-	roles := s.query.GetIAMMemberRoles()
+	roles := s.query.GetIAMMemberRoles(ctx)
 	return &admin_pb.ListIAMMemberRolesResponse{
 		Roles:   roles,
 		Details: object.ToListDetails(uint64(len(roles)), 0, time.Now()),
@@ -61,6 +61,16 @@ func (s *Server) UpdateIAMMember(ctx context.Context, req *admin_pb.UpdateIAMMem
 	}, nil
 }
 
+func (s *Server) SetIAMMemberValidity(ctx context.Context, req *admin_pb.SetIAMMemberValidityRequest) (*admin_pb.SetIAMMemberValidityResponse, error) {
+	objectDetails, err := s.command.SetInstanceMemberValidity(ctx, req.UserId, object.ValidityToDomain(req.ValidFrom, req.ValidUntil))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.SetIAMMemberValidityResponse{
+		Details: object.DomainToChangeDetailsPb(objectDetails),
+	}, nil
+}
+
 func (s *Server) RemoveIAMMember(ctx context.Context, req *admin_pb.RemoveIAMMemberRequest) (*admin_pb.RemoveIAMMemberResponse, error) {
 	objectDetails, err := s.command.RemoveInstanceMember(ctx, req.UserId)
 	if err != nil {
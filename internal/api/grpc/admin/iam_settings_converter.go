@@ -173,6 +173,9 @@ func SMTPConfigToPb(smtp *query.SMTPConfig) *settings_pb.SMTPConfig {
 		Details:        obj_grpc.ToViewDetailsPb(smtp.Sequence, smtp.CreationDate, smtp.ChangeDate, smtp.ResourceOwner),
 		Id:             smtp.ID,
 		State:          settings_pb.SMTPConfigState(smtp.State),
+		Http:           httpProviderConfigToPb(smtp.HTTPConfig),
+		Dkim:           smtpDKIMConfigToPb(smtp.DKIM),
+		Headers:        smtp.Headers,
 	}
 	return mapped
 }
@@ -183,6 +186,7 @@ func SecurityPolicyToPb(policy *query.SecurityPolicy) *settings_pb.SecurityPolic
 		EnableIframeEmbedding: policy.EnableIframeEmbedding,
 		AllowedOrigins:        policy.AllowedOrigins,
 		EnableImpersonation:   policy.EnableImpersonation,
+		SmsAllowedCountries:   policy.SMSAllowedCountries,
 	}
 }
 
@@ -191,5 +195,6 @@ func securityPolicyToCommand(req *admin_pb.SetSecurityPolicyRequest) *command.Se
 		EnableIframeEmbedding: req.GetEnableIframeEmbedding(),
 		AllowedOrigins:        req.GetAllowedOrigins(),
 		EnableImpersonation:   req.GetEnableImpersonation(),
+		SMSAllowedCountries:   req.GetSmsAllowedCountries(),
 	}
 }
@@ -300,6 +300,48 @@ func (s *Server) UpdateGitHubProvider(ctx context.Context, req *admin_pb.UpdateG
 	}, nil
 }
 
+func (s *Server) AddBitbucketProvider(ctx context.Context, req *admin_pb.AddBitbucketProviderRequest) (*admin_pb.AddBitbucketProviderResponse, error) {
+	id, details, err := s.command.AddInstanceBitbucketProvider(ctx, addBitbucketProviderToCommand(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.AddBitbucketProviderResponse{
+		Id:      id,
+		Details: object_pb.DomainToAddDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) UpdateBitbucketProvider(ctx context.Context, req *admin_pb.UpdateBitbucketProviderRequest) (*admin_pb.UpdateBitbucketProviderResponse, error) {
+	details, err := s.command.UpdateInstanceBitbucketProvider(ctx, req.Id, updateBitbucketProviderToCommand(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.UpdateBitbucketProviderResponse{
+		Details: object_pb.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) AddDiscordProvider(ctx context.Context, req *admin_pb.AddDiscordProviderRequest) (*admin_pb.AddDiscordProviderResponse, error) {
+	id, details, err := s.command.AddInstanceDiscordProvider(ctx, addDiscordProviderToCommand(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.AddDiscordProviderResponse{
+		Id:      id,
+		Details: object_pb.DomainToAddDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) UpdateDiscordProvider(ctx context.Context, req *admin_pb.UpdateDiscordProviderRequest) (*admin_pb.UpdateDiscordProviderResponse, error) {
+	details, err := s.command.UpdateInstanceDiscordProvider(ctx, req.Id, updateDiscordProviderToCommand(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.UpdateDiscordProviderResponse{
+		Details: object_pb.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
 func (s *Server) AddGitHubEnterpriseServerProvider(ctx context.Context, req *admin_pb.AddGitHubEnterpriseServerProviderRequest) (*admin_pb.AddGitHubEnterpriseServerProviderResponse, error) {
 	id, details, err := s.command.AddInstanceGitHubEnterpriseProvider(ctx, addGitHubEnterpriseProviderToCommand(req))
 	if err != nil {
@@ -363,6 +405,69 @@ func (s *Server) UpdateGitLabSelfHostedProvider(ctx context.Context, req *admin_
 	}, nil
 }
 
+func (s *Server) AddADFSProvider(ctx context.Context, req *admin_pb.AddADFSProviderRequest) (*admin_pb.AddADFSProviderResponse, error) {
+	id, details, err := s.command.AddInstanceADFSProvider(ctx, addADFSProviderToCommand(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.AddADFSProviderResponse{
+		Id:      id,
+		Details: object_pb.DomainToAddDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) UpdateADFSProvider(ctx context.Context, req *admin_pb.UpdateADFSProviderRequest) (*admin_pb.UpdateADFSProviderResponse, error) {
+	details, err := s.command.UpdateInstanceADFSProvider(ctx, req.Id, updateADFSProviderToCommand(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.UpdateADFSProviderResponse{
+		Details: object_pb.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) AddKeycloakProvider(ctx context.Context, req *admin_pb.AddKeycloakProviderRequest) (*admin_pb.AddKeycloakProviderResponse, error) {
+	id, details, err := s.command.AddInstanceKeycloakProvider(ctx, addKeycloakProviderToCommand(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.AddKeycloakProviderResponse{
+		Id:      id,
+		Details: object_pb.DomainToAddDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) UpdateKeycloakProvider(ctx context.Context, req *admin_pb.UpdateKeycloakProviderRequest) (*admin_pb.UpdateKeycloakProviderResponse, error) {
+	details, err := s.command.UpdateInstanceKeycloakProvider(ctx, req.Id, updateKeycloakProviderToCommand(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.UpdateKeycloakProviderResponse{
+		Details: object_pb.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) AddOktaProvider(ctx context.Context, req *admin_pb.AddOktaProviderRequest) (*admin_pb.AddOktaProviderResponse, error) {
+	id, details, err := s.command.AddInstanceOktaProvider(ctx, addOktaProviderToCommand(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.AddOktaProviderResponse{
+		Id:      id,
+		Details: object_pb.DomainToAddDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) UpdateOktaProvider(ctx context.Context, req *admin_pb.UpdateOktaProviderRequest) (*admin_pb.UpdateOktaProviderResponse, error) {
+	details, err := s.command.UpdateInstanceOktaProvider(ctx, req.Id, updateOktaProviderToCommand(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.UpdateOktaProviderResponse{
+		Details: object_pb.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
 func (s *Server) AddGoogleProvider(ctx context.Context, req *admin_pb.AddGoogleProviderRequest) (*admin_pb.AddGoogleProviderResponse, error) {
 	id, details, err := s.command.AddInstanceGoogleProvider(ctx, addGoogleProviderToCommand(req))
 	if err != nil {
@@ -466,3 +571,13 @@ func (s *Server) DeleteProvider(ctx context.Context, req *admin_pb.DeleteProvide
 		Details: object_pb.DomainToChangeDetailsPb(details),
 	}, nil
 }
+
+func (s *Server) SetProviderMappingRules(ctx context.Context, req *admin_pb.SetProviderMappingRulesRequest) (*admin_pb.SetProviderMappingRulesResponse, error) {
+	details, err := s.command.SetInstanceIDPMappingRules(ctx, req.Id, idp_grpc.MappingRulesToCommand(req.Rules))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.SetProviderMappingRulesResponse{
+		Details: object_pb.DomainToChangeDetailsPb(details),
+	}, nil
+}
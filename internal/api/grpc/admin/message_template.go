@@ -0,0 +1,43 @@
+package admin
+
+import (
+	"context"
+
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	text_grpc "github.com/zitadel/zitadel/internal/api/grpc/text"
+	admin_pb "github.com/zitadel/zitadel/pkg/grpc/admin"
+)
+
+func (s *Server) ListDefaultMessageTemplates(ctx context.Context, _ *admin_pb.ListDefaultMessageTemplatesRequest) (*admin_pb.ListDefaultMessageTemplatesResponse, error) {
+	result, err := s.query.MessageTemplatesByOrg(ctx, authz.GetInstance(ctx).InstanceID())
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.ListDefaultMessageTemplatesResponse{
+		Details: object.ToListDetails(result.Count, result.Sequence, result.LastRun),
+		Result:  text_grpc.MessageTemplatesToPb(result.MessageTemplates),
+	}, nil
+}
+
+func (s *Server) SetDefaultMessageTemplate(ctx context.Context, req *admin_pb.SetDefaultMessageTemplateRequest) (*admin_pb.SetDefaultMessageTemplateResponse, error) {
+	details, err := s.command.SetDefaultMessageTemplate(ctx, authz.GetInstance(ctx).InstanceID(), req.Name, language.Make(req.Language), req.Template)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.SetDefaultMessageTemplateResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) RemoveDefaultMessageTemplate(ctx context.Context, req *admin_pb.RemoveDefaultMessageTemplateRequest) (*admin_pb.RemoveDefaultMessageTemplateResponse, error) {
+	details, err := s.command.RemoveDefaultMessageTemplate(ctx, authz.GetInstance(ctx).InstanceID(), req.Name, language.Make(req.Language))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.RemoveDefaultMessageTemplateResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
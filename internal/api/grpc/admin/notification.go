@@ -0,0 +1,23 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	admin_pb "github.com/zitadel/zitadel/pkg/grpc/admin"
+)
+
+func (s *Server) ListNotifications(ctx context.Context, req *admin_pb.ListNotificationsRequest) (*admin_pb.ListNotificationsResponse, error) {
+	queries, err := listNotificationsToModel(req)
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.query.SearchNotifications(ctx, queries)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.ListNotificationsResponse{
+		Details: object.ToListDetails(result.Count, result.Sequence, result.LastRun),
+		Result:  notificationsToPb(result.Notifications),
+	}, nil
+}
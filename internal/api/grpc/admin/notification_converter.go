@@ -0,0 +1,115 @@
+package admin
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	admin_pb "github.com/zitadel/zitadel/pkg/grpc/admin"
+	settings_pb "github.com/zitadel/zitadel/pkg/grpc/settings"
+)
+
+func listNotificationsToModel(req *admin_pb.ListNotificationsRequest) (_ *query.NotificationSearchQueries, err error) {
+	offset, limit, asc := object.ListQueryToModel(req.Query)
+	queries := make([]query.SearchQuery, 0, 2)
+	if req.State != settings_pb.NotificationState_NOTIFICATION_STATE_UNSPECIFIED {
+		stateQuery, err := query.NewNotificationStateSearchQuery(notificationStateToDomain(req.State))
+		if err != nil {
+			return nil, err
+		}
+		queries = append(queries, stateQuery)
+	}
+	if req.TriggeringAggregateId != "" {
+		aggregateQuery, err := query.NewNotificationTriggeringAggregateIDSearchQuery(req.TriggeringAggregateId)
+		if err != nil {
+			return nil, err
+		}
+		queries = append(queries, aggregateQuery)
+	}
+	return &query.NotificationSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset:        offset,
+			Limit:         limit,
+			Asc:           asc,
+			SortingColumn: query.NotificationQueueColumnCreationDate,
+		},
+		Queries: queries,
+	}, nil
+}
+
+func notificationsToPb(notifications []*query.Notification) []*settings_pb.Notification {
+	n := make([]*settings_pb.Notification, len(notifications))
+	for i, notification := range notifications {
+		n[i] = notificationToPb(notification)
+	}
+	return n
+}
+
+func notificationToPb(notification *query.Notification) *settings_pb.Notification {
+	n := &settings_pb.Notification{
+		Details:               object.DomainToChangeDetailsPb(&notification.ObjectDetails),
+		Id:                    notification.ID,
+		State:                 notificationStateToPb(notification.State),
+		Channel:               notificationChannelToPb(notification.Channel),
+		TriggeringAggregateId: notification.TriggeringAggregateID,
+		TriggeringEventType:   string(notification.TriggeringEventType),
+		Attempts:              uint32(notification.Attempts),
+		LastError:             notification.LastError,
+	}
+	if !notification.NextAttemptDate.IsZero() {
+		n.NextAttemptDate = timestamppb.New(notification.NextAttemptDate)
+	}
+	return n
+}
+
+func notificationStateToPb(state domain.NotificationState) settings_pb.NotificationState {
+	switch state {
+	case domain.NotificationStateRequested:
+		return settings_pb.NotificationState_NOTIFICATION_STATE_REQUESTED
+	case domain.NotificationStateRetrying:
+		return settings_pb.NotificationState_NOTIFICATION_STATE_RETRYING
+	case domain.NotificationStateSent:
+		return settings_pb.NotificationState_NOTIFICATION_STATE_SENT
+	case domain.NotificationStateCanceled:
+		return settings_pb.NotificationState_NOTIFICATION_STATE_CANCELED
+	case domain.NotificationStateFailed:
+		return settings_pb.NotificationState_NOTIFICATION_STATE_FAILED
+	case domain.NotificationStateUnspecified:
+		return settings_pb.NotificationState_NOTIFICATION_STATE_UNSPECIFIED
+	default:
+		return settings_pb.NotificationState_NOTIFICATION_STATE_UNSPECIFIED
+	}
+}
+
+func notificationStateToDomain(state settings_pb.NotificationState) domain.NotificationState {
+	switch state {
+	case settings_pb.NotificationState_NOTIFICATION_STATE_REQUESTED:
+		return domain.NotificationStateRequested
+	case settings_pb.NotificationState_NOTIFICATION_STATE_RETRYING:
+		return domain.NotificationStateRetrying
+	case settings_pb.NotificationState_NOTIFICATION_STATE_SENT:
+		return domain.NotificationStateSent
+	case settings_pb.NotificationState_NOTIFICATION_STATE_CANCELED:
+		return domain.NotificationStateCanceled
+	case settings_pb.NotificationState_NOTIFICATION_STATE_FAILED:
+		return domain.NotificationStateFailed
+	case settings_pb.NotificationState_NOTIFICATION_STATE_UNSPECIFIED:
+		return domain.NotificationStateUnspecified
+	default:
+		return domain.NotificationStateUnspecified
+	}
+}
+
+func notificationChannelToPb(channel domain.NotificationType) settings_pb.NotificationChannel {
+	switch channel {
+	case domain.NotificationTypeSms:
+		return settings_pb.NotificationChannel_NOTIFICATION_CHANNEL_SMS
+	case domain.NotificationTypePush:
+		return settings_pb.NotificationChannel_NOTIFICATION_CHANNEL_PUSH
+	case domain.NotificationTypeEmail:
+		return settings_pb.NotificationChannel_NOTIFICATION_CHANNEL_EMAIL
+	default:
+		return settings_pb.NotificationChannel_NOTIFICATION_CHANNEL_EMAIL
+	}
+}
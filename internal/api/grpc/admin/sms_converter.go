@@ -1,22 +1,33 @@
 package admin
 
 import (
+	"context"
+	"net/http"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+
 	"github.com/zitadel/zitadel/internal/api/grpc/object"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/notification/channels/twilio"
+	"github.com/zitadel/zitadel/internal/notification/channels/webhook"
 	"github.com/zitadel/zitadel/internal/query"
 	admin_pb "github.com/zitadel/zitadel/pkg/grpc/admin"
 	settings_pb "github.com/zitadel/zitadel/pkg/grpc/settings"
 )
 
-func listSMSConfigsToModel(req *admin_pb.ListSMSProvidersRequest) (*query.SMSConfigsSearchQueries, error) {
+func listSMSConfigsToModel(ctx context.Context, req *admin_pb.ListSMSProvidersRequest) (*query.SMSConfigsSearchQueries, error) {
 	offset, limit, asc := object.ListQueryToModel(req.Query)
+	resourceOwnerQuery, err := query.NewSMSProviderResourceOwnerQuery(authz.GetInstance(ctx).InstanceID())
+	if err != nil {
+		return nil, err
+	}
 	return &query.SMSConfigsSearchQueries{
 		SearchRequest: query.SearchRequest{
 			Offset: offset,
 			Limit:  limit,
 			Asc:    asc,
 		},
+		Queries: []query.SearchQuery{resourceOwnerQuery},
 	}, nil
 }
 
@@ -41,6 +52,9 @@ func SMSConfigToPb(config *query.SMSConfig) settings_pb.SMSConfig {
 	if config.TwilioConfig != nil {
 		return TwilioConfigToPb(config.TwilioConfig)
 	}
+	if config.HTTPConfig != nil {
+		return HTTPConfigToPb(config.HTTPConfig)
+	}
 	return nil
 }
 
@@ -53,6 +67,21 @@ func TwilioConfigToPb(twilio *query.Twilio) *settings_pb.SMSProvider_Twilio {
 	}
 }
 
+func HTTPConfigToPb(http *query.HTTP) *settings_pb.SMSProvider_Http {
+	return &settings_pb.SMSProvider_Http{
+		Http: httpProviderConfigToPb(http),
+	}
+}
+
+func httpProviderConfigToPb(http *query.HTTP) *settings_pb.HTTPProviderConfig {
+	if http == nil {
+		return nil
+	}
+	return &settings_pb.HTTPProviderConfig{
+		Endpoint: http.Endpoint,
+	}
+}
+
 func smsStateToPb(state domain.SMSConfigState) settings_pb.SMSProviderConfigState {
 	switch state {
 	case domain.SMSConfigStateInactive:
@@ -78,3 +107,26 @@ func UpdateSMSConfigTwilioToConfig(req *admin_pb.UpdateSMSProviderTwilioRequest)
 		SenderNumber: req.SenderNumber,
 	}
 }
+
+func AddSMSConfigHTTPToConfig(req *admin_pb.AddSMSProviderHTTPRequest) *webhook.Config {
+	return httpProviderToConfig(req.Endpoint, req.Headers, req.SigningKey)
+}
+
+func UpdateSMSConfigHTTPToConfig(req *admin_pb.UpdateSMSProviderHTTPRequest) *webhook.Config {
+	return httpProviderToConfig(req.Endpoint, req.Headers, req.SigningKey)
+}
+
+func httpProviderToConfig(endpoint string, headers map[string]string, signingKey string) *webhook.Config {
+	config := &webhook.Config{
+		CallURL:    endpoint,
+		Method:     http.MethodPost,
+		SigningKey: signingKey,
+	}
+	if len(headers) > 0 {
+		config.Headers = make(http.Header, len(headers))
+		for key, value := range headers {
+			config.Headers.Set(key, value)
+		}
+	}
+	return config
+}
@@ -21,9 +21,7 @@ func (s *Server) GetSMTPConfig(ctx context.Context, req *admin_pb.GetSMTPConfigR
 
 func (s *Server) GetSMTPConfigById(ctx context.Context, req *admin_pb.GetSMTPConfigByIdRequest) (*admin_pb.GetSMTPConfigByIdResponse, error) {
 	instanceID := authz.GetInstance(ctx).InstanceID()
-	resourceOwner := instanceID // Will be replaced when orgs have smtp configs
-
-	smtp, err := s.query.SMTPConfigByID(ctx, instanceID, resourceOwner, req.Id)
+	smtp, err := s.query.SMTPConfigByID(ctx, instanceID, instanceID, req.Id)
 	if err != nil {
 		return nil, err
 	}
@@ -59,6 +57,33 @@ func (s *Server) UpdateSMTPConfig(ctx context.Context, req *admin_pb.UpdateSMTPC
 	}, nil
 }
 
+func (s *Server) AddSMTPConfigHTTP(ctx context.Context, req *admin_pb.AddSMTPConfigHTTPRequest) (*admin_pb.AddSMTPConfigHTTPResponse, error) {
+	id, details, err := s.command.AddSMTPConfigHTTP(ctx, authz.GetInstance(ctx).InstanceID(), req.Description, httpProviderToConfig(req.Endpoint, req.Headers, req.SigningKey))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.AddSMTPConfigHTTPResponse{
+		Details: object.ChangeToDetailsPb(
+			details.Sequence,
+			details.EventDate,
+			details.ResourceOwner),
+		Id: id,
+	}, nil
+}
+
+func (s *Server) UpdateSMTPConfigHTTP(ctx context.Context, req *admin_pb.UpdateSMTPConfigHTTPRequest) (*admin_pb.UpdateSMTPConfigHTTPResponse, error) {
+	details, err := s.command.ChangeSMTPConfigHTTP(ctx, authz.GetInstance(ctx).InstanceID(), req.Id, req.Description, httpProviderToConfig(req.Endpoint, req.Headers, req.SigningKey))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.UpdateSMTPConfigHTTPResponse{
+		Details: object.ChangeToDetailsPb(
+			details.Sequence,
+			details.EventDate,
+			details.ResourceOwner),
+	}, nil
+}
+
 func (s *Server) RemoveSMTPConfig(ctx context.Context, req *admin_pb.RemoveSMTPConfigRequest) (*admin_pb.RemoveSMTPConfigResponse, error) {
 	details, err := s.command.RemoveSMTPConfig(ctx, authz.GetInstance(ctx).InstanceID(), req.Id)
 	if err != nil {
@@ -85,8 +110,38 @@ func (s *Server) UpdateSMTPConfigPassword(ctx context.Context, req *admin_pb.Upd
 	}, nil
 }
 
+func (s *Server) SetSMTPConfigDKIM(ctx context.Context, req *admin_pb.SetSMTPConfigDKIMRequest) (*admin_pb.SetSMTPConfigDKIMResponse, error) {
+	details, err := s.command.SetSMTPConfigDKIM(ctx, authz.GetInstance(ctx).InstanceID(), req.Id, setSMTPConfigDKIMToConfig(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.SetSMTPConfigDKIMResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) RemoveSMTPConfigDKIM(ctx context.Context, req *admin_pb.RemoveSMTPConfigDKIMRequest) (*admin_pb.RemoveSMTPConfigDKIMResponse, error) {
+	details, err := s.command.RemoveSMTPConfigDKIM(ctx, authz.GetInstance(ctx).InstanceID(), req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.RemoveSMTPConfigDKIMResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) SetSMTPConfigHeaders(ctx context.Context, req *admin_pb.SetSMTPConfigHeadersRequest) (*admin_pb.SetSMTPConfigHeadersResponse, error) {
+	details, err := s.command.SetSMTPConfigHeaders(ctx, authz.GetInstance(ctx).InstanceID(), req.Id, req.Headers)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.SetSMTPConfigHeadersResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
 func (s *Server) ListSMTPConfigs(ctx context.Context, req *admin_pb.ListSMTPConfigsRequest) (*admin_pb.ListSMTPConfigsResponse, error) {
-	queries, err := listSMTPConfigsToModel(req)
+	queries, err := listSMTPConfigsToModel(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -139,6 +194,15 @@ func (s *Server) TestSMTPConfigById(ctx context.Context, req *admin_pb.TestSMTPC
 	return &admin_pb.TestSMTPConfigByIdResponse{}, nil
 }
 
+func (s *Server) TestSMTPConfigHTTPById(ctx context.Context, req *admin_pb.TestSMTPConfigHTTPByIdRequest) (*admin_pb.TestSMTPConfigHTTPByIdResponse, error) {
+	err := s.command.TestSMTPConfigHTTPByID(ctx, authz.GetInstance(ctx).InstanceID(), req.Id, req.ReceiverAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return &admin_pb.TestSMTPConfigHTTPByIdResponse{}, nil
+}
+
 func (s *Server) TestSMTPConfig(ctx context.Context, req *admin_pb.TestSMTPConfigRequest) (*admin_pb.TestSMTPConfigResponse, error) {
 	config := smtp.Config{}
 	config.Tls = req.Tls
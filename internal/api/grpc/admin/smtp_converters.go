@@ -1,20 +1,29 @@
 package admin
 
 import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/notification/channels/smtp"
 	"github.com/zitadel/zitadel/internal/query"
 	admin_pb "github.com/zitadel/zitadel/pkg/grpc/admin"
 	settings_pb "github.com/zitadel/zitadel/pkg/grpc/settings"
 )
 
-func listSMTPConfigsToModel(req *admin_pb.ListSMTPConfigsRequest) (*query.SMTPConfigsSearchQueries, error) {
+func listSMTPConfigsToModel(ctx context.Context, req *admin_pb.ListSMTPConfigsRequest) (*query.SMTPConfigsSearchQueries, error) {
 	offset, limit, asc := object.ListQueryToModel(req.Query)
+	resourceOwnerQuery, err := query.NewSMTPConfigResourceOwnerSearchQuery(authz.GetInstance(ctx).InstanceID())
+	if err != nil {
+		return nil, err
+	}
 	return &query.SMTPConfigsSearchQueries{
 		SearchRequest: query.SearchRequest{
 			Offset: offset,
 			Limit:  limit,
 			Asc:    asc,
 		},
+		Queries: []query.SearchQuery{resourceOwnerQuery},
 	}, nil
 }
 
@@ -29,6 +38,27 @@ func SMTPConfigToProviderPb(config *query.SMTPConfig) *settings_pb.SMTPConfig {
 		State:         settings_pb.SMTPConfigState(config.State),
 		SenderAddress: config.SenderAddress,
 		SenderName:    config.SenderName,
+		Http:          httpProviderConfigToPb(config.HTTPConfig),
+		Dkim:          smtpDKIMConfigToPb(config.DKIM),
+		Headers:       config.Headers,
+	}
+}
+
+func smtpDKIMConfigToPb(dkim *query.SMTPConfigDKIM) *settings_pb.SMTPDKIMConfig {
+	if dkim == nil {
+		return nil
+	}
+	return &settings_pb.SMTPDKIMConfig{
+		Domain:   dkim.Domain,
+		Selector: dkim.Selector,
+	}
+}
+
+func setSMTPConfigDKIMToConfig(req *admin_pb.SetSMTPConfigDKIMRequest) *smtp.DKIM {
+	return &smtp.DKIM{
+		Domain:     req.Domain,
+		Selector:   req.Selector,
+		PrivateKey: string(req.PrivateKey),
 	}
 }
 
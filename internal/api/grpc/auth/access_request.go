@@ -0,0 +1,54 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	member_grpc "github.com/zitadel/zitadel/internal/api/grpc/member"
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	auth_pb "github.com/zitadel/zitadel/pkg/grpc/auth"
+)
+
+func (s *Server) RequestMyAccess(ctx context.Context, req *auth_pb.RequestMyAccessRequest) (*auth_pb.RequestMyAccessResponse, error) {
+	ctxData := authz.GetCtxData(ctx)
+	request := &domain.AccessRequest{
+		UserID:   ctxData.UserID,
+		Scope:    member_grpc.AccessRequestScopeToDomain(req.Scope),
+		Roles:    req.Roles,
+		Duration: req.Duration.AsDuration(),
+		Reason:   req.Reason,
+	}
+	details, err := s.command.RequestAccess(ctx, request, ctxData.ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	return &auth_pb.RequestMyAccessResponse{
+		Id:      request.AggregateID,
+		Details: object.DomainToAddDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) ListMyAccessRequests(ctx context.Context, req *auth_pb.ListMyAccessRequestsRequest) (*auth_pb.ListMyAccessRequestsResponse, error) {
+	userQuery, err := query.NewAccessRequestUserIDSearchQuery(authz.GetCtxData(ctx).UserID)
+	if err != nil {
+		return nil, err
+	}
+	offset, limit, asc := object.ListQueryToModel(req.Query)
+	requests, err := s.query.SearchAccessRequests(ctx, &query.AccessRequestSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset: offset,
+			Limit:  limit,
+			Asc:    asc,
+		},
+		Queries: []query.SearchQuery{userQuery},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &auth_pb.ListMyAccessRequestsResponse{
+		Details: object.ToListDetails(requests.Count, requests.Sequence, requests.LastRun),
+		Result:  member_grpc.AccessRequestsToPb(requests.AccessRequests),
+	}, nil
+}
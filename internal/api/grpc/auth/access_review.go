@@ -0,0 +1,53 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	member_grpc "github.com/zitadel/zitadel/internal/api/grpc/member"
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	auth_pb "github.com/zitadel/zitadel/pkg/grpc/auth"
+)
+
+func (s *Server) ListMyAccessReviewItems(ctx context.Context, req *auth_pb.ListMyAccessReviewItemsRequest) (*auth_pb.ListMyAccessReviewItemsResponse, error) {
+	queries, err := member_grpc.AccessReviewItemQueriesToQuery(req.Queries)
+	if err != nil {
+		return nil, err
+	}
+	reviewerQuery, err := query.NewAccessReviewItemReviewerSearchQuery(authz.GetCtxData(ctx).UserID)
+	if err != nil {
+		return nil, err
+	}
+	activeQuery, err := query.NewAccessReviewItemReviewStateSearchQuery(domain.AccessReviewStateActive)
+	if err != nil {
+		return nil, err
+	}
+	offset, limit, asc := object.ListQueryToModel(req.Query)
+	items, err := s.query.SearchAccessReviewItems(ctx, &query.AccessReviewItemSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset: offset,
+			Limit:  limit,
+			Asc:    asc,
+		},
+		Queries: append(queries, reviewerQuery, activeQuery),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &auth_pb.ListMyAccessReviewItemsResponse{
+		Details: object.ToListDetails(items.Count, items.Sequence, items.LastRun),
+		Result:  member_grpc.AccessReviewItemsToPb(items.Items),
+	}, nil
+}
+
+func (s *Server) DecideMyAccessReviewItem(ctx context.Context, req *auth_pb.DecideMyAccessReviewItemRequest) (*auth_pb.DecideMyAccessReviewItemResponse, error) {
+	details, err := s.command.DecideMyAccessReviewItem(ctx, req.Id, req.ItemId, member_grpc.AccessReviewDecisionToDomain(req.Decision), req.Comment)
+	if err != nil {
+		return nil, err
+	}
+	return &auth_pb.DecideMyAccessReviewItemResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
@@ -414,6 +414,16 @@ func providerTypeToPb(idpType domain.IDPType) idp_pb.ProviderType {
 		return idp_pb.ProviderType_PROVIDER_TYPE_APPLE
 	case domain.IDPTypeSAML:
 		return idp_pb.ProviderType_PROVIDER_TYPE_SAML
+	case domain.IDPTypeADFS:
+		return idp_pb.ProviderType_PROVIDER_TYPE_ADFS
+	case domain.IDPTypeKeycloak:
+		return idp_pb.ProviderType_PROVIDER_TYPE_KEYCLOAK
+	case domain.IDPTypeOkta:
+		return idp_pb.ProviderType_PROVIDER_TYPE_OKTA
+	case domain.IDPTypeBitbucket:
+		return idp_pb.ProviderType_PROVIDER_TYPE_BITBUCKET
+	case domain.IDPTypeDiscord:
+		return idp_pb.ProviderType_PROVIDER_TYPE_DISCORD
 	case domain.IDPTypeUnspecified:
 		return idp_pb.ProviderType_PROVIDER_TYPE_UNSPECIFIED
 	default:
@@ -479,6 +489,26 @@ func configToPb(config *query.IDPTemplate) *idp_pb.ProviderConfig {
 		samlConfigToPb(providerConfig, config.SAMLIDPTemplate)
 		return providerConfig
 	}
+	if config.ADFSIDPTemplate != nil {
+		adfsConfigToPb(providerConfig, config.ADFSIDPTemplate)
+		return providerConfig
+	}
+	if config.KeycloakIDPTemplate != nil {
+		keycloakConfigToPb(providerConfig, config.KeycloakIDPTemplate)
+		return providerConfig
+	}
+	if config.OktaIDPTemplate != nil {
+		oktaConfigToPb(providerConfig, config.OktaIDPTemplate)
+		return providerConfig
+	}
+	if config.BitbucketIDPTemplate != nil {
+		bitbucketConfigToPb(providerConfig, config.BitbucketIDPTemplate)
+		return providerConfig
+	}
+	if config.DiscordIDPTemplate != nil {
+		discordConfigToPb(providerConfig, config.DiscordIDPTemplate)
+		return providerConfig
+	}
 	return providerConfig
 }
 
@@ -504,6 +534,7 @@ func oauthConfigToPb(providerConfig *idp_pb.ProviderConfig, template *query.OAut
 			UserEndpoint:          template.UserEndpoint,
 			Scopes:                template.Scopes,
 			IdAttribute:           template.IDAttribute,
+			UsePkce:               template.UsePKCE,
 		},
 	}
 }
@@ -670,6 +701,54 @@ func samlConfigToPb(providerConfig *idp_pb.ProviderConfig, template *query.SAMLI
 	}
 }
 
+func adfsConfigToPb(providerConfig *idp_pb.ProviderConfig, template *query.ADFSIDPTemplate) {
+	providerConfig.Config = &idp_pb.ProviderConfig_Adfs{
+		Adfs: &idp_pb.ADFSConfig{
+			ClientId: template.ClientID,
+			Issuer:   template.Issuer,
+			Scopes:   template.Scopes,
+		},
+	}
+}
+
+func keycloakConfigToPb(providerConfig *idp_pb.ProviderConfig, template *query.KeycloakIDPTemplate) {
+	providerConfig.Config = &idp_pb.ProviderConfig_Keycloak{
+		Keycloak: &idp_pb.KeycloakConfig{
+			ClientId: template.ClientID,
+			Issuer:   template.Issuer,
+			Scopes:   template.Scopes,
+		},
+	}
+}
+
+func oktaConfigToPb(providerConfig *idp_pb.ProviderConfig, template *query.OktaIDPTemplate) {
+	providerConfig.Config = &idp_pb.ProviderConfig_Okta{
+		Okta: &idp_pb.OktaConfig{
+			ClientId: template.ClientID,
+			Issuer:   template.Issuer,
+			Scopes:   template.Scopes,
+		},
+	}
+}
+
+func bitbucketConfigToPb(providerConfig *idp_pb.ProviderConfig, template *query.BitbucketIDPTemplate) {
+	providerConfig.Config = &idp_pb.ProviderConfig_Bitbucket{
+		Bitbucket: &idp_pb.BitbucketConfig{
+			ClientId: template.ClientID,
+			Scopes:   template.Scopes,
+		},
+	}
+}
+
+func discordConfigToPb(providerConfig *idp_pb.ProviderConfig, template *query.DiscordIDPTemplate) {
+	providerConfig.Config = &idp_pb.ProviderConfig_Discord{
+		Discord: &idp_pb.DiscordConfig{
+			ClientId: template.ClientID,
+			Scopes:   template.Scopes,
+		},
+	}
+}
+
 func bindingToPb(binding string) idp_pb.SAMLBinding {
 	switch binding {
 	case "":
@@ -699,3 +778,52 @@ func nameIDToPb(format domain.SAMLNameIDFormat) idp_pb.SAMLNameIDFormat {
 		return idp_pb.SAMLNameIDFormat_SAML_NAME_ID_FORMAT_UNSPECIFIED
 	}
 }
+
+func MappingRulesToCommand(rules *idp_pb.MappingRules) idp.MappingRules {
+	if rules == nil {
+		return idp.MappingRules{}
+	}
+	mappingRules := idp.MappingRules{
+		GroupsClaim: rules.GroupsClaim,
+	}
+	for _, mapping := range rules.ProfileMappings {
+		mappingRules.ProfileMappings = append(mappingRules.ProfileMappings, idp.ProfileMapping{
+			Claim: mapping.Claim,
+			Field: profileMappingFieldToDomain(mapping.Field),
+		})
+	}
+	for _, mapping := range rules.MetadataMappings {
+		mappingRules.MetadataMappings = append(mappingRules.MetadataMappings, idp.MetadataMapping{
+			Claim: mapping.Claim,
+			Key:   mapping.Key,
+		})
+	}
+	for _, mapping := range rules.GroupMappings {
+		mappingRules.GroupMappings = append(mappingRules.GroupMappings, idp.GroupMapping{
+			Group:          mapping.Group,
+			ProjectID:      mapping.ProjectId,
+			ProjectGrantID: mapping.ProjectGrantId,
+			RoleKeys:       mapping.RoleKeys,
+		})
+	}
+	return mappingRules
+}
+
+func profileMappingFieldToDomain(field idp_pb.ProfileMappingField) domain.IDPMappingProfileField {
+	switch field {
+	case idp_pb.ProfileMappingField_PROFILE_MAPPING_FIELD_FIRST_NAME:
+		return domain.IDPMappingProfileFieldFirstName
+	case idp_pb.ProfileMappingField_PROFILE_MAPPING_FIELD_LAST_NAME:
+		return domain.IDPMappingProfileFieldLastName
+	case idp_pb.ProfileMappingField_PROFILE_MAPPING_FIELD_NICK_NAME:
+		return domain.IDPMappingProfileFieldNickName
+	case idp_pb.ProfileMappingField_PROFILE_MAPPING_FIELD_DISPLAY_NAME:
+		return domain.IDPMappingProfileFieldDisplayName
+	case idp_pb.ProfileMappingField_PROFILE_MAPPING_FIELD_PREFERRED_LANGUAGE:
+		return domain.IDPMappingProfileFieldPreferredLanguage
+	case idp_pb.ProfileMappingField_PROFILE_MAPPING_FIELD_UNSPECIFIED:
+		return domain.IDPMappingProfileFieldUnspecified
+	default:
+		return domain.IDPMappingProfileFieldUnspecified
+	}
+}
@@ -0,0 +1,71 @@
+package management
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	member_grpc "github.com/zitadel/zitadel/internal/api/grpc/member"
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	mgmt_pb "github.com/zitadel/zitadel/pkg/grpc/management"
+)
+
+func (s *Server) ListAccessRequests(ctx context.Context, req *mgmt_pb.ListAccessRequestsRequest) (*mgmt_pb.ListAccessRequestsResponse, error) {
+	queries, err := listAccessRequestsRequestToQuery(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	requests, err := s.query.SearchAccessRequests(ctx, queries)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ListAccessRequestsResponse{
+		Details: object.ToListDetails(requests.Count, requests.Sequence, requests.LastRun),
+		Result:  member_grpc.AccessRequestsToPb(requests.AccessRequests),
+	}, nil
+}
+
+func (s *Server) ApproveAccessRequest(ctx context.Context, req *mgmt_pb.ApproveAccessRequestRequest) (*mgmt_pb.ApproveAccessRequestResponse, error) {
+	details, err := s.command.ApproveAccessRequest(ctx, req.Id, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ApproveAccessRequestResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) DenyAccessRequest(ctx context.Context, req *mgmt_pb.DenyAccessRequestRequest) (*mgmt_pb.DenyAccessRequestResponse, error) {
+	details, err := s.command.DenyAccessRequest(ctx, req.Id, authz.GetCtxData(ctx).OrgID, req.Reason)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.DenyAccessRequestResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func listAccessRequestsRequestToQuery(ctx context.Context, req *mgmt_pb.ListAccessRequestsRequest) (*query.AccessRequestSearchQueries, error) {
+	queries, err := member_grpc.AccessRequestQueriesToQuery(req.Queries)
+	if err != nil {
+		return nil, err
+	}
+	ownerQuery, err := query.NewAccessRequestResourceOwnerSearchQuery(authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	scopeQuery, err := query.NewAccessRequestScopeSearchQuery(domain.AccessRequestScopeOrg)
+	if err != nil {
+		return nil, err
+	}
+	offset, limit, asc := object.ListQueryToModel(req.Query)
+	return &query.AccessRequestSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset: offset,
+			Limit:  limit,
+			Asc:    asc,
+		},
+		Queries: append(queries, ownerQuery, scopeQuery),
+	}, nil
+}
@@ -0,0 +1,154 @@
+package management
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	member_grpc "github.com/zitadel/zitadel/internal/api/grpc/member"
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	mgmt_pb "github.com/zitadel/zitadel/pkg/grpc/management"
+	object_pb "github.com/zitadel/zitadel/pkg/grpc/object"
+)
+
+func (s *Server) StartAccessReview(ctx context.Context, req *mgmt_pb.StartAccessReviewRequest) (*mgmt_pb.StartAccessReviewResponse, error) {
+	orgID := authz.GetCtxData(ctx).OrgID
+	review := &domain.AccessReview{
+		Name:               req.Name,
+		Scope:              member_grpc.AccessReviewScopeToDomain(req.Scope),
+		ProjectID:          req.ProjectId,
+		RoleKey:            req.RoleKey,
+		ReviewerType:       member_grpc.AccessReviewerTypeToDomain(req.ReviewerType),
+		ManagerMetadataKey: req.ManagerMetadataKey,
+		Deadline:           req.Deadline.AsTime(),
+		DeadlineAction:     member_grpc.AccessReviewDeadlineActionToDomain(req.DeadlineAction),
+	}
+	items, err := s.query.AccessReviewCandidates(ctx, review, orgID)
+	if err != nil {
+		return nil, err
+	}
+	details, err := s.command.StartAccessReview(ctx, review, items, orgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.StartAccessReviewResponse{
+		Id:      review.AggregateID,
+		Details: object.DomainToAddDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) ListAccessReviews(ctx context.Context, req *mgmt_pb.ListAccessReviewsRequest) (*mgmt_pb.ListAccessReviewsResponse, error) {
+	queries, err := listAccessReviewsRequestToQuery(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	reviews, err := s.query.SearchAccessReviews(ctx, queries)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ListAccessReviewsResponse{
+		Details: object.ToListDetails(reviews.Count, reviews.Sequence, reviews.LastRun),
+		Result:  member_grpc.AccessReviewsToPb(reviews.AccessReviews),
+	}, nil
+}
+
+func (s *Server) GetAccessReview(ctx context.Context, req *mgmt_pb.GetAccessReviewRequest) (*mgmt_pb.GetAccessReviewResponse, error) {
+	review, err := s.query.AccessReviewByID(ctx, req.Id, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.GetAccessReviewResponse{
+		AccessReview: member_grpc.AccessReviewToPb(review),
+	}, nil
+}
+
+func (s *Server) ListAccessReviewItems(ctx context.Context, req *mgmt_pb.ListAccessReviewItemsRequest) (*mgmt_pb.ListAccessReviewItemsResponse, error) {
+	queries, err := member_grpc.AccessReviewItemQueriesToQuery(req.Queries)
+	if err != nil {
+		return nil, err
+	}
+	items, err := s.accessReviewItems(ctx, req.Id, req.Query, queries...)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ListAccessReviewItemsResponse{
+		Details: object.ToListDetails(items.Count, items.Sequence, items.LastRun),
+		Result:  member_grpc.AccessReviewItemsToPb(items.Items),
+	}, nil
+}
+
+func (s *Server) DecideAccessReviewItem(ctx context.Context, req *mgmt_pb.DecideAccessReviewItemRequest) (*mgmt_pb.DecideAccessReviewItemResponse, error) {
+	details, err := s.command.DecideAccessReviewItem(ctx, req.Id, authz.GetCtxData(ctx).OrgID, req.ItemId, member_grpc.AccessReviewDecisionToDomain(req.Decision), req.Comment)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.DecideAccessReviewItemResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) CancelAccessReview(ctx context.Context, req *mgmt_pb.CancelAccessReviewRequest) (*mgmt_pb.CancelAccessReviewResponse, error) {
+	details, err := s.command.CancelAccessReview(ctx, req.Id, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.CancelAccessReviewResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) ExportAccessReviewReport(ctx context.Context, req *mgmt_pb.ExportAccessReviewReportRequest) (*mgmt_pb.ExportAccessReviewReportResponse, error) {
+	items, err := s.accessReviewItems(ctx, req.Id, nil)
+	if err != nil {
+		return nil, err
+	}
+	report, err := items.CSV()
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ExportAccessReviewReportResponse{
+		Report: report,
+	}, nil
+}
+
+// accessReviewItems returns the items of the campaign if it belongs to the organization
+func (s *Server) accessReviewItems(ctx context.Context, reviewID string, listQuery *object_pb.ListQuery, queries ...query.SearchQuery) (*query.AccessReviewItems, error) {
+	review, err := s.query.AccessReviewByID(ctx, reviewID, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	reviewQuery, err := query.NewAccessReviewItemReviewIDSearchQuery(review.ID)
+	if err != nil {
+		return nil, err
+	}
+	offset, limit, asc := object.ListQueryToModel(listQuery)
+	return s.query.SearchAccessReviewItems(ctx, &query.AccessReviewItemSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset: offset,
+			Limit:  limit,
+			Asc:    asc,
+		},
+		Queries: append(queries, reviewQuery),
+	})
+}
+
+func listAccessReviewsRequestToQuery(ctx context.Context, req *mgmt_pb.ListAccessReviewsRequest) (*query.AccessReviewSearchQueries, error) {
+	queries, err := member_grpc.AccessReviewQueriesToQuery(req.Queries)
+	if err != nil {
+		return nil, err
+	}
+	ownerQuery, err := query.NewAccessReviewResourceOwnerSearchQuery(authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	offset, limit, asc := object.ListQueryToModel(req.Query)
+	return &query.AccessReviewSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset: offset,
+			Limit:  limit,
+			Asc:    asc,
+		},
+		Queries: append(queries, ownerQuery),
+	}, nil
+}
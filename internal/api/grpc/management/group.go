@@ -0,0 +1,183 @@
+package management
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	mgmt_pb "github.com/zitadel/zitadel/pkg/grpc/management"
+)
+
+func (s *Server) GetGroupByID(ctx context.Context, req *mgmt_pb.GetGroupByIDRequest) (*mgmt_pb.GetGroupByIDResponse, error) {
+	group, err := s.query.GroupByID(ctx, req.Id, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.GetGroupByIDResponse{
+		Group: groupToPb(group),
+	}, nil
+}
+
+func (s *Server) ListGroups(ctx context.Context, req *mgmt_pb.ListGroupsRequest) (*mgmt_pb.ListGroupsResponse, error) {
+	queries, err := listGroupsRequestToQuery(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	groups, err := s.query.SearchGroups(ctx, queries)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ListGroupsResponse{
+		Details: object.ToListDetails(groups.Count, groups.Sequence, groups.LastRun),
+		Result:  groupsToPb(groups.Groups),
+	}, nil
+}
+
+func (s *Server) AddGroup(ctx context.Context, req *mgmt_pb.AddGroupRequest) (*mgmt_pb.AddGroupResponse, error) {
+	group := addGroupRequestToDomain(req)
+	details, err := s.command.AddGroup(ctx, group, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.AddGroupResponse{
+		Id:      group.AggregateID,
+		Details: object.DomainToAddDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) UpdateGroup(ctx context.Context, req *mgmt_pb.UpdateGroupRequest) (*mgmt_pb.UpdateGroupResponse, error) {
+	details, err := s.command.ChangeGroup(ctx, updateGroupRequestToDomain(ctx, req))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.UpdateGroupResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) RemoveGroup(ctx context.Context, req *mgmt_pb.RemoveGroupRequest) (*mgmt_pb.RemoveGroupResponse, error) {
+	details, err := s.command.RemoveGroup(ctx, req.Id, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.RemoveGroupResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) ListGroupMembers(ctx context.Context, req *mgmt_pb.ListGroupMembersRequest) (*mgmt_pb.ListGroupMembersResponse, error) {
+	if _, err := s.query.GroupByID(ctx, req.Id, authz.GetCtxData(ctx).OrgID); err != nil {
+		return nil, err
+	}
+	members, err := s.query.SearchGroupMembers(ctx, req.Id, listQueryToSearchRequest(req.Query))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ListGroupMembersResponse{
+		Details: object.ToListDetails(members.Count, members.Sequence, members.LastRun),
+		Result:  groupMembersToPb(members.Members),
+	}, nil
+}
+
+func (s *Server) AddGroupMembers(ctx context.Context, req *mgmt_pb.AddGroupMembersRequest) (*mgmt_pb.AddGroupMembersResponse, error) {
+	details, err := s.command.AddGroupMembers(ctx, req.Id, authz.GetCtxData(ctx).OrgID, req.UserIds)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.AddGroupMembersResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) SetGroupMembers(ctx context.Context, req *mgmt_pb.SetGroupMembersRequest) (*mgmt_pb.SetGroupMembersResponse, error) {
+	details, err := s.command.SetGroupMembers(ctx, req.Id, authz.GetCtxData(ctx).OrgID, req.UserIds)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.SetGroupMembersResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) RemoveGroupMembers(ctx context.Context, req *mgmt_pb.RemoveGroupMembersRequest) (*mgmt_pb.RemoveGroupMembersResponse, error) {
+	details, err := s.command.RemoveGroupMembers(ctx, req.Id, authz.GetCtxData(ctx).OrgID, req.UserIds)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.RemoveGroupMembersResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) AddSubgroup(ctx context.Context, req *mgmt_pb.AddSubgroupRequest) (*mgmt_pb.AddSubgroupResponse, error) {
+	details, err := s.command.AddSubgroup(ctx, req.Id, req.SubgroupId, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.AddSubgroupResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) RemoveSubgroup(ctx context.Context, req *mgmt_pb.RemoveSubgroupRequest) (*mgmt_pb.RemoveSubgroupResponse, error) {
+	details, err := s.command.RemoveSubgroup(ctx, req.Id, req.SubgroupId, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.RemoveSubgroupResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) ListGroupGrants(ctx context.Context, req *mgmt_pb.ListGroupGrantsRequest) (*mgmt_pb.ListGroupGrantsResponse, error) {
+	if _, err := s.query.GroupByID(ctx, req.Id, authz.GetCtxData(ctx).OrgID); err != nil {
+		return nil, err
+	}
+	grants, err := s.query.SearchGroupGrants(ctx, req.Id, listQueryToSearchRequest(req.Query))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ListGroupGrantsResponse{
+		Details: object.ToListDetails(grants.Count, grants.Sequence, grants.LastRun),
+		Result:  groupGrantsToPb(grants.Grants),
+	}, nil
+}
+
+func (s *Server) AddGroupGrant(ctx context.Context, req *mgmt_pb.AddGroupGrantRequest) (*mgmt_pb.AddGroupGrantResponse, error) {
+	if err := checkExplicitProjectPermission(ctx, req.ProjectGrantId, req.ProjectId); err != nil {
+		return nil, err
+	}
+	details, err := s.command.AddGroupGrant(ctx, addGroupGrantRequestToDomain(req), authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.AddGroupGrantResponse{
+		Details: object.DomainToAddDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) UpdateGroupGrant(ctx context.Context, req *mgmt_pb.UpdateGroupGrantRequest) (*mgmt_pb.UpdateGroupGrantResponse, error) {
+	if err := checkExplicitProjectPermission(ctx, req.ProjectGrantId, req.ProjectId); err != nil {
+		return nil, err
+	}
+	details, err := s.command.ChangeGroupGrant(ctx, updateGroupGrantRequestToDomain(req), authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.UpdateGroupGrantResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) RemoveGroupGrant(ctx context.Context, req *mgmt_pb.RemoveGroupGrantRequest) (*mgmt_pb.RemoveGroupGrantResponse, error) {
+	if err := checkExplicitProjectPermission(ctx, req.ProjectGrantId, req.ProjectId); err != nil {
+		return nil, err
+	}
+	details, err := s.command.RemoveGroupGrant(ctx, req.Id, req.ProjectId, req.ProjectGrantId, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.RemoveGroupGrantResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
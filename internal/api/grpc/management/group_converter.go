@@ -0,0 +1,173 @@
+package management
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+	group_pb "github.com/zitadel/zitadel/pkg/grpc/group"
+	mgmt_pb "github.com/zitadel/zitadel/pkg/grpc/management"
+	v1_pb "github.com/zitadel/zitadel/pkg/grpc/v1"
+)
+
+func listGroupsRequestToQuery(ctx context.Context, req *mgmt_pb.ListGroupsRequest) (*query.GroupSearchQueries, error) {
+	queries, err := groupQueriesToQuery(req.Queries)
+	if err != nil {
+		return nil, err
+	}
+	ownerQuery, err := query.NewGroupResourceOwnerSearchQuery(authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	offset, limit, asc := object.ListQueryToModel(req.Query)
+	return &query.GroupSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset:        offset,
+			Limit:         limit,
+			Asc:           asc,
+			SortingColumn: query.GroupColumnName,
+		},
+		Queries: append(queries, ownerQuery),
+	}, nil
+}
+
+func groupQueriesToQuery(queries []*group_pb.GroupQuery) (_ []query.SearchQuery, err error) {
+	q := make([]query.SearchQuery, len(queries))
+	for i, query := range queries {
+		q[i], err = groupQueryToQuery(query)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return q, nil
+}
+
+func groupQueryToQuery(groupQuery *group_pb.GroupQuery) (query.SearchQuery, error) {
+	switch q := groupQuery.Query.(type) {
+	case *group_pb.GroupQuery_NameQuery:
+		return query.NewGroupNameSearchQuery(q.NameQuery.Name, object.TextMethodToQuery(q.NameQuery.Method))
+	case *group_pb.GroupQuery_ExternalIdQuery:
+		return query.NewGroupExternalIDSearchQuery(q.ExternalIdQuery.ExternalId, object.TextMethodToQuery(q.ExternalIdQuery.Method))
+	default:
+		return nil, zerrors.ThrowInvalidArgument(nil, "MGMT-Gr1qu", "List.Query.Invalid")
+	}
+}
+
+func listQueryToSearchRequest(listQuery *v1_pb.ListQuery) *query.SearchRequest {
+	offset, limit, asc := object.ListQueryToModel(listQuery)
+	return &query.SearchRequest{
+		Offset: offset,
+		Limit:  limit,
+		Asc:    asc,
+	}
+}
+
+func addGroupRequestToDomain(req *mgmt_pb.AddGroupRequest) *domain.Group {
+	return &domain.Group{
+		Name:        req.Name,
+		Description: req.Description,
+		ExternalID:  req.ExternalId,
+	}
+}
+
+func updateGroupRequestToDomain(ctx context.Context, req *mgmt_pb.UpdateGroupRequest) *domain.Group {
+	return &domain.Group{
+		ObjectRoot: models.ObjectRoot{
+			AggregateID:   req.Id,
+			ResourceOwner: authz.GetCtxData(ctx).OrgID,
+		},
+		Name:        req.Name,
+		Description: req.Description,
+		ExternalID:  req.ExternalId,
+	}
+}
+
+func addGroupGrantRequestToDomain(req *mgmt_pb.AddGroupGrantRequest) *domain.GroupGrant {
+	return &domain.GroupGrant{
+		GroupID:        req.Id,
+		ProjectID:      req.ProjectId,
+		ProjectGrantID: req.ProjectGrantId,
+		RoleKeys:       req.RoleKeys,
+	}
+}
+
+func updateGroupGrantRequestToDomain(req *mgmt_pb.UpdateGroupGrantRequest) *domain.GroupGrant {
+	return &domain.GroupGrant{
+		GroupID:        req.Id,
+		ProjectID:      req.ProjectId,
+		ProjectGrantID: req.ProjectGrantId,
+		RoleKeys:       req.RoleKeys,
+	}
+}
+
+func groupsToPb(groups []*query.Group) []*group_pb.Group {
+	result := make([]*group_pb.Group, len(groups))
+	for i, group := range groups {
+		result[i] = groupToPb(group)
+	}
+	return result
+}
+
+func groupToPb(group *query.Group) *group_pb.Group {
+	return &group_pb.Group{
+		Id:          group.ID,
+		Details:     object.ToViewDetailsPb(group.Sequence, group.CreationDate, group.ChangeDate, group.ResourceOwner),
+		State:       groupStateToPb(group.State),
+		Name:        group.Name,
+		Description: group.Description,
+		ExternalId:  group.ExternalID,
+	}
+}
+
+func groupStateToPb(state domain.GroupState) group_pb.GroupState {
+	switch state {
+	case domain.GroupStateActive:
+		return group_pb.GroupState_GROUP_STATE_ACTIVE
+	case domain.GroupStateUnspecified, domain.GroupStateRemoved:
+		return group_pb.GroupState_GROUP_STATE_UNSPECIFIED
+	default:
+		return group_pb.GroupState_GROUP_STATE_UNSPECIFIED
+	}
+}
+
+func groupMembersToPb(members []*query.GroupMember) []*group_pb.GroupMember {
+	result := make([]*group_pb.GroupMember, len(members))
+	for i, member := range members {
+		result[i] = &group_pb.GroupMember{
+			MemberId: member.MemberID,
+			Type:     groupMemberTypeToPb(member.Type),
+			Details:  object.ToViewDetailsPb(member.Sequence, member.CreationDate, member.CreationDate, ""),
+		}
+	}
+	return result
+}
+
+func groupMemberTypeToPb(memberType domain.GroupMemberType) group_pb.GroupMemberType {
+	switch memberType {
+	case domain.GroupMemberTypeUser:
+		return group_pb.GroupMemberType_GROUP_MEMBER_TYPE_USER
+	case domain.GroupMemberTypeGroup:
+		return group_pb.GroupMemberType_GROUP_MEMBER_TYPE_GROUP
+	case domain.GroupMemberTypeUnspecified:
+		return group_pb.GroupMemberType_GROUP_MEMBER_TYPE_UNSPECIFIED
+	default:
+		return group_pb.GroupMemberType_GROUP_MEMBER_TYPE_UNSPECIFIED
+	}
+}
+
+func groupGrantsToPb(grants []*query.GroupGrant) []*group_pb.GroupGrant {
+	result := make([]*group_pb.GroupGrant, len(grants))
+	for i, grant := range grants {
+		result[i] = &group_pb.GroupGrant{
+			ProjectId:      grant.ProjectID,
+			ProjectGrantId: grant.ProjectGrantID,
+			RoleKeys:       grant.Roles,
+			Details:        object.ToViewDetailsPb(grant.Sequence, grant.CreationDate, grant.ChangeDate, ""),
+		}
+	}
+	return result
+}
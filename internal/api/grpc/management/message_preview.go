@@ -0,0 +1,45 @@
+package management
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/domain"
+	mgmt_pb "github.com/zitadel/zitadel/pkg/grpc/management"
+)
+
+func (s *Server) PreviewMessage(ctx context.Context, req *mgmt_pb.PreviewMessageRequest) (*mgmt_pb.PreviewMessageResponse, error) {
+	msg, err := s.previewer.PreviewMessage(ctx, authz.GetCtxData(ctx).OrgID, req.Type, req.Language, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.PreviewMessageResponse{
+		Subject: msg.Subject,
+		Html:    msg.HTML,
+		Text:    msg.Text,
+		Sms:     msg.Text,
+	}, nil
+}
+
+func (s *Server) SendTestMessage(ctx context.Context, req *mgmt_pb.SendTestMessageRequest) (*mgmt_pb.SendTestMessageResponse, error) {
+	notificationType, recipient := testMessageRecipientToDomain(req)
+	details, err := s.command.RequestOrgTestMessage(ctx, authz.GetCtxData(ctx).OrgID, req.Type, req.Language, req.UserId, notificationType, recipient)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.SendTestMessageResponse{
+		Details: object.DomainToAddDetailsPb(details),
+	}, nil
+}
+
+func testMessageRecipientToDomain(req *mgmt_pb.SendTestMessageRequest) (domain.NotificationType, string) {
+	switch recipient := req.Recipient.(type) {
+	case *mgmt_pb.SendTestMessageRequest_Phone:
+		return domain.NotificationTypeSms, recipient.Phone
+	case *mgmt_pb.SendTestMessageRequest_Email:
+		return domain.NotificationTypeEmail, recipient.Email
+	default:
+		return domain.NotificationTypeEmail, ""
+	}
+}
@@ -0,0 +1,43 @@
+package management
+
+import (
+	"context"
+
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	text_grpc "github.com/zitadel/zitadel/internal/api/grpc/text"
+	mgmt_pb "github.com/zitadel/zitadel/pkg/grpc/management"
+)
+
+func (s *Server) ListMessageTemplates(ctx context.Context, _ *mgmt_pb.ListMessageTemplatesRequest) (*mgmt_pb.ListMessageTemplatesResponse, error) {
+	result, err := s.query.MessageTemplatesByOrg(ctx, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ListMessageTemplatesResponse{
+		Details: object.ToListDetails(result.Count, result.Sequence, result.LastRun),
+		Result:  text_grpc.MessageTemplatesToPb(result.MessageTemplates),
+	}, nil
+}
+
+func (s *Server) SetCustomMessageTemplate(ctx context.Context, req *mgmt_pb.SetCustomMessageTemplateRequest) (*mgmt_pb.SetCustomMessageTemplateResponse, error) {
+	details, err := s.command.SetOrgMessageTemplate(ctx, authz.GetCtxData(ctx).OrgID, req.Name, language.Make(req.Language), req.Template)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.SetCustomMessageTemplateResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) ResetCustomMessageTemplateToDefault(ctx context.Context, req *mgmt_pb.ResetCustomMessageTemplateToDefaultRequest) (*mgmt_pb.ResetCustomMessageTemplateToDefaultResponse, error) {
+	details, err := s.command.RemoveOrgMessageTemplate(ctx, authz.GetCtxData(ctx).OrgID, req.Name, language.Make(req.Language))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ResetCustomMessageTemplateToDefaultResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
@@ -140,6 +140,22 @@ func (s *Server) RemoveOrg(ctx context.Context, req *mgmt_pb.RemoveOrgRequest) (
 	return &mgmt_pb.RemoveOrgResponse{Details: object.DomainToChangeDetailsPb(details)}, nil
 }
 
+func (s *Server) SetOrgParent(ctx context.Context, req *mgmt_pb.SetOrgParentRequest) (*mgmt_pb.SetOrgParentResponse, error) {
+	details, err := s.command.SetOrgParent(ctx, authz.GetCtxData(ctx).OrgID, req.ParentId)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.SetOrgParentResponse{Details: object.DomainToChangeDetailsPb(details)}, nil
+}
+
+func (s *Server) RemoveOrgParent(ctx context.Context, _ *mgmt_pb.RemoveOrgParentRequest) (*mgmt_pb.RemoveOrgParentResponse, error) {
+	details, err := s.command.RemoveOrgParent(ctx, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.RemoveOrgParentResponse{Details: object.DomainToChangeDetailsPb(details)}, nil
+}
+
 func (s *Server) GetDomainPolicy(ctx context.Context, req *mgmt_pb.GetDomainPolicyRequest) (*mgmt_pb.GetDomainPolicyResponse, error) {
 	policy, err := s.query.DomainPolicyByOrg(ctx, true, authz.GetCtxData(ctx).OrgID, false)
 	if err != nil {
@@ -251,12 +267,22 @@ func (s *Server) SetPrimaryOrgDomain(ctx context.Context, req *mgmt_pb.SetPrimar
 	}, nil
 }
 
+func (s *Server) SetOrgDomainIDP(ctx context.Context, req *mgmt_pb.SetOrgDomainIDPRequest) (*mgmt_pb.SetOrgDomainIDPResponse, error) {
+	details, err := s.command.SetOrgDomainIDP(ctx, SetOrgDomainIDPRequestToDomain(ctx, req), req.IdpId)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.SetOrgDomainIDPResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
 func (s *Server) ListOrgMemberRoles(ctx context.Context, _ *mgmt_pb.ListOrgMemberRolesRequest) (*mgmt_pb.ListOrgMemberRolesResponse, error) {
 	instance, err := s.query.Instance(ctx, false)
 	if err != nil {
 		return nil, err
 	}
-	roles := s.query.GetOrgMemberRoles(authz.GetCtxData(ctx).OrgID == instance.DefaultOrgID)
+	roles := s.query.GetOrgMemberRoles(ctx, authz.GetCtxData(ctx).OrgID == instance.DefaultOrgID)
 	return &mgmt_pb.ListOrgMemberRolesResponse{
 		Result: roles,
 	}, nil
@@ -305,6 +331,46 @@ func (s *Server) UpdateOrgMember(ctx context.Context, req *mgmt_pb.UpdateOrgMemb
 	}, nil
 }
 
+func (s *Server) SetOrgMemberValidity(ctx context.Context, req *mgmt_pb.SetOrgMemberValidityRequest) (*mgmt_pb.SetOrgMemberValidityResponse, error) {
+	objectDetails, err := s.command.SetOrgMemberValidity(ctx, authz.GetCtxData(ctx).OrgID, req.UserId, object.ValidityToDomain(req.ValidFrom, req.ValidUntil))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.SetOrgMemberValidityResponse{
+		Details: object.DomainToChangeDetailsPb(objectDetails),
+	}, nil
+}
+
+func (s *Server) GetOrgMemberScope(ctx context.Context, req *mgmt_pb.GetOrgMemberScopeRequest) (*mgmt_pb.GetOrgMemberScopeResponse, error) {
+	scope, err := s.query.OrgMemberScope(ctx, authz.GetCtxData(ctx).OrgID, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.GetOrgMemberScopeResponse{
+		Scope: member_grpc.ScopeToPb(scope),
+	}, nil
+}
+
+func (s *Server) SetOrgMemberScope(ctx context.Context, req *mgmt_pb.SetOrgMemberScopeRequest) (*mgmt_pb.SetOrgMemberScopeResponse, error) {
+	details, err := s.command.SetOrgMemberScope(ctx, authz.GetCtxData(ctx).OrgID, req.UserId, member_grpc.ScopeToDomain(req.Scope))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.SetOrgMemberScopeResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) RemoveOrgMemberScope(ctx context.Context, req *mgmt_pb.RemoveOrgMemberScopeRequest) (*mgmt_pb.RemoveOrgMemberScopeResponse, error) {
+	details, err := s.command.SetOrgMemberScope(ctx, authz.GetCtxData(ctx).OrgID, req.UserId, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.RemoveOrgMemberScopeResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
 func (s *Server) RemoveOrgMember(ctx context.Context, req *mgmt_pb.RemoveOrgMemberRequest) (*mgmt_pb.RemoveOrgMemberResponse, error) {
 	details, err := s.command.RemoveOrgMember(ctx, authz.GetCtxData(ctx).OrgID, req.UserId)
 	if err != nil {
@@ -0,0 +1,94 @@
+package management
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	policy_grpc "github.com/zitadel/zitadel/internal/api/grpc/policy"
+	"github.com/zitadel/zitadel/internal/query"
+	mgmt_pb "github.com/zitadel/zitadel/pkg/grpc/management"
+)
+
+func (s *Server) GetUserLifecyclePolicy(ctx context.Context, req *mgmt_pb.GetUserLifecyclePolicyRequest) (*mgmt_pb.GetUserLifecyclePolicyResponse, error) {
+	policy, err := s.query.UserLifecyclePolicyByOrg(ctx, true, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.GetUserLifecyclePolicyResponse{
+		Policy: policy_grpc.ModelUserLifecyclePolicyToPb(policy),
+	}, nil
+}
+
+func (s *Server) AddUserLifecyclePolicy(ctx context.Context, req *mgmt_pb.AddUserLifecyclePolicyRequest) (*mgmt_pb.AddUserLifecyclePolicyResponse, error) {
+	result, err := s.command.AddUserLifecyclePolicy(ctx, authz.GetCtxData(ctx).OrgID, AddUserLifecyclePolicyToDomain(req))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.AddUserLifecyclePolicyResponse{
+		Details: object.AddToDetailsPb(
+			result.Sequence,
+			result.ChangeDate,
+			result.ResourceOwner,
+		),
+	}, nil
+}
+
+func (s *Server) UpdateUserLifecyclePolicy(ctx context.Context, req *mgmt_pb.UpdateUserLifecyclePolicyRequest) (*mgmt_pb.UpdateUserLifecyclePolicyResponse, error) {
+	result, err := s.command.ChangeUserLifecyclePolicy(ctx, authz.GetCtxData(ctx).OrgID, UpdateUserLifecyclePolicyToDomain(req))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.UpdateUserLifecyclePolicyResponse{
+		Details: object.ChangeToDetailsPb(
+			result.Sequence,
+			result.ChangeDate,
+			result.ResourceOwner,
+		),
+	}, nil
+}
+
+func (s *Server) RemoveUserLifecyclePolicy(ctx context.Context, req *mgmt_pb.RemoveUserLifecyclePolicyRequest) (*mgmt_pb.RemoveUserLifecyclePolicyResponse, error) {
+	objectDetails, err := s.command.RemoveUserLifecyclePolicy(ctx, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.RemoveUserLifecyclePolicyResponse{
+		Details: object.DomainToChangeDetailsPb(objectDetails),
+	}, nil
+}
+
+func (s *Server) ListUserLifecycleCandidates(ctx context.Context, req *mgmt_pb.ListUserLifecycleCandidatesRequest) (*mgmt_pb.ListUserLifecycleCandidatesResponse, error) {
+	policy, err := s.userLifecyclePolicyForDryRun(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	candidates, err := s.query.UserLifecycleCandidates(ctx, true, policy, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ListUserLifecycleCandidatesResponse{
+		Result: policy_grpc.UserLifecycleCandidatesToPb(candidates),
+	}, nil
+}
+
+// userLifecyclePolicyForDryRun returns the policy passed in the request
+// or the stored policy of the organization if none was passed.
+func (s *Server) userLifecyclePolicyForDryRun(ctx context.Context, req *mgmt_pb.ListUserLifecycleCandidatesRequest) (*query.UserLifecyclePolicy, error) {
+	orgID := authz.GetCtxData(ctx).OrgID
+	if req.GetPolicy() == nil {
+		return s.query.UserLifecyclePolicyByOrg(ctx, true, orgID)
+	}
+	policy := AddUserLifecyclePolicyToDomain(req.GetPolicy())
+	if err := policy.IsValid(); err != nil {
+		return nil, err
+	}
+	return &query.UserLifecyclePolicy{
+		ID:                  orgID,
+		ResourceOwner:       orgID,
+		DeactivateAfterDays: policy.DeactivateAfterDays,
+		DeleteAfterDays:     policy.DeleteAfterDays,
+		WarnDaysBefore:      policy.WarnDaysBefore,
+	}, nil
+}
@@ -0,0 +1,22 @@
+package management
+
+import (
+	"github.com/zitadel/zitadel/internal/domain"
+	mgmt_pb "github.com/zitadel/zitadel/pkg/grpc/management"
+)
+
+func AddUserLifecyclePolicyToDomain(policy *mgmt_pb.AddUserLifecyclePolicyRequest) *domain.UserLifecyclePolicy {
+	return &domain.UserLifecyclePolicy{
+		DeactivateAfterDays: policy.DeactivateAfterDays,
+		DeleteAfterDays:     policy.DeleteAfterDays,
+		WarnDaysBefore:      policy.WarnDaysBefore,
+	}
+}
+
+func UpdateUserLifecyclePolicyToDomain(policy *mgmt_pb.UpdateUserLifecyclePolicyRequest) *domain.UserLifecyclePolicy {
+	return &domain.UserLifecyclePolicy{
+		DeactivateAfterDays: policy.DeactivateAfterDays,
+		DeleteAfterDays:     policy.DeleteAfterDays,
+		WarnDaysBefore:      policy.WarnDaysBefore,
+	}
+}
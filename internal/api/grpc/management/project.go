@@ -332,6 +332,36 @@ func (s *Server) RemoveProjectRole(ctx context.Context, req *mgmt_pb.RemoveProje
 	}, nil
 }
 
+func (s *Server) GetProjectRoleCondition(ctx context.Context, req *mgmt_pb.GetProjectRoleConditionRequest) (*mgmt_pb.GetProjectRoleConditionResponse, error) {
+	conditions, err := s.query.ProjectRoleConditions(ctx, authz.GetCtxData(ctx).OrgID, req.ProjectId)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.GetProjectRoleConditionResponse{
+		Condition: project_grpc.RoleConditionToPb(conditions.Get(req.ProjectId, req.RoleKey)),
+	}, nil
+}
+
+func (s *Server) SetProjectRoleCondition(ctx context.Context, req *mgmt_pb.SetProjectRoleConditionRequest) (*mgmt_pb.SetProjectRoleConditionResponse, error) {
+	details, err := s.command.SetProjectRoleCondition(ctx, req.ProjectId, req.RoleKey, project_grpc.RoleConditionToDomain(req.Condition), authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.SetProjectRoleConditionResponse{
+		Details: object_grpc.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) RemoveProjectRoleCondition(ctx context.Context, req *mgmt_pb.RemoveProjectRoleConditionRequest) (*mgmt_pb.RemoveProjectRoleConditionResponse, error) {
+	details, err := s.command.SetProjectRoleCondition(ctx, req.ProjectId, req.RoleKey, nil, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.RemoveProjectRoleConditionResponse{
+		Details: object_grpc.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
 func (s *Server) ListProjectMemberRoles(ctx context.Context, _ *mgmt_pb.ListProjectMemberRolesRequest) (*mgmt_pb.ListProjectMemberRolesResponse, error) {
 	roles, err := s.query.GetProjectMemberRoles(ctx)
 	if err != nil {
@@ -379,6 +409,16 @@ func (s *Server) UpdateProjectMember(ctx context.Context, req *mgmt_pb.UpdatePro
 	}, nil
 }
 
+func (s *Server) SetProjectMemberValidity(ctx context.Context, req *mgmt_pb.SetProjectMemberValidityRequest) (*mgmt_pb.SetProjectMemberValidityResponse, error) {
+	objectDetails, err := s.command.SetProjectMemberValidity(ctx, req.ProjectId, req.UserId, authz.GetCtxData(ctx).OrgID, object_grpc.ValidityToDomain(req.ValidFrom, req.ValidUntil))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.SetProjectMemberValidityResponse{
+		Details: object_grpc.DomainToChangeDetailsPb(objectDetails),
+	}, nil
+}
+
 func (s *Server) RemoveProjectMember(ctx context.Context, req *mgmt_pb.RemoveProjectMemberRequest) (*mgmt_pb.RemoveProjectMemberResponse, error) {
 	details, err := s.command.RemoveProjectMember(ctx, req.ProjectId, req.UserId, authz.GetCtxData(ctx).OrgID)
 	if err != nil {
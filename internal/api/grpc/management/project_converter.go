@@ -189,3 +189,24 @@ func ListProjectMembersRequestToModel(ctx context.Context, req *mgmt_pb.ListProj
 		ProjectID: req.ProjectId,
 	}, nil
 }
+
+func listProjectPermissionsRequestToModel(ctx context.Context, req *mgmt_pb.ListProjectPermissionsRequest) (*query.ProjectPermissionSearchQueries, error) {
+	offset, limit, asc := object.ListQueryToModel(req.Query)
+	queries, err := proj_grpc.PermissionQueriesToModel(req.Queries)
+	if err != nil {
+		return nil, err
+	}
+	ownerQuery, err := query.NewProjectPermissionResourceOwnerSearchQuery(authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &query.ProjectPermissionSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset:        offset,
+			Limit:         limit,
+			Asc:           asc,
+			SortingColumn: query.ProjectPermissionColumnKey,
+		},
+		Queries: append(queries, ownerQuery),
+	}, nil
+}
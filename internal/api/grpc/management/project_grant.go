@@ -152,7 +152,7 @@ func (s *Server) RemoveProjectGrant(ctx context.Context, req *mgmt_pb.RemoveProj
 }
 
 func (s *Server) ListProjectGrantMemberRoles(ctx context.Context, req *mgmt_pb.ListProjectGrantMemberRolesRequest) (*mgmt_pb.ListProjectGrantMemberRolesResponse, error) {
-	roles := s.query.GetProjectGrantMemberRoles()
+	roles := s.query.GetProjectGrantMemberRoles(ctx)
 	return &mgmt_pb.ListProjectGrantMemberRolesResponse{
 		Result:  roles,
 		Details: object_grpc.ToListDetails(uint64(len(roles)), 0, time.Now()),
@@ -202,6 +202,16 @@ func (s *Server) UpdateProjectGrantMember(ctx context.Context, req *mgmt_pb.Upda
 	}, nil
 }
 
+func (s *Server) SetProjectGrantMemberValidity(ctx context.Context, req *mgmt_pb.SetProjectGrantMemberValidityRequest) (*mgmt_pb.SetProjectGrantMemberValidityResponse, error) {
+	objectDetails, err := s.command.SetProjectGrantMemberValidity(ctx, req.ProjectId, req.GrantId, req.UserId, object_grpc.ValidityToDomain(req.ValidFrom, req.ValidUntil))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.SetProjectGrantMemberValidityResponse{
+		Details: object_grpc.DomainToChangeDetailsPb(objectDetails),
+	}, nil
+}
+
 func (s *Server) RemoveProjectGrantMember(ctx context.Context, req *mgmt_pb.RemoveProjectGrantMemberRequest) (*mgmt_pb.RemoveProjectGrantMemberResponse, error) {
 	details, err := s.command.RemoveProjectGrantMember(ctx, req.ProjectId, req.UserId, req.GrantId)
 	if err != nil {
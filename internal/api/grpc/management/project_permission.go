@@ -0,0 +1,107 @@
+package management
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	object_grpc "github.com/zitadel/zitadel/internal/api/grpc/object"
+	project_grpc "github.com/zitadel/zitadel/internal/api/grpc/project"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	mgmt_pb "github.com/zitadel/zitadel/pkg/grpc/management"
+)
+
+func (s *Server) ListProjectRoleInclusions(ctx context.Context, req *mgmt_pb.ListProjectRoleInclusionsRequest) (*mgmt_pb.ListProjectRoleInclusionsResponse, error) {
+	inclusions, err := s.query.ProjectRoleInclusions(ctx, authz.GetCtxData(ctx).OrgID, req.ProjectId)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ListProjectRoleInclusionsResponse{
+		Result: project_grpc.RoleInclusionsToPb(inclusions),
+	}, nil
+}
+
+func (s *Server) SetProjectRoleInclusions(ctx context.Context, req *mgmt_pb.SetProjectRoleInclusionsRequest) (*mgmt_pb.SetProjectRoleInclusionsResponse, error) {
+	details, err := s.command.SetProjectRoleInclusions(ctx, req.ProjectId, req.RoleKey, req.IncludedRoleKeys, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.SetProjectRoleInclusionsResponse{
+		Details: object_grpc.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) ListProjectPermissions(ctx context.Context, req *mgmt_pb.ListProjectPermissionsRequest) (*mgmt_pb.ListProjectPermissionsResponse, error) {
+	queries, err := listProjectPermissionsRequestToModel(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	permissions, err := s.query.SearchProjectPermissions(ctx, req.ProjectId, queries)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ListProjectPermissionsResponse{
+		Result:  project_grpc.PermissionsToPb(permissions.Permissions),
+		Details: object_grpc.ToListDetails(permissions.Count, permissions.Sequence, permissions.LastRun),
+	}, nil
+}
+
+func (s *Server) AddProjectPermission(ctx context.Context, req *mgmt_pb.AddProjectPermissionRequest) (*mgmt_pb.AddProjectPermissionResponse, error) {
+	details, err := s.command.AddProjectPermission(ctx, &domain.ProjectPermission{
+		ObjectRoot:  models.ObjectRoot{AggregateID: req.ProjectId},
+		Key:         req.Key,
+		DisplayName: req.DisplayName,
+		RoleKeys:    req.RoleKeys,
+	}, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.AddProjectPermissionResponse{
+		Details: object_grpc.DomainToAddDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) UpdateProjectPermission(ctx context.Context, req *mgmt_pb.UpdateProjectPermissionRequest) (*mgmt_pb.UpdateProjectPermissionResponse, error) {
+	details, err := s.command.ChangeProjectPermission(ctx, &domain.ProjectPermission{
+		ObjectRoot:  models.ObjectRoot{AggregateID: req.ProjectId},
+		Key:         req.Key,
+		DisplayName: req.DisplayName,
+		RoleKeys:    req.RoleKeys,
+	}, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.UpdateProjectPermissionResponse{
+		Details: object_grpc.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) RemoveProjectPermission(ctx context.Context, req *mgmt_pb.RemoveProjectPermissionRequest) (*mgmt_pb.RemoveProjectPermissionResponse, error) {
+	details, err := s.command.RemoveProjectPermission(ctx, req.ProjectId, req.Key, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.RemoveProjectPermissionResponse{
+		Details: object_grpc.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) CheckPermission(ctx context.Context, req *mgmt_pb.CheckPermissionRequest) (*mgmt_pb.CheckPermissionResponse, error) {
+	allowed, err := s.query.CheckPermission(ctx, authz.GetCtxData(ctx).OrgID, req.UserId, req.ProjectId, req.Permission, req.ProjectGrantId)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.CheckPermissionResponse{
+		Allowed: allowed,
+	}, nil
+}
+
+func (s *Server) ListPermittedResources(ctx context.Context, req *mgmt_pb.ListPermittedResourcesRequest) (*mgmt_pb.ListPermittedResourcesResponse, error) {
+	resources, err := s.query.PermittedResources(ctx, authz.GetCtxData(ctx).OrgID, req.UserId, req.ProjectId, req.Permission)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ListPermittedResourcesResponse{
+		Result: project_grpc.PermittedResourcesToPb(resources),
+	}, nil
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/zitadel/zitadel/internal/command"
 	"github.com/zitadel/zitadel/internal/config/systemdefaults"
 	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/notification/types"
 	"github.com/zitadel/zitadel/internal/query"
 	"github.com/zitadel/zitadel/pkg/grpc/management"
 )
@@ -21,6 +22,11 @@ const (
 
 var _ management.ManagementServiceServer = (*Server)(nil)
 
+// MessagePreviewer renders the messages with the texts and branding of an organization.
+type MessagePreviewer interface {
+	PreviewMessage(ctx context.Context, orgID, messageType, language, userID string) (*types.Message, error)
+}
+
 type Server struct {
 	management.UnimplementedManagementServiceServer
 	command        *command.Commands
@@ -29,6 +35,7 @@ type Server struct {
 	assetAPIPrefix func(context.Context) string
 	userCodeAlg    crypto.EncryptionAlgorithm
 	externalSecure bool
+	previewer      MessagePreviewer
 }
 
 func CreateServer(
@@ -37,6 +44,7 @@ func CreateServer(
 	sd systemdefaults.SystemDefaults,
 	userCodeAlg crypto.EncryptionAlgorithm,
 	externalSecure bool,
+	previewer MessagePreviewer,
 ) *Server {
 	return &Server{
 		command:        command,
@@ -45,6 +53,7 @@ func CreateServer(
 		assetAPIPrefix: assets.AssetAPI(externalSecure),
 		userCodeAlg:    userCodeAlg,
 		externalSecure: externalSecure,
+		previewer:      previewer,
 	}
 }
 
@@ -0,0 +1,116 @@
+package management
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	mgmt_pb "github.com/zitadel/zitadel/pkg/grpc/management"
+)
+
+func (s *Server) ListOrgSMSProviders(ctx context.Context, req *mgmt_pb.ListOrgSMSProvidersRequest) (*mgmt_pb.ListOrgSMSProvidersResponse, error) {
+	queries, err := listOrgSMSConfigsToModel(authz.GetCtxData(ctx).OrgID, req)
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.query.SearchSMSConfigs(ctx, queries)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ListOrgSMSProvidersResponse{
+		Details: object.ToListDetails(result.Count, result.Sequence, result.LastRun),
+		Result:  smsConfigsToPb(result.Configs),
+	}, nil
+}
+
+func (s *Server) GetOrgSMSProvider(ctx context.Context, req *mgmt_pb.GetOrgSMSProviderRequest) (*mgmt_pb.GetOrgSMSProviderResponse, error) {
+	result, err := s.query.SMSProviderConfigByID(ctx, authz.GetCtxData(ctx).OrgID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.GetOrgSMSProviderResponse{
+		Config: smsConfigToProviderPb(result),
+	}, nil
+}
+
+func (s *Server) AddOrgSMSProviderTwilio(ctx context.Context, req *mgmt_pb.AddOrgSMSProviderTwilioRequest) (*mgmt_pb.AddOrgSMSProviderTwilioResponse, error) {
+	id, result, err := s.command.AddOrgSMSConfigTwilio(ctx, authz.GetCtxData(ctx).OrgID, addOrgSMSConfigTwilioToConfig(req))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.AddOrgSMSProviderTwilioResponse{
+		Details: object.DomainToAddDetailsPb(result),
+		Id:      id,
+	}, nil
+}
+
+func (s *Server) UpdateOrgSMSProviderTwilio(ctx context.Context, req *mgmt_pb.UpdateOrgSMSProviderTwilioRequest) (*mgmt_pb.UpdateOrgSMSProviderTwilioResponse, error) {
+	result, err := s.command.ChangeOrgSMSConfigTwilio(ctx, authz.GetCtxData(ctx).OrgID, req.Id, updateOrgSMSConfigTwilioToConfig(req))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.UpdateOrgSMSProviderTwilioResponse{
+		Details: object.DomainToChangeDetailsPb(result),
+	}, nil
+}
+
+func (s *Server) UpdateOrgSMSProviderTwilioToken(ctx context.Context, req *mgmt_pb.UpdateOrgSMSProviderTwilioTokenRequest) (*mgmt_pb.UpdateOrgSMSProviderTwilioTokenResponse, error) {
+	result, err := s.command.ChangeOrgSMSConfigTwilioToken(ctx, authz.GetCtxData(ctx).OrgID, req.Id, req.Token)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.UpdateOrgSMSProviderTwilioTokenResponse{
+		Details: object.DomainToChangeDetailsPb(result),
+	}, nil
+}
+
+func (s *Server) AddOrgSMSProviderHTTP(ctx context.Context, req *mgmt_pb.AddOrgSMSProviderHTTPRequest) (*mgmt_pb.AddOrgSMSProviderHTTPResponse, error) {
+	id, result, err := s.command.AddOrgSMSConfigHTTP(ctx, authz.GetCtxData(ctx).OrgID, httpProviderToConfig(req.Endpoint, req.Headers, req.SigningKey))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.AddOrgSMSProviderHTTPResponse{
+		Details: object.DomainToAddDetailsPb(result),
+		Id:      id,
+	}, nil
+}
+
+func (s *Server) UpdateOrgSMSProviderHTTP(ctx context.Context, req *mgmt_pb.UpdateOrgSMSProviderHTTPRequest) (*mgmt_pb.UpdateOrgSMSProviderHTTPResponse, error) {
+	result, err := s.command.ChangeOrgSMSConfigHTTP(ctx, authz.GetCtxData(ctx).OrgID, req.Id, httpProviderToConfig(req.Endpoint, req.Headers, req.SigningKey))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.UpdateOrgSMSProviderHTTPResponse{
+		Details: object.DomainToChangeDetailsPb(result),
+	}, nil
+}
+
+func (s *Server) ActivateOrgSMSProvider(ctx context.Context, req *mgmt_pb.ActivateOrgSMSProviderRequest) (*mgmt_pb.ActivateOrgSMSProviderResponse, error) {
+	result, err := s.command.ActivateOrgSMSConfig(ctx, authz.GetCtxData(ctx).OrgID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ActivateOrgSMSProviderResponse{
+		Details: object.DomainToChangeDetailsPb(result),
+	}, nil
+}
+
+func (s *Server) DeactivateOrgSMSProvider(ctx context.Context, req *mgmt_pb.DeactivateOrgSMSProviderRequest) (*mgmt_pb.DeactivateOrgSMSProviderResponse, error) {
+	result, err := s.command.DeactivateOrgSMSConfig(ctx, authz.GetCtxData(ctx).OrgID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.DeactivateOrgSMSProviderResponse{
+		Details: object.DomainToChangeDetailsPb(result),
+	}, nil
+}
+
+func (s *Server) RemoveOrgSMSProvider(ctx context.Context, req *mgmt_pb.RemoveOrgSMSProviderRequest) (*mgmt_pb.RemoveOrgSMSProviderResponse, error) {
+	result, err := s.command.RemoveOrgSMSConfig(ctx, authz.GetCtxData(ctx).OrgID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.RemoveOrgSMSProviderResponse{
+		Details: object.DomainToChangeDetailsPb(result),
+	}, nil
+}
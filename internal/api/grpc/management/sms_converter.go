@@ -0,0 +1,86 @@
+package management
+
+import (
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/notification/channels/twilio"
+	"github.com/zitadel/zitadel/internal/query"
+	mgmt_pb "github.com/zitadel/zitadel/pkg/grpc/management"
+	settings_pb "github.com/zitadel/zitadel/pkg/grpc/settings"
+)
+
+func listOrgSMSConfigsToModel(orgID string, req *mgmt_pb.ListOrgSMSProvidersRequest) (*query.SMSConfigsSearchQueries, error) {
+	offset, limit, asc := object.ListQueryToModel(req.Query)
+	resourceOwnerQuery, err := query.NewSMSProviderResourceOwnerQuery(orgID)
+	if err != nil {
+		return nil, err
+	}
+	return &query.SMSConfigsSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset: offset,
+			Limit:  limit,
+			Asc:    asc,
+		},
+		Queries: []query.SearchQuery{resourceOwnerQuery},
+	}, nil
+}
+
+func smsConfigsToPb(configs []*query.SMSConfig) []*settings_pb.SMSProvider {
+	c := make([]*settings_pb.SMSProvider, len(configs))
+	for i, config := range configs {
+		c[i] = smsConfigToProviderPb(config)
+	}
+	return c
+}
+
+func smsConfigToProviderPb(config *query.SMSConfig) *settings_pb.SMSProvider {
+	return &settings_pb.SMSProvider{
+		Details: object.ToViewDetailsPb(config.Sequence, config.CreationDate, config.ChangeDate, config.ResourceOwner),
+		Id:      config.ID,
+		State:   smsStateToPb(config.State),
+		Config:  smsConfigToPb(config),
+	}
+}
+
+func smsConfigToPb(config *query.SMSConfig) settings_pb.SMSConfig {
+	if config.TwilioConfig != nil {
+		return &settings_pb.SMSProvider_Twilio{
+			Twilio: &settings_pb.TwilioConfig{
+				Sid:          config.TwilioConfig.SID,
+				SenderNumber: config.TwilioConfig.SenderNumber,
+			},
+		}
+	}
+	if config.HTTPConfig != nil {
+		return &settings_pb.SMSProvider_Http{
+			Http: httpProviderConfigToPb(config.HTTPConfig),
+		}
+	}
+	return nil
+}
+
+func smsStateToPb(state domain.SMSConfigState) settings_pb.SMSProviderConfigState {
+	switch state {
+	case domain.SMSConfigStateInactive:
+		return settings_pb.SMSProviderConfigState_SMS_PROVIDER_CONFIG_INACTIVE
+	case domain.SMSConfigStateActive:
+		return settings_pb.SMSProviderConfigState_SMS_PROVIDER_CONFIG_ACTIVE
+	default:
+		return settings_pb.SMSProviderConfigState_SMS_PROVIDER_CONFIG_INACTIVE
+	}
+}
+
+func addOrgSMSConfigTwilioToConfig(req *mgmt_pb.AddOrgSMSProviderTwilioRequest) *twilio.Config {
+	return &twilio.Config{
+		SID:          req.Sid,
+		SenderNumber: req.SenderNumber,
+		Token:        req.Token,
+	}
+}
+
+func updateOrgSMSConfigTwilioToConfig(req *mgmt_pb.UpdateOrgSMSProviderTwilioRequest) *twilio.Config {
+	return &twilio.Config{
+		SID:          req.Sid,
+		SenderNumber: req.SenderNumber,
+	}
+}
@@ -0,0 +1,154 @@
+package management
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	mgmt_pb "github.com/zitadel/zitadel/pkg/grpc/management"
+)
+
+func (s *Server) ListOrgSMTPConfigs(ctx context.Context, req *mgmt_pb.ListOrgSMTPConfigsRequest) (*mgmt_pb.ListOrgSMTPConfigsResponse, error) {
+	queries, err := listOrgSMTPConfigsToModel(authz.GetCtxData(ctx).OrgID, req)
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.query.SearchSMTPConfigs(ctx, queries)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ListOrgSMTPConfigsResponse{
+		Details: object.ToListDetails(result.Count, result.Sequence, result.LastRun),
+		Result:  smtpConfigsToPb(result.Configs),
+	}, nil
+}
+
+func (s *Server) GetOrgSMTPConfigById(ctx context.Context, req *mgmt_pb.GetOrgSMTPConfigByIdRequest) (*mgmt_pb.GetOrgSMTPConfigByIdResponse, error) {
+	smtp, err := s.query.SMTPConfigByID(ctx, authz.GetInstance(ctx).InstanceID(), authz.GetCtxData(ctx).OrgID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.GetOrgSMTPConfigByIdResponse{
+		SmtpConfig: smtpConfigToPb(smtp),
+	}, nil
+}
+
+func (s *Server) AddOrgSMTPConfig(ctx context.Context, req *mgmt_pb.AddOrgSMTPConfigRequest) (*mgmt_pb.AddOrgSMTPConfigResponse, error) {
+	id, details, err := s.command.AddOrgSMTPConfig(ctx, authz.GetCtxData(ctx).OrgID, addOrgSMTPToConfig(req))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.AddOrgSMTPConfigResponse{
+		Details: object.DomainToAddDetailsPb(details),
+		Id:      id,
+	}, nil
+}
+
+func (s *Server) UpdateOrgSMTPConfig(ctx context.Context, req *mgmt_pb.UpdateOrgSMTPConfigRequest) (*mgmt_pb.UpdateOrgSMTPConfigResponse, error) {
+	details, err := s.command.ChangeOrgSMTPConfig(ctx, authz.GetCtxData(ctx).OrgID, req.Id, updateOrgSMTPToConfig(req))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.UpdateOrgSMTPConfigResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) UpdateOrgSMTPConfigPassword(ctx context.Context, req *mgmt_pb.UpdateOrgSMTPConfigPasswordRequest) (*mgmt_pb.UpdateOrgSMTPConfigPasswordResponse, error) {
+	details, err := s.command.ChangeOrgSMTPConfigPassword(ctx, authz.GetCtxData(ctx).OrgID, req.Id, req.Password)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.UpdateOrgSMTPConfigPasswordResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) SetOrgSMTPConfigDKIM(ctx context.Context, req *mgmt_pb.SetOrgSMTPConfigDKIMRequest) (*mgmt_pb.SetOrgSMTPConfigDKIMResponse, error) {
+	details, err := s.command.SetOrgSMTPConfigDKIM(ctx, authz.GetCtxData(ctx).OrgID, req.Id, setOrgSMTPConfigDKIMToConfig(req))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.SetOrgSMTPConfigDKIMResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) RemoveOrgSMTPConfigDKIM(ctx context.Context, req *mgmt_pb.RemoveOrgSMTPConfigDKIMRequest) (*mgmt_pb.RemoveOrgSMTPConfigDKIMResponse, error) {
+	details, err := s.command.RemoveOrgSMTPConfigDKIM(ctx, authz.GetCtxData(ctx).OrgID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.RemoveOrgSMTPConfigDKIMResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) SetOrgSMTPConfigHeaders(ctx context.Context, req *mgmt_pb.SetOrgSMTPConfigHeadersRequest) (*mgmt_pb.SetOrgSMTPConfigHeadersResponse, error) {
+	details, err := s.command.SetOrgSMTPConfigHeaders(ctx, authz.GetCtxData(ctx).OrgID, req.Id, req.Headers)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.SetOrgSMTPConfigHeadersResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) AddOrgSMTPConfigHTTP(ctx context.Context, req *mgmt_pb.AddOrgSMTPConfigHTTPRequest) (*mgmt_pb.AddOrgSMTPConfigHTTPResponse, error) {
+	id, details, err := s.command.AddOrgSMTPConfigHTTP(ctx, authz.GetCtxData(ctx).OrgID, req.Description, httpProviderToConfig(req.Endpoint, req.Headers, req.SigningKey))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.AddOrgSMTPConfigHTTPResponse{
+		Details: object.DomainToAddDetailsPb(details),
+		Id:      id,
+	}, nil
+}
+
+func (s *Server) UpdateOrgSMTPConfigHTTP(ctx context.Context, req *mgmt_pb.UpdateOrgSMTPConfigHTTPRequest) (*mgmt_pb.UpdateOrgSMTPConfigHTTPResponse, error) {
+	details, err := s.command.ChangeOrgSMTPConfigHTTP(ctx, authz.GetCtxData(ctx).OrgID, req.Id, req.Description, httpProviderToConfig(req.Endpoint, req.Headers, req.SigningKey))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.UpdateOrgSMTPConfigHTTPResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) ActivateOrgSMTPConfig(ctx context.Context, req *mgmt_pb.ActivateOrgSMTPConfigRequest) (*mgmt_pb.ActivateOrgSMTPConfigResponse, error) {
+	orgID := authz.GetCtxData(ctx).OrgID
+	// Get the ID of the currently active SMTP provider of the organization if any
+	currentActiveProviderID := ""
+	smtp, err := s.query.SMTPConfigActive(ctx, orgID)
+	if err == nil {
+		currentActiveProviderID = smtp.ID
+	}
+
+	details, err := s.command.ActivateOrgSMTPConfig(ctx, orgID, req.Id, currentActiveProviderID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ActivateOrgSMTPConfigResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) DeactivateOrgSMTPConfig(ctx context.Context, req *mgmt_pb.DeactivateOrgSMTPConfigRequest) (*mgmt_pb.DeactivateOrgSMTPConfigResponse, error) {
+	details, err := s.command.DeactivateOrgSMTPConfig(ctx, authz.GetCtxData(ctx).OrgID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.DeactivateOrgSMTPConfigResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) RemoveOrgSMTPConfig(ctx context.Context, req *mgmt_pb.RemoveOrgSMTPConfigRequest) (*mgmt_pb.RemoveOrgSMTPConfigResponse, error) {
+	details, err := s.command.RemoveOrgSMTPConfig(ctx, authz.GetCtxData(ctx).OrgID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.RemoveOrgSMTPConfigResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
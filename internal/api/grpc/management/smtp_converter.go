@@ -0,0 +1,126 @@
+package management
+
+import (
+	"net/http"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/notification/channels/smtp"
+	"github.com/zitadel/zitadel/internal/notification/channels/webhook"
+	"github.com/zitadel/zitadel/internal/query"
+	mgmt_pb "github.com/zitadel/zitadel/pkg/grpc/management"
+	settings_pb "github.com/zitadel/zitadel/pkg/grpc/settings"
+)
+
+func listOrgSMTPConfigsToModel(orgID string, req *mgmt_pb.ListOrgSMTPConfigsRequest) (*query.SMTPConfigsSearchQueries, error) {
+	offset, limit, asc := object.ListQueryToModel(req.Query)
+	resourceOwnerQuery, err := query.NewSMTPConfigResourceOwnerSearchQuery(orgID)
+	if err != nil {
+		return nil, err
+	}
+	return &query.SMTPConfigsSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset: offset,
+			Limit:  limit,
+			Asc:    asc,
+		},
+		Queries: []query.SearchQuery{resourceOwnerQuery},
+	}, nil
+}
+
+func addOrgSMTPToConfig(req *mgmt_pb.AddOrgSMTPConfigRequest) *smtp.Config {
+	return &smtp.Config{
+		Description:    req.Description,
+		Tls:            req.Tls,
+		From:           req.SenderAddress,
+		FromName:       req.SenderName,
+		ReplyToAddress: req.ReplyToAddress,
+		SMTP: smtp.SMTP{
+			Host:     req.Host,
+			User:     req.User,
+			Password: req.Password,
+		},
+	}
+}
+
+func updateOrgSMTPToConfig(req *mgmt_pb.UpdateOrgSMTPConfigRequest) *smtp.Config {
+	return &smtp.Config{
+		Description:    req.Description,
+		Tls:            req.Tls,
+		From:           req.SenderAddress,
+		FromName:       req.SenderName,
+		ReplyToAddress: req.ReplyToAddress,
+		SMTP: smtp.SMTP{
+			Host:     req.Host,
+			User:     req.User,
+			Password: req.Password,
+		},
+	}
+}
+
+func smtpConfigToPb(config *query.SMTPConfig) *settings_pb.SMTPConfig {
+	return &settings_pb.SMTPConfig{
+		Details:        object.ToViewDetailsPb(config.Sequence, config.CreationDate, config.ChangeDate, config.ResourceOwner),
+		Id:             config.ID,
+		Description:    config.Description,
+		Tls:            config.TLS,
+		Host:           config.Host,
+		User:           config.User,
+		State:          settings_pb.SMTPConfigState(config.State),
+		SenderAddress:  config.SenderAddress,
+		SenderName:     config.SenderName,
+		ReplyToAddress: config.ReplyToAddress,
+		Http:           httpProviderConfigToPb(config.HTTPConfig),
+		Dkim:           smtpDKIMConfigToPb(config.DKIM),
+		Headers:        config.Headers,
+	}
+}
+
+func smtpDKIMConfigToPb(dkim *query.SMTPConfigDKIM) *settings_pb.SMTPDKIMConfig {
+	if dkim == nil {
+		return nil
+	}
+	return &settings_pb.SMTPDKIMConfig{
+		Domain:   dkim.Domain,
+		Selector: dkim.Selector,
+	}
+}
+
+func setOrgSMTPConfigDKIMToConfig(req *mgmt_pb.SetOrgSMTPConfigDKIMRequest) *smtp.DKIM {
+	return &smtp.DKIM{
+		Domain:     req.Domain,
+		Selector:   req.Selector,
+		PrivateKey: string(req.PrivateKey),
+	}
+}
+
+func smtpConfigsToPb(configs []*query.SMTPConfig) []*settings_pb.SMTPConfig {
+	c := make([]*settings_pb.SMTPConfig, len(configs))
+	for i, config := range configs {
+		c[i] = smtpConfigToPb(config)
+	}
+	return c
+}
+
+func httpProviderConfigToPb(http *query.HTTP) *settings_pb.HTTPProviderConfig {
+	if http == nil {
+		return nil
+	}
+	return &settings_pb.HTTPProviderConfig{
+		Endpoint: http.Endpoint,
+	}
+}
+
+func httpProviderToConfig(endpoint string, headers map[string]string, signingKey string) *webhook.Config {
+	config := &webhook.Config{
+		CallURL:    endpoint,
+		Method:     http.MethodPost,
+		SigningKey: signingKey,
+	}
+	if len(headers) > 0 {
+		config.Headers = make(http.Header, len(headers))
+		for key, value := range headers {
+			config.Headers.Set(key, value)
+		}
+	}
+	return config
+}
@@ -35,6 +35,9 @@ func (s *Server) getUserByID(ctx context.Context, id string) (*query.User, error
 	if user.ResourceOwner != authz.GetCtxData(ctx).OrgID {
 		return nil, zerrors.ThrowNotFound(nil, "MANAG-fpo4B", "Errors.User.NotFound")
 	}
+	if err = s.query.CheckUserInMemberScope(ctx, user.ID); err != nil {
+		return nil, err
+	}
 	return user, nil
 }
 
@@ -68,6 +71,9 @@ func (s *Server) ListUsers(ctx context.Context, req *mgmt_pb.ListUsersRequest) (
 	if err != nil {
 		return nil, err
 	}
+	if err = s.query.AppendUserMemberScopeQuery(ctx, queries); err != nil {
+		return nil, err
+	}
 	res, err := s.query.SearchUsers(ctx, queries)
 	if err != nil {
 		return nil, err
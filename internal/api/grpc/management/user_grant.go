@@ -33,7 +33,12 @@ func (s *Server) ListUserGrants(ctx context.Context, req *mgmt_pb.ListUserGrantR
 	if err != nil {
 		return nil, err
 	}
-	res, err := s.query.UserGrants(ctx, queries, false)
+	var res *query.UserGrants
+	if userID := userGrantsRequestUserID(req); req.GetIncludeGroupGrants() && userID != "" {
+		res, err = s.query.UserGrantsWithGroupGrants(ctx, userID, queries, false)
+	} else {
+		res, err = s.query.UserGrants(ctx, queries, false)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -76,6 +81,16 @@ func (s *Server) UpdateUserGrant(ctx context.Context, req *mgmt_pb.UpdateUserGra
 	}, nil
 }
 
+func (s *Server) SetUserGrantValidity(ctx context.Context, req *mgmt_pb.SetUserGrantValidityRequest) (*mgmt_pb.SetUserGrantValidityResponse, error) {
+	objectDetails, err := s.command.SetUserGrantValidity(ctx, req.GrantId, authz.GetCtxData(ctx).OrgID, obj_grpc.ValidityToDomain(req.ValidFrom, req.ValidUntil))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.SetUserGrantValidityResponse{
+		Details: obj_grpc.DomainToChangeDetailsPb(objectDetails),
+	}, nil
+}
+
 func (s *Server) DeactivateUserGrant(ctx context.Context, req *mgmt_pb.DeactivateUserGrantRequest) (*mgmt_pb.DeactivateUserGrantResponse, error) {
 	objectDetails, err := s.command.DeactivateUserGrant(ctx, req.GrantId, authz.GetCtxData(ctx).OrgID)
 	if err != nil {
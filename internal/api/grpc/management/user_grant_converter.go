@@ -49,12 +49,23 @@ func shouldAppendUserGrantOwnerQuery(queries []*user.UserGrantQuery) bool {
 	return true
 }
 
+// userGrantsRequestUserID returns the id of the user the grants are searched for
+func userGrantsRequestUserID(req *mgmt_pb.ListUserGrantRequest) string {
+	for _, query := range req.Queries {
+		if q, ok := query.Query.(*user.UserGrantQuery_UserIdQuery); ok {
+			return q.UserIdQuery.GetUserId()
+		}
+	}
+	return ""
+}
+
 func AddUserGrantRequestToDomain(req *mgmt_pb.AddUserGrantRequest) *domain.UserGrant {
 	return &domain.UserGrant{
 		UserID:         req.UserId,
 		ProjectID:      req.ProjectId,
 		ProjectGrantID: req.ProjectGrantId,
 		RoleKeys:       req.RoleKeys,
+		Validity:       object.ValidityToDomain(req.ValidFrom, req.ValidUntil),
 	}
 }
 
@@ -0,0 +1,24 @@
+package management
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	obj_grpc "github.com/zitadel/zitadel/internal/api/grpc/object"
+	mgmt_pb "github.com/zitadel/zitadel/pkg/grpc/management"
+)
+
+func (s *Server) ListUserNotificationDeliveries(ctx context.Context, req *mgmt_pb.ListUserNotificationDeliveriesRequest) (*mgmt_pb.ListUserNotificationDeliveriesResponse, error) {
+	queries, err := listUserNotificationDeliveriesToModel(req, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	res, err := s.query.SearchNotificationDeliveries(ctx, queries)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ListUserNotificationDeliveriesResponse{
+		Details: obj_grpc.ToListDetails(res.Count, res.Sequence, res.LastRun),
+		Result:  notificationDeliveriesToPb(res.Deliveries),
+	}, nil
+}
@@ -0,0 +1,84 @@
+package management
+
+import (
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	mgmt_pb "github.com/zitadel/zitadel/pkg/grpc/management"
+	settings_pb "github.com/zitadel/zitadel/pkg/grpc/settings"
+)
+
+func listUserNotificationDeliveriesToModel(req *mgmt_pb.ListUserNotificationDeliveriesRequest, orgID string) (*query.NotificationDeliverySearchQueries, error) {
+	offset, limit, asc := object.ListQueryToModel(req.Query)
+	userQuery, err := query.NewNotificationDeliveryUserIDSearchQuery(req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	ownerQuery, err := query.NewNotificationDeliveryResourceOwnerSearchQuery(orgID)
+	if err != nil {
+		return nil, err
+	}
+	return &query.NotificationDeliverySearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset:        offset,
+			Limit:         limit,
+			Asc:           asc,
+			SortingColumn: query.NotificationDeliveryColumnCreationDate,
+		},
+		Queries: []query.SearchQuery{userQuery, ownerQuery},
+	}, nil
+}
+
+func notificationDeliveriesToPb(deliveries []*query.NotificationDelivery) []*settings_pb.NotificationDelivery {
+	d := make([]*settings_pb.NotificationDelivery, len(deliveries))
+	for i, delivery := range deliveries {
+		d[i] = notificationDeliveryToPb(delivery)
+	}
+	return d
+}
+
+func notificationDeliveryToPb(delivery *query.NotificationDelivery) *settings_pb.NotificationDelivery {
+	d := &settings_pb.NotificationDelivery{
+		CreationDate:        timestamppb.New(delivery.CreationDate),
+		Channel:             notificationChannelToPb(delivery.Channel),
+		MessageType:         delivery.MessageType,
+		ProviderId:          delivery.ProviderID,
+		ProviderMessageId:   delivery.ProviderMessageID,
+		Result:              notificationDeliveryResultToPb(delivery.Result),
+		Error:               delivery.Error,
+		TriggeringEventType: string(delivery.TriggeringEventType),
+	}
+	if delivery.CodeExpiry > 0 {
+		d.CodeExpiry = durationpb.New(delivery.CodeExpiry)
+	}
+	return d
+}
+
+func notificationChannelToPb(channel domain.NotificationType) settings_pb.NotificationChannel {
+	switch channel {
+	case domain.NotificationTypeSms:
+		return settings_pb.NotificationChannel_NOTIFICATION_CHANNEL_SMS
+	case domain.NotificationTypePush:
+		return settings_pb.NotificationChannel_NOTIFICATION_CHANNEL_PUSH
+	case domain.NotificationTypeEmail:
+		return settings_pb.NotificationChannel_NOTIFICATION_CHANNEL_EMAIL
+	default:
+		return settings_pb.NotificationChannel_NOTIFICATION_CHANNEL_EMAIL
+	}
+}
+
+func notificationDeliveryResultToPb(result domain.NotificationDeliveryResult) settings_pb.NotificationDeliveryResult {
+	switch result {
+	case domain.NotificationDeliveryResultSent:
+		return settings_pb.NotificationDeliveryResult_NOTIFICATION_DELIVERY_RESULT_SENT
+	case domain.NotificationDeliveryResultFailed:
+		return settings_pb.NotificationDeliveryResult_NOTIFICATION_DELIVERY_RESULT_FAILED
+	case domain.NotificationDeliveryResultUnspecified:
+		return settings_pb.NotificationDeliveryResult_NOTIFICATION_DELIVERY_RESULT_UNSPECIFIED
+	default:
+		return settings_pb.NotificationDeliveryResult_NOTIFICATION_DELIVERY_RESULT_UNSPECIFIED
+	}
+}
@@ -0,0 +1,112 @@
+package member
+
+import (
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+	access_request_pb "github.com/zitadel/zitadel/pkg/grpc/access_request"
+)
+
+func AccessRequestsToPb(requests []*query.AccessRequest) []*access_request_pb.AccessRequest {
+	result := make([]*access_request_pb.AccessRequest, len(requests))
+	for i, request := range requests {
+		result[i] = AccessRequestToPb(request)
+	}
+	return result
+}
+
+func AccessRequestToPb(request *query.AccessRequest) *access_request_pb.AccessRequest {
+	return &access_request_pb.AccessRequest{
+		Id:         request.ID,
+		Details:    object.ToViewDetailsPb(request.Sequence, request.CreationDate, request.ChangeDate, request.ResourceOwner),
+		State:      accessRequestStateToPb(request.State),
+		UserId:     request.UserID,
+		Scope:      AccessRequestScopeToPb(request.Scope),
+		Roles:      request.Roles,
+		Duration:   durationpb.New(request.Duration),
+		Reason:     request.Reason,
+		DecidedBy:  request.DecidedBy,
+		ValidUntil: object.TimeToPb(request.ValidUntil),
+	}
+}
+
+func AccessRequestQueriesToQuery(queries []*access_request_pb.AccessRequestQuery) (_ []query.SearchQuery, err error) {
+	q := make([]query.SearchQuery, len(queries))
+	for i, query := range queries {
+		q[i], err = accessRequestQueryToQuery(query)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return q, nil
+}
+
+func accessRequestQueryToQuery(req *access_request_pb.AccessRequestQuery) (query.SearchQuery, error) {
+	switch q := req.Query.(type) {
+	case *access_request_pb.AccessRequestQuery_StateQuery:
+		return query.NewAccessRequestStateSearchQuery(accessRequestStateToDomain(q.StateQuery.State))
+	case *access_request_pb.AccessRequestQuery_UserIdQuery:
+		return query.NewAccessRequestUserIDSearchQuery(q.UserIdQuery.UserId)
+	default:
+		return nil, zerrors.ThrowInvalidArgument(nil, "MEMB-Ar2qu", "List.Query.Invalid")
+	}
+}
+
+func AccessRequestScopeToDomain(scope access_request_pb.AccessRequestScope) domain.AccessRequestScope {
+	switch scope {
+	case access_request_pb.AccessRequestScope_ACCESS_REQUEST_SCOPE_INSTANCE:
+		return domain.AccessRequestScopeInstance
+	case access_request_pb.AccessRequestScope_ACCESS_REQUEST_SCOPE_ORG:
+		return domain.AccessRequestScopeOrg
+	case access_request_pb.AccessRequestScope_ACCESS_REQUEST_SCOPE_UNSPECIFIED:
+		return domain.AccessRequestScopeUnspecified
+	default:
+		return domain.AccessRequestScopeUnspecified
+	}
+}
+
+func AccessRequestScopeToPb(scope domain.AccessRequestScope) access_request_pb.AccessRequestScope {
+	switch scope {
+	case domain.AccessRequestScopeInstance:
+		return access_request_pb.AccessRequestScope_ACCESS_REQUEST_SCOPE_INSTANCE
+	case domain.AccessRequestScopeOrg:
+		return access_request_pb.AccessRequestScope_ACCESS_REQUEST_SCOPE_ORG
+	case domain.AccessRequestScopeUnspecified:
+		return access_request_pb.AccessRequestScope_ACCESS_REQUEST_SCOPE_UNSPECIFIED
+	default:
+		return access_request_pb.AccessRequestScope_ACCESS_REQUEST_SCOPE_UNSPECIFIED
+	}
+}
+
+func accessRequestStateToDomain(state access_request_pb.AccessRequestState) domain.AccessRequestState {
+	switch state {
+	case access_request_pb.AccessRequestState_ACCESS_REQUEST_STATE_PENDING:
+		return domain.AccessRequestStatePending
+	case access_request_pb.AccessRequestState_ACCESS_REQUEST_STATE_APPROVED:
+		return domain.AccessRequestStateApproved
+	case access_request_pb.AccessRequestState_ACCESS_REQUEST_STATE_DENIED:
+		return domain.AccessRequestStateDenied
+	case access_request_pb.AccessRequestState_ACCESS_REQUEST_STATE_UNSPECIFIED:
+		return domain.AccessRequestStateUnspecified
+	default:
+		return domain.AccessRequestStateUnspecified
+	}
+}
+
+func accessRequestStateToPb(state domain.AccessRequestState) access_request_pb.AccessRequestState {
+	switch state {
+	case domain.AccessRequestStatePending:
+		return access_request_pb.AccessRequestState_ACCESS_REQUEST_STATE_PENDING
+	case domain.AccessRequestStateApproved:
+		return access_request_pb.AccessRequestState_ACCESS_REQUEST_STATE_APPROVED
+	case domain.AccessRequestStateDenied:
+		return access_request_pb.AccessRequestState_ACCESS_REQUEST_STATE_DENIED
+	case domain.AccessRequestStateUnspecified:
+		return access_request_pb.AccessRequestState_ACCESS_REQUEST_STATE_UNSPECIFIED
+	default:
+		return access_request_pb.AccessRequestState_ACCESS_REQUEST_STATE_UNSPECIFIED
+	}
+}
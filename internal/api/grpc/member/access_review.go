@@ -0,0 +1,269 @@
+package member
+
+import (
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+	access_review_pb "github.com/zitadel/zitadel/pkg/grpc/access_review"
+)
+
+func AccessReviewsToPb(reviews []*query.AccessReview) []*access_review_pb.AccessReview {
+	result := make([]*access_review_pb.AccessReview, len(reviews))
+	for i, review := range reviews {
+		result[i] = AccessReviewToPb(review)
+	}
+	return result
+}
+
+func AccessReviewToPb(review *query.AccessReview) *access_review_pb.AccessReview {
+	return &access_review_pb.AccessReview{
+		Id:                 review.ID,
+		Details:            object.ToViewDetailsPb(review.Sequence, review.CreationDate, review.ChangeDate, review.ResourceOwner),
+		State:              accessReviewStateToPb(review.State),
+		Name:               review.Name,
+		Scope:              accessReviewScopeToPb(review.Scope),
+		ProjectId:          review.ProjectID,
+		RoleKey:            review.RoleKey,
+		ReviewerType:       accessReviewerTypeToPb(review.ReviewerType),
+		ManagerMetadataKey: review.ManagerMetadataKey,
+		Deadline:           object.TimeToPb(review.Deadline),
+		DeadlineAction:     accessReviewDeadlineActionToPb(review.DeadlineAction),
+	}
+}
+
+func AccessReviewItemsToPb(items []*query.AccessReviewItem) []*access_review_pb.AccessReviewItem {
+	result := make([]*access_review_pb.AccessReviewItem, len(items))
+	for i, item := range items {
+		result[i] = AccessReviewItemToPb(item)
+	}
+	return result
+}
+
+func AccessReviewItemToPb(item *query.AccessReviewItem) *access_review_pb.AccessReviewItem {
+	return &access_review_pb.AccessReviewItem{
+		Id:         item.ID,
+		ReviewId:   item.ReviewID,
+		Details:    object.ToViewDetailsPb(item.Sequence, item.CreationDate, item.ChangeDate, item.ResourceOwner),
+		Type:       accessReviewItemTypeToPb(item.Type),
+		ObjectId:   item.ObjectID,
+		UserId:     item.UserID,
+		ProjectId:  item.ProjectID,
+		Roles:      item.Roles,
+		Reviewers:  item.Reviewers,
+		Decision:   accessReviewDecisionToPb(item.Decision),
+		DecidedBy:  item.DecidedBy,
+		Comment:    item.Comment,
+		ReviewName: item.ReviewName,
+		Deadline:   object.TimeToPb(item.Deadline),
+	}
+}
+
+func AccessReviewQueriesToQuery(queries []*access_review_pb.AccessReviewQuery) (_ []query.SearchQuery, err error) {
+	q := make([]query.SearchQuery, len(queries))
+	for i, query := range queries {
+		q[i], err = accessReviewQueryToQuery(query)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return q, nil
+}
+
+func accessReviewQueryToQuery(req *access_review_pb.AccessReviewQuery) (query.SearchQuery, error) {
+	switch q := req.Query.(type) {
+	case *access_review_pb.AccessReviewQuery_StateQuery:
+		return query.NewAccessReviewStateSearchQuery(accessReviewStateToDomain(q.StateQuery.State))
+	case *access_review_pb.AccessReviewQuery_ProjectIdQuery:
+		return query.NewAccessReviewProjectIDSearchQuery(q.ProjectIdQuery.ProjectId)
+	default:
+		return nil, zerrors.ThrowInvalidArgument(nil, "MEMB-Rv1qu", "List.Query.Invalid")
+	}
+}
+
+func AccessReviewItemQueriesToQuery(queries []*access_review_pb.AccessReviewItemQuery) (_ []query.SearchQuery, err error) {
+	q := make([]query.SearchQuery, len(queries))
+	for i, query := range queries {
+		q[i], err = accessReviewItemQueryToQuery(query)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return q, nil
+}
+
+func accessReviewItemQueryToQuery(req *access_review_pb.AccessReviewItemQuery) (query.SearchQuery, error) {
+	switch q := req.Query.(type) {
+	case *access_review_pb.AccessReviewItemQuery_DecisionQuery:
+		return query.NewAccessReviewItemDecisionSearchQuery(AccessReviewDecisionToDomain(q.DecisionQuery.Decision))
+	case *access_review_pb.AccessReviewItemQuery_UserIdQuery:
+		return query.NewAccessReviewItemUserIDSearchQuery(q.UserIdQuery.UserId)
+	default:
+		return nil, zerrors.ThrowInvalidArgument(nil, "MEMB-Rv2qu", "List.Query.Invalid")
+	}
+}
+
+func AccessReviewScopeToDomain(v access_review_pb.AccessReviewScope) domain.AccessReviewScope {
+	switch v {
+	case access_review_pb.AccessReviewScope_ACCESS_REVIEW_SCOPE_ORG:
+		return domain.AccessReviewScopeOrg
+	case access_review_pb.AccessReviewScope_ACCESS_REVIEW_SCOPE_PROJECT:
+		return domain.AccessReviewScopeProject
+	case access_review_pb.AccessReviewScope_ACCESS_REVIEW_SCOPE_ROLE:
+		return domain.AccessReviewScopeRole
+	case access_review_pb.AccessReviewScope_ACCESS_REVIEW_SCOPE_UNSPECIFIED:
+		return domain.AccessReviewScopeUnspecified
+	default:
+		return domain.AccessReviewScopeUnspecified
+	}
+}
+
+func accessReviewScopeToPb(v domain.AccessReviewScope) access_review_pb.AccessReviewScope {
+	switch v {
+	case domain.AccessReviewScopeOrg:
+		return access_review_pb.AccessReviewScope_ACCESS_REVIEW_SCOPE_ORG
+	case domain.AccessReviewScopeProject:
+		return access_review_pb.AccessReviewScope_ACCESS_REVIEW_SCOPE_PROJECT
+	case domain.AccessReviewScopeRole:
+		return access_review_pb.AccessReviewScope_ACCESS_REVIEW_SCOPE_ROLE
+	case domain.AccessReviewScopeUnspecified:
+		return access_review_pb.AccessReviewScope_ACCESS_REVIEW_SCOPE_UNSPECIFIED
+	default:
+		return access_review_pb.AccessReviewScope_ACCESS_REVIEW_SCOPE_UNSPECIFIED
+	}
+}
+
+func AccessReviewerTypeToDomain(v access_review_pb.AccessReviewerType) domain.AccessReviewerType {
+	switch v {
+	case access_review_pb.AccessReviewerType_ACCESS_REVIEWER_TYPE_PROJECT_OWNER:
+		return domain.AccessReviewerTypeProjectOwner
+	case access_review_pb.AccessReviewerType_ACCESS_REVIEWER_TYPE_MANAGER:
+		return domain.AccessReviewerTypeManager
+	case access_review_pb.AccessReviewerType_ACCESS_REVIEWER_TYPE_UNSPECIFIED:
+		return domain.AccessReviewerTypeUnspecified
+	default:
+		return domain.AccessReviewerTypeUnspecified
+	}
+}
+
+func accessReviewerTypeToPb(v domain.AccessReviewerType) access_review_pb.AccessReviewerType {
+	switch v {
+	case domain.AccessReviewerTypeProjectOwner:
+		return access_review_pb.AccessReviewerType_ACCESS_REVIEWER_TYPE_PROJECT_OWNER
+	case domain.AccessReviewerTypeManager:
+		return access_review_pb.AccessReviewerType_ACCESS_REVIEWER_TYPE_MANAGER
+	case domain.AccessReviewerTypeUnspecified:
+		return access_review_pb.AccessReviewerType_ACCESS_REVIEWER_TYPE_UNSPECIFIED
+	default:
+		return access_review_pb.AccessReviewerType_ACCESS_REVIEWER_TYPE_UNSPECIFIED
+	}
+}
+
+func AccessReviewDeadlineActionToDomain(v access_review_pb.AccessReviewDeadlineAction) domain.AccessReviewDeadlineAction {
+	switch v {
+	case access_review_pb.AccessReviewDeadlineAction_ACCESS_REVIEW_DEADLINE_ACTION_REVOKE:
+		return domain.AccessReviewDeadlineActionRevoke
+	case access_review_pb.AccessReviewDeadlineAction_ACCESS_REVIEW_DEADLINE_ACTION_FLAG:
+		return domain.AccessReviewDeadlineActionFlag
+	case access_review_pb.AccessReviewDeadlineAction_ACCESS_REVIEW_DEADLINE_ACTION_UNSPECIFIED:
+		return domain.AccessReviewDeadlineActionUnspecified
+	default:
+		return domain.AccessReviewDeadlineActionUnspecified
+	}
+}
+
+func accessReviewDeadlineActionToPb(v domain.AccessReviewDeadlineAction) access_review_pb.AccessReviewDeadlineAction {
+	switch v {
+	case domain.AccessReviewDeadlineActionRevoke:
+		return access_review_pb.AccessReviewDeadlineAction_ACCESS_REVIEW_DEADLINE_ACTION_REVOKE
+	case domain.AccessReviewDeadlineActionFlag:
+		return access_review_pb.AccessReviewDeadlineAction_ACCESS_REVIEW_DEADLINE_ACTION_FLAG
+	case domain.AccessReviewDeadlineActionUnspecified:
+		return access_review_pb.AccessReviewDeadlineAction_ACCESS_REVIEW_DEADLINE_ACTION_UNSPECIFIED
+	default:
+		return access_review_pb.AccessReviewDeadlineAction_ACCESS_REVIEW_DEADLINE_ACTION_UNSPECIFIED
+	}
+}
+
+func accessReviewStateToDomain(v access_review_pb.AccessReviewState) domain.AccessReviewState {
+	switch v {
+	case access_review_pb.AccessReviewState_ACCESS_REVIEW_STATE_ACTIVE:
+		return domain.AccessReviewStateActive
+	case access_review_pb.AccessReviewState_ACCESS_REVIEW_STATE_COMPLETED:
+		return domain.AccessReviewStateCompleted
+	case access_review_pb.AccessReviewState_ACCESS_REVIEW_STATE_CANCELLED:
+		return domain.AccessReviewStateCancelled
+	case access_review_pb.AccessReviewState_ACCESS_REVIEW_STATE_UNSPECIFIED:
+		return domain.AccessReviewStateUnspecified
+	default:
+		return domain.AccessReviewStateUnspecified
+	}
+}
+
+func accessReviewStateToPb(v domain.AccessReviewState) access_review_pb.AccessReviewState {
+	switch v {
+	case domain.AccessReviewStateActive:
+		return access_review_pb.AccessReviewState_ACCESS_REVIEW_STATE_ACTIVE
+	case domain.AccessReviewStateCompleted:
+		return access_review_pb.AccessReviewState_ACCESS_REVIEW_STATE_COMPLETED
+	case domain.AccessReviewStateCancelled:
+		return access_review_pb.AccessReviewState_ACCESS_REVIEW_STATE_CANCELLED
+	case domain.AccessReviewStateUnspecified:
+		return access_review_pb.AccessReviewState_ACCESS_REVIEW_STATE_UNSPECIFIED
+	default:
+		return access_review_pb.AccessReviewState_ACCESS_REVIEW_STATE_UNSPECIFIED
+	}
+}
+
+func accessReviewItemTypeToPb(v domain.AccessReviewItemType) access_review_pb.AccessReviewItemType {
+	switch v {
+	case domain.AccessReviewItemTypeUserGrant:
+		return access_review_pb.AccessReviewItemType_ACCESS_REVIEW_ITEM_TYPE_USER_GRANT
+	case domain.AccessReviewItemTypeOrgMember:
+		return access_review_pb.AccessReviewItemType_ACCESS_REVIEW_ITEM_TYPE_ORG_MEMBER
+	case domain.AccessReviewItemTypeProjectMember:
+		return access_review_pb.AccessReviewItemType_ACCESS_REVIEW_ITEM_TYPE_PROJECT_MEMBER
+	case domain.AccessReviewItemTypeUnspecified:
+		return access_review_pb.AccessReviewItemType_ACCESS_REVIEW_ITEM_TYPE_UNSPECIFIED
+	default:
+		return access_review_pb.AccessReviewItemType_ACCESS_REVIEW_ITEM_TYPE_UNSPECIFIED
+	}
+}
+
+func AccessReviewDecisionToDomain(v access_review_pb.AccessReviewDecision) domain.AccessReviewDecision {
+	switch v {
+	case access_review_pb.AccessReviewDecision_ACCESS_REVIEW_DECISION_PENDING:
+		return domain.AccessReviewDecisionPending
+	case access_review_pb.AccessReviewDecision_ACCESS_REVIEW_DECISION_APPROVED:
+		return domain.AccessReviewDecisionApproved
+	case access_review_pb.AccessReviewDecision_ACCESS_REVIEW_DECISION_REVOKED:
+		return domain.AccessReviewDecisionRevoked
+	case access_review_pb.AccessReviewDecision_ACCESS_REVIEW_DECISION_FLAGGED:
+		return domain.AccessReviewDecisionFlagged
+	case access_review_pb.AccessReviewDecision_ACCESS_REVIEW_DECISION_EXPIRED:
+		return domain.AccessReviewDecisionExpired
+	case access_review_pb.AccessReviewDecision_ACCESS_REVIEW_DECISION_UNSPECIFIED:
+		return domain.AccessReviewDecisionUnspecified
+	default:
+		return domain.AccessReviewDecisionUnspecified
+	}
+}
+
+func accessReviewDecisionToPb(v domain.AccessReviewDecision) access_review_pb.AccessReviewDecision {
+	switch v {
+	case domain.AccessReviewDecisionPending:
+		return access_review_pb.AccessReviewDecision_ACCESS_REVIEW_DECISION_PENDING
+	case domain.AccessReviewDecisionApproved:
+		return access_review_pb.AccessReviewDecision_ACCESS_REVIEW_DECISION_APPROVED
+	case domain.AccessReviewDecisionRevoked:
+		return access_review_pb.AccessReviewDecision_ACCESS_REVIEW_DECISION_REVOKED
+	case domain.AccessReviewDecisionFlagged:
+		return access_review_pb.AccessReviewDecision_ACCESS_REVIEW_DECISION_FLAGGED
+	case domain.AccessReviewDecisionExpired:
+		return access_review_pb.AccessReviewDecision_ACCESS_REVIEW_DECISION_EXPIRED
+	case domain.AccessReviewDecisionUnspecified:
+		return access_review_pb.AccessReviewDecision_ACCESS_REVIEW_DECISION_UNSPECIFIED
+	default:
+		return access_review_pb.AccessReviewDecision_ACCESS_REVIEW_DECISION_UNSPECIFIED
+	}
+}
@@ -62,3 +62,25 @@ func MemberQueryToMember(search *member_pb.SearchQuery) (query.SearchQuery, erro
 		return nil, zerrors.ThrowInvalidArgument(nil, "MEMBE-7Bb92", "Errors.Query.InvalidRequest")
 	}
 }
+
+func ScopeToPb(scope *domain.OrgMemberScope) *member_pb.MemberScope {
+	if scope.IsEmpty() {
+		return nil
+	}
+	return &member_pb.MemberScope{
+		MetadataKey:   scope.MetadataKey,
+		MetadataValue: scope.MetadataValue,
+		GroupId:       scope.GroupID,
+	}
+}
+
+func ScopeToDomain(scope *member_pb.MemberScope) *domain.OrgMemberScope {
+	if scope == nil {
+		return nil
+	}
+	return &domain.OrgMemberScope{
+		MetadataKey:   scope.GetMetadataKey(),
+		MetadataValue: scope.GetMetadataValue(),
+		GroupID:       scope.GetGroupId(),
+	}
+}
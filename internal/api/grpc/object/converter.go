@@ -126,3 +126,27 @@ func ListQueryToModel(query *object_pb.ListQuery) (offset, limit uint64, asc boo
 	}
 	return query.Offset, uint64(query.Limit), query.Asc
 }
+
+// ValidityToDomain maps the optional validity window of a request
+func ValidityToDomain(from, until *timestamppb.Timestamp) domain.Validity {
+	return domain.Validity{
+		From:  timestampToTime(from),
+		Until: timestampToTime(until),
+	}
+}
+
+func timestampToTime(timestamp *timestamppb.Timestamp) *time.Time {
+	if timestamp == nil {
+		return nil
+	}
+	t := timestamp.AsTime()
+	return &t
+}
+
+// TimeToPb returns nil for the zero time, which marks unset optional timestamps
+func TimeToPb(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
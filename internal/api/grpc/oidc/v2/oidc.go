@@ -40,6 +40,8 @@ func authRequestToPb(a *query.AuthRequest) *oidc_pb.AuthRequest {
 		UiLocales:    a.UiLocales,
 		LoginHint:    a.LoginHint,
 		HintUserId:   a.HintUserID,
+
+		RequiredLevelOfAssurance: levelOfAssuranceToPb(a.RequiredLevelOfAssurance),
 	}
 	if a.MaxAge != nil {
 		pba.MaxAge = durationpb.New(*a.MaxAge)
@@ -47,6 +49,19 @@ func authRequestToPb(a *query.AuthRequest) *oidc_pb.AuthRequest {
 	return pba
 }
 
+func levelOfAssuranceToPb(level domain.LevelOfAssurance) oidc_pb.LevelOfAssurance {
+	switch level {
+	case domain.LevelOfAssurancePassword:
+		return oidc_pb.LevelOfAssurance_LEVEL_OF_ASSURANCE_PASSWORD
+	case domain.LevelOfAssuranceMFA:
+		return oidc_pb.LevelOfAssurance_LEVEL_OF_ASSURANCE_MFA
+	case domain.LevelOfAssurancePhishingResistant:
+		return oidc_pb.LevelOfAssurance_LEVEL_OF_ASSURANCE_PHISHING_RESISTANT
+	default:
+		return oidc_pb.LevelOfAssurance_LEVEL_OF_ASSURANCE_UNSPECIFIED
+	}
+}
+
 func promptsToPb(promps []domain.Prompt) []oidc_pb.Prompt {
 	out := make([]oidc_pb.Prompt, len(promps))
 	for i, p := range promps {
@@ -90,7 +105,7 @@ func (s *Server) failAuthRequest(ctx context.Context, authRequestID string, ae *
 	if err != nil {
 		return nil, err
 	}
-	authReq := &oidc.AuthRequestV2{CurrentAuthRequest: aar}
+	authReq := s.op.AuthRequestV2(aar)
 	callback, err := oidc.CreateErrorCallbackURL(authReq, errorReasonToOIDC(ae.GetError()), ae.GetErrorDescription(), ae.GetErrorUri(), s.op.Provider())
 	if err != nil {
 		return nil, err
@@ -106,7 +121,7 @@ func (s *Server) linkSessionToAuthRequest(ctx context.Context, authRequestID str
 	if err != nil {
 		return nil, err
 	}
-	authReq := &oidc.AuthRequestV2{CurrentAuthRequest: aar}
+	authReq := s.op.AuthRequestV2(aar)
 	ctx = op.ContextWithIssuer(ctx, http.BuildOrigin(authz.GetInstance(ctx).RequestedHost(), s.externalSecure))
 	var callback string
 	if aar.ResponseType == domain.OIDCResponseTypeCode {
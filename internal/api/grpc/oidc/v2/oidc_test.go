@@ -36,6 +36,8 @@ func Test_authRequestToPb(t *testing.T) {
 		LoginHint:  gu.Ptr("foo@bar.com"),
 		MaxAge:     gu.Ptr(time.Minute),
 		HintUserID: gu.Ptr("userID"),
+
+		RequiredLevelOfAssurance: domain.LevelOfAssuranceMFA,
 	}
 	want := &oidc_pb.AuthRequest{
 		Id:           "authID",
@@ -56,6 +58,8 @@ func Test_authRequestToPb(t *testing.T) {
 		LoginHint:  gu.Ptr("foo@bar.com"),
 		MaxAge:     durationpb.New(time.Minute),
 		HintUserId: gu.Ptr("userID"),
+
+		RequiredLevelOfAssurance: oidc_pb.LevelOfAssurance_LEVEL_OF_ASSURANCE_MFA,
 	}
 	got := authRequestToPb(arg)
 	if !proto.Equal(want, got) {
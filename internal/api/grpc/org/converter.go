@@ -27,6 +27,10 @@ func OrgQueryToModel(apiQuery *org_pb.OrgQuery) (query.SearchQuery, error) {
 		return query.NewOrgNameSearchQuery(object.TextMethodToQuery(q.NameQuery.Method), q.NameQuery.Name)
 	case *org_pb.OrgQuery_StateQuery:
 		return query.NewOrgStateSearchQuery(OrgStateToDomain(q.StateQuery.State))
+	case *org_pb.OrgQuery_ParentIdQuery:
+		return query.NewOrgParentIDSearchQuery(q.ParentIdQuery.ParentId)
+	case *org_pb.OrgQuery_SubtreeQuery:
+		return query.NewOrgSubtreeSearchQuery(q.SubtreeQuery.OrgId)
 	default:
 		return nil, zerrors.ThrowInvalidArgument(nil, "ORG-vR9nC", "List.Query.Invalid")
 	}
@@ -51,6 +55,10 @@ func OrgQueryToQuery(search *org_pb.OrgQuery) (query.SearchQuery, error) {
 		return query.NewOrgNameSearchQuery(object.TextMethodToQuery(q.NameQuery.Method), q.NameQuery.Name)
 	case *org_pb.OrgQuery_StateQuery:
 		return query.NewOrgStateSearchQuery(OrgStateToDomain(q.StateQuery.State))
+	case *org_pb.OrgQuery_ParentIdQuery:
+		return query.NewOrgParentIDSearchQuery(q.ParentIdQuery.ParentId)
+	case *org_pb.OrgQuery_SubtreeQuery:
+		return query.NewOrgSubtreeSearchQuery(q.SubtreeQuery.OrgId)
 	default:
 		return nil, zerrors.ThrowInvalidArgument(nil, "ADMIN-ADvsd", "List.Query.Invalid")
 	}
@@ -70,6 +78,7 @@ func OrgViewToPb(org *query.Org) *org_pb.Org {
 		State:         OrgStateToPb(org.State),
 		Name:          org.Name,
 		PrimaryDomain: org.Domain,
+		ParentId:      org.ParentID,
 		Details: object.ToViewDetailsPb(
 			org.Sequence,
 			org.CreationDate,
@@ -92,6 +101,7 @@ func OrgToPb(org *query.Org) *org_pb.Org {
 		Id:            org.ID,
 		Name:          org.Name,
 		PrimaryDomain: org.Domain,
+		ParentId:      org.ParentID,
 		Details:       object.ToViewDetailsPb(org.Sequence, org.CreationDate, org.ChangeDate, org.ResourceOwner),
 		State:         OrgStateToPb(org.State),
 	}
@@ -156,6 +166,7 @@ func DomainToPb(d *query.Domain) *org_pb.Domain {
 		IsVerified:     d.IsVerified,
 		IsPrimary:      d.IsPrimary,
 		ValidationType: DomainValidationTypeFromModel(d.ValidationType),
+		IdpId:          d.IDPID,
 		Details: object.ToViewDetailsPb(
 			d.Sequence,
 			d.CreationDate,
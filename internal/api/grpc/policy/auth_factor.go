@@ -23,6 +23,8 @@ func SecondFactorTypeToDomain(secondFactorType policy_pb.SecondFactorType) domai
 		return domain.SecondFactorTypeOTPEmail
 	case policy_pb.SecondFactorType_SECOND_FACTOR_TYPE_OTP_SMS:
 		return domain.SecondFactorTypeOTPSMS
+	case policy_pb.SecondFactorType_SECOND_FACTOR_TYPE_PUSH:
+		return domain.SecondFactorTypePush
 	default:
 		return domain.SecondFactorTypeUnspecified
 	}
@@ -46,6 +48,8 @@ func ModelSecondFactorTypeToPb(secondFactorType domain.SecondFactorType) policy_
 		return policy_pb.SecondFactorType_SECOND_FACTOR_TYPE_OTP_EMAIL
 	case domain.SecondFactorTypeOTPSMS:
 		return policy_pb.SecondFactorType_SECOND_FACTOR_TYPE_OTP_SMS
+	case domain.SecondFactorTypePush:
+		return policy_pb.SecondFactorType_SECOND_FACTOR_TYPE_PUSH
 	default:
 		return policy_pb.SecondFactorType_SECOND_FACTOR_TYPE_UNSPECIFIED
 	}
@@ -63,6 +67,8 @@ func MultiFactorTypeToDomain(multiFactorType policy_pb.MultiFactorType) domain.M
 	switch multiFactorType {
 	case policy_pb.MultiFactorType_MULTI_FACTOR_TYPE_U2F_WITH_VERIFICATION:
 		return domain.MultiFactorTypeU2FWithPIN
+	case policy_pb.MultiFactorType_MULTI_FACTOR_TYPE_PUSH:
+		return domain.MultiFactorTypePush
 	default:
 		return domain.MultiFactorTypeUnspecified
 	}
@@ -80,6 +86,8 @@ func ModelMultiFactorTypeToPb(typ domain.MultiFactorType) policy_pb.MultiFactorT
 	switch typ {
 	case domain.MultiFactorTypeU2FWithPIN:
 		return policy_pb.MultiFactorType_MULTI_FACTOR_TYPE_U2F_WITH_VERIFICATION
+	case domain.MultiFactorTypePush:
+		return policy_pb.MultiFactorType_MULTI_FACTOR_TYPE_PUSH
 	default:
 		return policy_pb.MultiFactorType_MULTI_FACTOR_TYPE_UNSPECIFIED
 	}
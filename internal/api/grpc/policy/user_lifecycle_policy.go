@@ -0,0 +1,65 @@
+package policy
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	policy_pb "github.com/zitadel/zitadel/pkg/grpc/policy"
+)
+
+func ModelUserLifecyclePolicyToPb(policy *query.UserLifecyclePolicy) *policy_pb.UserLifecyclePolicy {
+	return &policy_pb.UserLifecyclePolicy{
+		DeactivateAfterDays: policy.DeactivateAfterDays,
+		DeleteAfterDays:     policy.DeleteAfterDays,
+		WarnDaysBefore:      policy.WarnDaysBefore,
+		Details: object.ToViewDetailsPb(
+			policy.Sequence,
+			policy.CreationDate,
+			policy.ChangeDate,
+			policy.ResourceOwner,
+		),
+	}
+}
+
+func UserLifecycleCandidatesToPb(candidates []*query.UserLifecycleCandidate) []*policy_pb.UserLifecycleCandidate {
+	result := make([]*policy_pb.UserLifecycleCandidate, len(candidates))
+	for i, candidate := range candidates {
+		result[i] = &policy_pb.UserLifecycleCandidate{
+			UserId:        candidate.UserID,
+			Action:        userLifecycleActionToPb(candidate.Action),
+			DueDate:       timeToPb(candidate.DueDate),
+			LastLogin:     timeToPb(candidate.LastLogin),
+			LastActivity:  timeToPb(candidate.LastActivity),
+			DeactivatedAt: timeToPb(candidate.DeactivatedAt),
+		}
+	}
+	return result
+}
+
+func userLifecycleActionToPb(action domain.UserLifecycleAction) policy_pb.UserLifecycleAction {
+	switch action {
+	case domain.UserLifecycleActionWarnDeactivation:
+		return policy_pb.UserLifecycleAction_USER_LIFECYCLE_ACTION_WARN_DEACTIVATION
+	case domain.UserLifecycleActionDeactivate:
+		return policy_pb.UserLifecycleAction_USER_LIFECYCLE_ACTION_DEACTIVATE
+	case domain.UserLifecycleActionWarnDeletion:
+		return policy_pb.UserLifecycleAction_USER_LIFECYCLE_ACTION_WARN_DELETION
+	case domain.UserLifecycleActionDelete:
+		return policy_pb.UserLifecycleAction_USER_LIFECYCLE_ACTION_DELETE
+	case domain.UserLifecycleActionUnspecified:
+		fallthrough
+	default:
+		return policy_pb.UserLifecycleAction_USER_LIFECYCLE_ACTION_UNSPECIFIED
+	}
+}
+
+func timeToPb(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
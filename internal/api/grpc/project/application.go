@@ -61,6 +61,7 @@ func AppOIDCConfigToPb(app *query.OIDCApp) *app_pb.App_OidcConfig {
 			AdditionalOrigins:        app.AdditionalOrigins,
 			AllowedOrigins:           app.AllowedOrigins,
 			SkipNativeAppSuccessPage: app.SkipNativeAppSuccessPage,
+			RequiredLevelOfAssurance: levelOfAssuranceToPb(app.RequiredLevelOfAssurance),
 		},
 	}
 }
@@ -260,6 +261,32 @@ func OIDCTokenTypeToDomain(tokenType app_pb.OIDCTokenType) domain.OIDCTokenType
 	}
 }
 
+func levelOfAssuranceToPb(level domain.LevelOfAssurance) app_pb.LevelOfAssurance {
+	switch level {
+	case domain.LevelOfAssurancePassword:
+		return app_pb.LevelOfAssurance_LEVEL_OF_ASSURANCE_PASSWORD
+	case domain.LevelOfAssuranceMFA:
+		return app_pb.LevelOfAssurance_LEVEL_OF_ASSURANCE_MFA
+	case domain.LevelOfAssurancePhishingResistant:
+		return app_pb.LevelOfAssurance_LEVEL_OF_ASSURANCE_PHISHING_RESISTANT
+	default:
+		return app_pb.LevelOfAssurance_LEVEL_OF_ASSURANCE_UNSPECIFIED
+	}
+}
+
+func LevelOfAssuranceToDomain(level app_pb.LevelOfAssurance) domain.LevelOfAssurance {
+	switch level {
+	case app_pb.LevelOfAssurance_LEVEL_OF_ASSURANCE_PASSWORD:
+		return domain.LevelOfAssurancePassword
+	case app_pb.LevelOfAssurance_LEVEL_OF_ASSURANCE_MFA:
+		return domain.LevelOfAssuranceMFA
+	case app_pb.LevelOfAssurance_LEVEL_OF_ASSURANCE_PHISHING_RESISTANT:
+		return domain.LevelOfAssurancePhishingResistant
+	default:
+		return domain.LevelOfAssuranceNone
+	}
+}
+
 func ComplianceProblemsToLocalizedMessages(problems []string) []*message_pb.LocalizedMessage {
 	converted := make([]*message_pb.LocalizedMessage, len(problems))
 	for i, p := range problems {
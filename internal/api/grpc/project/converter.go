@@ -155,3 +155,95 @@ func RoleViewToPb(role *query.ProjectRole) *proj_pb.Role {
 		),
 	}
 }
+
+func PermissionQueriesToModel(queries []*proj_pb.PermissionQuery) (_ []query.SearchQuery, err error) {
+	q := make([]query.SearchQuery, len(queries))
+	for i, query := range queries {
+		q[i], err = PermissionQueryToModel(query)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return q, nil
+}
+
+func PermissionQueryToModel(apiQuery *proj_pb.PermissionQuery) (query.SearchQuery, error) {
+	switch q := apiQuery.Query.(type) {
+	case *proj_pb.PermissionQuery_KeyQuery:
+		return query.NewProjectPermissionKeySearchQuery(q.KeyQuery.Key, object.TextMethodToQuery(q.KeyQuery.Method))
+	default:
+		return nil, zerrors.ThrowInvalidArgument(nil, "PROJECT-Pq1iv", "List.Query.Invalid")
+	}
+}
+
+func PermissionsToPb(permissions []*query.ProjectPermission) []*proj_pb.Permission {
+	p := make([]*proj_pb.Permission, len(permissions))
+	for i, permission := range permissions {
+		p[i] = &proj_pb.Permission{
+			Key:         permission.Key,
+			DisplayName: permission.DisplayName,
+			RoleKeys:    permission.RoleKeys,
+			Details: object.ToViewDetailsPb(
+				permission.Sequence,
+				permission.CreationDate,
+				permission.ChangeDate,
+				permission.ResourceOwner,
+			),
+		}
+	}
+	return p
+}
+
+// RoleInclusionsToPb groups the included roles by the including role.
+func RoleInclusionsToPb(inclusions []*query.ProjectRoleInclusion) []*proj_pb.RoleInclusion {
+	result := make([]*proj_pb.RoleInclusion, 0)
+	for _, inclusion := range inclusions {
+		if len(result) == 0 || result[len(result)-1].RoleKey != inclusion.RoleKey {
+			result = append(result, &proj_pb.RoleInclusion{RoleKey: inclusion.RoleKey})
+		}
+		last := result[len(result)-1]
+		last.IncludedRoleKeys = append(last.IncludedRoleKeys, inclusion.IncludedRoleKey)
+	}
+	return result
+}
+
+func RoleConditionToPb(condition *domain.ProjectRoleCondition) *proj_pb.RoleCondition {
+	if condition == nil {
+		return new(proj_pb.RoleCondition)
+	}
+	return &proj_pb.RoleCondition{
+		OrgIds:         condition.OrgIDs,
+		MfaLevel:       proj_pb.RoleConditionMFALevel(condition.MFALevel),
+		Amr:            condition.AMR,
+		IpRanges:       condition.IPRanges,
+		TimeOfDayStart: condition.TimeOfDayStart,
+		TimeOfDayEnd:   condition.TimeOfDayEnd,
+		TimeZone:       condition.TimeZone,
+	}
+}
+
+func RoleConditionToDomain(condition *proj_pb.RoleCondition) *domain.ProjectRoleCondition {
+	if condition == nil {
+		return nil
+	}
+	return &domain.ProjectRoleCondition{
+		OrgIDs:         condition.OrgIds,
+		MFALevel:       domain.MFALevel(condition.MfaLevel),
+		AMR:            condition.Amr,
+		IPRanges:       condition.IpRanges,
+		TimeOfDayStart: condition.TimeOfDayStart,
+		TimeOfDayEnd:   condition.TimeOfDayEnd,
+		TimeZone:       condition.TimeZone,
+	}
+}
+
+func PermittedResourcesToPb(resources []*query.PermittedResource) []*proj_pb.PermittedResource {
+	r := make([]*proj_pb.PermittedResource, len(resources))
+	for i, resource := range resources {
+		r[i] = &proj_pb.PermittedResource{
+			OrgId:          resource.OrgID,
+			ProjectGrantId: resource.ProjectGrantID,
+		}
+	}
+	return r
+}
@@ -174,6 +174,10 @@ func (m *mockInstance) AuditLogRetention() *time.Duration {
 	panic("shouldn't be called here")
 }
 
+func (m *mockInstance) AdministratorRoles() []authz.RoleMapping {
+	return nil
+}
+
 func (m *mockInstance) InstanceID() string {
 	return "instanceID"
 }
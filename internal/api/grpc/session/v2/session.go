@@ -4,6 +4,7 @@ import (
 	"context"
 	"net"
 	"net/http"
+	"slices"
 	"time"
 
 	"github.com/muhlemmer/gu"
@@ -100,6 +101,30 @@ func (s *Server) SetSession(ctx context.Context, req *session.SetSessionRequest)
 	}, nil
 }
 
+func (s *Server) DiscoverIdentityProvider(ctx context.Context, req *session.DiscoverIdentityProviderRequest) (*session.DiscoverIdentityProviderResponse, error) {
+	domainIDP, err := s.query.DomainIDPByLoginName(ctx, req.GetLoginHint())
+	if err != nil {
+		return nil, err
+	}
+	policy, err := s.query.LoginPolicyByID(ctx, true, domainIDP.OrgID, false)
+	if err != nil {
+		return nil, err
+	}
+	links, err := s.query.IDPLoginPolicyLinks(ctx, domainIDP.OrgID, &query.IDPLoginPolicyLinksSearchQuery{}, false)
+	if err != nil {
+		return nil, err
+	}
+	if !policy.AllowExternalIDPs || !slices.ContainsFunc(links.Links, func(link *query.IDPLoginPolicyLink) bool {
+		return link.IDPID == domainIDP.IDPID
+	}) {
+		return nil, zerrors.ThrowNotFound(nil, "SESSION-Qz8vk", "Errors.IDPConfig.NotExisting")
+	}
+	return &session.DiscoverIdentityProviderResponse{
+		IdpId:          domainIDP.IDPID,
+		OrganizationId: domainIDP.OrgID,
+	}, nil
+}
+
 func (s *Server) DeleteSession(ctx context.Context, req *session.DeleteSessionRequest) (*session.DeleteSessionResponse, error) {
 	details, err := s.command.TerminateSession(ctx, req.GetSessionId(), req.GetSessionToken())
 	if err != nil {
@@ -175,6 +200,7 @@ func factorsToPb(s *query.Session) *session.Factors {
 		Totp:     totpFactorToPb(s.TOTPFactor),
 		OtpSms:   otpFactorToPb(s.OTPSMSFactor),
 		OtpEmail: otpFactorToPb(s.OTPEmailFactor),
+		Push:     pushFactorToPb(s.PushFactor),
 	}
 }
 
@@ -224,6 +250,15 @@ func otpFactorToPb(factor query.SessionOTPFactor) *session.OTPFactor {
 	}
 }
 
+func pushFactorToPb(factor query.SessionPushFactor) *session.PushFactor {
+	if factor.PushCheckedAt.IsZero() {
+		return nil
+	}
+	return &session.PushFactor{
+		VerifiedAt: timestamppb.New(factor.PushCheckedAt),
+	}
+}
+
 func userFactorToPb(factor query.SessionUserFactor) *session.UserFactor {
 	if factor.UserID == "" || factor.UserCheckedAt.IsZero() {
 		return nil
@@ -374,6 +409,9 @@ func (s *Server) checksToCommand(ctx context.Context, checks *session.Checks) ([
 	if otp := checks.GetOtpEmail(); otp != nil {
 		sessionChecks = append(sessionChecks, command.CheckOTPEmail(otp.GetCode()))
 	}
+	if push := checks.GetPush(); push != nil {
+		sessionChecks = append(sessionChecks, command.CheckPush(push.GetDeviceId(), push.GetSignature()))
+	}
 	return sessionChecks, nil
 }
 
@@ -400,6 +438,11 @@ func (s *Server) challengesToCommand(challenges *session.RequestChallenges, cmds
 		resp.OtpEmail = challenge
 		cmds = append(cmds, cmd)
 	}
+	if req := challenges.GetPush(); req != nil {
+		challenge := new(string)
+		resp.Push = challenge
+		cmds = append(cmds, s.command.CreatePushChallenge(challenge))
+	}
 	return resp, cmds, nil
 }
 
@@ -33,6 +33,7 @@ func loginSettingsToPb(current *query.LoginPolicy) *settings.LoginSettings {
 		AllowDomainDiscovery:       current.AllowDomainDiscovery,
 		DisableLoginWithEmail:      current.DisableLoginWithEmail,
 		DisableLoginWithPhone:      current.DisableLoginWithPhone,
+		ForcePasskeyRegistration:   current.ForcePasskeyRegistration,
 		DefaultRedirectUri:         current.DefaultRedirectURI,
 		PasswordCheckLifetime:      durationpb.New(time.Duration(current.PasswordCheckLifetime)),
 		ExternalLoginCheckLifetime: durationpb.New(time.Duration(current.ExternalLoginCheckLifetime)),
@@ -73,6 +74,8 @@ func secondFactorTypeToPb(secondFactorType domain.SecondFactorType) settings.Sec
 		return settings.SecondFactorType_SECOND_FACTOR_TYPE_OTP_EMAIL
 	case domain.SecondFactorTypeOTPSMS:
 		return settings.SecondFactorType_SECOND_FACTOR_TYPE_OTP_SMS
+	case domain.SecondFactorTypePush:
+		return settings.SecondFactorType_SECOND_FACTOR_TYPE_PUSH
 	case domain.SecondFactorTypeUnspecified:
 		return settings.SecondFactorType_SECOND_FACTOR_TYPE_UNSPECIFIED
 	default:
@@ -84,6 +87,8 @@ func multiFactorTypeToPb(typ domain.MultiFactorType) settings.MultiFactorType {
 	switch typ {
 	case domain.MultiFactorTypeU2FWithPIN:
 		return settings.MultiFactorType_MULTI_FACTOR_TYPE_U2F_WITH_VERIFICATION
+	case domain.MultiFactorTypePush:
+		return settings.MultiFactorType_MULTI_FACTOR_TYPE_PUSH
 	case domain.MultiFactorTypeUnspecified:
 		return settings.MultiFactorType_MULTI_FACTOR_TYPE_UNSPECIFIED
 	default:
@@ -218,6 +223,16 @@ func idpTypeToPb(idpType domain.IDPType) settings.IdentityProviderType {
 		return settings.IdentityProviderType_IDENTITY_PROVIDER_TYPE_GOOGLE
 	case domain.IDPTypeSAML:
 		return settings.IdentityProviderType_IDENTITY_PROVIDER_TYPE_SAML
+	case domain.IDPTypeADFS:
+		return settings.IdentityProviderType_IDENTITY_PROVIDER_TYPE_ADFS
+	case domain.IDPTypeKeycloak:
+		return settings.IdentityProviderType_IDENTITY_PROVIDER_TYPE_KEYCLOAK
+	case domain.IDPTypeOkta:
+		return settings.IdentityProviderType_IDENTITY_PROVIDER_TYPE_OKTA
+	case domain.IDPTypeBitbucket:
+		return settings.IdentityProviderType_IDENTITY_PROVIDER_TYPE_BITBUCKET
+	case domain.IDPTypeDiscord:
+		return settings.IdentityProviderType_IDENTITY_PROVIDER_TYPE_DISCORD
 	default:
 		return settings.IdentityProviderType_IDENTITY_PROVIDER_TYPE_UNSPECIFIED
 	}
@@ -230,6 +245,7 @@ func securityPolicyToSettingsPb(policy *query.SecurityPolicy) *settings.Security
 			AllowedOrigins: policy.AllowedOrigins,
 		},
 		EnableImpersonation: policy.EnableImpersonation,
+		SmsAllowedCountries: policy.SMSAllowedCountries,
 	}
 }
 
@@ -238,5 +254,6 @@ func securitySettingsToCommand(req *settings.SetSecuritySettingsRequest) *comman
 		EnableIframeEmbedding: req.GetEmbeddedIframe().GetEnabled(),
 		AllowedOrigins:        req.GetEmbeddedIframe().GetAllowedOrigins(),
 		EnableImpersonation:   req.GetEnableImpersonation(),
+		SMSAllowedCountries:   req.GetSmsAllowedCountries(),
 	}
 }
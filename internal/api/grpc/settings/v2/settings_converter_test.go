@@ -34,6 +34,7 @@ func Test_loginSettingsToPb(t *testing.T) {
 		AllowDomainDiscovery:       true,
 		DisableLoginWithEmail:      true,
 		DisableLoginWithPhone:      true,
+		ForcePasskeyRegistration:   true,
 		DefaultRedirectURI:         "example.com",
 		PasswordCheckLifetime:      database.Duration(time.Hour),
 		ExternalLoginCheckLifetime: database.Duration(time.Minute),
@@ -64,6 +65,7 @@ func Test_loginSettingsToPb(t *testing.T) {
 		AllowDomainDiscovery:       true,
 		DisableLoginWithEmail:      true,
 		DisableLoginWithPhone:      true,
+		ForcePasskeyRegistration:   true,
 		DefaultRedirectUri:         "example.com",
 		PasswordCheckLifetime:      durationpb.New(time.Hour),
 		ExternalLoginCheckLifetime: durationpb.New(time.Minute),
@@ -167,6 +169,10 @@ func Test_secondFactorTypeToPb(t *testing.T) {
 			args: args{domain.SecondFactorTypeOTPEmail},
 			want: settings.SecondFactorType_SECOND_FACTOR_TYPE_OTP_EMAIL,
 		},
+		{
+			args: args{domain.SecondFactorTypePush},
+			want: settings.SecondFactorType_SECOND_FACTOR_TYPE_PUSH,
+		},
 		{
 			args: args{domain.SecondFactorTypeUnspecified},
 			want: settings.SecondFactorType_SECOND_FACTOR_TYPE_UNSPECIFIED,
@@ -196,6 +202,10 @@ func Test_multiFactorTypeToPb(t *testing.T) {
 			args: args{domain.MultiFactorTypeU2FWithPIN},
 			want: settings.MultiFactorType_MULTI_FACTOR_TYPE_U2F_WITH_VERIFICATION,
 		},
+		{
+			args: args{domain.MultiFactorTypePush},
+			want: settings.MultiFactorType_MULTI_FACTOR_TYPE_PUSH,
+		},
 		{
 			args: args{domain.MultiFactorTypeUnspecified},
 			want: settings.MultiFactorType_MULTI_FACTOR_TYPE_UNSPECIFIED,
@@ -470,6 +480,26 @@ func Test_idpTypeToPb(t *testing.T) {
 			args: args{domain.IDPTypeSAML},
 			want: settings.IdentityProviderType_IDENTITY_PROVIDER_TYPE_SAML,
 		},
+		{
+			args: args{domain.IDPTypeADFS},
+			want: settings.IdentityProviderType_IDENTITY_PROVIDER_TYPE_ADFS,
+		},
+		{
+			args: args{domain.IDPTypeKeycloak},
+			want: settings.IdentityProviderType_IDENTITY_PROVIDER_TYPE_KEYCLOAK,
+		},
+		{
+			args: args{domain.IDPTypeOkta},
+			want: settings.IdentityProviderType_IDENTITY_PROVIDER_TYPE_OKTA,
+		},
+		{
+			args: args{domain.IDPTypeBitbucket},
+			want: settings.IdentityProviderType_IDENTITY_PROVIDER_TYPE_BITBUCKET,
+		},
+		{
+			args: args{domain.IDPTypeDiscord},
+			want: settings.IdentityProviderType_IDENTITY_PROVIDER_TYPE_DISCORD,
+		},
 		{
 			args: args{99},
 			want: settings.IdentityProviderType_IDENTITY_PROVIDER_TYPE_UNSPECIFIED,
@@ -491,11 +521,13 @@ func Test_securityPolicyToSettingsPb(t *testing.T) {
 			AllowedOrigins: []string{"foo", "bar"},
 		},
 		EnableImpersonation: true,
+		SmsAllowedCountries: []string{"CH", "DE"},
 	}
 	got := securityPolicyToSettingsPb(&query.SecurityPolicy{
 		EnableIframeEmbedding: true,
 		AllowedOrigins:        []string{"foo", "bar"},
 		EnableImpersonation:   true,
+		SMSAllowedCountries:   []string{"CH", "DE"},
 	})
 	assert.Equal(t, want, got)
 }
@@ -505,6 +537,7 @@ func Test_securitySettingsToCommand(t *testing.T) {
 		EnableIframeEmbedding: true,
 		AllowedOrigins:        []string{"foo", "bar"},
 		EnableImpersonation:   true,
+		SMSAllowedCountries:   []string{"CH", "DE"},
 	}
 	got := securitySettingsToCommand(&settings.SetSecuritySettingsRequest{
 		EmbeddedIframe: &settings.EmbeddedIframeSettings{
@@ -512,6 +545,7 @@ func Test_securitySettingsToCommand(t *testing.T) {
 			AllowedOrigins: []string{"foo", "bar"},
 		},
 		EnableImpersonation: true,
+		SmsAllowedCountries: []string{"CH", "DE"},
 	})
 	assert.Equal(t, want, got)
 }
@@ -26,6 +26,33 @@ func ModelCustomMessageTextToPb(msg *query.MessageText) *text_pb.MessageCustomTe
 	}
 }
 
+func SecurityAlertTypeToDomain(alertType text_pb.SecurityAlertType) string {
+	switch alertType {
+	case text_pb.SecurityAlertType_SECURITY_ALERT_TYPE_MFA_ADDED:
+		return domain.MFAAddedMessageType
+	case text_pb.SecurityAlertType_SECURITY_ALERT_TYPE_MFA_REMOVED:
+		return domain.MFARemovedMessageType
+	case text_pb.SecurityAlertType_SECURITY_ALERT_TYPE_EMAIL_CHANGED:
+		return domain.EmailChangedMessageType
+	case text_pb.SecurityAlertType_SECURITY_ALERT_TYPE_PHONE_CHANGED:
+		return domain.PhoneChangedMessageType
+	case text_pb.SecurityAlertType_SECURITY_ALERT_TYPE_PASSKEY_ADDED:
+		return domain.PasskeyAddedMessageType
+	case text_pb.SecurityAlertType_SECURITY_ALERT_TYPE_NEW_LOGIN:
+		return domain.NewLoginMessageType
+	case text_pb.SecurityAlertType_SECURITY_ALERT_TYPE_PERSONAL_ACCESS_TOKEN_ADDED:
+		return domain.PersonalAccessTokenAddedMessageType
+	case text_pb.SecurityAlertType_SECURITY_ALERT_TYPE_ACCOUNT_LOCKED:
+		return domain.AccountLockedMessageType
+	case text_pb.SecurityAlertType_SECURITY_ALERT_TYPE_BREAK_GLASS_USED:
+		return domain.BreakGlassUsedMessageType
+	case text_pb.SecurityAlertType_SECURITY_ALERT_TYPE_UNSPECIFIED:
+		fallthrough
+	default:
+		return ""
+	}
+}
+
 func CustomLoginTextToPb(text *domain.CustomLoginText) *text_pb.LoginCustomText {
 	return &text_pb.LoginCustomText{
 		Details: object.ToViewDetailsPb(
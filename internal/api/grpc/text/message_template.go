@@ -0,0 +1,30 @@
+package text
+
+import (
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/query"
+	text_pb "github.com/zitadel/zitadel/pkg/grpc/text"
+)
+
+func MessageTemplatesToPb(templates []*query.MessageTemplate) []*text_pb.MessageTemplate {
+	result := make([]*text_pb.MessageTemplate, len(templates))
+	for i, template := range templates {
+		result[i] = MessageTemplateToPb(template)
+	}
+	return result
+}
+
+func MessageTemplateToPb(template *query.MessageTemplate) *text_pb.MessageTemplate {
+	return &text_pb.MessageTemplate{
+		Name:      template.Name,
+		Language:  template.Language.String(),
+		Template:  template.Template,
+		IsDefault: template.IsDefault,
+		Details: object.ToViewDetailsPb(
+			template.Sequence,
+			template.CreationDate,
+			template.ChangeDate,
+			template.AggregateID,
+		),
+	}
+}
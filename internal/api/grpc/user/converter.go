@@ -224,6 +224,13 @@ func AuthMethodToPb(mfa *query.AuthMethod) *user_pb.AuthFactor {
 		factor.Type = &user_pb.AuthFactor_OtpEmail{
 			OtpEmail: &user_pb.AuthFactorOTPEmail{},
 		}
+	case domain.UserAuthMethodTypePush:
+		factor.Type = &user_pb.AuthFactor_Push{
+			Push: &user_pb.AuthFactorPush{
+				Id:   mfa.TokenID,
+				Name: mfa.Name,
+			},
+		}
 	}
 	return factor
 }
@@ -49,6 +49,8 @@ func MembershipToMembershipPb(membership *query.Membership) *user_pb.Membership
 		Type:        typ,
 		DisplayName: name,
 		Roles:       membership.Roles,
+		ValidFrom:   object.TimeToPb(membership.ValidFrom),
+		ValidUntil:  object.TimeToPb(membership.ValidUntil),
 		Details: object.ToViewDetailsPb(
 			membership.Sequence,
 			membership.CreationDate,
@@ -42,6 +42,10 @@ func UserGrantToPb(assetPrefix string, grant *query.UserGrant) *user_pb.UserGran
 		GrantedOrgId:       grant.GrantedOrgID,
 		GrantedOrgName:     grant.GrantedOrgName,
 		GrantedOrgDomain:   grant.GrantedOrgDomain,
+		GroupId:            grant.GroupID,
+		GroupName:          grant.GroupName,
+		ValidFrom:          object.TimeToPb(grant.ValidFrom),
+		ValidUntil:         object.TimeToPb(grant.ValidUntil),
 		Details: object.ToViewDetailsPb(
 			grant.Sequence,
 			grant.CreationDate,
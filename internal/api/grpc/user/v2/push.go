@@ -0,0 +1,48 @@
+package user
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/object/v2"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/domain"
+	user "github.com/zitadel/zitadel/pkg/grpc/user/v2beta"
+)
+
+func (s *Server) AddPushDevice(ctx context.Context, req *user.AddPushDeviceRequest) (*user.AddPushDeviceResponse, error) {
+	device := &command.PushDevice{
+		Name:      req.GetName(),
+		Platform:  pushPlatformToDomain(req.GetPlatform()),
+		PublicKey: req.GetPublicKey(),
+		PushToken: req.GetPushToken(),
+	}
+	details, err := s.command.AddUserPushDevice(ctx, req.GetUserId(), "", device)
+	if err != nil {
+		return nil, err
+	}
+	return &user.AddPushDeviceResponse{
+		Details:  object.DomainToDetailsPb(details),
+		DeviceId: device.ID,
+	}, nil
+}
+
+func (s *Server) RemovePushDevice(ctx context.Context, req *user.RemovePushDeviceRequest) (*user.RemovePushDeviceResponse, error) {
+	objectDetails, err := s.command.RemoveUserPushDevice(ctx, req.GetUserId(), "", req.GetDeviceId())
+	if err != nil {
+		return nil, err
+	}
+	return &user.RemovePushDeviceResponse{Details: object.DomainToDetailsPb(objectDetails)}, nil
+}
+
+func pushPlatformToDomain(platform user.PushPlatform) domain.PushPlatform {
+	switch platform {
+	case user.PushPlatform_PUSH_PLATFORM_FCM:
+		return domain.PushPlatformFCM
+	case user.PushPlatform_PUSH_PLATFORM_APNS:
+		return domain.PushPlatformAPNs
+	case user.PushPlatform_PUSH_PLATFORM_UNSPECIFIED:
+		return domain.PushPlatformUnspecified
+	default:
+		return domain.PushPlatformUnspecified
+	}
+}
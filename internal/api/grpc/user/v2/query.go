@@ -23,6 +23,9 @@ func (s *Server) GetUserByID(ctx context.Context, req *user.GetUserByIDRequest)
 		if err := s.checkPermission(ctx, domain.PermissionUserRead, resp.ResourceOwner, req.GetUserId()); err != nil {
 			return nil, err
 		}
+		if err := s.query.CheckUserInMemberScope(ctx, req.GetUserId()); err != nil {
+			return nil, err
+		}
 	}
 	return &user.GetUserByIDResponse{
 		Details: object.DomainToDetailsPb(&domain.ObjectDetails{
@@ -39,6 +42,9 @@ func (s *Server) ListUsers(ctx context.Context, req *user.ListUsersRequest) (*us
 	if err != nil {
 		return nil, err
 	}
+	if err = s.query.AppendUserMemberScopeQuery(ctx, queries); err != nil {
+		return nil, err
+	}
 	res, err := s.query.SearchUsers(ctx, queries)
 	if err != nil {
 		return nil, err
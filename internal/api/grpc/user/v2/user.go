@@ -32,13 +32,24 @@ func (s *Server) AddHumanUser(ctx context.Context, req *user.AddHumanUserRequest
 		return nil, err
 	}
 	return &user.AddHumanUserResponse{
-		UserId:    human.ID,
-		Details:   object.DomainToDetailsPb(human.Details),
-		EmailCode: human.EmailCode,
-		PhoneCode: human.PhoneCode,
+		UserId:      human.ID,
+		Details:     object.DomainToDetailsPb(human.Details),
+		EmailCode:   human.EmailCode,
+		PhoneCode:   human.PhoneCode,
+		PasskeyCode: passkeyCodeToPb(human.PasskeyCode),
 	}, nil
 }
 
+func passkeyCodeToPb(code *command.PasskeyCode) *user.PasskeyRegistrationCode {
+	if code == nil {
+		return nil
+	}
+	return &user.PasskeyRegistrationCode{
+		Id:   code.ID,
+		Code: code.Code,
+	}
+}
+
 func AddUserRequestToAddHuman(req *user.AddHumanUserRequest) (*command.AddHuman, error) {
 	username := req.GetUsername()
 	if username == "" {
@@ -52,6 +63,10 @@ func AddUserRequestToAddHuman(req *user.AddHumanUserRequest) (*command.AddHuman,
 			return nil, err
 		}
 	}
+	passkey, err := passkeyRegistrationToCommand(req.GetUserId(), req.GetPasskey())
+	if err != nil {
+		return nil, err
+	}
 	passwordChangeRequired := req.GetPassword().GetChangeRequired() || req.GetHashedPassword().GetChangeRequired()
 	metadata := make([]*command.AddMetadataEntry, len(req.Metadata))
 	for i, metadataEntry := range req.Metadata {
@@ -96,9 +111,25 @@ func AddUserRequestToAddHuman(req *user.AddHumanUserRequest) (*command.AddHuman,
 		Metadata:               metadata,
 		Links:                  links,
 		TOTPSecret:             req.GetTotpSecret(),
+		Passkey:                passkey,
 	}, nil
 }
 
+func passkeyRegistrationToCommand(userID string, passkey *user.PasskeyRegistration) (*command.AddHumanPasskey, error) {
+	if passkey == nil {
+		return nil, nil
+	}
+	if passkey.GetReturnCode() != nil {
+		return &command.AddHumanPasskey{ReturnCode: true}, nil
+	}
+	urlTemplate := passkey.GetSendLink().GetUrlTemplate()
+	// test the template execution so the async notification will not fail because of it and the user won't realize
+	if err := domain.RenderPasskeyURLTemplate(io.Discard, urlTemplate, userID, "orgID", "codeID", "code"); err != nil {
+		return nil, err
+	}
+	return &command.AddHumanPasskey{URLTemplate: urlTemplate}, nil
+}
+
 func genderToDomain(gender user.Gender) domain.Gender {
 	switch gender {
 	case user.Gender_GENDER_UNSPECIFIED:
@@ -624,6 +655,8 @@ func authMethodTypeToPb(methodType domain.UserAuthMethodType) user.Authenticatio
 		return user.AuthenticationMethodType_AUTHENTICATION_METHOD_TYPE_OTP_SMS
 	case domain.UserAuthMethodTypeOTPEmail:
 		return user.AuthenticationMethodType_AUTHENTICATION_METHOD_TYPE_OTP_EMAIL
+	case domain.UserAuthMethodTypePush:
+		return user.AuthenticationMethodType_AUTHENTICATION_METHOD_TYPE_PUSH
 	case domain.UserAuthMethodTypeUnspecified:
 		return user.AuthenticationMethodType_AUTHENTICATION_METHOD_TYPE_UNSPECIFIED
 	default:
@@ -30,6 +30,7 @@ type accessToken struct {
 	audience          []string
 	scope             []string
 	authMethods       []domain.UserAuthMethodType
+	userAgent         *domain.UserAgent
 	authTime          time.Time
 	tokenCreation     time.Time
 	tokenExpiration   time.Time
@@ -107,6 +108,7 @@ func accessTokenV2(tokenID, subject string, token *query.OIDCSessionAccessTokenR
 		audience:          token.Audience,
 		scope:             token.Scope,
 		authMethods:       token.AuthMethods,
+		userAgent:         token.UserAgent,
 		authTime:          token.AuthTime,
 		tokenCreation:     token.AccessTokenCreation,
 		tokenExpiration:   token.AccessTokenExpiration,
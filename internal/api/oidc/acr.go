@@ -0,0 +1,97 @@
+package oidc
+
+import (
+	"context"
+	"slices"
+
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+// ACRConfig maps the levels of assurance to Authentication Context Class Reference values
+// as defined in [OpenID Connect Core 1.0, section 2].
+// Levels with an empty value can't be requested by clients and are not set in the tokens.
+//
+// [OpenID Connect Core 1.0, section 2]: https://openid.net/specs/openid-connect-core-1_0.html#IDToken
+type ACRConfig struct {
+	// Password is reached by any authentication (e.g. password or idp)
+	Password string
+	// MFA is reached by multiple factors or a passkey
+	MFA string
+	// PhishingResistant is reached by a security key or a passkey
+	PhishingResistant string
+}
+
+func (c ACRConfig) levels() []struct {
+	level domain.LevelOfAssurance
+	value string
+} {
+	return []struct {
+		level domain.LevelOfAssurance
+		value string
+	}{
+		{domain.LevelOfAssurancePassword, c.Password},
+		{domain.LevelOfAssuranceMFA, c.MFA},
+		{domain.LevelOfAssurancePhishingResistant, c.PhishingResistant},
+	}
+}
+
+// Supported returns the configured values ordered by their strength.
+func (c ACRConfig) Supported() []string {
+	supported := make([]string, 0, 3)
+	for _, level := range c.levels() {
+		if level.value != "" {
+			supported = append(supported, level.value)
+		}
+	}
+	if len(supported) == 0 {
+		return nil
+	}
+	return supported
+}
+
+// LevelsFromValues maps the requested acr_values to levels of assurance, unknown values are ignored.
+func (c ACRConfig) LevelsFromValues(values []string) []domain.LevelOfAssurance {
+	levels := make([]domain.LevelOfAssurance, 0, len(values))
+	for _, value := range values {
+		for _, level := range c.levels() {
+			if level.value != "" && level.value == value {
+				levels = append(levels, level.level)
+			}
+		}
+	}
+	if len(levels) == 0 {
+		return nil
+	}
+	return levels
+}
+
+// RequiredLevel returns the level the authentication has to reach.
+// The acr_values are voluntary claims in order of preference, so any of the requested levels satisfies the client
+// and the weakest is required. The level required by the application is enforced in any case.
+func (c ACRConfig) RequiredLevel(appLevel domain.LevelOfAssurance, acrValues []string) domain.LevelOfAssurance {
+	requested := c.LevelsFromValues(acrValues)
+	if len(requested) == 0 {
+		return appLevel
+	}
+	return max(appLevel, slices.Min(requested))
+}
+
+// Achieved returns the value of the strongest configured level reached by the authentication methods.
+func (c ACRConfig) Achieved(authMethods []domain.UserAuthMethodType) string {
+	achieved := domain.LevelOfAssuranceFromAuthMethods(authMethods)
+	levels := c.levels()
+	for i := len(levels) - 1; i >= 0; i-- {
+		if levels[i].level <= achieved && levels[i].value != "" {
+			return levels[i].value
+		}
+	}
+	return ""
+}
+
+func (o *OPStorage) requiredLevelOfAssurance(ctx context.Context, clientID string, acrValues []string) (domain.LevelOfAssurance, error) {
+	client, err := o.query.GetOIDCClientByID(ctx, clientID, false)
+	if err != nil {
+		return domain.LevelOfAssuranceNone, err
+	}
+	return o.acr.RequiredLevel(client.RequiredLevelOfAssurance, acrValues), nil
+}
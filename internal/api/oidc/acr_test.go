@@ -0,0 +1,166 @@
+package oidc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+var testACRConfig = ACRConfig{
+	Password:          "urn:zitadel:acr:password",
+	MFA:               "urn:zitadel:acr:mfa",
+	PhishingResistant: "urn:zitadel:acr:phishing-resistant",
+}
+
+func TestACRConfig_Supported(t *testing.T) {
+	tests := []struct {
+		name   string
+		config ACRConfig
+		want   []string
+	}{
+		{
+			"not configured",
+			ACRConfig{},
+			nil,
+		},
+		{
+			"partially configured",
+			ACRConfig{MFA: "mfa"},
+			[]string{"mfa"},
+		},
+		{
+			"all configured",
+			testACRConfig,
+			[]string{"urn:zitadel:acr:password", "urn:zitadel:acr:mfa", "urn:zitadel:acr:phishing-resistant"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.config.Supported())
+		})
+	}
+}
+
+func TestACRConfig_RequiredLevel(t *testing.T) {
+	type args struct {
+		appLevel  domain.LevelOfAssurance
+		acrValues []string
+	}
+	tests := []struct {
+		name string
+		args args
+		want domain.LevelOfAssurance
+	}{
+		{
+			"nothing requested",
+			args{
+				domain.LevelOfAssuranceNone,
+				nil,
+			},
+			domain.LevelOfAssuranceNone,
+		},
+		{
+			"unknown value ignored",
+			args{
+				domain.LevelOfAssuranceNone,
+				[]string{"unknown"},
+			},
+			domain.LevelOfAssuranceNone,
+		},
+		{
+			"requested by client",
+			args{
+				domain.LevelOfAssuranceNone,
+				[]string{"urn:zitadel:acr:mfa"},
+			},
+			domain.LevelOfAssuranceMFA,
+		},
+		{
+			"weakest requested value",
+			args{
+				domain.LevelOfAssuranceNone,
+				[]string{"urn:zitadel:acr:phishing-resistant", "urn:zitadel:acr:mfa"},
+			},
+			domain.LevelOfAssuranceMFA,
+		},
+		{
+			"required by app",
+			args{
+				domain.LevelOfAssurancePhishingResistant,
+				[]string{"urn:zitadel:acr:password"},
+			},
+			domain.LevelOfAssurancePhishingResistant,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, testACRConfig.RequiredLevel(tt.args.appLevel, tt.args.acrValues))
+		})
+	}
+}
+
+func TestACRConfig_Achieved(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      ACRConfig
+		authMethods []domain.UserAuthMethodType
+		want        string
+	}{
+		{
+			"no authentication",
+			testACRConfig,
+			nil,
+			"",
+		},
+		{
+			"password",
+			testACRConfig,
+			[]domain.UserAuthMethodType{domain.UserAuthMethodTypePassword},
+			"urn:zitadel:acr:password",
+		},
+		{
+			"password and otp",
+			testACRConfig,
+			[]domain.UserAuthMethodType{domain.UserAuthMethodTypePassword, domain.UserAuthMethodTypeOTPEmail},
+			"urn:zitadel:acr:mfa",
+		},
+		{
+			"passkey",
+			testACRConfig,
+			[]domain.UserAuthMethodType{domain.UserAuthMethodTypePasswordless},
+			"urn:zitadel:acr:phishing-resistant",
+		},
+		{
+			"weaker level configured",
+			ACRConfig{Password: "pwd"},
+			[]domain.UserAuthMethodType{domain.UserAuthMethodTypePasswordless},
+			"pwd",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.config.Achieved(tt.authMethods))
+		})
+	}
+}
+
+func TestAuthRequest_GetACR(t *testing.T) {
+	v1 := &AuthRequest{
+		AuthRequest: &domain.AuthRequest{
+			PasswordVerified: true,
+			MFAsVerified:     []domain.MFAType{domain.MFATypeTOTP},
+		},
+		acr: testACRConfig,
+	}
+	v2 := &AuthRequestV2{
+		CurrentAuthRequest: &command.CurrentAuthRequest{
+			AuthMethods: []domain.UserAuthMethodType{domain.UserAuthMethodTypePassword, domain.UserAuthMethodTypeTOTP},
+		},
+		acr: testACRConfig,
+	}
+	assert.Equal(t, "urn:zitadel:acr:mfa", v1.GetACR())
+	assert.Equal(t, "urn:zitadel:acr:mfa", v2.GetACR())
+}
@@ -18,6 +18,8 @@ const (
 	OTP = "otp"
 	// UserPresence states that the end users presence has been verified (e.g. passkey and u2f)
 	UserPresence = "user"
+	// SoftwareKey states that the possession of a software-secured key has been verified (e.g. push approval)
+	SoftwareKey = "swk"
 )
 
 // AuthMethodTypesToAMR maps zitadel auth method types to Authentication Method Reference Values
@@ -48,6 +50,9 @@ func AuthMethodTypesToAMR(methodTypes []domain.UserAuthMethodType) []string {
 			// a user could use multiple (t)otp, which is a factor, but still will be returned as a single `otp` entry
 			otp++
 			factors++
+		case domain.UserAuthMethodTypePush:
+			amr = append(amr, SoftwareKey)
+			factors++
 		case domain.UserAuthMethodTypeIDP:
 			// no AMR value according to specification
 			factors++
@@ -77,6 +82,8 @@ func AMRToAuthMethodTypes(amr []string) []domain.UserAuthMethodType {
 			authMethods = append(authMethods, domain.UserAuthMethodTypePassword)
 		case OTP:
 			authMethods = append(authMethods, domain.UserAuthMethodTypeOTP)
+		case SoftwareKey:
+			authMethods = append(authMethods, domain.UserAuthMethodTypePush)
 		case UserPresence:
 			userPresence = true
 		case MFA:
@@ -73,6 +73,13 @@ func TestAMR(t *testing.T) {
 			},
 			[]string{OTP, MFA},
 		},
+		{
+			"push checked",
+			args{
+				[]domain.UserAuthMethodType{domain.UserAuthMethodTypePush},
+			},
+			[]string{SoftwareKey},
+		},
 		{
 			"multiple checked",
 			args{
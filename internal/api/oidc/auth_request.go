@@ -65,21 +65,26 @@ func (o *OPStorage) createAuthRequestLoginClient(ctx context.Context, req *oidc.
 	if err != nil {
 		return nil, err
 	}
+	requiredLevelOfAssurance, err := o.requiredLevelOfAssurance(ctx, req.ClientID, req.ACRValues)
+	if err != nil {
+		return nil, err
+	}
 	authRequest := &command.AuthRequest{
-		LoginClient:      loginClient,
-		ClientID:         req.ClientID,
-		RedirectURI:      req.RedirectURI,
-		State:            req.State,
-		Nonce:            req.Nonce,
-		Scope:            scope,
-		Audience:         audience,
-		NeedRefreshToken: slices.Contains(scope, oidc.ScopeOfflineAccess),
-		ResponseType:     ResponseTypeToBusiness(req.ResponseType),
-		ResponseMode:     ResponseModeToBusiness(req.ResponseMode),
-		CodeChallenge:    CodeChallengeToBusiness(req.CodeChallenge, req.CodeChallengeMethod),
-		Prompt:           PromptToBusiness(req.Prompt),
-		UILocales:        UILocalesToBusiness(req.UILocales),
-		MaxAge:           MaxAgeToBusiness(req.MaxAge),
+		LoginClient:              loginClient,
+		ClientID:                 req.ClientID,
+		RedirectURI:              req.RedirectURI,
+		State:                    req.State,
+		Nonce:                    req.Nonce,
+		Scope:                    scope,
+		Audience:                 audience,
+		NeedRefreshToken:         slices.Contains(scope, oidc.ScopeOfflineAccess),
+		ResponseType:             ResponseTypeToBusiness(req.ResponseType),
+		ResponseMode:             ResponseModeToBusiness(req.ResponseMode),
+		CodeChallenge:            CodeChallengeToBusiness(req.CodeChallenge, req.CodeChallengeMethod),
+		Prompt:                   PromptToBusiness(req.Prompt),
+		UILocales:                UILocalesToBusiness(req.UILocales),
+		MaxAge:                   MaxAgeToBusiness(req.MaxAge),
+		RequiredLevelOfAssurance: requiredLevelOfAssurance,
 	}
 	if req.LoginHint != "" {
 		authRequest.LoginHint = &req.LoginHint
@@ -92,7 +97,7 @@ func (o *OPStorage) createAuthRequestLoginClient(ctx context.Context, req *oidc.
 	if err != nil {
 		return nil, err
 	}
-	return &AuthRequestV2{aar}, nil
+	return &AuthRequestV2{CurrentAuthRequest: aar, acr: o.acr}, nil
 }
 
 func (o *OPStorage) createAuthRequest(ctx context.Context, req *oidc.AuthRequest, userID string) (_ op.AuthRequest, err error) {
@@ -106,11 +111,16 @@ func (o *OPStorage) createAuthRequest(ctx context.Context, req *oidc.AuthRequest
 	}
 	req.Scopes = scope
 	authRequest := CreateAuthRequestToBusiness(ctx, req, userAgentID, userID, audience)
+	authRequest.PossibleLOAs = o.acr.LevelsFromValues(req.ACRValues)
+	authRequest.RequiredLevelOfAssurance, err = o.requiredLevelOfAssurance(ctx, req.ClientID, req.ACRValues)
+	if err != nil {
+		return nil, err
+	}
 	resp, err := o.repo.CreateAuthRequest(ctx, authRequest)
 	if err != nil {
 		return nil, err
 	}
-	return AuthRequestFromBusiness(resp)
+	return AuthRequestFromBusiness(resp, o.acr)
 }
 
 func (o *OPStorage) audienceFromProjectID(ctx context.Context, projectID string) ([]string, error) {
@@ -138,7 +148,7 @@ func (o *OPStorage) AuthRequestByID(ctx context.Context, id string) (_ op.AuthRe
 		if err != nil {
 			return nil, err
 		}
-		return &AuthRequestV2{req}, nil
+		return &AuthRequestV2{CurrentAuthRequest: req, acr: o.acr}, nil
 	}
 
 	userAgentID, ok := middleware.UserAgentIDFromCtx(ctx)
@@ -149,7 +159,7 @@ func (o *OPStorage) AuthRequestByID(ctx context.Context, id string) (_ op.AuthRe
 	if err != nil {
 		return nil, err
 	}
-	return AuthRequestFromBusiness(resp)
+	return AuthRequestFromBusiness(resp, o.acr)
 }
 
 func (o *OPStorage) AuthRequestByCode(ctx context.Context, code string) (_ op.AuthRequest, err error) {
@@ -20,15 +20,16 @@ import (
 
 type AuthRequest struct {
 	*domain.AuthRequest
+	acr ACRConfig
 }
 
 func (a *AuthRequest) GetID() string {
 	return a.ID
 }
 
+// GetACR returns the value of the level of assurance achieved by the authentication, as it is set in the tokens.
 func (a *AuthRequest) GetACR() string {
-	// return a.
-	return "" //PLANNED: impl
+	return a.acr.Achieved(a.AuthMethods())
 }
 
 func (a *AuthRequest) GetAMR() []string {
@@ -95,11 +96,11 @@ func (a *AuthRequest) oidc() *domain.AuthRequestOIDC {
 	return a.Request.(*domain.AuthRequestOIDC)
 }
 
-func AuthRequestFromBusiness(authReq *domain.AuthRequest) (_ *AuthRequest, err error) {
+func AuthRequestFromBusiness(authReq *domain.AuthRequest, acr ACRConfig) (_ *AuthRequest, err error) {
 	if _, ok := authReq.Request.(*domain.AuthRequestOIDC); !ok {
 		return nil, zerrors.ThrowInvalidArgument(nil, "OIDC-Haz7A", "auth request is not of type oidc")
 	}
-	return &AuthRequest{authReq}, nil
+	return &AuthRequest{AuthRequest: authReq, acr: acr}, nil
 }
 
 func CreateAuthRequestToBusiness(ctx context.Context, authReq *oidc.AuthRequest, userAgentID, userID string, audience []string) *domain.AuthRequest {
@@ -111,7 +112,6 @@ func CreateAuthRequestToBusiness(ctx context.Context, authReq *oidc.AuthRequest,
 		CallbackURI:         authReq.RedirectURI,
 		TransferState:       authReq.State,
 		Prompt:              PromptToBusiness(authReq.Prompt),
-		PossibleLOAs:        ACRValuesToBusiness(authReq.ACRValues),
 		UiLocales:           UILocalesToBusiness(authReq.UILocales),
 		LoginHint:           authReq.LoginHint,
 		SelectedIDPConfigID: GetSelectedIDPIDFromScopes(authReq.Scopes),
@@ -176,10 +176,6 @@ func PromptToBusiness(oidcPrompt []string) []domain.Prompt {
 	return prompts
 }
 
-func ACRValuesToBusiness(values []string) []domain.LevelOfAssurance {
-	return nil
-}
-
 func UILocalesToBusiness(tags []language.Tag) []string {
 	if tags == nil {
 		return nil
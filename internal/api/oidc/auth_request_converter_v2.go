@@ -10,14 +10,21 @@ import (
 
 type AuthRequestV2 struct {
 	*command.CurrentAuthRequest
+	acr ACRConfig
+}
+
+// AuthRequestV2 wraps the auth request, so the ACR is reported with the configuration of the server.
+func (s *Server) AuthRequestV2(aar *command.CurrentAuthRequest) *AuthRequestV2 {
+	return &AuthRequestV2{CurrentAuthRequest: aar, acr: s.acr}
 }
 
 func (a *AuthRequestV2) GetID() string {
 	return a.ID
 }
 
+// GetACR returns the value of the level of assurance achieved by the authentication, as it is set in the tokens.
 func (a *AuthRequestV2) GetACR() string {
-	return "" //PLANNED: impl
+	return a.acr.Achieved(a.AuthMethods)
 }
 
 func (a *AuthRequestV2) GetAMR() []string {
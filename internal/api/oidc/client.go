@@ -39,6 +39,8 @@ const (
 	ClaimResourceOwnerID            = ScopeResourceOwner + ":id"
 	ClaimResourceOwnerName          = ScopeResourceOwner + ":name"
 	ClaimResourceOwnerPrimaryDomain = ScopeResourceOwner + ":primary_domain"
+	ScopeUserGroups                 = "urn:zitadel:iam:user:groups"
+	ClaimUserGroups                 = ScopeUserGroups
 	ClaimActionLogFormat            = "urn:zitadel:iam:action:%s:log"
 
 	oidcCtx = "oidc"
@@ -133,7 +135,7 @@ func (o *OPStorage) SetUserinfoFromToken(ctx context.Context, userInfo *oidc.Use
 		if err = o.isOriginAllowed(ctx, token.ClientID, origin); err != nil {
 			return err
 		}
-		return o.setUserinfo(ctx, userInfo, token.UserID, token.ClientID, token.Scope, nil)
+		return o.setUserinfo(ctx, userInfo, token.UserID, token.ClientID, token.Scope, nil, roleConditionContext(ctx, token.AuthMethods, token.UserAgent))
 	}
 
 	token, err := o.repo.TokenByIDs(ctx, subject, tokenID)
@@ -145,7 +147,11 @@ func (o *OPStorage) SetUserinfoFromToken(ctx context.Context, userInfo *oidc.Use
 			return err
 		}
 	}
-	return o.setUserinfo(ctx, userInfo, token.UserID, token.ApplicationID, token.Scopes, nil)
+	authMethods, err := o.userSessionAuthMethods(ctx, token.UserAgentID, token.UserID)
+	if err != nil {
+		return err
+	}
+	return o.setUserinfo(ctx, userInfo, token.UserID, token.ApplicationID, token.Scopes, nil, roleConditionContext(ctx, authMethods, nil))
 }
 
 func (o *OPStorage) SetUserinfoFromScopes(ctx context.Context, userInfo *oidc.UserInfo, userID, applicationID string, scopes []string) (err error) {
@@ -166,7 +172,9 @@ func (o *OPStorage) SetUserinfoFromScopes(ctx context.Context, userInfo *oidc.Us
 			}
 		}
 	}
-	return o.setUserinfo(ctx, userInfo, userID, applicationID, scopes, nil)
+	// the id_token is created by the token endpoint, where the session isn't known,
+	// so roles with conditions on the authentication methods are not asserted
+	return o.setUserinfo(ctx, userInfo, userID, applicationID, scopes, nil, roleConditionContext(ctx, nil, nil))
 }
 
 // SetUserinfoFromRequest extends the SetUserinfoFromScopes during the id_token generation.
@@ -200,7 +208,8 @@ func (o *OPStorage) SetIntrospectionFromToken(ctx context.Context, introspection
 		return o.introspect(ctx, introspection,
 			tokenID, token.UserID, token.ClientID, clientID, projectID,
 			token.Audience, token.Scope,
-			token.AccessTokenCreation, token.AccessTokenExpiration)
+			token.AccessTokenCreation, token.AccessTokenExpiration,
+			roleConditionContext(ctx, token.AuthMethods, token.UserAgent))
 	}
 
 	token, err := o.repo.TokenByIDs(ctx, subject, tokenID)
@@ -217,10 +226,15 @@ func (o *OPStorage) SetIntrospectionFromToken(ctx context.Context, introspection
 			return zerrors.ThrowPreconditionFailed(err, "OIDC-AGefw", "Errors.Internal")
 		}
 	}
+	authMethods, err := o.userSessionAuthMethods(ctx, token.UserAgentID, token.UserID)
+	if err != nil {
+		return err
+	}
 	return o.introspect(ctx, introspection,
 		token.ID, token.UserID, token.ApplicationID, clientID, projectID,
 		token.Audience, token.Scopes,
-		token.CreationDate, token.Expiration)
+		token.CreationDate, token.Expiration,
+		roleConditionContext(ctx, authMethods, nil))
 }
 
 func (o *OPStorage) ClientCredentialsTokenRequest(ctx context.Context, clientID string, scope []string) (_ op.TokenRequest, err error) {
@@ -273,6 +287,7 @@ func (o *OPStorage) introspect(
 	tokenID, subject, tokenClientID, introspectionClientID, introspectionProjectID string,
 	audience, scope []string,
 	tokenCreation, tokenExpiration time.Time,
+	roleSession *domain.RoleConditionContext,
 ) (err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
@@ -280,7 +295,7 @@ func (o *OPStorage) introspect(
 	for _, aud := range audience {
 		if aud == introspectionClientID || aud == introspectionProjectID {
 			userInfo := new(oidc.UserInfo)
-			err = o.setUserinfo(ctx, userInfo, subject, introspectionClientID, scope, []string{introspectionProjectID})
+			err = o.setUserinfo(ctx, userInfo, subject, introspectionClientID, scope, []string{introspectionProjectID}, roleSession)
 			if err != nil {
 				return err
 			}
@@ -326,7 +341,7 @@ func (o *OPStorage) checkOrgScopes(ctx context.Context, user *query.User, scopes
 	return scopes, nil
 }
 
-func (o *OPStorage) setUserinfo(ctx context.Context, userInfo *oidc.UserInfo, userID, applicationID string, scopes []string, roleAudience []string) (err error) {
+func (o *OPStorage) setUserinfo(ctx context.Context, userInfo *oidc.UserInfo, userID, applicationID string, scopes []string, roleAudience []string, roleSession *domain.RoleConditionContext) (err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 	user, err := o.query.GetUserByID(ctx, true, userID)
@@ -378,7 +393,7 @@ func (o *OPStorage) setUserinfo(ctx context.Context, userInfo *oidc.UserInfo, us
 		roleAudience = domain.AddAudScopeToAudience(ctx, roleAudience, scopes)
 	}
 
-	userGrants, projectRoles, err := o.assertRoles(ctx, userID, applicationID, roles, roleAudience)
+	userGrants, projectRoles, err := o.assertRoles(ctx, userID, applicationID, roles, roleAudience, roleSession)
 	if err != nil {
 		return err
 	}
@@ -646,7 +661,9 @@ func (o *OPStorage) GetPrivateClaimsFromScopes(ctx context.Context, userID, clie
 		roleAudience = domain.AddAudScopeToAudience(ctx, roleAudience, scopes)
 	}
 
-	userGrants, projectRoles, err := o.assertRoles(ctx, userID, clientID, roles, roleAudience)
+	// the private claims are created by the token endpoint, where the session isn't known,
+	// so roles with conditions on the authentication methods are not asserted
+	userGrants, projectRoles, err := o.assertRoles(ctx, userID, clientID, roles, roleAudience, roleConditionContext(ctx, nil, nil))
 	if err != nil {
 		return nil, err
 	}
@@ -789,7 +806,7 @@ func (o *OPStorage) privateClaimsFlows(ctx context.Context, userID string, userG
 	return claims, nil
 }
 
-func (o *OPStorage) assertRoles(ctx context.Context, userID, applicationID string, requestedRoles, roleAudience []string) (*query.UserGrants, *projectsRoles, error) {
+func (o *OPStorage) assertRoles(ctx context.Context, userID, applicationID string, requestedRoles, roleAudience []string, roleSession *domain.RoleConditionContext) (*query.UserGrants, *projectsRoles, error) {
 	if (applicationID == "" || len(requestedRoles) == 0) && len(roleAudience) == 0 {
 		return nil, nil, nil
 	}
@@ -819,6 +836,9 @@ func (o *OPStorage) assertRoles(ctx context.Context, userID, applicationID strin
 	if err != nil {
 		return nil, nil, err
 	}
+	if err = o.query.FilterUserGrantRoles(ctx, roleSession, grants.UserGrants...); err != nil {
+		return nil, nil, err
+	}
 	roles := new(projectsRoles)
 	// if specific roles where requested, check if they are granted and append them in the roles list
 	if len(requestedRoles) > 0 {
@@ -251,6 +251,9 @@ func isScopeAllowed(scope string, allowedScopes ...string) bool {
 	if scope == ScopeResourceOwner {
 		return true
 	}
+	if scope == ScopeUserGroups {
+		return true
+	}
 	if scope == ScopeProjectsRoles {
 		return true
 	}
@@ -0,0 +1,41 @@
+package oidc
+
+import (
+	"context"
+	"net"
+	"time"
+
+	http_utils "github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// roleConditionContext describes the session for which the project roles are asserted.
+// The ip of the session's user agent is preferred over the ip of the current request,
+// as tokens and userinfo might be requested by a backend of the client.
+func roleConditionContext(ctx context.Context, authMethods []domain.UserAuthMethodType, userAgent *domain.UserAgent) *domain.RoleConditionContext {
+	session := &domain.RoleConditionContext{
+		AuthMethods: authMethods,
+		AMR:         AuthMethodTypesToAMR(authMethods),
+		Time:        time.Now(),
+	}
+	if userAgent != nil && len(userAgent.IP) > 0 {
+		session.IP = userAgent.IP
+	} else {
+		session.IP = net.ParseIP(http_utils.RemoteIPFromCtx(ctx))
+	}
+	return session
+}
+
+// userSessionAuthMethods returns the authentication methods of the (login v1) user session a token was issued for.
+// Tokens without a user session, e.g. personal access tokens, have none.
+func (o *OPStorage) userSessionAuthMethods(ctx context.Context, agentID, userID string) ([]domain.UserAuthMethodType, error) {
+	if agentID == "" {
+		return nil, nil
+	}
+	authMethods, err := o.repo.UserSessionAuthMethods(ctx, agentID, userID)
+	if zerrors.IsNotFound(err) {
+		return nil, nil
+	}
+	return authMethods, err
+}
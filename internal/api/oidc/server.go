@@ -41,6 +41,7 @@ type Server struct {
 	opCrypto            op.Crypto
 
 	assetAPIPrefix func(ctx context.Context) string
+	acr            ACRConfig
 }
 
 func endpoints(endpointConfig *EndpointConfig) op.Endpoints {
@@ -203,6 +204,7 @@ func (s *Server) createDiscoveryConfig(ctx context.Context, supportedUILocales o
 		CodeChallengeMethodsSupported:                      op.CodeChallengeMethods(s.Provider()),
 		UILocalesSupported:                                 supportedUILocales,
 		RequestParameterSupported:                          s.Provider().RequestObjectSupported(),
+		ACRValuesSupported:                                 s.acr.Supported(),
 	}
 }
 
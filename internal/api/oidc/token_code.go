@@ -100,7 +100,7 @@ func (s *Server) getAuthRequestV1ByCode(ctx context.Context, code string) (*Auth
 	if err != nil {
 		return nil, err
 	}
-	return AuthRequestFromBusiness(authReq)
+	return AuthRequestFromBusiness(authReq, s.acr)
 }
 
 func (s *Server) getAuthRequestV1ByID(ctx context.Context, id string) (*AuthRequest, error) {
@@ -112,7 +112,7 @@ func (s *Server) getAuthRequestV1ByID(ctx context.Context, id string) (*AuthRequ
 	if err != nil {
 		return nil, err
 	}
-	return AuthRequestFromBusiness(resp)
+	return AuthRequestFromBusiness(resp, s.acr)
 }
 
 func codeExchangeComplianceChecker(client *Client, req *oidc.AccessTokenRequest) command.AuthRequestComplianceChecker {
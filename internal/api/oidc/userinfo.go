@@ -64,6 +64,7 @@ func (s *Server) UserInfo(ctx context.Context, r *op.Request[oidc.UserInfoReques
 		assertion,
 		true,
 		false,
+		roleConditionContext(ctx, token.authMethods, token.userAgent),
 	)(ctx, true, domain.TriggerTypePreUserinfoCreation)
 	if err != nil {
 		return nil, err
@@ -91,6 +92,7 @@ func (s *Server) userInfo(
 	scope []string,
 	projectID string,
 	projectRoleAssertion, userInfoAssertion, currentProjectOnly bool,
+	roleSession *domain.RoleConditionContext,
 ) func(ctx context.Context, roleAssertion bool, triggerType domain.TriggerType) (_ *oidc.UserInfo, err error) {
 	var (
 		once                         sync.Once
@@ -109,6 +111,14 @@ func (s *Server) userInfo(
 			if err != nil {
 				return
 			}
+			// roles whose conditions are not satisfied by the session are neither asserted nor passed to the actions
+			grants := make([]*query.UserGrant, len(qu.UserGrants))
+			for i := range qu.UserGrants {
+				grants[i] = &qu.UserGrants[i]
+			}
+			if err = s.query.FilterUserGrantRoles(ctx, roleSession, grants...); err != nil {
+				return
+			}
 			rawUserInfo = userInfoToOIDC(qu, userInfoAssertion, scope, s.assetAPIPrefix(ctx))
 		})
 		if err != nil {
@@ -192,6 +202,8 @@ func userInfoToOIDC(user *query.OIDCUserInfo, userInfoAssertion bool, scope []st
 			setUserInfoMetadata(user.Metadata, out)
 		case ScopeResourceOwner:
 			setUserInfoOrgClaims(user, out)
+		case ScopeUserGroups:
+			setUserInfoGroups(user.Groups, out)
 		default:
 			if claim, ok := strings.CutPrefix(s, domain.OrgDomainPrimaryScope); ok {
 				out.AppendClaims(domain.OrgDomainPrimaryClaim, claim)
@@ -270,6 +282,18 @@ func setUserInfoMetadata(metadata []query.UserMetadata, out *oidc.UserInfo) {
 	out.AppendClaims(ClaimUserMetaData, mdmap)
 }
 
+// setUserInfoGroups sets the groups the user is a member of as map of the group ID to its name.
+func setUserInfoGroups(groups []query.UserInfoGroup, out *oidc.UserInfo) {
+	if len(groups) == 0 {
+		return
+	}
+	groupMap := make(map[string]string, len(groups))
+	for _, group := range groups {
+		groupMap[group.ID] = group.Name
+	}
+	out.AppendClaims(ClaimUserGroups, groupMap)
+}
+
 func setUserInfoOrgClaims(user *query.OIDCUserInfo, out *oidc.UserInfo) {
 	if org := user.Org; org != nil {
 		out.AppendClaims(ClaimResourceOwnerID, org.ID)
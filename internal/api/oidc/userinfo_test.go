@@ -226,6 +226,9 @@ func Test_userInfoToOIDC(t *testing.T) {
 				UserResourceOwner: "org1",
 			},
 		},
+		Groups: []query.UserInfoGroup{
+			{ID: "group1", Name: "developers"},
+		},
 	}
 	machineUserInfo := &query.OIDCUserInfo{
 		User: &query.User{
@@ -454,6 +457,28 @@ func Test_userInfoToOIDC(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "human, scope groups",
+			args: args{
+				user:  humanUserInfo,
+				scope: []string{ScopeUserGroups},
+			},
+			want: &oidc.UserInfo{
+				Claims: map[string]any{
+					ClaimUserGroups: map[string]string{
+						"group1": "developers",
+					},
+				},
+			},
+		},
+		{
+			name: "machine, scope groups, none found",
+			args: args{
+				user:  machineUserInfo,
+				scope: []string{ScopeUserGroups},
+			},
+			want: &oidc.UserInfo{},
+		},
 		{
 			name: "human, scope org primary domain prefix",
 			args: args{
@@ -3,6 +3,7 @@ package saml
 import (
 	"context"
 	"encoding/json"
+	"net"
 	"time"
 
 	"github.com/dop251/goja"
@@ -16,7 +17,9 @@ import (
 	"github.com/zitadel/zitadel/internal/actions"
 	"github.com/zitadel/zitadel/internal/actions/object"
 	"github.com/zitadel/zitadel/internal/activity"
+	http_utils "github.com/zitadel/zitadel/internal/api/http"
 	"github.com/zitadel/zitadel/internal/api/http/middleware"
+	z_oidc "github.com/zitadel/zitadel/internal/api/oidc"
 	"github.com/zitadel/zitadel/internal/auth/repository"
 	"github.com/zitadel/zitadel/internal/command"
 	"github.com/zitadel/zitadel/internal/crypto"
@@ -330,12 +333,43 @@ func (p *Storage) getGrants(ctx context.Context, userID, applicationID string) (
 	if err != nil {
 		return nil, err
 	}
-	return p.query.UserGrants(ctx, &query.UserGrantsQueries{
+	grants, err := p.query.UserGrants(ctx, &query.UserGrantsQueries{
 		Queries: []query.SearchQuery{
 			projectQuery,
 			userIDQuery,
 		},
 	}, true)
+	if err != nil {
+		return nil, err
+	}
+	authMethods, err := p.userSessionAuthMethods(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	err = p.query.FilterUserGrantRoles(ctx, &domain.RoleConditionContext{
+		AuthMethods: authMethods,
+		AMR:         z_oidc.AuthMethodTypesToAMR(authMethods),
+		IP:          net.ParseIP(http_utils.RemoteIPFromCtx(ctx)),
+		Time:        time.Now(),
+	}, grants.UserGrants...)
+	if err != nil {
+		return nil, err
+	}
+	return grants, nil
+}
+
+// userSessionAuthMethods returns the authentication methods of the user's session on the current user agent,
+// which the response is created for.
+func (p *Storage) userSessionAuthMethods(ctx context.Context, userID string) ([]domain.UserAuthMethodType, error) {
+	userAgentID, ok := middleware.UserAgentIDFromCtx(ctx)
+	if !ok {
+		return nil, nil
+	}
+	authMethods, err := p.repo.UserSessionAuthMethods(ctx, userAgentID, userID)
+	if zerrors.IsNotFound(err) {
+		return nil, nil
+	}
+	return authMethods, err
 }
 
 type customAttribute struct {
@@ -19,15 +19,20 @@ import (
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
 	"github.com/zitadel/zitadel/internal/idp"
+	"github.com/zitadel/zitadel/internal/idp/providers/adfs"
 	"github.com/zitadel/zitadel/internal/idp/providers/apple"
 	"github.com/zitadel/zitadel/internal/idp/providers/azuread"
+	"github.com/zitadel/zitadel/internal/idp/providers/bitbucket"
+	"github.com/zitadel/zitadel/internal/idp/providers/discord"
 	"github.com/zitadel/zitadel/internal/idp/providers/github"
 	"github.com/zitadel/zitadel/internal/idp/providers/gitlab"
 	"github.com/zitadel/zitadel/internal/idp/providers/google"
 	"github.com/zitadel/zitadel/internal/idp/providers/jwt"
+	"github.com/zitadel/zitadel/internal/idp/providers/keycloak"
 	"github.com/zitadel/zitadel/internal/idp/providers/ldap"
 	"github.com/zitadel/zitadel/internal/idp/providers/oauth"
 	openid "github.com/zitadel/zitadel/internal/idp/providers/oidc"
+	"github.com/zitadel/zitadel/internal/idp/providers/okta"
 	"github.com/zitadel/zitadel/internal/idp/providers/saml"
 	"github.com/zitadel/zitadel/internal/idp/providers/saml/requesttracker"
 	"github.com/zitadel/zitadel/internal/query"
@@ -176,6 +181,16 @@ func (l *Login) handleIDP(w http.ResponseWriter, r *http.Request, authReq *domai
 		provider, err = l.ldapProvider(r.Context(), identityProvider)
 	case domain.IDPTypeSAML:
 		provider, err = l.samlProvider(r.Context(), identityProvider)
+	case domain.IDPTypeADFS:
+		provider, err = l.adfsProvider(r.Context(), identityProvider)
+	case domain.IDPTypeKeycloak:
+		provider, err = l.keycloakProvider(r.Context(), identityProvider)
+	case domain.IDPTypeOkta:
+		provider, err = l.oktaProvider(r.Context(), identityProvider)
+	case domain.IDPTypeBitbucket:
+		provider, err = l.bitbucketProvider(r.Context(), identityProvider)
+	case domain.IDPTypeDiscord:
+		provider, err = l.discordProvider(r.Context(), identityProvider)
 	case domain.IDPTypeUnspecified:
 		fallthrough
 	default:
@@ -256,7 +271,7 @@ func (l *Login) handleExternalLoginCallback(w http.ResponseWriter, r *http.Reque
 			l.externalAuthFailed(w, r, authReq, nil, nil, err)
 			return
 		}
-		session = &oauth.Session{Provider: provider.(*oauth.Provider), Code: data.Code}
+		session = &oauth.Session{Provider: provider.(*oauth.Provider), Code: data.Code, State: data.State}
 	case domain.IDPTypeOIDC:
 		provider, err = l.oidcProvider(r.Context(), identityProvider)
 		if err != nil {
@@ -324,6 +339,41 @@ func (l *Login) handleExternalLoginCallback(w http.ResponseWriter, r *http.Reque
 			l.externalAuthFailed(w, r, authReq, nil, nil, err)
 			return
 		}
+	case domain.IDPTypeADFS:
+		provider, err = l.adfsProvider(r.Context(), identityProvider)
+		if err != nil {
+			l.externalAuthFailed(w, r, authReq, nil, nil, err)
+			return
+		}
+		session = &openid.Session{Provider: provider.(*adfs.Provider).Provider, Code: data.Code}
+	case domain.IDPTypeKeycloak:
+		provider, err = l.keycloakProvider(r.Context(), identityProvider)
+		if err != nil {
+			l.externalAuthFailed(w, r, authReq, nil, nil, err)
+			return
+		}
+		session = &openid.Session{Provider: provider.(*keycloak.Provider).Provider, Code: data.Code}
+	case domain.IDPTypeOkta:
+		provider, err = l.oktaProvider(r.Context(), identityProvider)
+		if err != nil {
+			l.externalAuthFailed(w, r, authReq, nil, nil, err)
+			return
+		}
+		session = &openid.Session{Provider: provider.(*okta.Provider).Provider, Code: data.Code}
+	case domain.IDPTypeBitbucket:
+		provider, err = l.bitbucketProvider(r.Context(), identityProvider)
+		if err != nil {
+			l.externalAuthFailed(w, r, authReq, nil, nil, err)
+			return
+		}
+		session = &bitbucket.Session{Provider: provider.(*bitbucket.Provider), Code: data.Code}
+	case domain.IDPTypeDiscord:
+		provider, err = l.discordProvider(r.Context(), identityProvider)
+		if err != nil {
+			l.externalAuthFailed(w, r, authReq, nil, nil, err)
+			return
+		}
+		session = &oauth.Session{Provider: provider.(*discord.Provider).Provider, Code: data.Code}
 	case domain.IDPTypeJWT,
 		domain.IDPTypeLDAP,
 		domain.IDPTypeUnspecified:
@@ -999,9 +1049,21 @@ func (l *Login) jwtProvider(identityProvider *query.IDPTemplate) (*jwt.Provider,
 }
 
 func (l *Login) oauthProvider(ctx context.Context, identityProvider *query.IDPTemplate) (*oauth.Provider, error) {
-	secret, err := crypto.DecryptString(identityProvider.OAuthIDPTemplate.ClientSecret, l.idpConfigAlg)
-	if err != nil {
-		return nil, err
+	var secret string
+	if identityProvider.OAuthIDPTemplate.ClientSecret != nil {
+		var err error
+		secret, err = crypto.DecryptString(identityProvider.OAuthIDPTemplate.ClientSecret, l.idpConfigAlg)
+		if err != nil {
+			return nil, err
+		}
+	}
+	opts := make([]oauth.ProviderOpts, 0, 1)
+	if identityProvider.OAuthIDPTemplate.UsePKCE {
+		codeVerifierKey, err := crypto.Decrypt(identityProvider.OAuthIDPTemplate.CodeVerifierKey, l.idpConfigAlg)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, oauth.WithPKCE(codeVerifierKey))
 	}
 	config := &oauth2.Config{
 		ClientID:     identityProvider.OAuthIDPTemplate.ClientID,
@@ -1020,6 +1082,7 @@ func (l *Login) oauthProvider(ctx context.Context, identityProvider *query.IDPTe
 		func() idp.User {
 			return oauth.NewUserMapper(identityProvider.OAuthIDPTemplate.IDAttribute)
 		},
+		opts...,
 	)
 }
 
@@ -1152,6 +1215,80 @@ func (l *Login) gitlabSelfHostedProvider(ctx context.Context, identityProvider *
 	)
 }
 
+func (l *Login) adfsProvider(ctx context.Context, identityProvider *query.IDPTemplate) (*adfs.Provider, error) {
+	secret, err := crypto.DecryptString(identityProvider.ADFSIDPTemplate.ClientSecret, l.idpConfigAlg)
+	if err != nil {
+		return nil, err
+	}
+	return adfs.New(
+		identityProvider.Name,
+		identityProvider.ADFSIDPTemplate.Issuer,
+		identityProvider.ADFSIDPTemplate.ClientID,
+		secret,
+		l.baseURL(ctx)+EndpointExternalLoginCallback,
+		identityProvider.ADFSIDPTemplate.Scopes,
+		openid.WithSelectAccount(),
+	)
+}
+
+func (l *Login) keycloakProvider(ctx context.Context, identityProvider *query.IDPTemplate) (*keycloak.Provider, error) {
+	secret, err := crypto.DecryptString(identityProvider.KeycloakIDPTemplate.ClientSecret, l.idpConfigAlg)
+	if err != nil {
+		return nil, err
+	}
+	return keycloak.New(
+		identityProvider.Name,
+		identityProvider.KeycloakIDPTemplate.Issuer,
+		identityProvider.KeycloakIDPTemplate.ClientID,
+		secret,
+		l.baseURL(ctx)+EndpointExternalLoginCallback,
+		identityProvider.KeycloakIDPTemplate.Scopes,
+		openid.WithSelectAccount(),
+	)
+}
+
+func (l *Login) oktaProvider(ctx context.Context, identityProvider *query.IDPTemplate) (*okta.Provider, error) {
+	secret, err := crypto.DecryptString(identityProvider.OktaIDPTemplate.ClientSecret, l.idpConfigAlg)
+	if err != nil {
+		return nil, err
+	}
+	return okta.New(
+		identityProvider.Name,
+		identityProvider.OktaIDPTemplate.Issuer,
+		identityProvider.OktaIDPTemplate.ClientID,
+		secret,
+		l.baseURL(ctx)+EndpointExternalLoginCallback,
+		identityProvider.OktaIDPTemplate.Scopes,
+		openid.WithSelectAccount(),
+	)
+}
+
+func (l *Login) bitbucketProvider(ctx context.Context, identityProvider *query.IDPTemplate) (*bitbucket.Provider, error) {
+	secret, err := crypto.DecryptString(identityProvider.BitbucketIDPTemplate.ClientSecret, l.idpConfigAlg)
+	if err != nil {
+		return nil, err
+	}
+	return bitbucket.New(
+		identityProvider.BitbucketIDPTemplate.ClientID,
+		secret,
+		l.baseURL(ctx)+EndpointExternalLoginCallback,
+		identityProvider.BitbucketIDPTemplate.Scopes,
+	)
+}
+
+func (l *Login) discordProvider(ctx context.Context, identityProvider *query.IDPTemplate) (*discord.Provider, error) {
+	secret, err := crypto.DecryptString(identityProvider.DiscordIDPTemplate.ClientSecret, l.idpConfigAlg)
+	if err != nil {
+		return nil, err
+	}
+	return discord.New(
+		identityProvider.DiscordIDPTemplate.ClientID,
+		secret,
+		l.baseURL(ctx)+EndpointExternalLoginCallback,
+		identityProvider.DiscordIDPTemplate.Scopes,
+	)
+}
+
 func (l *Login) appleProvider(ctx context.Context, identityProvider *query.IDPTemplate) (*apple.Provider, error) {
 	privateKey, err := crypto.Decrypt(identityProvider.AppleIDPTemplate.PrivateKey, l.idpConfigAlg)
 	if err != nil {
@@ -1202,6 +1339,8 @@ func tokens(session idp.Session) *oidc.Tokens[*oidc.IDTokenClaims] {
 		return s.Tokens
 	case *azuread.Session:
 		return s.Tokens()
+	case *bitbucket.Session:
+		return s.Tokens()
 	case *apple.Session:
 		return s.Tokens
 	}
@@ -82,6 +82,16 @@ func (l *Login) handleLoginNameCheck(w http.ResponseWriter, r *http.Request) {
 	}
 	userAgentID, _ := http_mw.UserAgentIDFromCtx(r.Context())
 	loginName := data.LoginName
+	// redirect directly to the identity provider the domain of the login name is routed to
+	idpID, err := l.authRepo.CheckDomainIDP(r.Context(), authReq.ID, loginName, userAgentID)
+	if err != nil {
+		l.renderLogin(w, r, authReq, err)
+		return
+	}
+	if idpID != "" {
+		l.handleIDP(w, r, authReq, idpID)
+		return
+	}
 	err = l.authRepo.CheckLoginName(r.Context(), authReq.ID, loginName, userAgentID)
 	if err != nil {
 		l.renderLogin(w, r, authReq, err)
@@ -38,6 +38,9 @@ type registerData struct {
 	ShowUsername       bool
 	ShowUsernameSuffix bool
 	OrgRegister        bool
+	// PasskeyRegistration is set if the login policy forces the registration of a passkey,
+	// in which case no password is set on registration.
+	PasskeyRegistration bool
 }
 
 func (l *Login) handleRegister(w http.ResponseWriter, r *http.Request) {
@@ -57,17 +60,25 @@ func (l *Login) handleRegisterCheck(w http.ResponseWriter, r *http.Request) {
 		l.renderError(w, r, authRequest, err)
 		return
 	}
-	if data.Password != data.Password2 {
-		err := zerrors.ThrowInvalidArgument(nil, "VIEW-KaGue", "Errors.User.Password.ConfirmationWrong")
-		l.renderRegister(w, r, authRequest, data, err)
-		return
-	}
-
 	resourceOwner := authz.GetInstance(r.Context()).DefaultOrganisationID()
 
 	if authRequest != nil && authRequest.RequestedOrgID != "" && authRequest.RequestedOrgID != resourceOwner {
 		resourceOwner = authRequest.RequestedOrgID
 	}
+	loginPolicy, err := l.getLoginPolicy(r, resourceOwner)
+	if err != nil {
+		l.renderRegister(w, r, authRequest, data, err)
+		return
+	}
+	passkeyRegistration := loginPolicy.ForcePasskeyRegistration
+	if !passkeyRegistration && data.Password != data.Password2 {
+		err := zerrors.ThrowInvalidArgument(nil, "VIEW-KaGue", "Errors.User.Password.ConfirmationWrong")
+		l.renderRegister(w, r, authRequest, data, err)
+		return
+	}
+	if passkeyRegistration {
+		data.Password, data.Password2 = "", ""
+	}
 	// For consistency with the external authentication flow,
 	// the setMetadata() function is provided on the pre creation hook, for now,
 	// like for the ExternalAuthentication flow.
@@ -83,6 +94,11 @@ func (l *Login) handleRegisterCheck(w http.ResponseWriter, r *http.Request) {
 	}
 
 	human := command.AddHumanFromDomain(user, metadatas, authRequest, nil)
+	if passkeyRegistration {
+		// the passkey registration link is sent to the user instead of setting a password
+		human.Password = ""
+		human.Passkey = &command.AddHumanPasskey{}
+	}
 	err = l.command.AddUserHuman(setContext(r.Context(), resourceOwner), resourceOwner, human, true, l.userCodeAlg)
 	if err != nil {
 		l.renderRegister(w, r, authRequest, data, err)
@@ -172,6 +188,13 @@ func (l *Login) renderRegister(w http.ResponseWriter, r *http.Request, authReque
 	}
 	data.ShowUsernameSuffix = !labelPolicy.HideLoginNameSuffix
 
+	loginPolicy, err := l.getLoginPolicy(r, resourceOwner)
+	if err != nil {
+		l.renderRegister(w, r, authRequest, formData, err)
+		return
+	}
+	data.PasskeyRegistration = loginPolicy.ForcePasskeyRegistration
+
 	funcs := map[string]interface{}{
 		"selectedLanguage": func(l string) bool {
 			if formData == nil {
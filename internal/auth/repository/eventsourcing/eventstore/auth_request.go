@@ -52,6 +52,7 @@ type AuthRequestRepo struct {
 	ProjectProvider           projectProvider
 	ApplicationProvider       applicationProvider
 	CustomTextProvider        customTextProvider
+	BreakGlassPolicyProvider  breakGlassPolicyProvider
 
 	IdGenerator id.Generator
 }
@@ -121,6 +122,10 @@ type applicationProvider interface {
 	AppByOIDCClientID(context.Context, string) (*query.App, error)
 }
 
+type breakGlassPolicyProvider interface {
+	BreakGlassPolicyByUser(ctx context.Context, userID string) (*domain.BreakGlassPolicy, error)
+}
+
 type customTextProvider interface {
 	CustomTextListByTemplate(ctx context.Context, aggregateID string, text string, withOwnerRemoved bool) (texts *query.CustomTexts, err error)
 }
@@ -236,6 +241,22 @@ func (repo *AuthRequestRepo) CheckLoginName(ctx context.Context, id, loginName,
 	return repo.AuthRequests.UpdateAuthRequest(ctx, request)
 }
 
+// CheckDomainIDP checks if the domain of the login name is routed to an identity provider (home-realm discovery).
+// If so, the identity provider is selected on the auth request and its id is returned.
+func (repo *AuthRequestRepo) CheckDomainIDP(ctx context.Context, id, loginName, userAgentID string) (_ string, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+	request, err := repo.getAuthRequest(ctx, id, userAgentID)
+	if err != nil {
+		return "", err
+	}
+	ok, err := repo.checkDomainIDP(ctx, request, loginName)
+	if err != nil || !ok {
+		return "", err
+	}
+	return request.SelectedIDPConfigID, repo.AuthRequests.UpdateAuthRequest(ctx, request)
+}
+
 func (repo *AuthRequestRepo) SelectExternalIDP(ctx context.Context, authReqID, idpConfigID, userAgentID string) (err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
@@ -829,6 +850,45 @@ func (repo *AuthRequestRepo) checkDomainDiscovery(ctx context.Context, request *
 	return true, nil
 }
 
+func (repo *AuthRequestRepo) checkDomainIDP(ctx context.Context, request *domain.AuthRequest, loginName string) (bool, error) {
+	loginName = strings.TrimSpace(strings.ToLower(loginName))
+	domainIDP, err := repo.Query.DomainIDPByLoginName(ctx, loginName)
+	if zerrors.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	// never leave an explicitly requested organization
+	if request.RequestedOrgID != "" && request.RequestedOrgID != domainIDP.OrgID {
+		return false, nil
+	}
+	// the identity provider must be allowed by the login policy of the organization owning the domain
+	policy, idpProviders, err := repo.getLoginPolicyAndIDPProviders(ctx, domainIDP.OrgID)
+	if err != nil {
+		return false, err
+	}
+	if !policy.AllowExternalIDPs || !slices.ContainsFunc(idpProviders, func(provider *domain.IDPProvider) bool {
+		return provider.IDPConfigID == domainIDP.IDPID
+	}) {
+		return false, nil
+	}
+	if request.RequestedOrgID == "" {
+		org, err := repo.Query.OrgByID(ctx, false, domainIDP.OrgID)
+		if err != nil {
+			return false, err
+		}
+		// also ensure that the policies are read from the org
+		request.SetOrgInformation(org.ID, org.Name, org.Domain, false)
+		if err = repo.fillPolicies(ctx, request); err != nil {
+			return false, err
+		}
+	}
+	request.SelectedIDPConfigID = domainIDP.IDPID
+	request.LoginHint = loginName
+	return true, nil
+}
+
 func (repo *AuthRequestRepo) checkLoginNameInput(ctx context.Context, request *domain.AuthRequest, loginNameInput, preferredLoginName string) (*user_view_model.UserView, error) {
 	// always check the preferred / suffixed loginname first
 	user, err := repo.View.UserByLoginName(ctx, preferredLoginName, request.InstanceID)
@@ -948,6 +1008,7 @@ func queryLoginPolicyToDomain(policy *query.LoginPolicy) *domain.LoginPolicy {
 		MultiFactorCheckLifetime:   time.Duration(policy.MultiFactorCheckLifetime),
 		DisableLoginWithEmail:      policy.DisableLoginWithEmail,
 		DisableLoginWithPhone:      policy.DisableLoginWithPhone,
+		ForcePasskeyRegistration:   policy.ForcePasskeyRegistration,
 	}
 }
 
@@ -1030,6 +1091,10 @@ func (repo *AuthRequestRepo) nextSteps(ctx context.Context, request *domain.Auth
 	}
 	request.DisplayName = userSession.DisplayName
 	request.AvatarKey = userSession.AvatarKey
+	request.BreakGlassPolicy, err = repo.BreakGlassPolicyProvider.BreakGlassPolicyByUser(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
 	if user.HumanView != nil && user.HumanView.PreferredLanguage != "" {
 		request.PreferredLanguage = gu.Ptr(language.Make(user.HumanView.PreferredLanguage))
 	}
@@ -1039,7 +1104,8 @@ func (repo *AuthRequestRepo) nextSteps(ctx context.Context, request *domain.Auth
 	if err != nil {
 		return nil, err
 	}
-	if (!isInternalLogin || len(idps.Links) > 0) && len(request.LinkingUsers) == 0 {
+	// break-glass accounts must be able to log in even if the identity providers are not available
+	if (!isInternalLogin || (len(idps.Links) > 0 && request.BreakGlassPolicy == nil)) && len(request.LinkingUsers) == 0 {
 		step := repo.idpChecked(request, idps.Links, userSession)
 		if step != nil {
 			return append(steps, step), nil
@@ -1210,7 +1276,7 @@ func (repo *AuthRequestRepo) firstFactorChecked(request *domain.AuthRequest, use
 	}
 
 	var step domain.NextStep
-	if request.LoginPolicy.PasswordlessType != domain.PasswordlessTypeNotAllowed && user.IsPasswordlessReady() {
+	if (request.LoginPolicy.PasswordlessType != domain.PasswordlessTypeNotAllowed || request.BreakGlassPolicy != nil) && user.IsPasswordlessReady() {
 		if checkVerificationTimeMaxAge(userSession.PasswordlessVerification, request.LoginPolicy.MultiFactorCheckLifetime, request) {
 			request.MFAsVerified = append(request.MFAsVerified, domain.MFATypeU2FUserVerification)
 			request.AuthTime = userSession.PasswordlessVerification
@@ -1261,10 +1327,18 @@ func (repo *AuthRequestRepo) mfaChecked(userSession *user_model.UserSessionView,
 	if slices.Contains(request.MFAsVerified, domain.MFATypeU2FUserVerification) {
 		return nil, true, nil
 	}
-	allowedProviders, required := user.MFATypesAllowed(mfaLevel, request.LoginPolicy, isInternalAuthentication)
+	loginPolicy := request.LoginPolicy
+	if request.BreakGlassPolicy != nil {
+		loginPolicy = request.BreakGlassPolicy.LoginPolicy(loginPolicy)
+	}
+	allowedProviders, required := user.MFATypesAllowed(mfaLevel, loginPolicy, isInternalAuthentication)
 	promptRequired := (user.MFAMaxSetUp < mfaLevel) || (len(allowedProviders) == 0 && required)
+	if mfaLevel == domain.MFALevelMultiFactorCertified {
+		// security keys are set up as second factor, passkeys were already checked above
+		promptRequired = len(allowedProviders) == 0
+	}
 	if promptRequired || !repo.mfaSkippedOrSetUp(user, request) {
-		types := user.MFATypesSetupPossible(mfaLevel, request.LoginPolicy)
+		types := user.MFATypesSetupPossible(mfaLevel, loginPolicy)
 		if promptRequired && len(types) == 0 {
 			return nil, false, zerrors.ThrowPreconditionFailed(nil, "LOGIN-5Hm8s", "Errors.Login.LoginPolicy.MFA.ForceAndNotConfigured")
 		}
@@ -1277,6 +1351,13 @@ func (repo *AuthRequestRepo) mfaChecked(userSession *user_model.UserSessionView,
 		}, false, nil
 	}
 	switch mfaLevel {
+	case domain.MFALevelMultiFactorCertified:
+		if userSession.SecondFactorVerificationType == domain.MFATypeU2F &&
+			checkVerificationTimeMaxAge(userSession.SecondFactorVerification, request.LoginPolicy.SecondFactorCheckLifetime, request) {
+			request.MFAsVerified = append(request.MFAsVerified, userSession.SecondFactorVerificationType)
+			request.AuthTime = userSession.SecondFactorVerification
+			return nil, true, nil
+		}
 	default:
 		fallthrough
 	case domain.MFALevelNotSetUp:
@@ -1483,7 +1564,7 @@ func getLoginPolicyIDPProviders(ctx context.Context, provider idpProviderViewPro
 }
 
 func checkVerificationTimeMaxAge(verificationTime time.Time, lifetime time.Duration, request *domain.AuthRequest) bool {
-	if !checkVerificationTime(verificationTime, lifetime) {
+	if !checkVerificationTime(verificationTime, request.CheckLifetime(lifetime)) {
 		return false
 	}
 	if request.MaxAuthAge == nil {
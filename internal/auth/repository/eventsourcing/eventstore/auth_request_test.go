@@ -182,6 +182,14 @@ func (m *mockCustomText) CustomTextListByTemplate(ctx context.Context, aggregate
 	return m.texts, nil
 }
 
+type mockBreakGlassPolicy struct {
+	policy *domain.BreakGlassPolicy
+}
+
+func (m *mockBreakGlassPolicy) BreakGlassPolicyByUser(context.Context, string) (*domain.BreakGlassPolicy, error) {
+	return m.policy, nil
+}
+
 type mockLockoutPolicy struct {
 	policy *query.LockoutPolicy
 }
@@ -316,6 +324,7 @@ func TestAuthRequestRepo_nextSteps(t *testing.T) {
 		labelPolicyProvider       labelPolicyProvider
 		passwordAgePolicyProvider passwordAgePolicyProvider
 		customTextProvider        customTextProvider
+		breakGlassPolicyProvider  breakGlassPolicyProvider
 	}
 	type args struct {
 		request       *domain.AuthRequest
@@ -1432,6 +1441,77 @@ func TestAuthRequestRepo_nextSteps(t *testing.T) {
 			[]domain.NextStep{&domain.RedirectToCallbackStep{}},
 			nil,
 		},
+		{
+			"break-glass user with second factor, security key prompt step",
+			fields{
+				userSessionViewProvider: &mockViewUserSession{
+					PasswordVerification:     testNow.Add(-5 * time.Minute),
+					SecondFactorVerification: testNow.Add(-5 * time.Minute),
+				},
+				userViewProvider: &mockViewUser{
+					PasswordSet:     true,
+					IsEmailVerified: true,
+					MFAMaxSetUp:     int32(domain.MFALevelSecondFactor),
+				},
+				userEventProvider: &mockEventUser{},
+				orgViewProvider:   &mockViewOrg{State: domain.OrgStateActive},
+				lockoutPolicyProvider: &mockLockoutPolicy{
+					policy: &query.LockoutPolicy{
+						ShowFailures: true,
+					},
+				},
+				idpUserLinksProvider: &mockIDPUserLinks{},
+				breakGlassPolicyProvider: &mockBreakGlassPolicy{
+					policy: domain.DefaultBreakGlassPolicy(),
+				},
+			},
+			args{&domain.AuthRequest{
+				UserID:  "UserID",
+				Request: &domain.AuthRequestOIDC{},
+				LoginPolicy: &domain.LoginPolicy{
+					SecondFactors:             []domain.SecondFactorType{domain.SecondFactorTypeTOTP},
+					PasswordCheckLifetime:     10 * 24 * time.Hour,
+					SecondFactorCheckLifetime: 18 * time.Hour,
+				},
+			}, false},
+			[]domain.NextStep{&domain.MFAPromptStep{
+				Required:     true,
+				MFAProviders: []domain.MFAType{domain.MFATypeU2F},
+			}},
+			nil,
+		},
+		{
+			"break-glass user with password check older than session lifetime, password step",
+			fields{
+				userSessionViewProvider: &mockViewUserSession{
+					PasswordVerification: testNow.Add(-20 * time.Minute),
+				},
+				userViewProvider: &mockViewUser{
+					PasswordSet:     true,
+					IsEmailVerified: true,
+				},
+				userEventProvider: &mockEventUser{},
+				orgViewProvider:   &mockViewOrg{State: domain.OrgStateActive},
+				lockoutPolicyProvider: &mockLockoutPolicy{
+					policy: &query.LockoutPolicy{
+						ShowFailures: true,
+					},
+				},
+				idpUserLinksProvider: &mockIDPUserLinks{},
+				breakGlassPolicyProvider: &mockBreakGlassPolicy{
+					policy: domain.DefaultBreakGlassPolicy(),
+				},
+			},
+			args{&domain.AuthRequest{
+				UserID:  "UserID",
+				Request: &domain.AuthRequestOIDC{},
+				LoginPolicy: &domain.LoginPolicy{
+					PasswordCheckLifetime: 10 * 24 * time.Hour,
+				},
+			}, false},
+			[]domain.NextStep{&domain.PasswordStep{}},
+			nil,
+		},
 		{
 			"prompt none, checkLoggedIn true, authenticated and native, login succeeded step",
 			fields{
@@ -1719,6 +1799,10 @@ func TestAuthRequestRepo_nextSteps(t *testing.T) {
 				LabelPolicyProvider:       tt.fields.labelPolicyProvider,
 				PasswordAgePolicyProvider: tt.fields.passwordAgePolicyProvider,
 				CustomTextProvider:        tt.fields.customTextProvider,
+				BreakGlassPolicyProvider:  tt.fields.breakGlassPolicyProvider,
+			}
+			if repo.BreakGlassPolicyProvider == nil {
+				repo.BreakGlassPolicyProvider = &mockBreakGlassPolicy{}
 			}
 			got, err := repo.nextSteps(context.Background(), tt.args.request, tt.args.checkLoggedIn)
 			if (err != nil && tt.wantErr == nil) || (tt.wantErr != nil && !tt.wantErr(err)) {
@@ -1745,17 +1829,100 @@ func TestAuthRequestRepo_mfaChecked(t *testing.T) {
 		errFunc         func(err error) bool
 		wantMFAVerified []domain.MFAType
 	}{
-		//{
-		//	"required, prompt and false", //TODO: enable when LevelsOfAssurance is checked
-		//	fields{},
-		//	args{
-		//		request: &domain.AuthRequest{PossibleLOAs: []model.LevelOfAssurance{}},
-		//		user: &user_model.UserView{
-		//			OTPState: user_model.MFAStateReady,
-		//		},
-		//	},
-		//	false,
-		//},
+		{
+			"mfa required by level of assurance, not set up, required prompt and false",
+			args{
+				request: &domain.AuthRequest{
+					RequiredLevelOfAssurance: domain.LevelOfAssuranceMFA,
+					LoginPolicy: &domain.LoginPolicy{
+						SecondFactors:       []domain.SecondFactorType{domain.SecondFactorTypeTOTP},
+						MFAInitSkipLifetime: 30 * 24 * time.Hour,
+					},
+				},
+				user: &user_model.UserView{
+					HumanView: &user_model.HumanView{
+						MFAMaxSetUp: domain.MFALevelNotSetUp,
+					},
+				},
+				isInternal: true,
+			},
+			&domain.MFAPromptStep{
+				Required: true,
+				MFAProviders: []domain.MFAType{
+					domain.MFATypeTOTP,
+				},
+			},
+			false,
+			nil,
+			nil,
+		},
+		{
+			"phishing resistant required, otp checked, u2f check and false",
+			args{
+				request: &domain.AuthRequest{
+					RequiredLevelOfAssurance: domain.LevelOfAssurancePhishingResistant,
+					LoginPolicy: &domain.LoginPolicy{
+						SecondFactors:             []domain.SecondFactorType{domain.SecondFactorTypeTOTP, domain.SecondFactorTypeU2F},
+						SecondFactorCheckLifetime: 18 * time.Hour,
+					},
+				},
+				user: &user_model.UserView{
+					HumanView: &user_model.HumanView{
+						MFAMaxSetUp: domain.MFALevelSecondFactor,
+						OTPState:    user_model.MFAStateReady,
+						U2FTokens: []*user_model.WebAuthNView{
+							{
+								TokenID: "tokenID",
+								State:   user_model.MFAStateReady,
+							},
+						},
+					},
+				},
+				userSession: &user_model.UserSessionView{
+					SecondFactorVerification:     testNow.Add(-5 * time.Hour),
+					SecondFactorVerificationType: domain.MFATypeTOTP,
+				},
+				isInternal: true,
+			},
+			&domain.MFAVerificationStep{
+				MFAProviders: []domain.MFAType{domain.MFATypeU2F},
+			},
+			false,
+			nil,
+			nil,
+		},
+		{
+			"phishing resistant required, u2f checked, true",
+			args{
+				request: &domain.AuthRequest{
+					RequiredLevelOfAssurance: domain.LevelOfAssurancePhishingResistant,
+					LoginPolicy: &domain.LoginPolicy{
+						SecondFactors:             []domain.SecondFactorType{domain.SecondFactorTypeTOTP, domain.SecondFactorTypeU2F},
+						SecondFactorCheckLifetime: 18 * time.Hour,
+					},
+				},
+				user: &user_model.UserView{
+					HumanView: &user_model.HumanView{
+						MFAMaxSetUp: domain.MFALevelSecondFactor,
+						U2FTokens: []*user_model.WebAuthNView{
+							{
+								TokenID: "tokenID",
+								State:   user_model.MFAStateReady,
+							},
+						},
+					},
+				},
+				userSession: &user_model.UserSessionView{
+					SecondFactorVerification:     testNow.Add(-5 * time.Hour),
+					SecondFactorVerificationType: domain.MFATypeU2F,
+				},
+				isInternal: true,
+			},
+			nil,
+			true,
+			nil,
+			[]domain.MFAType{domain.MFATypeU2F},
+		},
 		{
 			"not set up, forced by policy, no mfas configured, error",
 			args{
@@ -2,15 +2,20 @@ package eventstore
 
 import (
 	"context"
+	"slices"
+	"time"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/auth/repository/eventsourcing/view"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
 	usr_model "github.com/zitadel/zitadel/internal/user/model"
 	"github.com/zitadel/zitadel/internal/user/repository/view/model"
 )
 
 type UserSessionRepo struct {
-	View *view.View
+	View  *view.View
+	Query *query.Queries
 }
 
 func (repo *UserSessionRepo) GetMyUserSessions(ctx context.Context) ([]*usr_model.UserSessionView, error) {
@@ -20,3 +25,39 @@ func (repo *UserSessionRepo) GetMyUserSessions(ctx context.Context) ([]*usr_mode
 	}
 	return model.UserSessionsToModel(userSessions), nil
 }
+
+// UserSessionAuthMethods returns the authentication methods of the user session on the user agent,
+// which are still valid according to the check lifetimes of the login policy of the user's organization
+func (repo *UserSessionRepo) UserSessionAuthMethods(ctx context.Context, agentID, userID string) ([]domain.UserAuthMethodType, error) {
+	session, err := repo.View.UserSessionByIDs(agentID, userID, authz.GetInstance(ctx).InstanceID())
+	if err != nil {
+		return nil, err
+	}
+	policy, err := repo.Query.LoginPolicyByID(ctx, false, session.ResourceOwner, false)
+	if err != nil {
+		return nil, err
+	}
+	return userSessionAuthMethods(model.UserSessionToModel(session), policy), nil
+}
+
+func userSessionAuthMethods(session *usr_model.UserSessionView, policy *query.LoginPolicy) []domain.UserAuthMethodType {
+	methods := make([]domain.UserAuthMethodType, 0, 4)
+	if checkVerificationTime(session.PasswordVerification, time.Duration(policy.PasswordCheckLifetime)) {
+		methods = append(methods, domain.UserAuthMethodTypePassword)
+	}
+	if checkVerificationTime(session.ExternalLoginVerification, time.Duration(policy.ExternalLoginCheckLifetime)) {
+		methods = append(methods, domain.UserAuthMethodTypeIDP)
+	}
+	if checkVerificationTime(session.PasswordlessVerification, time.Duration(policy.MultiFactorCheckLifetime)) {
+		methods = append(methods, domain.UserAuthMethodTypePasswordless)
+	}
+	if checkVerificationTime(session.SecondFactorVerification, time.Duration(policy.SecondFactorCheckLifetime)) {
+		methods = append(methods, session.SecondFactorVerificationType.UserAuthMethodType())
+	}
+	if checkVerificationTime(session.MultiFactorVerification, time.Duration(policy.MultiFactorCheckLifetime)) {
+		methods = append(methods, session.MultiFactorVerificationType.UserAuthMethodType())
+	}
+	// a passwordless check is stored as passwordless and multi factor verification
+	slices.Sort(methods)
+	return slices.Compact(methods)
+}
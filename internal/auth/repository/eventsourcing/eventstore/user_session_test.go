@@ -0,0 +1,65 @@
+package eventstore
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	user_model "github.com/zitadel/zitadel/internal/user/model"
+)
+
+func Test_userSessionAuthMethods(t *testing.T) {
+	policy := &query.LoginPolicy{
+		PasswordCheckLifetime:      database.Duration(time.Hour),
+		ExternalLoginCheckLifetime: database.Duration(time.Hour),
+		SecondFactorCheckLifetime:  database.Duration(time.Hour),
+		MultiFactorCheckLifetime:   database.Duration(time.Hour),
+	}
+	tests := []struct {
+		name    string
+		session *user_model.UserSessionView
+		want    []domain.UserAuthMethodType
+	}{
+		{
+			"no verification",
+			&user_model.UserSessionView{},
+			[]domain.UserAuthMethodType{},
+		},
+		{
+			"password and otp",
+			&user_model.UserSessionView{
+				PasswordVerification:         time.Now().Add(-time.Minute),
+				SecondFactorVerification:     time.Now().Add(-time.Minute),
+				SecondFactorVerificationType: domain.MFATypeTOTP,
+			},
+			[]domain.UserAuthMethodType{domain.UserAuthMethodTypeTOTP, domain.UserAuthMethodTypePassword},
+		},
+		{
+			"expired second factor",
+			&user_model.UserSessionView{
+				PasswordVerification:         time.Now().Add(-time.Minute),
+				SecondFactorVerification:     time.Now().Add(-2 * time.Hour),
+				SecondFactorVerificationType: domain.MFATypeTOTP,
+			},
+			[]domain.UserAuthMethodType{domain.UserAuthMethodTypePassword},
+		},
+		{
+			"passwordless",
+			&user_model.UserSessionView{
+				PasswordlessVerification:    time.Now().Add(-time.Minute),
+				MultiFactorVerification:     time.Now().Add(-time.Minute),
+				MultiFactorVerificationType: domain.MFATypeU2FUserVerification,
+			},
+			[]domain.UserAuthMethodType{domain.UserAuthMethodTypePasswordless},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, userSessionAuthMethods(tt.session, policy))
+		})
+	}
+}
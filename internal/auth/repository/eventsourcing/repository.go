@@ -2,6 +2,7 @@ package eventsourcing
 
 import (
 	"context"
+	"time"
 
 	"github.com/zitadel/zitadel/internal/auth/repository/eventsourcing/eventstore"
 	auth_handler "github.com/zitadel/zitadel/internal/auth/repository/eventsourcing/handler"
@@ -78,6 +79,7 @@ func Start(ctx context.Context, conf Config, systemDefaults sd.SystemDefaults, c
 			ProjectProvider:           queryView,
 			ApplicationProvider:       queries,
 			CustomTextProvider:        queries,
+			BreakGlassPolicyProvider:  queries,
 			IdGenerator:               id.SonyFlakeGenerator(),
 		},
 		eventstore.TokenRepo{
@@ -91,7 +93,8 @@ func Start(ctx context.Context, conf Config, systemDefaults sd.SystemDefaults, c
 			KeyAlgorithm: oidcEncryption,
 		},
 		eventstore.UserSessionRepo{
-			View: view,
+			View:  view,
+			Query: queries,
 		},
 		eventstore.OrgRepository{
 			SearchLimit:    conf.SearchLimit,
@@ -117,7 +120,11 @@ func (q queryViewWrapper) UserGrantsByProjectAndUserID(ctx context.Context, proj
 	if err != nil {
 		return nil, err
 	}
-	queries := &query.UserGrantsQueries{Queries: []query.SearchQuery{userGrantUserID, userGrantProjectID}}
+	userGrantValid, err := query.NewUserGrantValidAtQuery(time.Now())
+	if err != nil {
+		return nil, err
+	}
+	queries := &query.UserGrantsQueries{Queries: []query.SearchQuery{userGrantUserID, userGrantProjectID, userGrantValid}}
 	grants, err := q.Queries.UserGrants(ctx, queries, true)
 	if err != nil {
 		return nil, err
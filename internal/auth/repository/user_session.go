@@ -3,9 +3,11 @@ package repository
 import (
 	"context"
 
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/user/model"
 )
 
 type UserSessionRepository interface {
 	GetMyUserSessions(ctx context.Context) ([]*model.UserSessionView, error)
+	UserSessionAuthMethods(ctx context.Context, agentID, userID string) ([]domain.UserAuthMethodType, error)
 }
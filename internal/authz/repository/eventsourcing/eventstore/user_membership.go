@@ -2,6 +2,7 @@ package eventstore
 
 import (
 	"context"
+	"time"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/query"
@@ -39,8 +40,26 @@ func (repo *UserMembershipRepo) searchUserMemberships(ctx context.Context, orgID
 	if err != nil {
 		return nil, err
 	}
+	ownerQuery := query.Or(orgIDsQuery, grantedIDQuery)
+	// organization members of the ancestors are granted their roles on the descendants as well
+	ancestorIDs, err := repo.Queries.OrgAncestorIDs(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if len(ancestorIDs) > 0 {
+		ancestorsQuery, err := query.NewMembershipOrgIDsQuery(ancestorIDs...)
+		if err != nil {
+			return nil, err
+		}
+		ownerQuery = query.Or(orgIDsQuery, grantedIDQuery, ancestorsQuery)
+	}
+	// time-bound memberships only grant permissions inside of their validity
+	validQuery, err := query.NewMembershipValidAtQuery(time.Now())
+	if err != nil {
+		return nil, err
+	}
 	memberships, err := repo.Queries.Memberships(ctx, &query.MembershipSearchQuery{
-		Queries: []query.SearchQuery{userIDQuery, query.Or(orgIDsQuery, grantedIDQuery)},
+		Queries: []query.SearchQuery{userIDQuery, ownerQuery, validQuery},
 	}, shouldTriggerBulk)
 	if err != nil {
 		return nil, err
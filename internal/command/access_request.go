@@ -0,0 +1,209 @@
+package command
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/accessrequest"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// RequestAccess records the request of a user for an elevated membership on the instance or the organization (resourceOwner).
+// The membership is only granted after the approval of another user.
+func (c *Commands) RequestAccess(ctx context.Context, request *domain.AccessRequest, resourceOwner string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if !request.IsValid() {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ar1iv", "Errors.AccessRequest.Invalid")
+	}
+	if request.Scope == domain.AccessRequestScopeInstance {
+		resourceOwner = authz.GetInstance(ctx).InstanceID()
+	}
+	if resourceOwner == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ar2ro", "Errors.ResourceOwnerMissing")
+	}
+	if err = c.checkAccessRequestRoles(ctx, request.Scope, request.Roles); err != nil {
+		return nil, err
+	}
+	if exists, err := ExistsUser(ctx, c.eventstore.Filter, request.UserID, ""); err != nil || !exists {
+		return nil, zerrors.ThrowPreconditionFailed(err, "COMMAND-Ar3nu", "Errors.User.NotFound")
+	}
+	request.AggregateID, err = c.idGenerator.Next()
+	if err != nil {
+		return nil, err
+	}
+	writeModel := NewAccessRequestWriteModel(request.AggregateID, resourceOwner)
+	if err = c.pushAppendAndReduce(ctx, writeModel,
+		accessrequest.NewRequestedEvent(ctx,
+			accessrequest.NewAggregate(request.AggregateID, resourceOwner),
+			request.UserID,
+			request.Scope,
+			request.Roles,
+			request.Duration,
+			request.Reason,
+		),
+	); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// ApproveAccessRequest grants the requested roles as membership which expires after the requested duration.
+// Existing members keep their membership, the requested roles are granted in addition for the duration.
+// Users cannot approve their own requests.
+func (c *Commands) ApproveAccessRequest(ctx context.Context, requestID, resourceOwner string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel, err := c.pendingAccessRequestWriteModel(ctx, requestID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if writeModel.UserID == authz.GetCtxData(ctx).UserID {
+		return nil, zerrors.ThrowPermissionDenied(nil, "COMMAND-Ap1sa", "Errors.AccessRequest.SelfApproval")
+	}
+	membership, elevation, err := c.accessRequestMembership(ctx, writeModel)
+	if err != nil {
+		return nil, err
+	}
+	validUntil := time.Now().Add(writeModel.Duration)
+	approved := accessrequest.NewApprovedEvent(ctx, AccessRequestAggregateFromWriteModel(&writeModel.WriteModel), validUntil, elevation)
+	pushedEvents, err := c.eventstore.Push(ctx, append([]eventstore.Command{approved}, membership(&validUntil)...)...)
+	if err != nil {
+		return nil, err
+	}
+	if err = AppendAndReduce(writeModel, pushedEvents[0]); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// DenyAccessRequest closes the access request without granting a membership.
+func (c *Commands) DenyAccessRequest(ctx context.Context, requestID, resourceOwner, reason string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel, err := c.pendingAccessRequestWriteModel(ctx, requestID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if err = c.pushAppendAndReduce(ctx, writeModel,
+		accessrequest.NewDeniedEvent(ctx, AccessRequestAggregateFromWriteModel(&writeModel.WriteModel), reason),
+	); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+func (c *Commands) pendingAccessRequestWriteModel(ctx context.Context, requestID, resourceOwner string) (*AccessRequestWriteModel, error) {
+	if requestID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ap2id", "Errors.IDMissing")
+	}
+	writeModel := NewAccessRequestWriteModel(requestID, resourceOwner)
+	if err := c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	if writeModel.State == domain.AccessRequestStateUnspecified {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Ap3nf", "Errors.AccessRequest.NotFound")
+	}
+	if writeModel.State != domain.AccessRequestStatePending {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ap4np", "Errors.AccessRequest.NotPending")
+	}
+	return writeModel, nil
+}
+
+func (c *Commands) checkAccessRequestRoles(ctx context.Context, scope domain.AccessRequestScope, roles []string) error {
+	if scope == domain.AccessRequestScopeOrg {
+		return c.checkOrgMemberRoles(ctx, c.eventstore.Filter, roles)
+	}
+	invalidRoles, err := c.invalidMemberRoles(ctx, c.eventstore.Filter, roles, domain.IAMRolePrefix)
+	if err != nil {
+		return err
+	}
+	if len(invalidRoles) > 0 {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Ar4ir", "Errors.IAM.MemberInvalid")
+	}
+	return nil
+}
+
+// EndAccessRequestElevation records the end of the elevation of a member by the approval of the access request.
+// The membership is not changed, the elevated roles are only granted until the validity ends anyway.
+func (c *Commands) EndAccessRequestElevation(ctx context.Context, requestID, resourceOwner string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if requestID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ae1id", "Errors.IDMissing")
+	}
+	writeModel := NewAccessRequestWriteModel(requestID, resourceOwner)
+	if err = c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	if writeModel.State != domain.AccessRequestStateApproved || !writeModel.Elevation || writeModel.ElevationEnded {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ae2ne", "Errors.AccessRequest.NotElevated")
+	}
+	if err = c.pushAppendAndReduce(ctx, writeModel,
+		accessrequest.NewElevationEndedEvent(ctx, AccessRequestAggregateFromWriteModel(&writeModel.WriteModel)),
+	); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// accessRequestMembership returns the events granting the requested roles until the validity ends.
+// Users who are not yet members are added as time-bound members.
+// Existing members are elevated: their membership is left unchanged and the approval itself grants the requested roles
+// as a time-bound membership of its own, which is only honored inside of the validity.
+func (c *Commands) accessRequestMembership(ctx context.Context, writeModel *AccessRequestWriteModel) (_ func(validUntil *time.Time) []eventstore.Command, elevation bool, err error) {
+	member, err := c.accessRequestMemberWriteModel(ctx, writeModel)
+	if err != nil {
+		return nil, false, err
+	}
+	if member.State == domain.MemberStateActive {
+		if !slices.ContainsFunc(writeModel.Roles, func(role string) bool { return !slices.Contains(member.Roles, role) }) {
+			return nil, false, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ap5am", "Errors.AccessRequest.AlreadyMember")
+		}
+		return func(*time.Time) []eventstore.Command { return nil }, true, nil
+	}
+	return func(validUntil *time.Time) []eventstore.Command {
+		if writeModel.Scope == domain.AccessRequestScopeInstance {
+			agg := &instance.NewAggregate(writeModel.ResourceOwner).Aggregate
+			return []eventstore.Command{
+				instance.NewMemberAddedEvent(ctx, agg, writeModel.UserID, writeModel.Roles...),
+				instance.NewMemberValiditySetEvent(ctx, agg, writeModel.UserID, nil, validUntil),
+			}
+		}
+		agg := &org.NewAggregate(writeModel.ResourceOwner).Aggregate
+		return []eventstore.Command{
+			org.NewMemberAddedEvent(ctx, agg, writeModel.UserID, writeModel.Roles...),
+			org.NewMemberValiditySetEvent(ctx, agg, writeModel.UserID, nil, validUntil),
+		}
+	}, false, nil
+}
+
+func (c *Commands) accessRequestMemberWriteModel(ctx context.Context, writeModel *AccessRequestWriteModel) (*MemberWriteModel, error) {
+	if writeModel.Scope == domain.AccessRequestScopeInstance {
+		member := NewInstanceMemberWriteModel(ctx, writeModel.UserID)
+		if err := c.eventstore.FilterToQueryReducer(ctx, member); err != nil {
+			return nil, err
+		}
+		return &member.MemberWriteModel, nil
+	}
+	member := NewOrgMemberWriteModel(writeModel.ResourceOwner, writeModel.UserID)
+	if err := c.eventstore.FilterToQueryReducer(ctx, member); err != nil {
+		return nil, err
+	}
+	return &member.MemberWriteModel, nil
+}
+
+func AccessRequestAggregateFromWriteModel(wm *eventstore.WriteModel) *eventstore.Aggregate {
+	return eventstore.AggregateFromWriteModel(wm, accessrequest.AggregateType, accessrequest.AggregateVersion)
+}
@@ -0,0 +1,67 @@
+package command
+
+import (
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/accessrequest"
+)
+
+type AccessRequestWriteModel struct {
+	eventstore.WriteModel
+
+	UserID   string
+	Scope    domain.AccessRequestScope
+	Roles    []string
+	Duration time.Duration
+	State    domain.AccessRequestState
+	// Elevation is set if the approval granted the roles to an existing member in addition to the membership
+	Elevation      bool
+	ElevationEnded bool
+}
+
+func NewAccessRequestWriteModel(id, resourceOwner string) *AccessRequestWriteModel {
+	return &AccessRequestWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   id,
+			ResourceOwner: resourceOwner,
+		},
+	}
+}
+
+func (wm *AccessRequestWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *accessrequest.RequestedEvent:
+			wm.UserID = e.UserID
+			wm.Scope = e.Scope
+			wm.Roles = e.Roles
+			wm.Duration = e.Duration
+			wm.State = domain.AccessRequestStatePending
+		case *accessrequest.ApprovedEvent:
+			wm.State = domain.AccessRequestStateApproved
+			wm.Elevation = e.Elevation
+		case *accessrequest.ElevationEndedEvent:
+			wm.ElevationEnded = true
+		case *accessrequest.DeniedEvent:
+			wm.State = domain.AccessRequestStateDenied
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *AccessRequestWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(accessrequest.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			accessrequest.RequestedEventType,
+			accessrequest.ApprovedEventType,
+			accessrequest.DeniedEventType,
+			accessrequest.ElevationEndedEventType,
+		).
+		Builder()
+}
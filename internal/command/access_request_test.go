@@ -0,0 +1,402 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/id"
+	id_mock "github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/repository/accessrequest"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func accessRequestedEvent(userID string) eventstore.Event {
+	return eventFromEventPusher(
+		accessrequest.NewRequestedEvent(context.Background(),
+			accessrequest.NewAggregate("request1", "org1"),
+			userID,
+			domain.AccessRequestScopeOrg,
+			[]string{"ORG_OWNER"},
+			time.Hour,
+			"incident",
+		),
+	)
+}
+
+func TestCommands_RequestAccess(t *testing.T) {
+	type fields struct {
+		eventstore  func(*testing.T) *eventstore.Eventstore
+		idGenerator id.Generator
+	}
+	type args struct {
+		request *domain.AccessRequest
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "invalid request, error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				request: &domain.AccessRequest{
+					UserID: "user1",
+					Scope:  domain.AccessRequestScopeOrg,
+					Roles:  []string{"ORG_OWNER"},
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "user not existing, precondition failed",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				request: &domain.AccessRequest{
+					UserID:   "user1",
+					Scope:    domain.AccessRequestScopeOrg,
+					Roles:    []string{"ORG_OWNER"},
+					Duration: time.Hour,
+				},
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "request access, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						groupUserAddedEvent("user1"),
+					),
+					expectPush(
+						accessrequest.NewRequestedEvent(context.Background(),
+							accessrequest.NewAggregate("request1", "org1"),
+							"user1",
+							domain.AccessRequestScopeOrg,
+							[]string{"ORG_OWNER"},
+							time.Hour,
+							"incident",
+						),
+					),
+				),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "request1"),
+			},
+			args: args{
+				request: &domain.AccessRequest{
+					UserID:   "user1",
+					Scope:    domain.AccessRequestScopeOrg,
+					Roles:    []string{"ORG_OWNER"},
+					Duration: time.Hour,
+					Reason:   "incident",
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:   tt.fields.eventstore(t),
+				idGenerator:  tt.fields.idGenerator,
+				zitadelRoles: []authz.RoleMapping{{Role: "ORG_OWNER"}},
+			}
+			got, err := c.RequestAccess(context.Background(), tt.args.request, "org1")
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommands_ApproveAccessRequest(t *testing.T) {
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type res struct {
+		err func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		res    res
+	}{
+		{
+			name: "not existing, not found",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "already denied, precondition failed",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						accessRequestedEvent("user1"),
+						eventFromEventPusher(
+							accessrequest.NewDeniedEvent(context.Background(),
+								accessrequest.NewAggregate("request1", "org1"),
+								"",
+							),
+						),
+					),
+				),
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "own request, permission denied",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						accessRequestedEvent("approver1"),
+					),
+				),
+			},
+			res: res{
+				err: zerrors.IsPermissionDenied,
+			},
+		},
+		{
+			name: "already member with the roles, precondition failed",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						accessRequestedEvent("user1"),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewMemberAddedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								"user1",
+								"ORG_USER_MANAGER", "ORG_OWNER",
+							),
+						),
+					),
+				),
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			_, err := c.ApproveAccessRequest(authz.NewMockContext("instance1", "org1", "approver1"), "request1", "org1")
+			if !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+		})
+	}
+}
+
+func TestCommands_DenyAccessRequest(t *testing.T) {
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		res    res
+	}{
+		{
+			name: "already approved, precondition failed",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						accessRequestedEvent("user1"),
+						eventFromEventPusher(
+							accessrequest.NewApprovedEvent(context.Background(),
+								accessrequest.NewAggregate("request1", "org1"),
+								time.Now().Add(time.Hour),
+								false,
+							),
+						),
+					),
+				),
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "deny, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						accessRequestedEvent("user1"),
+					),
+					expectPush(
+						accessrequest.NewDeniedEvent(context.Background(),
+							accessrequest.NewAggregate("request1", "org1"),
+							"not needed",
+						),
+					),
+				),
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := c.DenyAccessRequest(context.Background(), "request1", "org1", "not needed")
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommands_EndAccessRequestElevation(t *testing.T) {
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	approvedEvent := func(elevation bool) eventstore.Event {
+		return eventFromEventPusher(
+			accessrequest.NewApprovedEvent(context.Background(),
+				accessrequest.NewAggregate("request1", "org1"),
+				time.Now(),
+				elevation,
+			),
+		)
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		res    res
+	}{
+		{
+			name: "new member, precondition failed",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						accessRequestedEvent("user1"),
+						approvedEvent(false),
+					),
+				),
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "already ended, precondition failed",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						accessRequestedEvent("user1"),
+						approvedEvent(true),
+						eventFromEventPusher(
+							accessrequest.NewElevationEndedEvent(context.Background(),
+								accessrequest.NewAggregate("request1", "org1"),
+							),
+						),
+					),
+				),
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "elevation ended",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						accessRequestedEvent("user1"),
+						approvedEvent(true),
+					),
+					expectPush(
+						accessrequest.NewElevationEndedEvent(context.Background(),
+							accessrequest.NewAggregate("request1", "org1"),
+						),
+					),
+				),
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := c.EndAccessRequestElevation(context.Background(), "request1", "org1")
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,251 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/accessreview"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/repository/usergrant"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// StartAccessReview starts a campaign of the organization (resourceOwner) to recertify the user grants and memberships (items) of the scope.
+// The items and their reviewers are resolved by the caller, the state at the start of the campaign is recorded.
+func (c *Commands) StartAccessReview(ctx context.Context, review *domain.AccessReview, items []*domain.AccessReviewItem, resourceOwner string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if !review.IsValid() {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Rv1iv", "Errors.AccessReview.Invalid")
+	}
+	if !review.Deadline.After(time.Now()) {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Rv2dl", "Errors.AccessReview.DeadlineInPast")
+	}
+	if resourceOwner == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Rv3ro", "Errors.ResourceOwnerMissing")
+	}
+	if review.ProjectID != "" {
+		if err = c.checkProjectExists(ctx, review.ProjectID, resourceOwner); err != nil {
+			return nil, err
+		}
+	}
+	review.AggregateID, err = c.idGenerator.Next()
+	if err != nil {
+		return nil, err
+	}
+	writeModel := NewAccessReviewWriteModel(review.AggregateID, resourceOwner)
+	agg := AccessReviewAggregateFromWriteModel(&writeModel.WriteModel)
+	cmds := make([]eventstore.Command, 0, len(items)+1)
+	cmds = append(cmds, accessreview.NewStartedEvent(ctx, agg, review))
+	for _, item := range items {
+		item.ID, err = c.idGenerator.Next()
+		if err != nil {
+			return nil, err
+		}
+		cmds = append(cmds, accessreview.NewItemAddedEvent(ctx, agg, item))
+	}
+	if err = c.pushAppendAndReduce(ctx, writeModel, cmds...); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// DecideAccessReviewItem approves or revokes an item of an active campaign of the organization (resourceOwner).
+// A revoked user grant or membership is removed.
+func (c *Commands) DecideAccessReviewItem(ctx context.Context, reviewID, resourceOwner, itemID string, decision domain.AccessReviewDecision, comment string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	return c.decideAccessReviewItem(ctx, reviewID, resourceOwner, itemID, decision, comment, false)
+}
+
+// DecideMyAccessReviewItem approves or revokes an item the user of the context is a reviewer of.
+func (c *Commands) DecideMyAccessReviewItem(ctx context.Context, reviewID, itemID string, decision domain.AccessReviewDecision, comment string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	return c.decideAccessReviewItem(ctx, reviewID, "", itemID, decision, comment, true)
+}
+
+func (c *Commands) decideAccessReviewItem(ctx context.Context, reviewID, resourceOwner, itemID string, decision domain.AccessReviewDecision, comment string, reviewerOnly bool) (*domain.ObjectDetails, error) {
+	if itemID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Rd1id", "Errors.IDMissing")
+	}
+	if !decision.IsReviewerDecision() {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Rd2dc", "Errors.AccessReview.DecisionInvalid")
+	}
+	writeModel, err := c.activeAccessReviewWriteModel(ctx, reviewID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	item, ok := writeModel.Items[itemID]
+	if !ok {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Rd3nf", "Errors.AccessReview.Item.NotFound")
+	}
+	userID := authz.GetCtxData(ctx).UserID
+	if reviewerOnly && !item.IsReviewer(userID) {
+		return nil, zerrors.ThrowPermissionDenied(nil, "COMMAND-Rd4pd", "Errors.AccessReview.Item.NotReviewer")
+	}
+	if item.UserID == userID {
+		return nil, zerrors.ThrowPermissionDenied(nil, "COMMAND-Rd5sr", "Errors.AccessReview.Item.SelfReview")
+	}
+	if item.Decision != domain.AccessReviewDecisionPending {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Rd6ad", "Errors.AccessReview.Item.AlreadyDecided")
+	}
+	cmds, err := c.accessReviewItemDecision(ctx, writeModel, item, decision, comment)
+	if err != nil {
+		return nil, err
+	}
+	if err = c.pushAccessReviewEvents(ctx, writeModel, cmds...); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// CompleteAccessReview closes the campaign after its deadline.
+// The undecided items are flagged or revoked depending on the deadline action of the campaign.
+func (c *Commands) CompleteAccessReview(ctx context.Context, reviewID, resourceOwner string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel, err := c.activeAccessReviewWriteModel(ctx, reviewID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if writeModel.Deadline.After(time.Now()) {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Rc1dl", "Errors.AccessReview.DeadlineNotReached")
+	}
+	decision := domain.AccessReviewDecisionFlagged
+	if writeModel.DeadlineAction == domain.AccessReviewDeadlineActionRevoke {
+		decision = domain.AccessReviewDecisionExpired
+	}
+	cmds := make([]eventstore.Command, 0, len(writeModel.Items)+1)
+	for _, item := range writeModel.PendingItems() {
+		itemCmds, err := c.accessReviewItemDecision(ctx, writeModel, item, decision, "")
+		if err != nil {
+			return nil, err
+		}
+		cmds = append(cmds, itemCmds...)
+	}
+	cmds = append(cmds, accessreview.NewCompletedEvent(ctx, AccessReviewAggregateFromWriteModel(&writeModel.WriteModel)))
+	if err = c.pushAccessReviewEvents(ctx, writeModel, cmds...); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// CancelAccessReview stops an active campaign, the undecided items are kept.
+func (c *Commands) CancelAccessReview(ctx context.Context, reviewID, resourceOwner string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel, err := c.activeAccessReviewWriteModel(ctx, reviewID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if err = c.pushAppendAndReduce(ctx, writeModel,
+		accessreview.NewCancelledEvent(ctx, AccessReviewAggregateFromWriteModel(&writeModel.WriteModel)),
+	); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+func (c *Commands) activeAccessReviewWriteModel(ctx context.Context, reviewID, resourceOwner string) (*AccessReviewWriteModel, error) {
+	if reviewID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ra1id", "Errors.IDMissing")
+	}
+	writeModel := NewAccessReviewWriteModel(reviewID, resourceOwner)
+	if err := c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	if writeModel.State == domain.AccessReviewStateUnspecified {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Ra2nf", "Errors.AccessReview.NotFound")
+	}
+	if writeModel.State != domain.AccessReviewStateActive {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ra3na", "Errors.AccessReview.NotActive")
+	}
+	return writeModel, nil
+}
+
+// pushAccessReviewEvents pushes the events and only reduces the events of the campaign,
+// the removals of the revoked user grants and memberships belong to other aggregates.
+func (c *Commands) pushAccessReviewEvents(ctx context.Context, writeModel *AccessReviewWriteModel, cmds ...eventstore.Command) error {
+	pushedEvents, err := c.eventstore.Push(ctx, cmds...)
+	if err != nil {
+		return err
+	}
+	reviewEvents := make([]eventstore.Event, 0, len(pushedEvents))
+	for _, event := range pushedEvents {
+		if event.Aggregate().Type == accessreview.AggregateType {
+			reviewEvents = append(reviewEvents, event)
+		}
+	}
+	return AppendAndReduce(writeModel, reviewEvents...)
+}
+
+// accessReviewItemDecision returns the decision event of the item
+// followed by the removal of the user grant or membership in case of a revocation.
+func (c *Commands) accessReviewItemDecision(ctx context.Context, writeModel *AccessReviewWriteModel, item *AccessReviewItemWriteModel, decision domain.AccessReviewDecision, comment string) ([]eventstore.Command, error) {
+	cmds := []eventstore.Command{
+		accessreview.NewItemDecidedEvent(ctx, AccessReviewAggregateFromWriteModel(&writeModel.WriteModel), item.ID, decision, comment),
+	}
+	if !decision.IsRevocation() {
+		return cmds, nil
+	}
+	revocation, err := c.accessReviewItemRevocation(ctx, item)
+	if err != nil || revocation == nil {
+		return cmds, err
+	}
+	return append(cmds, revocation), nil
+}
+
+// accessReviewItemRevocation returns the removal of the user grant or membership of the item,
+// nil is returned if it was already removed since the start of the campaign.
+func (c *Commands) accessReviewItemRevocation(ctx context.Context, item *AccessReviewItemWriteModel) (eventstore.Command, error) {
+	switch item.Type {
+	case domain.AccessReviewItemTypeUserGrant:
+		grant, err := c.userGrantWriteModelByID(ctx, item.ObjectID, item.ResourceOwner)
+		if err != nil {
+			return nil, err
+		}
+		if grant.State == domain.UserGrantStateUnspecified || grant.State == domain.UserGrantStateRemoved {
+			return nil, nil
+		}
+		return usergrant.NewUserGrantRemovedEvent(ctx,
+			UserGrantAggregateFromWriteModel(&grant.WriteModel),
+			grant.UserID,
+			grant.ProjectID,
+			grant.ProjectGrantID,
+		), nil
+	case domain.AccessReviewItemTypeOrgMember:
+		member := NewOrgMemberWriteModel(item.ObjectID, item.UserID)
+		if err := c.eventstore.FilterToQueryReducer(ctx, member); err != nil {
+			return nil, err
+		}
+		if member.State != domain.MemberStateActive {
+			return nil, nil
+		}
+		return org.NewMemberRemovedEvent(ctx, &org.NewAggregate(item.ObjectID).Aggregate, item.UserID), nil
+	case domain.AccessReviewItemTypeProjectMember:
+		member := NewProjectMemberWriteModel(item.ObjectID, item.UserID, item.ResourceOwner)
+		if err := c.eventstore.FilterToQueryReducer(ctx, member); err != nil {
+			return nil, err
+		}
+		if member.State != domain.MemberStateActive {
+			return nil, nil
+		}
+		return project.NewProjectMemberRemovedEvent(ctx, &project.NewAggregate(item.ObjectID, item.ResourceOwner).Aggregate, item.UserID), nil
+	}
+	return nil, zerrors.ThrowInternal(nil, "COMMAND-Rr1it", "Errors.AccessReview.Item.TypeInvalid")
+}
+
+func AccessReviewAggregateFromWriteModel(wm *eventstore.WriteModel) *eventstore.Aggregate {
+	return eventstore.AggregateFromWriteModel(wm, accessreview.AggregateType, accessreview.AggregateVersion)
+}
@@ -0,0 +1,102 @@
+package command
+
+import (
+	"slices"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/accessreview"
+)
+
+type AccessReviewWriteModel struct {
+	eventstore.WriteModel
+
+	State          domain.AccessReviewState
+	Deadline       time.Time
+	DeadlineAction domain.AccessReviewDeadlineAction
+	Items          map[string]*AccessReviewItemWriteModel
+	// itemIDs keeps the order in which the items were added
+	itemIDs []string
+}
+
+type AccessReviewItemWriteModel struct {
+	domain.AccessReviewItem
+	Decision domain.AccessReviewDecision
+}
+
+func (item *AccessReviewItemWriteModel) IsReviewer(userID string) bool {
+	return slices.Contains(item.Reviewers, userID)
+}
+
+func NewAccessReviewWriteModel(id, resourceOwner string) *AccessReviewWriteModel {
+	return &AccessReviewWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   id,
+			ResourceOwner: resourceOwner,
+		},
+		Items: make(map[string]*AccessReviewItemWriteModel),
+	}
+}
+
+// PendingItems returns the items without decision in the order they were added
+func (wm *AccessReviewWriteModel) PendingItems() []*AccessReviewItemWriteModel {
+	pending := make([]*AccessReviewItemWriteModel, 0, len(wm.itemIDs))
+	for _, id := range wm.itemIDs {
+		if item := wm.Items[id]; item.Decision == domain.AccessReviewDecisionPending {
+			pending = append(pending, item)
+		}
+	}
+	return pending
+}
+
+func (wm *AccessReviewWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *accessreview.StartedEvent:
+			wm.State = domain.AccessReviewStateActive
+			wm.Deadline = e.Deadline
+			wm.DeadlineAction = e.DeadlineAction
+		case *accessreview.ItemAddedEvent:
+			wm.Items[e.ItemID] = &AccessReviewItemWriteModel{
+				AccessReviewItem: domain.AccessReviewItem{
+					ID:            e.ItemID,
+					Type:          e.ItemType,
+					ObjectID:      e.ObjectID,
+					ResourceOwner: e.ItemResourceOwner,
+					UserID:        e.UserID,
+					ProjectID:     e.ProjectID,
+					Roles:         e.Roles,
+					Reviewers:     e.Reviewers,
+				},
+				Decision: domain.AccessReviewDecisionPending,
+			}
+			wm.itemIDs = append(wm.itemIDs, e.ItemID)
+		case *accessreview.ItemDecidedEvent:
+			if item, ok := wm.Items[e.ItemID]; ok {
+				item.Decision = e.Decision
+			}
+		case *accessreview.CompletedEvent:
+			wm.State = domain.AccessReviewStateCompleted
+		case *accessreview.CancelledEvent:
+			wm.State = domain.AccessReviewStateCancelled
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *AccessReviewWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(accessreview.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			accessreview.StartedEventType,
+			accessreview.ItemAddedEventType,
+			accessreview.ItemDecidedEventType,
+			accessreview.CompletedEventType,
+			accessreview.CancelledEventType,
+		).
+		Builder()
+}
@@ -0,0 +1,521 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/id"
+	id_mock "github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/repository/accessreview"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/repository/usergrant"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func accessReviewStartedEvent(deadline time.Time, action domain.AccessReviewDeadlineAction) eventstore.Event {
+	return eventFromEventPusher(
+		accessreview.NewStartedEvent(context.Background(),
+			accessreview.NewAggregate("review1", "org1"),
+			&domain.AccessReview{
+				Name:           "Q1",
+				Scope:          domain.AccessReviewScopeProject,
+				ProjectID:      "project1",
+				ReviewerType:   domain.AccessReviewerTypeProjectOwner,
+				Deadline:       deadline,
+				DeadlineAction: action,
+			},
+		),
+	)
+}
+
+func accessReviewGrantItem() *domain.AccessReviewItem {
+	return &domain.AccessReviewItem{
+		ID:            "item1",
+		Type:          domain.AccessReviewItemTypeUserGrant,
+		ObjectID:      "usergrant1",
+		ResourceOwner: "org1",
+		UserID:        "user1",
+		ProjectID:     "project1",
+		Roles:         []string{"rolekey1"},
+		Reviewers:     []string{"reviewer1"},
+	}
+}
+
+func accessReviewItemAddedEvent() eventstore.Event {
+	return eventFromEventPusher(
+		accessreview.NewItemAddedEvent(context.Background(),
+			accessreview.NewAggregate("review1", "org1"),
+			accessReviewGrantItem(),
+		),
+	)
+}
+
+func TestCommands_StartAccessReview(t *testing.T) {
+	deadline := time.Now().Add(24 * time.Hour)
+	type fields struct {
+		eventstore  func(*testing.T) *eventstore.Eventstore
+		idGenerator id.Generator
+	}
+	type args struct {
+		review *domain.AccessReview
+		items  []*domain.AccessReviewItem
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "role scope without role, error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				review: &domain.AccessReview{
+					Name:           "Q1",
+					Scope:          domain.AccessReviewScopeRole,
+					ProjectID:      "project1",
+					ReviewerType:   domain.AccessReviewerTypeProjectOwner,
+					Deadline:       deadline,
+					DeadlineAction: domain.AccessReviewDeadlineActionRevoke,
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "deadline in past, error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				review: &domain.AccessReview{
+					Name:           "Q1",
+					Scope:          domain.AccessReviewScopeOrg,
+					ReviewerType:   domain.AccessReviewerTypeProjectOwner,
+					Deadline:       time.Now().Add(-time.Hour),
+					DeadlineAction: domain.AccessReviewDeadlineActionRevoke,
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "project not existing, precondition failed",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				review: &domain.AccessReview{
+					Name:           "Q1",
+					Scope:          domain.AccessReviewScopeProject,
+					ProjectID:      "project1",
+					ReviewerType:   domain.AccessReviewerTypeProjectOwner,
+					Deadline:       deadline,
+					DeadlineAction: domain.AccessReviewDeadlineActionRevoke,
+				},
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "start review, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"project", false, false, false,
+								domain.PrivateLabelingSettingUnspecified,
+							),
+						),
+					),
+					expectPush(
+						accessreview.NewStartedEvent(context.Background(),
+							accessreview.NewAggregate("review1", "org1"),
+							&domain.AccessReview{
+								Name:           "Q1",
+								Scope:          domain.AccessReviewScopeProject,
+								ProjectID:      "project1",
+								ReviewerType:   domain.AccessReviewerTypeProjectOwner,
+								Deadline:       deadline,
+								DeadlineAction: domain.AccessReviewDeadlineActionRevoke,
+							},
+						),
+						accessreview.NewItemAddedEvent(context.Background(),
+							accessreview.NewAggregate("review1", "org1"),
+							accessReviewGrantItem(),
+						),
+					),
+				),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "review1", "item1"),
+			},
+			args: args{
+				review: &domain.AccessReview{
+					Name:           "Q1",
+					Scope:          domain.AccessReviewScopeProject,
+					ProjectID:      "project1",
+					ReviewerType:   domain.AccessReviewerTypeProjectOwner,
+					Deadline:       deadline,
+					DeadlineAction: domain.AccessReviewDeadlineActionRevoke,
+				},
+				items: []*domain.AccessReviewItem{
+					{
+						Type:          domain.AccessReviewItemTypeUserGrant,
+						ObjectID:      "usergrant1",
+						ResourceOwner: "org1",
+						UserID:        "user1",
+						ProjectID:     "project1",
+						Roles:         []string{"rolekey1"},
+						Reviewers:     []string{"reviewer1"},
+					},
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:  tt.fields.eventstore(t),
+				idGenerator: tt.fields.idGenerator,
+			}
+			got, err := c.StartAccessReview(context.Background(), tt.args.review, tt.args.items, "org1")
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommands_DecideMyAccessReviewItem(t *testing.T) {
+	ctx := authz.NewMockContext("", "org1", "reviewer1")
+	deadline := time.Now().Add(24 * time.Hour)
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx      context.Context
+		decision domain.AccessReviewDecision
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "invalid decision, error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx:      ctx,
+				decision: domain.AccessReviewDecisionFlagged,
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "review not existing, not found",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:      ctx,
+				decision: domain.AccessReviewDecisionApproved,
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "not a reviewer, permission denied",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						accessReviewStartedEvent(deadline, domain.AccessReviewDeadlineActionRevoke),
+						accessReviewItemAddedEvent(),
+					),
+				),
+			},
+			args: args{
+				ctx:      authz.NewMockContext("", "org1", "user2"),
+				decision: domain.AccessReviewDecisionApproved,
+			},
+			res: res{
+				err: zerrors.IsPermissionDenied,
+			},
+		},
+		{
+			name: "already decided, precondition failed",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						accessReviewStartedEvent(deadline, domain.AccessReviewDeadlineActionRevoke),
+						accessReviewItemAddedEvent(),
+						eventFromEventPusher(
+							accessreview.NewItemDecidedEvent(context.Background(),
+								accessreview.NewAggregate("review1", "org1"),
+								"item1",
+								domain.AccessReviewDecisionApproved,
+								"",
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:      ctx,
+				decision: domain.AccessReviewDecisionRevoked,
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "approve, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						accessReviewStartedEvent(deadline, domain.AccessReviewDeadlineActionRevoke),
+						accessReviewItemAddedEvent(),
+					),
+					expectPush(
+						accessreview.NewItemDecidedEvent(ctx,
+							accessreview.NewAggregate("review1", "org1"),
+							"item1",
+							domain.AccessReviewDecisionApproved,
+							"still needed",
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:      ctx,
+				decision: domain.AccessReviewDecisionApproved,
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			name: "revoke, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						accessReviewStartedEvent(deadline, domain.AccessReviewDeadlineActionRevoke),
+						accessReviewItemAddedEvent(),
+					),
+					expectFilter(
+						userGrantAddedEvent(),
+					),
+					expectPush(
+						accessreview.NewItemDecidedEvent(ctx,
+							accessreview.NewAggregate("review1", "org1"),
+							"item1",
+							domain.AccessReviewDecisionRevoked,
+							"still needed",
+						),
+						usergrant.NewUserGrantRemovedEvent(ctx,
+							&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
+							"user1",
+							"project1",
+							"",
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:      ctx,
+				decision: domain.AccessReviewDecisionRevoked,
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := c.DecideMyAccessReviewItem(tt.args.ctx, "review1", "item1", tt.args.decision, "still needed")
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommands_CompleteAccessReview(t *testing.T) {
+	past := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		res    res
+	}{
+		{
+			name: "deadline not reached, precondition failed",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						accessReviewStartedEvent(time.Now().Add(time.Hour), domain.AccessReviewDeadlineActionRevoke),
+					),
+				),
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "cancelled, precondition failed",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						accessReviewStartedEvent(past, domain.AccessReviewDeadlineActionRevoke),
+						eventFromEventPusher(
+							accessreview.NewCancelledEvent(context.Background(),
+								accessreview.NewAggregate("review1", "org1"),
+							),
+						),
+					),
+				),
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "flag undecided items, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						accessReviewStartedEvent(past, domain.AccessReviewDeadlineActionFlag),
+						accessReviewItemAddedEvent(),
+					),
+					expectPush(
+						accessreview.NewItemDecidedEvent(context.Background(),
+							accessreview.NewAggregate("review1", "org1"),
+							"item1",
+							domain.AccessReviewDecisionFlagged,
+							"",
+						),
+						accessreview.NewCompletedEvent(context.Background(),
+							accessreview.NewAggregate("review1", "org1"),
+						),
+					),
+				),
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			name: "revoke undecided items, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						accessReviewStartedEvent(past, domain.AccessReviewDeadlineActionRevoke),
+						accessReviewItemAddedEvent(),
+					),
+					expectFilter(
+						userGrantAddedEvent(),
+					),
+					expectPush(
+						accessreview.NewItemDecidedEvent(context.Background(),
+							accessreview.NewAggregate("review1", "org1"),
+							"item1",
+							domain.AccessReviewDecisionExpired,
+							"",
+						),
+						usergrant.NewUserGrantRemovedEvent(context.Background(),
+							&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
+							"user1",
+							"project1",
+							"",
+						),
+						accessreview.NewCompletedEvent(context.Background(),
+							accessreview.NewAggregate("review1", "org1"),
+						),
+					),
+				),
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := c.CompleteAccessReview(context.Background(), "review1", "org1")
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
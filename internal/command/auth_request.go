@@ -6,6 +6,7 @@ import (
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/repository/authrequest"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
 	"github.com/zitadel/zitadel/internal/zerrors"
@@ -29,6 +30,8 @@ type AuthRequest struct {
 	LoginHint        *string
 	HintUserID       *string
 	NeedRefreshToken bool
+	// RequiredLevelOfAssurance must be reached by the session linked to the auth request
+	RequiredLevelOfAssurance domain.LevelOfAssurance
 }
 
 type CurrentAuthRequest struct {
@@ -73,6 +76,7 @@ func (c *Commands) AddAuthRequest(ctx context.Context, authRequest *AuthRequest)
 		authRequest.LoginHint,
 		authRequest.HintUserID,
 		authRequest.NeedRefreshToken,
+		authRequest.RequiredLevelOfAssurance,
 	))
 	if err != nil {
 		return nil, err
@@ -105,19 +109,45 @@ func (c *Commands) LinkSessionToAuthRequest(ctx context.Context, id, sessionID,
 	if err := c.sessionTokenVerifier(ctx, sessionToken, sessionWriteModel.AggregateID, sessionWriteModel.TokenID); err != nil {
 		return nil, nil, err
 	}
+	if err := checkSessionSatisfiesAuthRequest(writeModel, sessionWriteModel); err != nil {
+		return nil, nil, err
+	}
+	breakGlassEvent, err := c.checkBreakGlassSessionLinked(ctx, sessionWriteModel)
+	if err != nil {
+		return nil, nil, err
+	}
 
-	if err := c.pushAppendAndReduce(ctx, writeModel, authrequest.NewSessionLinkedEvent(
-		ctx, &authrequest.NewAggregate(id, authz.GetInstance(ctx).InstanceID()).Aggregate,
-		sessionID,
-		sessionWriteModel.UserID,
-		sessionWriteModel.AuthenticationTime(),
-		sessionWriteModel.AuthMethodTypes(),
-	)); err != nil {
+	cmds := []eventstore.Command{
+		authrequest.NewSessionLinkedEvent(
+			ctx, &authrequest.NewAggregate(id, authz.GetInstance(ctx).InstanceID()).Aggregate,
+			sessionID,
+			sessionWriteModel.UserID,
+			sessionWriteModel.AuthenticationTime(),
+			sessionWriteModel.AuthMethodTypes(),
+		),
+	}
+	if breakGlassEvent != nil {
+		cmds = append(cmds, breakGlassEvent)
+	}
+	if err := c.pushAppendAndReduce(ctx, writeModel, cmds...); err != nil {
 		return nil, nil, err
 	}
 	return writeModelToObjectDetails(&writeModel.WriteModel), authRequestWriteModelToCurrentAuthRequest(writeModel), nil
 }
 
+// checkSessionSatisfiesAuthRequest ensures the session reaches the level of assurance
+// and was authenticated within the max_age of the auth request.
+// Otherwise the login has to step up or re-authenticate the user before linking the session.
+func checkSessionSatisfiesAuthRequest(writeModel *AuthRequestWriteModel, session *SessionWriteModel) error {
+	if domain.LevelOfAssuranceFromAuthMethods(session.AuthMethodTypes()) < writeModel.RequiredLevelOfAssurance {
+		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-Loa3s", "Errors.AuthRequest.LevelOfAssuranceNotSatisfied")
+	}
+	if writeModel.MaxAge != nil && session.AuthenticationTime().Add(*writeModel.MaxAge).Before(time.Now()) {
+		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-Mx4gE", "Errors.AuthRequest.MaxAgeExceeded")
+	}
+	return nil
+}
+
 func (c *Commands) FailAuthRequest(ctx context.Context, id string, reason domain.OIDCErrorReason) (*domain.ObjectDetails, *CurrentAuthRequest, error) {
 	writeModel, err := c.getAuthRequestWriteModel(ctx, id)
 	if err != nil {
@@ -171,6 +201,8 @@ func authRequestWriteModelToCurrentAuthRequest(writeModel *AuthRequestWriteModel
 			MaxAge:        writeModel.MaxAge,
 			LoginHint:     writeModel.LoginHint,
 			HintUserID:    writeModel.HintUserID,
+
+			RequiredLevelOfAssurance: writeModel.RequiredLevelOfAssurance,
 		},
 		SessionID:   writeModel.SessionID,
 		UserID:      writeModel.UserID,
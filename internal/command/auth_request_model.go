@@ -36,6 +36,8 @@ type AuthRequestWriteModel struct {
 	AuthMethods      []domain.UserAuthMethodType
 	AuthRequestState domain.AuthRequestState
 	NeedRefreshToken bool
+
+	RequiredLevelOfAssurance domain.LevelOfAssurance
 }
 
 func NewAuthRequestWriteModel(ctx context.Context, id string) *AuthRequestWriteModel {
@@ -68,6 +70,7 @@ func (m *AuthRequestWriteModel) Reduce() error {
 			m.HintUserID = e.HintUserID
 			m.AuthRequestState = domain.AuthRequestStateAdded
 			m.NeedRefreshToken = e.NeedRefreshToken
+			m.RequiredLevelOfAssurance = e.RequiredLevelOfAssurance
 		case *authrequest.SessionLinkedEvent:
 			m.SessionID = e.SessionID
 			m.UserID = e.UserID
@@ -0,0 +1,258 @@
+package command
+
+import (
+	"context"
+	"slices"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/group"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// AddGroup adds a group to the organization.
+// The id of the new group is set on the passed group.
+func (c *Commands) AddGroup(ctx context.Context, g *domain.Group, resourceOwner string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if resourceOwner == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Gr1ro", "Errors.ResourceOwnerMissing")
+	}
+	if !g.IsValid() {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Gr2nm", "Errors.Group.Invalid")
+	}
+	if err := c.checkOrgExists(ctx, resourceOwner); err != nil {
+		return nil, err
+	}
+	if g.AggregateID == "" {
+		g.AggregateID, err = c.idGenerator.Next()
+		if err != nil {
+			return nil, err
+		}
+	}
+	writeModel, err := c.groupWriteModelByID(ctx, g.AggregateID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if writeModel.State != domain.GroupStateUnspecified {
+		return nil, zerrors.ThrowAlreadyExists(nil, "COMMAND-Gr3ex", "Errors.Group.AlreadyExists")
+	}
+	return c.pushGroupCommands(ctx, writeModel,
+		group.NewAddedEvent(ctx, GroupAggregateFromWriteModel(&writeModel.WriteModel), g.Name, g.Description, g.ExternalID),
+	)
+}
+
+// ChangeGroup replaces the name, description and external id of the group.
+func (c *Commands) ChangeGroup(ctx context.Context, g *domain.Group) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if g.AggregateID == "" || !g.IsValid() {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Gr4nm", "Errors.Group.Invalid")
+	}
+	writeModel, err := c.existingGroupWriteModel(ctx, g.AggregateID, g.ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	changes := writeModel.NewChanges(g.Name, g.Description, g.ExternalID)
+	if len(changes) == 0 {
+		return writeModelToObjectDetails(&writeModel.WriteModel), nil
+	}
+	return c.pushGroupCommands(ctx, writeModel,
+		group.NewChangedEvent(ctx, GroupAggregateFromWriteModel(&writeModel.WriteModel), changes),
+	)
+}
+
+// RemoveGroup removes the group, its grants and its memberships in other groups.
+func (c *Commands) RemoveGroup(ctx context.Context, groupID, resourceOwner string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if groupID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Gr5id", "Errors.Group.Invalid")
+	}
+	writeModel, err := c.existingGroupWriteModel(ctx, groupID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	return c.pushGroupCommands(ctx, writeModel,
+		group.NewRemovedEvent(ctx, GroupAggregateFromWriteModel(&writeModel.WriteModel), writeModel.Name),
+	)
+}
+
+// AddGroupMembers adds the users to the group.
+// Users which are already members are ignored.
+func (c *Commands) AddGroupMembers(ctx context.Context, groupID, resourceOwner string, userIDs []string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel, err := c.existingGroupWriteModel(ctx, groupID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if err = c.checkGroupMembersInMemberScope(ctx, writeModel.ResourceOwner, groupID); err != nil {
+		return nil, err
+	}
+	cmds, err := c.addGroupMembers(ctx, writeModel, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	return c.pushGroupCommands(ctx, writeModel, cmds...)
+}
+
+// RemoveGroupMembers removes the users from the group.
+// Users which aren't members are ignored.
+func (c *Commands) RemoveGroupMembers(ctx context.Context, groupID, resourceOwner string, userIDs []string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel, err := c.existingGroupWriteModel(ctx, groupID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if err = c.checkGroupMembersInMemberScope(ctx, writeModel.ResourceOwner, groupID); err != nil {
+		return nil, err
+	}
+	return c.pushGroupCommands(ctx, writeModel, removeGroupMembers(ctx, writeModel, userIDs)...)
+}
+
+// SetGroupMembers replaces the users of the group (e.g. for a SCIM PUT request).
+func (c *Commands) SetGroupMembers(ctx context.Context, groupID, resourceOwner string, userIDs []string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel, err := c.existingGroupWriteModel(ctx, groupID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if err = c.checkGroupMembersInMemberScope(ctx, writeModel.ResourceOwner, groupID); err != nil {
+		return nil, err
+	}
+	cmds, err := c.addGroupMembers(ctx, writeModel, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	removed := slices.DeleteFunc(slices.Clone(writeModel.Members), func(id string) bool { return slices.Contains(userIDs, id) })
+	cmds = append(cmds, removeGroupMembers(ctx, writeModel, removed)...)
+	return c.pushGroupCommands(ctx, writeModel, cmds...)
+}
+
+func (c *Commands) addGroupMembers(ctx context.Context, writeModel *GroupWriteModel, userIDs []string) ([]eventstore.Command, error) {
+	cmds := make([]eventstore.Command, 0, len(userIDs))
+	for _, userID := range userIDs {
+		if userID == "" {
+			return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Gr6us", "Errors.Group.MemberInvalid")
+		}
+		if slices.Contains(writeModel.Members, userID) || slices.ContainsFunc(cmds, func(cmd eventstore.Command) bool {
+			return cmd.(*group.MemberAddedEvent).UserID == userID
+		}) {
+			continue
+		}
+		if err := c.checkUserExists(ctx, userID, ""); err != nil {
+			return nil, err
+		}
+		cmds = append(cmds, group.NewMemberAddedEvent(ctx, GroupAggregateFromWriteModel(&writeModel.WriteModel), userID))
+	}
+	return cmds, nil
+}
+
+func removeGroupMembers(ctx context.Context, writeModel *GroupWriteModel, userIDs []string) []eventstore.Command {
+	cmds := make([]eventstore.Command, 0, len(userIDs))
+	for _, userID := range userIDs {
+		if !slices.Contains(writeModel.Members, userID) || slices.ContainsFunc(cmds, func(cmd eventstore.Command) bool {
+			return cmd.(*group.MemberRemovedEvent).UserID == userID
+		}) {
+			continue
+		}
+		cmds = append(cmds, group.NewMemberRemovedEvent(ctx, GroupAggregateFromWriteModel(&writeModel.WriteModel), userID))
+	}
+	return cmds
+}
+
+// AddSubgroup nests a group of the same organization in the group.
+// The members of the subgroup inherit the grants of the group.
+func (c *Commands) AddSubgroup(ctx context.Context, groupID, subgroupID, resourceOwner string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if subgroupID == "" || subgroupID == groupID {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Gr7sg", "Errors.Group.SubgroupInvalid")
+	}
+	writeModel, err := c.existingGroupWriteModel(ctx, groupID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if slices.Contains(writeModel.Subgroups, subgroupID) {
+		return writeModelToObjectDetails(&writeModel.WriteModel), nil
+	}
+	if _, err = c.existingGroupWriteModel(ctx, subgroupID, writeModel.ResourceOwner); err != nil {
+		return nil, err
+	}
+	hierarchy := NewOrgGroupHierarchyWriteModel(writeModel.ResourceOwner)
+	if err = c.eventstore.FilterToQueryReducer(ctx, hierarchy); err != nil {
+		return nil, err
+	}
+	if hierarchy.Contains(subgroupID, groupID) {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Gr8cy", "Errors.Group.SubgroupCycle")
+	}
+	return c.pushGroupCommands(ctx, writeModel,
+		group.NewSubgroupAddedEvent(ctx, GroupAggregateFromWriteModel(&writeModel.WriteModel), subgroupID),
+	)
+}
+
+func (c *Commands) RemoveSubgroup(ctx context.Context, groupID, subgroupID, resourceOwner string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel, err := c.existingGroupWriteModel(ctx, groupID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(writeModel.Subgroups, subgroupID) {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Gr9sg", "Errors.Group.SubgroupNotFound")
+	}
+	return c.pushGroupCommands(ctx, writeModel,
+		group.NewSubgroupRemovedEvent(ctx, GroupAggregateFromWriteModel(&writeModel.WriteModel), subgroupID),
+	)
+}
+
+func (c *Commands) existingGroupWriteModel(ctx context.Context, groupID, resourceOwner string) (*GroupWriteModel, error) {
+	if groupID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Gr0id", "Errors.Group.Invalid")
+	}
+	writeModel, err := c.groupWriteModelByID(ctx, groupID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !writeModel.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Gr0nf", "Errors.Group.NotFound")
+	}
+	return writeModel, nil
+}
+
+func (c *Commands) groupWriteModelByID(ctx context.Context, groupID, resourceOwner string) (_ *GroupWriteModel, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel := NewGroupWriteModel(groupID, resourceOwner)
+	if err = c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
+
+func GroupAggregateFromWriteModel(wm *eventstore.WriteModel) *eventstore.Aggregate {
+	return group.NewAggregate(wm.AggregateID, wm.ResourceOwner)
+}
+
+func (c *Commands) pushGroupCommands(ctx context.Context, writeModel *GroupWriteModel, cmds ...eventstore.Command) (*domain.ObjectDetails, error) {
+	if len(cmds) == 0 {
+		return writeModelToObjectDetails(&writeModel.WriteModel), nil
+	}
+	if err := c.pushAppendAndReduce(ctx, writeModel, cmds...); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
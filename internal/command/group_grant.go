@@ -0,0 +1,115 @@
+package command
+
+import (
+	"context"
+	"slices"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/feature"
+	"github.com/zitadel/zitadel/internal/repository/group"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// AddGroupGrant grants roles of a project (or project grant) to all members of the group.
+func (c *Commands) AddGroupGrant(ctx context.Context, grant *domain.GroupGrant, resourceOwner string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if !grant.IsValid() {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Gg1iv", "Errors.Group.Grant.Invalid")
+	}
+	writeModel, err := c.existingGroupWriteModel(ctx, grant.GroupID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := writeModel.Grants[groupGrantKey(grant.ProjectID, grant.ProjectGrantID)]; ok {
+		return nil, zerrors.ThrowAlreadyExists(nil, "COMMAND-Gg2ex", "Errors.Group.Grant.AlreadyExists")
+	}
+	if err = c.checkGroupGrantPreCondition(ctx, grant, writeModel.ResourceOwner); err != nil {
+		return nil, err
+	}
+	return c.pushGroupCommands(ctx, writeModel,
+		group.NewGrantAddedEvent(ctx, GroupAggregateFromWriteModel(&writeModel.WriteModel), grant.ProjectID, grant.ProjectGrantID, grant.RoleKeys),
+	)
+}
+
+// ChangeGroupGrant replaces the granted roles of the group.
+func (c *Commands) ChangeGroupGrant(ctx context.Context, grant *domain.GroupGrant, resourceOwner string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if !grant.IsValid() {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Gg3iv", "Errors.Group.Grant.Invalid")
+	}
+	writeModel, err := c.existingGroupWriteModel(ctx, grant.GroupID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	existing, ok := writeModel.Grants[groupGrantKey(grant.ProjectID, grant.ProjectGrantID)]
+	if !ok {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Gg4nf", "Errors.Group.Grant.NotFound")
+	}
+	if len(existing) == len(grant.RoleKeys) && !slices.ContainsFunc(grant.RoleKeys, func(key string) bool { return !slices.Contains(existing, key) }) {
+		return writeModelToObjectDetails(&writeModel.WriteModel), nil
+	}
+	if err = c.checkGroupGrantPreCondition(ctx, grant, writeModel.ResourceOwner); err != nil {
+		return nil, err
+	}
+	return c.pushGroupCommands(ctx, writeModel,
+		group.NewGrantChangedEvent(ctx, GroupAggregateFromWriteModel(&writeModel.WriteModel), grant.ProjectID, grant.ProjectGrantID, grant.RoleKeys),
+	)
+}
+
+func (c *Commands) RemoveGroupGrant(ctx context.Context, groupID, projectID, projectGrantID, resourceOwner string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel, err := c.existingGroupWriteModel(ctx, groupID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := writeModel.Grants[groupGrantKey(projectID, projectGrantID)]; !ok {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Gg5nf", "Errors.Group.Grant.NotFound")
+	}
+	return c.pushGroupCommands(ctx, writeModel,
+		group.NewGrantRemovedEvent(ctx, GroupAggregateFromWriteModel(&writeModel.WriteModel), projectID, projectGrantID),
+	)
+}
+
+// checkGroupGrantPreCondition checks the same conditions as for user grants, except the existence of a user.
+func (c *Commands) checkGroupGrantPreCondition(ctx context.Context, grant *domain.GroupGrant, resourceOwner string) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if authz.GetFeatures(ctx).ShouldUseImprovedPerformance(feature.ImprovedPerformanceTypeUserGrant) {
+		existingRoleKeys, err := c.searchUserGrantPreConditionState(ctx, &domain.UserGrant{
+			ProjectID:      grant.ProjectID,
+			ProjectGrantID: grant.ProjectGrantID,
+			RoleKeys:       grant.RoleKeys,
+		}, resourceOwner)
+		if err != nil {
+			return err
+		}
+		if grant.HasInvalidRoles(existingRoleKeys) {
+			return zerrors.ThrowPreconditionFailed(nil, "COMMAND-Gg6ro", "Errors.Project.Role.NotFound")
+		}
+		return nil
+	}
+
+	preConditions := NewUserGrantPreConditionReadModel("", grant.ProjectID, grant.ProjectGrantID, resourceOwner)
+	if err = c.eventstore.FilterToQueryReducer(ctx, preConditions); err != nil {
+		return err
+	}
+	if grant.ProjectGrantID == "" && !preConditions.ProjectExists {
+		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-Gg7pr", "Errors.Project.NotFound")
+	}
+	if grant.ProjectGrantID != "" && !preConditions.ProjectGrantExists {
+		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-Gg8pg", "Errors.Project.Grant.NotFound")
+	}
+	if grant.HasInvalidRoles(preConditions.ExistingRoleKeys) {
+		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-Gg9ro", "Errors.Project.Role.NotFound")
+	}
+	return nil
+}
@@ -0,0 +1,180 @@
+package command
+
+import (
+	"slices"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/group"
+)
+
+type GroupWriteModel struct {
+	eventstore.WriteModel
+
+	Name        string
+	Description string
+	ExternalID  string
+	State       domain.GroupState
+	Members     []string
+	Subgroups   []string
+	// Grants are the role keys granted to the group by the key of the project (and project grant)
+	Grants map[string][]string
+}
+
+func NewGroupWriteModel(groupID, resourceOwner string) *GroupWriteModel {
+	return &GroupWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   groupID,
+			ResourceOwner: resourceOwner,
+		},
+		Grants: make(map[string][]string),
+	}
+}
+
+func (wm *GroupWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *group.AddedEvent:
+			wm.Name = e.Name
+			wm.Description = e.Description
+			wm.ExternalID = e.ExternalID
+			wm.State = domain.GroupStateActive
+		case *group.ChangedEvent:
+			if e.Name != nil {
+				wm.Name = *e.Name
+			}
+			if e.Description != nil {
+				wm.Description = *e.Description
+			}
+			if e.ExternalID != nil {
+				wm.ExternalID = *e.ExternalID
+			}
+		case *group.RemovedEvent:
+			wm.State = domain.GroupStateRemoved
+			wm.Members = nil
+			wm.Subgroups = nil
+			wm.Grants = make(map[string][]string)
+		case *group.MemberAddedEvent:
+			wm.Members = append(wm.Members, e.UserID)
+		case *group.MemberRemovedEvent:
+			wm.Members = slices.DeleteFunc(wm.Members, func(id string) bool { return id == e.UserID })
+		case *group.SubgroupAddedEvent:
+			wm.Subgroups = append(wm.Subgroups, e.GroupID)
+		case *group.SubgroupRemovedEvent:
+			wm.Subgroups = slices.DeleteFunc(wm.Subgroups, func(id string) bool { return id == e.GroupID })
+		case *group.GrantAddedEvent:
+			wm.Grants[groupGrantKey(e.ProjectID, e.ProjectGrantID)] = e.RoleKeys
+		case *group.GrantChangedEvent:
+			wm.Grants[groupGrantKey(e.ProjectID, e.ProjectGrantID)] = e.RoleKeys
+		case *group.GrantRemovedEvent:
+			delete(wm.Grants, groupGrantKey(e.ProjectID, e.ProjectGrantID))
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *GroupWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(group.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			group.AddedEventType,
+			group.ChangedEventType,
+			group.RemovedEventType,
+			group.MemberAddedEventType,
+			group.MemberRemovedEventType,
+			group.SubgroupAddedEventType,
+			group.SubgroupRemovedEventType,
+			group.GrantAddedEventType,
+			group.GrantChangedEventType,
+			group.GrantRemovedEventType,
+		).
+		Builder()
+}
+
+func (wm *GroupWriteModel) NewChanges(name, description, externalID string) []group.Changes {
+	changes := make([]group.Changes, 0, 3)
+	if wm.Name != name {
+		changes = append(changes, group.ChangeName(wm.Name, name))
+	}
+	if wm.Description != description {
+		changes = append(changes, group.ChangeDescription(description))
+	}
+	if wm.ExternalID != externalID {
+		changes = append(changes, group.ChangeExternalID(externalID))
+	}
+	return changes
+}
+
+func groupGrantKey(projectID, projectGrantID string) string {
+	return projectID + ":" + projectGrantID
+}
+
+// OrgGroupHierarchyWriteModel are the nested groups of an organization,
+// which are used to prevent cycles.
+type OrgGroupHierarchyWriteModel struct {
+	eventstore.WriteModel
+
+	// Subgroups are the ids of the direct subgroups by the id of the group
+	Subgroups map[string][]string
+}
+
+func NewOrgGroupHierarchyWriteModel(resourceOwner string) *OrgGroupHierarchyWriteModel {
+	return &OrgGroupHierarchyWriteModel{
+		WriteModel: eventstore.WriteModel{
+			ResourceOwner: resourceOwner,
+		},
+		Subgroups: make(map[string][]string),
+	}
+}
+
+func (wm *OrgGroupHierarchyWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *group.SubgroupAddedEvent:
+			wm.Subgroups[e.Aggregate().ID] = append(wm.Subgroups[e.Aggregate().ID], e.GroupID)
+		case *group.SubgroupRemovedEvent:
+			wm.Subgroups[e.Aggregate().ID] = slices.DeleteFunc(wm.Subgroups[e.Aggregate().ID], func(id string) bool { return id == e.GroupID })
+		case *group.RemovedEvent:
+			delete(wm.Subgroups, e.Aggregate().ID)
+			for id, subgroups := range wm.Subgroups {
+				wm.Subgroups[id] = slices.DeleteFunc(subgroups, func(id string) bool { return id == e.Aggregate().ID })
+			}
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *OrgGroupHierarchyWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(group.AggregateType).
+		EventTypes(
+			group.RemovedEventType,
+			group.SubgroupAddedEventType,
+			group.SubgroupRemovedEventType,
+		).
+		Builder()
+}
+
+// Contains returns true if the group with the id is the group itself or one of its (transitive) subgroups.
+func (wm *OrgGroupHierarchyWriteModel) Contains(groupID, id string) bool {
+	visited := make(map[string]struct{})
+	stack := []string{groupID}
+	for len(stack) > 0 {
+		current := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if current == id {
+			return true
+		}
+		if _, ok := visited[current]; ok {
+			continue
+		}
+		visited[current] = struct{}{}
+		stack = append(stack, wm.Subgroups[current]...)
+	}
+	return false
+}
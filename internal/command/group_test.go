@@ -0,0 +1,572 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	es_models "github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/id"
+	id_mock "github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/repository/group"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func groupAddedEvent(groupID, name string) eventstore.Event {
+	return eventFromEventPusher(
+		group.NewAddedEvent(context.Background(),
+			group.NewAggregate(groupID, "org1"),
+			name,
+			"",
+			"",
+		),
+	)
+}
+
+func groupUserAddedEvent(userID string) eventstore.Event {
+	return eventFromEventPusher(
+		user.NewHumanAddedEvent(context.Background(),
+			&user.NewAggregate(userID, "org1").Aggregate,
+			"username",
+			"firstname",
+			"lastname",
+			"nickname",
+			"displayname",
+			language.German,
+			domain.GenderUnspecified,
+			"email@test.ch",
+			true,
+		),
+	)
+}
+
+func TestCommands_AddGroup(t *testing.T) {
+	type fields struct {
+		eventstore  func(*testing.T) *eventstore.Eventstore
+		idGenerator id.Generator
+	}
+	type args struct {
+		group         *domain.Group
+		resourceOwner string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "missing name, invalid argument",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				group:         &domain.Group{},
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "org not found, precondition failed",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				group:         &domain.Group{Name: "group"},
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "add group, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewOrgAddedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								"org",
+							),
+						),
+					),
+					expectFilter(),
+					expectPush(
+						group.NewAddedEvent(context.Background(),
+							group.NewAggregate("group1", "org1"),
+							"group",
+							"description",
+							"external",
+						),
+					),
+				),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "group1"),
+			},
+			args: args{
+				group: &domain.Group{
+					Name:        "group",
+					Description: "description",
+					ExternalID:  "external",
+				},
+				resourceOwner: "org1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:  tt.fields.eventstore(t),
+				idGenerator: tt.fields.idGenerator,
+			}
+			got, err := c.AddGroup(context.Background(), tt.args.group, tt.args.resourceOwner)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+				assert.Equal(t, "group1", tt.args.group.AggregateID)
+			}
+		})
+	}
+}
+
+func TestCommands_ChangeGroup(t *testing.T) {
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		group  *domain.Group
+		res    res
+	}{
+		{
+			name: "not found, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			group: &domain.Group{ObjectRoot: objectRoot("group1"), Name: "group"},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "no changes, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						groupAddedEvent("group1", "group"),
+					),
+				),
+			},
+			group: &domain.Group{ObjectRoot: objectRoot("group1"), Name: "group"},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			name: "change name, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						groupAddedEvent("group1", "group"),
+					),
+					expectPush(
+						group.NewChangedEvent(context.Background(),
+							group.NewAggregate("group1", "org1"),
+							[]group.Changes{
+								group.ChangeName("group", "renamed"),
+							},
+						),
+					),
+				),
+			},
+			group: &domain.Group{ObjectRoot: objectRoot("group1"), Name: "renamed"},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := c.ChangeGroup(context.Background(), tt.group)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommands_SetGroupMembers(t *testing.T) {
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		userIDs []string
+		res     res
+	}{
+		{
+			name: "user not found, precondition failed",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						groupAddedEvent("group1", "group"),
+					),
+					expectFilter(),
+				),
+			},
+			userIDs: []string{"user1"},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "replace members, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						groupAddedEvent("group1", "group"),
+						eventFromEventPusher(
+							group.NewMemberAddedEvent(context.Background(),
+								group.NewAggregate("group1", "org1"),
+								"user1",
+							),
+						),
+						eventFromEventPusher(
+							group.NewMemberAddedEvent(context.Background(),
+								group.NewAggregate("group1", "org1"),
+								"user2",
+							),
+						),
+					),
+					expectFilter(
+						groupUserAddedEvent("user3"),
+					),
+					expectPush(
+						group.NewMemberAddedEvent(context.Background(),
+							group.NewAggregate("group1", "org1"),
+							"user3",
+						),
+						group.NewMemberRemovedEvent(context.Background(),
+							group.NewAggregate("group1", "org1"),
+							"user1",
+						),
+					),
+				),
+			},
+			userIDs: []string{"user2", "user3", "user3"},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := c.SetGroupMembers(context.Background(), "group1", "org1", tt.userIDs)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommands_AddSubgroup(t *testing.T) {
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		res    res
+	}{
+		{
+			name: "subgroup not found, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						groupAddedEvent("group1", "group"),
+					),
+					expectFilter(),
+				),
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "cycle, precondition failed",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						groupAddedEvent("group1", "group"),
+					),
+					expectFilter(
+						groupAddedEvent("group2", "subgroup"),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							group.NewSubgroupAddedEvent(context.Background(),
+								group.NewAggregate("group2", "org1"),
+								"group3",
+							),
+						),
+						eventFromEventPusher(
+							group.NewSubgroupAddedEvent(context.Background(),
+								group.NewAggregate("group3", "org1"),
+								"group1",
+							),
+						),
+					),
+				),
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "add subgroup, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						groupAddedEvent("group1", "group"),
+					),
+					expectFilter(
+						groupAddedEvent("group2", "subgroup"),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							group.NewSubgroupAddedEvent(context.Background(),
+								group.NewAggregate("group1", "org1"),
+								"group3",
+							),
+						),
+					),
+					expectPush(
+						group.NewSubgroupAddedEvent(context.Background(),
+							group.NewAggregate("group1", "org1"),
+							"group2",
+						),
+					),
+				),
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := c.AddSubgroup(context.Background(), "group1", "group2", "org1")
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommands_AddGroupGrant(t *testing.T) {
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		grant  *domain.GroupGrant
+		res    res
+	}{
+		{
+			name: "missing project, invalid argument",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			grant: &domain.GroupGrant{GroupID: "group1"},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "grant already exists, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						groupAddedEvent("group1", "group"),
+						eventFromEventPusher(
+							group.NewGrantAddedEvent(context.Background(),
+								group.NewAggregate("group1", "org1"),
+								"project1",
+								"",
+								[]string{"role"},
+							),
+						),
+					),
+				),
+			},
+			grant: &domain.GroupGrant{GroupID: "group1", ProjectID: "project1", RoleKeys: []string{"role"}},
+			res: res{
+				err: zerrors.IsErrorAlreadyExists,
+			},
+		},
+		{
+			name: "role not found, precondition failed",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						groupAddedEvent("group1", "group"),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"project", false, false, false,
+								domain.PrivateLabelingSettingUnspecified,
+							),
+						),
+					),
+				),
+			},
+			grant: &domain.GroupGrant{GroupID: "group1", ProjectID: "project1", RoleKeys: []string{"role"}},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "add grant, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						groupAddedEvent("group1", "group"),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"project", false, false, false,
+								domain.PrivateLabelingSettingUnspecified,
+							),
+						),
+						eventFromEventPusher(
+							project.NewRoleAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"role", "role", "",
+							),
+						),
+					),
+					expectPush(
+						group.NewGrantAddedEvent(context.Background(),
+							group.NewAggregate("group1", "org1"),
+							"project1",
+							"",
+							[]string{"role"},
+						),
+					),
+				),
+			},
+			grant: &domain.GroupGrant{GroupID: "group1", ProjectID: "project1", RoleKeys: []string{"role"}},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := c.AddGroupGrant(context.Background(), tt.grant, "org1")
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func objectRoot(id string) es_models.ObjectRoot {
+	return es_models.ObjectRoot{AggregateID: id, ResourceOwner: "org1"}
+}
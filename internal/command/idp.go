@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/zitadel/zitadel/internal/command/preparation"
+	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/repository/idp"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
@@ -20,6 +21,7 @@ type GenericOAuthProvider struct {
 	UserEndpoint          string
 	Scopes                []string
 	IDAttribute           string
+	UsePKCE               bool
 	IDPOptions            idp.Options
 }
 
@@ -60,6 +62,22 @@ type GitHubProvider struct {
 	IDPOptions   idp.Options
 }
 
+type BitbucketProvider struct {
+	Name         string
+	ClientID     string
+	ClientSecret string
+	Scopes       []string
+	IDPOptions   idp.Options
+}
+
+type DiscordProvider struct {
+	Name         string
+	ClientID     string
+	ClientSecret string
+	Scopes       []string
+	IDPOptions   idp.Options
+}
+
 type GitHubEnterpriseProvider struct {
 	Name                  string
 	ClientID              string
@@ -88,6 +106,33 @@ type GitLabSelfHostedProvider struct {
 	IDPOptions   idp.Options
 }
 
+type ADFSProvider struct {
+	Name         string
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	Scopes       []string
+	IDPOptions   idp.Options
+}
+
+type KeycloakProvider struct {
+	Name         string
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	Scopes       []string
+	IDPOptions   idp.Options
+}
+
+type OktaProvider struct {
+	Name         string
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	Scopes       []string
+	IDPOptions   idp.Options
+}
+
 type GoogleProvider struct {
 	Name         string
 	ClientID     string
@@ -217,3 +262,12 @@ func IDPProviderWriteModel(ctx context.Context, filter preparation.FilterToQuery
 
 	return allWriteModel, err
 }
+
+// newCodeVerifierKey creates a new encrypted key to derive the PKCE code verifier of an identity provider
+func (c *Commands) newCodeVerifierKey() (*crypto.CryptoValue, error) {
+	key, err := c.codeVerifierKeyGenerator()
+	if err != nil {
+		return nil, err
+	}
+	return crypto.Encrypt(key, c.idpConfigEncryption)
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/crewjam/saml"
 	"github.com/crewjam/saml/samlsp"
+	"github.com/zitadel/logging"
 	"github.com/zitadel/oidc/v3/pkg/oidc"
 
 	"github.com/zitadel/zitadel/internal/command/preparation"
@@ -18,6 +19,7 @@ import (
 	"github.com/zitadel/zitadel/internal/idp"
 	"github.com/zitadel/zitadel/internal/idp/providers/apple"
 	"github.com/zitadel/zitadel/internal/idp/providers/azuread"
+	"github.com/zitadel/zitadel/internal/idp/providers/bitbucket"
 	"github.com/zitadel/zitadel/internal/idp/providers/jwt"
 	"github.com/zitadel/zitadel/internal/idp/providers/oauth"
 	openid "github.com/zitadel/zitadel/internal/idp/providers/oidc"
@@ -181,7 +183,7 @@ func (c *Commands) SucceedIDPIntent(ctx context.Context, writeModel *IDPIntentWr
 		accessToken,
 		idToken,
 	)
-	err = c.pushAppendAndReduce(ctx, writeModel, cmd)
+	err = c.pushIDPIntentSucceeded(ctx, writeModel, cmd, idpInfo, nil, userID)
 	if err != nil {
 		return "", err
 	}
@@ -214,7 +216,7 @@ func (c *Commands) SucceedSAMLIDPIntent(ctx context.Context, writeModel *IDPInte
 		userID,
 		assertionEnc,
 	)
-	err = c.pushAppendAndReduce(ctx, writeModel, cmd)
+	err = c.pushIDPIntentSucceeded(ctx, writeModel, cmd, idpInfo, nil, userID)
 	if err != nil {
 		return "", err
 	}
@@ -255,13 +257,41 @@ func (c *Commands) SucceedLDAPIDPIntent(ctx context.Context, writeModel *IDPInte
 		userID,
 		attributes,
 	)
-	err = c.pushAppendAndReduce(ctx, writeModel, cmd)
+	err = c.pushIDPIntentSucceeded(ctx, writeModel, cmd, idpInfo, attributes, userID)
 	if err != nil {
 		return "", err
 	}
 	return token, nil
 }
 
+// pushIDPIntentSucceeded pushes the succeeded event of the intent
+// and applies the mapping rules of the IdP to the linked user.
+func (c *Commands) pushIDPIntentSucceeded(ctx context.Context, writeModel *IDPIntentWriteModel, cmd eventstore.Command, idpInfo []byte, attributes map[string][]string, userID string) error {
+	if err := c.pushAppendAndReduce(ctx, writeModel, cmd); err != nil {
+		return err
+	}
+	c.applyIDPMappingRules(ctx, writeModel.IDPID, userID, idpInfo, attributes)
+	return nil
+}
+
+// applyIDPMappingRules pushes the changes on the user resulting from the mapping rules of the IdP.
+// The changes are pushed on their own and failures are only logged,
+// as the mapping rules must not prevent the user from logging in.
+func (c *Commands) applyIDPMappingRules(ctx context.Context, idpID, userID string, idpInfo []byte, attributes map[string][]string) {
+	if userID == "" {
+		return
+	}
+	claims, err := idpUserClaims(idpInfo, attributes)
+	if err == nil {
+		var cmds []eventstore.Command
+		cmds, err = c.idpMappingCommands(ctx, idpID, userID, claims)
+		if err == nil && len(cmds) > 0 {
+			_, err = c.eventstore.Push(ctx, cmds...)
+		}
+	}
+	logging.WithFields("idpID", idpID, "userID", userID).OnError(err).Warn("unable to apply idp mapping rules")
+}
+
 func (c *Commands) FailIDPIntent(ctx context.Context, writeModel *IDPIntentWriteModel, reason string) error {
 	cmd := idpintent.NewFailedEvent(
 		ctx,
@@ -293,6 +323,8 @@ func tokensForSucceededIDPIntent(session idp.Session, encryptionAlg crypto.Encry
 		tokens = s.Tokens
 	case *azuread.Session:
 		tokens = s.Tokens()
+	case *bitbucket.Session:
+		tokens = s.Tokens()
 	case *apple.Session:
 		tokens = s.Tokens
 	default:
@@ -30,6 +30,9 @@ import (
 	"github.com/zitadel/zitadel/internal/repository/idpintent"
 	"github.com/zitadel/zitadel/internal/repository/instance"
 	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/repository/usergrant"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
@@ -182,6 +185,8 @@ func TestCommands_CreateIntent(t *testing.T) {
 								"user",
 								"idAttribute",
 								nil,
+								false,
+								nil,
 								rep_idp.Options{},
 							)),
 					),
@@ -235,6 +240,8 @@ func TestCommands_CreateIntent(t *testing.T) {
 								"user",
 								"idAttribute",
 								nil,
+								false,
+								nil,
 								rep_idp.Options{},
 							)),
 					),
@@ -288,6 +295,8 @@ func TestCommands_CreateIntent(t *testing.T) {
 								"user",
 								"idAttribute",
 								nil,
+								false,
+								nil,
 								rep_idp.Options{},
 							)),
 					),
@@ -402,6 +411,8 @@ func TestCommands_AuthFromProvider(t *testing.T) {
 								"user",
 								"idAttribute",
 								nil,
+								false,
+								nil,
 								rep_idp.Options{},
 							)),
 						eventFromEventPusherWithInstanceID(
@@ -446,6 +457,8 @@ func TestCommands_AuthFromProvider(t *testing.T) {
 								"user",
 								"idAttribute",
 								nil,
+								false,
+								nil,
 								rep_idp.Options{},
 							)),
 					),
@@ -467,6 +480,8 @@ func TestCommands_AuthFromProvider(t *testing.T) {
 								"user",
 								"idAttribute",
 								nil,
+								false,
+								nil,
 								rep_idp.Options{},
 							)),
 					),
@@ -841,6 +856,232 @@ func TestCommands_SucceedIDPIntent(t *testing.T) {
 				token: "aWQ",
 			},
 		},
+		{
+			"push with mapping rules",
+			fields{
+				idpConfigEncryption: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+				eventstore: expectEventstore(
+					expectPush(
+						idpintent.NewSucceededEvent(
+							context.Background(),
+							&idpintent.NewAggregate("id", "instance").Aggregate,
+							[]byte(`{"department":"sales","given_name":"Alice","groups":["admins"],"sub":"id"}`),
+							"id",
+							"",
+							"user1",
+							nil,
+							"",
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewIDPMappingRulesSetEvent(context.Background(), &instance.NewAggregate("instance").Aggregate,
+								"idp",
+								rep_idp.MappingRules{
+									ProfileMappings: []rep_idp.ProfileMapping{
+										{Claim: "given_name", Field: domain.IDPMappingProfileFieldFirstName},
+									},
+									MetadataMappings: []rep_idp.MetadataMapping{
+										{Claim: "department", Key: "department"},
+									},
+									GroupsClaim: "groups",
+									GroupMappings: []rep_idp.GroupMapping{
+										{Group: "admins", ProjectID: "project1", RoleKeys: []string{"admin"}},
+										{Group: "viewers", ProjectID: "project1", RoleKeys: []string{"viewer"}},
+									},
+								},
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"username",
+								"firstname",
+								"lastname",
+								"",
+								"firstname lastname",
+								language.English,
+								domain.GenderUnspecified,
+								"email@test.ch",
+								true,
+							),
+						),
+					),
+					expectFilter(),
+					expectFilter(
+						eventFromEventPusher(
+							usergrant.NewUserGrantAddedEvent(context.Background(),
+								&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
+								"user1",
+								"project1",
+								"",
+								[]string{"viewer", "other"},
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							usergrant.NewUserGrantAddedEvent(context.Background(),
+								&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
+								"user1",
+								"project1",
+								"",
+								[]string{"viewer", "other"},
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"username",
+								"firstname",
+								"lastname",
+								"",
+								"firstname lastname",
+								language.English,
+								domain.GenderUnspecified,
+								"email@test.ch",
+								true,
+							),
+						),
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"projectname1", true, true, true,
+								domain.PrivateLabelingSettingUnspecified,
+							),
+						),
+						eventFromEventPusher(
+							project.NewRoleAddedEvent(context.Background(), &project.NewAggregate("project1", "org1").Aggregate, "admin", "admin", ""),
+						),
+						eventFromEventPusher(
+							project.NewRoleAddedEvent(context.Background(), &project.NewAggregate("project1", "org1").Aggregate, "viewer", "viewer", ""),
+						),
+						eventFromEventPusher(
+							project.NewRoleAddedEvent(context.Background(), &project.NewAggregate("project1", "org1").Aggregate, "other", "other", ""),
+						),
+					),
+					expectPush(
+						func() eventstore.Command {
+							event, _ := user.NewHumanProfileChangedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								[]user.ProfileChanges{user.ChangeFirstName("Alice")},
+							)
+							return event
+						}(),
+						user.NewMetadataSetEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+							"department",
+							[]byte("sales"),
+						),
+						usergrant.NewUserGrantChangedEvent(context.Background(),
+							&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
+							[]string{"other", "admin"},
+						),
+					),
+				),
+			},
+			args{
+				ctx: authz.WithInstanceID(context.Background(), "instance"),
+				writeModel: func() *IDPIntentWriteModel {
+					writeModel := NewIDPIntentWriteModel("id", "instance")
+					writeModel.IDPID = "idp"
+					return writeModel
+				}(),
+				idpUser: openid.NewUser(&oidc.UserInfo{
+					Subject: "id",
+					UserInfoProfile: oidc.UserInfoProfile{
+						GivenName: "Alice",
+					},
+					Claims: map[string]any{
+						"groups":     []any{"admins"},
+						"department": "sales",
+					},
+				}),
+				userID: "user1",
+			},
+			res{
+				token: "aWQ",
+			},
+		},
+		{
+			"push with mapping rules, mapping conflict ignored",
+			fields{
+				idpConfigEncryption: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+				eventstore: expectEventstore(
+					expectPush(
+						idpintent.NewSucceededEvent(
+							context.Background(),
+							&idpintent.NewAggregate("id", "instance").Aggregate,
+							[]byte(`{"sub":"id","given_name":"Alice"}`),
+							"id",
+							"",
+							"user1",
+							nil,
+							"",
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewIDPMappingRulesSetEvent(context.Background(), &instance.NewAggregate("instance").Aggregate,
+								"idp",
+								rep_idp.MappingRules{
+									ProfileMappings: []rep_idp.ProfileMapping{
+										{Claim: "given_name", Field: domain.IDPMappingProfileFieldFirstName},
+									},
+								},
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"username",
+								"firstname",
+								"lastname",
+								"",
+								"firstname lastname",
+								language.English,
+								domain.GenderUnspecified,
+								"email@test.ch",
+								true,
+							),
+						),
+					),
+					expectPushFailed(zerrors.ThrowAlreadyExists(nil, "id", "conflict"),
+						func() eventstore.Command {
+							event, _ := user.NewHumanProfileChangedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								[]user.ProfileChanges{user.ChangeFirstName("Alice")},
+							)
+							return event
+						}(),
+					),
+				),
+			},
+			args{
+				ctx: authz.WithInstanceID(context.Background(), "instance"),
+				writeModel: func() *IDPIntentWriteModel {
+					writeModel := NewIDPIntentWriteModel("id", "instance")
+					writeModel.IDPID = "idp"
+					return writeModel
+				}(),
+				idpUser: openid.NewUser(&oidc.UserInfo{
+					Subject: "id",
+					UserInfoProfile: oidc.UserInfoProfile{
+						GivenName: "Alice",
+					},
+				}),
+				userID: "user1",
+			},
+			res{
+				token: "aWQ",
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -0,0 +1,398 @@
+package command
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/zitadel/logging"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/idp"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/repository/usergrant"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// SetInstanceIDPMappingRules replaces the mapping rules of an instance IdP.
+// Passing empty rules removes all of them.
+func (c *Commands) SetInstanceIDPMappingRules(ctx context.Context, id string, rules idp.MappingRules) (*domain.ObjectDetails, error) {
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "INST-Wq3bf", "Errors.IDMissing")
+	}
+	if err := validateIDPMappingRules(rules); err != nil {
+		return nil, err
+	}
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	existing := NewInstanceIDPRemoveWriteModel(instanceID, id)
+	if err := c.eventstore.FilterToQueryReducer(ctx, existing); err != nil {
+		return nil, err
+	}
+	if !existing.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "INST-Hs8ga", "Errors.IDPConfig.NotExisting")
+	}
+	return c.setIDPMappingRules(ctx, id, rules, func(ctx context.Context) eventstore.Command {
+		return instance.NewIDPMappingRulesSetEvent(ctx, &instance.NewAggregate(instanceID).Aggregate, id, rules)
+	})
+}
+
+// SetOrgIDPMappingRules replaces the mapping rules of an organization IdP.
+// Passing empty rules removes all of them.
+func (c *Commands) SetOrgIDPMappingRules(ctx context.Context, resourceOwner, id string, rules idp.MappingRules) (*domain.ObjectDetails, error) {
+	if resourceOwner == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "ORG-Vd2qr", "Errors.ResourceOwnerMissing")
+	}
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "ORG-Wq3bf", "Errors.IDMissing")
+	}
+	if err := validateIDPMappingRules(rules); err != nil {
+		return nil, err
+	}
+	existing := NewOrgIDPRemoveWriteModel(resourceOwner, id)
+	if err := c.eventstore.FilterToQueryReducer(ctx, existing); err != nil {
+		return nil, err
+	}
+	if !existing.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "ORG-Hs8ga", "Errors.IDPConfig.NotExisting")
+	}
+	return c.setIDPMappingRules(ctx, id, rules, func(ctx context.Context) eventstore.Command {
+		return org.NewIDPMappingRulesSetEvent(ctx, &org.NewAggregate(resourceOwner).Aggregate, id, rules)
+	})
+}
+
+func (c *Commands) setIDPMappingRules(ctx context.Context, id string, rules idp.MappingRules, setEvent func(context.Context) eventstore.Command) (*domain.ObjectDetails, error) {
+	writeModel := NewIDPMappingRulesWriteModel(id)
+	if err := c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	if reflect.DeepEqual(writeModel.Rules, rules) {
+		return writeModelToObjectDetails(&writeModel.WriteModel), nil
+	}
+	if err := c.pushAppendAndReduce(ctx, writeModel, setEvent(ctx)); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+func validateIDPMappingRules(rules idp.MappingRules) error {
+	for _, mapping := range rules.ProfileMappings {
+		if strings.TrimSpace(mapping.Claim) == "" || !mapping.Field.Valid() {
+			return zerrors.ThrowInvalidArgument(nil, "COMMAND-Jq2fx", "Errors.Invalid.Argument")
+		}
+	}
+	for _, mapping := range rules.MetadataMappings {
+		if strings.TrimSpace(mapping.Claim) == "" || strings.TrimSpace(mapping.Key) == "" {
+			return zerrors.ThrowInvalidArgument(nil, "COMMAND-Jq2fy", "Errors.Invalid.Argument")
+		}
+	}
+	if len(rules.GroupMappings) > 0 && strings.TrimSpace(rules.GroupsClaim) == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Jq2fz", "Errors.Invalid.Argument")
+	}
+	for _, mapping := range rules.GroupMappings {
+		if strings.TrimSpace(mapping.Group) == "" || mapping.ProjectID == "" || len(mapping.RoleKeys) == 0 {
+			return zerrors.ThrowInvalidArgument(nil, "COMMAND-Jq2g0", "Errors.Invalid.Argument")
+		}
+	}
+	return nil
+}
+
+// idpMappingCommands evaluates the mapping rules of the IdP against the raw information of the external user
+// and returns the commands needed to update the profile, metadata and user grants of the linked user.
+// If no user is linked (yet), no commands are returned.
+func (c *Commands) idpMappingCommands(ctx context.Context, idpID, userID string, claims map[string]any) (_ []eventstore.Command, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if idpID == "" || userID == "" {
+		return nil, nil
+	}
+	rules := NewIDPMappingRulesWriteModel(idpID)
+	if err = c.eventstore.FilterToQueryReducer(ctx, rules); err != nil {
+		return nil, err
+	}
+	if rules.Rules.IsZero() {
+		return nil, nil
+	}
+	userWriteModel, err := c.userHumanWriteModel(ctx, userID, true, false, false, false, false, false)
+	if err != nil {
+		return nil, err
+	}
+	if !isUserStateExists(userWriteModel.UserState) {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Sx3gq", "Errors.User.NotFound")
+	}
+	cmds, err := idpMappingProfileCommands(ctx, userWriteModel, rules.Rules.ProfileMappings, claims)
+	if err != nil {
+		return nil, err
+	}
+	metadataCmds, err := c.idpMappingMetadataCommands(ctx, userWriteModel, rules.Rules.MetadataMappings, claims)
+	if err != nil {
+		return nil, err
+	}
+	cmds = append(cmds, metadataCmds...)
+	grantCmds, err := c.idpMappingUserGrantCommands(ctx, userWriteModel, rules.Rules, claims)
+	if err != nil {
+		return nil, err
+	}
+	return append(cmds, grantCmds...), nil
+}
+
+func idpMappingProfileCommands(ctx context.Context, wm *UserV2WriteModel, mappings []idp.ProfileMapping, claims map[string]any) ([]eventstore.Command, error) {
+	if len(mappings) == 0 {
+		return nil, nil
+	}
+	profile := new(Profile)
+	for _, mapping := range mappings {
+		values := claimValues(claims, mapping.Claim)
+		if len(values) == 0 || values[0] == "" {
+			continue
+		}
+		value := values[0]
+		switch mapping.Field {
+		case domain.IDPMappingProfileFieldFirstName:
+			profile.FirstName = &value
+		case domain.IDPMappingProfileFieldLastName:
+			profile.LastName = &value
+		case domain.IDPMappingProfileFieldNickName:
+			profile.NickName = &value
+		case domain.IDPMappingProfileFieldDisplayName:
+			profile.DisplayName = &value
+		case domain.IDPMappingProfileFieldPreferredLanguage:
+			tag, err := language.Parse(value)
+			if err != nil {
+				continue
+			}
+			profile.PreferredLanguage = &tag
+		case domain.IDPMappingProfileFieldUnspecified:
+			continue
+		}
+	}
+	return changeUserProfile(ctx, nil, wm, profile)
+}
+
+func (c *Commands) idpMappingMetadataCommands(ctx context.Context, wm *UserV2WriteModel, mappings []idp.MetadataMapping, claims map[string]any) ([]eventstore.Command, error) {
+	if len(mappings) == 0 {
+		return nil, nil
+	}
+	existing, err := c.getUserMetadataListModelByID(ctx, wm.AggregateID, wm.ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	cmds := make([]eventstore.Command, 0, len(mappings))
+	for _, mapping := range mappings {
+		values := claimValues(claims, mapping.Claim)
+		if len(values) == 0 {
+			continue
+		}
+		value := []byte(values[0])
+		// multiple values are stored as JSON array
+		if len(values) > 1 {
+			value, err = json.Marshal(values)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if current, ok := existing.metadataList[mapping.Key]; ok && string(current) == string(value) {
+			continue
+		}
+		cmds = append(cmds, user.NewMetadataSetEvent(ctx, &wm.Aggregate().Aggregate, mapping.Key, value))
+	}
+	return cmds, nil
+}
+
+type idpMappingGrant struct {
+	projectID      string
+	projectGrantID string
+}
+
+// idpMappingUserGrantCommands adds the roles of all mappings where the user is member of the external group
+// and removes the roles of all mappings where the user is no longer member of.
+// Roles, which are not part of any mapping are not touched.
+// Grants which can't be added or changed (e.g. because a mapped role was removed) are skipped.
+func (c *Commands) idpMappingUserGrantCommands(ctx context.Context, wm *UserV2WriteModel, rules idp.MappingRules, claims map[string]any) ([]eventstore.Command, error) {
+	if len(rules.GroupMappings) == 0 {
+		return nil, nil
+	}
+	groups := claimValues(claims, rules.GroupsClaim)
+	managedRoles := make(map[idpMappingGrant][]string)
+	desiredRoles := make(map[idpMappingGrant][]string)
+	grants := make([]idpMappingGrant, 0, len(rules.GroupMappings))
+	for _, mapping := range rules.GroupMappings {
+		grant := idpMappingGrant{projectID: mapping.ProjectID, projectGrantID: mapping.ProjectGrantID}
+		if _, ok := managedRoles[grant]; !ok {
+			grants = append(grants, grant)
+		}
+		managedRoles[grant] = appendMissing(managedRoles[grant], mapping.RoleKeys...)
+		if slices.Contains(groups, mapping.Group) {
+			desiredRoles[grant] = appendMissing(desiredRoles[grant], mapping.RoleKeys...)
+		}
+	}
+
+	existingGrants, err := c.idpMappingExistingUserGrants(ctx, wm.AggregateID)
+	if err != nil {
+		return nil, err
+	}
+	cmds := make([]eventstore.Command, 0, len(grants))
+	for _, grant := range grants {
+		existing, ok := existingGrants[grant]
+		if !ok {
+			if len(desiredRoles[grant]) == 0 {
+				continue
+			}
+			cmd, err := c.idpMappingAddUserGrant(ctx, wm, grant, desiredRoles[grant])
+			if err != nil {
+				// e.g. a mapped role was removed from the project since
+				logging.WithFields("userID", wm.AggregateID, "projectID", grant.projectID, "projectGrantID", grant.projectGrantID).WithError(err).Warn("skipped invalid idp group mapping")
+				continue
+			}
+			cmds = append(cmds, cmd)
+			continue
+		}
+		roles := make([]string, 0, len(existing.RoleKeys))
+		for _, role := range existing.RoleKeys {
+			if !slices.Contains(managedRoles[grant], role) || slices.Contains(desiredRoles[grant], role) {
+				roles = append(roles, role)
+			}
+		}
+		roles = appendMissing(roles, desiredRoles[grant]...)
+		if len(roles) == 0 {
+			cmds = append(cmds, usergrant.NewUserGrantRemovedEvent(ctx, UserGrantAggregateFromWriteModel(&existing.WriteModel), existing.UserID, existing.ProjectID, existing.ProjectGrantID))
+			continue
+		}
+		if reflect.DeepEqual(roles, existing.RoleKeys) {
+			continue
+		}
+		if err = c.checkUserGrantPreCondition(ctx, &domain.UserGrant{UserID: wm.AggregateID, ProjectID: grant.projectID, ProjectGrantID: grant.projectGrantID, RoleKeys: roles}, existing.ResourceOwner); err != nil {
+			logging.WithFields("userID", wm.AggregateID, "projectID", grant.projectID, "projectGrantID", grant.projectGrantID).WithError(err).Warn("skipped invalid idp group mapping")
+			continue
+		}
+		cmds = append(cmds, usergrant.NewUserGrantChangedEvent(ctx, UserGrantAggregateFromWriteModel(&existing.WriteModel), roles))
+	}
+	return cmds, nil
+}
+
+func (c *Commands) idpMappingAddUserGrant(ctx context.Context, wm *UserV2WriteModel, grant idpMappingGrant, roles []string) (eventstore.Command, error) {
+	userGrant := &domain.UserGrant{
+		UserID:         wm.AggregateID,
+		ProjectID:      grant.projectID,
+		ProjectGrantID: grant.projectGrantID,
+		RoleKeys:       roles,
+	}
+	if err := c.checkUserGrantPreCondition(ctx, userGrant, wm.ResourceOwner); err != nil {
+		return nil, err
+	}
+	id, err := c.idGenerator.Next()
+	if err != nil {
+		return nil, err
+	}
+	writeModel := NewUserGrantWriteModel(id, wm.ResourceOwner)
+	return usergrant.NewUserGrantAddedEvent(
+		ctx,
+		UserGrantAggregateFromWriteModel(&writeModel.WriteModel),
+		userGrant.UserID,
+		userGrant.ProjectID,
+		userGrant.ProjectGrantID,
+		userGrant.RoleKeys,
+	), nil
+}
+
+func (c *Commands) idpMappingExistingUserGrants(ctx context.Context, userID string) (map[idpMappingGrant]*UserGrantWriteModel, error) {
+	ids := newUserGrantIDsWriteModel(userID)
+	if err := c.eventstore.FilterToQueryReducer(ctx, ids); err != nil {
+		return nil, err
+	}
+	if len(ids.GrantIDs) == 0 {
+		return nil, nil
+	}
+	bulk := newUserGrantsBulkWriteModel(ids.GrantIDs)
+	if err := c.eventstore.FilterToQueryReducer(ctx, bulk); err != nil {
+		return nil, err
+	}
+	grants := make(map[idpMappingGrant]*UserGrantWriteModel, len(ids.GrantIDs))
+	for _, writeModel := range bulk.writeModels {
+		if writeModel.State == domain.UserGrantStateUnspecified || writeModel.State == domain.UserGrantStateRemoved {
+			continue
+		}
+		grants[idpMappingGrant{projectID: writeModel.ProjectID, projectGrantID: writeModel.ProjectGrantID}] = writeModel
+	}
+	return grants, nil
+}
+
+// idpUserClaims returns the raw information of the external user as map, so the claims can be resolved by the mapping rules.
+// The attributes of LDAP (and SAML) users are available as `attributes`.
+func idpUserClaims(idpInfo []byte, attributes map[string][]string) (map[string]any, error) {
+	claims := make(map[string]any)
+	if err := json.Unmarshal(idpInfo, &claims); err != nil {
+		return nil, zerrors.ThrowInternal(err, "COMMAND-Gw3ta", "Errors.Internal")
+	}
+	if attributes != nil {
+		attrs := make(map[string]any, len(attributes))
+		for key, values := range attributes {
+			attrs[key] = values
+		}
+		claims["attributes"] = attrs
+	}
+	return claims, nil
+}
+
+// claimValues resolves the dot separated path in the claims
+// and returns the value(s) as string.
+func claimValues(claims map[string]any, path string) []string {
+	var value any = claims
+	for _, key := range strings.Split(path, ".") {
+		m, ok := value.(map[string]any)
+		if !ok {
+			return nil
+		}
+		if value, ok = m[key]; !ok {
+			return nil
+		}
+	}
+	switch v := value.(type) {
+	case []any:
+		values := make([]string, 0, len(v))
+		for _, item := range v {
+			if s, ok := claimValueString(item); ok {
+				values = append(values, s)
+			}
+		}
+		return values
+	case []string:
+		return v
+	default:
+		if s, ok := claimValueString(v); ok {
+			return []string{s}
+		}
+		return nil
+	}
+}
+
+func claimValueString(value any) (string, bool) {
+	switch v := value.(type) {
+	case string:
+		return v, true
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	case bool:
+		return strconv.FormatBool(v), true
+	default:
+		return "", false
+	}
+}
+
+func appendMissing(list []string, values ...string) []string {
+	for _, value := range values {
+		if !slices.Contains(list, value) {
+			list = append(list, value)
+		}
+	}
+	return list
+}
@@ -0,0 +1,156 @@
+package command
+
+import (
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/idp"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/usergrant"
+)
+
+// IDPMappingRulesWriteModel contains the mapping rules of an IdP,
+// independent of whether the IdP is defined on the instance or an organization.
+type IDPMappingRulesWriteModel struct {
+	eventstore.WriteModel
+
+	ID    string
+	Rules idp.MappingRules
+}
+
+func NewIDPMappingRulesWriteModel(id string) *IDPMappingRulesWriteModel {
+	return &IDPMappingRulesWriteModel{
+		ID: id,
+	}
+}
+
+func (wm *IDPMappingRulesWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *instance.IDPMappingRulesSetEvent:
+			wm.reduceSet(&e.MappingRulesSetEvent)
+		case *org.IDPMappingRulesSetEvent:
+			wm.reduceSet(&e.MappingRulesSetEvent)
+		case *instance.IDPRemovedEvent:
+			wm.reduceRemoved(e.ID)
+		case *org.IDPRemovedEvent:
+			wm.reduceRemoved(e.ID)
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *IDPMappingRulesWriteModel) reduceSet(e *idp.MappingRulesSetEvent) {
+	if wm.ID != e.ID {
+		return
+	}
+	wm.Rules = e.Rules
+}
+
+func (wm *IDPMappingRulesWriteModel) reduceRemoved(id string) {
+	if wm.ID != id {
+		return
+	}
+	wm.Rules = idp.MappingRules{}
+}
+
+func (wm *IDPMappingRulesWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(instance.AggregateType).
+		EventTypes(
+			instance.IDPMappingRulesSetEventType,
+			instance.IDPRemovedEventType,
+		).
+		EventData(map[string]interface{}{"id": wm.ID}).
+		Or().
+		AggregateTypes(org.AggregateType).
+		EventTypes(
+			org.IDPMappingRulesSetEventType,
+			org.IDPRemovedEventType,
+		).
+		EventData(map[string]interface{}{"id": wm.ID}).
+		Builder()
+}
+
+// userGrantIDsWriteModel collects the ids of all user grants ever added to the user.
+// The state of the grants has to be checked using the [UserGrantWriteModel].
+type userGrantIDsWriteModel struct {
+	eventstore.WriteModel
+
+	UserID   string
+	GrantIDs []string
+}
+
+func newUserGrantIDsWriteModel(userID string) *userGrantIDsWriteModel {
+	return &userGrantIDsWriteModel{
+		UserID: userID,
+	}
+}
+
+func (wm *userGrantIDsWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		if e, ok := event.(*usergrant.UserGrantAddedEvent); ok && e.UserID == wm.UserID {
+			wm.GrantIDs = append(wm.GrantIDs, e.Aggregate().ID)
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *userGrantIDsWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(usergrant.AggregateType).
+		EventTypes(usergrant.UserGrantAddedType).
+		EventData(map[string]interface{}{"userId": wm.UserID}).
+		Builder()
+}
+
+// userGrantsBulkWriteModel reduces the state of multiple user grants using a single query.
+type userGrantsBulkWriteModel struct {
+	eventstore.WriteModel
+
+	writeModels map[string]*UserGrantWriteModel
+	grantIDs    []string
+}
+
+func newUserGrantsBulkWriteModel(grantIDs []string) *userGrantsBulkWriteModel {
+	writeModels := make(map[string]*UserGrantWriteModel, len(grantIDs))
+	for _, id := range grantIDs {
+		writeModels[id] = NewUserGrantWriteModel(id, "")
+	}
+	return &userGrantsBulkWriteModel{
+		writeModels: writeModels,
+		grantIDs:    grantIDs,
+	}
+}
+
+func (wm *userGrantsBulkWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		grant, ok := wm.writeModels[event.Aggregate().ID]
+		if !ok {
+			continue
+		}
+		grant.AppendEvents(event)
+		if err := grant.Reduce(); err != nil {
+			return err
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *userGrantsBulkWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(usergrant.AggregateType).
+		AggregateIDs(wm.grantIDs...).
+		EventTypes(usergrant.UserGrantAddedType,
+			usergrant.UserGrantChangedType,
+			usergrant.UserGrantCascadeChangedType,
+			usergrant.UserGrantDeactivatedType,
+			usergrant.UserGrantReactivatedType,
+			usergrant.UserGrantRemovedType,
+			usergrant.UserGrantCascadeRemovedType,
+			usergrant.UserGrantValiditySetType,
+			usergrant.UserGrantExpiredType).
+		Builder()
+}
@@ -0,0 +1,472 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/idp"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/repository/usergrant"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommands_SetInstanceIDPMappingRules(t *testing.T) {
+	rules := idp.MappingRules{
+		ProfileMappings: []idp.ProfileMapping{
+			{Claim: "given_name", Field: domain.IDPMappingProfileFieldFirstName},
+		},
+		GroupsClaim: "groups",
+		GroupMappings: []idp.GroupMapping{
+			{Group: "admins", ProjectID: "project1", RoleKeys: []string{"admin"}},
+		},
+	}
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx   context.Context
+		id    string
+		rules idp.MappingRules
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"missing id, invalid argument error",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx: authz.WithInstanceID(context.Background(), "instance1"),
+			},
+			res{
+				err: zerrors.ThrowInvalidArgument(nil, "INST-Wq3bf", "Errors.IDMissing"),
+			},
+		},
+		{
+			"invalid profile mapping, invalid argument error",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx: authz.WithInstanceID(context.Background(), "instance1"),
+				id:  "idp1",
+				rules: idp.MappingRules{
+					ProfileMappings: []idp.ProfileMapping{{Claim: "given_name"}},
+				},
+			},
+			res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-Jq2fx", "Errors.Invalid.Argument"),
+			},
+		},
+		{
+			"group mapping without groups claim, invalid argument error",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx: authz.WithInstanceID(context.Background(), "instance1"),
+				id:  "idp1",
+				rules: idp.MappingRules{
+					GroupMappings: rules.GroupMappings,
+				},
+			},
+			res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-Jq2fz", "Errors.Invalid.Argument"),
+			},
+		},
+		{
+			"idp not existing, not found error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args{
+				ctx:   authz.WithInstanceID(context.Background(), "instance1"),
+				id:    "idp1",
+				rules: rules,
+			},
+			res{
+				err: zerrors.ThrowNotFound(nil, "INST-Hs8ga", "Errors.IDPConfig.NotExisting"),
+			},
+		},
+		{
+			"rules unchanged, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(instanceGoogleIDPAddedEvent("idp1")),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewIDPMappingRulesSetEvent(context.Background(), &instance.NewAggregate("instance1").Aggregate, "idp1", rules),
+						),
+					),
+				),
+			},
+			args{
+				ctx:   authz.WithInstanceID(context.Background(), "instance1"),
+				id:    "idp1",
+				rules: rules,
+			},
+			res{
+				want: &domain.ObjectDetails{ResourceOwner: "instance1"},
+			},
+		},
+		{
+			"rules set, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(instanceGoogleIDPAddedEvent("idp1")),
+					),
+					expectFilter(),
+					expectPush(
+						instance.NewIDPMappingRulesSetEvent(context.Background(), &instance.NewAggregate("instance1").Aggregate, "idp1", rules),
+					),
+				),
+			},
+			args{
+				ctx:   authz.WithInstanceID(context.Background(), "instance1"),
+				id:    "idp1",
+				rules: rules,
+			},
+			res{
+				want: &domain.ObjectDetails{ResourceOwner: "instance1"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := c.SetInstanceIDPMappingRules(tt.args.ctx, tt.args.id, tt.args.rules)
+			require.ErrorIs(t, err, tt.res.err)
+			assert.Equal(t, tt.res.want, got)
+		})
+	}
+}
+
+func TestCommands_SetOrgIDPMappingRules(t *testing.T) {
+	rules := idp.MappingRules{
+		MetadataMappings: []idp.MetadataMapping{
+			{Claim: "department", Key: "department"},
+		},
+	}
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx           context.Context
+		resourceOwner string
+		id            string
+		rules         idp.MappingRules
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"missing resource owner, invalid argument error",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx: context.Background(),
+				id:  "idp1",
+			},
+			res{
+				err: zerrors.ThrowInvalidArgument(nil, "ORG-Vd2qr", "Errors.ResourceOwnerMissing"),
+			},
+		},
+		{
+			"invalid metadata mapping, invalid argument error",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx:           context.Background(),
+				resourceOwner: "org1",
+				id:            "idp1",
+				rules: idp.MappingRules{
+					MetadataMappings: []idp.MetadataMapping{{Claim: "department"}},
+				},
+			},
+			res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-Jq2fy", "Errors.Invalid.Argument"),
+			},
+		},
+		{
+			"idp not existing, not found error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args{
+				ctx:           context.Background(),
+				resourceOwner: "org1",
+				id:            "idp1",
+				rules:         rules,
+			},
+			res{
+				err: zerrors.ThrowNotFound(nil, "ORG-Hs8ga", "Errors.IDPConfig.NotExisting"),
+			},
+		},
+		{
+			"rules removed, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewGoogleIDPAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+								"idp1",
+								"",
+								"clientID",
+								&crypto.CryptoValue{
+									CryptoType: crypto.TypeEncryption,
+									Algorithm:  "enc",
+									KeyID:      "id",
+									Crypted:    []byte("clientSecret"),
+								},
+								nil,
+								idp.Options{},
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewIDPMappingRulesSetEvent(context.Background(), &org.NewAggregate("org1").Aggregate, "idp1", rules),
+						),
+					),
+					expectPush(
+						org.NewIDPMappingRulesSetEvent(context.Background(), &org.NewAggregate("org1").Aggregate, "idp1", idp.MappingRules{}),
+					),
+				),
+			},
+			args{
+				ctx:           context.Background(),
+				resourceOwner: "org1",
+				id:            "idp1",
+				rules:         idp.MappingRules{},
+			},
+			res{
+				want: &domain.ObjectDetails{ResourceOwner: "org1"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := c.SetOrgIDPMappingRules(tt.args.ctx, tt.args.resourceOwner, tt.args.id, tt.args.rules)
+			require.ErrorIs(t, err, tt.res.err)
+			assert.Equal(t, tt.res.want, got)
+		})
+	}
+}
+
+func Test_claimValues(t *testing.T) {
+	claims := map[string]any{
+		"given_name": "Alice",
+		"age":        float64(42),
+		"groups":     []any{"admins", "viewers"},
+		"RawInfo": map[string]any{
+			"department": "sales",
+		},
+		"attributes": map[string]any{
+			"memberOf": []string{"cn=admins"},
+		},
+	}
+	tests := []struct {
+		name string
+		path string
+		want []string
+	}{
+		{"string", "given_name", []string{"Alice"}},
+		{"number", "age", []string{"42"}},
+		{"array", "groups", []string{"admins", "viewers"}},
+		{"nested", "RawInfo.department", []string{"sales"}},
+		{"attributes", "attributes.memberOf", []string{"cn=admins"}},
+		{"missing", "RawInfo.missing", nil},
+		{"not an object", "given_name.first", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, claimValues(claims, tt.path))
+		})
+	}
+}
+
+func instanceGoogleIDPAddedEvent(id string) *instance.GoogleIDPAddedEvent {
+	return instance.NewGoogleIDPAddedEvent(context.Background(), &instance.NewAggregate("instance1").Aggregate,
+		id,
+		"",
+		"clientID",
+		&crypto.CryptoValue{
+			CryptoType: crypto.TypeEncryption,
+			Algorithm:  "enc",
+			KeyID:      "id",
+			Crypted:    []byte("clientSecret"),
+		},
+		nil,
+		idp.Options{},
+	)
+}
+
+func TestCommands_idpMappingUserGrantCommands(t *testing.T) {
+	ctx := context.Background()
+	grantAdded := func() eventstore.Event {
+		return eventFromEventPusher(
+			usergrant.NewUserGrantAddedEvent(ctx, &usergrant.NewAggregate("grant1", "org1").Aggregate, "user1", "project1", "", []string{"role1"}),
+		)
+	}
+	userAdded := func() eventstore.Event {
+		return eventFromEventPusher(
+			user.NewHumanAddedEvent(ctx,
+				&user.NewAggregate("user1", "org1").Aggregate,
+				"username1",
+				"firstname1",
+				"lastname1",
+				"nickname1",
+				"displayname1",
+				language.German,
+				domain.GenderMale,
+				"email1",
+				true,
+			),
+		)
+	}
+	rules := idp.MappingRules{
+		GroupsClaim: "groups",
+		GroupMappings: []idp.GroupMapping{
+			{Group: "admins", ProjectID: "project1", RoleKeys: []string{"role2"}},
+			{Group: "viewers", ProjectID: "project2", RoleKeys: []string{"viewer"}},
+		},
+	}
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		claims map[string]any
+	}
+	type res struct {
+		want []eventstore.Command
+		err  error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"no grants, no mapped groups, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args{
+				claims: map[string]any{},
+			},
+			res{
+				want: []eventstore.Command{},
+			},
+		},
+		{
+			"existing grant changed, invalid mapping skipped",
+			fields{
+				eventstore: expectEventstore(
+					// user grant ids
+					expectFilter(
+						grantAdded(),
+					),
+					// user grants
+					expectFilter(
+						grantAdded(),
+					),
+					// pre condition of the changed grant
+					expectFilter(
+						userAdded(),
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(ctx,
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"projectname1", true, true, true,
+								domain.PrivateLabelingSettingUnspecified,
+							),
+						),
+						eventFromEventPusher(
+							project.NewRoleAddedEvent(ctx, &project.NewAggregate("project1", "org1").Aggregate, "role1", "role1", ""),
+						),
+						eventFromEventPusher(
+							project.NewRoleAddedEvent(ctx, &project.NewAggregate("project1", "org1").Aggregate, "role2", "role2", ""),
+						),
+					),
+					// pre condition of the new grant, the mapped role was removed
+					expectFilter(
+						userAdded(),
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(ctx,
+								&project.NewAggregate("project2", "org1").Aggregate,
+								"projectname2", true, true, true,
+								domain.PrivateLabelingSettingUnspecified,
+							),
+						),
+					),
+				),
+			},
+			args{
+				claims: map[string]any{"groups": []any{"admins", "viewers"}},
+			},
+			res{
+				want: []eventstore.Command{
+					usergrant.NewUserGrantChangedEvent(ctx, &usergrant.NewAggregate("grant1", "org1").Aggregate, []string{"role1", "role2"}),
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			wm := &UserV2WriteModel{
+				WriteModel: eventstore.WriteModel{
+					AggregateID:   "user1",
+					ResourceOwner: "org1",
+				},
+			}
+			got, err := c.idpMappingUserGrantCommands(ctx, wm, rules, tt.args.claims)
+			require.ErrorIs(t, err, tt.res.err)
+			assert.Equal(t, tt.res.want, got)
+		})
+	}
+}
@@ -14,15 +14,20 @@ import (
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	providers "github.com/zitadel/zitadel/internal/idp"
+	"github.com/zitadel/zitadel/internal/idp/providers/adfs"
 	"github.com/zitadel/zitadel/internal/idp/providers/apple"
 	"github.com/zitadel/zitadel/internal/idp/providers/azuread"
+	"github.com/zitadel/zitadel/internal/idp/providers/bitbucket"
+	"github.com/zitadel/zitadel/internal/idp/providers/discord"
 	"github.com/zitadel/zitadel/internal/idp/providers/github"
 	"github.com/zitadel/zitadel/internal/idp/providers/gitlab"
 	"github.com/zitadel/zitadel/internal/idp/providers/google"
 	"github.com/zitadel/zitadel/internal/idp/providers/jwt"
+	"github.com/zitadel/zitadel/internal/idp/providers/keycloak"
 	"github.com/zitadel/zitadel/internal/idp/providers/ldap"
 	"github.com/zitadel/zitadel/internal/idp/providers/oauth"
 	"github.com/zitadel/zitadel/internal/idp/providers/oidc"
+	"github.com/zitadel/zitadel/internal/idp/providers/okta"
 	saml2 "github.com/zitadel/zitadel/internal/idp/providers/saml"
 	"github.com/zitadel/zitadel/internal/idp/providers/saml/requesttracker"
 	"github.com/zitadel/zitadel/internal/repository/idp"
@@ -44,6 +49,8 @@ type OAuthIDPWriteModel struct {
 	UserEndpoint          string
 	Scopes                []string
 	IDAttribute           string
+	UsePKCE               bool
+	CodeVerifierKey       *crypto.CryptoValue
 	idp.Options
 
 	State domain.IDPState
@@ -72,6 +79,8 @@ func (wm *OAuthIDPWriteModel) reduceAddedEvent(e *idp.OAuthIDPAddedEvent) {
 	wm.UserEndpoint = e.UserEndpoint
 	wm.Scopes = e.Scopes
 	wm.IDAttribute = e.IDAttribute
+	wm.UsePKCE = e.UsePKCE
+	wm.CodeVerifierKey = e.CodeVerifierKey
 	wm.Options = e.Options
 	wm.State = domain.IDPStateActive
 }
@@ -101,6 +110,12 @@ func (wm *OAuthIDPWriteModel) reduceChangedEvent(e *idp.OAuthIDPChangedEvent) {
 	if e.IDAttribute != nil {
 		wm.IDAttribute = *e.IDAttribute
 	}
+	if e.UsePKCE != nil {
+		wm.UsePKCE = *e.UsePKCE
+	}
+	if e.CodeVerifierKey != nil {
+		wm.CodeVerifierKey = e.CodeVerifierKey
+	}
 	wm.Options.ReduceChanges(e.OptionChanges)
 }
 
@@ -114,6 +129,8 @@ func (wm *OAuthIDPWriteModel) NewChanges(
 	userEndpoint,
 	idAttribute string,
 	scopes []string,
+	usePKCE bool,
+	codeVerifierKey *crypto.CryptoValue,
 	options idp.Options,
 ) ([]idp.OAuthIDPChanges, error) {
 	changes := make([]idp.OAuthIDPChanges, 0)
@@ -147,6 +164,12 @@ func (wm *OAuthIDPWriteModel) NewChanges(
 	if wm.IDAttribute != idAttribute {
 		changes = append(changes, idp.ChangeOAuthIDAttribute(idAttribute))
 	}
+	if wm.UsePKCE != usePKCE {
+		changes = append(changes, idp.ChangeOAuthUsePKCE(usePKCE))
+	}
+	if codeVerifierKey != nil {
+		changes = append(changes, idp.ChangeOAuthCodeVerifierKey(codeVerifierKey))
+	}
 	opts := wm.Options.Changes(options)
 	if !opts.IsZero() {
 		changes = append(changes, idp.ChangeOAuthOptions(opts))
@@ -155,9 +178,14 @@ func (wm *OAuthIDPWriteModel) NewChanges(
 }
 
 func (wm *OAuthIDPWriteModel) ToProvider(callbackURL string, idpAlg crypto.EncryptionAlgorithm) (providers.Provider, error) {
-	secret, err := crypto.DecryptString(wm.ClientSecret, idpAlg)
-	if err != nil {
-		return nil, err
+	var secret string
+	// the client secret is optional for (public) clients using PKCE
+	if wm.ClientSecret != nil {
+		var err error
+		secret, err = crypto.DecryptString(wm.ClientSecret, idpAlg)
+		if err != nil {
+			return nil, err
+		}
 	}
 	config := &oauth2.Config{
 		ClientID:     wm.ClientID,
@@ -169,7 +197,7 @@ func (wm *OAuthIDPWriteModel) ToProvider(callbackURL string, idpAlg crypto.Encry
 		RedirectURL: callbackURL,
 		Scopes:      wm.Scopes,
 	}
-	opts := make([]oauth.ProviderOpts, 0, 4)
+	opts := make([]oauth.ProviderOpts, 0, 5)
 	if wm.IsCreationAllowed {
 		opts = append(opts, oauth.WithCreationAllowed())
 	}
@@ -182,6 +210,13 @@ func (wm *OAuthIDPWriteModel) ToProvider(callbackURL string, idpAlg crypto.Encry
 	if wm.IsAutoUpdate {
 		opts = append(opts, oauth.WithAutoUpdate())
 	}
+	if wm.UsePKCE {
+		codeVerifierKey, err := crypto.Decrypt(wm.CodeVerifierKey, idpAlg)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, oauth.WithPKCE(codeVerifierKey))
+	}
 	return oauth.New(
 		config,
 		wm.Name,
@@ -833,6 +868,242 @@ func (wm *GitHubIDPWriteModel) GetProviderOptions() idp.Options {
 	return wm.Options
 }
 
+type BitbucketIDPWriteModel struct {
+	eventstore.WriteModel
+
+	ID           string
+	Name         string
+	ClientID     string
+	ClientSecret *crypto.CryptoValue
+	Scopes       []string
+	idp.Options
+
+	State domain.IDPState
+}
+
+func (wm *BitbucketIDPWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *idp.BitbucketIDPAddedEvent:
+			wm.reduceAddedEvent(e)
+		case *idp.BitbucketIDPChangedEvent:
+			wm.reduceChangedEvent(e)
+		case *idp.RemovedEvent:
+			wm.State = domain.IDPStateRemoved
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *BitbucketIDPWriteModel) reduceAddedEvent(e *idp.BitbucketIDPAddedEvent) {
+	wm.Name = e.Name
+	wm.ClientID = e.ClientID
+	wm.ClientSecret = e.ClientSecret
+	wm.Scopes = e.Scopes
+	wm.Options = e.Options
+	wm.State = domain.IDPStateActive
+}
+
+func (wm *BitbucketIDPWriteModel) reduceChangedEvent(e *idp.BitbucketIDPChangedEvent) {
+	if e.Name != nil {
+		wm.Name = *e.Name
+	}
+	if e.ClientID != nil {
+		wm.ClientID = *e.ClientID
+	}
+	if e.ClientSecret != nil {
+		wm.ClientSecret = e.ClientSecret
+	}
+	if e.Scopes != nil {
+		wm.Scopes = e.Scopes
+	}
+	wm.Options.ReduceChanges(e.OptionChanges)
+}
+
+func (wm *BitbucketIDPWriteModel) NewChanges(
+	name,
+	clientID,
+	clientSecretString string,
+	secretCrypto crypto.EncryptionAlgorithm,
+	scopes []string,
+	options idp.Options,
+) ([]idp.BitbucketIDPChanges, error) {
+	changes := make([]idp.BitbucketIDPChanges, 0)
+	var clientSecret *crypto.CryptoValue
+	var err error
+	if clientSecretString != "" {
+		clientSecret, err = crypto.Crypt([]byte(clientSecretString), secretCrypto)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, idp.ChangeBitbucketClientSecret(clientSecret))
+	}
+	if wm.Name != name {
+		changes = append(changes, idp.ChangeBitbucketName(name))
+	}
+	if wm.ClientID != clientID {
+		changes = append(changes, idp.ChangeBitbucketClientID(clientID))
+	}
+	if !reflect.DeepEqual(wm.Scopes, scopes) {
+		changes = append(changes, idp.ChangeBitbucketScopes(scopes))
+	}
+
+	opts := wm.Options.Changes(options)
+	if !opts.IsZero() {
+		changes = append(changes, idp.ChangeBitbucketOptions(opts))
+	}
+	return changes, nil
+}
+
+func (wm *BitbucketIDPWriteModel) ToProvider(callbackURL string, idpAlg crypto.EncryptionAlgorithm) (providers.Provider, error) {
+	secret, err := crypto.DecryptString(wm.ClientSecret, idpAlg)
+	if err != nil {
+		return nil, err
+	}
+	oauthOpts := make([]oauth.ProviderOpts, 0, 4)
+	if wm.IsCreationAllowed {
+		oauthOpts = append(oauthOpts, oauth.WithCreationAllowed())
+	}
+	if wm.IsLinkingAllowed {
+		oauthOpts = append(oauthOpts, oauth.WithLinkingAllowed())
+	}
+	if wm.IsAutoCreation {
+		oauthOpts = append(oauthOpts, oauth.WithAutoCreation())
+	}
+	if wm.IsAutoUpdate {
+		oauthOpts = append(oauthOpts, oauth.WithAutoUpdate())
+	}
+	return bitbucket.New(
+		wm.ClientID,
+		secret,
+		callbackURL,
+		wm.Scopes,
+		oauthOpts...,
+	)
+}
+
+func (wm *BitbucketIDPWriteModel) GetProviderOptions() idp.Options {
+	return wm.Options
+}
+
+type DiscordIDPWriteModel struct {
+	eventstore.WriteModel
+
+	ID           string
+	Name         string
+	ClientID     string
+	ClientSecret *crypto.CryptoValue
+	Scopes       []string
+	idp.Options
+
+	State domain.IDPState
+}
+
+func (wm *DiscordIDPWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *idp.DiscordIDPAddedEvent:
+			wm.reduceAddedEvent(e)
+		case *idp.DiscordIDPChangedEvent:
+			wm.reduceChangedEvent(e)
+		case *idp.RemovedEvent:
+			wm.State = domain.IDPStateRemoved
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *DiscordIDPWriteModel) reduceAddedEvent(e *idp.DiscordIDPAddedEvent) {
+	wm.Name = e.Name
+	wm.ClientID = e.ClientID
+	wm.ClientSecret = e.ClientSecret
+	wm.Scopes = e.Scopes
+	wm.Options = e.Options
+	wm.State = domain.IDPStateActive
+}
+
+func (wm *DiscordIDPWriteModel) reduceChangedEvent(e *idp.DiscordIDPChangedEvent) {
+	if e.Name != nil {
+		wm.Name = *e.Name
+	}
+	if e.ClientID != nil {
+		wm.ClientID = *e.ClientID
+	}
+	if e.ClientSecret != nil {
+		wm.ClientSecret = e.ClientSecret
+	}
+	if e.Scopes != nil {
+		wm.Scopes = e.Scopes
+	}
+	wm.Options.ReduceChanges(e.OptionChanges)
+}
+
+func (wm *DiscordIDPWriteModel) NewChanges(
+	name,
+	clientID,
+	clientSecretString string,
+	secretCrypto crypto.EncryptionAlgorithm,
+	scopes []string,
+	options idp.Options,
+) ([]idp.DiscordIDPChanges, error) {
+	changes := make([]idp.DiscordIDPChanges, 0)
+	var clientSecret *crypto.CryptoValue
+	var err error
+	if clientSecretString != "" {
+		clientSecret, err = crypto.Crypt([]byte(clientSecretString), secretCrypto)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, idp.ChangeDiscordClientSecret(clientSecret))
+	}
+	if wm.Name != name {
+		changes = append(changes, idp.ChangeDiscordName(name))
+	}
+	if wm.ClientID != clientID {
+		changes = append(changes, idp.ChangeDiscordClientID(clientID))
+	}
+	if !reflect.DeepEqual(wm.Scopes, scopes) {
+		changes = append(changes, idp.ChangeDiscordScopes(scopes))
+	}
+
+	opts := wm.Options.Changes(options)
+	if !opts.IsZero() {
+		changes = append(changes, idp.ChangeDiscordOptions(opts))
+	}
+	return changes, nil
+}
+
+func (wm *DiscordIDPWriteModel) ToProvider(callbackURL string, idpAlg crypto.EncryptionAlgorithm) (providers.Provider, error) {
+	secret, err := crypto.DecryptString(wm.ClientSecret, idpAlg)
+	if err != nil {
+		return nil, err
+	}
+	oauthOpts := make([]oauth.ProviderOpts, 0, 4)
+	if wm.IsCreationAllowed {
+		oauthOpts = append(oauthOpts, oauth.WithCreationAllowed())
+	}
+	if wm.IsLinkingAllowed {
+		oauthOpts = append(oauthOpts, oauth.WithLinkingAllowed())
+	}
+	if wm.IsAutoCreation {
+		oauthOpts = append(oauthOpts, oauth.WithAutoCreation())
+	}
+	if wm.IsAutoUpdate {
+		oauthOpts = append(oauthOpts, oauth.WithAutoUpdate())
+	}
+	return discord.New(
+		wm.ClientID,
+		secret,
+		callbackURL,
+		wm.Scopes,
+		oauthOpts...,
+	)
+}
+
+func (wm *DiscordIDPWriteModel) GetProviderOptions() idp.Options {
+	return wm.Options
+}
+
 type GitHubEnterpriseIDPWriteModel struct {
 	eventstore.WriteModel
 
@@ -1227,11 +1498,12 @@ func (wm *GitLabSelfHostedIDPWriteModel) GetProviderOptions() idp.Options {
 	return wm.Options
 }
 
-type GoogleIDPWriteModel struct {
+type ADFSIDPWriteModel struct {
 	eventstore.WriteModel
 
 	ID           string
 	Name         string
+	Issuer       string
 	ClientID     string
 	ClientSecret *crypto.CryptoValue
 	Scopes       []string
@@ -1240,15 +1512,13 @@ type GoogleIDPWriteModel struct {
 	State domain.IDPState
 }
 
-func (wm *GoogleIDPWriteModel) Reduce() error {
+func (wm *ADFSIDPWriteModel) Reduce() error {
 	for _, event := range wm.Events {
 		switch e := event.(type) {
-		case *idp.GoogleIDPAddedEvent:
+		case *idp.ADFSIDPAddedEvent:
 			wm.reduceAddedEvent(e)
-		case *idp.GoogleIDPChangedEvent:
+		case *idp.ADFSIDPChangedEvent:
 			wm.reduceChangedEvent(e)
-		case *idp.OIDCIDPMigratedGoogleEvent:
-			wm.reduceAddedEvent(&e.GoogleIDPAddedEvent)
 		case *idp.RemovedEvent:
 			wm.State = domain.IDPStateRemoved
 		}
@@ -1256,8 +1526,9 @@ func (wm *GoogleIDPWriteModel) Reduce() error {
 	return wm.WriteModel.Reduce()
 }
 
-func (wm *GoogleIDPWriteModel) reduceAddedEvent(e *idp.GoogleIDPAddedEvent) {
+func (wm *ADFSIDPWriteModel) reduceAddedEvent(e *idp.ADFSIDPAddedEvent) {
 	wm.Name = e.Name
+	wm.Issuer = e.Issuer
 	wm.ClientID = e.ClientID
 	wm.ClientSecret = e.ClientSecret
 	wm.Scopes = e.Scopes
@@ -1265,31 +1536,35 @@ func (wm *GoogleIDPWriteModel) reduceAddedEvent(e *idp.GoogleIDPAddedEvent) {
 	wm.State = domain.IDPStateActive
 }
 
-func (wm *GoogleIDPWriteModel) reduceChangedEvent(e *idp.GoogleIDPChangedEvent) {
-	if e.Name != nil {
-		wm.Name = *e.Name
-	}
+func (wm *ADFSIDPWriteModel) reduceChangedEvent(e *idp.ADFSIDPChangedEvent) {
 	if e.ClientID != nil {
 		wm.ClientID = *e.ClientID
 	}
 	if e.ClientSecret != nil {
 		wm.ClientSecret = e.ClientSecret
 	}
+	if e.Name != nil {
+		wm.Name = *e.Name
+	}
+	if e.Issuer != nil {
+		wm.Issuer = *e.Issuer
+	}
 	if e.Scopes != nil {
 		wm.Scopes = e.Scopes
 	}
 	wm.Options.ReduceChanges(e.OptionChanges)
 }
 
-func (wm *GoogleIDPWriteModel) NewChanges(
+func (wm *ADFSIDPWriteModel) NewChanges(
 	name string,
+	issuer string,
 	clientID string,
 	clientSecretString string,
 	secretCrypto crypto.EncryptionAlgorithm,
 	scopes []string,
 	options idp.Options,
-) ([]idp.GoogleIDPChanges, error) {
-	changes := make([]idp.GoogleIDPChanges, 0)
+) ([]idp.ADFSIDPChanges, error) {
+	changes := make([]idp.ADFSIDPChanges, 0)
 	var clientSecret *crypto.CryptoValue
 	var err error
 	if clientSecretString != "" {
@@ -1297,31 +1572,28 @@ func (wm *GoogleIDPWriteModel) NewChanges(
 		if err != nil {
 			return nil, err
 		}
-		changes = append(changes, idp.ChangeGoogleClientSecret(clientSecret))
+		changes = append(changes, idp.ChangeADFSClientSecret(clientSecret))
+	}
+	if wm.ClientID != clientID {
+		changes = append(changes, idp.ChangeADFSClientID(clientID))
 	}
 	if wm.Name != name {
-		changes = append(changes, idp.ChangeGoogleName(name))
+		changes = append(changes, idp.ChangeADFSName(name))
 	}
-	if wm.ClientID != clientID {
-		changes = append(changes, idp.ChangeGoogleClientID(clientID))
+	if wm.Issuer != issuer {
+		changes = append(changes, idp.ChangeADFSIssuer(issuer))
 	}
 	if !reflect.DeepEqual(wm.Scopes, scopes) {
-		changes = append(changes, idp.ChangeGoogleScopes(scopes))
+		changes = append(changes, idp.ChangeADFSScopes(scopes))
 	}
-
 	opts := wm.Options.Changes(options)
 	if !opts.IsZero() {
-		changes = append(changes, idp.ChangeGoogleOptions(opts))
+		changes = append(changes, idp.ChangeADFSOptions(opts))
 	}
 	return changes, nil
 }
 
-func (wm *GoogleIDPWriteModel) ToProvider(callbackURL string, idpAlg crypto.EncryptionAlgorithm) (providers.Provider, error) {
-	errorHandler := func(w http.ResponseWriter, r *http.Request, errorType string, errorDesc string, state string) {
-		logging.Errorf("token exchanged failed: %s - %s (state: %s)", errorType, errorType, state)
-		rp.DefaultErrorHandler(w, r, errorType, errorDesc, state)
-	}
-	oidc.WithRelyingPartyOption(rp.WithErrorHandler(errorHandler))
+func (wm *ADFSIDPWriteModel) ToProvider(callbackURL string, idpAlg crypto.EncryptionAlgorithm) (providers.Provider, error) {
 	secret, err := crypto.DecryptString(wm.ClientSecret, idpAlg)
 	if err != nil {
 		return nil, err
@@ -1339,7 +1611,390 @@ func (wm *GoogleIDPWriteModel) ToProvider(callbackURL string, idpAlg crypto.Encr
 	if wm.IsAutoUpdate {
 		opts = append(opts, oidc.WithAutoUpdate())
 	}
-	return google.New(
+	return adfs.New(
+		wm.Name,
+		wm.Issuer,
+		wm.ClientID,
+		secret,
+		callbackURL,
+		wm.Scopes,
+		opts...,
+	)
+}
+
+func (wm *ADFSIDPWriteModel) GetProviderOptions() idp.Options {
+	return wm.Options
+}
+
+type KeycloakIDPWriteModel struct {
+	eventstore.WriteModel
+
+	ID           string
+	Name         string
+	Issuer       string
+	ClientID     string
+	ClientSecret *crypto.CryptoValue
+	Scopes       []string
+	idp.Options
+
+	State domain.IDPState
+}
+
+func (wm *KeycloakIDPWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *idp.KeycloakIDPAddedEvent:
+			wm.reduceAddedEvent(e)
+		case *idp.KeycloakIDPChangedEvent:
+			wm.reduceChangedEvent(e)
+		case *idp.RemovedEvent:
+			wm.State = domain.IDPStateRemoved
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *KeycloakIDPWriteModel) reduceAddedEvent(e *idp.KeycloakIDPAddedEvent) {
+	wm.Name = e.Name
+	wm.Issuer = e.Issuer
+	wm.ClientID = e.ClientID
+	wm.ClientSecret = e.ClientSecret
+	wm.Scopes = e.Scopes
+	wm.Options = e.Options
+	wm.State = domain.IDPStateActive
+}
+
+func (wm *KeycloakIDPWriteModel) reduceChangedEvent(e *idp.KeycloakIDPChangedEvent) {
+	if e.ClientID != nil {
+		wm.ClientID = *e.ClientID
+	}
+	if e.ClientSecret != nil {
+		wm.ClientSecret = e.ClientSecret
+	}
+	if e.Name != nil {
+		wm.Name = *e.Name
+	}
+	if e.Issuer != nil {
+		wm.Issuer = *e.Issuer
+	}
+	if e.Scopes != nil {
+		wm.Scopes = e.Scopes
+	}
+	wm.Options.ReduceChanges(e.OptionChanges)
+}
+
+func (wm *KeycloakIDPWriteModel) NewChanges(
+	name string,
+	issuer string,
+	clientID string,
+	clientSecretString string,
+	secretCrypto crypto.EncryptionAlgorithm,
+	scopes []string,
+	options idp.Options,
+) ([]idp.KeycloakIDPChanges, error) {
+	changes := make([]idp.KeycloakIDPChanges, 0)
+	var clientSecret *crypto.CryptoValue
+	var err error
+	if clientSecretString != "" {
+		clientSecret, err = crypto.Crypt([]byte(clientSecretString), secretCrypto)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, idp.ChangeKeycloakClientSecret(clientSecret))
+	}
+	if wm.ClientID != clientID {
+		changes = append(changes, idp.ChangeKeycloakClientID(clientID))
+	}
+	if wm.Name != name {
+		changes = append(changes, idp.ChangeKeycloakName(name))
+	}
+	if wm.Issuer != issuer {
+		changes = append(changes, idp.ChangeKeycloakIssuer(issuer))
+	}
+	if !reflect.DeepEqual(wm.Scopes, scopes) {
+		changes = append(changes, idp.ChangeKeycloakScopes(scopes))
+	}
+	opts := wm.Options.Changes(options)
+	if !opts.IsZero() {
+		changes = append(changes, idp.ChangeKeycloakOptions(opts))
+	}
+	return changes, nil
+}
+
+func (wm *KeycloakIDPWriteModel) ToProvider(callbackURL string, idpAlg crypto.EncryptionAlgorithm) (providers.Provider, error) {
+	secret, err := crypto.DecryptString(wm.ClientSecret, idpAlg)
+	if err != nil {
+		return nil, err
+	}
+	opts := make([]oidc.ProviderOpts, 0, 4)
+	if wm.IsCreationAllowed {
+		opts = append(opts, oidc.WithCreationAllowed())
+	}
+	if wm.IsLinkingAllowed {
+		opts = append(opts, oidc.WithLinkingAllowed())
+	}
+	if wm.IsAutoCreation {
+		opts = append(opts, oidc.WithAutoCreation())
+	}
+	if wm.IsAutoUpdate {
+		opts = append(opts, oidc.WithAutoUpdate())
+	}
+	return keycloak.New(
+		wm.Name,
+		wm.Issuer,
+		wm.ClientID,
+		secret,
+		callbackURL,
+		wm.Scopes,
+		opts...,
+	)
+}
+
+func (wm *KeycloakIDPWriteModel) GetProviderOptions() idp.Options {
+	return wm.Options
+}
+
+type OktaIDPWriteModel struct {
+	eventstore.WriteModel
+
+	ID           string
+	Name         string
+	Issuer       string
+	ClientID     string
+	ClientSecret *crypto.CryptoValue
+	Scopes       []string
+	idp.Options
+
+	State domain.IDPState
+}
+
+func (wm *OktaIDPWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *idp.OktaIDPAddedEvent:
+			wm.reduceAddedEvent(e)
+		case *idp.OktaIDPChangedEvent:
+			wm.reduceChangedEvent(e)
+		case *idp.RemovedEvent:
+			wm.State = domain.IDPStateRemoved
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *OktaIDPWriteModel) reduceAddedEvent(e *idp.OktaIDPAddedEvent) {
+	wm.Name = e.Name
+	wm.Issuer = e.Issuer
+	wm.ClientID = e.ClientID
+	wm.ClientSecret = e.ClientSecret
+	wm.Scopes = e.Scopes
+	wm.Options = e.Options
+	wm.State = domain.IDPStateActive
+}
+
+func (wm *OktaIDPWriteModel) reduceChangedEvent(e *idp.OktaIDPChangedEvent) {
+	if e.ClientID != nil {
+		wm.ClientID = *e.ClientID
+	}
+	if e.ClientSecret != nil {
+		wm.ClientSecret = e.ClientSecret
+	}
+	if e.Name != nil {
+		wm.Name = *e.Name
+	}
+	if e.Issuer != nil {
+		wm.Issuer = *e.Issuer
+	}
+	if e.Scopes != nil {
+		wm.Scopes = e.Scopes
+	}
+	wm.Options.ReduceChanges(e.OptionChanges)
+}
+
+func (wm *OktaIDPWriteModel) NewChanges(
+	name string,
+	issuer string,
+	clientID string,
+	clientSecretString string,
+	secretCrypto crypto.EncryptionAlgorithm,
+	scopes []string,
+	options idp.Options,
+) ([]idp.OktaIDPChanges, error) {
+	changes := make([]idp.OktaIDPChanges, 0)
+	var clientSecret *crypto.CryptoValue
+	var err error
+	if clientSecretString != "" {
+		clientSecret, err = crypto.Crypt([]byte(clientSecretString), secretCrypto)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, idp.ChangeOktaClientSecret(clientSecret))
+	}
+	if wm.ClientID != clientID {
+		changes = append(changes, idp.ChangeOktaClientID(clientID))
+	}
+	if wm.Name != name {
+		changes = append(changes, idp.ChangeOktaName(name))
+	}
+	if wm.Issuer != issuer {
+		changes = append(changes, idp.ChangeOktaIssuer(issuer))
+	}
+	if !reflect.DeepEqual(wm.Scopes, scopes) {
+		changes = append(changes, idp.ChangeOktaScopes(scopes))
+	}
+	opts := wm.Options.Changes(options)
+	if !opts.IsZero() {
+		changes = append(changes, idp.ChangeOktaOptions(opts))
+	}
+	return changes, nil
+}
+
+func (wm *OktaIDPWriteModel) ToProvider(callbackURL string, idpAlg crypto.EncryptionAlgorithm) (providers.Provider, error) {
+	secret, err := crypto.DecryptString(wm.ClientSecret, idpAlg)
+	if err != nil {
+		return nil, err
+	}
+	opts := make([]oidc.ProviderOpts, 0, 4)
+	if wm.IsCreationAllowed {
+		opts = append(opts, oidc.WithCreationAllowed())
+	}
+	if wm.IsLinkingAllowed {
+		opts = append(opts, oidc.WithLinkingAllowed())
+	}
+	if wm.IsAutoCreation {
+		opts = append(opts, oidc.WithAutoCreation())
+	}
+	if wm.IsAutoUpdate {
+		opts = append(opts, oidc.WithAutoUpdate())
+	}
+	return okta.New(
+		wm.Name,
+		wm.Issuer,
+		wm.ClientID,
+		secret,
+		callbackURL,
+		wm.Scopes,
+		opts...,
+	)
+}
+
+func (wm *OktaIDPWriteModel) GetProviderOptions() idp.Options {
+	return wm.Options
+}
+
+type GoogleIDPWriteModel struct {
+	eventstore.WriteModel
+
+	ID           string
+	Name         string
+	ClientID     string
+	ClientSecret *crypto.CryptoValue
+	Scopes       []string
+	idp.Options
+
+	State domain.IDPState
+}
+
+func (wm *GoogleIDPWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *idp.GoogleIDPAddedEvent:
+			wm.reduceAddedEvent(e)
+		case *idp.GoogleIDPChangedEvent:
+			wm.reduceChangedEvent(e)
+		case *idp.OIDCIDPMigratedGoogleEvent:
+			wm.reduceAddedEvent(&e.GoogleIDPAddedEvent)
+		case *idp.RemovedEvent:
+			wm.State = domain.IDPStateRemoved
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *GoogleIDPWriteModel) reduceAddedEvent(e *idp.GoogleIDPAddedEvent) {
+	wm.Name = e.Name
+	wm.ClientID = e.ClientID
+	wm.ClientSecret = e.ClientSecret
+	wm.Scopes = e.Scopes
+	wm.Options = e.Options
+	wm.State = domain.IDPStateActive
+}
+
+func (wm *GoogleIDPWriteModel) reduceChangedEvent(e *idp.GoogleIDPChangedEvent) {
+	if e.Name != nil {
+		wm.Name = *e.Name
+	}
+	if e.ClientID != nil {
+		wm.ClientID = *e.ClientID
+	}
+	if e.ClientSecret != nil {
+		wm.ClientSecret = e.ClientSecret
+	}
+	if e.Scopes != nil {
+		wm.Scopes = e.Scopes
+	}
+	wm.Options.ReduceChanges(e.OptionChanges)
+}
+
+func (wm *GoogleIDPWriteModel) NewChanges(
+	name string,
+	clientID string,
+	clientSecretString string,
+	secretCrypto crypto.EncryptionAlgorithm,
+	scopes []string,
+	options idp.Options,
+) ([]idp.GoogleIDPChanges, error) {
+	changes := make([]idp.GoogleIDPChanges, 0)
+	var clientSecret *crypto.CryptoValue
+	var err error
+	if clientSecretString != "" {
+		clientSecret, err = crypto.Crypt([]byte(clientSecretString), secretCrypto)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, idp.ChangeGoogleClientSecret(clientSecret))
+	}
+	if wm.Name != name {
+		changes = append(changes, idp.ChangeGoogleName(name))
+	}
+	if wm.ClientID != clientID {
+		changes = append(changes, idp.ChangeGoogleClientID(clientID))
+	}
+	if !reflect.DeepEqual(wm.Scopes, scopes) {
+		changes = append(changes, idp.ChangeGoogleScopes(scopes))
+	}
+
+	opts := wm.Options.Changes(options)
+	if !opts.IsZero() {
+		changes = append(changes, idp.ChangeGoogleOptions(opts))
+	}
+	return changes, nil
+}
+
+func (wm *GoogleIDPWriteModel) ToProvider(callbackURL string, idpAlg crypto.EncryptionAlgorithm) (providers.Provider, error) {
+	errorHandler := func(w http.ResponseWriter, r *http.Request, errorType string, errorDesc string, state string) {
+		logging.Errorf("token exchanged failed: %s - %s (state: %s)", errorType, errorType, state)
+		rp.DefaultErrorHandler(w, r, errorType, errorDesc, state)
+	}
+	oidc.WithRelyingPartyOption(rp.WithErrorHandler(errorHandler))
+	secret, err := crypto.DecryptString(wm.ClientSecret, idpAlg)
+	if err != nil {
+		return nil, err
+	}
+	opts := make([]oidc.ProviderOpts, 0, 4)
+	if wm.IsCreationAllowed {
+		opts = append(opts, oidc.WithCreationAllowed())
+	}
+	if wm.IsLinkingAllowed {
+		opts = append(opts, oidc.WithLinkingAllowed())
+	}
+	if wm.IsAutoCreation {
+		opts = append(opts, oidc.WithAutoCreation())
+	}
+	if wm.IsAutoUpdate {
+		opts = append(opts, oidc.WithAutoUpdate())
+	}
+	return google.New(
 		wm.ClientID,
 		secret,
 		callbackURL,
@@ -1914,12 +2569,22 @@ func (wm *IDPRemoveWriteModel) Reduce() error {
 			wm.reduceAdded(e.ID)
 		case *idp.GitHubIDPAddedEvent:
 			wm.reduceAdded(e.ID)
+		case *idp.BitbucketIDPAddedEvent:
+			wm.reduceAdded(e.ID)
+		case *idp.DiscordIDPAddedEvent:
+			wm.reduceAdded(e.ID)
 		case *idp.GitHubEnterpriseIDPAddedEvent:
 			wm.reduceAdded(e.ID)
 		case *idp.GitLabIDPAddedEvent:
 			wm.reduceAdded(e.ID)
 		case *idp.GitLabSelfHostedIDPAddedEvent:
 			wm.reduceAdded(e.ID)
+		case *idp.ADFSIDPAddedEvent:
+			wm.reduceAdded(e.ID)
+		case *idp.KeycloakIDPAddedEvent:
+			wm.reduceAdded(e.ID)
+		case *idp.OktaIDPAddedEvent:
+			wm.reduceAdded(e.ID)
 		case *idp.GoogleIDPAddedEvent:
 			wm.reduceAdded(e.ID)
 		case *idp.LDAPIDPAddedEvent:
@@ -1988,8 +2653,16 @@ func (wm *IDPTypeWriteModel) Reduce() error {
 			wm.reduceAdded(e.ID, domain.IDPTypeAzureAD, e.Aggregate())
 		case *instance.GitHubIDPAddedEvent:
 			wm.reduceAdded(e.ID, domain.IDPTypeGitHub, e.Aggregate())
+		case *instance.BitbucketIDPAddedEvent:
+			wm.reduceAdded(e.ID, domain.IDPTypeBitbucket, e.Aggregate())
+		case *instance.DiscordIDPAddedEvent:
+			wm.reduceAdded(e.ID, domain.IDPTypeDiscord, e.Aggregate())
 		case *org.GitHubIDPAddedEvent:
 			wm.reduceAdded(e.ID, domain.IDPTypeGitHub, e.Aggregate())
+		case *org.BitbucketIDPAddedEvent:
+			wm.reduceAdded(e.ID, domain.IDPTypeBitbucket, e.Aggregate())
+		case *org.DiscordIDPAddedEvent:
+			wm.reduceAdded(e.ID, domain.IDPTypeDiscord, e.Aggregate())
 		case *instance.GitHubEnterpriseIDPAddedEvent:
 			wm.reduceAdded(e.ID, domain.IDPTypeGitHubEnterprise, e.Aggregate())
 		case *org.GitHubEnterpriseIDPAddedEvent:
@@ -2000,8 +2673,20 @@ func (wm *IDPTypeWriteModel) Reduce() error {
 			wm.reduceAdded(e.ID, domain.IDPTypeGitLab, e.Aggregate())
 		case *instance.GitLabSelfHostedIDPAddedEvent:
 			wm.reduceAdded(e.ID, domain.IDPTypeGitLabSelfHosted, e.Aggregate())
+		case *instance.ADFSIDPAddedEvent:
+			wm.reduceAdded(e.ID, domain.IDPTypeADFS, e.Aggregate())
+		case *instance.KeycloakIDPAddedEvent:
+			wm.reduceAdded(e.ID, domain.IDPTypeKeycloak, e.Aggregate())
+		case *instance.OktaIDPAddedEvent:
+			wm.reduceAdded(e.ID, domain.IDPTypeOkta, e.Aggregate())
 		case *org.GitLabSelfHostedIDPAddedEvent:
 			wm.reduceAdded(e.ID, domain.IDPTypeGitLabSelfHosted, e.Aggregate())
+		case *org.ADFSIDPAddedEvent:
+			wm.reduceAdded(e.ID, domain.IDPTypeADFS, e.Aggregate())
+		case *org.KeycloakIDPAddedEvent:
+			wm.reduceAdded(e.ID, domain.IDPTypeKeycloak, e.Aggregate())
+		case *org.OktaIDPAddedEvent:
+			wm.reduceAdded(e.ID, domain.IDPTypeOkta, e.Aggregate())
 		case *instance.GoogleIDPAddedEvent:
 			wm.reduceAdded(e.ID, domain.IDPTypeGoogle, e.Aggregate())
 		case *org.GoogleIDPAddedEvent:
@@ -2088,9 +2773,14 @@ func (wm *IDPTypeWriteModel) Query() *eventstore.SearchQueryBuilder {
 			instance.JWTIDPAddedEventType,
 			instance.AzureADIDPAddedEventType,
 			instance.GitHubIDPAddedEventType,
+			instance.BitbucketIDPAddedEventType,
+			instance.DiscordIDPAddedEventType,
 			instance.GitHubEnterpriseIDPAddedEventType,
 			instance.GitLabIDPAddedEventType,
 			instance.GitLabSelfHostedIDPAddedEventType,
+			instance.ADFSIDPAddedEventType,
+			instance.KeycloakIDPAddedEventType,
+			instance.OktaIDPAddedEventType,
 			instance.GoogleIDPAddedEventType,
 			instance.LDAPIDPAddedEventType,
 			instance.AppleIDPAddedEventType,
@@ -2108,9 +2798,14 @@ func (wm *IDPTypeWriteModel) Query() *eventstore.SearchQueryBuilder {
 			org.JWTIDPAddedEventType,
 			org.AzureADIDPAddedEventType,
 			org.GitHubIDPAddedEventType,
+			org.BitbucketIDPAddedEventType,
+			org.DiscordIDPAddedEventType,
 			org.GitHubEnterpriseIDPAddedEventType,
 			org.GitLabIDPAddedEventType,
 			org.GitLabSelfHostedIDPAddedEventType,
+			org.ADFSIDPAddedEventType,
+			org.KeycloakIDPAddedEventType,
+			org.OktaIDPAddedEventType,
 			org.GoogleIDPAddedEventType,
 			org.LDAPIDPAddedEventType,
 			org.AppleIDPAddedEventType,
@@ -2181,12 +2876,22 @@ func NewAllIDPWriteModel(resourceOwner string, instanceBool bool, id string, idp
 			writeModel.model = NewAzureADInstanceIDPWriteModel(resourceOwner, id)
 		case domain.IDPTypeGitHub:
 			writeModel.model = NewGitHubInstanceIDPWriteModel(resourceOwner, id)
+		case domain.IDPTypeBitbucket:
+			writeModel.model = NewBitbucketInstanceIDPWriteModel(resourceOwner, id)
+		case domain.IDPTypeDiscord:
+			writeModel.model = NewDiscordInstanceIDPWriteModel(resourceOwner, id)
 		case domain.IDPTypeGitHubEnterprise:
 			writeModel.model = NewGitHubEnterpriseInstanceIDPWriteModel(resourceOwner, id)
 		case domain.IDPTypeGitLab:
 			writeModel.model = NewGitLabInstanceIDPWriteModel(resourceOwner, id)
 		case domain.IDPTypeGitLabSelfHosted:
 			writeModel.model = NewGitLabSelfHostedInstanceIDPWriteModel(resourceOwner, id)
+		case domain.IDPTypeADFS:
+			writeModel.model = NewADFSInstanceIDPWriteModel(resourceOwner, id)
+		case domain.IDPTypeKeycloak:
+			writeModel.model = NewKeycloakInstanceIDPWriteModel(resourceOwner, id)
+		case domain.IDPTypeOkta:
+			writeModel.model = NewOktaInstanceIDPWriteModel(resourceOwner, id)
 		case domain.IDPTypeGoogle:
 			writeModel.model = NewGoogleInstanceIDPWriteModel(resourceOwner, id)
 		case domain.IDPTypeApple:
@@ -2212,12 +2917,22 @@ func NewAllIDPWriteModel(resourceOwner string, instanceBool bool, id string, idp
 			writeModel.model = NewAzureADOrgIDPWriteModel(resourceOwner, id)
 		case domain.IDPTypeGitHub:
 			writeModel.model = NewGitHubOrgIDPWriteModel(resourceOwner, id)
+		case domain.IDPTypeBitbucket:
+			writeModel.model = NewBitbucketOrgIDPWriteModel(resourceOwner, id)
+		case domain.IDPTypeDiscord:
+			writeModel.model = NewDiscordOrgIDPWriteModel(resourceOwner, id)
 		case domain.IDPTypeGitHubEnterprise:
 			writeModel.model = NewGitHubEnterpriseOrgIDPWriteModel(resourceOwner, id)
 		case domain.IDPTypeGitLab:
 			writeModel.model = NewGitLabOrgIDPWriteModel(resourceOwner, id)
 		case domain.IDPTypeGitLabSelfHosted:
 			writeModel.model = NewGitLabSelfHostedOrgIDPWriteModel(resourceOwner, id)
+		case domain.IDPTypeADFS:
+			writeModel.model = NewADFSOrgIDPWriteModel(resourceOwner, id)
+		case domain.IDPTypeKeycloak:
+			writeModel.model = NewKeycloakOrgIDPWriteModel(resourceOwner, id)
+		case domain.IDPTypeOkta:
+			writeModel.model = NewOktaOrgIDPWriteModel(resourceOwner, id)
 		case domain.IDPTypeGoogle:
 			writeModel.model = NewGoogleOrgIDPWriteModel(resourceOwner, id)
 		case domain.IDPTypeApple:
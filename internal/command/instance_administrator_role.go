@@ -0,0 +1,113 @@
+package command
+
+import (
+	"context"
+	"slices"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/command/preparation"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// AddAdministratorRole adds a custom role to the instance, which can be assigned to the members
+// of the level defined by the prefix of its key (e.g. ORG_).
+// The role can only grant permissions, which are granted by one of the roles of the config.
+func (c *Commands) AddAdministratorRole(ctx context.Context, role *domain.AdministratorRole) (*domain.ObjectDetails, error) {
+	if err := role.Validate(c.zitadelRoles); err != nil {
+		return nil, err
+	}
+	writeModel, err := c.instanceAdministratorRoleWriteModel(ctx, role.Key)
+	if err != nil {
+		return nil, err
+	}
+	if writeModel.State == domain.PolicyStateActive {
+		return nil, zerrors.ThrowAlreadyExists(nil, "COMMAND-Ar5ex", "Errors.AdministratorRole.AlreadyExists")
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, instance.NewAdministratorRoleAddedEvent(ctx, InstanceAggregateFromWriteModel(&writeModel.WriteModel), role.Key, role.DisplayName, role.Permissions))
+	if err != nil {
+		return nil, err
+	}
+	if err = AppendAndReduce(writeModel, pushedEvents...); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// ChangeAdministratorRole replaces the display name and the permissions of a custom role.
+// The members the role is assigned to get the new permissions immediately.
+func (c *Commands) ChangeAdministratorRole(ctx context.Context, role *domain.AdministratorRole) (*domain.ObjectDetails, error) {
+	if err := role.Validate(c.zitadelRoles); err != nil {
+		return nil, err
+	}
+	writeModel, err := c.instanceAdministratorRoleWriteModel(ctx, role.Key)
+	if err != nil {
+		return nil, err
+	}
+	if writeModel.State != domain.PolicyStateActive {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Ar6nf", "Errors.AdministratorRole.NotFound")
+	}
+	if writeModel.DisplayName == role.DisplayName && slices.Equal(writeModel.Permissions, role.Permissions) {
+		return writeModelToObjectDetails(&writeModel.WriteModel), nil
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, instance.NewAdministratorRoleChangedEvent(ctx, InstanceAggregateFromWriteModel(&writeModel.WriteModel), role.Key, role.DisplayName, role.Permissions))
+	if err != nil {
+		return nil, err
+	}
+	if err = AppendAndReduce(writeModel, pushedEvents...); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// RemoveAdministratorRole removes a custom role.
+// Members keep the role, but it doesn't grant any permissions anymore.
+func (c *Commands) RemoveAdministratorRole(ctx context.Context, key string) (*domain.ObjectDetails, error) {
+	if key == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ar7ky", "Errors.AdministratorRole.KeyInvalid")
+	}
+	writeModel, err := c.instanceAdministratorRoleWriteModel(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if writeModel.State != domain.PolicyStateActive {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Ar8nf", "Errors.AdministratorRole.NotFound")
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, instance.NewAdministratorRoleRemovedEvent(ctx, InstanceAggregateFromWriteModel(&writeModel.WriteModel), key))
+	if err != nil {
+		return nil, err
+	}
+	if err = AppendAndReduce(writeModel, pushedEvents...); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+func (c *Commands) instanceAdministratorRoleWriteModel(ctx context.Context, key string) (*InstanceAdministratorRoleWriteModel, error) {
+	writeModel := NewInstanceAdministratorRoleWriteModel(authz.GetInstance(ctx).InstanceID(), key)
+	if err := c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
+
+// invalidMemberRoles returns the roles which are neither defined by the config nor as custom role of the instance
+// or don't have the prefix of the member level.
+// The custom roles are only read if a role isn't defined by the config.
+func (c *Commands) invalidMemberRoles(ctx context.Context, filter preparation.FilterToQueryReducer, roles []string, rolePrefix string) ([]string, error) {
+	invalidRoles := domain.CheckForInvalidRoles(roles, rolePrefix, c.zitadelRoles)
+	if len(invalidRoles) == 0 {
+		return nil, nil
+	}
+	writeModel := NewInstanceAdministratorRolesWriteModel(authz.GetInstance(ctx).InstanceID())
+	events, err := filter(ctx, writeModel.Query())
+	if err != nil {
+		return nil, err
+	}
+	writeModel.AppendEvents(events...)
+	if err = writeModel.Reduce(); err != nil {
+		return nil, err
+	}
+	return domain.CheckForInvalidRoles(invalidRoles, rolePrefix, writeModel.RoleMappings()), nil
+}
@@ -0,0 +1,136 @@
+package command
+
+import (
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+)
+
+type InstanceAdministratorRoleWriteModel struct {
+	eventstore.WriteModel
+
+	Key         string
+	DisplayName string
+	Permissions []string
+	State       domain.PolicyState
+}
+
+func NewInstanceAdministratorRoleWriteModel(instanceID, key string) *InstanceAdministratorRoleWriteModel {
+	return &InstanceAdministratorRoleWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   instanceID,
+			ResourceOwner: instanceID,
+			InstanceID:    instanceID,
+		},
+		Key: key,
+	}
+}
+
+func (wm *InstanceAdministratorRoleWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *instance.AdministratorRoleAddedEvent:
+			if e.Key != wm.Key {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
+		case *instance.AdministratorRoleChangedEvent:
+			if e.Key != wm.Key {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
+		case *instance.AdministratorRoleRemovedEvent:
+			if e.Key != wm.Key {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
+		}
+	}
+}
+
+func (wm *InstanceAdministratorRoleWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *instance.AdministratorRoleAddedEvent:
+			wm.DisplayName = e.DisplayName
+			wm.Permissions = e.Permissions
+			wm.State = domain.PolicyStateActive
+		case *instance.AdministratorRoleChangedEvent:
+			wm.DisplayName = e.DisplayName
+			wm.Permissions = e.Permissions
+		case *instance.AdministratorRoleRemovedEvent:
+			wm.DisplayName = ""
+			wm.Permissions = nil
+			wm.State = domain.PolicyStateRemoved
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *InstanceAdministratorRoleWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(instance.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			instance.AdministratorRoleAddedEventType,
+			instance.AdministratorRoleChangedEventType,
+			instance.AdministratorRoleRemovedEventType).
+		Builder()
+}
+
+// InstanceAdministratorRolesWriteModel are all custom roles of the instance.
+type InstanceAdministratorRolesWriteModel struct {
+	eventstore.WriteModel
+
+	Roles map[string][]string
+}
+
+func NewInstanceAdministratorRolesWriteModel(instanceID string) *InstanceAdministratorRolesWriteModel {
+	return &InstanceAdministratorRolesWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   instanceID,
+			ResourceOwner: instanceID,
+			InstanceID:    instanceID,
+		},
+		Roles: make(map[string][]string),
+	}
+}
+
+func (wm *InstanceAdministratorRolesWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *instance.AdministratorRoleAddedEvent:
+			wm.Roles[e.Key] = e.Permissions
+		case *instance.AdministratorRoleChangedEvent:
+			wm.Roles[e.Key] = e.Permissions
+		case *instance.AdministratorRoleRemovedEvent:
+			delete(wm.Roles, e.Key)
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *InstanceAdministratorRolesWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(instance.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			instance.AdministratorRoleAddedEventType,
+			instance.AdministratorRoleChangedEventType,
+			instance.AdministratorRoleRemovedEventType).
+		Builder()
+}
+
+// RoleMappings returns the custom roles the same way the roles of the config are defined.
+func (wm *InstanceAdministratorRolesWriteModel) RoleMappings() []authz.RoleMapping {
+	mappings := make([]authz.RoleMapping, 0, len(wm.Roles))
+	for key, permissions := range wm.Roles {
+		mappings = append(mappings, authz.RoleMapping{Role: key, Permissions: permissions})
+	}
+	return mappings
+}
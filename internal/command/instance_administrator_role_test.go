@@ -0,0 +1,475 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var administratorRoleTestConfigRoles = []authz.RoleMapping{
+	{
+		Role:        "ORG_OWNER",
+		Permissions: []string{"org.read", "user.read", "user.credential.write"},
+	},
+}
+
+func TestCommandSide_AddAdministratorRole(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx  context.Context
+		role *domain.AdministratorRole
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "invalid key, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+				role: &domain.AdministratorRole{
+					Key:         "USER_SUPPORT",
+					Permissions: []string{"user.read"},
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "key of config role, already exists error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+				role: &domain.AdministratorRole{
+					Key:         "ORG_OWNER",
+					Permissions: []string{"user.read"},
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorAlreadyExists,
+			},
+		},
+		{
+			name: "unknown permission, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+				role: &domain.AdministratorRole{
+					Key:         "ORG_USER_SUPPORT",
+					Permissions: []string{"iam.write"},
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "role already exists, already exists error",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewAdministratorRoleAddedEvent(context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"ORG_USER_SUPPORT",
+								"User Support",
+								[]string{"user.read"},
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+				role: &domain.AdministratorRole{
+					Key:         "ORG_USER_SUPPORT",
+					Permissions: []string{"user.read"},
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorAlreadyExists,
+			},
+		},
+		{
+			name: "add role, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(),
+					expectPush(
+						instance.NewAdministratorRoleAddedEvent(context.Background(),
+							&instance.NewAggregate("INSTANCE").Aggregate,
+							"ORG_USER_SUPPORT",
+							"User Support",
+							[]string{"user.read", "user.credential.write"},
+						),
+					),
+				),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+				role: &domain.AdministratorRole{
+					Key:         "ORG_USER_SUPPORT",
+					DisplayName: "User Support",
+					Permissions: []string{"user.read", "user.credential.write"},
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "INSTANCE",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:   tt.fields.eventstore,
+				zitadelRoles: administratorRoleTestConfigRoles,
+			}
+			got, err := r.AddAdministratorRole(tt.args.ctx, tt.args.role)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_ChangeAdministratorRole(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx  context.Context
+		role *domain.AdministratorRole
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "role not existing, not found error",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+				role: &domain.AdministratorRole{
+					Key:         "ORG_USER_SUPPORT",
+					Permissions: []string{"user.read"},
+				},
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "role removed, not found error",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewAdministratorRoleAddedEvent(context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"ORG_USER_SUPPORT",
+								"User Support",
+								[]string{"user.read"},
+							),
+						),
+						eventFromEventPusher(
+							instance.NewAdministratorRoleRemovedEvent(context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"ORG_USER_SUPPORT",
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+				role: &domain.AdministratorRole{
+					Key:         "ORG_USER_SUPPORT",
+					Permissions: []string{"user.read"},
+				},
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "no changes, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewAdministratorRoleAddedEvent(context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"ORG_USER_SUPPORT",
+								"User Support",
+								[]string{"user.read"},
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+				role: &domain.AdministratorRole{
+					Key:         "ORG_USER_SUPPORT",
+					DisplayName: "User Support",
+					Permissions: []string{"user.read"},
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "INSTANCE",
+				},
+			},
+		},
+		{
+			name: "change role, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewAdministratorRoleAddedEvent(context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"ORG_USER_SUPPORT",
+								"User Support",
+								[]string{"user.read"},
+							),
+						),
+					),
+					expectPush(
+						instance.NewAdministratorRoleChangedEvent(context.Background(),
+							&instance.NewAggregate("INSTANCE").Aggregate,
+							"ORG_USER_SUPPORT",
+							"User Support",
+							[]string{"user.read", "user.credential.write"},
+						),
+					),
+				),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+				role: &domain.AdministratorRole{
+					Key:         "ORG_USER_SUPPORT",
+					DisplayName: "User Support",
+					Permissions: []string{"user.read", "user.credential.write"},
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "INSTANCE",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:   tt.fields.eventstore,
+				zitadelRoles: administratorRoleTestConfigRoles,
+			}
+			got, err := r.ChangeAdministratorRole(tt.args.ctx, tt.args.role)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_RemoveAdministratorRole(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx context.Context
+		key string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "missing key, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "role not existing, not found error",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+				key: "ORG_USER_SUPPORT",
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "remove role, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewAdministratorRoleAddedEvent(context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"ORG_USER_SUPPORT",
+								"User Support",
+								[]string{"user.read"},
+							),
+						),
+					),
+					expectPush(
+						instance.NewAdministratorRoleRemovedEvent(context.Background(),
+							&instance.NewAggregate("INSTANCE").Aggregate,
+							"ORG_USER_SUPPORT",
+						),
+					),
+				),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+				key: "ORG_USER_SUPPORT",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "INSTANCE",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			got, err := r.RemoveAdministratorRole(tt.args.ctx, tt.args.key)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommands_invalidMemberRoles(t *testing.T) {
+	ctx := authz.WithInstanceID(context.Background(), "INSTANCE")
+	customRole := instance.NewAdministratorRoleAddedEvent(ctx,
+		&instance.NewAggregate("INSTANCE").Aggregate,
+		"ORG_USER_SUPPORT",
+		"User Support",
+		[]string{"user.read"},
+	)
+	tests := []struct {
+		name   string
+		roles  []string
+		filter *eventstore.Eventstore
+		want   []string
+	}{
+		{
+			name:   "config role, custom roles not read",
+			roles:  []string{"ORG_OWNER"},
+			filter: eventstoreExpect(t),
+		},
+		{
+			name:  "custom role, ok",
+			roles: []string{"ORG_OWNER", "ORG_USER_SUPPORT"},
+			filter: eventstoreExpect(t,
+				expectFilter(eventFromEventPusher(customRole)),
+			),
+		},
+		{
+			name:  "role of other level, invalid",
+			roles: []string{"IAM_USER_SUPPORT"},
+			filter: eventstoreExpect(t,
+				expectFilter(eventFromEventPusher(customRole)),
+			),
+			want: []string{"IAM_USER_SUPPORT"},
+		},
+		{
+			name:  "unknown role, invalid",
+			roles: []string{"ORG_UNKNOWN"},
+			filter: eventstoreExpect(t,
+				expectFilter(),
+			),
+			want: []string{"ORG_UNKNOWN"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				zitadelRoles: administratorRoleTestConfigRoles,
+			}
+			//nolint:staticcheck
+			got, err := c.invalidMemberRoles(ctx, tt.filter.Filter, tt.roles, domain.OrgRolePrefix)
+			assert.NoError(t, err)
+			assert.ElementsMatch(t, tt.want, got)
+		})
+	}
+}
@@ -155,6 +155,7 @@ func TestCommandSide_AddInstanceDomain(t *testing.T) {
 								time.Second*1,
 								[]string{"https://sub.test.ch"},
 								false,
+								domain.LevelOfAssuranceNone,
 							),
 						),
 					),
@@ -18,10 +18,12 @@ func (c *Commands) AddInstanceMemberCommand(a *instance.Aggregate, userID string
 		if userID == "" {
 			return nil, zerrors.ThrowInvalidArgument(nil, "INSTA-SDSfs", "Errors.Invalid.Argument")
 		}
-		if len(domain.CheckForInvalidRoles(roles, domain.IAMRolePrefix, c.zitadelRoles)) > 0 {
-			return nil, zerrors.ThrowInvalidArgument(nil, "INSTANCE-4m0fS", "Errors.IAM.MemberInvalid")
-		}
 		return func(ctx context.Context, filter preparation.FilterToQueryReducer) ([]eventstore.Command, error) {
+				if invalidRoles, err := c.invalidMemberRoles(ctx, filter, roles, domain.IAMRolePrefix); err != nil {
+					return nil, err
+				} else if len(invalidRoles) > 0 {
+					return nil, zerrors.ThrowInvalidArgument(nil, "INSTANCE-4m0fS", "Errors.IAM.MemberInvalid")
+				}
 				if exists, err := ExistsUser(ctx, filter, userID, ""); err != nil || !exists {
 					return nil, zerrors.ThrowPreconditionFailed(err, "INSTA-GSXOn", "Errors.User.NotFound")
 				}
@@ -44,6 +46,7 @@ func IsInstanceMember(ctx context.Context, filter preparation.FilterToQueryReduc
 			instance.MemberAddedEventType,
 			instance.MemberRemovedEventType,
 			instance.MemberCascadeRemovedEventType,
+			instance.MemberExpiredEventType,
 		).Builder())
 	if err != nil {
 		return false, err
@@ -63,6 +66,10 @@ func IsInstanceMember(ctx context.Context, filter preparation.FilterToQueryReduc
 			if e.UserID == userID {
 				isMember = false
 			}
+		case *instance.MemberExpiredEvent:
+			if e.UserID == userID {
+				isMember = false
+			}
 		}
 	}
 
@@ -92,7 +99,10 @@ func (c *Commands) ChangeInstanceMember(ctx context.Context, member *domain.Memb
 	if !member.IsIAMValid() {
 		return nil, zerrors.ThrowInvalidArgument(nil, "INSTANCE-LiaZi", "Errors.IAM.MemberInvalid")
 	}
-	if len(domain.CheckForInvalidRoles(member.Roles, domain.IAMRolePrefix, c.zitadelRoles)) > 0 {
+	//nolint:staticcheck
+	if invalidRoles, err := c.invalidMemberRoles(ctx, c.eventstore.Filter, member.Roles, domain.IAMRolePrefix); err != nil {
+		return nil, err
+	} else if len(invalidRoles) > 0 {
 		return nil, zerrors.ThrowInvalidArgument(nil, "INSTANCE-3m9fs", "Errors.IAM.MemberInvalid")
 	}
 
@@ -0,0 +1,63 @@
+package command
+
+import (
+	"bytes"
+	"context"
+
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// SetDefaultMessageTemplate sets the custom HTML template of a message type or a partial (header or footer) for a language of the instance,
+// which is used for all organizations without their own template.
+func (c *Commands) SetDefaultMessageTemplate(ctx context.Context, instanceID, name string, lang language.Tag, template []byte) (*domain.ObjectDetails, error) {
+	if err := validateMessageTemplate(name, lang, template); err != nil {
+		return nil, err
+	}
+	writeModel, err := c.instanceMessageTemplateWriteModel(ctx, instanceID, name, lang)
+	if err != nil {
+		return nil, err
+	}
+	if writeModel.State == domain.PolicyStateActive && bytes.Equal(writeModel.Template, template) {
+		return writeModelToObjectDetails(&writeModel.WriteModel), nil
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, instance.NewMessageTemplateSetEvent(ctx, InstanceAggregateFromWriteModel(&writeModel.WriteModel), name, lang, template))
+	if err != nil {
+		return nil, err
+	}
+	if err = AppendAndReduce(writeModel, pushedEvents...); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// RemoveDefaultMessageTemplate removes the custom HTML template of a message type or a partial for a language of the instance,
+// so the default template is used again.
+func (c *Commands) RemoveDefaultMessageTemplate(ctx context.Context, instanceID, name string, lang language.Tag) (*domain.ObjectDetails, error) {
+	writeModel, err := c.instanceMessageTemplateWriteModel(ctx, instanceID, name, lang)
+	if err != nil {
+		return nil, err
+	}
+	if writeModel.State != domain.PolicyStateActive {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Mt4nf", "Errors.MessageTemplate.NotFound")
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, instance.NewMessageTemplateRemovedEvent(ctx, InstanceAggregateFromWriteModel(&writeModel.WriteModel), name, lang))
+	if err != nil {
+		return nil, err
+	}
+	if err = AppendAndReduce(writeModel, pushedEvents...); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+func (c *Commands) instanceMessageTemplateWriteModel(ctx context.Context, instanceID, name string, lang language.Tag) (*InstanceMessageTemplateWriteModel, error) {
+	writeModel := NewInstanceMessageTemplateWriteModel(instanceID, name, lang)
+	if err := c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
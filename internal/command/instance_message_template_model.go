@@ -0,0 +1,52 @@
+package command
+
+import (
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+)
+
+type InstanceMessageTemplateWriteModel struct {
+	MessageTemplateWriteModel
+}
+
+func NewInstanceMessageTemplateWriteModel(instanceID, name string, lang language.Tag) *InstanceMessageTemplateWriteModel {
+	return &InstanceMessageTemplateWriteModel{
+		MessageTemplateWriteModel{
+			WriteModel: eventstore.WriteModel{
+				AggregateID:   instanceID,
+				ResourceOwner: instanceID,
+			},
+			Name:     name,
+			Language: lang,
+		},
+	}
+}
+
+func (wm *InstanceMessageTemplateWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *instance.MessageTemplateSetEvent:
+			wm.MessageTemplateWriteModel.AppendEvents(&e.MessageTemplateSetEvent)
+		case *instance.MessageTemplateRemovedEvent:
+			wm.MessageTemplateWriteModel.AppendEvents(&e.MessageTemplateRemovedEvent)
+		}
+	}
+}
+
+func (wm *InstanceMessageTemplateWriteModel) Reduce() error {
+	return wm.MessageTemplateWriteModel.Reduce()
+}
+
+func (wm *InstanceMessageTemplateWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(instance.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			instance.MessageTemplateSetEventType,
+			instance.MessageTemplateRemovedEventType).
+		Builder()
+}
@@ -0,0 +1,37 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// SetBreakGlassPolicy replaces the recipients, Actions targets and session lifetime
+// used for the emergency access accounts of the instance.
+func (c *Commands) SetBreakGlassPolicy(ctx context.Context, policy *domain.BreakGlassPolicy) (*domain.ObjectDetails, error) {
+	if err := policy.IsValid(); err != nil {
+		return nil, err
+	}
+	writeModel := NewInstanceBreakGlassPolicyWriteModel(ctx)
+	if err := c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	if !writeModel.hasChanged(policy) {
+		return writeModelToObjectDetails(&writeModel.WriteModel), nil
+	}
+	for _, userID := range policy.RecipientUserIDs {
+		if err := c.checkUserExists(ctx, userID, ""); err != nil {
+			return nil, err
+		}
+	}
+	if len(policy.TargetIDs) > 0 && !c.existsTargetsByIDs(ctx, policy.TargetIDs, writeModel.ResourceOwner) {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Bg1tn", "Errors.Target.NotFound")
+	}
+	instanceAgg := instance.NewAggregate(writeModel.AggregateID)
+	if err := c.pushAppendAndReduce(ctx, writeModel, instance.NewBreakGlassPolicySetEvent(ctx, &instanceAgg.Aggregate, policy)); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
@@ -0,0 +1,52 @@
+package command
+
+import (
+	"context"
+	"slices"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+)
+
+type InstanceBreakGlassPolicyWriteModel struct {
+	eventstore.WriteModel
+	Policy *domain.BreakGlassPolicy
+}
+
+func NewInstanceBreakGlassPolicyWriteModel(ctx context.Context) *InstanceBreakGlassPolicyWriteModel {
+	return &InstanceBreakGlassPolicyWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   authz.GetInstance(ctx).InstanceID(),
+			ResourceOwner: authz.GetInstance(ctx).InstanceID(),
+		},
+		Policy: domain.DefaultBreakGlassPolicy(),
+	}
+}
+
+func (wm *InstanceBreakGlassPolicyWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		if e, ok := event.(*instance.BreakGlassPolicySetEvent); ok {
+			wm.Policy = e.Policy()
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *InstanceBreakGlassPolicyWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(instance.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			instance.BreakGlassPolicySetEventType).
+		Builder()
+}
+
+func (wm *InstanceBreakGlassPolicyWriteModel) hasChanged(policy *domain.BreakGlassPolicy) bool {
+	return wm.Policy.SessionLifetime != policy.SessionLifetime ||
+		!slices.Equal(wm.Policy.RecipientUserIDs, policy.RecipientUserIDs) ||
+		!slices.Equal(wm.Policy.TargetIDs, policy.TargetIDs)
+}
@@ -236,6 +236,7 @@ func prepareChangeDefaultLoginPolicy(a *instance.Aggregate, policy *ChangeLoginP
 				policy.AllowDomainDiscovery,
 				policy.DisableLoginWithEmail,
 				policy.DisableLoginWithPhone,
+				policy.ForcePasskeyRegistration,
 				policy.PasswordlessType,
 				policy.DefaultRedirectURI,
 				policy.PasswordCheckLifetime,
@@ -263,6 +264,7 @@ func prepareAddDefaultLoginPolicy(
 	allowDomainDiscovery bool,
 	disableLoginWithEmail bool,
 	disableLoginWithPhone bool,
+	forcePasskeyRegistration bool,
 	passwordlessType domain.PasswordlessType,
 	defaultRedirectURI string,
 	passwordCheckLifetime time.Duration,
@@ -297,6 +299,7 @@ func prepareAddDefaultLoginPolicy(
 					allowDomainDiscovery,
 					disableLoginWithEmail,
 					disableLoginWithPhone,
+					forcePasskeyRegistration,
 					passwordlessType,
 					defaultRedirectURI,
 					passwordCheckLifetime,
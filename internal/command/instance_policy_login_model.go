@@ -69,7 +69,8 @@ func (wm *InstanceLoginPolicyWriteModel) NewChangedEvent(
 	ignoreUnknownUsernames,
 	allowDomainDiscovery,
 	disableLoginWithEmail,
-	disableLoginWithPhone bool,
+	disableLoginWithPhone,
+	forcePasskeyRegistration bool,
 	passwordlessType domain.PasswordlessType,
 	defaultRedirectURI string,
 	passwordCheckLifetime,
@@ -131,6 +132,9 @@ func (wm *InstanceLoginPolicyWriteModel) NewChangedEvent(
 	if wm.DisableLoginWithPhone != disableLoginWithPhone {
 		changes = append(changes, policy.ChangeDisableLoginWithPhone(disableLoginWithPhone))
 	}
+	if wm.ForcePasskeyRegistration != forcePasskeyRegistration {
+		changes = append(changes, policy.ChangeForcePasskeyRegistration(forcePasskeyRegistration))
+	}
 	if len(changes) == 0 {
 		return nil, false
 	}
@@ -21,6 +21,7 @@ func TestCommandSide_AddDefaultNotificationPolicy(t *testing.T) {
 		ctx            context.Context
 		resourceOwner  string
 		passwordChange bool
+		securityAlerts bool
 	}
 	type res struct {
 		want *domain.ObjectDetails
@@ -42,6 +43,7 @@ func TestCommandSide_AddDefaultNotificationPolicy(t *testing.T) {
 							instance.NewNotificationPolicyAddedEvent(context.Background(),
 								&instance.NewAggregate("INSTANCE").Aggregate,
 								true,
+								false,
 							),
 						),
 					),
@@ -66,6 +68,7 @@ func TestCommandSide_AddDefaultNotificationPolicy(t *testing.T) {
 						instance.NewNotificationPolicyAddedEvent(context.Background(),
 							&instance.NewAggregate("INSTANCE").Aggregate,
 							true,
+							false,
 						),
 					),
 				),
@@ -91,6 +94,7 @@ func TestCommandSide_AddDefaultNotificationPolicy(t *testing.T) {
 						instance.NewNotificationPolicyAddedEvent(context.Background(),
 							&instance.NewAggregate("INSTANCE").Aggregate,
 							true,
+							false,
 						),
 					),
 				),
@@ -112,7 +116,7 @@ func TestCommandSide_AddDefaultNotificationPolicy(t *testing.T) {
 			r := &Commands{
 				eventstore: tt.fields.eventstore,
 			}
-			got, err := r.AddDefaultNotificationPolicy(tt.args.ctx, tt.args.resourceOwner, tt.args.passwordChange)
+			got, err := r.AddDefaultNotificationPolicy(tt.args.ctx, tt.args.resourceOwner, tt.args.passwordChange, tt.args.securityAlerts)
 			if tt.res.err == nil {
 				assert.NoError(t, err)
 			}
@@ -134,6 +138,7 @@ func TestCommandSide_ChangeDefaultNotificationPolicy(t *testing.T) {
 		ctx            context.Context
 		resourceOwner  string
 		passwordChange bool
+		securityAlerts bool
 	}
 	type res struct {
 		want *domain.ObjectDetails
@@ -172,6 +177,7 @@ func TestCommandSide_ChangeDefaultNotificationPolicy(t *testing.T) {
 							instance.NewNotificationPolicyAddedEvent(context.Background(),
 								&instance.NewAggregate("INSTANCE").Aggregate,
 								true,
+								false,
 							),
 						),
 					),
@@ -196,6 +202,7 @@ func TestCommandSide_ChangeDefaultNotificationPolicy(t *testing.T) {
 							instance.NewNotificationPolicyAddedEvent(context.Background(),
 								&instance.NewAggregate("INSTANCE").Aggregate,
 								false,
+								false,
 							),
 						),
 					),
@@ -223,7 +230,7 @@ func TestCommandSide_ChangeDefaultNotificationPolicy(t *testing.T) {
 			r := &Commands{
 				eventstore: tt.fields.eventstore,
 			}
-			got, err := r.ChangeDefaultNotificationPolicy(tt.args.ctx, tt.args.resourceOwner, tt.args.passwordChange)
+			got, err := r.ChangeDefaultNotificationPolicy(tt.args.ctx, tt.args.resourceOwner, tt.args.passwordChange, tt.args.securityAlerts)
 			if tt.res.err == nil {
 				assert.NoError(t, err)
 			}
@@ -2,18 +2,23 @@ package command
 
 import (
 	"context"
+	"strings"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/command/preparation"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
 type SecurityPolicy struct {
 	EnableIframeEmbedding bool
 	AllowedOrigins        []string
 	EnableImpersonation   bool
+	// SMSAllowedCountries are the ISO 3166-1 alpha-2 codes of the countries one-time passwords can be sent to by SMS.
+	// If empty, all countries are allowed.
+	SMSAllowedCountries []string
 }
 
 func (c *Commands) SetSecurityPolicy(ctx context.Context, policy *SecurityPolicy) (*domain.ObjectDetails, error) {
@@ -36,6 +41,12 @@ func (c *Commands) SetSecurityPolicy(ctx context.Context, policy *SecurityPolicy
 
 func (c *Commands) prepareSetSecurityPolicy(a *instance.Aggregate, policy *SecurityPolicy) preparation.Validation {
 	return func() (preparation.CreateCommands, error) {
+		for i, country := range policy.SMSAllowedCountries {
+			policy.SMSAllowedCountries[i] = strings.ToUpper(country)
+			if !domain.IsValidPhoneRegion(policy.SMSAllowedCountries[i]) {
+				return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Sms9c", "Errors.Policy.Security.SMSCountryInvalid")
+			}
+		}
 		return func(ctx context.Context, filter preparation.FilterToQueryReducer) ([]eventstore.Command, error) {
 			writeModel, err := c.getSecurityPolicyWriteModel(ctx, filter)
 			if err != nil {
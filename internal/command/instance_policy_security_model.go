@@ -38,6 +38,9 @@ func (wm *InstanceSecurityPolicyWriteModel) Reduce() error {
 			if e.EnableImpersonation != nil {
 				wm.EnableImpersonation = *e.EnableImpersonation
 			}
+			if e.SMSAllowedCountries != nil {
+				wm.SMSAllowedCountries = *e.SMSAllowedCountries
+			}
 		}
 	}
 	return wm.WriteModel.Reduce()
@@ -71,6 +74,9 @@ func (wm *InstanceSecurityPolicyWriteModel) NewSetEvent(
 	if wm.EnableImpersonation != policy.EnableImpersonation {
 		changes = append(changes, instance.ChangeSecurityPolicyEnableImpersonation(policy.EnableImpersonation))
 	}
+	if !slices.Equal(wm.SMSAllowedCountries, policy.SMSAllowedCountries) {
+		changes = append(changes, instance.ChangeSecurityPolicySMSAllowedCountries(policy.SMSAllowedCountries))
+	}
 	changeEvent, err := instance.NewSecurityPolicySetEvent(ctx, aggregate, changes)
 	if err != nil {
 		return nil, err
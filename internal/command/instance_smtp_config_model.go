@@ -21,7 +21,12 @@ type IAMSMTPConfigWriteModel struct {
 	SenderAddress  string
 	SenderName     string
 	ReplyToAddress string
+	HTTPConfig     *HTTPConfig
 	State          domain.SMTPConfigState
+	DKIMDomain     string
+	DKIMSelector   string
+	DKIMPrivateKey *crypto.CryptoValue
+	Headers        map[string]string
 
 	domain                                 string
 	domainState                            domain.InstanceDomainState
@@ -78,6 +83,35 @@ func (wm *IAMSMTPConfigWriteModel) Reduce() error {
 				continue
 			}
 			wm.reduceSMTPConfigRemovedEvent(e)
+		case *instance.SMTPConfigHTTPAddedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			wm.reduceSMTPConfigHTTPAddedEvent(e)
+		case *instance.SMTPConfigHTTPChangedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			wm.reduceSMTPConfigHTTPChangedEvent(e)
+		case *instance.SMTPConfigDKIMSetEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			wm.DKIMDomain = e.Domain
+			wm.DKIMSelector = e.Selector
+			wm.DKIMPrivateKey = e.PrivateKey
+		case *instance.SMTPConfigDKIMRemovedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			wm.DKIMDomain = ""
+			wm.DKIMSelector = ""
+			wm.DKIMPrivateKey = nil
+		case *instance.SMTPConfigHeadersSetEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			wm.Headers = e.Headers
 		case *instance.SMTPConfigActivatedEvent:
 			if wm.ID != e.ID {
 				continue
@@ -120,6 +154,11 @@ func (wm *IAMSMTPConfigWriteModel) Query() *eventstore.SearchQueryBuilder {
 			instance.SMTPConfigRemovedEventType,
 			instance.SMTPConfigChangedEventType,
 			instance.SMTPConfigPasswordChangedEventType,
+			instance.SMTPConfigHTTPAddedEventType,
+			instance.SMTPConfigHTTPChangedEventType,
+			instance.SMTPConfigDKIMSetEventType,
+			instance.SMTPConfigDKIMRemovedEventType,
+			instance.SMTPConfigHeadersSetEventType,
 			instance.SMTPConfigActivatedEventType,
 			instance.SMTPConfigDeactivatedEventType,
 			instance.SMTPConfigRemovedEventType,
@@ -131,8 +170,19 @@ func (wm *IAMSMTPConfigWriteModel) Query() *eventstore.SearchQueryBuilder {
 }
 
 func (wm *IAMSMTPConfigWriteModel) NewChangedEvent(ctx context.Context, aggregate *eventstore.Aggregate, id, description string, tls bool, fromAddress, fromName, replyToAddress, smtpHost, smtpUser string, smtpPassword *crypto.CryptoValue) (*instance.SMTPConfigChangedEvent, bool, error) {
+	changes := wm.changes(id, description, tls, fromAddress, fromName, replyToAddress, smtpHost, smtpUser, smtpPassword)
+	if len(changes) == 0 {
+		return nil, false, nil
+	}
+	changeEvent, err := instance.NewSMTPConfigChangeEvent(ctx, aggregate, id, changes)
+	if err != nil {
+		return nil, false, err
+	}
+	return changeEvent, true, nil
+}
+
+func (wm *IAMSMTPConfigWriteModel) changes(id, description string, tls bool, fromAddress, fromName, replyToAddress, smtpHost, smtpUser string, smtpPassword *crypto.CryptoValue) []instance.SMTPConfigChanges {
 	changes := make([]instance.SMTPConfigChanges, 0)
-	var err error
 
 	if wm.ID != id {
 		changes = append(changes, instance.ChangeSMTPConfigID(id))
@@ -161,16 +211,39 @@ func (wm *IAMSMTPConfigWriteModel) NewChangedEvent(ctx context.Context, aggregat
 	if smtpPassword != nil {
 		changes = append(changes, instance.ChangeSMTPConfigSMTPPassword(smtpPassword))
 	}
+	return changes
+}
+
+func (wm *IAMSMTPConfigWriteModel) NewHTTPChangedEvent(ctx context.Context, aggregate *eventstore.Aggregate, id, description, endpoint string, headers, signingKey *crypto.CryptoValue) (*instance.SMTPConfigHTTPChangedEvent, bool, error) {
+	changes := wm.httpChanges(description, endpoint, headers, signingKey)
 	if len(changes) == 0 {
 		return nil, false, nil
 	}
-	changeEvent, err := instance.NewSMTPConfigChangeEvent(ctx, aggregate, id, changes)
+	changeEvent, err := instance.NewSMTPConfigHTTPChangedEvent(ctx, aggregate, id, changes)
 	if err != nil {
 		return nil, false, err
 	}
 	return changeEvent, true, nil
 }
 
+func (wm *IAMSMTPConfigWriteModel) httpChanges(description, endpoint string, headers, signingKey *crypto.CryptoValue) []instance.SMTPConfigHTTPChanges {
+	changes := make([]instance.SMTPConfigHTTPChanges, 0)
+
+	if wm.Description != description {
+		changes = append(changes, instance.ChangeSMTPConfigHTTPDescription(description))
+	}
+	if wm.HTTPConfig.Endpoint != endpoint {
+		changes = append(changes, instance.ChangeSMTPConfigHTTPEndpoint(endpoint))
+	}
+	if headers != nil {
+		changes = append(changes, instance.ChangeSMTPConfigHTTPHeaders(headers))
+	}
+	if signingKey != nil {
+		changes = append(changes, instance.ChangeSMTPConfigHTTPSigningKey(signingKey))
+	}
+	return changes
+}
+
 func (wm *IAMSMTPConfigWriteModel) reduceSMTPConfigAddedEvent(e *instance.SMTPConfigAddedEvent) {
 	wm.Description = e.Description
 	wm.TLS = e.TLS
@@ -225,6 +298,31 @@ func (wm *IAMSMTPConfigWriteModel) reduceSMTPConfigChangedEvent(e *instance.SMTP
 	}
 }
 
+func (wm *IAMSMTPConfigWriteModel) reduceSMTPConfigHTTPAddedEvent(e *instance.SMTPConfigHTTPAddedEvent) {
+	wm.Description = e.Description
+	wm.HTTPConfig = &HTTPConfig{
+		Endpoint:   e.Endpoint,
+		Headers:    e.Headers,
+		SigningKey: e.SigningKey,
+	}
+	wm.State = domain.SMTPConfigStateInactive
+}
+
+func (wm *IAMSMTPConfigWriteModel) reduceSMTPConfigHTTPChangedEvent(e *instance.SMTPConfigHTTPChangedEvent) {
+	if e.Description != nil {
+		wm.Description = *e.Description
+	}
+	if e.Endpoint != nil {
+		wm.HTTPConfig.Endpoint = *e.Endpoint
+	}
+	if e.Headers != nil {
+		wm.HTTPConfig.Headers = e.Headers
+	}
+	if e.SigningKey != nil {
+		wm.HTTPConfig.SigningKey = e.SigningKey
+	}
+}
+
 func (wm *IAMSMTPConfigWriteModel) reduceSMTPConfigRemovedEvent(e *instance.SMTPConfigRemovedEvent) {
 	wm.Description = ""
 	wm.TLS = false
@@ -234,6 +332,7 @@ func (wm *IAMSMTPConfigWriteModel) reduceSMTPConfigRemovedEvent(e *instance.SMTP
 	wm.Host = ""
 	wm.User = ""
 	wm.Password = nil
+	wm.HTTPConfig = nil
 	wm.State = domain.SMTPConfigStateRemoved
 
 	// If ID has empty value we're dealing with the old and unique smtp settings
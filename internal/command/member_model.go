@@ -1,6 +1,8 @@
 package command
 
 import (
+	"time"
+
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/repository/member"
@@ -9,8 +11,10 @@ import (
 type MemberWriteModel struct {
 	eventstore.WriteModel
 
-	UserID string
-	Roles  []string
+	UserID     string
+	Roles      []string
+	ValidFrom  *time.Time
+	ValidUntil *time.Time
 
 	State domain.MemberState
 }
@@ -27,12 +31,20 @@ func (wm *MemberWriteModel) Reduce() error {
 		case *member.MemberAddedEvent:
 			wm.UserID = e.UserID
 			wm.Roles = e.Roles
+			wm.ValidFrom = nil
+			wm.ValidUntil = nil
 			wm.State = domain.MemberStateActive
 		case *member.MemberChangedEvent:
 			wm.Roles = e.Roles
 		case *member.MemberRemovedEvent:
 			wm.Roles = nil
 			wm.State = domain.MemberStateRemoved
+		case *member.MemberValiditySetEvent:
+			wm.ValidFrom = e.ValidFrom
+			wm.ValidUntil = e.ValidUntil
+		case *member.MemberExpiredEvent:
+			wm.Roles = nil
+			wm.State = domain.MemberStateRemoved
 		}
 	}
 	return wm.WriteModel.Reduce()
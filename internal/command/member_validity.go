@@ -0,0 +1,191 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// SetInstanceMemberValidity restricts the membership to the time window of the validity.
+// An empty validity removes the restriction.
+func (c *Commands) SetInstanceMemberValidity(ctx context.Context, userID string, validity domain.Validity) (*domain.ObjectDetails, error) {
+	if err := checkMemberValidity(userID, validity); err != nil {
+		return nil, err
+	}
+	existingMember, err := c.instanceMemberWriteModelByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if validity.Equal(memberValidity(&existingMember.MemberWriteModel)) {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "INSTANCE-Mv4nc", "Errors.IAM.Member.ValidityNotChanged")
+	}
+	if err = c.pushAppendAndReduce(ctx, existingMember,
+		instance.NewMemberValiditySetEvent(ctx, InstanceAggregateFromWriteModel(&existingMember.MemberWriteModel.WriteModel), userID, validity.From, validity.Until),
+	); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingMember.MemberWriteModel.WriteModel), nil
+}
+
+// ExpireInstanceMember removes the membership after the end of its validity.
+func (c *Commands) ExpireInstanceMember(ctx context.Context, userID string) (*domain.ObjectDetails, error) {
+	if userID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "INSTANCE-Me1vs", "Errors.IDMissing")
+	}
+	existingMember, err := c.instanceMemberWriteModelByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !memberValidity(&existingMember.MemberWriteModel).ExpiredAt(time.Now()) {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "INSTANCE-Me2ne", "Errors.Member.NotExpired")
+	}
+	if err = c.pushAppendAndReduce(ctx, existingMember,
+		instance.NewMemberExpiredEvent(ctx, InstanceAggregateFromWriteModel(&existingMember.MemberWriteModel.WriteModel), userID, *existingMember.ValidUntil),
+	); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingMember.MemberWriteModel.WriteModel), nil
+}
+
+// SetOrgMemberValidity restricts the membership to the time window of the validity.
+// An empty validity removes the restriction.
+func (c *Commands) SetOrgMemberValidity(ctx context.Context, orgID, userID string, validity domain.Validity) (*domain.ObjectDetails, error) {
+	if err := checkMemberValidity(userID, validity); err != nil {
+		return nil, err
+	}
+	existingMember, err := c.orgMemberWriteModelByID(ctx, orgID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if validity.Equal(memberValidity(&existingMember.MemberWriteModel)) {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "ORG-Mv4nc", "Errors.Org.Member.ValidityNotChanged")
+	}
+	if err = c.pushAppendAndReduce(ctx, existingMember,
+		org.NewMemberValiditySetEvent(ctx, OrgAggregateFromWriteModel(&existingMember.MemberWriteModel.WriteModel), userID, validity.From, validity.Until),
+	); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingMember.MemberWriteModel.WriteModel), nil
+}
+
+// ExpireOrgMember removes the membership after the end of its validity.
+func (c *Commands) ExpireOrgMember(ctx context.Context, orgID, userID string) (*domain.ObjectDetails, error) {
+	if orgID == "" || userID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "ORG-Me1vs", "Errors.IDMissing")
+	}
+	existingMember, err := c.orgMemberWriteModelByID(ctx, orgID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !memberValidity(&existingMember.MemberWriteModel).ExpiredAt(time.Now()) {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "ORG-Me2ne", "Errors.Member.NotExpired")
+	}
+	if err = c.pushAppendAndReduce(ctx, existingMember,
+		org.NewMemberExpiredEvent(ctx, OrgAggregateFromWriteModel(&existingMember.MemberWriteModel.WriteModel), userID, *existingMember.ValidUntil),
+	); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingMember.MemberWriteModel.WriteModel), nil
+}
+
+// SetProjectMemberValidity restricts the membership to the time window of the validity.
+// An empty validity removes the restriction.
+func (c *Commands) SetProjectMemberValidity(ctx context.Context, projectID, userID, resourceOwner string, validity domain.Validity) (*domain.ObjectDetails, error) {
+	if err := checkMemberValidity(userID, validity); err != nil {
+		return nil, err
+	}
+	existingMember, err := c.projectMemberWriteModelByID(ctx, projectID, userID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if validity.Equal(memberValidity(&existingMember.MemberWriteModel)) {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "PROJECT-Mv4nc", "Errors.Project.Member.ValidityNotChanged")
+	}
+	if err = c.pushAppendAndReduce(ctx, existingMember,
+		project.NewMemberValiditySetEvent(ctx, ProjectAggregateFromWriteModel(&existingMember.MemberWriteModel.WriteModel), userID, validity.From, validity.Until),
+	); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingMember.MemberWriteModel.WriteModel), nil
+}
+
+// ExpireProjectMember removes the membership after the end of its validity.
+func (c *Commands) ExpireProjectMember(ctx context.Context, projectID, userID, resourceOwner string) (*domain.ObjectDetails, error) {
+	if projectID == "" || userID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "PROJECT-Me1vs", "Errors.IDMissing")
+	}
+	existingMember, err := c.projectMemberWriteModelByID(ctx, projectID, userID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !memberValidity(&existingMember.MemberWriteModel).ExpiredAt(time.Now()) {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "PROJECT-Me2ne", "Errors.Member.NotExpired")
+	}
+	if err = c.pushAppendAndReduce(ctx, existingMember,
+		project.NewMemberExpiredEvent(ctx, ProjectAggregateFromWriteModel(&existingMember.MemberWriteModel.WriteModel), userID, *existingMember.ValidUntil),
+	); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingMember.MemberWriteModel.WriteModel), nil
+}
+
+// SetProjectGrantMemberValidity restricts the membership to the time window of the validity.
+// An empty validity removes the restriction.
+func (c *Commands) SetProjectGrantMemberValidity(ctx context.Context, projectID, grantID, userID string, validity domain.Validity) (*domain.ObjectDetails, error) {
+	if err := checkMemberValidity(userID, validity); err != nil {
+		return nil, err
+	}
+	existingMember, err := c.projectGrantMemberWriteModelByID(ctx, projectID, userID, grantID)
+	if err != nil {
+		return nil, err
+	}
+	if validity.Equal(domain.Validity{From: existingMember.ValidFrom, Until: existingMember.ValidUntil}) {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "PROJECT-Gv4nc", "Errors.Project.Member.ValidityNotChanged")
+	}
+	if err = c.pushAppendAndReduce(ctx, existingMember,
+		project.NewProjectGrantMemberValiditySetEvent(ctx, ProjectAggregateFromWriteModel(&existingMember.WriteModel), userID, grantID, validity.From, validity.Until),
+	); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingMember.WriteModel), nil
+}
+
+// ExpireProjectGrantMember removes the membership after the end of its validity.
+func (c *Commands) ExpireProjectGrantMember(ctx context.Context, projectID, grantID, userID string) (*domain.ObjectDetails, error) {
+	if projectID == "" || grantID == "" || userID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "PROJECT-Ge1vs", "Errors.IDMissing")
+	}
+	existingMember, err := c.projectGrantMemberWriteModelByID(ctx, projectID, userID, grantID)
+	if err != nil {
+		return nil, err
+	}
+	validity := domain.Validity{From: existingMember.ValidFrom, Until: existingMember.ValidUntil}
+	if !validity.ExpiredAt(time.Now()) {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "PROJECT-Ge2ne", "Errors.Member.NotExpired")
+	}
+	if err = c.pushAppendAndReduce(ctx, existingMember,
+		project.NewProjectGrantMemberExpiredEvent(ctx, ProjectAggregateFromWriteModel(&existingMember.WriteModel), userID, grantID, *existingMember.ValidUntil),
+	); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingMember.WriteModel), nil
+}
+
+func checkMemberValidity(userID string, validity domain.Validity) error {
+	if userID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Mv1vs", "Errors.IDMissing")
+	}
+	if !validity.IsValid() {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Mv2vi", "Errors.Validity.Invalid")
+	}
+	return nil
+}
+
+func memberValidity(wm *MemberWriteModel) domain.Validity {
+	return domain.Validity{From: wm.ValidFrom, Until: wm.ValidUntil}
+}
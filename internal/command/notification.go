@@ -0,0 +1,78 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/notification"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// RequestNotification enqueues a new notification, which will be rendered from the triggering event
+// and delivered asynchronously.
+// A triggering event enqueues only one notification per type, so requesting it again
+// (e.g. when the event is reduced again) does not return an error.
+func (c *Commands) RequestNotification(ctx context.Context, request *notification.Request) error {
+	if request == nil || request.TriggeringAggregateID == "" || request.TriggeringEventType == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Rq8sd", "Errors.Notification.Invalid")
+	}
+	id, err := c.idGenerator.Next()
+	if err != nil {
+		return err
+	}
+	_, err = c.eventstore.Push(ctx, notification.NewRequestedEvent(ctx, &notification.NewAggregate(ctx, id).Aggregate, *request))
+	if zerrors.IsErrorAlreadyExists(err) {
+		return nil
+	}
+	return err
+}
+
+// NotificationSent marks the notification as delivered.
+func (c *Commands) NotificationSent(ctx context.Context, id string, attempt uint16) error {
+	return c.pushNotificationResult(ctx, id, func(aggregate *eventstore.Aggregate) eventstore.Command {
+		return notification.NewSentEvent(ctx, aggregate, attempt)
+	})
+}
+
+// NotificationRetryRequested reschedules the notification after a failed attempt.
+func (c *Commands) NotificationRetryRequested(ctx context.Context, id string, attempt uint16, nextAttempt time.Time, sendErr error) error {
+	return c.pushNotificationResult(ctx, id, func(aggregate *eventstore.Aggregate) eventstore.Command {
+		return notification.NewRetryRequestedEvent(ctx, aggregate, attempt, nextAttempt, sendErr)
+	})
+}
+
+// NotificationCanceled stops the delivery of the notification,
+// e.g. because the code was already used or is expired.
+func (c *Commands) NotificationCanceled(ctx context.Context, id, reason string) error {
+	return c.pushNotificationResult(ctx, id, func(aggregate *eventstore.Aggregate) eventstore.Command {
+		return notification.NewCanceledEvent(ctx, aggregate, reason)
+	})
+}
+
+// NotificationFailed stops the delivery of the notification after the last failed attempt.
+func (c *Commands) NotificationFailed(ctx context.Context, id string, attempt uint16, sendErr error) error {
+	return c.pushNotificationResult(ctx, id, func(aggregate *eventstore.Aggregate) eventstore.Command {
+		return notification.NewFailedEvent(ctx, aggregate, attempt, sendErr)
+	})
+}
+
+func (c *Commands) pushNotificationResult(ctx context.Context, id string, event func(aggregate *eventstore.Aggregate) eventstore.Command) error {
+	if id == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Nf0ds", "Errors.IDMissing")
+	}
+	wm := newNotificationWriteModel(id, authz.GetInstance(ctx).InstanceID())
+	if err := c.eventstore.FilterToQueryReducer(ctx, wm); err != nil {
+		return err
+	}
+	if wm.State == domain.NotificationStateUnspecified {
+		return zerrors.ThrowNotFound(nil, "COMMAND-Gm2kd", "Errors.Notification.NotFound")
+	}
+	if !wm.State.Pending() {
+		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ps9wl", "Errors.Notification.AlreadyFinished")
+	}
+	_, err := c.eventstore.Push(ctx, event(&notification.NewAggregate(ctx, id).Aggregate))
+	return err
+}
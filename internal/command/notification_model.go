@@ -0,0 +1,66 @@
+package command
+
+import (
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/notification"
+)
+
+type notificationWriteModel struct {
+	eventstore.WriteModel
+
+	State       domain.NotificationState
+	Attempts    uint16
+	NextAttempt time.Time
+}
+
+func newNotificationWriteModel(id, instanceID string) *notificationWriteModel {
+	return &notificationWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   id,
+			InstanceID:    instanceID,
+			ResourceOwner: instanceID,
+		},
+	}
+}
+
+func (wm *notificationWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		InstanceID(wm.InstanceID).
+		AddQuery().
+		AggregateTypes(notification.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			notification.RequestedType,
+			notification.RetryRequestedType,
+			notification.SentType,
+			notification.CanceledType,
+			notification.FailedType,
+		).
+		Builder()
+}
+
+func (wm *notificationWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *notification.RequestedEvent:
+			wm.State = domain.NotificationStateRequested
+			wm.NextAttempt = e.CreatedAt()
+		case *notification.RetryRequestedEvent:
+			wm.State = domain.NotificationStateRetrying
+			wm.Attempts = e.Attempt
+			wm.NextAttempt = e.NextAttempt
+		case *notification.SentEvent:
+			wm.State = domain.NotificationStateSent
+			wm.Attempts = e.Attempt
+		case *notification.CanceledEvent:
+			wm.State = domain.NotificationStateCanceled
+		case *notification.FailedEvent:
+			wm.State = domain.NotificationStateFailed
+			wm.Attempts = e.Attempt
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
@@ -0,0 +1,270 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/id"
+	id_mock "github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/repository/notification"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommandSide_RequestNotification(t *testing.T) {
+	ctx := authz.WithInstanceID(context.Background(), "instance1")
+	request := &notification.Request{
+		TriggeringAggregateType: user.AggregateType,
+		TriggeringAggregateID:   "user1",
+		TriggeringEventType:     user.HumanEmailCodeAddedType,
+		TriggeringSequence:      5,
+		TriggeringResourceOwner: "org1",
+		NotificationType:        domain.NotificationTypeEmail,
+	}
+	type fields struct {
+		eventstore  *eventstore.Eventstore
+		idGenerator id.Generator
+	}
+	type args struct {
+		request *notification.Request
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		err    func(error) bool
+	}{
+		{
+			name: "missing request, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args: args{},
+			err:  zerrors.IsErrorInvalidArgument,
+		},
+		{
+			name: "missing triggering event, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args: args{
+				request: &notification.Request{
+					TriggeringAggregateType: user.AggregateType,
+					TriggeringAggregateID:   "user1",
+				},
+			},
+			err: zerrors.IsErrorInvalidArgument,
+		},
+		{
+			name: "request, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectPush(
+						notification.NewRequestedEvent(ctx,
+							&notification.NewAggregate(ctx, "notification1").Aggregate,
+							*request,
+						),
+					),
+				),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "notification1"),
+			},
+			args: args{
+				request: request,
+			},
+		},
+		{
+			name: "already requested, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectPushFailed(
+						zerrors.ThrowAlreadyExists(nil, "id", "Errors.Notification.AlreadyRequested"),
+						notification.NewRequestedEvent(ctx,
+							&notification.NewAggregate(ctx, "notification2").Aggregate,
+							*request,
+						),
+					),
+				),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "notification2"),
+			},
+			args: args{
+				request: request,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:  tt.fields.eventstore,
+				idGenerator: tt.fields.idGenerator,
+			}
+			err := c.RequestNotification(ctx, tt.args.request)
+			if tt.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.err != nil && !tt.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+		})
+	}
+}
+
+func TestCommandSide_NotificationRetryRequested(t *testing.T) {
+	ctx := authz.WithInstanceID(context.Background(), "instance1")
+	nextAttempt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	sendErr := errors.New("smtp unavailable")
+	aggregate := &notification.NewAggregate(ctx, "notification1").Aggregate
+	requested := notification.NewRequestedEvent(ctx, aggregate, notification.Request{
+		TriggeringAggregateType: user.AggregateType,
+		TriggeringAggregateID:   "user1",
+		TriggeringEventType:     user.HumanEmailCodeAddedType,
+		TriggeringSequence:      5,
+		TriggeringResourceOwner: "org1",
+		NotificationType:        domain.NotificationTypeEmail,
+	})
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		id string
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		err    func(error) bool
+	}{
+		{
+			name: "missing id, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args: args{},
+			err:  zerrors.IsErrorInvalidArgument,
+		},
+		{
+			name: "not existing, not found error",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(),
+				),
+			},
+			args: args{
+				id: "notification1",
+			},
+			err: zerrors.IsNotFound,
+		},
+		{
+			name: "already sent, precondition error",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(requested),
+						eventFromEventPusher(
+							notification.NewSentEvent(ctx, aggregate, 1),
+						),
+					),
+				),
+			},
+			args: args{
+				id: "notification1",
+			},
+			err: zerrors.IsPreconditionFailed,
+		},
+		{
+			name: "retry, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(requested),
+					),
+					expectPush(
+						notification.NewRetryRequestedEvent(ctx, aggregate, 1, nextAttempt, sendErr),
+					),
+				),
+			},
+			args: args{
+				id: "notification1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			err := c.NotificationRetryRequested(ctx, tt.args.id, 1, nextAttempt, sendErr)
+			if tt.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.err != nil && !tt.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+		})
+	}
+}
+
+func TestCommandSide_NotificationCanceled(t *testing.T) {
+	ctx := authz.WithInstanceID(context.Background(), "instance1")
+	aggregate := &notification.NewAggregate(ctx, "notification1").Aggregate
+	requested := notification.NewRequestedEvent(ctx, aggregate, notification.Request{
+		TriggeringAggregateType: user.AggregateType,
+		TriggeringAggregateID:   "user1",
+		TriggeringEventType:     user.HumanPhoneCodeAddedType,
+		TriggeringSequence:      5,
+		TriggeringResourceOwner: "org1",
+		NotificationType:        domain.NotificationTypeSms,
+	})
+	tests := []struct {
+		name       string
+		eventstore *eventstore.Eventstore
+		err        func(error) bool
+	}{
+		{
+			name: "already failed, precondition error",
+			eventstore: eventstoreExpect(t,
+				expectFilter(
+					eventFromEventPusher(requested),
+					eventFromEventPusher(
+						notification.NewFailedEvent(ctx, aggregate, 3, errors.New("failed")),
+					),
+				),
+			),
+			err: zerrors.IsPreconditionFailed,
+		},
+		{
+			name: "cancel retrying notification, ok",
+			eventstore: eventstoreExpect(t,
+				expectFilter(
+					eventFromEventPusher(requested),
+					eventFromEventPusher(
+						notification.NewRetryRequestedEvent(ctx, aggregate, 1, time.Now(), errors.New("failed")),
+					),
+				),
+				expectPush(
+					notification.NewCanceledEvent(ctx, aggregate, "code expired"),
+				),
+			),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.eventstore,
+			}
+			err := c.NotificationCanceled(ctx, "notification1", "code expired")
+			if tt.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.err != nil && !tt.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+		})
+	}
+}
@@ -135,6 +135,7 @@ func TestCommands_CreateOIDCSessionFromAuthRequest(t *testing.T) {
 								gu.Ptr("loginHint"),
 								gu.Ptr("hintUserID"),
 								true,
+								domain.LevelOfAssuranceNone,
 							),
 						),
 						eventFromEventPusher(
@@ -179,6 +180,7 @@ func TestCommands_CreateOIDCSessionFromAuthRequest(t *testing.T) {
 								gu.Ptr("loginHint"),
 								gu.Ptr("hintUserID"),
 								true,
+								domain.LevelOfAssuranceNone,
 							),
 						),
 						eventFromEventPusher(
@@ -231,6 +233,7 @@ func TestCommands_CreateOIDCSessionFromAuthRequest(t *testing.T) {
 								gu.Ptr("loginHint"),
 								gu.Ptr("hintUserID"),
 								true,
+								domain.LevelOfAssuranceNone,
 							),
 						),
 						eventFromEventPusher(
@@ -350,6 +353,7 @@ func TestCommands_CreateOIDCSessionFromAuthRequest(t *testing.T) {
 								gu.Ptr("loginHint"),
 								gu.Ptr("hintUserID"),
 								false,
+								domain.LevelOfAssuranceNone,
 							),
 						),
 						eventFromEventPusher(
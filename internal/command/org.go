@@ -460,6 +460,13 @@ func (c *Commands) prepareRemoveOrg(a *org.Aggregate) preparation.Validation {
 			if !isOrgStateExists(writeModel.State) {
 				return nil, zerrors.ThrowNotFound(nil, "COMMA-aps2n", "Errors.Org.NotFound")
 			}
+			hierarchy := NewOrgHierarchyWriteModel()
+			if err = queryAndReduce(ctx, filter, hierarchy); err != nil {
+				return nil, err
+			}
+			if hierarchy.HasChildren(a.ID) {
+				return nil, zerrors.ThrowPreconditionFailed(nil, "COMMA-Hc2sb", "Errors.Org.HasChildren")
+			}
 
 			domainPolicy, err := c.domainPolicyWriteModel(ctx, a.ID)
 			if err != nil {
@@ -287,6 +287,72 @@ func (c *Commands) RemoveOrgDomain(ctx context.Context, orgDomain *domain.OrgDom
 	return writeModelToObjectDetails(&domainWriteModel.WriteModel), nil
 }
 
+// SetOrgDomainIDP routes users entering a login name of the verified domain directly to the identity provider.
+// The identity provider can be defined on the organization or the instance. An empty idpID removes the routing.
+func (c *Commands) SetOrgDomainIDP(ctx context.Context, orgDomain *domain.OrgDomain, idpID string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if orgDomain == nil || !orgDomain.IsValid() || orgDomain.AggregateID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "ORG-Fq2nd", "Errors.Org.InvalidDomain")
+	}
+	domainWriteModel, err := c.getOrgDomainWriteModel(ctx, orgDomain.AggregateID, orgDomain.Domain)
+	if err != nil {
+		return nil, err
+	}
+	if domainWriteModel.State != domain.OrgDomainStateActive {
+		return nil, zerrors.ThrowNotFound(nil, "ORG-Fq2ne", "Errors.Org.DomainNotOnOrg")
+	}
+	if !domainWriteModel.Verified {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "ORG-Fq2nf", "Errors.Org.DomainNotVerified")
+	}
+	if domainWriteModel.IDPID == idpID {
+		return writeModelToObjectDetails(&domainWriteModel.WriteModel), nil
+	}
+	if idpID != "" {
+		exists, err := ExistsIDPOnOrgOrInstance(ctx, c.eventstore.Filter, authz.GetInstance(ctx).InstanceID(), orgDomain.AggregateID, idpID)
+		if !exists || err != nil {
+			return nil, zerrors.ThrowPreconditionFailed(err, "ORG-Fq2ng", "Errors.IDPConfig.NotExisting")
+		}
+		allowed, err := c.idpAllowedInLoginPolicy(ctx, orgDomain.AggregateID, idpID)
+		if err != nil {
+			return nil, err
+		}
+		if !allowed {
+			return nil, zerrors.ThrowPreconditionFailed(nil, "ORG-Fq2nh", "Errors.Org.LoginPolicy.IdpProviderNotExisting")
+		}
+	}
+	orgAgg := OrgAggregateFromWriteModel(&domainWriteModel.WriteModel)
+	if err = c.pushAppendAndReduce(ctx, domainWriteModel, org.NewDomainIDPSetEvent(ctx, orgAgg, orgDomain.Domain, idpID)); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&domainWriteModel.WriteModel), nil
+}
+
+// idpAllowedInLoginPolicy checks if the identity provider is allowed in the active login policy of the organization,
+// which is either its own, the one of its nearest ancestor or the default policy of the instance.
+func (c *Commands) idpAllowedInLoginPolicy(ctx context.Context, orgID, idpID string) (bool, error) {
+	policy, err := c.getOrgLoginPolicy(ctx, orgID)
+	if err != nil {
+		return false, err
+	}
+	if !policy.AllowExternalIDP {
+		return false, nil
+	}
+	if policy.Default {
+		provider := NewInstanceIdentityProviderWriteModel(ctx, idpID)
+		if err = c.eventstore.FilterToQueryReducer(ctx, provider); err != nil {
+			return false, err
+		}
+		return provider.State == domain.IdentityProviderStateActive, nil
+	}
+	provider := NewOrgIdentityProviderWriteModel(policy.AggregateID, idpID)
+	if err = c.eventstore.FilterToQueryReducer(ctx, provider); err != nil {
+		return false, err
+	}
+	return provider.State == domain.IdentityProviderStateActive, nil
+}
+
 func (c *Commands) addOrgDomain(ctx context.Context, orgAgg *eventstore.Aggregate, addedDomain *OrgDomainWriteModel, orgDomain *domain.OrgDomain, claimedUserIDs []string) ([]eventstore.Command, error) {
 	err := c.eventstore.FilterToQueryReducer(ctx, addedDomain)
 	if err != nil {
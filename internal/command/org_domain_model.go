@@ -15,6 +15,7 @@ type OrgDomainWriteModel struct {
 	ValidationCode *crypto.CryptoValue
 	Primary        bool
 	Verified       bool
+	IDPID          string
 
 	State domain.OrgDomainState
 }
@@ -59,6 +60,11 @@ func (wm *OrgDomainWriteModel) AppendEvents(events ...eventstore.Event) {
 				continue
 			}
 			wm.WriteModel.AppendEvents(e)
+		case *org.DomainIDPSetEvent:
+			if e.Domain != wm.Domain {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
 		}
 	}
 }
@@ -76,10 +82,13 @@ func (wm *OrgDomainWriteModel) Reduce() error {
 			wm.Verified = true
 		case *org.DomainPrimarySetEvent:
 			wm.Primary = e.Domain == wm.Domain
+		case *org.DomainIDPSetEvent:
+			wm.IDPID = e.IDPID
 		case *org.DomainRemovedEvent:
 			wm.State = domain.OrgDomainStateRemoved
 			wm.Verified = false
 			wm.Primary = false
+			wm.IDPID = ""
 			wm.ValidationType = domain.OrgDomainValidationTypeUnspecified
 			wm.ValidationCode = nil
 		}
@@ -99,7 +108,8 @@ func (wm *OrgDomainWriteModel) Query() *eventstore.SearchQueryBuilder {
 			org.OrgDomainVerificationAddedEventType,
 			org.OrgDomainVerifiedEventType,
 			org.OrgDomainPrimarySetEventType,
-			org.OrgDomainRemovedEventType).
+			org.OrgDomainRemovedEventType,
+			org.OrgDomainIDPSetEventType).
 		Builder()
 }
 
@@ -0,0 +1,104 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/command/preparation"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// SetOrgParent places the organization below the parent organization.
+// The organization inherits the policies of the parent and the managers of the parent can administrate it.
+func (c *Commands) SetOrgParent(ctx context.Context, orgID, parentID string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if orgID == "" || parentID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Op1id", "Errors.IDMissing")
+	}
+	if orgID == parentID {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Op2sf", "Errors.Org.Parent.Cycle")
+	}
+	orgWriteModel, err := c.getOrgWriteModelByID(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if !isOrgStateExists(orgWriteModel.State) {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Op3nf", "Errors.Org.NotFound")
+	}
+	if orgWriteModel.ParentID == parentID {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Op4nc", "Errors.Org.Parent.NotChanged")
+	}
+	parentWriteModel, err := c.getOrgWriteModelByID(ctx, parentID)
+	if err != nil {
+		return nil, err
+	}
+	if !isOrgStateExists(parentWriteModel.State) {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Op5pn", "Errors.Org.Parent.NotFound")
+	}
+	// the managers of the parent gain rights on the organization, so they have to agree
+	if err = c.checkPermission(ctx, domain.PermissionOrgWrite, parentID, parentID); err != nil {
+		return nil, err
+	}
+	hierarchy := NewOrgHierarchyWriteModel()
+	if err = c.eventstore.FilterToQueryReducer(ctx, hierarchy); err != nil {
+		return nil, err
+	}
+	if hierarchy.IsAncestor(orgID, parentID) {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Op6cy", "Errors.Org.Parent.Cycle")
+	}
+	if err = c.pushAppendAndReduce(ctx, orgWriteModel,
+		org.NewOrgParentSetEvent(ctx, OrgAggregateFromWriteModel(&orgWriteModel.WriteModel), parentID),
+	); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&orgWriteModel.WriteModel), nil
+}
+
+// RemoveOrgParent makes the organization a top level organization of the instance.
+func (c *Commands) RemoveOrgParent(ctx context.Context, orgID string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if orgID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Or1id", "Errors.IDMissing")
+	}
+	orgWriteModel, err := c.getOrgWriteModelByID(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if !isOrgStateExists(orgWriteModel.State) {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Or2nf", "Errors.Org.NotFound")
+	}
+	if orgWriteModel.ParentID == "" {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Or3ns", "Errors.Org.Parent.NotSet")
+	}
+	// the managers of the parent lose their rights on the organization, so they have to agree
+	if err = c.checkPermission(ctx, domain.PermissionOrgWrite, orgWriteModel.ParentID, orgWriteModel.ParentID); err != nil {
+		return nil, err
+	}
+	if err = c.pushAppendAndReduce(ctx, orgWriteModel,
+		org.NewOrgParentRemovedEvent(ctx, OrgAggregateFromWriteModel(&orgWriteModel.WriteModel)),
+	); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&orgWriteModel.WriteModel), nil
+}
+
+// orgPolicyAncestorIDs returns the ancestors of the organization, whose policies apply to the organization
+// if it has none itself, ordered from the nearest to the farthest the same way the queries resolve the policies.
+func orgPolicyAncestorIDs(ctx context.Context, filter preparation.FilterToQueryReducer, orgID string) ([]string, error) {
+	hierarchy := NewOrgHierarchyWriteModel()
+	events, err := filter(ctx, hierarchy.Query())
+	if err != nil {
+		return nil, err
+	}
+	hierarchy.AppendEvents(events...)
+	if err = hierarchy.Reduce(); err != nil {
+		return nil, err
+	}
+	return hierarchy.Ancestors(orgID), nil
+}
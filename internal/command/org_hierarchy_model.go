@@ -0,0 +1,87 @@
+package command
+
+import (
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
+)
+
+// OrgHierarchyWriteModel contains the parents of all organizations of the instance
+type OrgHierarchyWriteModel struct {
+	eventstore.WriteModel
+
+	// Parents maps the id of an organization to the id of its parent
+	Parents map[string]string
+}
+
+func NewOrgHierarchyWriteModel() *OrgHierarchyWriteModel {
+	return &OrgHierarchyWriteModel{
+		Parents: make(map[string]string),
+	}
+}
+
+func (wm *OrgHierarchyWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *org.OrgParentSetEvent:
+			wm.Parents[e.Aggregate().ID] = e.ParentID
+		case *org.OrgParentRemovedEvent:
+			delete(wm.Parents, e.Aggregate().ID)
+		case *org.OrgRemovedEvent:
+			delete(wm.Parents, e.Aggregate().ID)
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *OrgHierarchyWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(org.AggregateType).
+		EventTypes(
+			org.OrgParentSetEventType,
+			org.OrgParentRemovedEventType,
+			org.OrgRemovedEventType).
+		Builder()
+}
+
+// IsAncestor returns true if ancestorID is a direct or indirect parent of orgID
+func (wm *OrgHierarchyWriteModel) IsAncestor(ancestorID, orgID string) bool {
+	// the length of the hierarchy limits the iterations in case of inconsistent data
+	for i := 0; i <= len(wm.Parents); i++ {
+		parentID, ok := wm.Parents[orgID]
+		if !ok {
+			return false
+		}
+		if parentID == ancestorID {
+			return true
+		}
+		orgID = parentID
+	}
+	return false
+}
+
+// Ancestors returns the parents of the organization,
+// ordered from the direct parent up to the top level organization.
+func (wm *OrgHierarchyWriteModel) Ancestors(orgID string) []string {
+	ancestors := make([]string, 0)
+	// the length of the hierarchy limits the iterations in case of inconsistent data
+	for i := 0; i < len(wm.Parents); i++ {
+		parentID, ok := wm.Parents[orgID]
+		if !ok {
+			break
+		}
+		ancestors = append(ancestors, parentID)
+		orgID = parentID
+	}
+	return ancestors
+}
+
+// HasChildren returns true if any organization is placed below orgID
+func (wm *OrgHierarchyWriteModel) HasChildren(orgID string) bool {
+	for _, parentID := range wm.Parents {
+		if parentID == orgID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,403 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func orgAddedEvent(orgID string) eventstore.Event {
+	return eventFromEventPusher(
+		org.NewOrgAddedEvent(context.Background(),
+			&org.NewAggregate(orgID).Aggregate,
+			orgID,
+		),
+	)
+}
+
+func TestCommands_SetOrgParent(t *testing.T) {
+	type fields struct {
+		eventstore      func(*testing.T) *eventstore.Eventstore
+		checkPermission domain.PermissionCheck
+	}
+	type args struct {
+		orgID    string
+		parentID string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "parent is org, error",
+			fields: fields{
+				checkPermission: newMockPermissionCheckAllowed(),
+				eventstore:      expectEventstore(),
+			},
+			args: args{
+				orgID:    "org1",
+				parentID: "org1",
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "org not existing, not found",
+			fields: fields{
+				checkPermission: newMockPermissionCheckAllowed(),
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				orgID:    "org1",
+				parentID: "org2",
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "parent not existing, precondition failed",
+			fields: fields{
+				checkPermission: newMockPermissionCheckAllowed(),
+				eventstore: expectEventstore(
+					expectFilter(
+						orgAddedEvent("org1"),
+					),
+					expectFilter(),
+				),
+			},
+			args: args{
+				orgID:    "org1",
+				parentID: "org2",
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "no permission on parent, error",
+			fields: fields{
+				checkPermission: newMockPermissionCheckNotAllowed(),
+				eventstore: expectEventstore(
+					expectFilter(
+						orgAddedEvent("org1"),
+					),
+					expectFilter(
+						orgAddedEvent("org2"),
+					),
+				),
+			},
+			args: args{
+				orgID:    "org1",
+				parentID: "org2",
+			},
+			res: res{
+				err: zerrors.IsPermissionDenied,
+			},
+		},
+		{
+			name: "parent is descendant, precondition failed",
+			fields: fields{
+				checkPermission: newMockPermissionCheckAllowed(),
+				eventstore: expectEventstore(
+					expectFilter(
+						orgAddedEvent("org1"),
+					),
+					expectFilter(
+						orgAddedEvent("org3"),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewOrgParentSetEvent(context.Background(),
+								&org.NewAggregate("org2").Aggregate,
+								"org1",
+							),
+						),
+						eventFromEventPusher(
+							org.NewOrgParentSetEvent(context.Background(),
+								&org.NewAggregate("org3").Aggregate,
+								"org2",
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				orgID:    "org1",
+				parentID: "org3",
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "set parent, ok",
+			fields: fields{
+				checkPermission: newMockPermissionCheckAllowed(),
+				eventstore: expectEventstore(
+					expectFilter(
+						orgAddedEvent("org1"),
+					),
+					expectFilter(
+						orgAddedEvent("org2"),
+					),
+					expectFilter(),
+					expectPush(
+						org.NewOrgParentSetEvent(context.Background(),
+							&org.NewAggregate("org1").Aggregate,
+							"org2",
+						),
+					),
+				),
+			},
+			args: args{
+				orgID:    "org1",
+				parentID: "org2",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.fields.eventstore(t),
+				checkPermission: tt.fields.checkPermission,
+			}
+			got, err := c.SetOrgParent(context.Background(), tt.args.orgID, tt.args.parentID)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommands_RemoveOrgParent(t *testing.T) {
+	type fields struct {
+		eventstore      func(*testing.T) *eventstore.Eventstore
+		checkPermission domain.PermissionCheck
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		res    res
+	}{
+		{
+			name: "no parent, precondition failed",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						orgAddedEvent("org1"),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "no permission on parent, permission denied",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						orgAddedEvent("org1"),
+						eventFromEventPusher(
+							org.NewOrgParentSetEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								"org2",
+							),
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			},
+			res: res{
+				err: zerrors.IsPermissionDenied,
+			},
+		},
+		{
+			name: "remove parent, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						orgAddedEvent("org1"),
+						eventFromEventPusher(
+							org.NewOrgParentSetEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								"org2",
+							),
+						),
+					),
+					expectPush(
+						org.NewOrgParentRemovedEvent(context.Background(),
+							&org.NewAggregate("org1").Aggregate,
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.fields.eventstore(t),
+				checkPermission: tt.fields.checkPermission,
+			}
+			got, err := c.RemoveOrgParent(context.Background(), "org1")
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommands_getOrgPasswordComplexityPolicy_inherited(t *testing.T) {
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type res struct {
+		resourceOwner string
+		minLength     uint64
+		err           func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		res    res
+	}{
+		{
+			name: "own policy, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewPasswordComplexityPolicyAddedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								8, true, true, true, true,
+							),
+						),
+					),
+				),
+			},
+			res: res{
+				resourceOwner: "org1",
+				minLength:     8,
+			},
+		},
+		{
+			name: "policy of nearest ancestor, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewOrgParentSetEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								"org2",
+							),
+						),
+						eventFromEventPusher(
+							org.NewOrgParentSetEvent(context.Background(),
+								&org.NewAggregate("org2").Aggregate,
+								"org3",
+							),
+						),
+					),
+					expectFilter(),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewPasswordComplexityPolicyAddedEvent(context.Background(),
+								&org.NewAggregate("org3").Aggregate,
+								12, true, true, true, true,
+							),
+						),
+					),
+				),
+			},
+			res: res{
+				resourceOwner: "org3",
+				minLength:     12,
+			},
+		},
+		{
+			name: "no policy in hierarchy, default policy missing",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewOrgParentSetEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								"org2",
+							),
+						),
+					),
+					expectFilter(),
+					expectFilter(),
+				),
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := c.getOrgPasswordComplexityPolicy(context.Background(), "org1")
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.resourceOwner, got.ResourceOwner)
+				assert.Equal(t, tt.res.minLength, got.MinLength)
+			}
+		})
+	}
+}
@@ -20,14 +20,14 @@ func (c *Commands) AddOrgMemberCommand(a *org.Aggregate, userID string, roles ..
 		if len(roles) == 0 {
 			return nil, zerrors.ThrowInvalidArgument(nil, "V2-PfYhb", "Errors.Invalid.Argument")
 		}
-
-		if len(domain.CheckForInvalidRoles(roles, domain.OrgRolePrefix, c.zitadelRoles)) > 0 && len(domain.CheckForInvalidRoles(roles, domain.RoleSelfManagementGlobal, c.zitadelRoles)) > 0 {
-			return nil, zerrors.ThrowInvalidArgument(nil, "Org-4N8es", "Errors.Org.MemberInvalid")
-		}
 		return func(ctx context.Context, filter preparation.FilterToQueryReducer) (_ []eventstore.Command, err error) {
 				ctx, span := tracing.NewSpan(ctx)
 				defer func() { span.EndWithError(err) }()
 
+				if err := c.checkOrgMemberRoles(ctx, filter, roles); err != nil {
+					return nil, err
+				}
+
 				if exists, err := ExistsUser(ctx, filter, userID, ""); err != nil || !exists {
 					return nil, zerrors.ThrowPreconditionFailed(err, "ORG-GoXOn", "Errors.User.NotFound")
 				}
@@ -40,6 +40,18 @@ func (c *Commands) AddOrgMemberCommand(a *org.Aggregate, userID string, roles ..
 	}
 }
 
+// checkOrgMemberRoles returns an error if a role is neither an organization role nor the global self management role.
+func (c *Commands) checkOrgMemberRoles(ctx context.Context, filter preparation.FilterToQueryReducer, roles []string) error {
+	invalidRoles, err := c.invalidMemberRoles(ctx, filter, roles, domain.OrgRolePrefix)
+	if err != nil {
+		return err
+	}
+	if len(invalidRoles) > 0 && len(domain.CheckForInvalidRoles(roles, domain.RoleSelfManagementGlobal, c.zitadelRoles)) > 0 {
+		return zerrors.ThrowInvalidArgument(nil, "Org-4N8es", "Errors.Org.MemberInvalid")
+	}
+	return nil
+}
+
 func IsOrgMember(ctx context.Context, filter preparation.FilterToQueryReducer, orgID, userID string) (isMember bool, err error) {
 	events, err := filter(ctx, eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
 		ResourceOwner(orgID).
@@ -51,6 +63,7 @@ func IsOrgMember(ctx context.Context, filter preparation.FilterToQueryReducer, o
 			org.MemberAddedEventType,
 			org.MemberRemovedEventType,
 			org.MemberCascadeRemovedEventType,
+			org.MemberExpiredEventType,
 		).Builder())
 	if err != nil {
 		return false, err
@@ -70,6 +83,10 @@ func IsOrgMember(ctx context.Context, filter preparation.FilterToQueryReducer, o
 			if e.UserID == userID {
 				isMember = false
 			}
+		case *org.MemberExpiredEvent:
+			if e.UserID == userID {
+				isMember = false
+			}
 		}
 	}
 
@@ -101,8 +118,9 @@ func (c *Commands) addOrgMember(ctx context.Context, orgAgg *eventstore.Aggregat
 	if !member.IsValid() {
 		return nil, zerrors.ThrowInvalidArgument(nil, "Org-W8m4l", "Errors.Org.MemberInvalid")
 	}
-	if len(domain.CheckForInvalidRoles(member.Roles, domain.OrgRolePrefix, c.zitadelRoles)) > 0 && len(domain.CheckForInvalidRoles(member.Roles, domain.RoleSelfManagementGlobal, c.zitadelRoles)) > 0 {
-		return nil, zerrors.ThrowInvalidArgument(nil, "Org-4N8es", "Errors.Org.MemberInvalid")
+	//nolint:staticcheck
+	if err := c.checkOrgMemberRoles(ctx, c.eventstore.Filter, member.Roles); err != nil {
+		return nil, err
 	}
 	err := c.eventstore.FilterToQueryReducer(ctx, addedMember)
 	if err != nil {
@@ -120,7 +138,10 @@ func (c *Commands) ChangeOrgMember(ctx context.Context, member *domain.Member) (
 	if !member.IsValid() {
 		return nil, zerrors.ThrowInvalidArgument(nil, "Org-LiaZi", "Errors.Org.MemberInvalid")
 	}
-	if len(domain.CheckForInvalidRoles(member.Roles, domain.OrgRolePrefix, c.zitadelRoles)) > 0 {
+	//nolint:staticcheck
+	if invalidRoles, err := c.invalidMemberRoles(ctx, c.eventstore.Filter, member.Roles, domain.OrgRolePrefix); err != nil {
+		return nil, err
+	} else if len(invalidRoles) > 0 {
 		return nil, zerrors.ThrowInvalidArgument(nil, "IAM-m9fG8", "Errors.Org.MemberInvalid")
 	}
 
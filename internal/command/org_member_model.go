@@ -44,6 +44,16 @@ func (wm *OrgMemberWriteModel) AppendEvents(events ...eventstore.Event) {
 				continue
 			}
 			wm.MemberWriteModel.AppendEvents(&e.MemberCascadeRemovedEvent)
+		case *org.MemberValiditySetEvent:
+			if e.UserID != wm.MemberWriteModel.UserID {
+				continue
+			}
+			wm.MemberWriteModel.AppendEvents(&e.MemberValiditySetEvent)
+		case *org.MemberExpiredEvent:
+			if e.UserID != wm.MemberWriteModel.UserID {
+				continue
+			}
+			wm.MemberWriteModel.AppendEvents(&e.MemberExpiredEvent)
 		}
 	}
 }
@@ -62,6 +72,8 @@ func (wm *OrgMemberWriteModel) Query() *eventstore.SearchQueryBuilder {
 			org.MemberAddedEventType,
 			org.MemberChangedEventType,
 			org.MemberRemovedEventType,
-			org.MemberCascadeRemovedEventType).
+			org.MemberCascadeRemovedEventType,
+			org.MemberValiditySetEventType,
+			org.MemberExpiredEventType).
 		Builder()
 }
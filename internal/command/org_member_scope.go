@@ -0,0 +1,170 @@
+package command
+
+import (
+	"context"
+	"slices"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// SetOrgMemberScope restricts the permissions of the organization member on users to the users in the scope.
+// An empty scope removes the restriction.
+func (c *Commands) SetOrgMemberScope(ctx context.Context, orgID, userID string, scope *domain.OrgMemberScope) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if orgID == "" || userID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ms1iv", "Errors.Org.MemberInvalid")
+	}
+	if scope == nil {
+		scope = new(domain.OrgMemberScope)
+	}
+	if !scope.IsValid() {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ms2iv", "Errors.Org.Member.ScopeInvalid")
+	}
+	writeModel, err := c.orgMemberScopeWriteModel(ctx, orgID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if writeModel.State != domain.MemberStateActive {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Ms3nf", "Errors.NotFound")
+	}
+	if scope.IsEmpty() && writeModel.Scope.IsEmpty() || !writeModel.Scope.IsEmpty() && *writeModel.Scope == *scope {
+		return writeModelToObjectDetails(&writeModel.WriteModel), nil
+	}
+	if scope.GroupID != "" {
+		if _, err = c.existingGroupWriteModel(ctx, scope.GroupID, orgID); err != nil {
+			return nil, err
+		}
+	}
+	err = c.pushAppendAndReduce(ctx, writeModel, org.NewMemberScopeSetEvent(
+		ctx,
+		&org.NewAggregate(orgID).Aggregate,
+		userID,
+		scope,
+	))
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// checkUserInMemberScope checks if the user is in the scope of the organization membership of the authenticated user.
+// Members without a scope, instance members and system users are not restricted, nor are changes of the own user.
+func (c *Commands) checkUserInMemberScope(ctx context.Context, resourceOwner, userID string) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if authz.GetCtxData(ctx).UserID == userID {
+		return nil
+	}
+	scope, err := c.orgMemberScope(ctx, resourceOwner)
+	if err != nil || scope == nil {
+		return err
+	}
+	return c.checkUserInScope(ctx, scope, resourceOwner, userID)
+}
+
+// checkUserMetadataInMemberScope checks if the user is in the scope of the authenticated user (see [Commands.checkUserInMemberScope])
+// and prevents restricted members from changing the metadata key of their scope,
+// which would move users in or out of the scope.
+func (c *Commands) checkUserMetadataInMemberScope(ctx context.Context, resourceOwner, userID string, keys ...string) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	scope, err := c.orgMemberScope(ctx, resourceOwner)
+	if err != nil || scope == nil {
+		return err
+	}
+	if scope.MetadataKey != "" && slices.Contains(keys, scope.MetadataKey) {
+		return zerrors.ThrowPermissionDenied(nil, "COMMAND-Ms5pd", "Errors.Org.Member.ScopeChangeDenied")
+	}
+	if authz.GetCtxData(ctx).UserID == userID {
+		return nil
+	}
+	return c.checkUserInScope(ctx, scope, resourceOwner, userID)
+}
+
+// checkGroupMembersInMemberScope prevents restricted members from changing the members of the group of their scope,
+// which would move users in or out of the scope.
+func (c *Commands) checkGroupMembersInMemberScope(ctx context.Context, resourceOwner, groupID string) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	scope, err := c.orgMemberScope(ctx, resourceOwner)
+	if err != nil || scope == nil {
+		return err
+	}
+	if scope.GroupID == groupID {
+		return zerrors.ThrowPermissionDenied(nil, "COMMAND-Ms6pd", "Errors.Org.Member.ScopeChangeDenied")
+	}
+	return nil
+}
+
+// orgMemberScope returns the scope of the organization membership of the authenticated user.
+// It returns nil if the authenticated user is not restricted.
+func (c *Commands) orgMemberScope(ctx context.Context, resourceOwner string) (*domain.OrgMemberScope, error) {
+	ctxData := authz.GetCtxData(ctx)
+	if ctxData.UserID == "" || ctxData.SystemMemberships != nil {
+		return nil, nil
+	}
+	scopeModel, err := c.orgMemberScopeWriteModel(ctx, resourceOwner, ctxData.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if scopeModel.State != domain.MemberStateActive || scopeModel.Scope.IsEmpty() {
+		return nil, nil
+	}
+	instanceMember := NewInstanceMemberWriteModel(ctx, ctxData.UserID)
+	if err = c.eventstore.FilterToQueryReducer(ctx, instanceMember); err != nil {
+		return nil, err
+	}
+	if instanceMember.State == domain.MemberStateActive {
+		return nil, nil
+	}
+	return scopeModel.Scope, nil
+}
+
+func (c *Commands) checkUserInScope(ctx context.Context, scope *domain.OrgMemberScope, resourceOwner, userID string) error {
+	var (
+		metadata map[string][]byte
+		groupIDs []string
+	)
+	if scope.MetadataKey != "" {
+		metadataModel := NewUserMetadataWriteModel(userID, resourceOwner, scope.MetadataKey)
+		if err := c.eventstore.FilterToQueryReducer(ctx, metadataModel); err != nil {
+			return err
+		}
+		if metadataModel.State == domain.MetadataStateActive {
+			metadata = map[string][]byte{metadataModel.Key: metadataModel.Value}
+		}
+	}
+	if scope.GroupID != "" {
+		group, err := c.groupWriteModelByID(ctx, scope.GroupID, resourceOwner)
+		if err != nil {
+			return err
+		}
+		if group.State.Exists() && slices.Contains(group.Members, userID) {
+			groupIDs = []string{group.AggregateID}
+		}
+	}
+	if !scope.Contains(metadata, groupIDs) {
+		return zerrors.ThrowPermissionDenied(nil, "COMMAND-Ms4pd", "Errors.Org.Member.OutOfScope")
+	}
+	return nil
+}
+
+func (c *Commands) orgMemberScopeWriteModel(ctx context.Context, orgID, userID string) (_ *OrgMemberScopeWriteModel, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel := NewOrgMemberScopeWriteModel(orgID, userID)
+	if err = c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
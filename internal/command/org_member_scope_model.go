@@ -0,0 +1,89 @@
+package command
+
+import (
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
+)
+
+// OrgMemberScopeWriteModel holds the scope of the permissions of an organization member on users.
+type OrgMemberScopeWriteModel struct {
+	eventstore.WriteModel
+
+	UserID string
+	State  domain.MemberState
+	Scope  *domain.OrgMemberScope
+}
+
+func NewOrgMemberScopeWriteModel(orgID, userID string) *OrgMemberScopeWriteModel {
+	return &OrgMemberScopeWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   orgID,
+			ResourceOwner: orgID,
+		},
+		UserID: userID,
+	}
+}
+
+func (wm *OrgMemberScopeWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *org.MemberAddedEvent:
+			if e.UserID != wm.UserID {
+				continue
+			}
+		case *org.MemberRemovedEvent:
+			if e.UserID != wm.UserID {
+				continue
+			}
+		case *org.MemberCascadeRemovedEvent:
+			if e.UserID != wm.UserID {
+				continue
+			}
+		case *org.MemberExpiredEvent:
+			if e.UserID != wm.UserID {
+				continue
+			}
+		case *org.MemberScopeSetEvent:
+			if e.UserID != wm.UserID {
+				continue
+			}
+		}
+		wm.WriteModel.AppendEvents(event)
+	}
+}
+
+func (wm *OrgMemberScopeWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *org.MemberAddedEvent:
+			wm.State = domain.MemberStateActive
+			wm.Scope = nil
+		case *org.MemberRemovedEvent, *org.MemberCascadeRemovedEvent, *org.MemberExpiredEvent:
+			wm.State = domain.MemberStateRemoved
+			wm.Scope = nil
+		case *org.MemberScopeSetEvent:
+			if scope := e.Scope(); !scope.IsEmpty() {
+				wm.Scope = scope
+				continue
+			}
+			wm.Scope = nil
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *OrgMemberScopeWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(org.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			org.MemberAddedEventType,
+			org.MemberRemovedEventType,
+			org.MemberCascadeRemovedEventType,
+			org.MemberExpiredEventType,
+			org.MemberScopeSetEventType).
+		Builder()
+}
@@ -0,0 +1,522 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/group"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommands_SetOrgMemberScope(t *testing.T) {
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		userID string
+		scope  *domain.OrgMemberScope
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "missing user, invalid argument",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				scope: &domain.OrgMemberScope{MetadataKey: "region"},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "value without key, invalid argument",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				userID: "user1",
+				scope:  &domain.OrgMemberScope{MetadataValue: "EMEA"},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "member not existing, not found",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				userID: "user1",
+				scope:  &domain.OrgMemberScope{MetadataKey: "region", MetadataValue: "EMEA"},
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "group not existing, not found",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						orgMemberAddedEvent("user1"),
+					),
+					expectFilter(),
+				),
+			},
+			args: args{
+				userID: "user1",
+				scope:  &domain.OrgMemberScope{GroupID: "group1"},
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "set scope, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						orgMemberAddedEvent("user1"),
+					),
+					expectFilter(
+						groupAddedEvent("group1", "helpdesk"),
+					),
+					expectPush(
+						org.NewMemberScopeSetEvent(context.Background(),
+							&org.NewAggregate("org1").Aggregate,
+							"user1",
+							&domain.OrgMemberScope{MetadataKey: "region", MetadataValue: "EMEA", GroupID: "group1"},
+						),
+					),
+				),
+			},
+			args: args{
+				userID: "user1",
+				scope:  &domain.OrgMemberScope{MetadataKey: "region", MetadataValue: "EMEA", GroupID: "group1"},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			name: "scope unchanged, no push",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						orgMemberAddedEvent("user1"),
+						orgMemberScopeSetEvent("user1", &domain.OrgMemberScope{MetadataKey: "region", MetadataValue: "EMEA"}),
+					),
+				),
+			},
+			args: args{
+				userID: "user1",
+				scope:  &domain.OrgMemberScope{MetadataKey: "region", MetadataValue: "EMEA"},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			name: "remove scope, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						orgMemberAddedEvent("user1"),
+						orgMemberScopeSetEvent("user1", &domain.OrgMemberScope{MetadataKey: "region", MetadataValue: "EMEA"}),
+					),
+					expectPush(
+						org.NewMemberScopeSetEvent(context.Background(),
+							&org.NewAggregate("org1").Aggregate,
+							"user1",
+							&domain.OrgMemberScope{},
+						),
+					),
+				),
+			},
+			args: args{
+				userID: "user1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			name: "scope of removed member, not found",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						orgMemberAddedEvent("user1"),
+						orgMemberScopeSetEvent("user1", &domain.OrgMemberScope{MetadataKey: "region", MetadataValue: "EMEA"}),
+						eventFromEventPusher(
+							org.NewMemberRemovedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								"user1",
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				userID: "user1",
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := c.SetOrgMemberScope(context.Background(), "org1", tt.args.userID, tt.args.scope)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommands_checkPermissionUpdateUser_memberScope(t *testing.T) {
+	type fields struct {
+		eventstore      func(*testing.T) *eventstore.Eventstore
+		checkPermission domain.PermissionCheck
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		ctx    context.Context
+		err    func(error) bool
+	}{
+		{
+			name: "no permission, permission denied",
+			fields: fields{
+				eventstore:      expectEventstore(),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			},
+			ctx: authz.NewMockContext("instance1", "org1", "admin1"),
+			err: zerrors.IsPermissionDenied,
+		},
+		{
+			name: "member without scope, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						orgMemberAddedEvent("admin1"),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			ctx: authz.NewMockContext("instance1", "org1", "admin1"),
+		},
+		{
+			name: "system user, ok",
+			fields: fields{
+				eventstore:      expectEventstore(),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			ctx: authz.SetCtxData(context.Background(), authz.CtxData{UserID: "system", SystemMemberships: authz.Memberships{}}),
+		},
+		{
+			name: "instance member with scoped org membership, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						orgMemberAddedEvent("admin1"),
+						orgMemberScopeSetEvent("admin1", &domain.OrgMemberScope{MetadataKey: "region", MetadataValue: "EMEA"}),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewMemberAddedEvent(context.Background(),
+								&instance.NewAggregate("instance1").Aggregate,
+								"admin1",
+							),
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			ctx: authz.NewMockContext("instance1", "org1", "admin1"),
+		},
+		{
+			name: "user with metadata in scope, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						orgMemberAddedEvent("admin1"),
+						orgMemberScopeSetEvent("admin1", &domain.OrgMemberScope{MetadataKey: "region", MetadataValue: "EMEA"}),
+					),
+					expectFilter(),
+					expectFilter(
+						eventFromEventPusher(
+							user.NewMetadataSetEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"region",
+								[]byte("EMEA"),
+							),
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			ctx: authz.NewMockContext("instance1", "org1", "admin1"),
+		},
+		{
+			name: "user with other metadata value, permission denied",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						orgMemberAddedEvent("admin1"),
+						orgMemberScopeSetEvent("admin1", &domain.OrgMemberScope{MetadataKey: "region", MetadataValue: "EMEA"}),
+					),
+					expectFilter(),
+					expectFilter(
+						eventFromEventPusher(
+							user.NewMetadataSetEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"region",
+								[]byte("APAC"),
+							),
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			ctx: authz.NewMockContext("instance1", "org1", "admin1"),
+			err: zerrors.IsPermissionDenied,
+		},
+		{
+			name: "user in group of scope, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						orgMemberAddedEvent("admin1"),
+						orgMemberScopeSetEvent("admin1", &domain.OrgMemberScope{GroupID: "group1"}),
+					),
+					expectFilter(),
+					expectFilter(
+						groupAddedEvent("group1", "helpdesk"),
+						eventFromEventPusher(
+							group.NewMemberAddedEvent(context.Background(),
+								group.NewAggregate("group1", "org1"),
+								"user1",
+							),
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			ctx: authz.NewMockContext("instance1", "org1", "admin1"),
+		},
+		{
+			name: "user not in group of scope, permission denied",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						orgMemberAddedEvent("admin1"),
+						orgMemberScopeSetEvent("admin1", &domain.OrgMemberScope{GroupID: "group1"}),
+					),
+					expectFilter(),
+					expectFilter(
+						groupAddedEvent("group1", "helpdesk"),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			ctx: authz.NewMockContext("instance1", "org1", "admin1"),
+			err: zerrors.IsPermissionDenied,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.fields.eventstore(t),
+				checkPermission: tt.fields.checkPermission,
+			}
+			err := c.checkPermissionUpdateUser(tt.ctx, "org1", "user1")
+			if tt.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.err != nil && !tt.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+		})
+	}
+}
+
+func TestCommands_checkUserMetadataInMemberScope(t *testing.T) {
+	tests := []struct {
+		name       string
+		eventstore func(*testing.T) *eventstore.Eventstore
+		keys       []string
+		err        func(error) bool
+	}{
+		{
+			name: "key of scope, permission denied",
+			eventstore: expectEventstore(
+				expectFilter(
+					orgMemberAddedEvent("admin1"),
+					orgMemberScopeSetEvent("admin1", &domain.OrgMemberScope{MetadataKey: "region", MetadataValue: "EMEA"}),
+				),
+				expectFilter(),
+			),
+			keys: []string{"department", "region"},
+			err:  zerrors.IsPermissionDenied,
+		},
+		{
+			name: "other key of user in scope, ok",
+			eventstore: expectEventstore(
+				expectFilter(
+					orgMemberAddedEvent("admin1"),
+					orgMemberScopeSetEvent("admin1", &domain.OrgMemberScope{MetadataKey: "region", MetadataValue: "EMEA"}),
+				),
+				expectFilter(),
+				expectFilter(
+					eventFromEventPusher(
+						user.NewMetadataSetEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+							"region",
+							[]byte("EMEA"),
+						),
+					),
+				),
+			),
+			keys: []string{"department"},
+		},
+		{
+			name: "other key of user out of scope, permission denied",
+			eventstore: expectEventstore(
+				expectFilter(
+					orgMemberAddedEvent("admin1"),
+					orgMemberScopeSetEvent("admin1", &domain.OrgMemberScope{MetadataKey: "region", MetadataValue: "EMEA"}),
+				),
+				expectFilter(),
+				expectFilter(),
+			),
+			keys: []string{"department"},
+			err:  zerrors.IsPermissionDenied,
+		},
+		{
+			name: "member without scope, ok",
+			eventstore: expectEventstore(
+				expectFilter(
+					orgMemberAddedEvent("admin1"),
+				),
+			),
+			keys: []string{"region"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.eventstore(t),
+			}
+			err := c.checkUserMetadataInMemberScope(authz.NewMockContext("instance1", "org1", "admin1"), "org1", "user1", tt.keys...)
+			if tt.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.err != nil && !tt.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+		})
+	}
+}
+
+func TestCommands_checkGroupMembersInMemberScope(t *testing.T) {
+	tests := []struct {
+		name       string
+		eventstore func(*testing.T) *eventstore.Eventstore
+		groupID    string
+		err        func(error) bool
+	}{
+		{
+			name: "group of scope, permission denied",
+			eventstore: expectEventstore(
+				expectFilter(
+					orgMemberAddedEvent("admin1"),
+					orgMemberScopeSetEvent("admin1", &domain.OrgMemberScope{GroupID: "group1"}),
+				),
+				expectFilter(),
+			),
+			groupID: "group1",
+			err:     zerrors.IsPermissionDenied,
+		},
+		{
+			name: "other group, ok",
+			eventstore: expectEventstore(
+				expectFilter(
+					orgMemberAddedEvent("admin1"),
+					orgMemberScopeSetEvent("admin1", &domain.OrgMemberScope{GroupID: "group1"}),
+				),
+				expectFilter(),
+			),
+			groupID: "group2",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.eventstore(t),
+			}
+			err := c.checkGroupMembersInMemberScope(authz.NewMockContext("instance1", "org1", "admin1"), "org1", tt.groupID)
+			if tt.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.err != nil && !tt.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+		})
+	}
+}
+
+func orgMemberAddedEvent(userID string) eventstore.Event {
+	return eventFromEventPusher(
+		org.NewMemberAddedEvent(context.Background(),
+			&org.NewAggregate("org1").Aggregate,
+			userID,
+			"ORG_USER_MANAGER",
+		),
+	)
+}
+
+func orgMemberScopeSetEvent(userID string, scope *domain.OrgMemberScope) eventstore.Event {
+	return eventFromEventPusher(
+		org.NewMemberScopeSetEvent(context.Background(),
+			&org.NewAggregate("org1").Aggregate,
+			userID, scope,
+		),
+	)
+}
@@ -56,14 +56,18 @@ func TestAddMember(t *testing.T) {
 			},
 		},
 		{
-			name: "TODO: invalid roles",
+			name: "invalid roles",
 			args: args{
 				a:      agg,
 				userID: "123",
 				roles:  []string{"ORG_OWNER"},
+				filter: NewMultiFilter().Append(
+					func(ctx context.Context, queryFactory *eventstore.SearchQueryBuilder) ([]eventstore.Event, error) {
+						return nil, nil
+					}).Filter(),
 			},
 			want: Want{
-				ValidationErr: zerrors.ThrowInvalidArgument(nil, "Org-4N8es", ""),
+				CreateErr: zerrors.ThrowInvalidArgument(nil, "Org-4N8es", ""),
 			},
 		},
 		{
@@ -326,6 +330,7 @@ func TestCommandSide_AddOrgMember(t *testing.T) {
 			fields: fields{
 				eventstore: eventstoreExpect(
 					t,
+					expectFilter(),
 				),
 			},
 			args: args{
@@ -570,6 +575,7 @@ func TestCommandSide_ChangeOrgMember(t *testing.T) {
 			fields: fields{
 				eventstore: eventstoreExpect(
 					t,
+					expectFilter(),
 				),
 			},
 			args: args{
@@ -0,0 +1,83 @@
+package command
+
+import (
+	"bytes"
+	"context"
+
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/i18n"
+	"github.com/zitadel/zitadel/internal/notification/templates"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// SetOrgMessageTemplate sets the custom HTML template of a message type or a partial (header or footer) for a language of the organization.
+// The template is validated against the data available when rendering a message.
+func (c *Commands) SetOrgMessageTemplate(ctx context.Context, orgID, name string, lang language.Tag, template []byte) (*domain.ObjectDetails, error) {
+	if orgID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Mt1ro", "Errors.ResourceOwnerMissing")
+	}
+	if err := validateMessageTemplate(name, lang, template); err != nil {
+		return nil, err
+	}
+	writeModel, err := c.orgMessageTemplateWriteModel(ctx, orgID, name, lang)
+	if err != nil {
+		return nil, err
+	}
+	if writeModel.State == domain.PolicyStateActive && bytes.Equal(writeModel.Template, template) {
+		return writeModelToObjectDetails(&writeModel.WriteModel), nil
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, org.NewMessageTemplateSetEvent(ctx, OrgAggregateFromWriteModel(&writeModel.WriteModel), name, lang, template))
+	if err != nil {
+		return nil, err
+	}
+	if err = AppendAndReduce(writeModel, pushedEvents...); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// RemoveOrgMessageTemplate removes the custom HTML template of a message type or a partial for a language of the organization,
+// so the template of the instance or the default template is used again.
+func (c *Commands) RemoveOrgMessageTemplate(ctx context.Context, orgID, name string, lang language.Tag) (*domain.ObjectDetails, error) {
+	if orgID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Mt2ro", "Errors.ResourceOwnerMissing")
+	}
+	writeModel, err := c.orgMessageTemplateWriteModel(ctx, orgID, name, lang)
+	if err != nil {
+		return nil, err
+	}
+	if writeModel.State != domain.PolicyStateActive {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Mt3nf", "Errors.MessageTemplate.NotFound")
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, org.NewMessageTemplateRemovedEvent(ctx, OrgAggregateFromWriteModel(&writeModel.WriteModel), name, lang))
+	if err != nil {
+		return nil, err
+	}
+	if err = AppendAndReduce(writeModel, pushedEvents...); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+func (c *Commands) orgMessageTemplateWriteModel(ctx context.Context, orgID, name string, lang language.Tag) (*OrgMessageTemplateWriteModel, error) {
+	writeModel := NewOrgMessageTemplateWriteModel(orgID, name, lang)
+	if err := c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
+
+// validateMessageTemplate only validates if the language is supported, not if it is allowed.
+// This enables setting templates before allowing a language
+func validateMessageTemplate(name string, lang language.Tag, template []byte) error {
+	if err := domain.LanguageIsDefined(lang); err != nil {
+		return err
+	}
+	if err := domain.LanguagesAreSupported(i18n.SupportedLanguages(), lang); err != nil {
+		return err
+	}
+	return templates.ValidateMessageTemplate(name, string(template))
+}
@@ -0,0 +1,52 @@
+package command
+
+import (
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
+)
+
+type OrgMessageTemplateWriteModel struct {
+	MessageTemplateWriteModel
+}
+
+func NewOrgMessageTemplateWriteModel(orgID, name string, lang language.Tag) *OrgMessageTemplateWriteModel {
+	return &OrgMessageTemplateWriteModel{
+		MessageTemplateWriteModel{
+			WriteModel: eventstore.WriteModel{
+				AggregateID:   orgID,
+				ResourceOwner: orgID,
+			},
+			Name:     name,
+			Language: lang,
+		},
+	}
+}
+
+func (wm *OrgMessageTemplateWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *org.MessageTemplateSetEvent:
+			wm.MessageTemplateWriteModel.AppendEvents(&e.MessageTemplateSetEvent)
+		case *org.MessageTemplateRemovedEvent:
+			wm.MessageTemplateWriteModel.AppendEvents(&e.MessageTemplateRemovedEvent)
+		}
+	}
+}
+
+func (wm *OrgMessageTemplateWriteModel) Reduce() error {
+	return wm.MessageTemplateWriteModel.Reduce()
+}
+
+func (wm *OrgMessageTemplateWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(org.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			org.MessageTemplateSetEventType,
+			org.MessageTemplateRemovedEventType).
+		Builder()
+}
@@ -0,0 +1,245 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommandSide_SetOrgMessageTemplate(t *testing.T) {
+	type args struct {
+		ctx      context.Context
+		orgID    string
+		name     string
+		lang     language.Tag
+		template []byte
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name       string
+		eventstore func(t *testing.T) *eventstore.Eventstore
+		args       args
+		res        res
+	}{
+		{
+			name:       "no resource owner, invalid argument error",
+			eventstore: expectEventstore(),
+			args: args{
+				ctx:      context.Background(),
+				name:     domain.InitCodeMessageType,
+				lang:     language.English,
+				template: []byte(`{{.Text}}`),
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name:       "unsupported language, invalid argument error",
+			eventstore: expectEventstore(),
+			args: args{
+				ctx:      context.Background(),
+				orgID:    "org1",
+				name:     domain.InitCodeMessageType,
+				lang:     language.Make("xx"),
+				template: []byte(`{{.Text}}`),
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name:       "unknown template data, invalid argument error",
+			eventstore: expectEventstore(),
+			args: args{
+				ctx:      context.Background(),
+				orgID:    "org1",
+				name:     domain.InitCodeMessageType,
+				lang:     language.English,
+				template: []byte(`{{.Password}}`),
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "unchanged, ok",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(
+						org.NewMessageTemplateSetEvent(
+							context.Background(),
+							&org.NewAggregate("org1").Aggregate,
+							domain.InitCodeMessageType,
+							language.English,
+							[]byte(`{{template "header" .}}{{.Text}}`),
+						),
+					),
+				),
+			),
+			args: args{
+				ctx:      context.Background(),
+				orgID:    "org1",
+				name:     domain.InitCodeMessageType,
+				lang:     language.English,
+				template: []byte(`{{template "header" .}}{{.Text}}`),
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			name: "set partial, ok",
+			eventstore: expectEventstore(
+				expectFilter(),
+				expectPush(
+					org.NewMessageTemplateSetEvent(
+						context.Background(),
+						&org.NewAggregate("org1").Aggregate,
+						domain.MessageTemplatePartialHeader,
+						language.English,
+						[]byte(`<img src="{{.LogoURL}}">`),
+					),
+				),
+			),
+			args: args{
+				ctx:      context.Background(),
+				orgID:    "org1",
+				name:     domain.MessageTemplatePartialHeader,
+				lang:     language.English,
+				template: []byte(`<img src="{{.LogoURL}}">`),
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.eventstore(t),
+			}
+			got, err := r.SetOrgMessageTemplate(tt.args.ctx, tt.args.orgID, tt.args.name, tt.args.lang, tt.args.template)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_RemoveOrgMessageTemplate(t *testing.T) {
+	type args struct {
+		ctx   context.Context
+		orgID string
+		name  string
+		lang  language.Tag
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name       string
+		eventstore func(t *testing.T) *eventstore.Eventstore
+		args       args
+		res        res
+	}{
+		{
+			name: "other language, not found error",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(
+						org.NewMessageTemplateSetEvent(
+							context.Background(),
+							&org.NewAggregate("org1").Aggregate,
+							domain.InitCodeMessageType,
+							language.German,
+							[]byte(`{{.Text}}`),
+						),
+					),
+				),
+			),
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+				name:  domain.InitCodeMessageType,
+				lang:  language.English,
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "remove, ok",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(
+						org.NewMessageTemplateSetEvent(
+							context.Background(),
+							&org.NewAggregate("org1").Aggregate,
+							domain.InitCodeMessageType,
+							language.English,
+							[]byte(`{{.Text}}`),
+						),
+					),
+				),
+				expectPush(
+					org.NewMessageTemplateRemovedEvent(
+						context.Background(),
+						&org.NewAggregate("org1").Aggregate,
+						domain.InitCodeMessageType,
+						language.English,
+					),
+				),
+			),
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+				name:  domain.InitCodeMessageType,
+				lang:  language.English,
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.eventstore(t),
+			}
+			got, err := r.RemoveOrgMessageTemplate(tt.args.ctx, tt.args.orgID, tt.args.name, tt.args.lang)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
@@ -36,6 +36,7 @@ type AddLoginPolicy struct {
 	MultiFactorCheckLifetime   time.Duration
 	DisableLoginWithEmail      bool
 	DisableLoginWithPhone      bool
+	ForcePasskeyRegistration   bool
 }
 
 type AddLoginPolicyIDP struct {
@@ -61,6 +62,7 @@ type ChangeLoginPolicy struct {
 	MultiFactorCheckLifetime   time.Duration
 	DisableLoginWithEmail      bool
 	DisableLoginWithPhone      bool
+	ForcePasskeyRegistration   bool
 }
 
 func (c *Commands) AddLoginPolicy(ctx context.Context, resourceOwner string, policy *AddLoginPolicy) (_ *domain.ObjectDetails, err error) {
@@ -96,6 +98,20 @@ func (c *Commands) getOrgLoginPolicy(ctx context.Context, orgID string) (*domain
 	if policy.State == domain.PolicyStateActive {
 		return writeModelToLoginPolicy(&policy.LoginPolicyWriteModel), nil
 	}
+	// nested organizations inherit the policy of their nearest ancestor with a policy
+	ancestors, err := orgPolicyAncestorIDs(ctx, c.eventstore.Filter, orgID)
+	if err != nil {
+		return nil, err
+	}
+	for _, ancestor := range ancestors {
+		policy, err = c.orgLoginPolicyWriteModelByID(ctx, ancestor)
+		if err != nil {
+			return nil, err
+		}
+		if policy.State == domain.PolicyStateActive {
+			return writeModelToLoginPolicy(&policy.LoginPolicyWriteModel), nil
+		}
+	}
 	return c.getDefaultLoginPolicy(ctx)
 }
 
@@ -439,6 +455,7 @@ func prepareAddLoginPolicy(a *org.Aggregate, policy *AddLoginPolicy) preparation
 				policy.AllowDomainDiscovery,
 				policy.DisableLoginWithEmail,
 				policy.DisableLoginWithPhone,
+				policy.ForcePasskeyRegistration,
 				policy.PasswordlessType,
 				policy.DefaultRedirectURI,
 				policy.PasswordCheckLifetime,
@@ -485,6 +502,7 @@ func prepareChangeLoginPolicy(a *org.Aggregate, policy *ChangeLoginPolicy) prepa
 				policy.AllowDomainDiscovery,
 				policy.DisableLoginWithEmail,
 				policy.DisableLoginWithPhone,
+				policy.ForcePasskeyRegistration,
 				policy.PasswordlessType,
 				policy.DefaultRedirectURI,
 				policy.PasswordCheckLifetime,
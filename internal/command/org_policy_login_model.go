@@ -71,7 +71,8 @@ func (wm *OrgLoginPolicyWriteModel) NewChangedEvent(
 	ignoreUnknownUsernames,
 	allowDomainDiscovery,
 	disableLoginWithEmail,
-	disableLoginWithPhone bool,
+	disableLoginWithPhone,
+	forcePasskeyRegistration bool,
 	passwordlessType domain.PasswordlessType,
 	defaultRedirectURI string,
 	passwordCheckLifetime,
@@ -133,6 +134,9 @@ func (wm *OrgLoginPolicyWriteModel) NewChangedEvent(
 	if wm.DisableLoginWithPhone != disableLoginWithPhone {
 		changes = append(changes, policy.ChangeDisableLoginWithPhone(disableLoginWithPhone))
 	}
+	if wm.ForcePasskeyRegistration != forcePasskeyRegistration {
+		changes = append(changes, policy.ChangeForcePasskeyRegistration(forcePasskeyRegistration))
+	}
 	if len(changes) == 0 {
 		return nil, false
 	}
@@ -62,6 +62,7 @@ func TestCommandSide_AddLoginPolicy(t *testing.T) {
 								true,
 								false,
 								false,
+								false,
 								domain.PasswordlessTypeAllowed,
 								"https://example.com/redirect",
 								time.Hour*1,
@@ -117,6 +118,7 @@ func TestCommandSide_AddLoginPolicy(t *testing.T) {
 							true,
 							true,
 							true,
+							false,
 							domain.PasswordlessTypeAllowed,
 							"https://example.com/redirect",
 							time.Hour*1,
@@ -211,6 +213,7 @@ func TestCommandSide_AddLoginPolicy(t *testing.T) {
 							true,
 							true,
 							true,
+							false,
 							domain.PasswordlessTypeAllowed,
 							"https://example.com/redirect",
 							time.Hour*1,
@@ -336,6 +339,7 @@ func TestCommandSide_AddLoginPolicy(t *testing.T) {
 							true,
 							true,
 							true,
+							false,
 							domain.PasswordlessTypeAllowed,
 							"https://example.com/redirect",
 							time.Hour*1,
@@ -418,6 +422,7 @@ func TestCommandSide_AddLoginPolicy(t *testing.T) {
 							true,
 							true,
 							true,
+							false,
 							domain.PasswordlessTypeAllowed,
 							"https://example.com/redirect",
 							time.Hour*1,
@@ -556,6 +561,7 @@ func TestCommandSide_ChangeLoginPolicy(t *testing.T) {
 								true,
 								true,
 								true,
+								false,
 								domain.PasswordlessTypeAllowed,
 								"https://example.com/redirect",
 								time.Hour*1,
@@ -614,6 +620,7 @@ func TestCommandSide_ChangeLoginPolicy(t *testing.T) {
 								true,
 								true,
 								true,
+								false,
 								domain.PasswordlessTypeAllowed,
 								"https://example.com/redirect",
 								time.Hour*1,
@@ -763,6 +770,7 @@ func TestCommandSide_RemoveLoginPolicy(t *testing.T) {
 								true,
 								true,
 								true,
+								false,
 								domain.PasswordlessTypeAllowed,
 								"",
 								time.Hour*1,
@@ -903,6 +911,7 @@ func TestCommandSide_AddIDPProviderLoginPolicy(t *testing.T) {
 								true,
 								true,
 								true,
+								false,
 								domain.PasswordlessTypeAllowed,
 								"",
 								time.Hour*1,
@@ -948,6 +957,7 @@ func TestCommandSide_AddIDPProviderLoginPolicy(t *testing.T) {
 								true,
 								true,
 								true,
+								false,
 								domain.PasswordlessTypeAllowed,
 								"",
 								time.Hour*1,
@@ -1013,6 +1023,7 @@ func TestCommandSide_AddIDPProviderLoginPolicy(t *testing.T) {
 								true,
 								true,
 								true,
+								false,
 								domain.PasswordlessTypeAllowed,
 								"",
 								time.Hour*1,
@@ -1176,6 +1187,7 @@ func TestCommandSide_RemoveIDPProviderLoginPolicy(t *testing.T) {
 								true,
 								true,
 								true,
+								false,
 								domain.PasswordlessTypeAllowed,
 								"",
 								time.Hour*1,
@@ -1221,6 +1233,7 @@ func TestCommandSide_RemoveIDPProviderLoginPolicy(t *testing.T) {
 								true,
 								true,
 								true,
+								false,
 								domain.PasswordlessTypeAllowed,
 								"",
 								time.Hour*1,
@@ -1278,6 +1291,7 @@ func TestCommandSide_RemoveIDPProviderLoginPolicy(t *testing.T) {
 								true,
 								true,
 								true,
+								false,
 								domain.PasswordlessTypeAllowed,
 								"",
 								time.Hour*1,
@@ -1338,6 +1352,7 @@ func TestCommandSide_RemoveIDPProviderLoginPolicy(t *testing.T) {
 								true,
 								true,
 								true,
+								false,
 								domain.PasswordlessTypeAllowed,
 								"",
 								time.Hour*1,
@@ -20,6 +20,20 @@ func (c *Commands) getOrgPasswordComplexityPolicy(ctx context.Context, orgID str
 	if policy.State == domain.PolicyStateActive {
 		return orgWriteModelToPasswordComplexityPolicy(policy), nil
 	}
+	// nested organizations inherit the policy of their nearest ancestor with a policy
+	ancestors, err := orgPolicyAncestorIDs(ctx, c.eventstore.Filter, orgID)
+	if err != nil {
+		return nil, err
+	}
+	for _, ancestor := range ancestors {
+		policy, err = c.orgPasswordComplexityPolicyWriteModelByID(ctx, ancestor)
+		if err != nil {
+			return nil, err
+		}
+		if policy.State == domain.PolicyStateActive {
+			return orgWriteModelToPasswordComplexityPolicy(policy), nil
+		}
+	}
 	return c.getDefaultPasswordComplexityPolicy(ctx)
 }
 
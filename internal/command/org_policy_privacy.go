@@ -17,6 +17,20 @@ func (c *Commands) getOrgPrivacyPolicy(ctx context.Context, orgID string) (*doma
 	if policy.State == domain.PolicyStateActive {
 		return orgWriteModelToPrivacyPolicy(policy), nil
 	}
+	// nested organizations inherit the policy of their nearest ancestor with a policy
+	ancestors, err := orgPolicyAncestorIDs(ctx, c.eventstore.Filter, orgID)
+	if err != nil {
+		return nil, err
+	}
+	for _, ancestor := range ancestors {
+		policy, err = c.orgPrivacyPolicyWriteModelByID(ctx, ancestor)
+		if err != nil {
+			return nil, err
+		}
+		if policy.State == domain.PolicyStateActive {
+			return orgWriteModelToPrivacyPolicy(policy), nil
+		}
+	}
 	return c.getDefaultPrivacyPolicy(ctx)
 }
 
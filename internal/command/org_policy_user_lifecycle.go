@@ -0,0 +1,94 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func (c *Commands) AddUserLifecyclePolicy(ctx context.Context, resourceOwner string, policy *domain.UserLifecyclePolicy) (*domain.UserLifecyclePolicy, error) {
+	if resourceOwner == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "Org-Gh3wq", "Errors.ResourceOwnerMissing")
+	}
+	if err := policy.IsValid(); err != nil {
+		return nil, err
+	}
+	addedPolicy := NewOrgUserLifecyclePolicyWriteModel(resourceOwner)
+	err := c.eventstore.FilterToQueryReducer(ctx, addedPolicy)
+	if err != nil {
+		return nil, err
+	}
+	if addedPolicy.State == domain.PolicyStateActive {
+		return nil, zerrors.ThrowAlreadyExists(nil, "ORG-sL7vb", "Errors.Org.UserLifecyclePolicy.AlreadyExists")
+	}
+
+	orgAgg := OrgAggregateFromWriteModel(&addedPolicy.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, org.NewUserLifecyclePolicyAddedEvent(ctx, orgAgg, policy.DeactivateAfterDays, policy.DeleteAfterDays, policy.WarnDaysBefore))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(addedPolicy, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToUserLifecyclePolicy(addedPolicy), nil
+}
+
+func (c *Commands) ChangeUserLifecyclePolicy(ctx context.Context, resourceOwner string, policy *domain.UserLifecyclePolicy) (*domain.UserLifecyclePolicy, error) {
+	if resourceOwner == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "Org-p0Vnd", "Errors.ResourceOwnerMissing")
+	}
+	if err := policy.IsValid(); err != nil {
+		return nil, err
+	}
+	existingPolicy := NewOrgUserLifecyclePolicyWriteModel(resourceOwner)
+	err := c.eventstore.FilterToQueryReducer(ctx, existingPolicy)
+	if err != nil {
+		return nil, err
+	}
+	if existingPolicy.State == domain.PolicyStateUnspecified || existingPolicy.State == domain.PolicyStateRemoved {
+		return nil, zerrors.ThrowNotFound(nil, "ORG-Q2xnd", "Errors.Org.UserLifecyclePolicy.NotFound")
+	}
+
+	orgAgg := OrgAggregateFromWriteModel(&existingPolicy.WriteModel)
+	changedEvent, hasChanged := existingPolicy.NewChangedEvent(ctx, orgAgg, policy.DeactivateAfterDays, policy.DeleteAfterDays, policy.WarnDaysBefore)
+	if !hasChanged {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "Org-Zk4mf", "Errors.Org.UserLifecyclePolicy.NotChanged")
+	}
+
+	pushedEvents, err := c.eventstore.Push(ctx, changedEvent)
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingPolicy, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToUserLifecyclePolicy(existingPolicy), nil
+}
+
+func (c *Commands) RemoveUserLifecyclePolicy(ctx context.Context, orgID string) (*domain.ObjectDetails, error) {
+	if orgID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "Org-Ba8ws", "Errors.ResourceOwnerMissing")
+	}
+	existingPolicy := NewOrgUserLifecyclePolicyWriteModel(orgID)
+	err := c.eventstore.FilterToQueryReducer(ctx, existingPolicy)
+	if err != nil {
+		return nil, err
+	}
+	if existingPolicy.State == domain.PolicyStateUnspecified || existingPolicy.State == domain.PolicyStateRemoved {
+		return nil, zerrors.ThrowNotFound(nil, "ORG-u5Rjm", "Errors.Org.UserLifecyclePolicy.NotFound")
+	}
+	orgAgg := OrgAggregateFromWriteModel(&existingPolicy.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, org.NewUserLifecyclePolicyRemovedEvent(ctx, orgAgg))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingPolicy, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingPolicy.WriteModel), nil
+}
@@ -0,0 +1,115 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/policy"
+)
+
+type OrgUserLifecyclePolicyWriteModel struct {
+	eventstore.WriteModel
+
+	DeactivateAfterDays uint64
+	DeleteAfterDays     uint64
+	WarnDaysBefore      uint64
+	State               domain.PolicyState
+}
+
+func NewOrgUserLifecyclePolicyWriteModel(orgID string) *OrgUserLifecyclePolicyWriteModel {
+	return &OrgUserLifecyclePolicyWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   orgID,
+			ResourceOwner: orgID,
+		},
+	}
+}
+
+func (wm *OrgUserLifecyclePolicyWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *org.UserLifecyclePolicyAddedEvent:
+			wm.WriteModel.AppendEvents(&e.UserLifecyclePolicyAddedEvent)
+		case *org.UserLifecyclePolicyChangedEvent:
+			wm.WriteModel.AppendEvents(&e.UserLifecyclePolicyChangedEvent)
+		case *org.UserLifecyclePolicyRemovedEvent:
+			wm.WriteModel.AppendEvents(&e.UserLifecyclePolicyRemovedEvent)
+		}
+	}
+}
+
+func (wm *OrgUserLifecyclePolicyWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *policy.UserLifecyclePolicyAddedEvent:
+			wm.DeactivateAfterDays = e.DeactivateAfterDays
+			wm.DeleteAfterDays = e.DeleteAfterDays
+			wm.WarnDaysBefore = e.WarnDaysBefore
+			wm.State = domain.PolicyStateActive
+		case *policy.UserLifecyclePolicyChangedEvent:
+			if e.DeactivateAfterDays != nil {
+				wm.DeactivateAfterDays = *e.DeactivateAfterDays
+			}
+			if e.DeleteAfterDays != nil {
+				wm.DeleteAfterDays = *e.DeleteAfterDays
+			}
+			if e.WarnDaysBefore != nil {
+				wm.WarnDaysBefore = *e.WarnDaysBefore
+			}
+		case *policy.UserLifecyclePolicyRemovedEvent:
+			wm.State = domain.PolicyStateRemoved
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *OrgUserLifecyclePolicyWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(org.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			org.UserLifecyclePolicyAddedEventType,
+			org.UserLifecyclePolicyChangedEventType,
+			org.UserLifecyclePolicyRemovedEventType).
+		Builder()
+}
+
+func (wm *OrgUserLifecyclePolicyWriteModel) NewChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	deactivateAfterDays,
+	deleteAfterDays,
+	warnDaysBefore uint64,
+) (*org.UserLifecyclePolicyChangedEvent, bool) {
+	changes := make([]policy.UserLifecyclePolicyChanges, 0)
+	if wm.DeactivateAfterDays != deactivateAfterDays {
+		changes = append(changes, policy.ChangeDeactivateAfterDays(deactivateAfterDays))
+	}
+	if wm.DeleteAfterDays != deleteAfterDays {
+		changes = append(changes, policy.ChangeDeleteAfterDays(deleteAfterDays))
+	}
+	if wm.WarnDaysBefore != warnDaysBefore {
+		changes = append(changes, policy.ChangeWarnDaysBefore(warnDaysBefore))
+	}
+	if len(changes) == 0 {
+		return nil, false
+	}
+	changedEvent, err := org.NewUserLifecyclePolicyChangedEvent(ctx, aggregate, changes)
+	if err != nil {
+		return nil, false
+	}
+	return changedEvent, true
+}
+
+func writeModelToUserLifecyclePolicy(wm *OrgUserLifecyclePolicyWriteModel) *domain.UserLifecyclePolicy {
+	return &domain.UserLifecyclePolicy{
+		ObjectRoot:          writeModelToObjectRoot(wm.WriteModel),
+		DeactivateAfterDays: wm.DeactivateAfterDays,
+		DeleteAfterDays:     wm.DeleteAfterDays,
+		WarnDaysBefore:      wm.WarnDaysBefore,
+	}
+}
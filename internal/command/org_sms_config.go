@@ -0,0 +1,320 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/notification/channels/twilio"
+	"github.com/zitadel/zitadel/internal/notification/channels/webhook"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// AddOrgSMSConfigTwilio adds an inactive Twilio SMS provider to the organization.
+// As long as the organization has no active provider, the one of the instance is used.
+func (c *Commands) AddOrgSMSConfigTwilio(ctx context.Context, orgID string, config *twilio.Config) (string, *domain.ObjectDetails, error) {
+	if orgID == "" {
+		return "", nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Dk3wl", "Errors.ResourceOwnerMissing")
+	}
+	id, err := c.idGenerator.Next()
+	if err != nil {
+		return "", nil, err
+	}
+	smsConfigWriteModel, err := c.getOrgSMSConfig(ctx, orgID, id)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var token *crypto.CryptoValue
+	if config.Token != "" {
+		token, err = crypto.Encrypt([]byte(config.Token), c.smsEncryption)
+		if err != nil {
+			return "", nil, err
+		}
+	}
+
+	orgAgg := OrgAggregateFromWriteModel(&smsConfigWriteModel.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, org.NewSMSConfigTwilioAddedEvent(
+		ctx,
+		orgAgg,
+		id,
+		config.SID,
+		config.SenderNumber,
+		token))
+	if err != nil {
+		return "", nil, err
+	}
+	err = AppendAndReduce(smsConfigWriteModel, pushedEvents...)
+	if err != nil {
+		return "", nil, err
+	}
+	return id, writeModelToObjectDetails(&smsConfigWriteModel.WriteModel), nil
+}
+
+func (c *Commands) ChangeOrgSMSConfigTwilio(ctx context.Context, orgID, id string, config *twilio.Config) (*domain.ObjectDetails, error) {
+	if orgID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Lr8sq", "Errors.ResourceOwnerMissing")
+	}
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Vb2xm", "Errors.IDMissing")
+	}
+	smsConfigWriteModel, err := c.getOrgSMSConfig(ctx, orgID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !smsConfigWriteModel.State.Exists() || smsConfigWriteModel.Twilio == nil {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Hc6ty", "Errors.SMSConfig.NotFound")
+	}
+	orgAgg := OrgAggregateFromWriteModel(&smsConfigWriteModel.WriteModel)
+
+	changedEvent, hasChanged, err := smsConfigWriteModel.NewChangedEvent(
+		ctx,
+		orgAgg,
+		id,
+		config.SID,
+		config.SenderNumber)
+	if err != nil {
+		return nil, err
+	}
+	if !hasChanged {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ej4po", "Errors.NoChangesFound")
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, changedEvent)
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(smsConfigWriteModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&smsConfigWriteModel.WriteModel), nil
+}
+
+func (c *Commands) ChangeOrgSMSConfigTwilioToken(ctx context.Context, orgID, id, token string) (*domain.ObjectDetails, error) {
+	if orgID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Wy9an", "Errors.ResourceOwnerMissing")
+	}
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Pf1cs", "Errors.IDMissing")
+	}
+	smsConfigWriteModel, err := c.getOrgSMSConfig(ctx, orgID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !smsConfigWriteModel.State.Exists() || smsConfigWriteModel.Twilio == nil {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Qa5rk", "Errors.SMSConfig.NotFound")
+	}
+	orgAgg := OrgAggregateFromWriteModel(&smsConfigWriteModel.WriteModel)
+	newToken, err := crypto.Encrypt([]byte(token), c.smsEncryption)
+	if err != nil {
+		return nil, err
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, org.NewSMSConfigTokenChangedEvent(
+		ctx,
+		orgAgg,
+		id,
+		newToken))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(smsConfigWriteModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&smsConfigWriteModel.WriteModel), nil
+}
+
+func (c *Commands) AddOrgSMSConfigHTTP(ctx context.Context, orgID string, config *webhook.Config) (string, *domain.ObjectDetails, error) {
+	if orgID == "" {
+		return "", nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Nt7gd", "Errors.ResourceOwnerMissing")
+	}
+	endpoint, err := validateHTTPProviderEndpoint(config.CallURL)
+	if err != nil {
+		return "", nil, err
+	}
+	id, err := c.idGenerator.Next()
+	if err != nil {
+		return "", nil, err
+	}
+	smsConfigWriteModel, err := c.getOrgSMSConfig(ctx, orgID, id)
+	if err != nil {
+		return "", nil, err
+	}
+	headers, signingKey, err := encryptHTTPProviderSecrets(config, c.smsEncryption)
+	if err != nil {
+		return "", nil, err
+	}
+
+	orgAgg := OrgAggregateFromWriteModel(&smsConfigWriteModel.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, org.NewSMSConfigHTTPAddedEvent(
+		ctx,
+		orgAgg,
+		id,
+		endpoint,
+		headers,
+		signingKey))
+	if err != nil {
+		return "", nil, err
+	}
+	err = AppendAndReduce(smsConfigWriteModel, pushedEvents...)
+	if err != nil {
+		return "", nil, err
+	}
+	return id, writeModelToObjectDetails(&smsConfigWriteModel.WriteModel), nil
+}
+
+// ChangeOrgSMSConfigHTTP changes the endpoint of the HTTP provider of the organization.
+// The headers and the signing key are only changed if they are passed.
+func (c *Commands) ChangeOrgSMSConfigHTTP(ctx context.Context, orgID, id string, config *webhook.Config) (*domain.ObjectDetails, error) {
+	if orgID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ux2mf", "Errors.ResourceOwnerMissing")
+	}
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Kd8hz", "Errors.IDMissing")
+	}
+	endpoint, err := validateHTTPProviderEndpoint(config.CallURL)
+	if err != nil {
+		return nil, err
+	}
+	smsConfigWriteModel, err := c.getOrgSMSConfig(ctx, orgID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !smsConfigWriteModel.State.Exists() || smsConfigWriteModel.HTTP == nil {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Zi3vb", "Errors.SMSConfig.NotFound")
+	}
+	headers, signingKey, err := encryptHTTPProviderSecrets(config, c.smsEncryption)
+	if err != nil {
+		return nil, err
+	}
+	orgAgg := OrgAggregateFromWriteModel(&smsConfigWriteModel.WriteModel)
+
+	changedEvent, hasChanged, err := smsConfigWriteModel.NewHTTPChangedEvent(
+		ctx,
+		orgAgg,
+		id,
+		endpoint,
+		headers,
+		signingKey)
+	if err != nil {
+		return nil, err
+	}
+	if !hasChanged {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Gm6ws", "Errors.NoChangesFound")
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, changedEvent)
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(smsConfigWriteModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&smsConfigWriteModel.WriteModel), nil
+}
+
+func (c *Commands) ActivateOrgSMSConfig(ctx context.Context, orgID, id string) (*domain.ObjectDetails, error) {
+	if orgID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ob4ra", "Errors.ResourceOwnerMissing")
+	}
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Sy7dn", "Errors.IDMissing")
+	}
+	smsConfigWriteModel, err := c.getOrgSMSConfig(ctx, orgID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !smsConfigWriteModel.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Ti9ek", "Errors.SMSConfig.NotFound")
+	}
+	if smsConfigWriteModel.State == domain.SMSConfigStateActive {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Bw2jq", "Errors.SMSConfig.AlreadyActive")
+	}
+
+	orgAgg := OrgAggregateFromWriteModel(&smsConfigWriteModel.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, org.NewSMSConfigActivatedEvent(
+		ctx,
+		orgAgg,
+		id))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(smsConfigWriteModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&smsConfigWriteModel.WriteModel), nil
+}
+
+func (c *Commands) DeactivateOrgSMSConfig(ctx context.Context, orgID, id string) (*domain.ObjectDetails, error) {
+	if orgID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Fe5lu", "Errors.ResourceOwnerMissing")
+	}
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Rk1cz", "Errors.IDMissing")
+	}
+	smsConfigWriteModel, err := c.getOrgSMSConfig(ctx, orgID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !smsConfigWriteModel.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Ah6pn", "Errors.SMSConfig.NotFound")
+	}
+	if smsConfigWriteModel.State == domain.SMSConfigStateInactive {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Jo3xw", "Errors.SMSConfig.AlreadyDeactivated")
+	}
+
+	orgAgg := OrgAggregateFromWriteModel(&smsConfigWriteModel.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, org.NewSMSConfigDeactivatedEvent(
+		ctx,
+		orgAgg,
+		id))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(smsConfigWriteModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&smsConfigWriteModel.WriteModel), nil
+}
+
+func (c *Commands) RemoveOrgSMSConfig(ctx context.Context, orgID, id string) (*domain.ObjectDetails, error) {
+	if orgID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Xc4hb", "Errors.ResourceOwnerMissing")
+	}
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Mv8gs", "Errors.IDMissing")
+	}
+	smsConfigWriteModel, err := c.getOrgSMSConfig(ctx, orgID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !smsConfigWriteModel.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Yd2ou", "Errors.SMSConfig.NotFound")
+	}
+
+	orgAgg := OrgAggregateFromWriteModel(&smsConfigWriteModel.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, org.NewSMSConfigRemovedEvent(
+		ctx,
+		orgAgg,
+		id))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(smsConfigWriteModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&smsConfigWriteModel.WriteModel), nil
+}
+
+func (c *Commands) getOrgSMSConfig(ctx context.Context, orgID, id string) (_ *OrgSMSConfigWriteModel, err error) {
+	writeModel := NewOrgSMSConfigWriteModel(orgID, id)
+	err = c.eventstore.FilterToQueryReducer(ctx, writeModel)
+	if err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
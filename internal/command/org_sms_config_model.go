@@ -0,0 +1,93 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
+)
+
+// OrgSMSConfigWriteModel reduces the SMS configuration of an organization.
+// The org events embed the payload of the instance events,
+// which is why the reduction of the instance write model is reused.
+type OrgSMSConfigWriteModel struct {
+	IAMSMSConfigWriteModel
+}
+
+func NewOrgSMSConfigWriteModel(orgID, id string) *OrgSMSConfigWriteModel {
+	return &OrgSMSConfigWriteModel{
+		IAMSMSConfigWriteModel: IAMSMSConfigWriteModel{
+			WriteModel: eventstore.WriteModel{
+				AggregateID:   orgID,
+				ResourceOwner: orgID,
+			},
+			ID: id,
+		},
+	}
+}
+
+func (wm *OrgSMSConfigWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *org.SMSConfigTwilioAddedEvent:
+			wm.WriteModel.AppendEvents(&e.SMSConfigTwilioAddedEvent)
+		case *org.SMSConfigTwilioChangedEvent:
+			wm.WriteModel.AppendEvents(&e.SMSConfigTwilioChangedEvent)
+		case *org.SMSConfigTwilioTokenChangedEvent:
+			wm.WriteModel.AppendEvents(&e.SMSConfigTwilioTokenChangedEvent)
+		case *org.SMSConfigHTTPAddedEvent:
+			wm.WriteModel.AppendEvents(&e.SMSConfigHTTPAddedEvent)
+		case *org.SMSConfigHTTPChangedEvent:
+			wm.WriteModel.AppendEvents(&e.SMSConfigHTTPChangedEvent)
+		case *org.SMSConfigActivatedEvent:
+			wm.WriteModel.AppendEvents(&e.SMSConfigActivatedEvent)
+		case *org.SMSConfigDeactivatedEvent:
+			wm.WriteModel.AppendEvents(&e.SMSConfigDeactivatedEvent)
+		case *org.SMSConfigRemovedEvent:
+			wm.WriteModel.AppendEvents(&e.SMSConfigRemovedEvent)
+		}
+	}
+}
+
+func (wm *OrgSMSConfigWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(org.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			org.SMSConfigTwilioAddedEventType,
+			org.SMSConfigTwilioChangedEventType,
+			org.SMSConfigTwilioTokenChangedEventType,
+			org.SMSConfigHTTPAddedEventType,
+			org.SMSConfigHTTPChangedEventType,
+			org.SMSConfigActivatedEventType,
+			org.SMSConfigDeactivatedEventType,
+			org.SMSConfigRemovedEventType).
+		Builder()
+}
+
+func (wm *OrgSMSConfigWriteModel) NewChangedEvent(ctx context.Context, aggregate *eventstore.Aggregate, id, sid, senderNumber string) (*org.SMSConfigTwilioChangedEvent, bool, error) {
+	changes := wm.twilioChanges(sid, senderNumber)
+	if len(changes) == 0 {
+		return nil, false, nil
+	}
+	changeEvent, err := org.NewSMSConfigTwilioChangedEvent(ctx, aggregate, id, changes)
+	if err != nil {
+		return nil, false, err
+	}
+	return changeEvent, true, nil
+}
+
+func (wm *OrgSMSConfigWriteModel) NewHTTPChangedEvent(ctx context.Context, aggregate *eventstore.Aggregate, id, endpoint string, headers, signingKey *crypto.CryptoValue) (*org.SMSConfigHTTPChangedEvent, bool, error) {
+	changes := wm.httpChanges(endpoint, headers, signingKey)
+	if len(changes) == 0 {
+		return nil, false, nil
+	}
+	changeEvent, err := org.NewSMSConfigHTTPChangedEvent(ctx, aggregate, id, changes)
+	if err != nil {
+		return nil, false, err
+	}
+	return changeEvent, true, nil
+}
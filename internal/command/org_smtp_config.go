@@ -0,0 +1,541 @@
+package command
+
+import (
+	"context"
+	"maps"
+	"net"
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/notification/channels/smtp"
+	"github.com/zitadel/zitadel/internal/notification/channels/webhook"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// AddOrgSMTPConfig adds an inactive SMTP configuration to the organization.
+// As long as the organization has no active configuration, the one of the instance is used.
+func (c *Commands) AddOrgSMTPConfig(ctx context.Context, orgID string, config *smtp.Config) (string, *domain.ObjectDetails, error) {
+	if orgID == "" {
+		return "", nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Sj2ma", "Errors.ResourceOwnerMissing")
+	}
+	id, err := c.idGenerator.Next()
+	if err != nil {
+		return "", nil, err
+	}
+
+	from := strings.TrimSpace(config.From)
+	if from == "" {
+		return "", nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Pa0ws", "Errors.Invalid.Argument")
+	}
+	fromSplitted := strings.Split(from, "@")
+	senderDomain := fromSplitted[len(fromSplitted)-1]
+	description := strings.TrimSpace(config.Description)
+	replyTo := strings.TrimSpace(config.ReplyToAddress)
+	hostAndPort := strings.TrimSpace(config.SMTP.Host)
+
+	if _, _, err := net.SplitHostPort(hostAndPort); err != nil {
+		return "", nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Vm4ld", "Errors.Invalid.Argument")
+	}
+
+	var smtpPassword *crypto.CryptoValue
+	if config.SMTP.Password != "" {
+		smtpPassword, err = crypto.Encrypt([]byte(config.SMTP.Password), c.smtpEncryption)
+		if err != nil {
+			return "", nil, err
+		}
+	}
+
+	if err = c.checkOrgSMTPSenderAddress(ctx, orgID, senderDomain); err != nil {
+		return "", nil, err
+	}
+
+	smtpConfigWriteModel, err := c.getOrgSMTPConfig(ctx, orgID, id)
+	if err != nil {
+		return "", nil, err
+	}
+
+	orgAgg := OrgAggregateFromWriteModel(&smtpConfigWriteModel.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, org.NewSMTPConfigAddedEvent(
+		ctx,
+		orgAgg,
+		id,
+		description,
+		config.Tls,
+		from,
+		config.FromName,
+		replyTo,
+		hostAndPort,
+		config.SMTP.User,
+		smtpPassword,
+	))
+	if err != nil {
+		return "", nil, err
+	}
+
+	err = AppendAndReduce(smtpConfigWriteModel, pushedEvents...)
+	if err != nil {
+		return "", nil, err
+	}
+	return id, writeModelToObjectDetails(&smtpConfigWriteModel.WriteModel), nil
+}
+
+func (c *Commands) ChangeOrgSMTPConfig(ctx context.Context, orgID, id string, config *smtp.Config) (*domain.ObjectDetails, error) {
+	if orgID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Xm3la", "Errors.ResourceOwnerMissing")
+	}
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Wq8vn", "Errors.IDMissing")
+	}
+
+	from := strings.TrimSpace(config.From)
+	if from == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Lq2xz", "Errors.Invalid.Argument")
+	}
+	fromSplitted := strings.Split(from, "@")
+	senderDomain := fromSplitted[len(fromSplitted)-1]
+	description := strings.TrimSpace(config.Description)
+	replyTo := strings.TrimSpace(config.ReplyToAddress)
+	hostAndPort := strings.TrimSpace(config.SMTP.Host)
+	if _, _, err := net.SplitHostPort(hostAndPort); err != nil {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Bd7ko", "Errors.Invalid.Argument")
+	}
+
+	var smtpPassword *crypto.CryptoValue
+	var err error
+	if config.SMTP.Password != "" {
+		smtpPassword, err = crypto.Encrypt([]byte(config.SMTP.Password), c.smtpEncryption)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	smtpConfigWriteModel, err := c.getOrgSMTPConfig(ctx, orgID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !smtpConfigWriteModel.State.Exists() || smtpConfigWriteModel.HTTPConfig != nil {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Fk29s", "Errors.SMTPConfig.NotFound")
+	}
+
+	if err = c.checkOrgSMTPSenderAddress(ctx, orgID, senderDomain); err != nil {
+		return nil, err
+	}
+
+	orgAgg := OrgAggregateFromWriteModel(&smtpConfigWriteModel.WriteModel)
+	changedEvent, hasChanged, err := smtpConfigWriteModel.NewChangedEvent(
+		ctx,
+		orgAgg,
+		id,
+		description,
+		config.Tls,
+		from,
+		config.FromName,
+		replyTo,
+		hostAndPort,
+		config.SMTP.User,
+		smtpPassword,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !hasChanged {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ox8bd", "Errors.NoChangesFound")
+	}
+
+	pushedEvents, err := c.eventstore.Push(ctx, changedEvent)
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(smtpConfigWriteModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&smtpConfigWriteModel.WriteModel), nil
+}
+
+func (c *Commands) ChangeOrgSMTPConfigPassword(ctx context.Context, orgID, id, password string) (*domain.ObjectDetails, error) {
+	if orgID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ue9pw", "Errors.ResourceOwnerMissing")
+	}
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Gs3mb", "Errors.IDMissing")
+	}
+	smtpConfigWriteModel, err := c.getOrgSMTPConfig(ctx, orgID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !smtpConfigWriteModel.State.Exists() || smtpConfigWriteModel.HTTPConfig != nil {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Rz5ye", "Errors.SMTPConfig.NotFound")
+	}
+
+	var smtpPassword *crypto.CryptoValue
+	if password != "" {
+		smtpPassword, err = crypto.Encrypt([]byte(password), c.smtpEncryption)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	orgAgg := OrgAggregateFromWriteModel(&smtpConfigWriteModel.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, org.NewSMTPConfigPasswordChangedEvent(
+		ctx,
+		orgAgg,
+		id,
+		smtpPassword))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(smtpConfigWriteModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&smtpConfigWriteModel.WriteModel), nil
+}
+
+func (c *Commands) AddOrgSMTPConfigHTTP(ctx context.Context, orgID, description string, config *webhook.Config) (string, *domain.ObjectDetails, error) {
+	if orgID == "" {
+		return "", nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ha8re", "Errors.ResourceOwnerMissing")
+	}
+	endpoint, err := validateHTTPProviderEndpoint(config.CallURL)
+	if err != nil {
+		return "", nil, err
+	}
+	id, err := c.idGenerator.Next()
+	if err != nil {
+		return "", nil, err
+	}
+	headers, signingKey, err := encryptHTTPProviderSecrets(config, c.smtpEncryption)
+	if err != nil {
+		return "", nil, err
+	}
+
+	smtpConfigWriteModel, err := c.getOrgSMTPConfig(ctx, orgID, id)
+	if err != nil {
+		return "", nil, err
+	}
+
+	orgAgg := OrgAggregateFromWriteModel(&smtpConfigWriteModel.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, org.NewSMTPConfigHTTPAddedEvent(
+		ctx,
+		orgAgg,
+		id,
+		strings.TrimSpace(description),
+		endpoint,
+		headers,
+		signingKey,
+	))
+	if err != nil {
+		return "", nil, err
+	}
+
+	err = AppendAndReduce(smtpConfigWriteModel, pushedEvents...)
+	if err != nil {
+		return "", nil, err
+	}
+	return id, writeModelToObjectDetails(&smtpConfigWriteModel.WriteModel), nil
+}
+
+// ChangeOrgSMTPConfigHTTP changes the description and endpoint of the HTTP email provider of the organization.
+// The headers and the signing key are only changed if they are passed.
+func (c *Commands) ChangeOrgSMTPConfigHTTP(ctx context.Context, orgID, id, description string, config *webhook.Config) (*domain.ObjectDetails, error) {
+	if orgID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Nq3ps", "Errors.ResourceOwnerMissing")
+	}
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Jt6wd", "Errors.IDMissing")
+	}
+	endpoint, err := validateHTTPProviderEndpoint(config.CallURL)
+	if err != nil {
+		return nil, err
+	}
+	headers, signingKey, err := encryptHTTPProviderSecrets(config, c.smtpEncryption)
+	if err != nil {
+		return nil, err
+	}
+
+	smtpConfigWriteModel, err := c.getOrgSMTPConfig(ctx, orgID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !smtpConfigWriteModel.State.Exists() || smtpConfigWriteModel.HTTPConfig == nil {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Ep4bx", "Errors.SMTPConfig.NotFound")
+	}
+
+	orgAgg := OrgAggregateFromWriteModel(&smtpConfigWriteModel.WriteModel)
+	changedEvent, hasChanged, err := smtpConfigWriteModel.NewHTTPChangedEvent(
+		ctx,
+		orgAgg,
+		id,
+		strings.TrimSpace(description),
+		endpoint,
+		headers,
+		signingKey,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !hasChanged {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Yk2rv", "Errors.NoChangesFound")
+	}
+
+	pushedEvents, err := c.eventstore.Push(ctx, changedEvent)
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(smtpConfigWriteModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&smtpConfigWriteModel.WriteModel), nil
+}
+
+// ActivateOrgSMTPConfig activates the SMTP configuration of the organization.
+// If activatedID is passed, the currently active configuration is deactivated first.
+func (c *Commands) ActivateOrgSMTPConfig(ctx context.Context, orgID, id, activatedID string) (*domain.ObjectDetails, error) {
+	if orgID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Cv9rd", "Errors.ResourceOwnerMissing")
+	}
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Tu3kl", "Errors.IDMissing")
+	}
+
+	if len(activatedID) > 0 {
+		_, err := c.DeactivateOrgSMTPConfig(ctx, orgID, activatedID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	smtpConfigWriteModel, err := c.getOrgSMTPConfig(ctx, orgID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !smtpConfigWriteModel.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Ql5vn", "Errors.SMTPConfig.NotFound")
+	}
+	if smtpConfigWriteModel.State == domain.SMTPConfigStateActive {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Zs2ox", "Errors.SMTPConfig.AlreadyActive")
+	}
+
+	orgAgg := OrgAggregateFromWriteModel(&smtpConfigWriteModel.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, org.NewSMTPConfigActivatedEvent(
+		ctx,
+		orgAgg,
+		id))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(smtpConfigWriteModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&smtpConfigWriteModel.WriteModel), nil
+}
+
+func (c *Commands) DeactivateOrgSMTPConfig(ctx context.Context, orgID, id string) (*domain.ObjectDetails, error) {
+	if orgID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Mb6wq", "Errors.ResourceOwnerMissing")
+	}
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Aw1gu", "Errors.IDMissing")
+	}
+
+	smtpConfigWriteModel, err := c.getOrgSMTPConfig(ctx, orgID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !smtpConfigWriteModel.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Ib8eh", "Errors.SMTPConfig.NotFound")
+	}
+	if smtpConfigWriteModel.State == domain.SMTPConfigStateInactive {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Dp0tz", "Errors.SMTPConfig.AlreadyDeactivated")
+	}
+
+	orgAgg := OrgAggregateFromWriteModel(&smtpConfigWriteModel.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, org.NewSMTPConfigDeactivatedEvent(
+		ctx,
+		orgAgg,
+		id))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(smtpConfigWriteModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&smtpConfigWriteModel.WriteModel), nil
+}
+
+func (c *Commands) RemoveOrgSMTPConfig(ctx context.Context, orgID, id string) (*domain.ObjectDetails, error) {
+	if orgID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ro4jc", "Errors.ResourceOwnerMissing")
+	}
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Gz7fy", "Errors.IDMissing")
+	}
+
+	smtpConfigWriteModel, err := c.getOrgSMTPConfig(ctx, orgID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !smtpConfigWriteModel.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Vf3ni", "Errors.SMTPConfig.NotFound")
+	}
+
+	orgAgg := OrgAggregateFromWriteModel(&smtpConfigWriteModel.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, org.NewSMTPConfigRemovedEvent(
+		ctx,
+		orgAgg,
+		id))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(smtpConfigWriteModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&smtpConfigWriteModel.WriteModel), nil
+}
+
+// SetOrgSMTPConfigDKIM sets the domain, selector and private key used to sign the messages
+// sent through the SMTP configuration of the organization.
+func (c *Commands) SetOrgSMTPConfigDKIM(ctx context.Context, orgID, id string, dkim *smtp.DKIM) (*domain.ObjectDetails, error) {
+	if orgID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Dk6ro", "Errors.ResourceOwnerMissing")
+	}
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Dk7id", "Errors.IDMissing")
+	}
+	if err := dkim.Validate(); err != nil {
+		return nil, err
+	}
+	smtpConfigWriteModel, err := c.getOrgSMTPConfig(ctx, orgID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !smtpConfigWriteModel.State.Exists() || smtpConfigWriteModel.HTTPConfig != nil {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Dk8nf", "Errors.SMTPConfig.NotFound")
+	}
+	privateKey, err := crypto.Encrypt([]byte(dkim.PrivateKey), c.smtpEncryption)
+	if err != nil {
+		return nil, err
+	}
+
+	pushedEvents, err := c.eventstore.Push(ctx, org.NewSMTPConfigDKIMSetEvent(
+		ctx,
+		OrgAggregateFromWriteModel(&smtpConfigWriteModel.WriteModel),
+		id,
+		dkim.Domain,
+		dkim.Selector,
+		privateKey))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(smtpConfigWriteModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&smtpConfigWriteModel.WriteModel), nil
+}
+
+// RemoveOrgSMTPConfigDKIM stops the signing of the messages sent through the SMTP configuration of the organization.
+func (c *Commands) RemoveOrgSMTPConfigDKIM(ctx context.Context, orgID, id string) (*domain.ObjectDetails, error) {
+	if orgID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Dk9ro", "Errors.ResourceOwnerMissing")
+	}
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Dl1id", "Errors.IDMissing")
+	}
+	smtpConfigWriteModel, err := c.getOrgSMTPConfig(ctx, orgID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !smtpConfigWriteModel.State.Exists() || smtpConfigWriteModel.HTTPConfig != nil {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Dl2nf", "Errors.SMTPConfig.NotFound")
+	}
+	if smtpConfigWriteModel.DKIMPrivateKey == nil {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Dl3nf", "Errors.SMTPConfig.DKIMNotFound")
+	}
+
+	pushedEvents, err := c.eventstore.Push(ctx, org.NewSMTPConfigDKIMRemovedEvent(
+		ctx,
+		OrgAggregateFromWriteModel(&smtpConfigWriteModel.WriteModel),
+		id))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(smtpConfigWriteModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&smtpConfigWriteModel.WriteModel), nil
+}
+
+// SetOrgSMTPConfigHeaders replaces the headers added to the non-critical messages
+// sent through the SMTP configuration of the organization, e.g. List-Unsubscribe.
+func (c *Commands) SetOrgSMTPConfigHeaders(ctx context.Context, orgID, id string, headers map[string]string) (*domain.ObjectDetails, error) {
+	if orgID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Hd4ro", "Errors.ResourceOwnerMissing")
+	}
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Hd5id", "Errors.IDMissing")
+	}
+	if err := smtp.ValidateHeaders(headers); err != nil {
+		return nil, err
+	}
+	smtpConfigWriteModel, err := c.getOrgSMTPConfig(ctx, orgID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !smtpConfigWriteModel.State.Exists() || smtpConfigWriteModel.HTTPConfig != nil {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Hd6nf", "Errors.SMTPConfig.NotFound")
+	}
+	if maps.Equal(smtpConfigWriteModel.Headers, headers) {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Hd7nc", "Errors.NoChangesFound")
+	}
+
+	pushedEvents, err := c.eventstore.Push(ctx, org.NewSMTPConfigHeadersSetEvent(
+		ctx,
+		OrgAggregateFromWriteModel(&smtpConfigWriteModel.WriteModel),
+		id,
+		headers))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(smtpConfigWriteModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&smtpConfigWriteModel.WriteModel), nil
+}
+
+// checkOrgSMTPSenderAddress ensures the sender address uses a verified domain of the organization,
+// if required by the domain policy of the organization.
+func (c *Commands) checkOrgSMTPSenderAddress(ctx context.Context, orgID, senderDomain string) error {
+	policy, err := c.getOrgDomainPolicy(ctx, orgID)
+	if err != nil {
+		return err
+	}
+	if !policy.SMTPSenderAddressMatchesInstanceDomain {
+		return nil
+	}
+	domainWriteModel, err := c.getOrgDomainWriteModel(ctx, orgID, senderDomain)
+	if err != nil {
+		return err
+	}
+	if !domainWriteModel.Verified {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Ko2sd", "Errors.SMTPConfig.SenderAdressNotCustomDomain")
+	}
+	return nil
+}
+
+func (c *Commands) getOrgSMTPConfig(ctx context.Context, orgID, id string) (writeModel *OrgSMTPConfigWriteModel, err error) {
+	writeModel = NewOrgSMTPConfigWriteModel(orgID, id)
+	err = c.eventstore.FilterToQueryReducer(ctx, writeModel)
+	if err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
@@ -0,0 +1,102 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
+)
+
+// OrgSMTPConfigWriteModel reduces the SMTP configuration of an organization.
+// The org events embed the payload of the instance events,
+// which is why the reduction of the instance write model is reused.
+type OrgSMTPConfigWriteModel struct {
+	IAMSMTPConfigWriteModel
+}
+
+func NewOrgSMTPConfigWriteModel(orgID, id string) *OrgSMTPConfigWriteModel {
+	return &OrgSMTPConfigWriteModel{
+		IAMSMTPConfigWriteModel: IAMSMTPConfigWriteModel{
+			WriteModel: eventstore.WriteModel{
+				AggregateID:   orgID,
+				ResourceOwner: orgID,
+			},
+			ID: id,
+		},
+	}
+}
+
+func (wm *OrgSMTPConfigWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *org.SMTPConfigAddedEvent:
+			wm.WriteModel.AppendEvents(&e.SMTPConfigAddedEvent)
+		case *org.SMTPConfigChangedEvent:
+			wm.WriteModel.AppendEvents(&e.SMTPConfigChangedEvent)
+		case *org.SMTPConfigPasswordChangedEvent:
+			wm.WriteModel.AppendEvents(&e.SMTPConfigPasswordChangedEvent)
+		case *org.SMTPConfigHTTPAddedEvent:
+			wm.WriteModel.AppendEvents(&e.SMTPConfigHTTPAddedEvent)
+		case *org.SMTPConfigHTTPChangedEvent:
+			wm.WriteModel.AppendEvents(&e.SMTPConfigHTTPChangedEvent)
+		case *org.SMTPConfigDKIMSetEvent:
+			wm.WriteModel.AppendEvents(&e.SMTPConfigDKIMSetEvent)
+		case *org.SMTPConfigDKIMRemovedEvent:
+			wm.WriteModel.AppendEvents(&e.SMTPConfigDKIMRemovedEvent)
+		case *org.SMTPConfigHeadersSetEvent:
+			wm.WriteModel.AppendEvents(&e.SMTPConfigHeadersSetEvent)
+		case *org.SMTPConfigActivatedEvent:
+			wm.WriteModel.AppendEvents(&e.SMTPConfigActivatedEvent)
+		case *org.SMTPConfigDeactivatedEvent:
+			wm.WriteModel.AppendEvents(&e.SMTPConfigDeactivatedEvent)
+		case *org.SMTPConfigRemovedEvent:
+			wm.WriteModel.AppendEvents(&e.SMTPConfigRemovedEvent)
+		}
+	}
+}
+
+func (wm *OrgSMTPConfigWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(org.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			org.SMTPConfigAddedEventType,
+			org.SMTPConfigChangedEventType,
+			org.SMTPConfigPasswordChangedEventType,
+			org.SMTPConfigHTTPAddedEventType,
+			org.SMTPConfigHTTPChangedEventType,
+			org.SMTPConfigDKIMSetEventType,
+			org.SMTPConfigDKIMRemovedEventType,
+			org.SMTPConfigHeadersSetEventType,
+			org.SMTPConfigActivatedEventType,
+			org.SMTPConfigDeactivatedEventType,
+			org.SMTPConfigRemovedEventType).
+		Builder()
+}
+
+func (wm *OrgSMTPConfigWriteModel) NewChangedEvent(ctx context.Context, aggregate *eventstore.Aggregate, id, description string, tls bool, fromAddress, fromName, replyToAddress, smtpHost, smtpUser string, smtpPassword *crypto.CryptoValue) (*org.SMTPConfigChangedEvent, bool, error) {
+	changes := wm.changes(id, description, tls, fromAddress, fromName, replyToAddress, smtpHost, smtpUser, smtpPassword)
+	if len(changes) == 0 {
+		return nil, false, nil
+	}
+	changeEvent, err := org.NewSMTPConfigChangeEvent(ctx, aggregate, id, changes)
+	if err != nil {
+		return nil, false, err
+	}
+	return changeEvent, true, nil
+}
+
+func (wm *OrgSMTPConfigWriteModel) NewHTTPChangedEvent(ctx context.Context, aggregate *eventstore.Aggregate, id, description, endpoint string, headers, signingKey *crypto.CryptoValue) (*org.SMTPConfigHTTPChangedEvent, bool, error) {
+	changes := wm.httpChanges(description, endpoint, headers, signingKey)
+	if len(changes) == 0 {
+		return nil, false, nil
+	}
+	changeEvent, err := org.NewSMTPConfigHTTPChangedEvent(ctx, aggregate, id, changes)
+	if err != nil {
+		return nil, false, err
+	}
+	return changeEvent, true, nil
+}
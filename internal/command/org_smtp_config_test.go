@@ -0,0 +1,922 @@
+package command
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/id"
+	id_mock "github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/notification/channels/smtp"
+	"github.com/zitadel/zitadel/internal/notification/channels/webhook"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommandSide_AddOrgSMTPConfig(t *testing.T) {
+	type fields struct {
+		eventstore  *eventstore.Eventstore
+		idGenerator id.Generator
+		alg         crypto.EncryptionAlgorithm
+	}
+	type args struct {
+		ctx   context.Context
+		orgID string
+		smtp  *smtp.Config
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "org id missing, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args: args{
+				ctx:  context.Background(),
+				smtp: &smtp.Config{},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "sender domain not verified, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							org.NewDomainPolicyAddedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								true, true, true,
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewDomainAddedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								"domain.ch",
+							),
+						),
+					),
+				),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "configid"),
+				alg:         crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+				smtp: &smtp.Config{
+					From: "from@domain.ch",
+					SMTP: smtp.SMTP{
+						Host: "host:587",
+					},
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "add smtp config, sender domain verified, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							org.NewDomainPolicyAddedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								true, true, true,
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewDomainAddedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								"domain.ch",
+							),
+						),
+						eventFromEventPusher(
+							org.NewDomainVerifiedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								"domain.ch",
+							),
+						),
+					),
+					expectFilter(),
+					expectPush(
+						org.NewSMTPConfigAddedEvent(
+							context.Background(),
+							&org.NewAggregate("org1").Aggregate,
+							"configid",
+							"test",
+							true,
+							"from@domain.ch",
+							"name",
+							"replyto@domain.ch",
+							"host:587",
+							"user",
+							&crypto.CryptoValue{
+								CryptoType: crypto.TypeEncryption,
+								Algorithm:  "enc",
+								KeyID:      "id",
+								Crypted:    []byte("password"),
+							},
+						),
+					),
+				),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "configid"),
+				alg:         crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+				smtp: &smtp.Config{
+					Description:    "test",
+					Tls:            true,
+					From:           "from@domain.ch",
+					FromName:       "name",
+					ReplyToAddress: "replyto@domain.ch",
+					SMTP: smtp.SMTP{
+						Host:     "host:587",
+						User:     "user",
+						Password: "password",
+					},
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			name: "add smtp config, default policy without domain check, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(),
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewDomainPolicyAddedEvent(context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								true, true, false,
+							),
+						),
+					),
+					expectFilter(),
+					expectPush(
+						org.NewSMTPConfigAddedEvent(
+							context.Background(),
+							&org.NewAggregate("org1").Aggregate,
+							"configid",
+							"test",
+							true,
+							"from@domain.ch",
+							"name",
+							"",
+							"host:587",
+							"user",
+							nil,
+						),
+					),
+				),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "configid"),
+				alg:         crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+				smtp: &smtp.Config{
+					Description: "test",
+					Tls:         true,
+					From:        "from@domain.ch",
+					FromName:    "name",
+					SMTP: smtp.SMTP{
+						Host: "host:587",
+						User: "user",
+					},
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:     tt.fields.eventstore,
+				idGenerator:    tt.fields.idGenerator,
+				smtpEncryption: tt.fields.alg,
+			}
+			_, got, err := r.AddOrgSMTPConfig(tt.args.ctx, tt.args.orgID, tt.args.smtp)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_ChangeOrgSMTPConfigHTTP(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+		alg        crypto.EncryptionAlgorithm
+	}
+	type args struct {
+		ctx         context.Context
+		orgID       string
+		id          string
+		description string
+		config      *webhook.Config
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "smtp config not existing, not found error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(),
+				),
+				alg: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args: args{
+				ctx:    context.Background(),
+				orgID:  "org1",
+				id:     "configid",
+				config: &webhook.Config{CallURL: "https://endpoint.com"},
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "smtp config of other type, not found error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							org.NewSMTPConfigAddedEvent(
+								context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								"configid",
+								"test",
+								true,
+								"from@domain.ch",
+								"name",
+								"",
+								"host:587",
+								"user",
+								nil,
+							),
+						),
+					),
+				),
+				alg: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args: args{
+				ctx:    context.Background(),
+				orgID:  "org1",
+				id:     "configid",
+				config: &webhook.Config{CallURL: "https://endpoint.com"},
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "change smtp config http, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							org.NewSMTPConfigHTTPAddedEvent(
+								context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								"configid",
+								"test",
+								"https://endpoint.com",
+								nil,
+								nil,
+							),
+						),
+					),
+					expectPush(
+						func() eventstore.Command {
+							event, _ := org.NewSMTPConfigHTTPChangedEvent(
+								context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								"configid",
+								[]instance.SMTPConfigHTTPChanges{
+									instance.ChangeSMTPConfigHTTPDescription("changed"),
+									instance.ChangeSMTPConfigHTTPEndpoint("https://changed.com"),
+								},
+							)
+							return event
+						}(),
+					),
+				),
+				alg: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args: args{
+				ctx:         context.Background(),
+				orgID:       "org1",
+				id:          "configid",
+				description: "changed",
+				config:      &webhook.Config{CallURL: "https://changed.com"},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:     tt.fields.eventstore,
+				smtpEncryption: tt.fields.alg,
+			}
+			got, err := r.ChangeOrgSMTPConfigHTTP(tt.args.ctx, tt.args.orgID, tt.args.id, tt.args.description, tt.args.config)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_ActivateOrgSMTPConfig(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx         context.Context
+		orgID       string
+		id          string
+		activatedID string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "id empty, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "smtp config not existing, not found error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+				id:    "configid",
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "activate smtp config, deactivate previous, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							org.NewSMTPConfigHTTPAddedEvent(
+								context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								"previousid",
+								"previous",
+								"https://endpoint.com",
+								nil,
+								nil,
+							),
+						),
+						eventFromEventPusher(
+							org.NewSMTPConfigActivatedEvent(
+								context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								"previousid",
+							),
+						),
+					),
+					expectPush(
+						org.NewSMTPConfigDeactivatedEvent(
+							context.Background(),
+							&org.NewAggregate("org1").Aggregate,
+							"previousid",
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewSMTPConfigHTTPAddedEvent(
+								context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								"configid",
+								"test",
+								"https://endpoint.com",
+								nil,
+								nil,
+							),
+						),
+					),
+					expectPush(
+						org.NewSMTPConfigActivatedEvent(
+							context.Background(),
+							&org.NewAggregate("org1").Aggregate,
+							"configid",
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:         context.Background(),
+				orgID:       "org1",
+				id:          "configid",
+				activatedID: "previousid",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			got, err := r.ActivateOrgSMTPConfig(tt.args.ctx, tt.args.orgID, tt.args.id, tt.args.activatedID)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_RemoveOrgSMTPConfig(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx   context.Context
+		orgID string
+		id    string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "smtp config removed, not found error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							org.NewSMTPConfigHTTPAddedEvent(
+								context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								"configid",
+								"test",
+								"https://endpoint.com",
+								nil,
+								nil,
+							),
+						),
+						eventFromEventPusher(
+							org.NewSMTPConfigRemovedEvent(
+								context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								"configid",
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+				id:    "configid",
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "remove smtp config, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							org.NewSMTPConfigHTTPAddedEvent(
+								context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								"configid",
+								"test",
+								"https://endpoint.com",
+								nil,
+								nil,
+							),
+						),
+					),
+					expectPush(
+						org.NewSMTPConfigRemovedEvent(
+							context.Background(),
+							&org.NewAggregate("org1").Aggregate,
+							"configid",
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+				id:    "configid",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			got, err := r.RemoveOrgSMTPConfig(tt.args.ctx, tt.args.orgID, tt.args.id)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_SetOrgSMTPConfigDKIM(t *testing.T) {
+	privateKey := string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: testDKIMKey(t)}))
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+		alg        crypto.EncryptionAlgorithm
+	}
+	type args struct {
+		ctx   context.Context
+		orgID string
+		id    string
+		dkim  *smtp.DKIM
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "private key invalid, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+				id:    "configid",
+				dkim: &smtp.DKIM{
+					Domain:     "example.com",
+					Selector:   "zitadel",
+					PrivateKey: "invalid",
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "http config, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewSMTPConfigHTTPAddedEvent(
+								context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								"configid",
+								"test",
+								"https://endpoint.com",
+								nil,
+								nil,
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+				id:    "configid",
+				dkim: &smtp.DKIM{
+					Domain:     "example.com",
+					Selector:   "zitadel",
+					PrivateKey: privateKey,
+				},
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "set dkim, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewSMTPConfigAddedEvent(
+								context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								"configid",
+								"test",
+								true,
+								"from@example.com",
+								"name",
+								"",
+								"host:587",
+								"user",
+								nil,
+							),
+						),
+					),
+					expectPush(
+						org.NewSMTPConfigDKIMSetEvent(
+							context.Background(),
+							&org.NewAggregate("org1").Aggregate,
+							"configid",
+							"example.com",
+							"zitadel",
+							&crypto.CryptoValue{
+								CryptoType: crypto.TypeEncryption,
+								Algorithm:  "enc",
+								KeyID:      "id",
+								Crypted:    []byte(privateKey),
+							},
+						),
+					),
+				),
+				alg: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+				id:    "configid",
+				dkim: &smtp.DKIM{
+					Domain:     "example.com",
+					Selector:   "zitadel",
+					PrivateKey: privateKey,
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:     tt.fields.eventstore(t),
+				smtpEncryption: tt.fields.alg,
+			}
+			got, err := r.SetOrgSMTPConfigDKIM(tt.args.ctx, tt.args.orgID, tt.args.id, tt.args.dkim)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_SetOrgSMTPConfigHeaders(t *testing.T) {
+	smtpConfigAdded := func() eventstore.Event {
+		return eventFromEventPusher(
+			org.NewSMTPConfigAddedEvent(
+				context.Background(),
+				&org.NewAggregate("org1").Aggregate,
+				"configid",
+				"test",
+				true,
+				"from@example.com",
+				"name",
+				"",
+				"host:587",
+				"user",
+				nil,
+			),
+		)
+	}
+	type args struct {
+		ctx     context.Context
+		orgID   string
+		id      string
+		headers map[string]string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name       string
+		eventstore func(t *testing.T) *eventstore.Eventstore
+		args       args
+		res        res
+	}{
+		{
+			name:       "reserved header, invalid argument error",
+			eventstore: expectEventstore(),
+			args: args{
+				ctx:     context.Background(),
+				orgID:   "org1",
+				id:      "configid",
+				headers: map[string]string{"Subject": "overwritten"},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "no changes, precondition error",
+			eventstore: expectEventstore(
+				expectFilter(
+					smtpConfigAdded(),
+					eventFromEventPusher(
+						org.NewSMTPConfigHeadersSetEvent(
+							context.Background(),
+							&org.NewAggregate("org1").Aggregate,
+							"configid",
+							map[string]string{"List-Unsubscribe": "<https://example.com/unsubscribe>"},
+						),
+					),
+				),
+			),
+			args: args{
+				ctx:     context.Background(),
+				orgID:   "org1",
+				id:      "configid",
+				headers: map[string]string{"List-Unsubscribe": "<https://example.com/unsubscribe>"},
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "set headers, ok",
+			eventstore: expectEventstore(
+				expectFilter(
+					smtpConfigAdded(),
+				),
+				expectPush(
+					org.NewSMTPConfigHeadersSetEvent(
+						context.Background(),
+						&org.NewAggregate("org1").Aggregate,
+						"configid",
+						map[string]string{"List-Unsubscribe": "<https://example.com/unsubscribe>"},
+					),
+				),
+			),
+			args: args{
+				ctx:     context.Background(),
+				orgID:   "org1",
+				id:      "configid",
+				headers: map[string]string{"List-Unsubscribe": "<https://example.com/unsubscribe>"},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.eventstore(t),
+			}
+			got, err := r.SetOrgSMTPConfigHeaders(tt.args.ctx, tt.args.orgID, tt.args.id, tt.args.headers)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func testDKIMKey(t *testing.T) []byte {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return der
+}
@@ -1065,6 +1065,36 @@ func TestCommandSide_RemoveOrg(t *testing.T) {
 				err: zerrors.IsNotFound,
 			},
 		},
+		{
+			name: "org with sub organizations, error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(), // zitadel project check
+					expectFilter(
+						eventFromEventPusher(
+							org.NewOrgAddedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								"org"),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewOrgParentSetEvent(context.Background(),
+								&org.NewAggregate("org2").Aggregate,
+								"org1"),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
 		{
 			name: "push failed, error",
 			fields: fields{
@@ -1078,6 +1108,7 @@ func TestCommandSide_RemoveOrg(t *testing.T) {
 								"org"),
 						),
 					),
+					expectFilter(), // sub organizations check
 					expectFilter(
 						eventFromEventPusher(
 							org.NewDomainPolicyAddedEvent(context.Background(),
@@ -1121,6 +1152,7 @@ func TestCommandSide_RemoveOrg(t *testing.T) {
 								"org"),
 						),
 					),
+					expectFilter(), // sub organizations check
 					expectFilter(
 						eventFromEventPusher(
 							org.NewDomainPolicyAddedEvent(context.Background(),
@@ -1161,6 +1193,7 @@ func TestCommandSide_RemoveOrg(t *testing.T) {
 								"org"),
 						),
 					),
+					expectFilter(), // sub organizations check
 
 					expectFilter(
 						eventFromEventPusher(
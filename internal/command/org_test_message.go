@@ -0,0 +1,77 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// RequestOrgTestMessage requests the message of the messageType to be rendered with the texts and branding of the organization
+// and to be sent to the recipient through the active provider of the organization.
+// If a userID is passed, the message is rendered with the data of the user instead of sample data.
+func (c *Commands) RequestOrgTestMessage(ctx context.Context, orgID, messageType, language, userID string, notificationType domain.NotificationType, recipient string) (*domain.ObjectDetails, error) {
+	if orgID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Tm9qw", "Errors.ResourceOwnerMissing")
+	}
+	if !domain.IsMessageTextType(messageType) {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Tm2pd", "Errors.CustomMessageText.Invalid")
+	}
+	if language != "" {
+		if _, err := domain.ParseLanguage(language); err != nil {
+			return nil, err
+		}
+	}
+	switch notificationType {
+	case domain.NotificationTypeEmail:
+		if recipient == "" {
+			return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Tm4ne", "Errors.SMTPConfig.TestEmailNotFound")
+		}
+	case domain.NotificationTypeSms:
+		if recipient == "" {
+			return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Tm5np", "Errors.SMSConfig.TestPhoneNotFound")
+		}
+		phone, err := domain.PhoneNumber(recipient).Normalize()
+		if err != nil {
+			return nil, err
+		}
+		recipient = string(phone)
+	default:
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Tm6nt", "Errors.Notification.InvalidType")
+	}
+	if err := c.checkOrgExists(ctx, orgID); err != nil {
+		return nil, err
+	}
+	if userID != "" {
+		if err := c.checkUserExists(ctx, userID, orgID); err != nil {
+			return nil, err
+		}
+		// the test message contains the personal data of the user
+		if err := c.checkPermissionReadUser(ctx, orgID, userID); err != nil {
+			return nil, err
+		}
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, org.NewTestMessageRequestedEvent(
+		ctx,
+		&org.NewAggregate(orgID).Aggregate,
+		messageType,
+		language,
+		userID,
+		notificationType,
+		recipient,
+	))
+	if err != nil {
+		return nil, err
+	}
+	return pushedEventsToObjectDetails(pushedEvents), nil
+}
+
+// OrgTestMessageSent marks the test message requested by the event with the requestSequence as sent.
+func (c *Commands) OrgTestMessageSent(ctx context.Context, orgID string, requestSequence uint64) error {
+	if orgID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Tm7sq", "Errors.ResourceOwnerMissing")
+	}
+	_, err := c.eventstore.Push(ctx, org.NewTestMessageSentEvent(ctx, &org.NewAggregate(orgID).Aggregate, requestSequence))
+	return err
+}
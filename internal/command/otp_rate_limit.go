@@ -0,0 +1,179 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/logging"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	http_util "github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/command/preparation"
+	sd "github.com/zitadel/zitadel/internal/config/systemdefaults"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/session"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/telemetry/metrics"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	otpRateLimitedCounter            = "otp_delivery_rate_limited"
+	otpRateLimitedCounterDescription = "One-time password deliveries rejected by a rate limit"
+)
+
+// otpChannel defines the events a one-time password delivered by a channel (SMS / email) is stored on.
+type otpChannel struct {
+	name             string
+	sessionEventType eventstore.EventType
+	userEventType    eventstore.EventType
+}
+
+var (
+	otpChannelSMS = otpChannel{
+		name:             "sms",
+		sessionEventType: session.OTPSMSChallengedType,
+		userEventType:    user.HumanOTPSMSCodeAddedType,
+	}
+	otpChannelEmail = otpChannel{
+		name:             "email",
+		sessionEventType: session.OTPEmailChallengedType,
+		userEventType:    user.HumanOTPEmailCodeAddedType,
+	}
+)
+
+// otpDelivery returns the recipient of a one-time password sent by ZITADEL,
+// which is stored on the event of the code, so the deliveries can be counted.
+// It returns nil if the deliveries are not limited.
+func otpDelivery(ctx context.Context, limit sd.OTPRateLimit, userID string, phone domain.PhoneNumber) *domain.OTPDelivery {
+	if !limit.Enabled() {
+		return nil
+	}
+	delivery := &domain.OTPDelivery{
+		UserID:   userID,
+		RemoteIP: http_util.RemoteIPFromCtx(ctx),
+	}
+	if phone != "" && limit.PhonePrefixLength > 0 {
+		delivery.PhonePrefix = phone.Prefix(limit.PhonePrefixLength)
+	}
+	return delivery
+}
+
+// checkOTPRateLimit returns an error if sending another one-time password to the recipient of the delivery
+// would exceed one of the limits of the channel.
+// The previous deliveries are counted on the events of the session and the user API.
+func checkOTPRateLimit(ctx context.Context, filter preparation.FilterToQueryReducer, limit sd.OTPRateLimit, channel otpChannel, delivery *domain.OTPDelivery, now time.Time) error {
+	if delivery == nil {
+		return nil
+	}
+	if limit.Cooldown > 0 {
+		exceeded, err := otpDeliveriesExceeded(ctx, filter, channel, now.Add(-limit.Cooldown), 1, map[string]interface{}{"userID": delivery.UserID})
+		if err != nil {
+			return err
+		}
+		if exceeded {
+			otpRateLimited(ctx, channel, "cooldown", delivery)
+			return zerrors.ThrowResourceExhausted(nil, "COMMAND-Otp2c", "Errors.User.MFA.OTP.CooldownActive")
+		}
+	}
+	if limit.Window == 0 {
+		return nil
+	}
+	limits := []struct {
+		name string
+		max  uint64
+		data map[string]interface{}
+	}{
+		{name: "user", max: limit.PerUser, data: map[string]interface{}{"userID": delivery.UserID}},
+		{name: "phone_prefix", max: limit.PerPhonePrefix, data: map[string]interface{}{"phonePrefix": delivery.PhonePrefix}},
+		{name: "ip", max: limit.PerIP, data: map[string]interface{}{"remoteIP": delivery.RemoteIP}},
+		{name: "instance", max: limit.PerInstance, data: map[string]interface{}{}},
+	}
+	for _, l := range limits {
+		if l.max == 0 || !otpDeliveryDataSet(l.data) {
+			continue
+		}
+		exceeded, err := otpDeliveriesExceeded(ctx, filter, channel, now.Add(-limit.Window), l.max, l.data)
+		if err != nil {
+			return err
+		}
+		if exceeded {
+			otpRateLimited(ctx, channel, l.name, delivery)
+			return zerrors.ThrowResourceExhausted(nil, "COMMAND-Otp3l", "Errors.User.MFA.OTP.RateLimited")
+		}
+	}
+	return nil
+}
+
+// otpDeliveryDataSet returns false if a value of the delivery is unknown (e.g. no IP or phone number),
+// in which case the deliveries can't be counted.
+func otpDeliveryDataSet(data map[string]interface{}) bool {
+	for _, value := range data {
+		if value == "" {
+			return false
+		}
+	}
+	return true
+}
+
+func otpDeliveriesExceeded(ctx context.Context, filter preparation.FilterToQueryReducer, channel otpChannel, since time.Time, max uint64, data map[string]interface{}) (bool, error) {
+	delivery := map[string]interface{}{"delivery": data}
+	events, err := filter(ctx, eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		InstanceID(authz.GetInstance(ctx).InstanceID()).
+		CreationDateAfter(since).
+		Limit(max).
+		AddQuery().
+		AggregateTypes(session.AggregateType).
+		EventTypes(channel.sessionEventType).
+		EventData(delivery).
+		Or().
+		AggregateTypes(user.AggregateType).
+		EventTypes(channel.userEventType).
+		EventData(delivery).
+		Builder(),
+	)
+	if err != nil {
+		return false, err
+	}
+	return uint64(len(events)) >= max, nil
+}
+
+// otpRateLimited logs and counts the rejected delivery, so anomalies like SMS pumping can be alerted on.
+func otpRateLimited(ctx context.Context, channel otpChannel, limit string, delivery *domain.OTPDelivery) {
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	logging.WithFields(
+		"instance", instanceID,
+		"channel", channel.name,
+		"limit", limit,
+		"user", delivery.UserID,
+		"phonePrefix", delivery.PhonePrefix,
+		"remoteIP", delivery.RemoteIP,
+	).Warn("otp delivery rate limited")
+	err := metrics.AddCount(ctx, otpRateLimitedCounter, 1, map[string]attribute.Value{
+		"instance": attribute.StringValue(instanceID),
+		"channel":  attribute.StringValue(channel.name),
+		"limit":    attribute.StringValue(limit),
+	})
+	logging.WithFields("name", otpRateLimitedCounter).OnError(err).Error("incrementing counter metric failed")
+}
+
+// checkOTPSMSCountry returns an error if the security policy of the instance restricts
+// the countries one-time passwords can be sent to by SMS and the phone number isn't in one of them.
+func (c *Commands) checkOTPSMSCountry(ctx context.Context, filter preparation.FilterToQueryReducer, phone domain.PhoneNumber) error {
+	policy, err := c.getSecurityPolicyWriteModel(ctx, filter)
+	if err != nil {
+		return err
+	}
+	if len(policy.SMSAllowedCountries) == 0 {
+		return nil
+	}
+	region := phone.Region()
+	for _, country := range policy.SMSAllowedCountries {
+		if country == region {
+			return nil
+		}
+	}
+	return zerrors.ThrowPreconditionFailed(nil, "COMMAND-Otp4c", "Errors.User.MFA.OTP.CountryNotAllowed")
+}
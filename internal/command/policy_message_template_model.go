@@ -0,0 +1,38 @@
+package command
+
+import (
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/policy"
+)
+
+type MessageTemplateWriteModel struct {
+	eventstore.WriteModel
+
+	Name     string
+	Language language.Tag
+	Template []byte
+	State    domain.PolicyState
+}
+
+func (wm *MessageTemplateWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *policy.MessageTemplateSetEvent:
+			if e.Name != wm.Name || e.Language != wm.Language {
+				continue
+			}
+			wm.Template = e.Template
+			wm.State = domain.PolicyStateActive
+		case *policy.MessageTemplateRemovedEvent:
+			if e.Name != wm.Name || e.Language != wm.Language {
+				continue
+			}
+			wm.Template = nil
+			wm.State = domain.PolicyStateRemoved
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
@@ -31,6 +31,7 @@ type addOIDCApp struct {
 	ClockSkew                   time.Duration
 	AdditionalOrigins           []string
 	SkipSuccessPageForNativeApp bool
+	RequiredLevelOfAssurance    domain.LevelOfAssurance
 
 	ClientID          string
 	ClientSecret      string
@@ -52,6 +53,10 @@ func (c *Commands) AddOIDCAppCommand(app *addOIDCApp) preparation.Validation {
 			return nil, zerrors.ThrowInvalidArgument(nil, "V2-PnCMS", "Errors.Invalid.Argument")
 		}
 
+		if !app.RequiredLevelOfAssurance.Valid() {
+			return nil, zerrors.ThrowInvalidArgument(nil, "V2-Lo4sA", "Errors.Invalid.Argument")
+		}
+
 		for _, origin := range app.AdditionalOrigins {
 			if !http_util.IsOrigin(strings.TrimSpace(origin)) {
 				return nil, zerrors.ThrowInvalidArgument(nil, "V2-DqWPX", "Errors.Invalid.Argument")
@@ -108,6 +113,7 @@ func (c *Commands) AddOIDCAppCommand(app *addOIDCApp) preparation.Validation {
 					app.ClockSkew,
 					trimStringSliceWhiteSpaces(app.AdditionalOrigins),
 					app.SkipSuccessPageForNativeApp,
+					app.RequiredLevelOfAssurance,
 				),
 			}, nil
 		}, nil
@@ -199,6 +205,7 @@ func (c *Commands) addOIDCApplicationWithID(ctx context.Context, oidcApp *domain
 		oidcApp.ClockSkew,
 		trimStringSliceWhiteSpaces(oidcApp.AdditionalOrigins),
 		oidcApp.SkipNativeAppSuccessPage,
+		oidcApp.RequiredLevelOfAssurance,
 	))
 
 	addedApplication.AppID = oidcApp.AppID
@@ -251,6 +258,7 @@ func (c *Commands) ChangeOIDCApplication(ctx context.Context, oidc *domain.OIDCA
 		oidc.ClockSkew,
 		trimStringSliceWhiteSpaces(oidc.AdditionalOrigins),
 		oidc.SkipNativeAppSuccessPage,
+		oidc.RequiredLevelOfAssurance,
 	)
 	if err != nil {
 		return nil, err
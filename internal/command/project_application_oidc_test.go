@@ -84,6 +84,29 @@ func TestAddOIDCApp(t *testing.T) {
 				ValidationErr: zerrors.ThrowInvalidArgument(nil, "PROJE-Fef31", "Errors.Invalid.Argument"),
 			},
 		},
+		{
+			name:   "invalid required level of assurance",
+			fields: fields{},
+			args: args{
+				app: &addOIDCApp{
+					AddApp: AddApp{
+						Aggregate: *agg,
+						ID:        "id",
+						Name:      "name",
+					},
+					GrantTypes:               []domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
+					ResponseTypes:            []domain.OIDCResponseType{domain.OIDCResponseTypeCode},
+					Version:                  domain.OIDCVersionV1,
+					ApplicationType:          domain.OIDCApplicationTypeWeb,
+					AuthMethodType:           domain.OIDCAuthMethodTypeNone,
+					AccessTokenType:          domain.OIDCTokenTypeBearer,
+					RequiredLevelOfAssurance: 99,
+				},
+			},
+			want: Want{
+				ValidationErr: zerrors.ThrowInvalidArgument(nil, "V2-Lo4sA", "Errors.Invalid.Argument"),
+			},
+		},
 		{
 			name:   "project doesn't exist",
 			fields: fields{},
@@ -174,6 +197,7 @@ func TestAddOIDCApp(t *testing.T) {
 						0,
 						[]string{"https://sub.test.ch"},
 						false,
+						domain.LevelOfAssuranceNone,
 					),
 				},
 			},
@@ -239,6 +263,7 @@ func TestAddOIDCApp(t *testing.T) {
 						0,
 						nil,
 						false,
+						domain.LevelOfAssuranceNone,
 					),
 				},
 			},
@@ -304,6 +329,7 @@ func TestAddOIDCApp(t *testing.T) {
 						0,
 						nil,
 						false,
+						domain.LevelOfAssuranceNone,
 					),
 				},
 			},
@@ -369,6 +395,7 @@ func TestAddOIDCApp(t *testing.T) {
 						0,
 						nil,
 						false,
+						domain.LevelOfAssuranceNone,
 					),
 				},
 			},
@@ -515,6 +542,7 @@ func TestCommandSide_AddOIDCApplication(t *testing.T) {
 							time.Second*1,
 							[]string{"https://sub.test.ch"},
 							true,
+							domain.LevelOfAssuranceNone,
 						),
 					),
 				),
@@ -613,6 +641,7 @@ func TestCommandSide_AddOIDCApplication(t *testing.T) {
 							time.Second*1,
 							[]string{"https://sub.test.ch"},
 							true,
+							domain.LevelOfAssuranceNone,
 						),
 					),
 				),
@@ -845,6 +874,7 @@ func TestCommandSide_ChangeOIDCApplication(t *testing.T) {
 								time.Second*1,
 								[]string{"https://sub.test.ch"},
 								true,
+								domain.LevelOfAssuranceNone,
 							),
 						),
 					),
@@ -914,6 +944,7 @@ func TestCommandSide_ChangeOIDCApplication(t *testing.T) {
 								time.Second*1,
 								[]string{"https://sub.test.ch"},
 								true,
+								domain.LevelOfAssuranceNone,
 							),
 						),
 					),
@@ -983,6 +1014,7 @@ func TestCommandSide_ChangeOIDCApplication(t *testing.T) {
 								time.Second*1,
 								[]string{"https://sub.test.ch"},
 								true,
+								domain.LevelOfAssuranceNone,
 							),
 						),
 					),
@@ -1168,6 +1200,7 @@ func TestCommandSide_ChangeOIDCApplicationSecret(t *testing.T) {
 								time.Second*1,
 								[]string{"https://sub.test.ch"},
 								false,
+								domain.LevelOfAssuranceNone,
 							),
 						),
 					),
@@ -1325,6 +1358,7 @@ func TestCommands_VerifyOIDCClientSecret(t *testing.T) {
 							time.Second*1,
 							[]string{"https://sub.test.ch"},
 							false,
+							domain.LevelOfAssuranceNone,
 						),
 					),
 				),
@@ -1360,6 +1394,7 @@ func TestCommands_VerifyOIDCClientSecret(t *testing.T) {
 							time.Second*1,
 							[]string{"https://sub.test.ch"},
 							false,
+							domain.LevelOfAssuranceNone,
 						),
 					),
 				),
@@ -1397,6 +1432,7 @@ func TestCommands_VerifyOIDCClientSecret(t *testing.T) {
 							time.Second*1,
 							[]string{"https://sub.test.ch"},
 							false,
+							domain.LevelOfAssuranceNone,
 						),
 					),
 				),
@@ -18,7 +18,10 @@ func (c *Commands) AddProjectGrantMember(ctx context.Context, member *domain.Pro
 	if !member.IsValid() {
 		return nil, zerrors.ThrowInvalidArgument(nil, "PROJECT-8fi7G", "Errors.Project.Grant.Member.Invalid")
 	}
-	if len(domain.CheckForInvalidRoles(member.Roles, domain.ProjectGrantRolePrefix, c.zitadelRoles)) > 0 {
+	//nolint:staticcheck
+	if invalidRoles, err := c.invalidMemberRoles(ctx, c.eventstore.Filter, member.Roles, domain.ProjectGrantRolePrefix); err != nil {
+		return nil, err
+	} else if len(invalidRoles) > 0 {
 		return nil, zerrors.ThrowInvalidArgument(nil, "PROJECT-m9gKK", "Errors.Project.Grant.Member.Invalid")
 	}
 	err = c.checkUserExists(ctx, member.UserID, "")
@@ -46,7 +49,10 @@ func (c *Commands) ChangeProjectGrantMember(ctx context.Context, member *domain.
 	if !member.IsValid() {
 		return nil, zerrors.ThrowInvalidArgument(nil, "PROJECT-109fs", "Errors.Project.Member.Invalid")
 	}
-	if len(domain.CheckForInvalidRoles(member.Roles, domain.ProjectGrantRolePrefix, c.zitadelRoles)) > 0 {
+	//nolint:staticcheck
+	if invalidRoles, err := c.invalidMemberRoles(ctx, c.eventstore.Filter, member.Roles, domain.ProjectGrantRolePrefix); err != nil {
+		return nil, err
+	} else if len(invalidRoles) > 0 {
 		return nil, zerrors.ThrowInvalidArgument(nil, "PROJECT-m0sDf", "Errors.Project.Member.Invalid")
 	}
 
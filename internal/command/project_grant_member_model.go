@@ -1,6 +1,8 @@
 package command
 
 import (
+	"time"
+
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/repository/project"
@@ -9,9 +11,11 @@ import (
 type ProjectGrantMemberWriteModel struct {
 	eventstore.WriteModel
 
-	GrantID string
-	UserID  string
-	Roles   []string
+	GrantID    string
+	UserID     string
+	Roles      []string
+	ValidFrom  *time.Time
+	ValidUntil *time.Time
 
 	State domain.MemberState
 }
@@ -49,6 +53,16 @@ func (wm *ProjectGrantMemberWriteModel) AppendEvents(events ...eventstore.Event)
 				continue
 			}
 			wm.WriteModel.AppendEvents(e)
+		case *project.GrantMemberValiditySetEvent:
+			if e.UserID != wm.UserID || e.GrantID != wm.GrantID {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
+		case *project.GrantMemberExpiredEvent:
+			if e.UserID != wm.UserID || e.GrantID != wm.GrantID {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
 		case *project.GrantRemovedEvent:
 			if e.GrantID != wm.GrantID {
 				continue
@@ -65,6 +79,8 @@ func (wm *ProjectGrantMemberWriteModel) Reduce() error {
 		switch e := event.(type) {
 		case *project.GrantMemberAddedEvent:
 			wm.Roles = e.Roles
+			wm.ValidFrom = nil
+			wm.ValidUntil = nil
 			wm.State = domain.MemberStateActive
 		case *project.GrantMemberChangedEvent:
 			wm.Roles = e.Roles
@@ -72,6 +88,11 @@ func (wm *ProjectGrantMemberWriteModel) Reduce() error {
 			wm.State = domain.MemberStateRemoved
 		case *project.GrantMemberCascadeRemovedEvent:
 			wm.State = domain.MemberStateRemoved
+		case *project.GrantMemberValiditySetEvent:
+			wm.ValidFrom = e.ValidFrom
+			wm.ValidUntil = e.ValidUntil
+		case *project.GrantMemberExpiredEvent:
+			wm.State = domain.MemberStateRemoved
 		case *project.GrantRemovedEvent, *project.ProjectRemovedEvent:
 			wm.State = domain.MemberStateRemoved
 		}
@@ -89,6 +110,8 @@ func (wm *ProjectGrantMemberWriteModel) Query() *eventstore.SearchQueryBuilder {
 			project.GrantMemberChangedType,
 			project.GrantMemberRemovedType,
 			project.GrantMemberCascadeRemovedType,
+			project.GrantMemberValiditySetType,
+			project.GrantMemberExpiredType,
 			project.GrantRemovedType,
 			project.ProjectRemovedType).
 		Builder()
@@ -41,7 +41,10 @@ func (c *Commands) addProjectMember(ctx context.Context, projectAgg *eventstore.
 	if !member.IsValid() {
 		return nil, zerrors.ThrowInvalidArgument(nil, "PROJECT-W8m4l", "Errors.Project.Member.Invalid")
 	}
-	if len(domain.CheckForInvalidRoles(member.Roles, domain.ProjectRolePrefix, c.zitadelRoles)) > 0 {
+	//nolint:staticcheck
+	if invalidRoles, err := c.invalidMemberRoles(ctx, c.eventstore.Filter, member.Roles, domain.ProjectRolePrefix); err != nil {
+		return nil, err
+	} else if len(invalidRoles) > 0 {
 		return nil, zerrors.ThrowInvalidArgument(nil, "PROJECT-3m9ds", "Errors.Project.Member.Invalid")
 	}
 
@@ -65,7 +68,10 @@ func (c *Commands) ChangeProjectMember(ctx context.Context, member *domain.Membe
 	if !member.IsValid() {
 		return nil, zerrors.ThrowInvalidArgument(nil, "PROJECT-LiaZi", "Errors.Project.Member.Invalid")
 	}
-	if len(domain.CheckForInvalidRoles(member.Roles, domain.ProjectRolePrefix, c.zitadelRoles)) > 0 {
+	//nolint:staticcheck
+	if invalidRoles, err := c.invalidMemberRoles(ctx, c.eventstore.Filter, member.Roles, domain.ProjectRolePrefix); err != nil {
+		return nil, err
+	} else if len(invalidRoles) > 0 {
 		return nil, zerrors.ThrowInvalidArgument(nil, "PROJECT-3m9d", "Errors.Project.Member.Invalid")
 	}
 
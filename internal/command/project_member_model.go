@@ -44,6 +44,16 @@ func (wm *ProjectMemberWriteModel) AppendEvents(events ...eventstore.Event) {
 				continue
 			}
 			wm.MemberWriteModel.AppendEvents(&e.MemberCascadeRemovedEvent)
+		case *project.MemberValiditySetEvent:
+			if e.UserID != wm.MemberWriteModel.UserID {
+				continue
+			}
+			wm.MemberWriteModel.AppendEvents(&e.MemberValiditySetEvent)
+		case *project.MemberExpiredEvent:
+			if e.UserID != wm.MemberWriteModel.UserID {
+				continue
+			}
+			wm.MemberWriteModel.AppendEvents(&e.MemberExpiredEvent)
 		}
 	}
 }
@@ -61,6 +71,8 @@ func (wm *ProjectMemberWriteModel) Query() *eventstore.SearchQueryBuilder {
 		EventTypes(project.MemberAddedType,
 			project.MemberChangedType,
 			project.MemberRemovedType,
-			project.MemberCascadeRemovedType).
+			project.MemberCascadeRemovedType,
+			project.MemberValiditySetType,
+			project.MemberExpiredType).
 		Builder()
 }
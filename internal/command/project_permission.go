@@ -0,0 +1,160 @@
+package command
+
+import (
+	"context"
+	"slices"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// AddProjectPermission defines a permission of the project, which is granted through the given roles.
+func (c *Commands) AddProjectPermission(ctx context.Context, permission *domain.ProjectPermission, resourceOwner string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if !permission.IsValid() {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Pp1iv", "Errors.Project.Permission.Invalid")
+	}
+	writeModel, err := c.projectAuthorizationWriteModel(ctx, permission.AggregateID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := writeModel.Permissions[permission.Key]; ok {
+		return nil, zerrors.ThrowAlreadyExists(nil, "COMMAND-Pp2ex", "Errors.Project.Permission.AlreadyExists")
+	}
+	if permission.HasInvalidRoles(writeModel.Roles) {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Pp3nf", "Errors.Project.Role.NotExisting")
+	}
+	err = c.pushAppendAndReduce(ctx, writeModel, project.NewPermissionAddedEvent(
+		ctx,
+		ProjectAggregateFromWriteModel(&writeModel.WriteModel),
+		permission.Key,
+		permission.DisplayName,
+		permission.RoleKeys,
+	))
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// ChangeProjectPermission replaces the display name and the roles of the permission.
+func (c *Commands) ChangeProjectPermission(ctx context.Context, permission *domain.ProjectPermission, resourceOwner string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if !permission.IsValid() {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Pp4iv", "Errors.Project.Permission.Invalid")
+	}
+	writeModel, err := c.projectAuthorizationWriteModel(ctx, permission.AggregateID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	existing, ok := writeModel.Permissions[permission.Key]
+	if !ok {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Pp5nf", "Errors.Project.Permission.NotFound")
+	}
+	if permission.HasInvalidRoles(writeModel.Roles) {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Pp6nf", "Errors.Project.Role.NotExisting")
+	}
+	if existing.DisplayName == permission.DisplayName && equalRoleKeys(existing.RoleKeys, permission.RoleKeys) {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Pp7nc", "Errors.NoChangesFound")
+	}
+	err = c.pushAppendAndReduce(ctx, writeModel, project.NewPermissionChangedEvent(
+		ctx,
+		ProjectAggregateFromWriteModel(&writeModel.WriteModel),
+		permission.Key,
+		permission.DisplayName,
+		permission.RoleKeys,
+	))
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+func (c *Commands) RemoveProjectPermission(ctx context.Context, projectID, key, resourceOwner string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if projectID == "" || key == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Pp8iv", "Errors.Project.Permission.Invalid")
+	}
+	writeModel, err := c.projectAuthorizationWriteModel(ctx, projectID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := writeModel.Permissions[key]; !ok {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Pp9nf", "Errors.Project.Permission.NotFound")
+	}
+	err = c.pushAppendAndReduce(ctx, writeModel, project.NewPermissionRemovedEvent(
+		ctx,
+		ProjectAggregateFromWriteModel(&writeModel.WriteModel),
+		key,
+	))
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// SetProjectRoleInclusions replaces the roles included in the role.
+// Inclusions must not form a cycle, so a role can never (indirectly) include itself.
+func (c *Commands) SetProjectRoleInclusions(ctx context.Context, projectID, roleKey string, includedRoleKeys []string, resourceOwner string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if projectID == "" || roleKey == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ri1iv", "Errors.Project.Role.Invalid")
+	}
+	writeModel, err := c.projectAuthorizationWriteModel(ctx, projectID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(writeModel.Roles, roleKey) {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Ri2nf", "Errors.Project.Role.NotExisting")
+	}
+	for _, included := range includedRoleKeys {
+		if !slices.Contains(writeModel.Roles, included) {
+			return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ri3nf", "Errors.Project.Role.NotExisting")
+		}
+		if included == roleKey || writeModel.Includes(included, roleKey) {
+			return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ri4cy", "Errors.Project.Role.InclusionCycle")
+		}
+	}
+	if equalRoleKeys(writeModel.Inclusions[roleKey], includedRoleKeys) {
+		return writeModelToObjectDetails(&writeModel.WriteModel), nil
+	}
+	err = c.pushAppendAndReduce(ctx, writeModel, project.NewRoleInclusionsSetEvent(
+		ctx,
+		ProjectAggregateFromWriteModel(&writeModel.WriteModel),
+		roleKey,
+		includedRoleKeys,
+	))
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+func (c *Commands) projectAuthorizationWriteModel(ctx context.Context, projectID, resourceOwner string) (*ProjectAuthorizationWriteModel, error) {
+	writeModel := NewProjectAuthorizationWriteModel(projectID, resourceOwner)
+	if err := c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	if !writeModel.ProjectExists {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Pa1nf", "Errors.Project.NotFound")
+	}
+	return writeModel, nil
+}
+
+// equalRoleKeys reports if both lists contain the same role keys regardless of their order.
+func equalRoleKeys(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	return !slices.ContainsFunc(b, func(key string) bool { return !slices.Contains(a, key) })
+}
@@ -0,0 +1,133 @@
+package command
+
+import (
+	"slices"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/project"
+)
+
+// ProjectAuthorizationWriteModel holds the roles, role inclusions, role conditions and permissions of a project.
+type ProjectAuthorizationWriteModel struct {
+	eventstore.WriteModel
+
+	ProjectExists bool
+	Roles         []string
+	Inclusions    map[string][]string
+	Conditions    map[string]*domain.ProjectRoleCondition
+	Permissions   map[string]*projectPermission
+}
+
+type projectPermission struct {
+	DisplayName string
+	RoleKeys    []string
+}
+
+func NewProjectAuthorizationWriteModel(projectID, resourceOwner string) *ProjectAuthorizationWriteModel {
+	return &ProjectAuthorizationWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   projectID,
+			ResourceOwner: resourceOwner,
+		},
+		Inclusions:  make(map[string][]string),
+		Conditions:  make(map[string]*domain.ProjectRoleCondition),
+		Permissions: make(map[string]*projectPermission),
+	}
+}
+
+func (wm *ProjectAuthorizationWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *project.ProjectAddedEvent:
+			wm.ProjectExists = true
+		case *project.ProjectRemovedEvent:
+			wm.ProjectExists = false
+			wm.Roles = nil
+			wm.Inclusions = make(map[string][]string)
+			wm.Conditions = make(map[string]*domain.ProjectRoleCondition)
+			wm.Permissions = make(map[string]*projectPermission)
+		case *project.RoleAddedEvent:
+			wm.Roles = append(wm.Roles, e.Key)
+		case *project.RoleRemovedEvent:
+			wm.reduceRoleRemoved(e.Key)
+		case *project.RoleInclusionsSetEvent:
+			if len(e.IncludedRoleKeys) == 0 {
+				delete(wm.Inclusions, e.Key)
+				continue
+			}
+			wm.Inclusions[e.Key] = e.IncludedRoleKeys
+		case *project.RoleConditionSetEvent:
+			if condition := e.Condition(); !condition.IsEmpty() {
+				wm.Conditions[e.Key] = condition
+				continue
+			}
+			delete(wm.Conditions, e.Key)
+		case *project.PermissionAddedEvent:
+			wm.Permissions[e.Key] = &projectPermission{
+				DisplayName: e.DisplayName,
+				RoleKeys:    e.RoleKeys,
+			}
+		case *project.PermissionChangedEvent:
+			wm.Permissions[e.Key] = &projectPermission{
+				DisplayName: e.DisplayName,
+				RoleKeys:    e.RoleKeys,
+			}
+		case *project.PermissionRemovedEvent:
+			delete(wm.Permissions, e.Key)
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+// reduceRoleRemoved removes the role from the project and from all inclusions, conditions and permissions.
+func (wm *ProjectAuthorizationWriteModel) reduceRoleRemoved(key string) {
+	isKey := func(roleKey string) bool { return roleKey == key }
+	wm.Roles = slices.DeleteFunc(wm.Roles, isKey)
+	delete(wm.Inclusions, key)
+	delete(wm.Conditions, key)
+	for role, included := range wm.Inclusions {
+		wm.Inclusions[role] = slices.DeleteFunc(included, isKey)
+	}
+	for _, permission := range wm.Permissions {
+		permission.RoleKeys = slices.DeleteFunc(permission.RoleKeys, isKey)
+	}
+}
+
+func (wm *ProjectAuthorizationWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(project.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			project.ProjectAddedType,
+			project.ProjectRemovedType,
+			project.RoleAddedType,
+			project.RoleRemovedType,
+			project.RoleInclusionsSetType,
+			project.RoleConditionSetType,
+			project.PermissionAddedType,
+			project.PermissionChangedType,
+			project.PermissionRemovedType).
+		Builder()
+}
+
+// Includes reports if the role includes the other role directly or through further inclusions.
+func (wm *ProjectAuthorizationWriteModel) Includes(roleKey, includedRoleKey string) bool {
+	visited := make(map[string]bool)
+	var includes func(string) bool
+	includes = func(key string) bool {
+		if visited[key] {
+			return false
+		}
+		visited[key] = true
+		for _, included := range wm.Inclusions[key] {
+			if included == includedRoleKey || includes(included) {
+				return true
+			}
+		}
+		return false
+	}
+	return includes(roleKey)
+}
@@ -0,0 +1,472 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	es_models "github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommands_AddProjectPermission(t *testing.T) {
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		permission *domain.ProjectPermission
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "invalid permission, error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				permission: &domain.ProjectPermission{
+					ObjectRoot: es_models.ObjectRoot{AggregateID: "project1"},
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "project not existing, precondition failed",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				permission: &domain.ProjectPermission{
+					ObjectRoot: es_models.ObjectRoot{AggregateID: "project1"},
+					Key:        "document.read",
+				},
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "already existing, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						projectAddedEvent(),
+						eventFromEventPusher(
+							project.NewPermissionAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"document.read", "", nil,
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				permission: &domain.ProjectPermission{
+					ObjectRoot: es_models.ObjectRoot{AggregateID: "project1"},
+					Key:        "document.read",
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorAlreadyExists,
+			},
+		},
+		{
+			name: "role not existing, precondition failed",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						projectAddedEvent(),
+					),
+				),
+			},
+			args: args{
+				permission: &domain.ProjectPermission{
+					ObjectRoot: es_models.ObjectRoot{AggregateID: "project1"},
+					Key:        "document.read",
+					RoleKeys:   []string{"reader"},
+				},
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "add permission, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						projectAddedEvent(),
+						projectRoleAddedEvent("reader"),
+					),
+					expectPush(
+						project.NewPermissionAddedEvent(context.Background(),
+							&project.NewAggregate("project1", "org1").Aggregate,
+							"document.read", "Read documents", []string{"reader"},
+						),
+					),
+				),
+			},
+			args: args{
+				permission: &domain.ProjectPermission{
+					ObjectRoot:  es_models.ObjectRoot{AggregateID: "project1"},
+					Key:         "document.read",
+					DisplayName: "Read documents",
+					RoleKeys:    []string{"reader"},
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := c.AddProjectPermission(context.Background(), tt.args.permission, "org1")
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommands_ChangeProjectPermission(t *testing.T) {
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		roleKeys []string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "not existing, not found",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						projectAddedEvent(),
+						projectRoleAddedEvent("reader"),
+					),
+				),
+			},
+			args: args{
+				roleKeys: []string{"reader"},
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "no changes, precondition failed",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						projectAddedEvent(),
+						projectRoleAddedEvent("reader"),
+						projectRoleAddedEvent("writer"),
+						eventFromEventPusher(
+							project.NewPermissionAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"document.read", "Read documents", []string{"writer", "reader"},
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				roleKeys: []string{"reader", "writer"},
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "role removed from permission, precondition failed",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						projectAddedEvent(),
+						projectRoleAddedEvent("reader"),
+						projectRoleAddedEvent("writer"),
+						eventFromEventPusher(
+							project.NewPermissionAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"document.read", "Read documents", []string{"reader", "writer"},
+							),
+						),
+						eventFromEventPusher(
+							project.NewRoleRemovedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"writer",
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				roleKeys: []string{"reader"},
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "change permission, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						projectAddedEvent(),
+						projectRoleAddedEvent("reader"),
+						projectRoleAddedEvent("writer"),
+						eventFromEventPusher(
+							project.NewPermissionAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"document.read", "Read documents", []string{"reader"},
+							),
+						),
+					),
+					expectPush(
+						project.NewPermissionChangedEvent(context.Background(),
+							&project.NewAggregate("project1", "org1").Aggregate,
+							"document.read", "Read documents", []string{"reader", "writer"},
+						),
+					),
+				),
+			},
+			args: args{
+				roleKeys: []string{"reader", "writer"},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := c.ChangeProjectPermission(context.Background(), &domain.ProjectPermission{
+				ObjectRoot:  es_models.ObjectRoot{AggregateID: "project1"},
+				Key:         "document.read",
+				DisplayName: "Read documents",
+				RoleKeys:    tt.args.roleKeys,
+			}, "org1")
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommands_SetProjectRoleInclusions(t *testing.T) {
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		roleKey          string
+		includedRoleKeys []string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "role not existing, not found",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						projectAddedEvent(),
+					),
+				),
+			},
+			args: args{
+				roleKey:          "writer",
+				includedRoleKeys: []string{"reader"},
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "included role not existing, precondition failed",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						projectAddedEvent(),
+						projectRoleAddedEvent("writer"),
+					),
+				),
+			},
+			args: args{
+				roleKey:          "writer",
+				includedRoleKeys: []string{"reader"},
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "include itself, precondition failed",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						projectAddedEvent(),
+						projectRoleAddedEvent("writer"),
+					),
+				),
+			},
+			args: args{
+				roleKey:          "writer",
+				includedRoleKeys: []string{"writer"},
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "cycle, precondition failed",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						projectAddedEvent(),
+						projectRoleAddedEvent("admin"),
+						projectRoleAddedEvent("writer"),
+						projectRoleAddedEvent("reader"),
+						projectRoleInclusionsSetEvent("admin", "writer"),
+						projectRoleInclusionsSetEvent("writer", "reader"),
+					),
+				),
+			},
+			args: args{
+				roleKey:          "reader",
+				includedRoleKeys: []string{"admin"},
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "set inclusions, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						projectAddedEvent(),
+						projectRoleAddedEvent("admin"),
+						projectRoleAddedEvent("writer"),
+						projectRoleAddedEvent("reader"),
+						projectRoleInclusionsSetEvent("writer", "reader"),
+					),
+					expectPush(
+						project.NewRoleInclusionsSetEvent(context.Background(),
+							&project.NewAggregate("project1", "org1").Aggregate,
+							"admin", []string{"writer"},
+						),
+					),
+				),
+			},
+			args: args{
+				roleKey:          "admin",
+				includedRoleKeys: []string{"writer"},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := c.SetProjectRoleInclusions(context.Background(), "project1", tt.args.roleKey, tt.args.includedRoleKeys, "org1")
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func projectAddedEvent() eventstore.Event {
+	return eventFromEventPusher(
+		project.NewProjectAddedEvent(context.Background(),
+			&project.NewAggregate("project1", "org1").Aggregate,
+			"project", true, true, true,
+			domain.PrivateLabelingSettingUnspecified,
+		),
+	)
+}
+
+func projectRoleAddedEvent(key string) eventstore.Event {
+	return eventFromEventPusher(
+		project.NewRoleAddedEvent(context.Background(),
+			&project.NewAggregate("project1", "org1").Aggregate,
+			key, key, "",
+		),
+	)
+}
+
+func projectRoleInclusionsSetEvent(key string, includedRoleKeys ...string) eventstore.Event {
+	return eventFromEventPusher(
+		project.NewRoleInclusionsSetEvent(context.Background(),
+			&project.NewAggregate("project1", "org1").Aggregate,
+			key, includedRoleKeys,
+		),
+	)
+}
@@ -0,0 +1,50 @@
+package command
+
+import (
+	"context"
+	"reflect"
+	"slices"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// SetProjectRoleCondition replaces the condition the session must satisfy to assert the role in tokens.
+// An empty condition removes the restriction.
+func (c *Commands) SetProjectRoleCondition(ctx context.Context, projectID, roleKey string, condition *domain.ProjectRoleCondition, resourceOwner string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if projectID == "" || roleKey == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Rc1iv", "Errors.Project.Role.Invalid")
+	}
+	if condition == nil {
+		condition = new(domain.ProjectRoleCondition)
+	}
+	if !condition.IsValid() {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Rc2iv", "Errors.Project.Role.ConditionInvalid")
+	}
+	writeModel, err := c.projectAuthorizationWriteModel(ctx, projectID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(writeModel.Roles, roleKey) {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Rc3nf", "Errors.Project.Role.NotExisting")
+	}
+	existing, ok := writeModel.Conditions[roleKey]
+	if condition.IsEmpty() && !ok || ok && reflect.DeepEqual(existing, condition) {
+		return writeModelToObjectDetails(&writeModel.WriteModel), nil
+	}
+	err = c.pushAppendAndReduce(ctx, writeModel, project.NewRoleConditionSetEvent(
+		ctx,
+		ProjectAggregateFromWriteModel(&writeModel.WriteModel),
+		roleKey,
+		condition,
+	))
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
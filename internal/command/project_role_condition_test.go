@@ -0,0 +1,220 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommands_SetProjectRoleCondition(t *testing.T) {
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		roleKey   string
+		condition *domain.ProjectRoleCondition
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "invalid ip range, invalid argument",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				roleKey: "admin",
+				condition: &domain.ProjectRoleCondition{
+					IPRanges: []string{"10.0.0.1"},
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "time of day without end, invalid argument",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				roleKey: "admin",
+				condition: &domain.ProjectRoleCondition{
+					TimeOfDayStart: "08:00",
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "role not existing, not found",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						projectAddedEvent(),
+					),
+				),
+			},
+			args: args{
+				roleKey: "admin",
+				condition: &domain.ProjectRoleCondition{
+					MFALevel: domain.MFALevelMultiFactorCertified,
+				},
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "set condition, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						projectAddedEvent(),
+						projectRoleAddedEvent("admin"),
+					),
+					expectPush(
+						project.NewRoleConditionSetEvent(context.Background(),
+							&project.NewAggregate("project1", "org1").Aggregate,
+							"admin",
+							&domain.ProjectRoleCondition{
+								MFALevel:       domain.MFALevelMultiFactorCertified,
+								IPRanges:       []string{"10.0.0.0/8"},
+								TimeOfDayStart: "08:00",
+								TimeOfDayEnd:   "18:00",
+								TimeZone:       "Europe/Zurich",
+							},
+						),
+					),
+				),
+			},
+			args: args{
+				roleKey: "admin",
+				condition: &domain.ProjectRoleCondition{
+					MFALevel:       domain.MFALevelMultiFactorCertified,
+					IPRanges:       []string{"10.0.0.0/8"},
+					TimeOfDayStart: "08:00",
+					TimeOfDayEnd:   "18:00",
+					TimeZone:       "Europe/Zurich",
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			name: "condition unchanged, no push",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						projectAddedEvent(),
+						projectRoleAddedEvent("admin"),
+						projectRoleConditionSetEvent("admin", &domain.ProjectRoleCondition{AMR: []string{"user"}}),
+					),
+				),
+			},
+			args: args{
+				roleKey:   "admin",
+				condition: &domain.ProjectRoleCondition{AMR: []string{"user"}},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			name: "remove condition, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						projectAddedEvent(),
+						projectRoleAddedEvent("admin"),
+						projectRoleConditionSetEvent("admin", &domain.ProjectRoleCondition{AMR: []string{"user"}}),
+					),
+					expectPush(
+						project.NewRoleConditionSetEvent(context.Background(),
+							&project.NewAggregate("project1", "org1").Aggregate,
+							"admin",
+							&domain.ProjectRoleCondition{},
+						),
+					),
+				),
+			},
+			args: args{
+				roleKey: "admin",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			name: "remove condition of removed role, not found",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						projectAddedEvent(),
+						projectRoleAddedEvent("admin"),
+						projectRoleConditionSetEvent("admin", &domain.ProjectRoleCondition{AMR: []string{"user"}}),
+						eventFromEventPusher(
+							project.NewRoleRemovedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"admin",
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				roleKey: "admin",
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := c.SetProjectRoleCondition(context.Background(), "project1", tt.args.roleKey, tt.args.condition, "org1")
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func projectRoleConditionSetEvent(key string, condition *domain.ProjectRoleCondition) eventstore.Event {
+	return eventFromEventPusher(
+		project.NewRoleConditionSetEvent(context.Background(),
+			&project.NewAggregate("project1", "org1").Aggregate,
+			key, condition,
+		),
+	)
+}
@@ -30,28 +30,35 @@ type SessionCommands struct {
 	intentWriteModel  *IDPIntentWriteModel
 	eventstore        *eventstore.Eventstore
 	eventCommands     []eventstore.Command
+	// breakGlassPolicy is set if the user of the session is a break-glass account
+	breakGlassPolicy *domain.BreakGlassPolicy
 
-	hasher      *crypto.Hasher
-	intentAlg   crypto.EncryptionAlgorithm
-	totpAlg     crypto.EncryptionAlgorithm
-	otpAlg      crypto.EncryptionAlgorithm
-	createCode  encryptedCodeWithDefaultFunc
-	createToken func(sessionID string) (id string, token string, err error)
-	now         func() time.Time
+	hasher              *crypto.Hasher
+	intentAlg           crypto.EncryptionAlgorithm
+	totpAlg             crypto.EncryptionAlgorithm
+	otpAlg              crypto.EncryptionAlgorithm
+	createCode          encryptedCodeWithDefaultFunc
+	createToken         func(sessionID string) (id string, token string, err error)
+	createPushChallenge func() (string, error)
+	// applyIDPMappingRules applies the mapping rules of the IdP to a user linked after the intent succeeded
+	applyIDPMappingRules func(ctx context.Context, idpID, userID string, idpInfo []byte, attributes map[string][]string)
+	now                  func() time.Time
 }
 
 func (c *Commands) NewSessionCommands(cmds []SessionCommand, session *SessionWriteModel) *SessionCommands {
 	return &SessionCommands{
-		sessionCommands:   cmds,
-		sessionWriteModel: session,
-		eventstore:        c.eventstore,
-		hasher:            c.userPasswordHasher,
-		intentAlg:         c.idpConfigEncryption,
-		totpAlg:           c.multifactors.OTP.CryptoMFA,
-		otpAlg:            c.userEncryption,
-		createCode:        c.newEncryptedCodeWithDefault,
-		createToken:       c.sessionTokenCreator,
-		now:               time.Now,
+		sessionCommands:      cmds,
+		sessionWriteModel:    session,
+		eventstore:           c.eventstore,
+		hasher:               c.userPasswordHasher,
+		intentAlg:            c.idpConfigEncryption,
+		totpAlg:              c.multifactors.OTP.CryptoMFA,
+		otpAlg:               c.userEncryption,
+		createCode:           c.newEncryptedCodeWithDefault,
+		createToken:          c.sessionTokenCreator,
+		createPushChallenge:  generatePushChallenge,
+		applyIDPMappingRules: c.applyIDPMappingRules,
+		now:                  time.Now,
 	}
 }
 
@@ -68,7 +75,7 @@ func CheckUser(id string, resourceOwner string, preferredLanguage *language.Tag)
 // CheckPassword defines a password check to be executed for a session update
 func CheckPassword(password string) SessionCommand {
 	return func(ctx context.Context, cmd *SessionCommands) ([]eventstore.Command, error) {
-		commands, err := checkPassword(ctx, cmd.sessionWriteModel.UserID, password, cmd.eventstore, cmd.hasher, nil)
+		commands, err := checkPassword(ctx, cmd.sessionWriteModel.UserID, password, cmd.eventstore, cmd.hasher, userAgentToAuthRequestInfo(cmd.sessionWriteModel.UserAgent))
 		if err != nil {
 			return commands, err
 		}
@@ -108,6 +115,9 @@ func CheckIntent(intentID, token string) SessionCommand {
 			if linkWriteModel.State != domain.UserIDPLinkStateActive {
 				return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-O8xk3w", "Errors.Intent.OtherUser")
 			}
+			// the user was created (or linked) from the intent after it succeeded,
+			// so the mapping rules are applied on the first login instead of the next one
+			cmd.applyIDPMappingRules(ctx, cmd.intentWriteModel.IDPID, cmd.sessionWriteModel.UserID, cmd.intentWriteModel.IDPUser, cmd.intentWriteModel.IDPEntryAttributes)
 		}
 		cmd.IntentChecked(ctx, cmd.now())
 		return nil, nil
@@ -147,6 +157,8 @@ func (s *SessionCommands) Exec(ctx context.Context) ([]eventstore.Command, error
 
 func (s *SessionCommands) Start(ctx context.Context, userAgent *domain.UserAgent) {
 	s.eventCommands = append(s.eventCommands, session.NewAddedEvent(ctx, s.sessionWriteModel.aggregate, userAgent))
+	// set the user agent so the checks of the user can use it
+	s.sessionWriteModel.UserAgent = userAgent
 }
 
 func (s *SessionCommands) UserChecked(ctx context.Context, userID, resourceOwner string, checkedAt time.Time, preferredLanguage *language.Tag) error {
@@ -176,6 +188,11 @@ func (s *SessionCommands) WebAuthNChecked(ctx context.Context, checkedAt time.Ti
 	if s.sessionWriteModel.WebAuthNChallenge.UserVerification == domain.UserVerificationRequirementRequired {
 		s.eventCommands = append(s.eventCommands,
 			user.NewHumanPasswordlessSignCountChangedEvent(ctx, s.sessionWriteModel.aggregate, tokenID, signCount),
+			// the passkey login is recorded on the user as well, the same way a password check is
+			user.NewHumanPasswordlessCheckSucceededEvent(ctx,
+				&user.NewAggregate(s.sessionWriteModel.UserID, s.sessionWriteModel.UserResourceOwner).Aggregate,
+				userAgentToAuthRequestInfo(s.sessionWriteModel.UserAgent),
+			),
 		)
 	} else {
 		s.eventCommands = append(s.eventCommands,
@@ -188,22 +205,30 @@ func (s *SessionCommands) TOTPChecked(ctx context.Context, checkedAt time.Time)
 	s.eventCommands = append(s.eventCommands, session.NewTOTPCheckedEvent(ctx, s.sessionWriteModel.aggregate, checkedAt))
 }
 
-func (s *SessionCommands) OTPSMSChallenged(ctx context.Context, code *crypto.CryptoValue, expiry time.Duration, returnCode bool) {
-	s.eventCommands = append(s.eventCommands, session.NewOTPSMSChallengedEvent(ctx, s.sessionWriteModel.aggregate, code, expiry, returnCode))
+func (s *SessionCommands) OTPSMSChallenged(ctx context.Context, code *crypto.CryptoValue, expiry time.Duration, returnCode bool, delivery *domain.OTPDelivery) {
+	s.eventCommands = append(s.eventCommands, session.NewOTPSMSChallengedEvent(ctx, s.sessionWriteModel.aggregate, code, expiry, returnCode, delivery))
 }
 
 func (s *SessionCommands) OTPSMSChecked(ctx context.Context, checkedAt time.Time) {
 	s.eventCommands = append(s.eventCommands, session.NewOTPSMSCheckedEvent(ctx, s.sessionWriteModel.aggregate, checkedAt))
 }
 
-func (s *SessionCommands) OTPEmailChallenged(ctx context.Context, code *crypto.CryptoValue, expiry time.Duration, returnCode bool, urlTmpl string) {
-	s.eventCommands = append(s.eventCommands, session.NewOTPEmailChallengedEvent(ctx, s.sessionWriteModel.aggregate, code, expiry, returnCode, urlTmpl))
+func (s *SessionCommands) OTPEmailChallenged(ctx context.Context, code *crypto.CryptoValue, expiry time.Duration, returnCode bool, urlTmpl string, delivery *domain.OTPDelivery) {
+	s.eventCommands = append(s.eventCommands, session.NewOTPEmailChallengedEvent(ctx, s.sessionWriteModel.aggregate, code, expiry, returnCode, urlTmpl, delivery))
 }
 
 func (s *SessionCommands) OTPEmailChecked(ctx context.Context, checkedAt time.Time) {
 	s.eventCommands = append(s.eventCommands, session.NewOTPEmailCheckedEvent(ctx, s.sessionWriteModel.aggregate, checkedAt))
 }
 
+func (s *SessionCommands) PushChallenged(ctx context.Context, challenge string, expiry time.Duration, devices []*session.PushDevice) {
+	s.eventCommands = append(s.eventCommands, session.NewPushChallengedEvent(ctx, s.sessionWriteModel.aggregate, challenge, expiry, devices))
+}
+
+func (s *SessionCommands) PushChecked(ctx context.Context, checkedAt time.Time, deviceID string) {
+	s.eventCommands = append(s.eventCommands, session.NewPushCheckedEvent(ctx, s.sessionWriteModel.aggregate, checkedAt, deviceID))
+}
+
 func (s *SessionCommands) SetToken(ctx context.Context, tokenID string) {
 	// trigger activity log for session for user
 	activity.Trigger(ctx, s.sessionWriteModel.UserResourceOwner, s.sessionWriteModel.UserID, activity.SessionAPI, s.eventstore.FilterToQueryReducer)
@@ -238,6 +263,9 @@ func (s *SessionCommands) SetLifetime(ctx context.Context, lifetime time.Duratio
 	if lifetime < 0 {
 		return zerrors.ThrowInvalidArgument(nil, "COMMAND-asEG4", "Errors.Session.PositiveLifetime")
 	}
+	if s.breakGlassPolicy != nil {
+		lifetime = s.breakGlassPolicy.Lifetime(lifetime)
+	}
 	if lifetime == 0 {
 		return nil
 	}
@@ -343,6 +371,9 @@ func (c *Commands) updateSession(ctx context.Context, checks *SessionCommands, m
 		}
 		return nil, err
 	}
+	if err = c.checkBreakGlassSession(ctx, checks, lifetime); err != nil {
+		return nil, err
+	}
 	checks.ChangeMetadata(ctx, metadata)
 	err = checks.SetLifetime(ctx, lifetime)
 	if err != nil {
@@ -19,6 +19,12 @@ type WebAuthNChallengeModel struct {
 	RPID               string
 }
 
+type PushChallengeModel struct {
+	Challenge    string
+	Expiry       time.Duration
+	CreationDate time.Time
+}
+
 type OTPCode struct {
 	Code         *crypto.CryptoValue
 	Expiry       time.Duration
@@ -50,6 +56,7 @@ type SessionWriteModel struct {
 	TOTPCheckedAt        time.Time
 	OTPSMSCheckedAt      time.Time
 	OTPEmailCheckedAt    time.Time
+	PushCheckedAt        time.Time
 	WebAuthNUserVerified bool
 	Metadata             map[string][]byte
 	State                domain.SessionState
@@ -59,6 +66,7 @@ type SessionWriteModel struct {
 	WebAuthNChallenge     *WebAuthNChallengeModel
 	OTPSMSCodeChallenge   *OTPCode
 	OTPEmailCodeChallenge *OTPCode
+	PushChallenge         *PushChallengeModel
 
 	aggregate *eventstore.Aggregate
 }
@@ -98,6 +106,10 @@ func (wm *SessionWriteModel) Reduce() error {
 			wm.reduceOTPEmailChallenged(e)
 		case *session.OTPEmailCheckedEvent:
 			wm.reduceOTPEmailChecked(e)
+		case *session.PushChallengedEvent:
+			wm.reducePushChallenged(e)
+		case *session.PushCheckedEvent:
+			wm.reducePushChecked(e)
 		case *session.TokenSetEvent:
 			wm.reduceTokenSet(e)
 		case *session.LifetimeSetEvent:
@@ -126,6 +138,8 @@ func (wm *SessionWriteModel) Query() *eventstore.SearchQueryBuilder {
 			session.OTPSMSCheckedType,
 			session.OTPEmailChallengedType,
 			session.OTPEmailCheckedType,
+			session.PushChallengedType,
+			session.PushCheckedType,
 			session.TokenSetType,
 			session.MetadataSetType,
 			session.LifetimeSetType,
@@ -204,6 +218,19 @@ func (wm *SessionWriteModel) reduceOTPEmailChecked(e *session.OTPEmailCheckedEve
 	wm.OTPEmailCheckedAt = e.CheckedAt
 }
 
+func (wm *SessionWriteModel) reducePushChallenged(e *session.PushChallengedEvent) {
+	wm.PushChallenge = &PushChallengeModel{
+		Challenge:    e.Challenge,
+		Expiry:       e.Expiry,
+		CreationDate: e.CreationDate(),
+	}
+}
+
+func (wm *SessionWriteModel) reducePushChecked(e *session.PushCheckedEvent) {
+	wm.PushChallenge = nil
+	wm.PushCheckedAt = e.CheckedAt
+}
+
 func (wm *SessionWriteModel) reduceTokenSet(e *session.TokenSetEvent) {
 	wm.TokenID = e.TokenID
 }
@@ -226,6 +253,7 @@ func (wm *SessionWriteModel) AuthenticationTime() time.Time {
 		wm.IntentCheckedAt,
 		wm.OTPSMSCheckedAt,
 		wm.OTPEmailCheckedAt,
+		wm.PushCheckedAt,
 	} {
 		if check.After(authTime) {
 			authTime = check
@@ -259,6 +287,9 @@ func (wm *SessionWriteModel) AuthMethodTypes() []domain.UserAuthMethodType {
 	if !wm.OTPEmailCheckedAt.IsZero() {
 		types = append(types, domain.UserAuthMethodTypeOTPEmail)
 	}
+	if !wm.PushCheckedAt.IsZero() {
+		types = append(types, domain.UserAuthMethodTypePush)
+	}
 	return types
 }
 
@@ -274,6 +305,11 @@ func (wm *SessionWriteModel) CheckNotInvalidated() error {
 	return nil
 }
 
+// isUserVerifiedByWebAuthN returns true if the session was checked by WebAuthN with user verification (passkey).
+func (wm *SessionWriteModel) isUserVerifiedByWebAuthN() bool {
+	return !wm.WebAuthNCheckedAt.IsZero() && wm.WebAuthNUserVerified
+}
+
 // CheckIsActive checks that the session was not invalidated ([CheckNotInvalidated]) and actually already exists.
 func (wm *SessionWriteModel) CheckIsActive() error {
 	if wm.State == domain.SessionStateUnspecified {
@@ -33,6 +33,16 @@ func (c *Commands) createOTPSMSChallenge(returnCode bool, dst *string) SessionCo
 		if !writeModel.OTPAdded() {
 			return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-BJ2g3", "Errors.User.MFA.OTP.NotReady")
 		}
+		var delivery *domain.OTPDelivery
+		if !returnCode {
+			if err := c.checkOTPSMSCountry(ctx, cmd.eventstore.Filter, writeModel.Phone()); err != nil { //nolint:staticcheck
+				return nil, err
+			}
+			delivery = otpDelivery(ctx, c.otpRateLimits.SMS, cmd.sessionWriteModel.UserID, writeModel.Phone())
+			if err := checkOTPRateLimit(ctx, cmd.eventstore.Filter, c.otpRateLimits.SMS, otpChannelSMS, delivery, cmd.now()); err != nil { //nolint:staticcheck
+				return nil, err
+			}
+		}
 		code, err := cmd.createCode(ctx, cmd.eventstore.Filter, domain.SecretGeneratorTypeOTPSMS, cmd.otpAlg, c.defaultSecretGenerators.OTPSMS)
 		if err != nil {
 			return nil, err
@@ -40,7 +50,7 @@ func (c *Commands) createOTPSMSChallenge(returnCode bool, dst *string) SessionCo
 		if returnCode {
 			*dst = code.Plain
 		}
-		cmd.OTPSMSChallenged(ctx, code.Crypted, code.Expiry, returnCode)
+		cmd.OTPSMSChallenged(ctx, code.Crypted, code.Expiry, returnCode, delivery)
 		return nil, nil
 	}
 }
@@ -86,6 +96,13 @@ func (c *Commands) createOTPEmailChallenge(returnCode bool, urlTmpl string, dst
 		if !writeModel.OTPAdded() {
 			return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-JKLJ3", "Errors.User.MFA.OTP.NotReady")
 		}
+		var delivery *domain.OTPDelivery
+		if !returnCode {
+			delivery = otpDelivery(ctx, c.otpRateLimits.Email, cmd.sessionWriteModel.UserID, "")
+			if err := checkOTPRateLimit(ctx, cmd.eventstore.Filter, c.otpRateLimits.Email, otpChannelEmail, delivery, cmd.now()); err != nil { //nolint:staticcheck
+				return nil, err
+			}
+		}
 		code, err := cmd.createCode(ctx, cmd.eventstore.Filter, domain.SecretGeneratorTypeOTPEmail, cmd.otpAlg, c.defaultSecretGenerators.OTPEmail)
 		if err != nil {
 			return nil, err
@@ -93,7 +110,7 @@ func (c *Commands) createOTPEmailChallenge(returnCode bool, urlTmpl string, dst
 		if returnCode {
 			*dst = code.Plain
 		}
-		cmd.OTPEmailChallenged(ctx, code.Crypted, code.Expiry, returnCode, urlTmpl)
+		cmd.OTPEmailChallenged(ctx, code.Crypted, code.Expiry, returnCode, urlTmpl, delivery)
 		return nil, nil
 	}
 }
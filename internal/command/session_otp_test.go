@@ -8,9 +8,11 @@ import (
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 
+	sd "github.com/zitadel/zitadel/internal/config/systemdefaults"
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
 	"github.com/zitadel/zitadel/internal/repository/org"
 	"github.com/zitadel/zitadel/internal/repository/session"
 	"github.com/zitadel/zitadel/internal/repository/user"
@@ -80,6 +82,7 @@ func TestCommands_CreateOTPSMSChallengeReturnCode(t *testing.T) {
 						},
 						5*time.Minute,
 						true,
+						nil,
 					),
 				},
 			},
@@ -122,9 +125,10 @@ func TestCommands_CreateOTPSMSChallengeReturnCode(t *testing.T) {
 
 func TestCommands_CreateOTPSMSChallenge(t *testing.T) {
 	type fields struct {
-		userID     string
-		eventstore func(*testing.T) *eventstore.Eventstore
-		createCode encryptedCodeWithDefaultFunc
+		userID        string
+		eventstore    func(*testing.T) *eventstore.Eventstore
+		createCode    encryptedCodeWithDefaultFunc
+		otpRateLimits sd.OTPRateLimits
 	}
 	type res struct {
 		err      error
@@ -157,6 +161,146 @@ func TestCommands_CreateOTPSMSChallenge(t *testing.T) {
 				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-BJ2g3", "Errors.User.MFA.OTP.NotReady"),
 			},
 		},
+		{
+			name: "country not allowed, precondition error",
+			fields: fields{
+				userID: "userID",
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanPhoneChangedEvent(context.Background(), &user.NewAggregate("userID", "org").Aggregate, "+4930123456"),
+						),
+						eventFromEventPusher(
+							user.NewHumanOTPSMSAddedEvent(context.Background(), &user.NewAggregate("userID", "org").Aggregate),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							securityPolicySMSAllowedCountriesEvent("CH"),
+						),
+					),
+				),
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Otp4c", "Errors.User.MFA.OTP.CountryNotAllowed"),
+			},
+		},
+		{
+			name: "cooldown active, resource exhausted error",
+			fields: fields{
+				userID: "userID",
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanOTPSMSAddedEvent(context.Background(), &user.NewAggregate("userID", "org").Aggregate),
+						),
+					),
+					expectFilter(),
+					expectFilter(
+						eventFromEventPusher(
+							session.NewOTPSMSChallengedEvent(context.Background(), &session.NewAggregate("sessionID2", "instanceID").Aggregate,
+								&crypto.CryptoValue{
+									CryptoType: crypto.TypeEncryption,
+									Algorithm:  "enc",
+									KeyID:      "id",
+									Crypted:    []byte("1234567"),
+								},
+								5*time.Minute,
+								false,
+								&domain.OTPDelivery{UserID: "userID"},
+							),
+						),
+					),
+				),
+				otpRateLimits: sd.OTPRateLimits{
+					SMS: sd.OTPRateLimit{Cooldown: time.Minute},
+				},
+			},
+			res: res{
+				err: zerrors.ThrowResourceExhausted(nil, "COMMAND-Otp2c", "Errors.User.MFA.OTP.CooldownActive"),
+			},
+		},
+		{
+			name: "phone prefix limit reached, resource exhausted error",
+			fields: fields{
+				userID: "userID",
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanPhoneChangedEvent(context.Background(), &user.NewAggregate("userID", "org").Aggregate, "+41711234567"),
+						),
+						eventFromEventPusher(
+							user.NewHumanOTPSMSAddedEvent(context.Background(), &user.NewAggregate("userID", "org").Aggregate),
+						),
+					),
+					expectFilter(),
+					expectFilter(),
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanOTPSMSCodeAddedEvent(context.Background(), &user.NewAggregate("userID2", "org").Aggregate,
+								&crypto.CryptoValue{
+									CryptoType: crypto.TypeEncryption,
+									Algorithm:  "enc",
+									KeyID:      "id",
+									Crypted:    []byte("1234567"),
+								},
+								5*time.Minute,
+								nil,
+								&domain.OTPDelivery{UserID: "userID2", PhonePrefix: "+4171"},
+							),
+						),
+					),
+				),
+				otpRateLimits: sd.OTPRateLimits{
+					SMS: sd.OTPRateLimit{Window: time.Hour, PerUser: 5, PerPhonePrefix: 1, PhonePrefixLength: 4},
+				},
+			},
+			res: res{
+				err: zerrors.ThrowResourceExhausted(nil, "COMMAND-Otp3l", "Errors.User.MFA.OTP.RateLimited"),
+			},
+		},
+		{
+			name: "generate code, rate limited",
+			fields: fields{
+				userID: "userID",
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanPhoneChangedEvent(context.Background(), &user.NewAggregate("userID", "org").Aggregate, "+41711234567"),
+						),
+						eventFromEventPusher(
+							user.NewHumanOTPSMSAddedEvent(context.Background(), &user.NewAggregate("userID", "org").Aggregate),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							securityPolicySMSAllowedCountriesEvent("CH"),
+						),
+					),
+					expectFilter(),
+					expectFilter(),
+				),
+				createCode: mockEncryptedCodeWithDefault("1234567", 5*time.Minute),
+				otpRateLimits: sd.OTPRateLimits{
+					SMS: sd.OTPRateLimit{Window: time.Hour, PerUser: 5, PerPhonePrefix: 10, PhonePrefixLength: 4},
+				},
+			},
+			res: res{
+				commands: []eventstore.Command{
+					session.NewOTPSMSChallengedEvent(context.Background(), &session.NewAggregate("sessionID", "instanceID").Aggregate,
+						&crypto.CryptoValue{
+							CryptoType: crypto.TypeEncryption,
+							Algorithm:  "enc",
+							KeyID:      "id",
+							Crypted:    []byte("1234567"),
+						},
+						5*time.Minute,
+						false,
+						&domain.OTPDelivery{UserID: "userID", PhonePrefix: "+4171"},
+					),
+				},
+			},
+		},
 		{
 			name: "generate code",
 			fields: fields{
@@ -167,6 +311,7 @@ func TestCommands_CreateOTPSMSChallenge(t *testing.T) {
 							user.NewHumanOTPSMSAddedEvent(context.Background(), &user.NewAggregate("userID", "org").Aggregate),
 						),
 					),
+					expectFilter(),
 				),
 				createCode: mockEncryptedCodeWithDefault("1234567", 5*time.Minute),
 			},
@@ -181,6 +326,7 @@ func TestCommands_CreateOTPSMSChallenge(t *testing.T) {
 						},
 						5*time.Minute,
 						false,
+						nil,
 					),
 				},
 			},
@@ -194,6 +340,7 @@ func TestCommands_CreateOTPSMSChallenge(t *testing.T) {
 				defaultSecretGenerators: &SecretGenerators{
 					OTPSMS: emptyConfig,
 				},
+				otpRateLimits: tt.fields.otpRateLimits,
 			}
 
 			cmd := c.CreateOTPSMSChallenge()
@@ -264,6 +411,7 @@ func TestCommands_OTPSMSSent(t *testing.T) {
 								},
 								5*time.Minute,
 								false,
+								nil,
 							),
 						),
 					),
@@ -378,6 +526,7 @@ func TestCommands_CreateOTPEmailChallengeURLTemplate(t *testing.T) {
 						5*time.Minute,
 						false,
 						"https://example.com/mfa/email?userID={{.UserID}}&code={{.Code}}&lang={{.PreferredLanguage}}",
+						nil,
 					),
 				},
 			},
@@ -484,6 +633,7 @@ func TestCommands_CreateOTPEmailChallengeReturnCode(t *testing.T) {
 						5*time.Minute,
 						true,
 						"",
+						nil,
 					),
 				},
 			},
@@ -526,9 +676,10 @@ func TestCommands_CreateOTPEmailChallengeReturnCode(t *testing.T) {
 
 func TestCommands_CreateOTPEmailChallenge(t *testing.T) {
 	type fields struct {
-		userID     string
-		eventstore func(*testing.T) *eventstore.Eventstore
-		createCode encryptedCodeWithDefaultFunc
+		userID        string
+		eventstore    func(*testing.T) *eventstore.Eventstore
+		createCode    encryptedCodeWithDefaultFunc
+		otpRateLimits sd.OTPRateLimits
 	}
 	type res struct {
 		err      error
@@ -560,6 +711,41 @@ func TestCommands_CreateOTPEmailChallenge(t *testing.T) {
 				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-JKLJ3", "Errors.User.MFA.OTP.NotReady"),
 			},
 		},
+		{
+			name: "instance limit reached, resource exhausted error",
+			fields: fields{
+				userID: "userID",
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanOTPEmailAddedEvent(context.Background(), &user.NewAggregate("userID", "org").Aggregate),
+						),
+					),
+					expectFilter(),
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanOTPEmailCodeAddedEvent(context.Background(), &user.NewAggregate("userID2", "org").Aggregate,
+								&crypto.CryptoValue{
+									CryptoType: crypto.TypeEncryption,
+									Algorithm:  "enc",
+									KeyID:      "id",
+									Crypted:    []byte("1234567"),
+								},
+								5*time.Minute,
+								nil,
+								&domain.OTPDelivery{UserID: "userID2"},
+							),
+						),
+					),
+				),
+				otpRateLimits: sd.OTPRateLimits{
+					Email: sd.OTPRateLimit{Window: time.Hour, PerUser: 5, PerInstance: 1},
+				},
+			},
+			res: res{
+				err: zerrors.ThrowResourceExhausted(nil, "COMMAND-Otp3l", "Errors.User.MFA.OTP.RateLimited"),
+			},
+		},
 		{
 			name: "generate code",
 			fields: fields{
@@ -585,6 +771,7 @@ func TestCommands_CreateOTPEmailChallenge(t *testing.T) {
 						5*time.Minute,
 						false,
 						"",
+						nil,
 					),
 				},
 			},
@@ -598,6 +785,7 @@ func TestCommands_CreateOTPEmailChallenge(t *testing.T) {
 				defaultSecretGenerators: &SecretGenerators{
 					OTPEmail: emptyConfig,
 				},
+				otpRateLimits: tt.fields.otpRateLimits,
 			}
 
 			cmd := c.CreateOTPEmailChallenge()
@@ -669,6 +857,7 @@ func TestCommands_OTPEmailSent(t *testing.T) {
 								5*time.Minute,
 								false,
 								"",
+								nil,
 							),
 						),
 					),
@@ -849,7 +1038,7 @@ func TestCheckOTPSMS(t *testing.T) {
 				err: zerrors.ThrowPreconditionFailed(nil, "CODE-QvUQ4P", "Errors.User.Code.Expired"),
 				errorCommands: []eventstore.Command{
 					user.NewHumanOTPSMSCheckFailedEvent(context.Background(), &user.NewAggregate("userID", "org1").Aggregate, nil),
-					user.NewUserLockedEvent(context.Background(), &user.NewAggregate("userID", "org1").Aggregate),
+					user.NewUserLockoutEvent(context.Background(), &user.NewAggregate("userID", "org1").Aggregate),
 				},
 			},
 		},
@@ -1101,7 +1290,7 @@ func TestCheckOTPEmail(t *testing.T) {
 				err: zerrors.ThrowPreconditionFailed(nil, "CODE-QvUQ4P", "Errors.User.Code.Expired"),
 				errorCommands: []eventstore.Command{
 					user.NewHumanOTPEmailCheckFailedEvent(context.Background(), &user.NewAggregate("userID", "org1").Aggregate, nil),
-					user.NewUserLockedEvent(context.Background(), &user.NewAggregate("userID", "org1").Aggregate),
+					user.NewUserLockoutEvent(context.Background(), &user.NewAggregate("userID", "org1").Aggregate),
 				},
 			},
 		},
@@ -1199,3 +1388,11 @@ func TestCheckOTPEmail(t *testing.T) {
 		})
 	}
 }
+
+func securityPolicySMSAllowedCountriesEvent(countries ...string) *instance.SecurityPolicySetEvent {
+	event, _ := instance.NewSecurityPolicySetEvent(context.Background(),
+		&instance.NewAggregate("instanceID").Aggregate,
+		[]instance.SecurityPolicyChanges{instance.ChangeSecurityPolicySMSAllowedCountries(countries)},
+	)
+	return event
+}
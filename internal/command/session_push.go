@@ -0,0 +1,106 @@
+package command
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/session"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	pushChallengeLength = 32
+	pushChallengeExpiry = 2 * time.Minute
+)
+
+// CreatePushChallenge creates a challenge, which is sent to all registered push devices of the user.
+// The challenge is returned in dst, so it can be displayed to the user to be compared with the one on the device.
+func (c *Commands) CreatePushChallenge(dst *string) SessionCommand {
+	return func(ctx context.Context, cmd *SessionCommands) ([]eventstore.Command, error) {
+		if cmd.sessionWriteModel.UserID == "" {
+			return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Pu7id", "Errors.User.UserIDMissing")
+		}
+		writeModel := NewHumanPushDevicesWriteModel(cmd.sessionWriteModel.UserID, "")
+		if err := cmd.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+			return nil, err
+		}
+		if len(writeModel.Devices) == 0 {
+			return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Pu8nr", "Errors.User.MFA.Push.NotReady")
+		}
+		challenge, err := cmd.createPushChallenge()
+		if err != nil {
+			return nil, err
+		}
+		if dst != nil {
+			*dst = challenge
+		}
+		devices := make([]*session.PushDevice, len(writeModel.Devices))
+		for i, device := range writeModel.Devices {
+			devices[i] = &session.PushDevice{
+				DeviceID:  device.DeviceID,
+				Platform:  device.Platform,
+				PushToken: device.PushToken,
+			}
+		}
+		cmd.PushChallenged(ctx, challenge, pushChallengeExpiry, devices)
+		return nil, nil
+	}
+}
+
+// PushChallengeSent marks the push challenge of the session as sent to the devices of the user.
+func (c *Commands) PushChallengeSent(ctx context.Context, sessionID, resourceOwner string) error {
+	sessionWriteModel := NewSessionWriteModel(sessionID, resourceOwner)
+	err := c.eventstore.FilterToQueryReducer(ctx, sessionWriteModel)
+	if err != nil {
+		return err
+	}
+	if sessionWriteModel.PushChallenge == nil {
+		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-Pu9nc", "Errors.Session.Push.NoChallenge")
+	}
+	return c.pushAppendAndReduce(ctx, sessionWriteModel,
+		session.NewPushSentEvent(ctx, &session.NewAggregate(sessionID, sessionWriteModel.ResourceOwner).Aggregate),
+	)
+}
+
+// CheckPush checks the approval of the push challenge, which is the signature of the challenge
+// created by the device with its private key.
+func CheckPush(deviceID string, signature []byte) SessionCommand {
+	return func(ctx context.Context, cmd *SessionCommands) ([]eventstore.Command, error) {
+		challenge := cmd.sessionWriteModel.PushChallenge
+		if challenge == nil {
+			return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Pv1nc", "Errors.Session.Push.NoChallenge")
+		}
+		if crypto.IsCodeExpired(challenge.CreationDate, challenge.Expiry) {
+			return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Pv2ex", "Errors.Session.Push.Expired")
+		}
+		writeModel := NewHumanPushDevicesWriteModel(cmd.sessionWriteModel.UserID, "")
+		if err := cmd.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+			return nil, err
+		}
+		device := writeModel.Device(deviceID)
+		if device == nil {
+			return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Pv3nf", "Errors.User.MFA.Push.NotExisting")
+		}
+		userAgg := UserAggregateFromWriteModel(&writeModel.WriteModel)
+		if err := domain.VerifyPushApproval(device.PublicKey, challenge.Challenge, signature); err != nil {
+			return []eventstore.Command{user.NewHumanPushCheckFailedEvent(ctx, userAgg, deviceID)}, err
+		}
+		cmd.eventCommands = append(cmd.eventCommands, user.NewHumanPushCheckSucceededEvent(ctx, userAgg, deviceID))
+		cmd.PushChecked(ctx, cmd.now(), deviceID)
+		return nil, nil
+	}
+}
+
+func generatePushChallenge() (string, error) {
+	challenge := make([]byte, pushChallengeLength)
+	if _, err := rand.Read(challenge); err != nil {
+		return "", zerrors.ThrowInternal(err, "COMMAND-Pv4rn", "Errors.Internal")
+	}
+	return base64.RawURLEncoding.EncodeToString(challenge), nil
+}
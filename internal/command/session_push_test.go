@@ -0,0 +1,358 @@
+package command
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/session"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommands_CreatePushChallenge(t *testing.T) {
+	type fields struct {
+		userID     string
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type res struct {
+		err       error
+		challenge string
+		commands  []eventstore.Command
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		res    res
+	}{
+		{
+			name: "userID missing, precondition error",
+			fields: fields{
+				userID:     "",
+				eventstore: expectEventstore(),
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Pu7id", "Errors.User.UserIDMissing"),
+			},
+		},
+		{
+			name: "no devices, precondition error",
+			fields: fields{
+				userID: "userID",
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanPushDeviceAddedEvent(context.Background(), &user.NewAggregate("userID", "org").Aggregate,
+								"deviceID", "phone", domain.PushPlatformFCM, []byte("publicKey"), "pushToken"),
+						),
+						eventFromEventPusher(
+							user.NewHumanPushDeviceRemovedEvent(context.Background(), &user.NewAggregate("userID", "org").Aggregate, "deviceID"),
+						),
+					),
+				),
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Pu8nr", "Errors.User.MFA.Push.NotReady"),
+			},
+		},
+		{
+			name: "challenge devices",
+			fields: fields{
+				userID: "userID",
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanPushDeviceAddedEvent(context.Background(), &user.NewAggregate("userID", "org").Aggregate,
+								"deviceID1", "phone", domain.PushPlatformFCM, []byte("publicKey"), "pushToken1"),
+						),
+						eventFromEventPusher(
+							user.NewHumanPushDeviceAddedEvent(context.Background(), &user.NewAggregate("userID", "org").Aggregate,
+								"deviceID2", "tablet", domain.PushPlatformAPNs, []byte("publicKey"), "pushToken2"),
+						),
+					),
+				),
+			},
+			res: res{
+				challenge: "challenge",
+				commands: []eventstore.Command{
+					session.NewPushChallengedEvent(context.Background(), &session.NewAggregate("sessionID", "instanceID").Aggregate,
+						"challenge",
+						2*time.Minute,
+						[]*session.PushDevice{
+							{DeviceID: "deviceID1", Platform: domain.PushPlatformFCM, PushToken: "pushToken1"},
+							{DeviceID: "deviceID2", Platform: domain.PushPlatformAPNs, PushToken: "pushToken2"},
+						},
+					),
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{}
+			var dst string
+			cmd := c.CreatePushChallenge(&dst)
+
+			sessionModel := &SessionWriteModel{
+				UserID:        tt.fields.userID,
+				UserCheckedAt: testNow,
+				State:         domain.SessionStateActive,
+				aggregate:     &session.NewAggregate("sessionID", "instanceID").Aggregate,
+			}
+			cmds := &SessionCommands{
+				sessionCommands:   []SessionCommand{cmd},
+				sessionWriteModel: sessionModel,
+				eventstore:        tt.fields.eventstore(t),
+				createPushChallenge: func() (string, error) {
+					return "challenge", nil
+				},
+				now: time.Now,
+			}
+
+			gotCmds, err := cmd(context.Background(), cmds)
+			assert.ErrorIs(t, err, tt.res.err)
+			assert.Empty(t, gotCmds)
+			assert.Equal(t, tt.res.challenge, dst)
+			assert.Equal(t, tt.res.commands, cmds.eventCommands)
+		})
+	}
+}
+
+func TestCommands_PushChallengeSent(t *testing.T) {
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx           context.Context
+		sessionID     string
+		resourceOwner string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr error
+	}{
+		{
+			name: "not challenged, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				sessionID:     "sessionID",
+				resourceOwner: "instanceID",
+			},
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Pu9nc", "Errors.Session.Push.NoChallenge"),
+		},
+		{
+			name: "challenged and sent",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							session.NewPushChallengedEvent(context.Background(), &session.NewAggregate("sessionID", "instanceID").Aggregate,
+								"challenge",
+								2*time.Minute,
+								[]*session.PushDevice{
+									{DeviceID: "deviceID", Platform: domain.PushPlatformFCM, PushToken: "pushToken"},
+								},
+							),
+						),
+					),
+					expectPush(
+						session.NewPushSentEvent(context.Background(), &session.NewAggregate("sessionID", "instanceID").Aggregate),
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				sessionID:     "sessionID",
+				resourceOwner: "instanceID",
+			},
+			wantErr: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			err := c.PushChallengeSent(tt.args.ctx, tt.args.sessionID, tt.args.resourceOwner)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestCheckPush(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	publicKey, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+	digest := sha256.Sum256([]byte("challenge"))
+	signature, err := ecdsa.SignASN1(rand.Reader, key, digest[:])
+	require.NoError(t, err)
+
+	deviceAddedEvent := func() eventstore.Event {
+		return eventFromEventPusher(
+			user.NewHumanPushDeviceAddedEvent(context.Background(), &user.NewAggregate("userID", "org1").Aggregate,
+				"deviceID", "phone", domain.PushPlatformFCM, publicKey, "pushToken"),
+		)
+	}
+
+	type fields struct {
+		eventstore    func(*testing.T) *eventstore.Eventstore
+		pushChallenge *PushChallengeModel
+	}
+	type args struct {
+		deviceID  string
+		signature []byte
+	}
+	type res struct {
+		err           error
+		commands      []eventstore.Command
+		errorCommands []eventstore.Command
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "missing challenge",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				deviceID:  "deviceID",
+				signature: signature,
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Pv1nc", "Errors.Session.Push.NoChallenge"),
+			},
+		},
+		{
+			name: "expired challenge",
+			fields: fields{
+				eventstore: expectEventstore(),
+				pushChallenge: &PushChallengeModel{
+					Challenge:    "challenge",
+					Expiry:       2 * time.Minute,
+					CreationDate: testNow.Add(-time.Hour),
+				},
+			},
+			args: args{
+				deviceID:  "deviceID",
+				signature: signature,
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Pv2ex", "Errors.Session.Push.Expired"),
+			},
+		},
+		{
+			name: "unknown device",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(deviceAddedEvent()),
+				),
+				pushChallenge: &PushChallengeModel{
+					Challenge:    "challenge",
+					Expiry:       2 * time.Minute,
+					CreationDate: testNow,
+				},
+			},
+			args: args{
+				deviceID:  "otherDeviceID",
+				signature: signature,
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Pv3nf", "Errors.User.MFA.Push.NotExisting"),
+			},
+		},
+		{
+			name: "invalid signature",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(deviceAddedEvent()),
+				),
+				pushChallenge: &PushChallengeModel{
+					Challenge:    "challenge",
+					Expiry:       2 * time.Minute,
+					CreationDate: testNow,
+				},
+			},
+			args: args{
+				deviceID:  "deviceID",
+				signature: []byte("signature"),
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "DOMAIN-Pu3sg", "Errors.User.MFA.Push.InvalidSignature"),
+				errorCommands: []eventstore.Command{
+					user.NewHumanPushCheckFailedEvent(context.Background(), &user.NewAggregate("userID", "org1").Aggregate, "deviceID"),
+				},
+			},
+		},
+		{
+			name: "approved",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(deviceAddedEvent()),
+				),
+				pushChallenge: &PushChallengeModel{
+					Challenge:    "challenge",
+					Expiry:       2 * time.Minute,
+					CreationDate: testNow,
+				},
+			},
+			args: args{
+				deviceID:  "deviceID",
+				signature: signature,
+			},
+			res: res{
+				commands: []eventstore.Command{
+					user.NewHumanPushCheckSucceededEvent(context.Background(), &user.NewAggregate("userID", "org1").Aggregate, "deviceID"),
+					session.NewPushCheckedEvent(context.Background(), &session.NewAggregate("sessionID", "instanceID").Aggregate, testNow, "deviceID"),
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := CheckPush(tt.args.deviceID, tt.args.signature)
+
+			sessionModel := &SessionWriteModel{
+				UserID:        "userID",
+				UserCheckedAt: testNow,
+				State:         domain.SessionStateActive,
+				PushChallenge: tt.fields.pushChallenge,
+				aggregate:     &session.NewAggregate("sessionID", "instanceID").Aggregate,
+			}
+			cmds := &SessionCommands{
+				sessionCommands:   []SessionCommand{cmd},
+				sessionWriteModel: sessionModel,
+				eventstore:        tt.fields.eventstore(t),
+				now: func() time.Time {
+					return testNow
+				},
+			}
+
+			gotCmds, err := cmd(context.Background(), cmds)
+			assert.ErrorIs(t, err, tt.res.err)
+			assert.Equal(t, tt.res.errorCommands, gotCmds)
+			assert.Equal(t, tt.res.commands, cmds.eventCommands)
+		})
+	}
+}
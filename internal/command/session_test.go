@@ -21,7 +21,9 @@ import (
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/id"
 	"github.com/zitadel/zitadel/internal/id/mock"
+	rep_idp "github.com/zitadel/zitadel/internal/repository/idp"
 	"github.com/zitadel/zitadel/internal/repository/idpintent"
+	"github.com/zitadel/zitadel/internal/repository/instance"
 	"github.com/zitadel/zitadel/internal/repository/org"
 	"github.com/zitadel/zitadel/internal/repository/session"
 	"github.com/zitadel/zitadel/internal/repository/user"
@@ -590,6 +592,7 @@ func TestCommands_updateSession(t *testing.T) {
 						),
 					),
 					expectFilter(), // recheck
+					expectFilter(), // break-glass
 					expectPush(
 						session.NewUserCheckedEvent(context.Background(), &session.NewAggregate("sessionID", "instance1").Aggregate,
 							"userID", "org1", testNow, &language.Afrikaans,
@@ -778,6 +781,7 @@ func TestCommands_updateSession(t *testing.T) {
 							),
 						),
 					),
+					expectFilter(), // break-glass
 					expectPush(
 						session.NewUserCheckedEvent(context.Background(), &session.NewAggregate("sessionID", "instance1").Aggregate,
 							"userID", "org1", testNow, &language.Afrikaans),
@@ -842,7 +846,7 @@ func TestCommands_updateSession(t *testing.T) {
 						eventFromEventPusher(
 							idpintent.NewSucceededEvent(context.Background(),
 								&idpintent.NewAggregate("id", "instance1").Aggregate,
-								nil,
+								[]byte(`{"sub":"idpUserID","given_name":"Alice"}`),
 								"idpUserID",
 								"idpUsername",
 								"",
@@ -860,6 +864,34 @@ func TestCommands_updateSession(t *testing.T) {
 							),
 						),
 					),
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewIDPMappingRulesSetEvent(context.Background(), &instance.NewAggregate("instance1").Aggregate,
+								"idpID",
+								rep_idp.MappingRules{
+									ProfileMappings: []rep_idp.ProfileMapping{
+										{Claim: "given_name", Field: domain.IDPMappingProfileFieldFirstName},
+									},
+								},
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanAddedEvent(context.Background(), &user.NewAggregate("userID", "org1").Aggregate,
+								"username", "", "", "", "", language.English, domain.GenderUnspecified, "", false),
+						),
+					),
+					expectPush(
+						func() eventstore.Command {
+							event, _ := user.NewHumanProfileChangedEvent(context.Background(),
+								&user.NewAggregate("userID", "org1").Aggregate,
+								[]user.ProfileChanges{user.ChangeFirstName("Alice")},
+							)
+							return event
+						}(),
+					),
+					expectFilter(), // break-glass
 					expectPush(
 						session.NewUserCheckedEvent(context.Background(), &session.NewAggregate("sessionID", "instance1").Aggregate,
 							"userID", "org1", testNow, &language.Afrikaans),
@@ -906,6 +938,7 @@ func TestCommands_updateSession(t *testing.T) {
 				eventstore: tt.fields.eventstore(t),
 			}
 			tt.args.checks.eventstore = c.eventstore
+			tt.args.checks.applyIDPMappingRules = c.applyIDPMappingRules
 			got, err := c.updateSession(tt.args.ctx, tt.args.checks, tt.args.metadata, tt.args.lifetime)
 			require.ErrorIs(t, err, tt.res.err)
 			assert.Equal(t, tt.res.want, got)
@@ -1042,7 +1075,7 @@ func TestCheckTOTP(t *testing.T) {
 			},
 			wantErrorCommands: []eventstore.Command{
 				user.NewHumanOTPCheckFailedEvent(ctx, userAgg, nil),
-				user.NewUserLockedEvent(ctx, userAgg),
+				user.NewUserLockoutEvent(ctx, userAgg),
 			},
 			wantErr: zerrors.ThrowInvalidArgument(nil, "EVENT-8isk2", "Errors.User.MFA.OTP.InvalidCode"),
 		},
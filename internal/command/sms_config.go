@@ -2,10 +2,15 @@ package command
 
 import (
 	"context"
+	"net/http"
+	"net/url"
+	"strings"
 
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/notification/channels/twilio"
+	"github.com/zitadel/zitadel/internal/notification/channels/webhook"
+	"github.com/zitadel/zitadel/internal/notification/messages"
 	"github.com/zitadel/zitadel/internal/repository/instance"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
@@ -110,6 +115,114 @@ func (c *Commands) ChangeSMSConfigTwilioToken(ctx context.Context, instanceID, i
 	return writeModelToObjectDetails(&smsConfigWriteModel.WriteModel), nil
 }
 
+func (c *Commands) AddSMSConfigHTTP(ctx context.Context, instanceID string, config *webhook.Config) (string, *domain.ObjectDetails, error) {
+	endpoint, err := validateHTTPProviderEndpoint(config.CallURL)
+	if err != nil {
+		return "", nil, err
+	}
+	id, err := c.idGenerator.Next()
+	if err != nil {
+		return "", nil, err
+	}
+	smsConfigWriteModel, err := c.getSMSConfig(ctx, instanceID, id)
+	if err != nil {
+		return "", nil, err
+	}
+	headers, signingKey, err := encryptHTTPProviderSecrets(config, c.smsEncryption)
+	if err != nil {
+		return "", nil, err
+	}
+
+	iamAgg := InstanceAggregateFromWriteModel(&smsConfigWriteModel.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, instance.NewSMSConfigHTTPAddedEvent(
+		ctx,
+		iamAgg,
+		id,
+		endpoint,
+		headers,
+		signingKey))
+	if err != nil {
+		return "", nil, err
+	}
+	err = AppendAndReduce(smsConfigWriteModel, pushedEvents...)
+	if err != nil {
+		return "", nil, err
+	}
+	return id, writeModelToObjectDetails(&smsConfigWriteModel.WriteModel), nil
+}
+
+// ChangeSMSConfigHTTP changes the endpoint of the HTTP provider.
+// The headers and the signing key are only changed if they are passed.
+func (c *Commands) ChangeSMSConfigHTTP(ctx context.Context, instanceID, id string, config *webhook.Config) (*domain.ObjectDetails, error) {
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "SMS-Ja2nd", "Errors.IDMissing")
+	}
+	endpoint, err := validateHTTPProviderEndpoint(config.CallURL)
+	if err != nil {
+		return nil, err
+	}
+	smsConfigWriteModel, err := c.getSMSConfig(ctx, instanceID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !smsConfigWriteModel.State.Exists() || smsConfigWriteModel.HTTP == nil {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-8Jdsw", "Errors.SMSConfig.NotFound")
+	}
+	headers, signingKey, err := encryptHTTPProviderSecrets(config, c.smsEncryption)
+	if err != nil {
+		return nil, err
+	}
+	iamAgg := InstanceAggregateFromWriteModel(&smsConfigWriteModel.WriteModel)
+
+	changedEvent, hasChanged, err := smsConfigWriteModel.NewHTTPChangedEvent(
+		ctx,
+		iamAgg,
+		id,
+		endpoint,
+		headers,
+		signingKey)
+	if err != nil {
+		return nil, err
+	}
+	if !hasChanged {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-k3Lsp", "Errors.NoChangesFound")
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, changedEvent)
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(smsConfigWriteModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&smsConfigWriteModel.WriteModel), nil
+}
+
+// TestSMSConfigHTTPByID sends a test message to the phone number using the stored HTTP provider.
+func (c *Commands) TestSMSConfigHTTPByID(ctx context.Context, instanceID, id, phoneNumber string) error {
+	if id == "" {
+		return zerrors.ThrowInvalidArgument(nil, "SMS-Pq9dn", "Errors.IDMissing")
+	}
+	if phoneNumber == "" {
+		return zerrors.ThrowInvalidArgument(nil, "SMS-Xn3ds", "Errors.SMSConfig.TestPhoneNotFound")
+	}
+	smsConfigWriteModel, err := c.getSMSConfig(ctx, instanceID, id)
+	if err != nil {
+		return err
+	}
+	if !smsConfigWriteModel.State.Exists() || smsConfigWriteModel.HTTP == nil {
+		return zerrors.ThrowNotFound(nil, "COMMAND-Lw0sx", "Errors.SMSConfig.NotFound")
+	}
+	config, err := httpProviderConfig(smsConfigWriteModel.HTTP, c.smsEncryption)
+	if err != nil {
+		return err
+	}
+	return webhook.TestProviderConfiguration(ctx, *config, &messages.SMS{
+		RecipientPhoneNumber: phoneNumber,
+		Content:              "This is a test message to check if your SMS provider works fine",
+	})
+}
+
 func (c *Commands) ActivateSMSConfig(ctx context.Context, instanceID, id string) (*domain.ObjectDetails, error) {
 	if id == "" {
 		return nil, zerrors.ThrowInvalidArgument(nil, "SMS-dn93n", "Errors.IDMissing")
@@ -205,3 +318,44 @@ func (c *Commands) getSMSConfig(ctx context.Context, instanceID, id string) (_ *
 
 	return writeModel, nil
 }
+
+func validateHTTPProviderEndpoint(endpoint string) (string, error) {
+	endpoint = strings.TrimSpace(endpoint)
+	parsed, err := url.Parse(endpoint)
+	if err != nil || parsed.Host == "" || (parsed.Scheme != "http" && parsed.Scheme != "https") {
+		return "", zerrors.ThrowInvalidArgument(err, "COMMAND-Hq8sk", "Errors.Invalid.Argument")
+	}
+	return endpoint, nil
+}
+
+func encryptHTTPProviderSecrets(config *webhook.Config, alg crypto.EncryptionAlgorithm) (headers, signingKey *crypto.CryptoValue, err error) {
+	headers, err = webhook.EncryptHeaders(config.Headers, alg)
+	if err != nil {
+		return nil, nil, err
+	}
+	if config.SigningKey != "" {
+		signingKey, err = crypto.Encrypt([]byte(config.SigningKey), alg)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return headers, signingKey, nil
+}
+
+func httpProviderConfig(config *HTTPConfig, alg crypto.EncryptionAlgorithm) (_ *webhook.Config, err error) {
+	webhookConfig := &webhook.Config{
+		CallURL: config.Endpoint,
+		Method:  http.MethodPost,
+	}
+	webhookConfig.Headers, err = webhook.DecryptHeaders(config.Headers, alg)
+	if err != nil {
+		return nil, err
+	}
+	if config.SigningKey != nil {
+		webhookConfig.SigningKey, err = crypto.DecryptString(config.SigningKey, alg)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return webhookConfig, nil
+}
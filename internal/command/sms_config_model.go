@@ -14,6 +14,7 @@ type IAMSMSConfigWriteModel struct {
 
 	ID     string
 	Twilio *TwilioConfig
+	HTTP   *HTTPConfig
 	State  domain.SMSConfigState
 }
 
@@ -23,6 +24,13 @@ type TwilioConfig struct {
 	SenderNumber string
 }
 
+// HTTPConfig is the configuration of a generic HTTP provider for SMS and email
+type HTTPConfig struct {
+	Endpoint   string
+	Headers    *crypto.CryptoValue
+	SigningKey *crypto.CryptoValue
+}
+
 func NewIAMSMSConfigWriteModel(instanceID, id string) *IAMSMSConfigWriteModel {
 	return &IAMSMSConfigWriteModel{
 		WriteModel: eventstore.WriteModel{
@@ -62,6 +70,29 @@ func (wm *IAMSMSConfigWriteModel) Reduce() error {
 				continue
 			}
 			wm.Twilio.Token = e.Token
+		case *instance.SMSConfigHTTPAddedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			wm.HTTP = &HTTPConfig{
+				Endpoint:   e.Endpoint,
+				Headers:    e.Headers,
+				SigningKey: e.SigningKey,
+			}
+			wm.State = domain.SMSConfigStateInactive
+		case *instance.SMSConfigHTTPChangedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			if e.Endpoint != nil {
+				wm.HTTP.Endpoint = *e.Endpoint
+			}
+			if e.Headers != nil {
+				wm.HTTP.Headers = e.Headers
+			}
+			if e.SigningKey != nil {
+				wm.HTTP.SigningKey = e.SigningKey
+			}
 		case *instance.SMSConfigActivatedEvent:
 			if wm.ID != e.ID {
 				continue
@@ -77,6 +108,7 @@ func (wm *IAMSMSConfigWriteModel) Reduce() error {
 				continue
 			}
 			wm.Twilio = nil
+			wm.HTTP = nil
 			wm.State = domain.SMSConfigStateRemoved
 		}
 	}
@@ -92,6 +124,8 @@ func (wm *IAMSMSConfigWriteModel) Query() *eventstore.SearchQueryBuilder {
 			instance.SMSConfigTwilioAddedEventType,
 			instance.SMSConfigTwilioChangedEventType,
 			instance.SMSConfigTwilioTokenChangedEventType,
+			instance.SMSConfigHTTPAddedEventType,
+			instance.SMSConfigHTTPChangedEventType,
 			instance.SMSConfigActivatedEventType,
 			instance.SMSConfigDeactivatedEventType,
 			instance.SMSConfigRemovedEventType).
@@ -99,8 +133,19 @@ func (wm *IAMSMSConfigWriteModel) Query() *eventstore.SearchQueryBuilder {
 }
 
 func (wm *IAMSMSConfigWriteModel) NewChangedEvent(ctx context.Context, aggregate *eventstore.Aggregate, id, sid, senderNumber string) (*instance.SMSConfigTwilioChangedEvent, bool, error) {
+	changes := wm.twilioChanges(sid, senderNumber)
+	if len(changes) == 0 {
+		return nil, false, nil
+	}
+	changeEvent, err := instance.NewSMSConfigTwilioChangedEvent(ctx, aggregate, id, changes)
+	if err != nil {
+		return nil, false, err
+	}
+	return changeEvent, true, nil
+}
+
+func (wm *IAMSMSConfigWriteModel) twilioChanges(sid, senderNumber string) []instance.SMSConfigTwilioChanges {
 	changes := make([]instance.SMSConfigTwilioChanges, 0)
-	var err error
 
 	if wm.Twilio.SID != sid {
 		changes = append(changes, instance.ChangeSMSConfigTwilioSID(sid))
@@ -108,13 +153,32 @@ func (wm *IAMSMSConfigWriteModel) NewChangedEvent(ctx context.Context, aggregate
 	if wm.Twilio.SenderNumber != senderNumber {
 		changes = append(changes, instance.ChangeSMSConfigTwilioSenderNumber(senderNumber))
 	}
+	return changes
+}
 
+func (wm *IAMSMSConfigWriteModel) NewHTTPChangedEvent(ctx context.Context, aggregate *eventstore.Aggregate, id, endpoint string, headers, signingKey *crypto.CryptoValue) (*instance.SMSConfigHTTPChangedEvent, bool, error) {
+	changes := wm.httpChanges(endpoint, headers, signingKey)
 	if len(changes) == 0 {
 		return nil, false, nil
 	}
-	changeEvent, err := instance.NewSMSConfigTwilioChangedEvent(ctx, aggregate, id, changes)
+	changeEvent, err := instance.NewSMSConfigHTTPChangedEvent(ctx, aggregate, id, changes)
 	if err != nil {
 		return nil, false, err
 	}
 	return changeEvent, true, nil
 }
+
+func (wm *IAMSMSConfigWriteModel) httpChanges(endpoint string, headers, signingKey *crypto.CryptoValue) []instance.SMSConfigHTTPChanges {
+	changes := make([]instance.SMSConfigHTTPChanges, 0)
+
+	if wm.HTTP.Endpoint != endpoint {
+		changes = append(changes, instance.ChangeSMSConfigHTTPEndpoint(endpoint))
+	}
+	if headers != nil {
+		changes = append(changes, instance.ChangeSMSConfigHTTPHeaders(headers))
+	}
+	if signingKey != nil {
+		changes = append(changes, instance.ChangeSMSConfigHTTPSigningKey(signingKey))
+	}
+	return changes
+}
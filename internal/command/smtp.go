@@ -2,6 +2,7 @@ package command
 
 import (
 	"context"
+	"maps"
 	"net"
 	"strings"
 
@@ -11,6 +12,8 @@ import (
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/notification/channels/smtp"
+	"github.com/zitadel/zitadel/internal/notification/channels/webhook"
+	"github.com/zitadel/zitadel/internal/notification/messages"
 	"github.com/zitadel/zitadel/internal/repository/instance"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
@@ -110,7 +113,7 @@ func (c *Commands) ChangeSMTPConfig(ctx context.Context, instanceID string, id s
 		return nil, err
 	}
 
-	if !smtpConfigWriteModel.State.Exists() {
+	if !smtpConfigWriteModel.State.Exists() || smtpConfigWriteModel.HTTPConfig != nil {
 		return nil, zerrors.ThrowNotFound(nil, "COMMAND-7j8gv", "Errors.SMTPConfig.NotFound")
 	}
 
@@ -152,6 +155,97 @@ func (c *Commands) ChangeSMTPConfig(ctx context.Context, instanceID string, id s
 	return writeModelToObjectDetails(&smtpConfigWriteModel.WriteModel), nil
 }
 
+func (c *Commands) AddSMTPConfigHTTP(ctx context.Context, instanceID, description string, config *webhook.Config) (string, *domain.ObjectDetails, error) {
+	endpoint, err := validateHTTPProviderEndpoint(config.CallURL)
+	if err != nil {
+		return "", nil, err
+	}
+	id, err := c.idGenerator.Next()
+	if err != nil {
+		return "", nil, err
+	}
+	headers, signingKey, err := encryptHTTPProviderSecrets(config, c.smtpEncryption)
+	if err != nil {
+		return "", nil, err
+	}
+
+	smtpConfigWriteModel, err := c.getSMTPConfig(ctx, instanceID, id, "")
+	if err != nil {
+		return "", nil, err
+	}
+
+	iamAgg := InstanceAggregateFromWriteModel(&smtpConfigWriteModel.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, instance.NewSMTPConfigHTTPAddedEvent(
+		ctx,
+		iamAgg,
+		id,
+		strings.TrimSpace(description),
+		endpoint,
+		headers,
+		signingKey,
+	))
+	if err != nil {
+		return "", nil, err
+	}
+
+	err = AppendAndReduce(smtpConfigWriteModel, pushedEvents...)
+	if err != nil {
+		return "", nil, err
+	}
+	return id, writeModelToObjectDetails(&smtpConfigWriteModel.WriteModel), nil
+}
+
+// ChangeSMTPConfigHTTP changes the description and endpoint of the HTTP email provider.
+// The headers and the signing key are only changed if they are passed.
+func (c *Commands) ChangeSMTPConfigHTTP(ctx context.Context, instanceID, id, description string, config *webhook.Config) (*domain.ObjectDetails, error) {
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "SMTP-Mw9sd", "Errors.IDMissing")
+	}
+	endpoint, err := validateHTTPProviderEndpoint(config.CallURL)
+	if err != nil {
+		return nil, err
+	}
+	headers, signingKey, err := encryptHTTPProviderSecrets(config, c.smtpEncryption)
+	if err != nil {
+		return nil, err
+	}
+
+	smtpConfigWriteModel, err := c.getSMTPConfig(ctx, instanceID, id, "")
+	if err != nil {
+		return nil, err
+	}
+	if !smtpConfigWriteModel.State.Exists() || smtpConfigWriteModel.HTTPConfig == nil {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-xIs8q", "Errors.SMTPConfig.NotFound")
+	}
+
+	iamAgg := InstanceAggregateFromWriteModel(&smtpConfigWriteModel.WriteModel)
+	changedEvent, hasChanged, err := smtpConfigWriteModel.NewHTTPChangedEvent(
+		ctx,
+		iamAgg,
+		id,
+		strings.TrimSpace(description),
+		endpoint,
+		headers,
+		signingKey,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !hasChanged {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Nw1ks", "Errors.NoChangesFound")
+	}
+
+	pushedEvents, err := c.eventstore.Push(ctx, changedEvent)
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(smtpConfigWriteModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&smtpConfigWriteModel.WriteModel), nil
+}
+
 func (c *Commands) ChangeSMTPConfigPassword(ctx context.Context, instanceID, id string, password string) (*domain.ObjectDetails, error) {
 	instanceAgg := instance.NewAggregate(authz.GetInstance(ctx).InstanceID())
 	smtpConfigWriteModel, err := c.getSMTPConfig(ctx, instanceID, id, "")
@@ -186,6 +280,108 @@ func (c *Commands) ChangeSMTPConfigPassword(ctx context.Context, instanceID, id
 	return writeModelToObjectDetails(&smtpConfigWriteModel.WriteModel), nil
 }
 
+// SetSMTPConfigDKIM sets the domain, selector and private key used to sign the messages sent through the SMTP configuration.
+func (c *Commands) SetSMTPConfigDKIM(ctx context.Context, instanceID, id string, dkim *smtp.DKIM) (*domain.ObjectDetails, error) {
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Dk1id", "Errors.IDMissing")
+	}
+	if err := dkim.Validate(); err != nil {
+		return nil, err
+	}
+	smtpConfigWriteModel, err := c.getSMTPConfig(ctx, instanceID, id, "")
+	if err != nil {
+		return nil, err
+	}
+	if !smtpConfigWriteModel.State.Exists() || smtpConfigWriteModel.HTTPConfig != nil {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Dk2nf", "Errors.SMTPConfig.NotFound")
+	}
+	privateKey, err := crypto.Encrypt([]byte(dkim.PrivateKey), c.smtpEncryption)
+	if err != nil {
+		return nil, err
+	}
+
+	pushedEvents, err := c.eventstore.Push(ctx, instance.NewSMTPConfigDKIMSetEvent(
+		ctx,
+		InstanceAggregateFromWriteModel(&smtpConfigWriteModel.WriteModel),
+		id,
+		dkim.Domain,
+		dkim.Selector,
+		privateKey))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(smtpConfigWriteModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&smtpConfigWriteModel.WriteModel), nil
+}
+
+// RemoveSMTPConfigDKIM stops the signing of the messages sent through the SMTP configuration.
+func (c *Commands) RemoveSMTPConfigDKIM(ctx context.Context, instanceID, id string) (*domain.ObjectDetails, error) {
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Dk3id", "Errors.IDMissing")
+	}
+	smtpConfigWriteModel, err := c.getSMTPConfig(ctx, instanceID, id, "")
+	if err != nil {
+		return nil, err
+	}
+	if !smtpConfigWriteModel.State.Exists() || smtpConfigWriteModel.HTTPConfig != nil {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Dk4nf", "Errors.SMTPConfig.NotFound")
+	}
+	if smtpConfigWriteModel.DKIMPrivateKey == nil {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Dk5nf", "Errors.SMTPConfig.DKIMNotFound")
+	}
+
+	pushedEvents, err := c.eventstore.Push(ctx, instance.NewSMTPConfigDKIMRemovedEvent(
+		ctx,
+		InstanceAggregateFromWriteModel(&smtpConfigWriteModel.WriteModel),
+		id))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(smtpConfigWriteModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&smtpConfigWriteModel.WriteModel), nil
+}
+
+// SetSMTPConfigHeaders replaces the headers added to the non-critical messages sent through the SMTP configuration,
+// e.g. List-Unsubscribe.
+func (c *Commands) SetSMTPConfigHeaders(ctx context.Context, instanceID, id string, headers map[string]string) (*domain.ObjectDetails, error) {
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Hd1id", "Errors.IDMissing")
+	}
+	if err := smtp.ValidateHeaders(headers); err != nil {
+		return nil, err
+	}
+	smtpConfigWriteModel, err := c.getSMTPConfig(ctx, instanceID, id, "")
+	if err != nil {
+		return nil, err
+	}
+	if !smtpConfigWriteModel.State.Exists() || smtpConfigWriteModel.HTTPConfig != nil {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Hd2nf", "Errors.SMTPConfig.NotFound")
+	}
+	if maps.Equal(smtpConfigWriteModel.Headers, headers) {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Hd3nc", "Errors.NoChangesFound")
+	}
+
+	pushedEvents, err := c.eventstore.Push(ctx, instance.NewSMTPConfigHeadersSetEvent(
+		ctx,
+		InstanceAggregateFromWriteModel(&smtpConfigWriteModel.WriteModel),
+		id,
+		headers))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(smtpConfigWriteModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&smtpConfigWriteModel.WriteModel), nil
+}
+
 func (c *Commands) ActivateSMTPConfig(ctx context.Context, instanceID, id, activatedId string) (*domain.ObjectDetails, error) {
 	if id == "" {
 		return nil, zerrors.ThrowInvalidArgument(nil, "SMTP-nm56k", "Errors.IDMissing")
@@ -338,7 +534,7 @@ func (c *Commands) TestSMTPConfigById(ctx context.Context, instanceID, id, email
 		return err
 	}
 
-	if !smtpConfigWriteModel.State.Exists() {
+	if !smtpConfigWriteModel.State.Exists() || smtpConfigWriteModel.HTTPConfig != nil {
 		return zerrors.ThrowNotFound(nil, "SMTP-99klw", "Errors.SMTPConfig.NotFound")
 	}
 
@@ -347,6 +543,11 @@ func (c *Commands) TestSMTPConfigById(ctx context.Context, instanceID, id, email
 		return err
 	}
 
+	dkim, err := c.smtpConfigDKIM(smtpConfigWriteModel)
+	if err != nil {
+		return err
+	}
+
 	smtpConfig := &smtp.Config{
 		Description: smtpConfigWriteModel.Description,
 		Tls:         smtpConfigWriteModel.TLS,
@@ -357,6 +558,7 @@ func (c *Commands) TestSMTPConfigById(ctx context.Context, instanceID, id, email
 			User:     smtpConfigWriteModel.User,
 			Password: password,
 		},
+		DKIM: dkim,
 	}
 
 	// Try to send an email
@@ -368,6 +570,33 @@ func (c *Commands) TestSMTPConfigById(ctx context.Context, instanceID, id, email
 	return nil
 }
 
+// TestSMTPConfigHTTPByID sends a test email to the address using the stored HTTP email provider.
+func (c *Commands) TestSMTPConfigHTTPByID(ctx context.Context, instanceID, id, email string) error {
+	if id == "" {
+		return zerrors.ThrowInvalidArgument(nil, "SMTP-Jd9wq", "Errors.IDMissing")
+	}
+	if email == "" {
+		return zerrors.ThrowInvalidArgument(nil, "SMTP-Ks8ne", "Errors.SMTPConfig.TestEmailNotFound")
+	}
+
+	smtpConfigWriteModel, err := c.getSMTPConfig(ctx, instanceID, id, "")
+	if err != nil {
+		return err
+	}
+	if !smtpConfigWriteModel.State.Exists() || smtpConfigWriteModel.HTTPConfig == nil {
+		return zerrors.ThrowNotFound(nil, "SMTP-Pw7sn", "Errors.SMTPConfig.NotFound")
+	}
+	config, err := httpProviderConfig(smtpConfigWriteModel.HTTPConfig, c.smtpEncryption)
+	if err != nil {
+		return err
+	}
+	return webhook.TestProviderConfiguration(ctx, *config, &messages.Email{
+		Recipients: []string{email},
+		Subject:    "Test email",
+		Content:    "This is a test email to check if your email provider works fine",
+	})
+}
+
 func checkSenderAddress(writeModel *IAMSMTPConfigWriteModel) error {
 	if !writeModel.smtpSenderAddressMatchesInstanceDomain {
 		return nil
@@ -378,6 +607,21 @@ func checkSenderAddress(writeModel *IAMSMTPConfigWriteModel) error {
 	return nil
 }
 
+func (c *Commands) smtpConfigDKIM(writeModel *IAMSMTPConfigWriteModel) (*smtp.DKIM, error) {
+	if writeModel.DKIMPrivateKey == nil {
+		return nil, nil
+	}
+	privateKey, err := crypto.DecryptString(writeModel.DKIMPrivateKey, c.smtpEncryption)
+	if err != nil {
+		return nil, err
+	}
+	return &smtp.DKIM{
+		Domain:     writeModel.DKIMDomain,
+		Selector:   writeModel.DKIMSelector,
+		PrivateKey: privateKey,
+	}, nil
+}
+
 func (c *Commands) getSMTPConfig(ctx context.Context, instanceID, id, domain string) (writeModel *IAMSMTPConfigWriteModel, err error) {
 	writeModel = NewIAMSMTPConfigWriteModel(instanceID, id, domain)
 	err = c.eventstore.FilterToQueryReducer(ctx, writeModel)
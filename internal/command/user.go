@@ -31,6 +31,9 @@ func (c *Commands) ChangeUsername(ctx context.Context, orgID, userID, userName s
 	if !isUserStateExists(existingUser.UserState) {
 		return nil, zerrors.ThrowNotFound(nil, "COMMAND-5N9ds", "Errors.User.NotFound")
 	}
+	if err = c.checkUserInMemberScope(ctx, existingUser.ResourceOwner, userID); err != nil {
+		return nil, err
+	}
 
 	if existingUser.UserName == userName {
 		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-6m9gs", "Errors.User.UsernameNotChanged")
@@ -75,6 +78,9 @@ func (c *Commands) DeactivateUser(ctx context.Context, userID, resourceOwner str
 	if isUserStateInactive(existingUser.UserState) {
 		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-5M0sf", "Errors.User.AlreadyInactive")
 	}
+	if err = c.checkUserInMemberScope(ctx, existingUser.ResourceOwner, userID); err != nil {
+		return nil, err
+	}
 
 	pushedEvents, err := c.eventstore.Push(ctx,
 		user.NewUserDeactivatedEvent(ctx, UserAggregateFromWriteModel(&existingUser.WriteModel)))
@@ -103,6 +109,9 @@ func (c *Commands) ReactivateUser(ctx context.Context, userID, resourceOwner str
 	if !isUserStateInactive(existingUser.UserState) {
 		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-6M0sf", "Errors.User.NotInactive")
 	}
+	if err = c.checkUserInMemberScope(ctx, existingUser.ResourceOwner, userID); err != nil {
+		return nil, err
+	}
 
 	pushedEvents, err := c.eventstore.Push(ctx,
 		user.NewUserReactivatedEvent(ctx, UserAggregateFromWriteModel(&existingUser.WriteModel)))
@@ -131,6 +140,9 @@ func (c *Commands) LockUser(ctx context.Context, userID, resourceOwner string) (
 	if !hasUserState(existingUser.UserState, domain.UserStateActive, domain.UserStateInitial) {
 		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-3NN8v", "Errors.User.ShouldBeActiveOrInitial")
 	}
+	if err = c.checkUserInMemberScope(ctx, existingUser.ResourceOwner, userID); err != nil {
+		return nil, err
+	}
 
 	pushedEvents, err := c.eventstore.Push(ctx,
 		user.NewUserLockedEvent(ctx, UserAggregateFromWriteModel(&existingUser.WriteModel)))
@@ -159,6 +171,9 @@ func (c *Commands) UnlockUser(ctx context.Context, userID, resourceOwner string)
 	if !hasUserState(existingUser.UserState, domain.UserStateLocked) {
 		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-4M0ds", "Errors.User.NotLocked")
 	}
+	if err = c.checkUserInMemberScope(ctx, existingUser.ResourceOwner, userID); err != nil {
+		return nil, err
+	}
 
 	pushedEvents, err := c.eventstore.Push(ctx,
 		user.NewUserUnlockedEvent(ctx, UserAggregateFromWriteModel(&existingUser.WriteModel)))
@@ -184,6 +199,9 @@ func (c *Commands) RemoveUser(ctx context.Context, userID, resourceOwner string,
 	if !isUserStateExists(existingUser.UserState) {
 		return nil, zerrors.ThrowNotFound(nil, "COMMAND-m9od", "Errors.User.NotFound")
 	}
+	if err = c.checkUserInMemberScope(ctx, existingUser.ResourceOwner, userID); err != nil {
+		return nil, err
+	}
 
 	domainPolicy, err := c.domainPolicyWriteModel(ctx, existingUser.ResourceOwner)
 	if err != nil {
@@ -1,6 +1,7 @@
 package command
 
 import (
+	http_utils "github.com/zitadel/zitadel/internal/api/http"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/repository/user"
 )
@@ -130,6 +131,25 @@ func authRequestDomainToAuthRequestInfo(authRequest *domain.AuthRequest) *user.A
 	return info
 }
 
+// userAgentToAuthRequestInfo provides the browser info of a session's user agent for the checks of the user,
+// the same way [authRequestDomainToAuthRequestInfo] does for the login UI.
+func userAgentToAuthRequestInfo(userAgent *domain.UserAgent) *user.AuthRequestInfo {
+	if userAgent == nil || userAgent.IsEmpty() {
+		return nil
+	}
+	info := &user.BrowserInfo{
+		RemoteIP: userAgent.IP,
+	}
+	if userAgent.Header != nil {
+		info.UserAgent = userAgent.Header.Get(http_utils.UserAgentHeader)
+		info.AcceptLanguage = userAgent.Header.Get(http_utils.AcceptLanguage)
+	}
+	if info.UserAgent == "" && userAgent.Description != nil {
+		info.UserAgent = *userAgent.Description
+	}
+	return &user.AuthRequestInfo{BrowserInfo: info}
+}
+
 func writeModelToPasswordlessInitCode(initCodeModel *HumanPasswordlessInitCodeWriteModel, code string) *domain.PasswordlessInitCode {
 	return &domain.PasswordlessInitCode{
 		ObjectRoot: writeModelToObjectRoot(initCodeModel.WriteModel),
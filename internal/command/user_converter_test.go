@@ -0,0 +1,67 @@
+package command
+
+import (
+	"net"
+	"net/http"
+	"testing"
+
+	"github.com/muhlemmer/gu"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+func Test_userAgentToAuthRequestInfo(t *testing.T) {
+	tests := []struct {
+		name      string
+		userAgent *domain.UserAgent
+		want      *user.AuthRequestInfo
+	}{
+		{
+			name: "no user agent",
+			want: nil,
+		},
+		{
+			name:      "empty user agent",
+			userAgent: &domain.UserAgent{},
+			want:      nil,
+		},
+		{
+			name: "header",
+			userAgent: &domain.UserAgent{
+				IP:          net.IPv4(127, 0, 0, 1),
+				Description: gu.Ptr("description"),
+				Header: http.Header{
+					"User-Agent":      {"browser"},
+					"Accept-Language": {"de"},
+				},
+			},
+			want: &user.AuthRequestInfo{
+				BrowserInfo: &user.BrowserInfo{
+					UserAgent:      "browser",
+					AcceptLanguage: "de",
+					RemoteIP:       net.IPv4(127, 0, 0, 1),
+				},
+			},
+		},
+		{
+			name: "description",
+			userAgent: &domain.UserAgent{
+				IP:          net.IPv4(127, 0, 0, 1),
+				Description: gu.Ptr("description"),
+			},
+			want: &user.AuthRequestInfo{
+				BrowserInfo: &user.BrowserInfo{
+					UserAgent: "description",
+					RemoteIP:  net.IPv4(127, 0, 0, 1),
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, userAgentToAuthRequestInfo(tt.userAgent))
+		})
+	}
+}
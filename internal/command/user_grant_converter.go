@@ -10,5 +10,9 @@ func userGrantWriteModelToUserGrant(writeModel *UserGrantWriteModel) *domain.Use
 		ProjectGrantID: writeModel.ProjectGrantID,
 		RoleKeys:       writeModel.RoleKeys,
 		State:          writeModel.State,
+		Validity: domain.Validity{
+			From:  writeModel.ValidFrom,
+			Until: writeModel.ValidUntil,
+		},
 	}
 }
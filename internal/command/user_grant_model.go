@@ -1,6 +1,8 @@
 package command
 
 import (
+	"time"
+
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/repository/project"
@@ -15,6 +17,8 @@ type UserGrantWriteModel struct {
 	ProjectID      string
 	ProjectGrantID string
 	RoleKeys       []string
+	ValidFrom      *time.Time
+	ValidUntil     *time.Time
 	State          domain.UserGrantState
 }
 
@@ -54,6 +58,11 @@ func (wm *UserGrantWriteModel) Reduce() error {
 			wm.State = domain.UserGrantStateRemoved
 		case *usergrant.UserGrantCascadeRemovedEvent:
 			wm.State = domain.UserGrantStateRemoved
+		case *usergrant.UserGrantValiditySetEvent:
+			wm.ValidFrom = e.ValidFrom
+			wm.ValidUntil = e.ValidUntil
+		case *usergrant.UserGrantExpiredEvent:
+			wm.State = domain.UserGrantStateRemoved
 		}
 	}
 	return wm.WriteModel.Reduce()
@@ -70,7 +79,9 @@ func (wm *UserGrantWriteModel) Query() *eventstore.SearchQueryBuilder {
 			usergrant.UserGrantDeactivatedType,
 			usergrant.UserGrantReactivatedType,
 			usergrant.UserGrantRemovedType,
-			usergrant.UserGrantCascadeRemovedType).
+			usergrant.UserGrantCascadeRemovedType,
+			usergrant.UserGrantValiditySetType,
+			usergrant.UserGrantExpiredType).
 		Builder()
 
 	if wm.ResourceOwner != "" {
@@ -0,0 +1,78 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/usergrant"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// SetUserGrantValidity restricts the user grant to the time window of the validity.
+// An empty validity removes the restriction.
+func (c *Commands) SetUserGrantValidity(ctx context.Context, grantID, resourceOwner string, validity domain.Validity) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if grantID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ug7vs", "Errors.UserGrant.IDMissing")
+	}
+	if !validity.IsValid() {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ug8vi", "Errors.Validity.Invalid")
+	}
+	existingUserGrant, err := c.userGrantWriteModelByID(ctx, grantID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if existingUserGrant.State == domain.UserGrantStateUnspecified || existingUserGrant.State == domain.UserGrantStateRemoved {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Ug9nf", "Errors.UserGrant.NotFound")
+	}
+	err = checkExplicitProjectPermission(ctx, existingUserGrant.ProjectGrantID, existingUserGrant.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+	if validity.Equal(domain.Validity{From: existingUserGrant.ValidFrom, Until: existingUserGrant.ValidUntil}) {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ug0nc", "Errors.UserGrant.NotChanged")
+	}
+	if err = c.pushAppendAndReduce(ctx, existingUserGrant,
+		usergrant.NewUserGrantValiditySetEvent(ctx, UserGrantAggregateFromWriteModel(&existingUserGrant.WriteModel), validity.From, validity.Until),
+	); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingUserGrant.WriteModel), nil
+}
+
+// ExpireUserGrant removes the user grant after the end of its validity.
+func (c *Commands) ExpireUserGrant(ctx context.Context, grantID, resourceOwner string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if grantID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ue1vs", "Errors.UserGrant.IDMissing")
+	}
+	existingUserGrant, err := c.userGrantWriteModelByID(ctx, grantID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if existingUserGrant.State == domain.UserGrantStateUnspecified || existingUserGrant.State == domain.UserGrantStateRemoved {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Ue2nf", "Errors.UserGrant.NotFound")
+	}
+	validity := domain.Validity{From: existingUserGrant.ValidFrom, Until: existingUserGrant.ValidUntil}
+	if !validity.ExpiredAt(time.Now()) {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ue3ne", "Errors.UserGrant.NotExpired")
+	}
+	if err = c.pushAppendAndReduce(ctx, existingUserGrant,
+		usergrant.NewUserGrantExpiredEvent(ctx,
+			UserGrantAggregateFromWriteModel(&existingUserGrant.WriteModel),
+			existingUserGrant.UserID,
+			existingUserGrant.ProjectID,
+			existingUserGrant.ProjectGrantID,
+			*existingUserGrant.ValidUntil,
+		),
+	); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingUserGrant.WriteModel), nil
+}
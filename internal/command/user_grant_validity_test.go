@@ -0,0 +1,240 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/usergrant"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func userGrantAddedEvent() eventstore.Event {
+	return eventFromEventPusher(
+		usergrant.NewUserGrantAddedEvent(context.Background(),
+			&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
+			"user1",
+			"project1",
+			"",
+			[]string{"rolekey1"},
+		),
+	)
+}
+
+func TestCommands_SetUserGrantValidity(t *testing.T) {
+	validFrom := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	validUntil := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	ctx := authz.NewMockContextWithPermissions("", "org1", "user1", []string{domain.RoleProjectOwner})
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		validity domain.Validity
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "invalid window, error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				validity: domain.Validity{From: &validUntil, Until: &validFrom},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "user grant not existing, not found",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				validity: domain.Validity{Until: &validUntil},
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "validity not changed, precondition failed",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						userGrantAddedEvent(),
+						eventFromEventPusher(
+							usergrant.NewUserGrantValiditySetEvent(context.Background(),
+								&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
+								nil,
+								&validUntil,
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				validity: domain.Validity{Until: &validUntil},
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "set validity, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						userGrantAddedEvent(),
+					),
+					expectPush(
+						usergrant.NewUserGrantValiditySetEvent(ctx,
+							&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
+							&validFrom,
+							&validUntil,
+						),
+					),
+				),
+			},
+			args: args{
+				validity: domain.Validity{From: &validFrom, Until: &validUntil},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := c.SetUserGrantValidity(ctx, "usergrant1", "org1", tt.args.validity)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommands_ExpireUserGrant(t *testing.T) {
+	expired := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	notExpired := time.Now().Add(time.Hour)
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		res    res
+	}{
+		{
+			name: "without validity, precondition failed",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						userGrantAddedEvent(),
+					),
+				),
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "not yet expired, precondition failed",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						userGrantAddedEvent(),
+						eventFromEventPusher(
+							usergrant.NewUserGrantValiditySetEvent(context.Background(),
+								&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
+								nil,
+								&notExpired,
+							),
+						),
+					),
+				),
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "expire, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						userGrantAddedEvent(),
+						eventFromEventPusher(
+							usergrant.NewUserGrantValiditySetEvent(context.Background(),
+								&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
+								nil,
+								&expired,
+							),
+						),
+					),
+					expectPush(
+						usergrant.NewUserGrantExpiredEvent(context.Background(),
+							&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
+							"user1",
+							"project1",
+							"",
+							expired,
+						),
+					),
+				),
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := c.ExpireUserGrant(context.Background(), "usergrant1", "org1")
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
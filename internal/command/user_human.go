@@ -71,6 +71,10 @@ type AddHuman struct {
 	// TOTPSecret is optional
 	TOTPSecret string
 
+	// Passkey is optional, if set a passkey registration code is created
+	// and the user will have to register a passkey instead of a password
+	Passkey *AddHumanPasskey
+
 	// Details are set after a successful execution of the command
 	Details *domain.ObjectDetails
 
@@ -79,6 +83,21 @@ type AddHuman struct {
 
 	// PhoneCode is set by the command
 	PhoneCode *string
+
+	// PasskeyCode is set by the command
+	PasskeyCode *PasskeyCode
+}
+
+type AddHumanPasskey struct {
+	// URLTemplate is optional and used for the link sent to the user
+	URLTemplate string
+	// ReturnCode returns the passkey registration code instead of sending it to the user
+	ReturnCode bool
+}
+
+type PasskeyCode struct {
+	ID   string
+	Code string
 }
 
 type AddLink struct {
@@ -88,8 +107,10 @@ type AddLink struct {
 }
 
 func (h *AddHuman) Validate(hasher *crypto.Hasher) (err error) {
-	if err := h.Email.Validate(); err != nil {
-		return err
+	if !h.registersPasskeyWithPhoneOnly() {
+		if err := h.Email.Validate(); err != nil {
+			return err
+		}
 	}
 	if h.Username = strings.TrimSpace(h.Username); h.Username == "" {
 		return zerrors.ThrowInvalidArgument(nil, "V2-zzad3", "Errors.Invalid.Argument")
@@ -119,9 +140,18 @@ func (h *AddHuman) Validate(hasher *crypto.Hasher) (err error) {
 			return zerrors.ThrowInvalidArgument(nil, "USER-JDk4t", "Errors.User.Password.NotSupported")
 		}
 	}
+	if h.Passkey != nil && (h.Password != "" || h.EncodedPasswordHash != "") {
+		return zerrors.ThrowInvalidArgument(nil, "USER-Pk3sx", "Errors.User.Passkey.PasswordNotAllowed")
+	}
 	return nil
 }
 
+// registersPasskeyWithPhoneOnly returns true if the human registers a passkey and only has a phone number.
+// This is only possible if the passkey registration code is returned, as it can't be sent by email.
+func (h *AddHuman) registersPasskeyWithPhoneOnly() bool {
+	return h.Passkey != nil && h.Passkey.ReturnCode && h.Email.Address == "" && h.Phone.Number != ""
+}
+
 type AddMetadataEntry struct {
 	Key   string
 	Value []byte
@@ -153,6 +183,9 @@ func (c *Commands) AddHuman(ctx context.Context, resourceOwner string, human *Ad
 	if err != nil {
 		return err
 	}
+	if err = c.checkPasskeyRegistrationForced(ctx, resourceOwner, human.Passkey != nil || len(human.Links) > 0); err != nil {
+		return err
+	}
 
 	events, err := c.eventstore.Push(ctx, cmds...)
 	if err != nil {
@@ -270,6 +303,9 @@ func (c *Commands) AddHumanCommand(human *AddHuman, orgID string, hasher *crypto
 }
 
 func (c *Commands) addHumanCommandEmail(ctx context.Context, filter preparation.FilterToQueryReducer, cmds []eventstore.Command, a *user.Aggregate, human *AddHuman, codeAlg crypto.EncryptionAlgorithm, allowInitMail bool) ([]eventstore.Command, error) {
+	if human.Email.Address == "" {
+		return cmds, nil
+	}
 	if human.Email.Verified {
 		cmds = append(cmds, user.NewHumanEmailVerifiedEvent(ctx, &a.Aggregate))
 	}
@@ -322,6 +358,27 @@ func (c *Commands) addHumanCommandPhone(ctx context.Context, filter preparation.
 	return append(cmds, user.NewHumanPhoneCodeAddedEventV2(ctx, &a.Aggregate, phoneCode.Crypted, phoneCode.Expiry, human.Phone.ReturnCode)), nil
 }
 
+func (c *Commands) addHumanCommandPasskey(ctx context.Context, filter preparation.FilterToQueryReducer, cmds []eventstore.Command, a *user.Aggregate, human *AddHuman, codeAlg crypto.EncryptionAlgorithm) ([]eventstore.Command, error) {
+	if human.Passkey == nil {
+		return cmds, nil
+	}
+	codeID, err := c.idGenerator.Next()
+	if err != nil {
+		return nil, err
+	}
+	code, err := c.newPasskeyCode(ctx, filter, codeAlg)
+	if err != nil {
+		return nil, err
+	}
+	if human.Passkey.ReturnCode {
+		human.PasskeyCode = &PasskeyCode{
+			ID:   codeID,
+			Code: code.Plain,
+		}
+	}
+	return append(cmds, user.NewHumanPasswordlessInitCodeRequestedEvent(ctx, &a.Aggregate, codeID, code.Crypted, code.Expiry, human.Passkey.URLTemplate, human.Passkey.ReturnCode)), nil
+}
+
 // Deprecated: use commands.NewUserHumanWriteModel, to remove deprecated eventstore.Filter
 func (c *Commands) addHumanCommandCheckID(ctx context.Context, filter preparation.FilterToQueryReducer, human *AddHuman, orgID string) (err error) {
 	if human.ID == "" {
@@ -417,7 +474,7 @@ func (h *AddHuman) ensureDisplayName() {
 func (h *AddHuman) shouldAddInitCode() bool {
 	return len(h.Links) == 0 &&
 		(!h.Email.Verified ||
-			(!h.Passwordless && h.Password == ""))
+			(!h.Passwordless && h.Passkey == nil && h.Password == ""))
 }
 
 // Deprecated: use commands.AddUserHuman
@@ -436,6 +493,10 @@ func (c *Commands) ImportHuman(ctx context.Context, orgID string, human *domain.
 	if err != nil {
 		return nil, nil, zerrors.ThrowPreconditionFailed(err, "COMMAND-4N8gs", "Errors.Org.PasswordComplexityPolicy.NotFound")
 	}
+	// an imported human registers a passkey only if it has no password
+	if err = c.checkPasskeyRegistrationForced(ctx, orgID, (passwordless && human.Password == nil) || len(links) > 0); err != nil {
+		return nil, nil, err
+	}
 
 	if human.AggregateID != "" {
 		existing, err := c.getHumanWriteModelByID(ctx, human.AggregateID, human.ResourceOwner)
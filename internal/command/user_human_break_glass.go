@@ -0,0 +1,140 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/session"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// SetUserBreakGlass marks the human user as emergency access (break-glass) account or removes the mark.
+// Break-glass accounts bypass the identity provider restrictions of the login policy,
+// but must always authenticate with a security key and every login is alerted.
+func (c *Commands) SetUserBreakGlass(ctx context.Context, userID, resourceOwner string, breakGlass bool) (*domain.ObjectDetails, error) {
+	if userID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Bg3ui", "Errors.User.UserIDMissing")
+	}
+	writeModel := NewHumanBreakGlassWriteModel(userID, resourceOwner)
+	if err := c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	if !isUserStateExists(writeModel.UserState) {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Bg4nf", "Errors.User.NotFound")
+	}
+	if writeModel.BreakGlass == breakGlass {
+		return writeModelToObjectDetails(&writeModel.WriteModel), nil
+	}
+	if err := c.pushAppendAndReduce(ctx, writeModel,
+		user.NewHumanBreakGlassSetEvent(ctx, UserAggregateFromWriteModel(&writeModel.WriteModel), breakGlass),
+	); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// breakGlassPolicyByUser returns the break-glass policy of the instance if the user is a break-glass account,
+// nil otherwise.
+func (c *Commands) breakGlassPolicyByUser(ctx context.Context, userID string) (*domain.BreakGlassPolicy, error) {
+	userWriteModel := NewHumanBreakGlassWriteModel(userID, "")
+	if err := c.eventstore.FilterToQueryReducer(ctx, userWriteModel); err != nil {
+		return nil, err
+	}
+	if !userWriteModel.BreakGlass {
+		return nil, nil
+	}
+	policyWriteModel := NewInstanceBreakGlassPolicyWriteModel(ctx)
+	if err := c.eventstore.FilterToQueryReducer(ctx, policyWriteModel); err != nil {
+		return nil, err
+	}
+	return policyWriteModel.Policy, nil
+}
+
+// breakGlassUsedEvent returns the event alerting the login of a break-glass account, nil if the user is none.
+func (c *Commands) breakGlassUsedEvent(ctx context.Context, agg *eventstore.Aggregate, sessionID string, info *user.AuthRequestInfo) (eventstore.Command, error) {
+	policy, err := c.breakGlassPolicyByUser(ctx, agg.ID)
+	if err != nil || policy == nil {
+		return nil, err
+	}
+	return user.NewHumanBreakGlassUsedEvent(ctx, agg, sessionID, time.Now().Add(policy.SessionLifetime), info), nil
+}
+
+// checkBreakGlassSession ensures sessions of break-glass accounts are only authenticated
+// by a WebAuthN check with user verification (passkey or security key with PIN) and limits their lifetime.
+// The login is alerted as soon as the session is verified by such a check.
+func (c *Commands) checkBreakGlassSession(ctx context.Context, cmd *SessionCommands, lifetime time.Duration) error {
+	if cmd.sessionWriteModel.UserID == "" {
+		return nil
+	}
+	var factorChecked, userVerified bool
+	for _, event := range cmd.eventCommands {
+		switch e := event.(type) {
+		case *session.WebAuthNCheckedEvent:
+			factorChecked = true
+			userVerified = userVerified || e.UserVerified
+		case *session.PasswordCheckedEvent,
+			*session.IntentCheckedEvent,
+			*session.TOTPCheckedEvent,
+			*session.OTPSMSCheckedEvent,
+			*session.OTPEmailCheckedEvent,
+			*session.PushCheckedEvent:
+			factorChecked = true
+		}
+	}
+	// the lifetime of an already verified session must not be extended
+	if !factorChecked && lifetime == 0 {
+		return nil
+	}
+	policy, err := c.breakGlassPolicyByUser(ctx, cmd.sessionWriteModel.UserID)
+	if err != nil || policy == nil {
+		return err
+	}
+	cmd.breakGlassPolicy = policy
+	if factorChecked && !userVerified && !cmd.sessionWriteModel.isUserVerifiedByWebAuthN() {
+		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-Bg5wa", "Errors.User.BreakGlass.WebAuthNRequired")
+	}
+	if userVerified {
+		cmd.eventCommands = append(cmd.eventCommands,
+			breakGlassSessionUsedEvent(ctx, cmd.sessionWriteModel, cmd.now().Add(policy.Lifetime(lifetime))),
+		)
+	}
+	return nil
+}
+
+// checkBreakGlassSessionLinked ensures a session of a break-glass account was verified by WebAuthN with user verification
+// before it is used to log in to an application and returns the event alerting the login, nil if the user is no break-glass account.
+func (c *Commands) checkBreakGlassSessionLinked(ctx context.Context, sessionWriteModel *SessionWriteModel) (eventstore.Command, error) {
+	policy, err := c.breakGlassPolicyByUser(ctx, sessionWriteModel.UserID)
+	if err != nil || policy == nil {
+		return nil, err
+	}
+	if !sessionWriteModel.isUserVerifiedByWebAuthN() {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Bg6wa", "Errors.User.BreakGlass.WebAuthNRequired")
+	}
+	expiration := sessionWriteModel.Expiration
+	if expiration.IsZero() {
+		expiration = sessionWriteModel.WebAuthNCheckedAt.Add(policy.SessionLifetime)
+	}
+	return breakGlassSessionUsedEvent(ctx, sessionWriteModel, expiration), nil
+}
+
+func breakGlassSessionUsedEvent(ctx context.Context, sessionWriteModel *SessionWriteModel, expiration time.Time) *user.HumanBreakGlassUsedEvent {
+	var info *user.AuthRequestInfo
+	if userAgent := sessionWriteModel.UserAgent; userAgent != nil {
+		info = &user.AuthRequestInfo{
+			BrowserInfo: &user.BrowserInfo{
+				UserAgent: userAgent.Header.Get("User-Agent"),
+				RemoteIP:  userAgent.IP,
+			},
+		}
+	}
+	return user.NewHumanBreakGlassUsedEvent(ctx,
+		&user.NewAggregate(sessionWriteModel.UserID, sessionWriteModel.UserResourceOwner).Aggregate,
+		sessionWriteModel.AggregateID,
+		expiration,
+		info,
+	)
+}
@@ -0,0 +1,57 @@
+package command
+
+import (
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+type HumanBreakGlassWriteModel struct {
+	eventstore.WriteModel
+
+	BreakGlass bool
+	UserState  domain.UserState
+}
+
+func NewHumanBreakGlassWriteModel(userID, resourceOwner string) *HumanBreakGlassWriteModel {
+	return &HumanBreakGlassWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   userID,
+			ResourceOwner: resourceOwner,
+		},
+	}
+}
+
+func (wm *HumanBreakGlassWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *user.HumanAddedEvent, *user.HumanRegisteredEvent:
+			wm.UserState = domain.UserStateActive
+		case *user.HumanBreakGlassSetEvent:
+			wm.BreakGlass = e.BreakGlass
+		case *user.UserRemovedEvent:
+			wm.UserState = domain.UserStateDeleted
+			wm.BreakGlass = false
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *HumanBreakGlassWriteModel) Query() *eventstore.SearchQueryBuilder {
+	query := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(user.UserV1AddedType,
+			user.HumanAddedType,
+			user.UserV1RegisteredType,
+			user.HumanRegisteredType,
+			user.HumanBreakGlassSetType,
+			user.UserRemovedType).
+		Builder()
+
+	if wm.ResourceOwner != "" {
+		query.ResourceOwner(wm.ResourceOwner)
+	}
+	return query
+}
@@ -0,0 +1,300 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/session"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommandSide_SetUserBreakGlass(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx        context.Context
+		orgID      string
+		userID     string
+		breakGlass bool
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "userid missing, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args: args{
+				ctx:        context.Background(),
+				orgID:      "org1",
+				breakGlass: true,
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "user not existing, not found error",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:        context.Background(),
+				orgID:      "org1",
+				userID:     "user1",
+				breakGlass: true,
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "already break-glass account, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							newLifecycleHumanAddedEvent(),
+						),
+						eventFromEventPusher(
+							user.NewHumanBreakGlassSetEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								true,
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:        context.Background(),
+				orgID:      "org1",
+				userID:     "user1",
+				breakGlass: true,
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			name: "set break-glass account, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							newLifecycleHumanAddedEvent(),
+						),
+					),
+					expectPush(
+						user.NewHumanBreakGlassSetEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+							true,
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:        context.Background(),
+				orgID:      "org1",
+				userID:     "user1",
+				breakGlass: true,
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			got, err := r.SetUserBreakGlass(tt.args.ctx, tt.args.userID, tt.args.orgID, tt.args.breakGlass)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommands_checkBreakGlassSession(t *testing.T) {
+	testNow := time.Now()
+	sessionAgg := &session.NewAggregate("sessionID", "instance1").Aggregate
+	breakGlassUser := expectFilter(
+		eventFromEventPusher(
+			user.NewHumanBreakGlassSetEvent(context.Background(), &user.NewAggregate("userID", "org1").Aggregate, true),
+		),
+	)
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		checks   []eventstore.Command
+		lifetime time.Duration
+	}
+	type res struct {
+		alerted  bool
+		lifetime time.Duration
+		err      func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "no factor checked, ok",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				checks: []eventstore.Command{
+					session.NewUserCheckedEvent(context.Background(), sessionAgg, "userID", "org1", testNow, nil),
+				},
+			},
+		},
+		{
+			name: "no break-glass account, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				checks: []eventstore.Command{
+					session.NewPasswordCheckedEvent(context.Background(), sessionAgg, testNow),
+				},
+			},
+		},
+		{
+			name: "password checked, precondition failed",
+			fields: fields{
+				eventstore: expectEventstore(
+					breakGlassUser,
+					expectFilter(),
+				),
+			},
+			args: args{
+				checks: []eventstore.Command{
+					session.NewPasswordCheckedEvent(context.Background(), sessionAgg, testNow),
+				},
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "security key without user verification, precondition failed",
+			fields: fields{
+				eventstore: expectEventstore(
+					breakGlassUser,
+					expectFilter(),
+				),
+			},
+			args: args{
+				checks: []eventstore.Command{
+					session.NewWebAuthNCheckedEvent(context.Background(), sessionAgg, testNow, false),
+				},
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "passkey checked, alerted",
+			fields: fields{
+				eventstore: expectEventstore(
+					breakGlassUser,
+					expectFilter(),
+				),
+			},
+			args: args{
+				checks: []eventstore.Command{
+					session.NewWebAuthNCheckedEvent(context.Background(), sessionAgg, testNow, true),
+				},
+				lifetime: time.Hour,
+			},
+			res: res{
+				alerted:  true,
+				lifetime: domain.BreakGlassSessionLifetimeDefault,
+			},
+		},
+		{
+			name: "lifetime without checks, limited",
+			fields: fields{
+				eventstore: expectEventstore(
+					breakGlassUser,
+					expectFilter(),
+				),
+			},
+			args: args{
+				lifetime: time.Hour,
+			},
+			res: res{
+				lifetime: domain.BreakGlassSessionLifetimeDefault,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			sessionWriteModel := NewSessionWriteModel("sessionID", "instance1")
+			sessionWriteModel.UserID = "userID"
+			sessionWriteModel.UserResourceOwner = "org1"
+			cmd := &SessionCommands{
+				sessionWriteModel: sessionWriteModel,
+				eventCommands:     tt.args.checks,
+				now: func() time.Time {
+					return testNow
+				},
+			}
+			err := c.checkBreakGlassSession(context.Background(), cmd, tt.args.lifetime)
+			if tt.res.err != nil {
+				if !tt.res.err(err) {
+					t.Errorf("got wrong err: %v ", err)
+				}
+				return
+			}
+			assert.NoError(t, err)
+			var alerted bool
+			for _, event := range cmd.eventCommands {
+				if _, ok := event.(*user.HumanBreakGlassUsedEvent); ok {
+					alerted = true
+				}
+			}
+			assert.Equal(t, tt.res.alerted, alerted)
+			if tt.res.lifetime > 0 {
+				assert.Equal(t, tt.res.lifetime, cmd.breakGlassPolicy.Lifetime(tt.args.lifetime))
+			}
+		})
+	}
+}
@@ -31,6 +31,9 @@ func (c *Commands) ChangeHumanEmail(ctx context.Context, email *domain.Email, em
 	if existingEmail.UserState == domain.UserStateInitial {
 		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-J8dsk", "Errors.User.NotInitialised")
 	}
+	if err = c.checkUserInMemberScope(ctx, existingEmail.ResourceOwner, email.AggregateID); err != nil {
+		return nil, err
+	}
 	userAgg := UserAggregateFromWriteModel(&existingEmail.WriteModel)
 	changedEvent, hasChanged := existingEmail.NewChangedEvent(ctx, userAgg, email.EmailAddress)
 
@@ -96,6 +96,7 @@ type OTPCodeWriteModel interface {
 type HumanOTPSMSWriteModel struct {
 	eventstore.WriteModel
 
+	phone         domain.PhoneNumber
 	phoneVerified bool
 	otpAdded      bool
 }
@@ -104,6 +105,11 @@ func (wm *HumanOTPSMSWriteModel) OTPAdded() bool {
 	return wm.otpAdded
 }
 
+// Phone returns the phone number the codes are sent to.
+func (wm *HumanOTPSMSWriteModel) Phone() domain.PhoneNumber {
+	return wm.phone
+}
+
 func (wm *HumanOTPSMSWriteModel) ResourceOwner() string {
 	return wm.WriteModel.ResourceOwner
 }
@@ -119,7 +125,13 @@ func NewHumanOTPSMSWriteModel(userID, resourceOwner string) *HumanOTPSMSWriteMod
 
 func (wm *HumanOTPSMSWriteModel) Reduce() error {
 	for _, event := range wm.Events {
-		switch event.(type) {
+		switch e := event.(type) {
+		case *user.HumanAddedEvent:
+			wm.phone = e.PhoneNumber
+		case *user.HumanRegisteredEvent:
+			wm.phone = e.PhoneNumber
+		case *user.HumanPhoneChangedEvent:
+			wm.phone = e.PhoneNumber
 		case *user.HumanPhoneVerifiedEvent:
 			wm.phoneVerified = true
 		case *user.HumanOTPSMSAddedEvent:
@@ -128,6 +140,7 @@ func (wm *HumanOTPSMSWriteModel) Reduce() error {
 			wm.otpAdded = false
 		case *user.HumanPhoneRemovedEvent,
 			*user.UserRemovedEvent:
+			wm.phone = ""
 			wm.phoneVerified = false
 			wm.otpAdded = false
 		}
@@ -140,7 +153,11 @@ func (wm *HumanOTPSMSWriteModel) Query() *eventstore.SearchQueryBuilder {
 		AddQuery().
 		AggregateTypes(user.AggregateType).
 		AggregateIDs(wm.AggregateID).
-		EventTypes(user.HumanPhoneVerifiedType,
+		EventTypes(
+			user.HumanAddedType,
+			user.HumanRegisteredType,
+			user.HumanPhoneChangedType,
+			user.HumanPhoneVerifiedType,
 			user.HumanOTPSMSAddedType,
 			user.HumanOTPSMSRemovedType,
 			user.HumanPhoneRemovedType,
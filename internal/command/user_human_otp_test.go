@@ -14,6 +14,7 @@ import (
 	"golang.org/x/text/language"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
+	sd "github.com/zitadel/zitadel/internal/config/systemdefaults"
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
@@ -560,6 +561,7 @@ func TestCommands_createHumanTOTP(t *testing.T) {
 							),
 						),
 					),
+					expectFilter(),
 					expectFilter(
 						eventFromEventPusher(
 							org.NewOrgAddedEvent(context.Background(),
@@ -802,6 +804,7 @@ func TestCommands_HumanCheckMFATOTPSetup(t *testing.T) {
 							user.NewHumanOTPAddedEvent(ctx, userAgg2, secret),
 						),
 					),
+					expectFilter(),
 					expectPush(
 						user.NewHumanOTPVerifiedEvent(ctx,
 							userAgg2,
@@ -1123,6 +1126,7 @@ func TestCommandSide_AddHumanOTPSMS(t *testing.T) {
 							),
 						),
 					),
+					expectFilter(),
 					expectPush(
 						user.NewHumanOTPSMSAddedEvent(ctx,
 							&user.NewAggregate("user2", "org1").Aggregate,
@@ -1414,6 +1418,7 @@ func TestCommandSide_HumanSendOTPSMS(t *testing.T) {
 		eventstore              func(*testing.T) *eventstore.Eventstore
 		userEncryption          crypto.EncryptionAlgorithm
 		defaultSecretGenerators *SecretGenerators
+		otpRateLimits           sd.OTPRateLimits
 	}
 	type (
 		args struct {
@@ -1465,6 +1470,83 @@ func TestCommandSide_HumanSendOTPSMS(t *testing.T) {
 				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-SFD52", "Errors.User.MFA.OTP.NotReady"),
 			},
 		},
+		{
+			name: "country not allowed, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanPhoneChangedEvent(ctx,
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"+4930123456",
+							),
+						),
+						eventFromEventPusher(
+							user.NewHumanOTPSMSAddedEvent(ctx,
+								&user.NewAggregate("user1", "org1").Aggregate,
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							securityPolicySMSAllowedCountriesEvent("CH", "AT"),
+						),
+					),
+				),
+				defaultSecretGenerators: defaultGenerators,
+			},
+			args: args{
+				ctx:           ctx,
+				userID:        "user1",
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Otp4c", "Errors.User.MFA.OTP.CountryNotAllowed"),
+			},
+		},
+		{
+			name: "user limit reached, resource exhausted error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanOTPSMSAddedEvent(ctx,
+								&user.NewAggregate("user1", "org1").Aggregate,
+							),
+						),
+					),
+					expectFilter(),
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanOTPSMSCodeAddedEvent(ctx,
+								&user.NewAggregate("user1", "org1").Aggregate,
+								&crypto.CryptoValue{
+									CryptoType: crypto.TypeEncryption,
+									Algorithm:  "enc",
+									KeyID:      "id",
+									Crypted:    []byte("12345678"),
+								},
+								time.Hour,
+								nil,
+								&domain.OTPDelivery{UserID: "user1"},
+							),
+						),
+					),
+				),
+				defaultSecretGenerators: defaultGenerators,
+				otpRateLimits: sd.OTPRateLimits{
+					SMS: sd.OTPRateLimit{Window: time.Hour, PerUser: 1},
+				},
+			},
+			args: args{
+				ctx:           ctx,
+				userID:        "user1",
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: zerrors.ThrowResourceExhausted(nil, "COMMAND-Otp3l", "Errors.User.MFA.OTP.RateLimited"),
+			},
+		},
 		{
 			name: "successful add",
 			fields: fields{
@@ -1476,6 +1558,7 @@ func TestCommandSide_HumanSendOTPSMS(t *testing.T) {
 							),
 						),
 					),
+					expectFilter(),
 					expectFilter(
 						eventFromEventPusher(
 							instance.NewSecretGeneratorAddedEvent(context.Background(),
@@ -1500,6 +1583,7 @@ func TestCommandSide_HumanSendOTPSMS(t *testing.T) {
 							},
 							time.Hour,
 							nil,
+							nil,
 						),
 					),
 				),
@@ -1529,6 +1613,7 @@ func TestCommandSide_HumanSendOTPSMS(t *testing.T) {
 						),
 					),
 					expectFilter(),
+					expectFilter(),
 					expectPush(
 						user.NewHumanOTPSMSCodeAddedEvent(ctx,
 							&user.NewAggregate("user1", "org1").Aggregate,
@@ -1540,6 +1625,7 @@ func TestCommandSide_HumanSendOTPSMS(t *testing.T) {
 							},
 							time.Hour,
 							nil,
+							nil,
 						),
 					),
 				),
@@ -1568,6 +1654,7 @@ func TestCommandSide_HumanSendOTPSMS(t *testing.T) {
 							),
 						),
 					),
+					expectFilter(),
 					expectFilter(
 						eventFromEventPusher(
 							instance.NewSecretGeneratorAddedEvent(context.Background(),
@@ -1600,6 +1687,7 @@ func TestCommandSide_HumanSendOTPSMS(t *testing.T) {
 									RemoteIP:       net.IP{192, 0, 2, 1},
 								},
 							},
+							nil,
 						),
 					),
 				),
@@ -1633,6 +1721,7 @@ func TestCommandSide_HumanSendOTPSMS(t *testing.T) {
 				eventstore:              tt.fields.eventstore(t),
 				userEncryption:          tt.fields.userEncryption,
 				defaultSecretGenerators: tt.fields.defaultSecretGenerators,
+				otpRateLimits:           tt.fields.otpRateLimits,
 			}
 			err := r.HumanSendOTPSMS(tt.args.ctx, tt.args.userID, tt.args.resourceOwner, tt.args.authRequest)
 			assert.ErrorIs(t, err, tt.res.err)
@@ -1857,6 +1946,7 @@ func TestCommandSide_HumanCheckOTPSMS(t *testing.T) {
 										RemoteIP:       net.IP{192, 0, 2, 1},
 									},
 								},
+								nil,
 							),
 						),
 					),
@@ -1934,6 +2024,7 @@ func TestCommandSide_HumanCheckOTPSMS(t *testing.T) {
 										RemoteIP:       net.IP{192, 0, 2, 1},
 									},
 								},
+								nil,
 							),
 						),
 					),
@@ -1959,7 +2050,7 @@ func TestCommandSide_HumanCheckOTPSMS(t *testing.T) {
 								},
 							},
 						),
-						user.NewUserLockedEvent(ctx,
+						user.NewUserLockoutEvent(ctx,
 							&user.NewAggregate("user1", "org1").Aggregate,
 						),
 					),
@@ -2014,6 +2105,7 @@ func TestCommandSide_HumanCheckOTPSMS(t *testing.T) {
 										RemoteIP:       net.IP{192, 0, 2, 1},
 									},
 								},
+								nil,
 							),
 						),
 					),
@@ -2085,6 +2177,7 @@ func TestCommandSide_HumanCheckOTPSMS(t *testing.T) {
 										RemoteIP:       net.IP{192, 0, 2, 1},
 									},
 								},
+								nil,
 							),
 						),
 					),
@@ -2278,6 +2371,7 @@ func TestCommandSide_AddHumanOTPEmail(t *testing.T) {
 							),
 						),
 					),
+					expectFilter(),
 					expectPush(
 						user.NewHumanOTPEmailAddedEvent(ctx,
 							&user.NewAggregate("user2", "org1").Aggregate,
@@ -2655,6 +2749,7 @@ func TestCommandSide_HumanSendOTPEmail(t *testing.T) {
 							},
 							time.Hour,
 							nil,
+							nil,
 						),
 					),
 				),
@@ -2695,6 +2790,7 @@ func TestCommandSide_HumanSendOTPEmail(t *testing.T) {
 							},
 							time.Hour,
 							nil,
+							nil,
 						),
 					),
 				),
@@ -2755,6 +2851,7 @@ func TestCommandSide_HumanSendOTPEmail(t *testing.T) {
 									RemoteIP:       net.IP{192, 0, 2, 1},
 								},
 							},
+							nil,
 						),
 					),
 				),
@@ -3012,6 +3109,7 @@ func TestCommandSide_HumanCheckOTPEmail(t *testing.T) {
 										RemoteIP:       net.IP{192, 0, 2, 1},
 									},
 								},
+								nil,
 							),
 						),
 					),
@@ -3089,6 +3187,7 @@ func TestCommandSide_HumanCheckOTPEmail(t *testing.T) {
 										RemoteIP:       net.IP{192, 0, 2, 1},
 									},
 								},
+								nil,
 							),
 						),
 					),
@@ -3114,7 +3213,7 @@ func TestCommandSide_HumanCheckOTPEmail(t *testing.T) {
 								},
 							},
 						),
-						user.NewUserLockedEvent(ctx,
+						user.NewUserLockoutEvent(ctx,
 							&user.NewAggregate("user1", "org1").Aggregate,
 						),
 					),
@@ -3169,6 +3268,7 @@ func TestCommandSide_HumanCheckOTPEmail(t *testing.T) {
 										RemoteIP:       net.IP{192, 0, 2, 1},
 									},
 								},
+								nil,
 							),
 						),
 					),
@@ -3240,6 +3340,7 @@ func TestCommandSide_HumanCheckOTPEmail(t *testing.T) {
 										RemoteIP:       net.IP{192, 0, 2, 1},
 									},
 								},
+								nil,
 							),
 						),
 					),
@@ -93,7 +93,10 @@ type setPasswordVerification func(ctx context.Context) (newEncodedPassword strin
 // setPasswordWithPermission returns a permission check as [setPasswordVerification] implementation
 func (c *Commands) setPasswordWithPermission(userID, orgID string) setPasswordVerification {
 	return func(ctx context.Context) (_ string, err error) {
-		return "", c.checkPermission(ctx, domain.PermissionUserWrite, orgID, userID)
+		if err = c.checkPermission(ctx, domain.PermissionUserWrite, orgID, userID); err != nil {
+			return "", err
+		}
+		return "", c.checkUserInMemberScope(ctx, orgID, userID)
 	}
 }
 
@@ -312,7 +315,14 @@ func (c *Commands) HumanCheckPassword(ctx context.Context, orgID, userID, passwo
 		return zerrors.ThrowPreconditionFailed(err, "COMMAND-Edf3g", "Errors.Org.LoginPolicy.NotFound")
 	}
 	if !loginPolicy.AllowUsernamePassword {
-		return zerrors.ThrowPreconditionFailed(err, "COMMAND-Dft32", "Errors.Org.LoginPolicy.UsernamePasswordNotAllowed")
+		// break-glass accounts must be able to log in even if only identity providers are allowed
+		breakGlassPolicy, err := c.breakGlassPolicyByUser(ctx, userID)
+		if err != nil {
+			return err
+		}
+		if breakGlassPolicy == nil {
+			return zerrors.ThrowPreconditionFailed(nil, "COMMAND-Dft32", "Errors.Org.LoginPolicy.UsernamePasswordNotAllowed")
+		}
 	}
 	commands, err := checkPassword(ctx, userID, password, c.eventstore, c.userPasswordHasher, authRequestDomainToAuthRequestInfo(authRequest))
 	if len(commands) == 0 {
@@ -371,7 +381,7 @@ func checkPassword(ctx context.Context, userID, password string, es *eventstore.
 	lockoutPolicy, lockoutErr := getLockoutPolicy(ctx, wm.ResourceOwner, es.FilterToQueryReducer)
 	logging.OnError(lockoutErr).Error("unable to get lockout policy")
 	if lockoutPolicy != nil && lockoutPolicy.MaxPasswordAttempts > 0 && wm.PasswordCheckFailedCount+1 >= lockoutPolicy.MaxPasswordAttempts {
-		commands = append(commands, user.NewUserLockedEvent(ctx, userAgg))
+		commands = append(commands, user.NewUserLockoutEvent(ctx, userAgg))
 	}
 	return commands, err
 }
@@ -24,6 +24,9 @@ func (c *Commands) ChangeHumanPhone(ctx context.Context, phone *domain.Phone, re
 	if !existingPhone.UserState.Exists() {
 		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-3M0fs", "Errors.User.NotFound")
 	}
+	if err = c.checkUserInMemberScope(ctx, existingPhone.ResourceOwner, phone.AggregateID); err != nil {
+		return nil, err
+	}
 
 	userAgg := UserAggregateFromWriteModel(&existingPhone.WriteModel)
 	changedEvent, hasChanged := existingPhone.NewChangedEvent(ctx, userAgg, phone.PhoneNumber)
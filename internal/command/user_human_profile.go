@@ -22,6 +22,9 @@ func (c *Commands) ChangeHumanProfile(ctx context.Context, profile *domain.Profi
 	if existingProfile.UserState == domain.UserStateUnspecified || existingProfile.UserState == domain.UserStateDeleted {
 		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-3M9sd", "Errors.User.Profile.NotFound")
 	}
+	if err = c.checkUserInMemberScope(ctx, existingProfile.ResourceOwner, profile.AggregateID); err != nil {
+		return nil, err
+	}
 	userAgg := UserAggregateFromWriteModel(&existingProfile.WriteModel)
 	changedEvent, hasChanged, err := existingProfile.NewChangedEvent(ctx, userAgg, profile.FirstName, profile.LastName, profile.NickName, profile.DisplayName, profile.PreferredLanguage, profile.Gender)
 	if err != nil {
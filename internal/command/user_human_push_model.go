@@ -0,0 +1,88 @@
+package command
+
+import (
+	"slices"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+type PushDeviceWriteModel struct {
+	DeviceID  string
+	Name      string
+	Platform  domain.PushPlatform
+	PublicKey []byte
+	PushToken string
+}
+
+type HumanPushDevicesWriteModel struct {
+	eventstore.WriteModel
+
+	UserState domain.UserState
+	Devices   []*PushDeviceWriteModel
+}
+
+func NewHumanPushDevicesWriteModel(userID, resourceOwner string) *HumanPushDevicesWriteModel {
+	return &HumanPushDevicesWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   userID,
+			ResourceOwner: resourceOwner,
+		},
+	}
+}
+
+func (wm *HumanPushDevicesWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *user.HumanAddedEvent, *user.HumanRegisteredEvent:
+			wm.UserState = domain.UserStateActive
+		case *user.HumanPushDeviceAddedEvent:
+			wm.Devices = append(wm.Devices, &PushDeviceWriteModel{
+				DeviceID:  e.DeviceID,
+				Name:      e.Name,
+				Platform:  e.Platform,
+				PublicKey: e.PublicKey,
+				PushToken: e.PushToken,
+			})
+		case *user.HumanPushDeviceRemovedEvent:
+			wm.Devices = slices.DeleteFunc(wm.Devices, func(device *PushDeviceWriteModel) bool {
+				return device.DeviceID == e.DeviceID
+			})
+		case *user.UserRemovedEvent:
+			wm.UserState = domain.UserStateDeleted
+			wm.Devices = nil
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *HumanPushDevicesWriteModel) Query() *eventstore.SearchQueryBuilder {
+	query := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			user.HumanAddedType,
+			user.HumanRegisteredType,
+			user.HumanPushDeviceAddedType,
+			user.HumanPushDeviceRemovedType,
+			user.UserRemovedType,
+		).
+		Builder()
+
+	if wm.ResourceOwner != "" {
+		query.ResourceOwner(wm.ResourceOwner)
+	}
+	return query
+}
+
+// Device returns the registered device with the ID or nil if there is none.
+func (wm *HumanPushDevicesWriteModel) Device(deviceID string) *PushDeviceWriteModel {
+	for _, device := range wm.Devices {
+		if device.DeviceID == deviceID {
+			return device
+		}
+	}
+	return nil
+}
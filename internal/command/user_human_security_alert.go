@@ -0,0 +1,40 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// SecurityAlertSent marks the security alert of type alertType,
+// caused by the event with triggeringSequence, as sent to the user.
+func (c *Commands) SecurityAlertSent(ctx context.Context, orgID, userID, alertType string, triggeringSequence uint64) error {
+	return c.securityAlertDelivered(ctx, orgID, userID, alertType, triggeringSequence, "", "")
+}
+
+// SecurityAlertDelivered marks a single delivery of the security alert of type alertType,
+// caused by the event with triggeringSequence, either to the user with recipientID or to the Actions target with targetID.
+func (c *Commands) SecurityAlertDelivered(ctx context.Context, orgID, userID, alertType string, triggeringSequence uint64, recipientID, targetID string) error {
+	if recipientID == "" && targetID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Sa4dl", "Errors.IDMissing")
+	}
+	return c.securityAlertDelivered(ctx, orgID, userID, alertType, triggeringSequence, recipientID, targetID)
+}
+
+func (c *Commands) securityAlertDelivered(ctx context.Context, orgID, userID, alertType string, triggeringSequence uint64, recipientID, targetID string) error {
+	if userID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Sa3lp", "Errors.User.UserIDMissing")
+	}
+	existingUser, err := c.userWriteModelByID(ctx, userID, orgID)
+	if err != nil {
+		return err
+	}
+	if !isUserStateExists(existingUser.UserState) {
+		return zerrors.ThrowNotFound(nil, "COMMAND-Sa9wq", "Errors.User.NotFound")
+	}
+	_, err = c.eventstore.Push(ctx,
+		user.NewHumanSecurityAlertDeliveredEvent(ctx, UserAggregateFromWriteModel(&existingUser.WriteModel), alertType, triggeringSequence, recipientID, targetID),
+	)
+	return err
+}
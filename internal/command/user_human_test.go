@@ -179,6 +179,7 @@ func TestCommandSide_AddHuman(t *testing.T) {
 					),
 					expectFilter(),
 					expectFilter(),
+					expectFilter(),
 				),
 			},
 			args: args{
@@ -218,6 +219,9 @@ func TestCommandSide_AddHuman(t *testing.T) {
 							),
 						),
 					),
+					expectFilter(),
+					expectFilter(),
+					expectFilter(),
 					expectPush(
 						user.NewHumanAddedEvent(context.Background(),
 							&userAgg.Aggregate,
@@ -286,6 +290,9 @@ func TestCommandSide_AddHuman(t *testing.T) {
 							),
 						),
 					),
+					expectFilter(),
+					expectFilter(),
+					expectFilter(),
 					expectPush(
 						user.NewHumanAddedEvent(context.Background(),
 							&userAgg.Aggregate,
@@ -355,6 +362,9 @@ func TestCommandSide_AddHuman(t *testing.T) {
 							),
 						),
 					),
+					expectFilter(),
+					expectFilter(),
+					expectFilter(),
 					expectPush(
 						user.NewHumanAddedEvent(context.Background(),
 							&userAgg.Aggregate,
@@ -409,6 +419,72 @@ func TestCommandSide_AddHuman(t *testing.T) {
 				wantID: "user1",
 			},
 		},
+		{
+			name: "add human without passkey, passkey registration forced, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewDomainPolicyAddedEvent(context.Background(),
+								&userAgg.Aggregate,
+								true,
+								true,
+								true,
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewLoginPolicyAddedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								true,
+								true,
+								false,
+								false,
+								false,
+								false,
+								false,
+								false,
+								false,
+								false,
+								true,
+								domain.PasswordlessTypeAllowed,
+								"",
+								time.Hour*1,
+								time.Hour*2,
+								time.Hour*3,
+								time.Hour*4,
+								time.Hour*5,
+							),
+						),
+					),
+				),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "user1"),
+				codeAlg:     crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+				newCode:     mockEncryptedCode("userinit", time.Hour),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+				human: &AddHuman{
+					Username:  "username",
+					FirstName: "firstname",
+					LastName:  "lastname",
+					Email: Email{
+						Address: "email@test.ch",
+					},
+					PreferredLanguage: AllowedLanguage,
+				},
+				secretGenerator: GetMockSecretGenerator(t),
+				allowInitMail:   true,
+			},
+			res: res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Qa2wy", "Errors.User.Passkey.RegistrationRequired"))
+				},
+			},
+		},
 		{
 			name: "add human (with password and initial code), ok",
 			fields: fields{
@@ -436,6 +512,9 @@ func TestCommandSide_AddHuman(t *testing.T) {
 							),
 						),
 					),
+					expectFilter(),
+					expectFilter(),
+					expectFilter(),
 					expectPush(
 						newAddHumanEvent("$plain$x$password", false, true, "", AllowedLanguage),
 						user.NewHumanInitialCodeAddedEvent(context.Background(),
@@ -506,6 +585,9 @@ func TestCommandSide_AddHuman(t *testing.T) {
 							),
 						),
 					),
+					expectFilter(),
+					expectFilter(),
+					expectFilter(),
 					expectPush(
 						newAddHumanEvent("$plain$x$password", false, true, "", AllowedLanguage),
 						user.NewHumanEmailCodeAddedEventV2(context.Background(),
@@ -579,6 +661,9 @@ func TestCommandSide_AddHuman(t *testing.T) {
 							),
 						),
 					),
+					expectFilter(),
+					expectFilter(),
+					expectFilter(),
 					expectPush(
 						newAddHumanEvent("$plain$x$password", false, true, "", AllowedLanguage),
 						user.NewHumanEmailCodeAddedEventV2(context.Background(),
@@ -653,6 +738,9 @@ func TestCommandSide_AddHuman(t *testing.T) {
 							),
 						),
 					),
+					expectFilter(),
+					expectFilter(),
+					expectFilter(),
 					expectPush(
 						newAddHumanEvent("$plain$x$password", true, true, "", AllowedLanguage),
 						user.NewHumanEmailVerifiedEvent(context.Background(),
@@ -716,6 +804,9 @@ func TestCommandSide_AddHuman(t *testing.T) {
 							),
 						),
 					),
+					expectFilter(),
+					expectFilter(),
+					expectFilter(),
 					expectPush(
 						newAddHumanEvent("$plain$x$password", true, true, "", AllowedLanguage),
 						user.NewHumanEmailVerifiedEvent(context.Background(),
@@ -779,6 +870,9 @@ func TestCommandSide_AddHuman(t *testing.T) {
 							),
 						),
 					),
+					expectFilter(),
+					expectFilter(),
+					expectFilter(),
 					expectPush(
 						newAddHumanEvent("$plain$x$password", true, false, "", AllowedLanguage),
 						user.NewHumanEmailVerifiedEvent(context.Background(),
@@ -902,6 +996,9 @@ func TestCommandSide_AddHuman(t *testing.T) {
 							),
 						),
 					),
+					expectFilter(),
+					expectFilter(),
+					expectFilter(),
 					expectPush(
 						func() eventstore.Command {
 							event := user.NewHumanAddedEvent(context.Background(),
@@ -981,6 +1078,9 @@ func TestCommandSide_AddHuman(t *testing.T) {
 							),
 						),
 					),
+					expectFilter(),
+					expectFilter(),
+					expectFilter(),
 					expectPush(
 						newAddHumanEvent("$plain$x$password", false, true, "+41711234567", AllowedLanguage),
 						user.NewHumanEmailVerifiedEvent(
@@ -1046,6 +1146,9 @@ func TestCommandSide_AddHuman(t *testing.T) {
 							),
 						),
 					),
+					expectFilter(),
+					expectFilter(),
+					expectFilter(),
 					expectPush(
 						newAddHumanEvent("", false, true, "+41711234567", AllowedLanguage),
 						user.NewHumanInitialCodeAddedEvent(
@@ -1122,6 +1225,9 @@ func TestCommandSide_AddHuman(t *testing.T) {
 							),
 						),
 					),
+					expectFilter(),
+					expectFilter(),
+					expectFilter(),
 					expectPush(
 						newAddHumanEvent("$plain$x$password", false, true, "+41711234567", AllowedLanguage),
 						user.NewHumanEmailVerifiedEvent(context.Background(),
@@ -1188,6 +1294,9 @@ func TestCommandSide_AddHuman(t *testing.T) {
 							),
 						),
 					),
+					expectFilter(),
+					expectFilter(),
+					expectFilter(),
 					expectPush(
 						newAddHumanEvent("", false, true, "", AllowedLanguage),
 						user.NewHumanInitialCodeAddedEvent(
@@ -1371,6 +1480,7 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 							),
 							expectFilter(),
 							expectFilter(),
+							expectFilter(),
 						),
 					},
 					args{
@@ -1421,6 +1531,9 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 									),
 								),
 							),
+							expectFilter(),
+							expectFilter(),
+							expectFilter(),
 						),
 					},
 					args{
@@ -1466,6 +1579,9 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 									),
 								),
 							),
+							expectFilter(),
+							expectFilter(),
+							expectFilter(),
 							expectPush(
 								newAddHumanEvent("$plain$x$password", true, true, "", AllowedLanguage),
 								user.NewHumanInitialCodeAddedEvent(context.Background(),
@@ -1525,6 +1641,89 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "add human with password, passkey registration forced, precondition error",
+			given: func(t *testing.T) (fields, args) {
+				return fields{
+						eventstore: eventstoreExpect(
+							t,
+							expectFilter(
+								eventFromEventPusher(
+									org.NewDomainPolicyAddedEvent(context.Background(),
+										&user.NewAggregate("user1", "org1").Aggregate,
+										true,
+										true,
+										true,
+									),
+								),
+							),
+							expectFilter(
+								eventFromEventPusher(
+									org.NewPasswordComplexityPolicyAddedEvent(context.Background(),
+										&user.NewAggregate("user1", "org1").Aggregate,
+										1,
+										false,
+										false,
+										false,
+										false,
+									),
+								),
+							),
+							expectFilter(
+								eventFromEventPusher(
+									org.NewLoginPolicyAddedEvent(context.Background(),
+										&org.NewAggregate("org1").Aggregate,
+										true,
+										true,
+										false,
+										false,
+										false,
+										false,
+										false,
+										false,
+										false,
+										false,
+										true,
+										domain.PasswordlessTypeAllowed,
+										"",
+										time.Hour*1,
+										time.Hour*2,
+										time.Hour*3,
+										time.Hour*4,
+										time.Hour*5,
+									),
+								),
+							),
+						),
+						userPasswordHasher: mockPasswordHasher("x"),
+					},
+					args{
+						ctx:   context.Background(),
+						orgID: "org1",
+						human: &domain.Human{
+							Username: "username",
+							Password: &domain.Password{
+								SecretString:   "password",
+								ChangeRequired: true,
+							},
+							Profile: &domain.Profile{
+								FirstName:         "firstname",
+								LastName:          "lastname",
+								PreferredLanguage: AllowedLanguage,
+							},
+							Email: &domain.Email{
+								EmailAddress: "email@test.ch",
+							},
+						},
+						secretGenerator: GetMockSecretGenerator(t),
+					}
+			},
+			res: res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Qa2wy", "Errors.User.Passkey.RegistrationRequired"))
+				},
+			},
+		},
 		{
 			name: "add human email verified password change not required, ok",
 			given: func(t *testing.T) (fields, args) {
@@ -1553,6 +1752,9 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 									),
 								),
 							),
+							expectFilter(),
+							expectFilter(),
+							expectFilter(),
 							expectPush(
 								newAddHumanEvent("$plain$x$password", false, true, "", AllowedLanguage),
 								user.NewHumanEmailVerifiedEvent(context.Background(),
@@ -1735,6 +1937,9 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 								),
 							),
 							expectFilter(),
+							expectFilter(),
+							expectFilter(),
+							expectFilter(),
 							expectPush(
 								newAddHumanEvent("$plain$x$password", false, true, "", AllowedLanguage),
 								user.NewHumanEmailVerifiedEvent(context.Background(),
@@ -1838,6 +2043,9 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 									),
 								),
 							),
+							expectFilter(),
+							expectFilter(),
+							expectFilter(),
 							expectPush(
 								newAddHumanEvent("$plain$x$password", false, true, "+41711234567", AllowedLanguage),
 								user.NewHumanInitialCodeAddedEvent(context.Background(),
@@ -1940,6 +2148,9 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 									),
 								),
 							),
+							expectFilter(),
+							expectFilter(),
+							expectFilter(),
 							expectPush(
 								newAddHumanEvent("$plain$x$password", false, true, "+41711234567", AllowedLanguage),
 								user.NewHumanInitialCodeAddedEvent(context.Background(),
@@ -2036,6 +2247,9 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 									),
 								),
 							),
+							expectFilter(),
+							expectFilter(),
+							expectFilter(),
 							expectPush(
 								newAddHumanEvent("$plain$x$password", false, true, "", language.Und),
 								user.NewHumanInitialCodeAddedEvent(context.Background(),
@@ -2122,6 +2336,9 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 									),
 								),
 							),
+							expectFilter(),
+							expectFilter(),
+							expectFilter(),
 							expectPush(
 								newAddHumanEvent("$plain$x$password", false, true, "", UnsupportedLanguage),
 								user.NewHumanInitialCodeAddedEvent(context.Background(),
@@ -6,7 +6,6 @@ import (
 
 	"github.com/zitadel/logging"
 
-	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
@@ -146,10 +145,8 @@ func (c *Commands) addHumanWebAuthN(ctx context.Context, userID, resourceowner,
 	if err != nil {
 		return nil, nil, nil, err
 	}
-	if authz.GetCtxData(ctx).UserID != userID {
-		if err = c.checkPermission(ctx, domain.PermissionUserCredentialWrite, user.ResourceOwner, userID); err != nil {
-			return nil, nil, nil, err
-		}
+	if err = c.checkPermissionUpdateUserCredentials(ctx, user.ResourceOwner, userID); err != nil {
+		return nil, nil, nil, err
 	}
 	org, err := c.getOrg(ctx, user.ResourceOwner)
 	if err != nil {
@@ -390,7 +387,7 @@ func (c *Commands) HumanFinishU2FLogin(ctx context.Context, userID, resourceOwne
 		return err
 	}
 
-	_, err = c.eventstore.Push(ctx,
+	cmds := []eventstore.Command{
 		usr_repo.NewHumanU2FCheckSucceededEvent(
 			ctx,
 			userAgg,
@@ -402,7 +399,15 @@ func (c *Commands) HumanFinishU2FLogin(ctx context.Context, userID, resourceOwne
 			token.WebAuthNTokenID,
 			signCount,
 		),
-	)
+	}
+	breakGlassEvent, err := c.breakGlassUsedEvent(ctx, userAgg, authRequest.AgentID, authRequestDomainToAuthRequestInfo(authRequest))
+	if err != nil {
+		return err
+	}
+	if breakGlassEvent != nil {
+		cmds = append(cmds, breakGlassEvent)
+	}
+	_, err = c.eventstore.Push(ctx, cmds...)
 
 	return err
 }
@@ -435,7 +440,7 @@ func (c *Commands) HumanFinishPasswordlessLogin(ctx context.Context, userID, res
 		return err
 	}
 
-	_, err = c.eventstore.Push(ctx,
+	cmds := []eventstore.Command{
 		usr_repo.NewHumanPasswordlessCheckSucceededEvent(
 			ctx,
 			userAgg,
@@ -447,7 +452,15 @@ func (c *Commands) HumanFinishPasswordlessLogin(ctx context.Context, userID, res
 			token.WebAuthNTokenID,
 			signCount,
 		),
-	)
+	}
+	breakGlassEvent, err := c.breakGlassUsedEvent(ctx, userAgg, authRequest.AgentID, authRequestDomainToAuthRequestInfo(authRequest))
+	if err != nil {
+		return err
+	}
+	if breakGlassEvent != nil {
+		cmds = append(cmds, breakGlassEvent)
+	}
+	_, err = c.eventstore.Push(ctx, cmds...)
 	return err
 }
 
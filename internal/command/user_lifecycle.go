@@ -0,0 +1,96 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// AddUserDeactivationWarning informs the user that the account is going to be deactivated
+// at deactivationDate because of inactivity, as defined by the user lifecycle policy.
+func (c *Commands) AddUserDeactivationWarning(ctx context.Context, userID, resourceOwner string, deactivationDate time.Time) (*domain.ObjectDetails, error) {
+	if userID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Jd92k", "Errors.User.UserIDMissing")
+	}
+	existingUser, err := c.userWriteModelByID(ctx, userID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !isUserStateExists(existingUser.UserState) {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-b7Lsw", "Errors.User.NotFound")
+	}
+	if isUserStateInactive(existingUser.UserState) {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Pw2fq", "Errors.User.AlreadyInactive")
+	}
+	pushedEvents, err := c.eventstore.Push(ctx,
+		user.NewUserDeactivationWarningAddedEvent(ctx, UserAggregateFromWriteModel(&existingUser.WriteModel), deactivationDate))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingUser, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingUser.WriteModel), nil
+}
+
+func (c *Commands) UserDeactivationWarningSent(ctx context.Context, orgID, userID string) error {
+	if userID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Ax3mv", "Errors.User.UserIDMissing")
+	}
+	existingUser, err := c.userWriteModelByID(ctx, userID, orgID)
+	if err != nil {
+		return err
+	}
+	if !isUserStateExists(existingUser.UserState) {
+		return zerrors.ThrowNotFound(nil, "COMMAND-hL0cz", "Errors.User.NotFound")
+	}
+	_, err = c.eventstore.Push(ctx, user.NewUserDeactivationWarningSentEvent(ctx, UserAggregateFromWriteModel(&existingUser.WriteModel)))
+	return err
+}
+
+// AddUserDeletionWarning informs the user that the deactivated account is going to be deleted
+// at deletionDate, as defined by the user lifecycle policy.
+func (c *Commands) AddUserDeletionWarning(ctx context.Context, userID, resourceOwner string, deletionDate time.Time) (*domain.ObjectDetails, error) {
+	if userID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-T8vne", "Errors.User.UserIDMissing")
+	}
+	existingUser, err := c.userWriteModelByID(ctx, userID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !isUserStateExists(existingUser.UserState) {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-r4Kyd", "Errors.User.NotFound")
+	}
+	if !isUserStateInactive(existingUser.UserState) {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Fe6ob", "Errors.User.NotInactive")
+	}
+	pushedEvents, err := c.eventstore.Push(ctx,
+		user.NewUserDeletionWarningAddedEvent(ctx, UserAggregateFromWriteModel(&existingUser.WriteModel), deletionDate))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingUser, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingUser.WriteModel), nil
+}
+
+func (c *Commands) UserDeletionWarningSent(ctx context.Context, orgID, userID string) error {
+	if userID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Wq1sd", "Errors.User.UserIDMissing")
+	}
+	existingUser, err := c.userWriteModelByID(ctx, userID, orgID)
+	if err != nil {
+		return err
+	}
+	if !isUserStateExists(existingUser.UserState) {
+		return zerrors.ThrowNotFound(nil, "COMMAND-Ym5pu", "Errors.User.NotFound")
+	}
+	_, err = c.eventstore.Push(ctx, user.NewUserDeletionWarningSentEvent(ctx, UserAggregateFromWriteModel(&existingUser.WriteModel)))
+	return err
+}
@@ -18,6 +18,9 @@ func (c *Commands) SetUserMetadata(ctx context.Context, metadata *domain.Metadat
 	if err != nil {
 		return nil, err
 	}
+	if err = c.checkUserMetadataInMemberScope(ctx, resourceOwner, userID, metadata.Key); err != nil {
+		return nil, err
+	}
 	setMetadata := NewUserMetadataWriteModel(userID, resourceOwner, metadata.Key)
 	userAgg := UserAggregateFromWriteModel(&setMetadata.WriteModel)
 	event, err := c.setUserMetadata(ctx, userAgg, metadata)
@@ -44,6 +47,13 @@ func (c *Commands) BulkSetUserMetadata(ctx context.Context, userID, resourceOwne
 	if err != nil {
 		return nil, err
 	}
+	keys := make([]string, len(metadatas))
+	for i, data := range metadatas {
+		keys[i] = data.Key
+	}
+	if err = c.checkUserMetadataInMemberScope(ctx, resourceOwner, userID, keys...); err != nil {
+		return nil, err
+	}
 
 	events := make([]eventstore.Command, len(metadatas))
 	setMetadata := NewUserMetadataListWriteModel(userID, resourceOwner)
@@ -88,6 +98,9 @@ func (c *Commands) RemoveUserMetadata(ctx context.Context, metadataKey, userID,
 	if err != nil {
 		return nil, err
 	}
+	if err = c.checkUserMetadataInMemberScope(ctx, resourceOwner, userID, metadataKey); err != nil {
+		return nil, err
+	}
 	removeMetadata, err := c.getUserMetadataModelByID(ctx, userID, resourceOwner, metadataKey)
 	if err != nil {
 		return nil, err
@@ -120,6 +133,9 @@ func (c *Commands) BulkRemoveUserMetadata(ctx context.Context, userID, resourceO
 	if err != nil {
 		return nil, err
 	}
+	if err = c.checkUserMetadataInMemberScope(ctx, resourceOwner, userID, metadataKeys...); err != nil {
+		return nil, err
+	}
 
 	events := make([]eventstore.Command, len(metadataKeys))
 	removeMetadata, err := c.getUserMetadataListModelByID(ctx, userID, resourceOwner)
@@ -0,0 +1,30 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/notification"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// RecordNotificationDelivery adds the attempt to send a notification to the delivery history of the user.
+// The delivery is recorded as failed if sendErr is set.
+// Recorded deliveries are removed from the history after the retention, a retention of 0 keeps them.
+func (c *Commands) RecordNotificationDelivery(ctx context.Context, userID, resourceOwner string, delivery *domain.NotificationDelivery, triggeringEventType eventstore.EventType, retention time.Duration, sendErr error) error {
+	if userID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Nd1us", "Errors.User.UserIDMissing")
+	}
+	if delivery == nil {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Nd2dl", "Errors.Notification.Invalid")
+	}
+	aggregate := &notification.NewDeliveryAggregate(ctx, userID, resourceOwner).Aggregate
+	var event eventstore.Command = notification.NewDeliverySucceededEvent(ctx, aggregate, *delivery, triggeringEventType, retention)
+	if sendErr != nil {
+		event = notification.NewDeliveryFailedEvent(ctx, aggregate, *delivery, triggeringEventType, retention, sendErr)
+	}
+	_, err := c.eventstore.Push(ctx, event)
+	return err
+}
@@ -0,0 +1,117 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/notification"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommandSide_RecordNotificationDelivery(t *testing.T) {
+	ctx := authz.WithInstanceID(context.Background(), "instance1")
+	delivery := &domain.NotificationDelivery{
+		Channel:           domain.NotificationTypeEmail,
+		MessageType:       domain.VerifyEmailMessageType,
+		ProviderID:        "provider1",
+		ProviderMessageID: "<id@zitadel.cloud>",
+		CodeExpiry:        time.Hour,
+	}
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		userID   string
+		delivery *domain.NotificationDelivery
+		sendErr  error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		err    func(error) bool
+	}{
+		{
+			name: "missing user id, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				delivery: delivery,
+			},
+			err: zerrors.IsErrorInvalidArgument,
+		},
+		{
+			name: "missing delivery, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				userID: "user1",
+			},
+			err: zerrors.IsErrorInvalidArgument,
+		},
+		{
+			name: "sent, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectPush(
+						notification.NewDeliverySucceededEvent(ctx,
+							&notification.NewDeliveryAggregate(ctx, "user1", "org1").Aggregate,
+							*delivery,
+							user.HumanEmailCodeAddedType,
+							30*24*time.Hour,
+						),
+					),
+				),
+			},
+			args: args{
+				userID:   "user1",
+				delivery: delivery,
+			},
+		},
+		{
+			name: "failed, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectPush(
+						notification.NewDeliveryFailedEvent(ctx,
+							&notification.NewDeliveryAggregate(ctx, "user1", "org1").Aggregate,
+							*delivery,
+							user.HumanEmailCodeAddedType,
+							30*24*time.Hour,
+							errors.New("provider unavailable"),
+						),
+					),
+				),
+			},
+			args: args{
+				userID:   "user1",
+				delivery: delivery,
+				sendErr:  errors.New("provider unavailable"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			err := c.RecordNotificationDelivery(ctx, tt.args.userID, "org1", tt.args.delivery, user.HumanEmailCodeAddedType, 30*24*time.Hour, tt.args.sendErr)
+			if tt.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.err != nil && !tt.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+		})
+	}
+}
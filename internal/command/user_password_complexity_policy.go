@@ -20,8 +20,31 @@ func passwordComplexityPolicyWriteModel(ctx context.Context, filter preparation.
 	return nil, zerrors.ThrowInternal(nil, "USER-uQ96e", "Errors.Internal")
 }
 
+// customPasswordComplexityPolicy returns the policy of the organization or of its nearest ancestor with a policy.
 func customPasswordComplexityPolicy(ctx context.Context, filter preparation.FilterToQueryReducer) (*PasswordComplexityPolicyWriteModel, error) {
-	policy := NewOrgPasswordComplexityPolicyWriteModel(authz.GetCtxData(ctx).OrgID)
+	orgID := authz.GetCtxData(ctx).OrgID
+	policy, err := orgPasswordComplexityPolicy(ctx, filter, orgID)
+	if err != nil || policy != nil && policy.State.Exists() {
+		return policy, err
+	}
+	ancestors, err := orgPolicyAncestorIDs(ctx, filter, orgID)
+	if err != nil {
+		return nil, err
+	}
+	for _, ancestor := range ancestors {
+		ancestorPolicy, err := orgPasswordComplexityPolicy(ctx, filter, ancestor)
+		if err != nil {
+			return nil, err
+		}
+		if ancestorPolicy != nil && ancestorPolicy.State.Exists() {
+			return ancestorPolicy, nil
+		}
+	}
+	return policy, nil
+}
+
+func orgPasswordComplexityPolicy(ctx context.Context, filter preparation.FilterToQueryReducer, orgID string) (*PasswordComplexityPolicyWriteModel, error) {
+	policy := NewOrgPasswordComplexityPolicyWriteModel(orgID)
 	events, err := filter(ctx, policy.Query())
 	if err != nil {
 		return nil, err
@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"golang.org/x/text/language"
 
+	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/command/preparation"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
@@ -117,6 +118,46 @@ func TestCommandSide_UsernameChange(t *testing.T) {
 				err: zerrors.IsNotFound,
 			},
 		},
+		{
+			name: "user out of member scope, permission denied",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"username",
+								"firstname",
+								"lastname",
+								"nickname",
+								"displayname",
+								language.German,
+								domain.GenderUnspecified,
+								"email@test.ch",
+								true,
+							),
+						),
+					),
+					expectFilter(
+						orgMemberAddedEvent("admin1"),
+						orgMemberScopeSetEvent("admin1", &domain.OrgMemberScope{GroupID: "group1"}),
+					),
+					expectFilter(),
+					expectFilter(
+						groupAddedEvent("group1", "helpdesk"),
+					),
+				),
+			},
+			args: args{
+				ctx:      authz.NewMockContext("instance1", "org1", "admin1"),
+				orgID:    "org1",
+				userID:   "user1",
+				username: "username1",
+			},
+			res: res{
+				err: zerrors.IsPermissionDenied,
+			},
+		},
 		{
 			name: "username not changed, precondition error",
 			fields: fields{
@@ -894,6 +935,46 @@ func TestCommandSide_LockUser(t *testing.T) {
 				err: zerrors.IsPreconditionFailed,
 			},
 		},
+		{
+			name: "user out of member scope, permission denied",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"username",
+								"firstname",
+								"lastname",
+								"nickname",
+								"displayname",
+								language.German,
+								domain.GenderUnspecified,
+								"email@test.ch",
+								true,
+							),
+						),
+					),
+					expectFilter(
+						orgMemberAddedEvent("admin1"),
+						orgMemberScopeSetEvent("admin1", &domain.OrgMemberScope{GroupID: "group1"}),
+					),
+					expectFilter(),
+					expectFilter(
+						groupAddedEvent("group1", "helpdesk"),
+					),
+				),
+			},
+			args: args{
+				ctx:    authz.NewMockContext("instance1", "org1", "admin1"),
+				orgID:  "org1",
+				userID: "user1",
+			},
+			res: res{
+				err: zerrors.IsPermissionDenied,
+			},
+		},
 		{
 			name: "lock user, ok",
 			fields: fields{
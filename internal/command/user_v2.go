@@ -117,6 +117,16 @@ func (c *Commands) ReactivateUserV2(ctx context.Context, userID string) (*domain
 	return writeModelToObjectDetails(&existingHuman.WriteModel), nil
 }
 
+func (c *Commands) checkPermissionReadUser(ctx context.Context, resourceOwner, userID string) error {
+	if userID != "" && userID == authz.GetCtxData(ctx).UserID {
+		return nil
+	}
+	if err := c.checkPermission(ctx, domain.PermissionUserRead, resourceOwner, userID); err != nil {
+		return err
+	}
+	return c.checkUserInMemberScope(ctx, resourceOwner, userID)
+}
+
 func (c *Commands) checkPermissionUpdateUser(ctx context.Context, resourceOwner, userID string) error {
 	if userID != "" && userID == authz.GetCtxData(ctx).UserID {
 		return nil
@@ -124,7 +134,17 @@ func (c *Commands) checkPermissionUpdateUser(ctx context.Context, resourceOwner,
 	if err := c.checkPermission(ctx, domain.PermissionUserWrite, resourceOwner, userID); err != nil {
 		return err
 	}
-	return nil
+	return c.checkUserInMemberScope(ctx, resourceOwner, userID)
+}
+
+func (c *Commands) checkPermissionUpdateUserCredentials(ctx context.Context, resourceOwner, userID string) error {
+	if userID != "" && userID == authz.GetCtxData(ctx).UserID {
+		return nil
+	}
+	if err := c.checkPermission(ctx, domain.PermissionUserCredentialWrite, resourceOwner, userID); err != nil {
+		return err
+	}
+	return c.checkUserInMemberScope(ctx, resourceOwner, userID)
 }
 
 func (c *Commands) checkPermissionDeleteUser(ctx context.Context, resourceOwner, userID string) error {
@@ -134,7 +154,7 @@ func (c *Commands) checkPermissionDeleteUser(ctx context.Context, resourceOwner,
 	if err := c.checkPermission(ctx, domain.PermissionUserDelete, resourceOwner, userID); err != nil {
 		return err
 	}
-	return nil
+	return c.checkUserInMemberScope(ctx, resourceOwner, userID)
 }
 
 func (c *Commands) userStateWriteModel(ctx context.Context, userID string) (writeModel *UserV2WriteModel, err error) {
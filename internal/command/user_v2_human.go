@@ -144,6 +144,9 @@ func (c *Commands) AddUserHuman(ctx context.Context, resourceOwner string, human
 		return err
 	}
 
+	if err = c.checkPasskeyRegistrationForced(ctx, resourceOwner, human.Passkey != nil || len(human.Links) > 0); err != nil {
+		return err
+	}
 	if err = c.userValidateDomain(ctx, resourceOwner, human.Username, domainPolicy.UserLoginMustBeDomain); err != nil {
 		return err
 	}
@@ -202,6 +205,11 @@ func (c *Commands) AddUserHuman(ctx context.Context, resourceOwner string, human
 		return err
 	}
 
+	cmds, err = c.addHumanCommandPasskey(ctx, filter, cmds, existingHuman.Aggregate(), human, alg)
+	if err != nil {
+		return err
+	}
+
 	for _, metadataEntry := range human.Metadata {
 		cmds = append(cmds, user.NewMetadataSetEvent(
 			ctx,
@@ -242,6 +250,23 @@ func (c *Commands) AddUserHuman(ctx context.Context, resourceOwner string, human
 	return nil
 }
 
+// checkPasskeyRegistrationForced returns an error if the login policy of the organization forces
+// a passkey registration, but the new human neither registers one nor is linked to an identity provider.
+// It has to be checked by every command creating a human.
+func (c *Commands) checkPasskeyRegistrationForced(ctx context.Context, resourceOwner string, registersPasskeyOrLinks bool) error {
+	if registersPasskeyOrLinks {
+		return nil
+	}
+	loginPolicy, err := c.getOrgLoginPolicy(ctx, resourceOwner)
+	if err != nil {
+		return err
+	}
+	if loginPolicy.ForcePasskeyRegistration {
+		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-Qa2wy", "Errors.User.Passkey.RegistrationRequired")
+	}
+	return nil
+}
+
 func (c *Commands) ChangeUserHuman(ctx context.Context, human *ChangeHuman, alg crypto.EncryptionAlgorithm) (err error) {
 	if err := human.Validate(c.userPasswordHasher); err != nil {
 		return err
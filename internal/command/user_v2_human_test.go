@@ -40,10 +40,11 @@ func TestCommandSide_AddUserHuman(t *testing.T) {
 		codeAlg         crypto.EncryptionAlgorithm
 	}
 	type res struct {
-		want          *domain.ObjectDetails
-		wantID        string
-		wantEmailCode string
-		err           func(error) bool
+		want            *domain.ObjectDetails
+		wantID          string
+		wantEmailCode   string
+		wantPasskeyCode *PasskeyCode
+		err             func(error) bool
 	}
 
 	userAgg := user.NewAggregate("user1", "org1")
@@ -187,6 +188,10 @@ func TestCommandSide_AddUserHuman(t *testing.T) {
 					),
 					expectFilter(),
 					expectFilter(),
+					expectFilter(),
+					expectFilter(),
+					expectFilter(),
+					expectFilter(),
 				),
 				checkPermission: newMockPermissionCheckAllowed(),
 			},
@@ -227,6 +232,9 @@ func TestCommandSide_AddUserHuman(t *testing.T) {
 							),
 						),
 					),
+					expectFilter(),
+					expectFilter(),
+					expectFilter(),
 					expectPush(
 						user.NewHumanRegisteredEvent(context.Background(),
 							&userAgg.Aggregate,
@@ -333,6 +341,9 @@ func TestCommandSide_AddUserHuman(t *testing.T) {
 							),
 						),
 					),
+					expectFilter(),
+					expectFilter(),
+					expectFilter(),
 					expectPush(
 						user.NewHumanAddedEvent(context.Background(),
 							&userAgg.Aggregate,
@@ -403,6 +414,9 @@ func TestCommandSide_AddUserHuman(t *testing.T) {
 							),
 						),
 					),
+					expectFilter(),
+					expectFilter(),
+					expectFilter(),
 					expectFilter(
 						eventFromEventPusher(
 							org.NewPasswordComplexityPolicyAddedEvent(context.Background(),
@@ -474,6 +488,9 @@ func TestCommandSide_AddUserHuman(t *testing.T) {
 							),
 						),
 					),
+					expectFilter(),
+					expectFilter(),
+					expectFilter(),
 					expectFilter(
 						eventFromEventPusher(
 							org.NewPasswordComplexityPolicyAddedEvent(context.Background(),
@@ -548,6 +565,9 @@ func TestCommandSide_AddUserHuman(t *testing.T) {
 							),
 						),
 					),
+					expectFilter(),
+					expectFilter(),
+					expectFilter(),
 					expectFilter(
 						eventFromEventPusher(
 							org.NewPasswordComplexityPolicyAddedEvent(context.Background(),
@@ -623,6 +643,9 @@ func TestCommandSide_AddUserHuman(t *testing.T) {
 							),
 						),
 					),
+					expectFilter(),
+					expectFilter(),
+					expectFilter(),
 					expectFilter(
 						eventFromEventPusher(
 							org.NewPasswordComplexityPolicyAddedEvent(context.Background(),
@@ -687,6 +710,9 @@ func TestCommandSide_AddUserHuman(t *testing.T) {
 							),
 						),
 					),
+					expectFilter(),
+					expectFilter(),
+					expectFilter(),
 					expectFilter(
 						eventFromEventPusher(
 							org.NewPasswordComplexityPolicyAddedEvent(context.Background(),
@@ -751,6 +777,9 @@ func TestCommandSide_AddUserHuman(t *testing.T) {
 							),
 						),
 					),
+					expectFilter(),
+					expectFilter(),
+					expectFilter(),
 					expectFilter(
 						eventFromEventPusher(
 							org.NewPasswordComplexityPolicyAddedEvent(context.Background(),
@@ -815,6 +844,9 @@ func TestCommandSide_AddUserHuman(t *testing.T) {
 							),
 						),
 					),
+					expectFilter(),
+					expectFilter(),
+					expectFilter(),
 					expectFilter(
 						eventFromEventPusher(
 							org.NewDomainVerifiedEvent(context.Background(),
@@ -868,6 +900,9 @@ func TestCommandSide_AddUserHuman(t *testing.T) {
 							),
 						),
 					),
+					expectFilter(),
+					expectFilter(),
+					expectFilter(),
 					expectFilter(
 						eventFromEventPusher(
 							org.NewDomainVerifiedEvent(context.Background(),
@@ -956,6 +991,9 @@ func TestCommandSide_AddUserHuman(t *testing.T) {
 							),
 						),
 					),
+					expectFilter(),
+					expectFilter(),
+					expectFilter(),
 					expectFilter(
 						eventFromEventPusher(
 							org.NewPasswordComplexityPolicyAddedEvent(context.Background(),
@@ -1034,6 +1072,9 @@ func TestCommandSide_AddUserHuman(t *testing.T) {
 							),
 						),
 					),
+					expectFilter(),
+					expectFilter(),
+					expectFilter(),
 					expectPush(
 						newAddHumanEvent("", false, true, "+41711234567", language.English),
 						user.NewHumanInitialCodeAddedEvent(
@@ -1099,6 +1140,9 @@ func TestCommandSide_AddUserHuman(t *testing.T) {
 							),
 						),
 					),
+					expectFilter(),
+					expectFilter(),
+					expectFilter(),
 					expectFilter(
 						eventFromEventPusher(
 							org.NewPasswordComplexityPolicyAddedEvent(context.Background(),
@@ -1178,6 +1222,9 @@ func TestCommandSide_AddUserHuman(t *testing.T) {
 							),
 						),
 					),
+					expectFilter(),
+					expectFilter(),
+					expectFilter(),
 					expectPush(
 						newAddHumanEvent("", false, true, "", language.English),
 						user.NewHumanInitialCodeAddedEvent(
@@ -1415,6 +1462,9 @@ func TestCommandSide_AddUserHuman(t *testing.T) {
 							),
 						),
 					),
+					expectFilter(),
+					expectFilter(),
+					expectFilter(),
 					expectPush(
 						user.NewHumanRegisteredEvent(context.Background(),
 							&userAgg.Aggregate,
@@ -1483,6 +1533,251 @@ func TestCommandSide_AddUserHuman(t *testing.T) {
 				wantID: "user1",
 			},
 		},
+		{
+			name: "add human with passkey and password, invalid argument error",
+			fields: fields{
+				eventstore:      expectEventstore(),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+				human: &AddHuman{
+					Username:  "username",
+					Password:  "password",
+					FirstName: "firstname",
+					LastName:  "lastname",
+					Email: Email{
+						Address: "email@test.ch",
+					},
+					PreferredLanguage: language.English,
+					Passkey:           &AddHumanPasskey{},
+				},
+				allowInitMail: true,
+			},
+			res: res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowInvalidArgument(nil, "USER-Pk3sx", "Errors.User.Passkey.PasswordNotAllowed"))
+				},
+			},
+		},
+		{
+			name: "add human without passkey, passkey registration forced, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewDomainPolicyAddedEvent(context.Background(),
+								&userAgg.Aggregate,
+								true,
+								true,
+								true,
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewLoginPolicyAddedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								true,
+								true,
+								false,
+								false,
+								false,
+								false,
+								false,
+								false,
+								false,
+								false,
+								true,
+								domain.PasswordlessTypeAllowed,
+								"",
+								time.Hour*1,
+								time.Hour*2,
+								time.Hour*3,
+								time.Hour*4,
+								time.Hour*5,
+							),
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+				idGenerator:     id_mock.NewIDGeneratorExpectIDs(t, "user1"),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+				human: &AddHuman{
+					Username:  "username",
+					Password:  "password",
+					FirstName: "firstname",
+					LastName:  "lastname",
+					Email: Email{
+						Address:  "email@test.ch",
+						Verified: true,
+					},
+					PreferredLanguage: language.English,
+				},
+				allowInitMail: true,
+			},
+			res: res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Qa2wy", "Errors.User.Passkey.RegistrationRequired"))
+				},
+			},
+		},
+		{
+			name: "add human with passkey (return code), ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewDomainPolicyAddedEvent(context.Background(),
+								&userAgg.Aggregate,
+								true,
+								true,
+								true,
+							),
+						),
+					),
+					expectPush(
+						newAddHumanEvent("", false, true, "", language.English),
+						user.NewHumanEmailVerifiedEvent(context.Background(),
+							&userAgg.Aggregate),
+						user.NewHumanPasswordlessInitCodeRequestedEvent(context.Background(),
+							&userAgg.Aggregate,
+							"code1",
+							&crypto.CryptoValue{
+								CryptoType: crypto.TypeEncryption,
+								Algorithm:  "enc",
+								KeyID:      "id",
+								Crypted:    []byte("passkeyCode"),
+							},
+							time.Hour,
+							"",
+							true,
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+				idGenerator:     id_mock.NewIDGeneratorExpectIDs(t, "user1", "code1"),
+				newCode:         mockEncryptedCode("passkeyCode", time.Hour),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+				human: &AddHuman{
+					Username:  "username",
+					FirstName: "firstname",
+					LastName:  "lastname",
+					Email: Email{
+						Address:  "email@test.ch",
+						Verified: true,
+					},
+					PreferredLanguage: language.English,
+					Passkey: &AddHumanPasskey{
+						ReturnCode: true,
+					},
+				},
+				allowInitMail: true,
+				codeAlg:       crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+				wantID: "user1",
+				wantPasskeyCode: &PasskeyCode{
+					ID:   "code1",
+					Code: "passkeyCode",
+				},
+			},
+		},
+		{
+			name: "add human with passkey and phone only, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewDomainPolicyAddedEvent(context.Background(),
+								&userAgg.Aggregate,
+								true,
+								true,
+								true,
+							),
+						),
+					),
+					expectPush(
+						func() eventstore.Command {
+							event := user.NewHumanAddedEvent(context.Background(),
+								&userAgg.Aggregate,
+								"username",
+								"firstname",
+								"lastname",
+								"",
+								"firstname lastname",
+								language.English,
+								domain.GenderUnspecified,
+								"",
+								true,
+							)
+							event.AddPhoneData("+41711234567")
+							return event
+						}(),
+						user.NewHumanPhoneVerifiedEvent(context.Background(),
+							&userAgg.Aggregate),
+						user.NewHumanPasswordlessInitCodeRequestedEvent(context.Background(),
+							&userAgg.Aggregate,
+							"code1",
+							&crypto.CryptoValue{
+								CryptoType: crypto.TypeEncryption,
+								Algorithm:  "enc",
+								KeyID:      "id",
+								Crypted:    []byte("passkeyCode"),
+							},
+							time.Hour,
+							"",
+							true,
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+				idGenerator:     id_mock.NewIDGeneratorExpectIDs(t, "user1", "code1"),
+				newCode:         mockEncryptedCode("passkeyCode", time.Hour),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+				human: &AddHuman{
+					Username:  "username",
+					FirstName: "firstname",
+					LastName:  "lastname",
+					Phone: Phone{
+						Number:   "+41711234567",
+						Verified: true,
+					},
+					PreferredLanguage: language.English,
+					Passkey: &AddHumanPasskey{
+						ReturnCode: true,
+					},
+				},
+				allowInitMail: true,
+				codeAlg:       crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+				wantID: "user1",
+				wantPasskeyCode: &PasskeyCode{
+					ID:   "code1",
+					Code: "passkeyCode",
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1512,6 +1807,7 @@ func TestCommandSide_AddUserHuman(t *testing.T) {
 				assert.Equal(t, tt.res.want, tt.args.human.Details)
 				assert.Equal(t, tt.res.wantID, tt.args.human.ID)
 				assert.Equal(t, tt.res.wantEmailCode, gu.Value(tt.args.human.EmailCode))
+				assert.Equal(t, tt.res.wantPasskeyCode, tt.args.human.PasskeyCode)
 			}
 		})
 	}
@@ -0,0 +1,100 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// PushDevice is a mobile app device which approves push challenges of a user.
+// ID will be set when the device is added.
+type PushDevice struct {
+	ID   string
+	Name string
+
+	Platform domain.PushPlatform
+	// PublicKey is the PKIX, ASN.1 DER encoded public key used to verify the approvals of the device.
+	PublicKey []byte
+	// PushToken is the registration token of the app at the push service of the platform.
+	PushToken string
+}
+
+func (d *PushDevice) Validate() error {
+	if !d.Platform.Valid() {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Pu1pl", "Errors.User.MFA.Push.PlatformInvalid")
+	}
+	if d.PushToken == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Pu2tk", "Errors.User.MFA.Push.TokenMissing")
+	}
+	_, err := domain.ParsePushDevicePublicKey(d.PublicKey)
+	return err
+}
+
+// AddUserPushDevice registers a device of the user for push approvals.
+func (c *Commands) AddUserPushDevice(ctx context.Context, userID, resourceOwner string, device *PushDevice) (*domain.ObjectDetails, error) {
+	if userID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Pu3id", "Errors.User.UserIDMissing")
+	}
+	if err := device.Validate(); err != nil {
+		return nil, err
+	}
+	writeModel, err := c.pushDevicesWriteModelByID(ctx, userID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if writeModel.UserState != domain.UserStateActive {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Pu4nf", "Errors.User.NotFound")
+	}
+	if err := c.checkPermissionUpdateUserCredentials(ctx, writeModel.ResourceOwner, userID); err != nil {
+		return nil, err
+	}
+	device.ID, err = c.idGenerator.Next()
+	if err != nil {
+		return nil, err
+	}
+	if err = c.pushAppendAndReduce(ctx, writeModel,
+		user.NewHumanPushDeviceAddedEvent(ctx, UserAggregateFromWriteModel(&writeModel.WriteModel),
+			device.ID, device.Name, device.Platform, device.PublicKey, device.PushToken),
+	); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// RemoveUserPushDevice removes a registered push device of the user.
+func (c *Commands) RemoveUserPushDevice(ctx context.Context, userID, resourceOwner, deviceID string) (*domain.ObjectDetails, error) {
+	if userID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Pu5id", "Errors.User.UserIDMissing")
+	}
+	writeModel, err := c.pushDevicesWriteModelByID(ctx, userID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.checkPermissionUpdateUser(ctx, writeModel.ResourceOwner, userID); err != nil {
+		return nil, err
+	}
+	if writeModel.Device(deviceID) == nil {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Pu6nf", "Errors.User.MFA.Push.NotExisting")
+	}
+	if err = c.pushAppendAndReduce(ctx, writeModel,
+		user.NewHumanPushDeviceRemovedEvent(ctx, UserAggregateFromWriteModel(&writeModel.WriteModel), deviceID),
+	); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+func (c *Commands) pushDevicesWriteModelByID(ctx context.Context, userID, resourceOwner string) (writeModel *HumanPushDevicesWriteModel, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel = NewHumanPushDevicesWriteModel(userID, resourceOwner)
+	err = c.eventstore.FilterToQueryReducer(ctx, writeModel)
+	if err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
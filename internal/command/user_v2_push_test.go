@@ -0,0 +1,303 @@
+package command
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/id"
+	"github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommands_AddUserPushDevice(t *testing.T) {
+	ctx := authz.NewMockContext("inst1", "org1", "user1")
+	userAgg := &user.NewAggregate("user1", "org1").Aggregate
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	publicKey, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+
+	humanAddedEvent := func() eventstore.Event {
+		return eventFromEventPusher(
+			user.NewHumanAddedEvent(ctx,
+				userAgg,
+				"username",
+				"firstname",
+				"lastname",
+				"nickname",
+				"displayname",
+				language.German,
+				domain.GenderUnspecified,
+				"email@test.ch",
+				true,
+			),
+		)
+	}
+
+	type fields struct {
+		eventstore      func(t *testing.T) *eventstore.Eventstore
+		idGenerator     id.Generator
+		checkPermission domain.PermissionCheck
+	}
+	type args struct {
+		userID string
+		device *PushDevice
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantID  string
+		wantErr error
+	}{
+		{
+			name: "missing user id",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				device: &PushDevice{},
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "COMMAND-Pu3id", "Errors.User.UserIDMissing"),
+		},
+		{
+			name: "invalid platform",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				userID: "user1",
+				device: &PushDevice{
+					PublicKey: publicKey,
+					PushToken: "pushToken",
+				},
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "COMMAND-Pu1pl", "Errors.User.MFA.Push.PlatformInvalid"),
+		},
+		{
+			name: "missing push token",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				userID: "user1",
+				device: &PushDevice{
+					Platform:  domain.PushPlatformFCM,
+					PublicKey: publicKey,
+				},
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "COMMAND-Pu2tk", "Errors.User.MFA.Push.TokenMissing"),
+		},
+		{
+			name: "invalid public key",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				userID: "user1",
+				device: &PushDevice{
+					Platform:  domain.PushPlatformFCM,
+					PublicKey: []byte("publicKey"),
+					PushToken: "pushToken",
+				},
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "DOMAIN-Pu1ky", "Errors.User.MFA.Push.InvalidPublicKey"),
+		},
+		{
+			name: "user not found",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				userID: "user1",
+				device: &PushDevice{
+					Platform:  domain.PushPlatformFCM,
+					PublicKey: publicKey,
+					PushToken: "pushToken",
+				},
+			},
+			wantErr: zerrors.ThrowNotFound(nil, "COMMAND-Pu4nf", "Errors.User.NotFound"),
+		},
+		{
+			name: "other user, no permission",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanAddedEvent(ctx,
+								&user.NewAggregate("user2", "org1").Aggregate,
+								"username",
+								"firstname",
+								"lastname",
+								"nickname",
+								"displayname",
+								language.German,
+								domain.GenderUnspecified,
+								"email@test.ch",
+								true,
+							),
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			},
+			args: args{
+				userID: "user2",
+				device: &PushDevice{
+					Platform:  domain.PushPlatformFCM,
+					PublicKey: publicKey,
+					PushToken: "pushToken",
+				},
+			},
+			wantErr: zerrors.ThrowPermissionDenied(nil, "AUTHZ-HKJD33", "Errors.PermissionDenied"),
+		},
+		{
+			name: "added",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(humanAddedEvent()),
+					expectPush(
+						user.NewHumanPushDeviceAddedEvent(ctx, userAgg,
+							"device1", "phone", domain.PushPlatformAPNs, publicKey, "pushToken"),
+					),
+				),
+				idGenerator: mock.ExpectID(t, "device1"),
+			},
+			args: args{
+				userID: "user1",
+				device: &PushDevice{
+					Name:      "phone",
+					Platform:  domain.PushPlatformAPNs,
+					PublicKey: publicKey,
+					PushToken: "pushToken",
+				},
+			},
+			wantID: "device1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.fields.eventstore(t),
+				idGenerator:     tt.fields.idGenerator,
+				checkPermission: tt.fields.checkPermission,
+			}
+			got, err := c.AddUserPushDevice(ctx, tt.args.userID, "", tt.args.device)
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr == nil {
+				assert.Equal(t, "org1", got.ResourceOwner)
+				assert.Equal(t, tt.wantID, tt.args.device.ID)
+			}
+		})
+	}
+}
+
+func TestCommands_RemoveUserPushDevice(t *testing.T) {
+	ctx := authz.NewMockContext("inst1", "org1", "user1")
+	userAgg := &user.NewAggregate("user1", "org1").Aggregate
+
+	type fields struct {
+		eventstore      func(t *testing.T) *eventstore.Eventstore
+		checkPermission domain.PermissionCheck
+	}
+	type args struct {
+		userID   string
+		deviceID string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr error
+	}{
+		{
+			name: "missing user id",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				deviceID: "device1",
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "COMMAND-Pu5id", "Errors.User.UserIDMissing"),
+		},
+		{
+			name: "other user, no permission",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			},
+			args: args{
+				userID:   "user2",
+				deviceID: "device1",
+			},
+			wantErr: zerrors.ThrowPermissionDenied(nil, "AUTHZ-HKJD33", "Errors.PermissionDenied"),
+		},
+		{
+			name: "device not found",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanPushDeviceAddedEvent(ctx, userAgg,
+								"device2", "phone", domain.PushPlatformFCM, []byte("publicKey"), "pushToken"),
+						),
+					),
+				),
+			},
+			args: args{
+				userID:   "user1",
+				deviceID: "device1",
+			},
+			wantErr: zerrors.ThrowNotFound(nil, "COMMAND-Pu6nf", "Errors.User.MFA.Push.NotExisting"),
+		},
+		{
+			name: "removed",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanPushDeviceAddedEvent(ctx, userAgg,
+								"device1", "phone", domain.PushPlatformFCM, []byte("publicKey"), "pushToken"),
+						),
+					),
+					expectPush(
+						user.NewHumanPushDeviceRemovedEvent(ctx, userAgg, "device1"),
+					),
+				),
+			},
+			args: args{
+				userID:   "user1",
+				deviceID: "device1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.fields.eventstore(t),
+				checkPermission: tt.fields.checkPermission,
+			}
+			got, err := c.RemoveUserPushDevice(ctx, tt.args.userID, "", tt.args.deviceID)
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr == nil {
+				assert.Equal(t, "org1", got.ResourceOwner)
+			}
+		})
+	}
+}
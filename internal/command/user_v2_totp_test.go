@@ -206,6 +206,7 @@ func TestCommands_AddUserTOTP(t *testing.T) {
 							),
 						),
 					),
+					expectFilter(),
 					expectFilter(
 						eventFromEventPusher(
 							org.NewOrgAddedEvent(ctx,
@@ -314,6 +315,7 @@ func TestCommands_CheckUserTOTP(t *testing.T) {
 							user.NewHumanOTPAddedEvent(ctx, &user.NewAggregate("foo", "org1").Aggregate, secret),
 						),
 					),
+					expectFilter(),
 					expectPush(
 						user.NewHumanOTPVerifiedEvent(ctx, &user.NewAggregate("foo", "org1").Aggregate, ""),
 					),
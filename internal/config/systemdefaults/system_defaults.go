@@ -22,13 +22,45 @@ type SecretGenerators struct {
 }
 
 type MultifactorConfig struct {
-	OTP OTPConfig
+	OTP           OTPConfig
+	OTPRateLimits OTPRateLimits
 }
 
 type OTPConfig struct {
 	Issuer string
 }
 
+// OTPRateLimits limit the one-time passwords ZITADEL sends by SMS and email.
+type OTPRateLimits struct {
+	SMS   OTPRateLimit
+	Email OTPRateLimit
+}
+
+// OTPRateLimit limits the deliveries of one-time passwords.
+// Limits set to 0 are disabled.
+type OTPRateLimit struct {
+	// Window is the duration the deliveries are counted in.
+	Window time.Duration
+	// Cooldown is the minimal duration between two deliveries to the same user.
+	Cooldown time.Duration
+	// PerUser is the maximum of deliveries to a user in the window.
+	PerUser uint64
+	// PerPhonePrefix is the maximum of deliveries to phone numbers starting with the same digits in the window.
+	PerPhonePrefix uint64
+	// PhonePrefixLength is the number of digits (including the country code) the phone numbers are grouped by.
+	PhonePrefixLength int
+	// PerIP is the maximum of deliveries requested from an IP address in the window.
+	PerIP uint64
+	// PerInstance is the maximum of deliveries of an instance in the window.
+	PerInstance uint64
+}
+
+// Enabled returns true if any of the limits is set.
+func (l OTPRateLimit) Enabled() bool {
+	return l.Cooldown > 0 ||
+		(l.Window > 0 && (l.PerUser > 0 || l.PerPhonePrefix > 0 || l.PerIP > 0 || l.PerInstance > 0))
+}
+
 type DomainVerification struct {
 	VerificationGenerator crypto.GeneratorConfig
 }
@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"time"
+
+	es_models "github.com/zitadel/zitadel/internal/eventstore/v1/models"
+)
+
+// AccessRequest is the request of a user for an elevated membership for a limited duration,
+// which has to be approved by another user.
+type AccessRequest struct {
+	es_models.ObjectRoot
+
+	UserID   string
+	Scope    AccessRequestScope
+	Roles    []string
+	Duration time.Duration
+	Reason   string
+}
+
+func (r *AccessRequest) IsValid() bool {
+	return r.UserID != "" && r.Scope.Valid() && len(r.Roles) > 0 && r.Duration > 0
+}
+
+// AccessRequestScope defines the membership the access is requested for.
+type AccessRequestScope int32
+
+const (
+	AccessRequestScopeUnspecified AccessRequestScope = iota
+	AccessRequestScopeInstance
+	AccessRequestScopeOrg
+
+	accessRequestScopeCount
+)
+
+func (s AccessRequestScope) Valid() bool {
+	return s > AccessRequestScopeUnspecified && s < accessRequestScopeCount
+}
+
+type AccessRequestState int32
+
+const (
+	AccessRequestStateUnspecified AccessRequestState = iota
+	AccessRequestStatePending
+	AccessRequestStateApproved
+	AccessRequestStateDenied
+)
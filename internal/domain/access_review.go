@@ -0,0 +1,140 @@
+package domain
+
+import (
+	"time"
+
+	es_models "github.com/zitadel/zitadel/internal/eventstore/v1/models"
+)
+
+// AccessReview is a campaign to recertify the user grants and memberships of a scope.
+// The reviewers of the items approve or revoke them until the deadline.
+type AccessReview struct {
+	es_models.ObjectRoot
+
+	Name string
+	// Scope defines which user grants and memberships are reviewed
+	Scope AccessReviewScope
+	// ProjectID is required for the project and role scope
+	ProjectID string
+	// RoleKey is required for the role scope
+	RoleKey      string
+	ReviewerType AccessReviewerType
+	// ManagerMetadataKey is the key of the user metadata containing the id of the manager of the user,
+	// it is used if the manager reviews the items
+	ManagerMetadataKey string
+	Deadline           time.Time
+	DeadlineAction     AccessReviewDeadlineAction
+}
+
+func (r *AccessReview) IsValid() bool {
+	if r.Name == "" || !r.Scope.Valid() || !r.ReviewerType.Valid() || !r.DeadlineAction.Valid() || r.Deadline.IsZero() {
+		return false
+	}
+	if r.Scope != AccessReviewScopeOrg && r.ProjectID == "" {
+		return false
+	}
+	if r.Scope == AccessReviewScopeRole && r.RoleKey == "" {
+		return false
+	}
+	return r.ReviewerType != AccessReviewerTypeManager || r.ManagerMetadataKey != ""
+}
+
+// AccessReviewItem is a user grant or membership which has to be recertified by one of the reviewers.
+type AccessReviewItem struct {
+	ID   string
+	Type AccessReviewItemType
+	// ObjectID is the id of the user grant, the organization or the project of the membership
+	ObjectID      string
+	ResourceOwner string
+	UserID        string
+	ProjectID     string
+	Roles         []string
+	Reviewers     []string
+}
+
+type AccessReviewScope int32
+
+const (
+	AccessReviewScopeUnspecified AccessReviewScope = iota
+	AccessReviewScopeOrg
+	AccessReviewScopeProject
+	AccessReviewScopeRole
+
+	accessReviewScopeCount
+)
+
+func (s AccessReviewScope) Valid() bool {
+	return s > AccessReviewScopeUnspecified && s < accessReviewScopeCount
+}
+
+type AccessReviewerType int32
+
+const (
+	AccessReviewerTypeUnspecified AccessReviewerType = iota
+	// AccessReviewerTypeProjectOwner lets the owners of the project review the items,
+	// the owners of the organization review the organization memberships
+	AccessReviewerTypeProjectOwner
+	// AccessReviewerTypeManager lets the manager of the user review the items
+	AccessReviewerTypeManager
+
+	accessReviewerTypeCount
+)
+
+func (t AccessReviewerType) Valid() bool {
+	return t > AccessReviewerTypeUnspecified && t < accessReviewerTypeCount
+}
+
+// AccessReviewDeadlineAction defines what happens with the items which are not decided at the deadline.
+type AccessReviewDeadlineAction int32
+
+const (
+	AccessReviewDeadlineActionUnspecified AccessReviewDeadlineAction = iota
+	AccessReviewDeadlineActionRevoke
+	AccessReviewDeadlineActionFlag
+
+	accessReviewDeadlineActionCount
+)
+
+func (a AccessReviewDeadlineAction) Valid() bool {
+	return a > AccessReviewDeadlineActionUnspecified && a < accessReviewDeadlineActionCount
+}
+
+type AccessReviewState int32
+
+const (
+	AccessReviewStateUnspecified AccessReviewState = iota
+	AccessReviewStateActive
+	AccessReviewStateCompleted
+	AccessReviewStateCancelled
+)
+
+type AccessReviewItemType int32
+
+const (
+	AccessReviewItemTypeUnspecified AccessReviewItemType = iota
+	AccessReviewItemTypeUserGrant
+	AccessReviewItemTypeOrgMember
+	AccessReviewItemTypeProjectMember
+)
+
+type AccessReviewDecision int32
+
+const (
+	AccessReviewDecisionUnspecified AccessReviewDecision = iota
+	AccessReviewDecisionPending
+	AccessReviewDecisionApproved
+	AccessReviewDecisionRevoked
+	// AccessReviewDecisionFlagged is set at the deadline for undecided items, if the campaign flags them
+	AccessReviewDecisionFlagged
+	// AccessReviewDecisionExpired is set at the deadline for undecided items, if the campaign revokes them
+	AccessReviewDecisionExpired
+)
+
+func (d AccessReviewDecision) IsReviewerDecision() bool {
+	return d == AccessReviewDecisionApproved || d == AccessReviewDecisionRevoked
+}
+
+// IsRevocation returns true if the user grant or membership of the item is removed
+func (d AccessReviewDecision) IsRevocation() bool {
+	return d == AccessReviewDecisionRevoked || d == AccessReviewDecisionExpired
+}
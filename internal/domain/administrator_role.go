@@ -0,0 +1,72 @@
+package domain
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// administratorRoleKeyRegex requires the key to start with the prefix of the level (e.g. ORG_)
+// the members the role can be assigned to are on.
+var administratorRoleKeyRegex = regexp.MustCompile(`^(IAM|ORG|PROJECT|PROJECT_GRANT)_[A-Z0-9_]+$`)
+
+// AdministratorRole is a custom role of an instance, which grants its permissions to the members it's assigned to.
+// Custom roles are merged with the roles of the InternalAuthZ config.
+type AdministratorRole struct {
+	Key         string
+	DisplayName string
+	Permissions []string
+}
+
+// administratorRoleLevels are the prefixes of the levels a role can be assigned on.
+// PROJECT_GRANT_ must be checked before PROJECT_ as it shares its prefix.
+var administratorRoleLevels = []string{"IAM_", "ORG_", "PROJECT_GRANT_", "PROJECT_"}
+
+// Validate checks the key and that only permissions are granted,
+// which are granted by one of the configured roles of the same level (e.g. ORG_).
+// This prevents e.g. an ORG_ role from granting instance permissions.
+func (r *AdministratorRole) Validate(configRoles []authz.RoleMapping) error {
+	if r == nil || len(r.Key) > 200 || !administratorRoleKeyRegex.MatchString(r.Key) {
+		return zerrors.ThrowInvalidArgument(nil, "DOMAIN-Ar1ky", "Errors.AdministratorRole.KeyInvalid")
+	}
+	for _, role := range configRoles {
+		if role.Role == r.Key {
+			return zerrors.ThrowAlreadyExists(nil, "DOMAIN-Ar2cf", "Errors.AdministratorRole.AlreadyExists")
+		}
+	}
+	if len(r.Permissions) == 0 {
+		return zerrors.ThrowInvalidArgument(nil, "DOMAIN-Ar3pm", "Errors.AdministratorRole.PermissionsMissing")
+	}
+	level := administratorRoleLevel(r.Key)
+	for _, permission := range r.Permissions {
+		if !permissionExists(permission, level, configRoles) {
+			return zerrors.ThrowInvalidArgument(nil, "DOMAIN-Ar4pi", "Errors.AdministratorRole.PermissionInvalid")
+		}
+	}
+	return nil
+}
+
+func administratorRoleLevel(key string) string {
+	for _, level := range administratorRoleLevels {
+		if strings.HasPrefix(key, level) {
+			return level
+		}
+	}
+	return ""
+}
+
+func permissionExists(permission, level string, roles []authz.RoleMapping) bool {
+	for _, role := range roles {
+		if administratorRoleLevel(role.Role) != level {
+			continue
+		}
+		for _, p := range role.Permissions {
+			if p == permission {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,102 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestAdministratorRole_Validate(t *testing.T) {
+	configRoles := []authz.RoleMapping{
+		{
+			Role:        "ORG_OWNER",
+			Permissions: []string{"org.read", "user.read", "user.credential.write"},
+		},
+		{
+			Role:        "IAM_OWNER",
+			Permissions: []string{"iam.read", "iam.write", "org.read"},
+		},
+		{
+			Role:        "PROJECT_OWNER",
+			Permissions: []string{"project.read", "project.grant.write"},
+		},
+		{
+			Role:        "PROJECT_GRANT_OWNER",
+			Permissions: []string{"project.read", "org.read"},
+		},
+	}
+	tests := []struct {
+		name string
+		role *AdministratorRole
+		err  func(error) bool
+	}{
+		{
+			"nil, invalid argument",
+			nil,
+			zerrors.IsErrorInvalidArgument,
+		},
+		{
+			"key without level prefix, invalid argument",
+			&AdministratorRole{Key: "USER_SUPPORT", Permissions: []string{"user.read"}},
+			zerrors.IsErrorInvalidArgument,
+		},
+		{
+			"lowercase key, invalid argument",
+			&AdministratorRole{Key: "ORG_user_support", Permissions: []string{"user.read"}},
+			zerrors.IsErrorInvalidArgument,
+		},
+		{
+			"key of config role, already exists",
+			&AdministratorRole{Key: "ORG_OWNER", Permissions: []string{"user.read"}},
+			zerrors.IsErrorAlreadyExists,
+		},
+		{
+			"no permissions, invalid argument",
+			&AdministratorRole{Key: "ORG_USER_SUPPORT"},
+			zerrors.IsErrorInvalidArgument,
+		},
+		{
+			"unknown permission, invalid argument",
+			&AdministratorRole{Key: "ORG_USER_SUPPORT", Permissions: []string{"user.read", "iam.write"}},
+			zerrors.IsErrorInvalidArgument,
+		},
+		{
+			"instance permission in org role, invalid argument",
+			&AdministratorRole{Key: "ORG_USER_SUPPORT", Permissions: []string{"iam.*"}},
+			zerrors.IsErrorInvalidArgument,
+		},
+		{
+			"instance permission of config role in org role, invalid argument",
+			&AdministratorRole{Key: "ORG_USER_SUPPORT", Permissions: []string{"user.read", "iam.write"}},
+			zerrors.IsErrorInvalidArgument,
+		},
+		{
+			"project permission in project grant role, invalid argument",
+			&AdministratorRole{Key: "PROJECT_GRANT_VIEWER", Permissions: []string{"project.grant.write"}},
+			zerrors.IsErrorInvalidArgument,
+		},
+		{
+			"valid",
+			&AdministratorRole{Key: "ORG_USER_SUPPORT", Permissions: []string{"user.read", "user.credential.write"}},
+			nil,
+		},
+		{
+			"project grant level, valid",
+			&AdministratorRole{Key: "PROJECT_GRANT_VIEWER", Permissions: []string{"org.read"}},
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.role.Validate(configRoles)
+			if tt.err == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.True(t, tt.err(err), "got wrong err: %v", err)
+		})
+	}
+}
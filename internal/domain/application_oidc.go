@@ -45,6 +45,7 @@ type OIDCApp struct {
 	ClockSkew                time.Duration
 	AdditionalOrigins        []string
 	SkipNativeAppSuccessPage bool
+	RequiredLevelOfAssurance LevelOfAssurance
 
 	State AppState
 }
@@ -133,7 +134,7 @@ const (
 )
 
 func (a *OIDCApp) IsValid() bool {
-	if a.ClockSkew > time.Second*5 || a.ClockSkew < time.Second*0 || !a.OriginsValid() {
+	if a.ClockSkew > time.Second*5 || a.ClockSkew < time.Second*0 || !a.OriginsValid() || !a.RequiredLevelOfAssurance.Valid() {
 		return false
 	}
 	grantTypes := a.getRequiredGrantTypes()
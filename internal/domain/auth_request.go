@@ -26,8 +26,10 @@ type AuthRequest struct {
 	MaxAuthAge    *time.Duration
 	InstanceID    string
 	Request       Request
+	// RequiredLevelOfAssurance is the minimal strength of the authentication
+	// requested by the client (acr_values) or required by the application
+	RequiredLevelOfAssurance LevelOfAssurance
 
-	levelOfAssurance         LevelOfAssurance
 	UserID                   string
 	UserName                 string
 	LoginName                string
@@ -57,9 +59,12 @@ type AuthRequest struct {
 	PrivacyPolicy            *PrivacyPolicy
 	LockoutPolicy            *LockoutPolicy
 	PasswordAgePolicy        *PasswordAgePolicy
-	DefaultTranslations      []*CustomText
-	OrgTranslations          []*CustomText
-	SAMLRequestID            string
+	// BreakGlassPolicy is only set if the user is a break-glass account,
+	// which must authenticate with a hardware key regardless of the login policy
+	BreakGlassPolicy    *BreakGlassPolicy
+	DefaultTranslations []*CustomText
+	OrgTranslations     []*CustomText
+	SAMLRequestID       string
 	// orgID the policies were last loaded with
 	policyOrgID string
 }
@@ -122,12 +127,40 @@ func IsPrompt(prompt []Prompt, requestedPrompt Prompt) bool {
 	return false
 }
 
+// LevelOfAssurance is the strength of an authentication,
+// a higher level satisfies all lower levels.
 type LevelOfAssurance int
 
 const (
 	LevelOfAssuranceNone LevelOfAssurance = iota
+	// LevelOfAssurancePassword is satisfied by any factor, e.g. a password or an identity provider
+	LevelOfAssurancePassword
+	// LevelOfAssuranceMFA requires multiple factors or a passkey
+	LevelOfAssuranceMFA
+	// LevelOfAssurancePhishingResistant requires a hardware bound key (U2F or passkey)
+	LevelOfAssurancePhishingResistant
 )
 
+func (l LevelOfAssurance) Valid() bool {
+	return l >= LevelOfAssuranceNone && l <= LevelOfAssurancePhishingResistant
+}
+
+// LevelOfAssuranceFromAuthMethods returns the level of assurance achieved by the authentication methods.
+func LevelOfAssuranceFromAuthMethods(methods []UserAuthMethodType) LevelOfAssurance {
+	switch {
+	case slices.Contains(methods, UserAuthMethodTypePasswordless),
+		slices.Contains(methods, UserAuthMethodTypeU2F) && HasMFA(methods):
+		return LevelOfAssurancePhishingResistant
+	case HasMFA(methods):
+		return LevelOfAssuranceMFA
+	case slices.ContainsFunc(methods, func(method UserAuthMethodType) bool {
+		return method != UserAuthMethodTypeUnspecified
+	}):
+		return LevelOfAssurancePassword
+	}
+	return LevelOfAssuranceNone
+}
+
 type MFAType int
 
 const (
@@ -208,9 +241,31 @@ func (a *AuthRequest) SetOrgInformation(id, name, primaryDomain string, requeste
 	a.RequestedOrgDomain = requestedByDomain
 }
 
+// MFALevel returns the level of multi-factor authentication required by the level of assurance,
+// -1 if the login policy decides.
+// Break-glass accounts always require a hardware key.
 func (a *AuthRequest) MFALevel() MFALevel {
+	if a.BreakGlassPolicy != nil {
+		return MFALevelMultiFactorCertified
+	}
+	switch a.RequiredLevelOfAssurance {
+	case LevelOfAssuranceMFA:
+		return MFALevelSecondFactor
+	case LevelOfAssurancePhishingResistant:
+		return MFALevelMultiFactorCertified
+	case LevelOfAssuranceNone,
+		LevelOfAssurancePassword:
+	}
 	return -1
-	//PLANNED: check a.PossibleLOAs (and Prompt Login?)
+}
+
+// CheckLifetime returns the lifetime of an authentication check,
+// which is limited by the session lifetime for break-glass accounts.
+func (a *AuthRequest) CheckLifetime(lifetime time.Duration) time.Duration {
+	if a.BreakGlassPolicy == nil || lifetime <= a.BreakGlassPolicy.SessionLifetime {
+		return lifetime
+	}
+	return a.BreakGlassPolicy.SessionLifetime
 }
 
 func (a *AuthRequest) AppendAudIfNotExisting(aud string) {
@@ -106,3 +106,47 @@ func TestAuthRequest_UserAuthMethodTypes(t *testing.T) {
 		})
 	}
 }
+
+func TestLevelOfAssuranceFromAuthMethods(t *testing.T) {
+	tests := []struct {
+		name    string
+		methods []UserAuthMethodType
+		want    LevelOfAssurance
+	}{
+		{
+			name:    "no methods",
+			methods: nil,
+			want:    LevelOfAssuranceNone,
+		},
+		{
+			name:    "password",
+			methods: []UserAuthMethodType{UserAuthMethodTypePassword},
+			want:    LevelOfAssurancePassword,
+		},
+		{
+			name:    "idp",
+			methods: []UserAuthMethodType{UserAuthMethodTypeIDP},
+			want:    LevelOfAssurancePassword,
+		},
+		{
+			name:    "password and totp",
+			methods: []UserAuthMethodType{UserAuthMethodTypePassword, UserAuthMethodTypeTOTP},
+			want:    LevelOfAssuranceMFA,
+		},
+		{
+			name:    "password and u2f",
+			methods: []UserAuthMethodType{UserAuthMethodTypePassword, UserAuthMethodTypeU2F},
+			want:    LevelOfAssurancePhishingResistant,
+		},
+		{
+			name:    "passkey",
+			methods: []UserAuthMethodType{UserAuthMethodTypePasswordless},
+			want:    LevelOfAssurancePhishingResistant,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, LevelOfAssuranceFromAuthMethods(tt.methods))
+		})
+	}
+}
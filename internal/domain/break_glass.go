@@ -0,0 +1,67 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	// BreakGlassSessionLifetimeDefault is used if the instance did not define a break-glass policy.
+	BreakGlassSessionLifetimeDefault = 15 * time.Minute
+	// BreakGlassSessionLifetimeMax is the longest lifetime allowed for sessions of break-glass accounts.
+	BreakGlassSessionLifetimeMax = 8 * time.Hour
+)
+
+// BreakGlassPolicy defines how the emergency access (break-glass) accounts of an instance are monitored.
+// Every login of such an account notifies the recipients and calls the Actions targets.
+type BreakGlassPolicy struct {
+	// RecipientUserIDs are the users notified about every login of a break-glass account.
+	RecipientUserIDs []string
+	// TargetIDs are the Actions targets called on every login of a break-glass account.
+	TargetIDs []string
+	// SessionLifetime limits the lifetime of the sessions and authentication checks of break-glass accounts.
+	SessionLifetime time.Duration
+}
+
+func DefaultBreakGlassPolicy() *BreakGlassPolicy {
+	return &BreakGlassPolicy{
+		SessionLifetime: BreakGlassSessionLifetimeDefault,
+	}
+}
+
+func (p *BreakGlassPolicy) IsValid() error {
+	if p.SessionLifetime <= 0 || p.SessionLifetime > BreakGlassSessionLifetimeMax {
+		return zerrors.ThrowInvalidArgument(nil, "DOMAIN-Bg1lt", "Errors.Policy.BreakGlass.SessionLifetimeInvalid")
+	}
+	for _, id := range append(p.RecipientUserIDs, p.TargetIDs...) {
+		if id == "" {
+			return zerrors.ThrowInvalidArgument(nil, "DOMAIN-Bg2id", "Errors.IDMissing")
+		}
+	}
+	return nil
+}
+
+// Lifetime returns the lifetime capped to the session lifetime of the policy.
+// A lifetime of 0 (unlimited) returns the session lifetime.
+func (p *BreakGlassPolicy) Lifetime(lifetime time.Duration) time.Duration {
+	if lifetime == 0 || lifetime > p.SessionLifetime {
+		return p.SessionLifetime
+	}
+	return lifetime
+}
+
+// LoginPolicy returns a copy of the login policy which allows break-glass accounts
+// to log in with username and password or passkey, but always requires a security key.
+func (p *BreakGlassPolicy) LoginPolicy(policy *LoginPolicy) *LoginPolicy {
+	breakGlass := *policy
+	breakGlass.AllowUsernamePassword = true
+	breakGlass.ForceMFA = true
+	breakGlass.ForceMFALocalOnly = false
+	breakGlass.SecondFactors = []SecondFactorType{SecondFactorTypeU2F}
+	breakGlass.MultiFactors = []MultiFactorType{MultiFactorTypeU2FWithPIN}
+	if breakGlass.PasswordlessType == PasswordlessTypeNotAllowed {
+		breakGlass.PasswordlessType = PasswordlessTypeAllowed
+	}
+	return &breakGlass
+}
@@ -14,9 +14,21 @@ const (
 	VerifyPhoneMessageType              = "VerifyPhone"
 	VerifySMSOTPMessageType             = "VerifySMSOTP"
 	VerifyEmailOTPMessageType           = "VerifyEmailOTP"
+	VerifyPushMessageType               = "VerifyPush"
 	DomainClaimedMessageType            = "DomainClaimed"
 	PasswordlessRegistrationMessageType = "PasswordlessRegistration"
 	PasswordChangeMessageType           = "PasswordChange"
+	UserDeactivationWarningMessageType  = "UserDeactivationWarning"
+	UserDeletionWarningMessageType      = "UserDeletionWarning"
+	MFAAddedMessageType                 = "MFAAdded"
+	MFARemovedMessageType               = "MFARemoved"
+	EmailChangedMessageType             = "EmailChanged"
+	PhoneChangedMessageType             = "PhoneChanged"
+	PasskeyAddedMessageType             = "PasskeyAdded"
+	NewLoginMessageType                 = "NewLogin"
+	PersonalAccessTokenAddedMessageType = "PersonalAccessTokenAdded"
+	AccountLockedMessageType            = "AccountLocked"
+	BreakGlassUsedMessageType           = "BreakGlassUsed"
 	MessageTitle                        = "Title"
 	MessagePreHeader                    = "PreHeader"
 	MessageSubject                      = "Subject"
@@ -69,7 +81,42 @@ func IsMessageTextType(textType string) bool {
 		textType == VerifyPhoneMessageType ||
 		textType == VerifySMSOTPMessageType ||
 		textType == VerifyEmailOTPMessageType ||
+		textType == VerifyPushMessageType ||
 		textType == DomainClaimedMessageType ||
 		textType == PasswordlessRegistrationMessageType ||
-		textType == PasswordChangeMessageType
+		textType == PasswordChangeMessageType ||
+		textType == UserDeactivationWarningMessageType ||
+		textType == UserDeletionWarningMessageType ||
+		IsSecurityAlertMessageType(textType)
+}
+
+// IsSecurityAlertMessageType returns true for the message types
+// of the notifications sent on security relevant changes of a user.
+func IsSecurityAlertMessageType(textType string) bool {
+	return textType == MFAAddedMessageType ||
+		textType == MFARemovedMessageType ||
+		textType == EmailChangedMessageType ||
+		textType == PhoneChangedMessageType ||
+		textType == PasskeyAddedMessageType ||
+		textType == NewLoginMessageType ||
+		textType == PersonalAccessTokenAddedMessageType ||
+		textType == AccountLockedMessageType ||
+		textType == BreakGlassUsedMessageType
+}
+
+// IsCriticalMessageType returns true for the message types a user must receive to be able to
+// (re)gain access to the account or to be warned about its deactivation or deletion.
+// Additional headers like List-Unsubscribe are only added to the non-critical messages.
+func IsCriticalMessageType(textType string) bool {
+	return textType == InitCodeMessageType ||
+		textType == PasswordResetMessageType ||
+		textType == VerifyEmailMessageType ||
+		textType == VerifyPhoneMessageType ||
+		textType == VerifySMSOTPMessageType ||
+		textType == VerifyEmailOTPMessageType ||
+		textType == VerifyPushMessageType ||
+		textType == DomainClaimedMessageType ||
+		textType == PasswordlessRegistrationMessageType ||
+		textType == UserDeactivationWarningMessageType ||
+		textType == UserDeletionWarningMessageType
 }
@@ -8,6 +8,7 @@ const (
 	SecondFactorTypeU2F
 	SecondFactorTypeOTPEmail
 	SecondFactorTypeOTPSMS
+	SecondFactorTypePush
 
 	secondFactorCount
 )
@@ -17,6 +18,7 @@ type MultiFactorType int32
 const (
 	MultiFactorTypeUnspecified MultiFactorType = iota
 	MultiFactorTypeU2FWithPIN
+	MultiFactorTypePush
 
 	multiFactorCount
 )
@@ -0,0 +1,66 @@
+package domain
+
+import es_models "github.com/zitadel/zitadel/internal/eventstore/v1/models"
+
+// Group is a set of users and nested groups of an organization.
+// Project roles granted to a group are granted to all its (transitive) members.
+type Group struct {
+	es_models.ObjectRoot
+
+	State       GroupState
+	Name        string
+	Description string
+	// ExternalID is the id of the group in an external system (e.g. a SCIM client)
+	ExternalID string
+}
+
+func (g *Group) IsValid() bool {
+	return g.Name != ""
+}
+
+type GroupState int32
+
+const (
+	GroupStateUnspecified GroupState = iota
+	GroupStateActive
+	GroupStateRemoved
+
+	groupStateCount
+)
+
+func (s GroupState) Valid() bool {
+	return s >= 0 && s < groupStateCount
+}
+
+func (s GroupState) Exists() bool {
+	return s == GroupStateActive
+}
+
+type GroupMemberType int32
+
+const (
+	GroupMemberTypeUnspecified GroupMemberType = iota
+	GroupMemberTypeUser
+	GroupMemberTypeGroup
+)
+
+// GroupGrant grants roles of a project (or a granted project) to all members of a group.
+type GroupGrant struct {
+	GroupID        string
+	ProjectID      string
+	ProjectGrantID string
+	RoleKeys       []string
+}
+
+func (g *GroupGrant) IsValid() bool {
+	return g.GroupID != "" && g.ProjectID != ""
+}
+
+func (g *GroupGrant) HasInvalidRoles(validRoles []string) bool {
+	for _, roleKey := range g.RoleKeys {
+		if !containsRoleKey(roleKey, validRoles) {
+			return true
+		}
+	}
+	return false
+}
@@ -35,3 +35,11 @@ func VerifyTOTP(code string, secret *crypto.CryptoValue, cryptoAlg crypto.Encryp
 	}
 	return nil
 }
+
+// OTPDelivery describes the recipient of a one-time password sent by ZITADEL.
+// It is stored on the events creating the code, so the deliveries can be rate limited.
+type OTPDelivery struct {
+	UserID      string `json:"userID,omitempty"`
+	PhonePrefix string `json:"phonePrefix,omitempty"`
+	RemoteIP    string `json:"remoteIP,omitempty"`
+}
@@ -25,6 +25,36 @@ func (p PhoneNumber) Normalize() (PhoneNumber, error) {
 	return PhoneNumber(libphonenumber.Format(phoneNr, libphonenumber.E164)), nil
 }
 
+// Region returns the ISO 3166-1 alpha-2 code of the country of the phone number
+// or an empty string if it can't be determined.
+func (p PhoneNumber) Region() string {
+	phoneNr, err := libphonenumber.Parse(string(p), defaultRegion)
+	if err != nil {
+		return ""
+	}
+	return libphonenumber.GetRegionCodeForNumber(phoneNr)
+}
+
+// Prefix returns the first digits of the E.164 formatted phone number,
+// which is used to group numbers of the same range (e.g. premium rate numbers).
+func (p PhoneNumber) Prefix(digits int) string {
+	normalized, err := p.Normalize()
+	if err != nil {
+		return ""
+	}
+	// include the leading plus
+	if len(normalized) <= digits+1 {
+		return string(normalized)
+	}
+	return string(normalized[:digits+1])
+}
+
+// IsValidPhoneRegion checks if the region is an ISO 3166-1 alpha-2 code of a country phone numbers can be assigned to.
+func IsValidPhoneRegion(region string) bool {
+	_, ok := libphonenumber.GetSupportedRegions()[region]
+	return ok
+}
+
 type Phone struct {
 	es_models.ObjectRoot
 
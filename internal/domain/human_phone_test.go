@@ -104,3 +104,69 @@ func TestFormatPhoneNumber(t *testing.T) {
 		})
 	}
 }
+
+func TestPhoneNumber_Prefix(t *testing.T) {
+	tests := []struct {
+		name   string
+		phone  PhoneNumber
+		digits int
+		want   string
+	}{
+		{
+			name:   "invalid phone number",
+			phone:  "PhoneNumber",
+			digits: 4,
+			want:   "",
+		},
+		{
+			name:   "normalized prefix",
+			phone:  "0711234567",
+			digits: 4,
+			want:   "+4171",
+		},
+		{
+			name:   "shorter than prefix",
+			phone:  "+41711234567",
+			digits: 20,
+			want:   "+41711234567",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.phone.Prefix(tt.digits); got != tt.want {
+				t.Errorf("Prefix() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPhoneNumber_Region(t *testing.T) {
+	tests := []struct {
+		name  string
+		phone PhoneNumber
+		want  string
+	}{
+		{
+			name:  "invalid phone number",
+			phone: "PhoneNumber",
+			want:  "",
+		},
+		{
+			name:  "default region",
+			phone: "0711234567",
+			want:  "CH",
+		},
+		{
+			name:  "international",
+			phone: "+4930123456",
+			want:  "DE",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.phone.Region(); got != tt.want {
+				t.Errorf("Region() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
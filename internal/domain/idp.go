@@ -38,6 +38,11 @@ const (
 	IDPTypeGoogle
 	IDPTypeApple
 	IDPTypeSAML
+	IDPTypeADFS
+	IDPTypeKeycloak
+	IDPTypeOkta
+	IDPTypeBitbucket
+	IDPTypeDiscord
 )
 
 func (t IDPType) GetCSSClass() string {
@@ -54,6 +59,16 @@ func (t IDPType) GetCSSClass() string {
 		return "azure"
 	case IDPTypeApple:
 		return "apple"
+	case IDPTypeADFS:
+		return "adfs"
+	case IDPTypeKeycloak:
+		return "keycloak"
+	case IDPTypeOkta:
+		return "okta"
+	case IDPTypeBitbucket:
+		return "bitbucket"
+	case IDPTypeDiscord:
+		return "discord"
 	case IDPTypeUnspecified,
 		IDPTypeOIDC,
 		IDPTypeJWT,
@@ -85,6 +100,10 @@ func (t IDPType) DisplayName() string {
 		return "Google"
 	case IDPTypeApple:
 		return "Apple"
+	case IDPTypeBitbucket:
+		return "Bitbucket"
+	case IDPTypeDiscord:
+		return "Discord"
 	case IDPTypeUnspecified,
 		IDPTypeOIDC,
 		IDPTypeJWT,
@@ -93,7 +112,10 @@ func (t IDPType) DisplayName() string {
 		IDPTypeAzureAD,
 		IDPTypeGitHubEnterprise,
 		IDPTypeGitLabSelfHosted,
-		IDPTypeSAML:
+		IDPTypeSAML,
+		IDPTypeADFS,
+		IDPTypeKeycloak,
+		IDPTypeOkta:
 		fallthrough
 	default:
 		// we should never get here, so log it
@@ -143,3 +165,21 @@ const (
 	SAMLNameIDFormatPersistent
 	SAMLNameIDFormatTransient
 )
+
+// IDPMappingProfileField is the field of the user profile an external claim can be mapped to
+type IDPMappingProfileField uint8
+
+const (
+	IDPMappingProfileFieldUnspecified IDPMappingProfileField = iota
+	IDPMappingProfileFieldFirstName
+	IDPMappingProfileFieldLastName
+	IDPMappingProfileFieldNickName
+	IDPMappingProfileFieldDisplayName
+	IDPMappingProfileFieldPreferredLanguage
+
+	idpMappingProfileFieldCount
+)
+
+func (f IDPMappingProfileField) Valid() bool {
+	return f > IDPMappingProfileFieldUnspecified && f < idpMappingProfileFieldCount
+}
@@ -1,10 +1,13 @@
 package domain
 
+import "time"
+
 type NotificationType int32
 
 const (
 	NotificationTypeEmail NotificationType = iota
 	NotificationTypeSms
+	NotificationTypePush
 
 	notificationCount
 )
@@ -31,3 +34,44 @@ const (
 
 	notificationProviderTypeCount
 )
+
+type NotificationState int32
+
+const (
+	NotificationStateUnspecified NotificationState = iota
+	NotificationStateRequested
+	NotificationStateRetrying
+	NotificationStateSent
+	NotificationStateCanceled
+	NotificationStateFailed
+
+	notificationStateCount
+)
+
+// Pending returns true if the notification still has to be delivered
+func (s NotificationState) Pending() bool {
+	return s == NotificationStateRequested || s == NotificationStateRetrying
+}
+
+type NotificationDeliveryResult int32
+
+const (
+	NotificationDeliveryResultUnspecified NotificationDeliveryResult = iota
+	NotificationDeliveryResultSent
+	NotificationDeliveryResultFailed
+
+	notificationDeliveryResultCount
+)
+
+// NotificationDelivery describes an attempt to send a notification to a user.
+// It never contains the content or the code of the message.
+type NotificationDelivery struct {
+	Channel     NotificationType `json:"channel"`
+	MessageType string           `json:"messageType,omitempty"`
+	// ProviderID is the id of the email or SMS provider config the notification was sent with
+	ProviderID string `json:"providerId,omitempty"`
+	// ProviderMessageID is the id the provider assigned to the message (e.g. the Message-ID of an email)
+	ProviderMessageID string `json:"providerMessageId,omitempty"`
+	// CodeExpiry is the lifetime of the code sent in the message, if any
+	CodeExpiry time.Duration `json:"codeExpiry,omitempty"`
+}
@@ -0,0 +1,38 @@
+package domain
+
+import (
+	"bytes"
+	"slices"
+)
+
+// OrgMemberScope restricts the permissions of an organization member on users to the users matching all defined criteria.
+type OrgMemberScope struct {
+	// MetadataKey restricts the permissions to users with the metadata,
+	// if MetadataValue is set, the metadata must have the value
+	MetadataKey   string
+	MetadataValue string
+	// GroupID restricts the permissions to the direct members of the group
+	GroupID string
+}
+
+func (s *OrgMemberScope) IsEmpty() bool {
+	return s == nil || s.MetadataKey == "" && s.MetadataValue == "" && s.GroupID == ""
+}
+
+func (s *OrgMemberScope) IsValid() bool {
+	return s.MetadataValue == "" || s.MetadataKey != ""
+}
+
+// Contains checks if the user with the metadata (by key) and the groups is in the scope.
+func (s *OrgMemberScope) Contains(metadata map[string][]byte, groupIDs []string) bool {
+	if s.IsEmpty() {
+		return true
+	}
+	if s.MetadataKey != "" {
+		value, ok := metadata[s.MetadataKey]
+		if !ok || s.MetadataValue != "" && !bytes.Equal(value, []byte(s.MetadataValue)) {
+			return false
+		}
+	}
+	return s.GroupID == "" || slices.Contains(groupIDs, s.GroupID)
+}
@@ -0,0 +1,108 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrgMemberScope_IsValid(t *testing.T) {
+	tests := []struct {
+		name  string
+		scope *OrgMemberScope
+		want  bool
+	}{
+		{
+			"empty, valid",
+			&OrgMemberScope{},
+			true,
+		},
+		{
+			"all attributes, valid",
+			&OrgMemberScope{MetadataKey: "region", MetadataValue: "EMEA", GroupID: "group1"},
+			true,
+		},
+		{
+			"key without value, valid",
+			&OrgMemberScope{MetadataKey: "region"},
+			true,
+		},
+		{
+			"value without key, invalid",
+			&OrgMemberScope{MetadataValue: "EMEA"},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.scope.IsValid())
+		})
+	}
+}
+
+func TestOrgMemberScope_Contains(t *testing.T) {
+	type args struct {
+		metadata map[string][]byte
+		groupIDs []string
+	}
+	tests := []struct {
+		name  string
+		scope *OrgMemberScope
+		args  args
+		want  bool
+	}{
+		{
+			"nil scope, contained",
+			nil,
+			args{},
+			true,
+		},
+		{
+			"metadata value matches, contained",
+			&OrgMemberScope{MetadataKey: "region", MetadataValue: "EMEA"},
+			args{metadata: map[string][]byte{"region": []byte("EMEA")}},
+			true,
+		},
+		{
+			"metadata value differs, not contained",
+			&OrgMemberScope{MetadataKey: "region", MetadataValue: "EMEA"},
+			args{metadata: map[string][]byte{"region": []byte("APAC")}},
+			false,
+		},
+		{
+			"metadata missing, not contained",
+			&OrgMemberScope{MetadataKey: "region", MetadataValue: "EMEA"},
+			args{groupIDs: []string{"group1"}},
+			false,
+		},
+		{
+			"metadata key only, any value contained",
+			&OrgMemberScope{MetadataKey: "region"},
+			args{metadata: map[string][]byte{"region": []byte("APAC")}},
+			true,
+		},
+		{
+			"group member, contained",
+			&OrgMemberScope{GroupID: "group1"},
+			args{groupIDs: []string{"group2", "group1"}},
+			true,
+		},
+		{
+			"not group member, not contained",
+			&OrgMemberScope{GroupID: "group1"},
+			args{groupIDs: []string{"group2"}},
+			false,
+		},
+		{
+			"metadata matches but not group member, not contained",
+			&OrgMemberScope{MetadataKey: "region", MetadataValue: "EMEA", GroupID: "group1"},
+			args{metadata: map[string][]byte{"region": []byte("EMEA")}},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.scope.Contains(tt.args.metadata, tt.args.groupIDs))
+		})
+	}
+}
@@ -13,3 +13,27 @@ type MailTemplate struct {
 func (m *MailTemplate) IsValid() bool {
 	return m.Template != nil
 }
+
+const (
+	// MessageTemplatePartialHeader is the name of the partial template, which can be included
+	// in the templates of the message types using {{template "header" .}}
+	MessageTemplatePartialHeader = "header"
+	// MessageTemplatePartialFooter is the name of the partial template, which can be included
+	// in the templates of the message types using {{template "footer" .}}
+	MessageTemplatePartialFooter = "footer"
+)
+
+// MessageTemplatePartials are the names of the partials available in custom message templates
+var MessageTemplatePartials = []string{MessageTemplatePartialHeader, MessageTemplatePartialFooter}
+
+// IsMessageTemplatePartial returns true if the name is the name of a partial
+// and not of a message type.
+func IsMessageTemplatePartial(name string) bool {
+	return name == MessageTemplatePartialHeader || name == MessageTemplatePartialFooter
+}
+
+// IsMessageTemplateName returns true if a custom message template can be set with the name,
+// which is either a message type or a partial.
+func IsMessageTemplateName(name string) bool {
+	return IsMessageTextType(name) || IsMessageTemplatePartial(name)
+}
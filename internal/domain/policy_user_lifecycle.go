@@ -0,0 +1,82 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// UserLifecyclePolicy defines when inactive users of an organization are deactivated and deleted.
+// A value of 0 disables the corresponding step.
+type UserLifecyclePolicy struct {
+	models.ObjectRoot
+
+	// DeactivateAfterDays is the number of days without a successful login after which an active user is deactivated.
+	DeactivateAfterDays uint64
+	// DeleteAfterDays is the number of days after the deactivation after which a user is deleted.
+	DeleteAfterDays uint64
+	// WarnDaysBefore is the number of days before the deactivation and deletion the user is notified.
+	WarnDaysBefore uint64
+}
+
+type UserLifecycleAction int32
+
+const (
+	UserLifecycleActionUnspecified UserLifecycleAction = iota
+	UserLifecycleActionWarnDeactivation
+	UserLifecycleActionDeactivate
+	UserLifecycleActionWarnDeletion
+	UserLifecycleActionDelete
+)
+
+func (p *UserLifecyclePolicy) IsValid() error {
+	if p.DeactivateAfterDays == 0 && p.DeleteAfterDays == 0 {
+		return zerrors.ThrowInvalidArgument(nil, "DOMAIN-Lq8rk", "Errors.Org.UserLifecyclePolicy.Invalid")
+	}
+	if p.WarnDaysBefore > 0 &&
+		(p.DeactivateAfterDays > 0 && p.WarnDaysBefore >= p.DeactivateAfterDays ||
+			p.DeleteAfterDays > 0 && p.WarnDaysBefore >= p.DeleteAfterDays) {
+		return zerrors.ThrowInvalidArgument(nil, "DOMAIN-v2Gdp", "Errors.Org.UserLifecyclePolicy.WarnDaysTooLong")
+	}
+	return nil
+}
+
+// DeactivationAction returns the action due at now for an active user without activity since lastActivity,
+// who was warned about the deactivation at warnedAt (zero if not yet), together with the deactivation date.
+// If warnings are enabled, a user is never deactivated before the full warning period passed.
+func (p *UserLifecyclePolicy) DeactivationAction(lastActivity, warnedAt, now time.Time) (UserLifecycleAction, time.Time) {
+	return p.nextAction(lastActivity, p.DeactivateAfterDays, warnedAt, now, UserLifecycleActionWarnDeactivation, UserLifecycleActionDeactivate)
+}
+
+// DeletionAction returns the action due at now for a user deactivated at deactivatedAt,
+// who was warned about the deletion at warnedAt (zero if not yet), together with the deletion date.
+// If warnings are enabled, a user is never deleted before the full warning period passed.
+func (p *UserLifecyclePolicy) DeletionAction(deactivatedAt, warnedAt, now time.Time) (UserLifecycleAction, time.Time) {
+	return p.nextAction(deactivatedAt, p.DeleteAfterDays, warnedAt, now, UserLifecycleActionWarnDeletion, UserLifecycleActionDelete)
+}
+
+func (p *UserLifecyclePolicy) nextAction(since time.Time, days uint64, warnedAt, now time.Time, warnAction, action UserLifecycleAction) (UserLifecycleAction, time.Time) {
+	if days == 0 || since.IsZero() {
+		return UserLifecycleActionUnspecified, time.Time{}
+	}
+	due := since.AddDate(0, 0, int(days))
+	if p.WarnDaysBefore > 0 {
+		if warnedAt.IsZero() || warnedAt.Before(since) {
+			if now.Before(due.AddDate(0, 0, -int(p.WarnDaysBefore))) {
+				return UserLifecycleActionUnspecified, due
+			}
+			if earliest := now.AddDate(0, 0, int(p.WarnDaysBefore)); earliest.After(due) {
+				due = earliest
+			}
+			return warnAction, due
+		}
+		if earliest := warnedAt.AddDate(0, 0, int(p.WarnDaysBefore)); earliest.After(due) {
+			due = earliest
+		}
+	}
+	if now.Before(due) {
+		return UserLifecycleActionUnspecified, due
+	}
+	return action, due
+}
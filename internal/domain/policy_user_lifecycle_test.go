@@ -0,0 +1,139 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserLifecyclePolicy_DeactivationAction(t *testing.T) {
+	day := 24 * time.Hour
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	type args struct {
+		policy       *UserLifecyclePolicy
+		lastActivity time.Time
+		warnedAt     time.Time
+	}
+	type want struct {
+		action UserLifecycleAction
+		due    time.Time
+	}
+	tests := []struct {
+		name string
+		args args
+		want want
+	}{
+		{
+			"disabled, unspecified",
+			args{
+				policy:       &UserLifecyclePolicy{DeleteAfterDays: 30},
+				lastActivity: now.Add(-100 * day),
+			},
+			want{
+				action: UserLifecycleActionUnspecified,
+			},
+		},
+		{
+			"not due, unspecified",
+			args{
+				policy:       &UserLifecyclePolicy{DeactivateAfterDays: 90},
+				lastActivity: now.Add(-10 * day),
+			},
+			want{
+				action: UserLifecycleActionUnspecified,
+				due:    now.Add(80 * day),
+			},
+		},
+		{
+			"due without warning, deactivate",
+			args{
+				policy:       &UserLifecyclePolicy{DeactivateAfterDays: 90},
+				lastActivity: now.Add(-90 * day),
+			},
+			want{
+				action: UserLifecycleActionDeactivate,
+				due:    now,
+			},
+		},
+		{
+			"in warning period, warn",
+			args{
+				policy:       &UserLifecyclePolicy{DeactivateAfterDays: 90, WarnDaysBefore: 7},
+				lastActivity: now.Add(-85 * day),
+			},
+			want{
+				action: UserLifecycleActionWarnDeactivation,
+				due:    now.Add(7 * day),
+			},
+		},
+		{
+			"overdue but not warned, warn with full period",
+			args{
+				policy:       &UserLifecyclePolicy{DeactivateAfterDays: 90, WarnDaysBefore: 7},
+				lastActivity: now.Add(-200 * day),
+			},
+			want{
+				action: UserLifecycleActionWarnDeactivation,
+				due:    now.Add(7 * day),
+			},
+		},
+		{
+			"warned, warning period not passed, unspecified",
+			args{
+				policy:       &UserLifecyclePolicy{DeactivateAfterDays: 90, WarnDaysBefore: 7},
+				lastActivity: now.Add(-200 * day),
+				warnedAt:     now.Add(-3 * day),
+			},
+			want{
+				action: UserLifecycleActionUnspecified,
+				due:    now.Add(4 * day),
+			},
+		},
+		{
+			"warned before last activity, warn again",
+			args{
+				policy:       &UserLifecyclePolicy{DeactivateAfterDays: 90, WarnDaysBefore: 7},
+				lastActivity: now.Add(-84 * day),
+				warnedAt:     now.Add(-100 * day),
+			},
+			want{
+				action: UserLifecycleActionWarnDeactivation,
+				due:    now.Add(7 * day),
+			},
+		},
+		{
+			"warned and due, deactivate",
+			args{
+				policy:       &UserLifecyclePolicy{DeactivateAfterDays: 90, WarnDaysBefore: 7},
+				lastActivity: now.Add(-90 * day),
+				warnedAt:     now.Add(-7 * day),
+			},
+			want{
+				action: UserLifecycleActionDeactivate,
+				due:    now,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			action, due := tt.args.policy.DeactivationAction(tt.args.lastActivity, tt.args.warnedAt, now)
+			assert.Equal(t, tt.want.action, action)
+			assert.Equal(t, tt.want.due, due)
+		})
+	}
+}
+
+func TestUserLifecyclePolicy_DeletionAction(t *testing.T) {
+	day := 24 * time.Hour
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	policy := &UserLifecyclePolicy{DeactivateAfterDays: 90, DeleteAfterDays: 30, WarnDaysBefore: 7}
+
+	action, due := policy.DeletionAction(now.Add(-25*day), time.Time{}, now)
+	assert.Equal(t, UserLifecycleActionWarnDeletion, action)
+	assert.Equal(t, now.Add(7*day), due)
+
+	action, due = policy.DeletionAction(now.Add(-30*day), now.Add(-7*day), now)
+	assert.Equal(t, UserLifecycleActionDelete, action)
+	assert.Equal(t, now, due)
+}
@@ -0,0 +1,28 @@
+package domain
+
+import (
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+)
+
+// ProjectPermission is a permission defined by an application (project),
+// which is granted through the listed roles of the project.
+type ProjectPermission struct {
+	models.ObjectRoot
+
+	Key         string
+	DisplayName string
+	RoleKeys    []string
+}
+
+func (p *ProjectPermission) IsValid() bool {
+	return p.AggregateID != "" && p.Key != ""
+}
+
+func (p *ProjectPermission) HasInvalidRoles(validRoles []string) bool {
+	for _, roleKey := range p.RoleKeys {
+		if !containsRoleKey(roleKey, validRoles) {
+			return true
+		}
+	}
+	return false
+}
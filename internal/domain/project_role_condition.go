@@ -0,0 +1,145 @@
+package domain
+
+import (
+	"net"
+	"slices"
+	"time"
+)
+
+const timeOfDayLayout = "15:04"
+
+// ProjectRoleCondition restricts the assertion of a project role in tokens.
+// The role is only asserted if the session satisfies all of the defined attributes.
+type ProjectRoleCondition struct {
+	// OrgIDs restricts the role to user grants of the organizations
+	OrgIDs []string
+	// MFALevel is the minimal strength of the authentication of the session
+	MFALevel MFALevel
+	// AMR requires at least one of the authentication method references in the session
+	AMR []string
+	// IPRanges restricts the role to requests from the networks in CIDR notation
+	IPRanges []string
+	// TimeOfDayStart and TimeOfDayEnd restrict the role to a daily period (15:04),
+	// the period may span midnight
+	TimeOfDayStart string
+	TimeOfDayEnd   string
+	// TimeZone is the IANA time zone of the daily period, UTC is used if empty
+	TimeZone string
+}
+
+// RoleConditionContext describes the session the roles are asserted for.
+// IP is nil if the origin of the session is unknown.
+type RoleConditionContext struct {
+	AuthMethods []UserAuthMethodType
+	AMR         []string
+	IP          net.IP
+	Time        time.Time
+}
+
+func (c *ProjectRoleCondition) IsEmpty() bool {
+	return c == nil || len(c.OrgIDs) == 0 && c.MFALevel == MFALevelNotSetUp && len(c.AMR) == 0 &&
+		len(c.IPRanges) == 0 && c.TimeOfDayStart == "" && c.TimeOfDayEnd == ""
+}
+
+func (c *ProjectRoleCondition) IsValid() bool {
+	if c.MFALevel < MFALevelNotSetUp || c.MFALevel > MFALevelMultiFactorCertified {
+		return false
+	}
+	for _, ipRange := range c.IPRanges {
+		if _, _, err := net.ParseCIDR(ipRange); err != nil {
+			return false
+		}
+	}
+	if (c.TimeOfDayStart == "") != (c.TimeOfDayEnd == "") {
+		return false
+	}
+	if c.TimeOfDayStart == "" {
+		return c.TimeZone == ""
+	}
+	if _, err := time.Parse(timeOfDayLayout, c.TimeOfDayStart); err != nil {
+		return false
+	}
+	if _, err := time.Parse(timeOfDayLayout, c.TimeOfDayEnd); err != nil {
+		return false
+	}
+	_, err := time.LoadLocation(c.TimeZone)
+	return err == nil
+}
+
+// Satisfied checks if the role granted by the organization (orgID) can be asserted for the session.
+// Conditions which can't be evaluated with the context are not satisfied.
+func (c *ProjectRoleCondition) Satisfied(orgID string, session *RoleConditionContext) bool {
+	if c.IsEmpty() {
+		return true
+	}
+	if session == nil {
+		session = new(RoleConditionContext)
+	}
+	if len(c.OrgIDs) > 0 && !slices.Contains(c.OrgIDs, orgID) {
+		return false
+	}
+	if !hasMFALevel(session.AuthMethods, c.MFALevel) {
+		return false
+	}
+	if len(c.AMR) > 0 && !slices.ContainsFunc(c.AMR, func(amr string) bool { return slices.Contains(session.AMR, amr) }) {
+		return false
+	}
+	if len(c.IPRanges) > 0 && !c.containsIP(session.IP) {
+		return false
+	}
+	return c.inTimeOfDay(session.Time)
+}
+
+func (c *ProjectRoleCondition) containsIP(ip net.IP) bool {
+	if ip == nil {
+		return false
+	}
+	for _, ipRange := range c.IPRanges {
+		_, network, err := net.ParseCIDR(ipRange)
+		if err == nil && network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *ProjectRoleCondition) inTimeOfDay(t time.Time) bool {
+	if c.TimeOfDayStart == "" {
+		return true
+	}
+	location, err := time.LoadLocation(c.TimeZone)
+	if err != nil {
+		return false
+	}
+	start, errStart := time.Parse(timeOfDayLayout, c.TimeOfDayStart)
+	end, errEnd := time.Parse(timeOfDayLayout, c.TimeOfDayEnd)
+	if errStart != nil || errEnd != nil {
+		return false
+	}
+	local := t.In(location)
+	minute := local.Hour()*60 + local.Minute()
+	startMinute := start.Hour()*60 + start.Minute()
+	endMinute := end.Hour()*60 + end.Minute()
+	if startMinute <= endMinute {
+		return minute >= startMinute && minute < endMinute
+	}
+	// the period spans midnight
+	return minute >= startMinute || minute < endMinute
+}
+
+func hasMFALevel(methods []UserAuthMethodType, level MFALevel) bool {
+	switch level {
+	case MFALevelNotSetUp:
+		return true
+	case MFALevelSecondFactor:
+		return Has2FA(methods) || HasMFA(methods)
+	case MFALevelMultiFactor:
+		return HasMFA(methods)
+	case MFALevelMultiFactorCertified:
+		// only hardware bound keys are certified
+		return slices.ContainsFunc(methods, func(method UserAuthMethodType) bool {
+			return method == UserAuthMethodTypeU2F || method == UserAuthMethodTypePasswordless
+		})
+	}
+	return false
+}
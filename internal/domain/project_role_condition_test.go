@@ -0,0 +1,185 @@
+package domain
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProjectRoleCondition_IsValid(t *testing.T) {
+	tests := []struct {
+		name      string
+		condition *ProjectRoleCondition
+		want      bool
+	}{
+		{
+			"empty, valid",
+			&ProjectRoleCondition{},
+			true,
+		},
+		{
+			"all attributes, valid",
+			&ProjectRoleCondition{
+				OrgIDs:         []string{"org1"},
+				MFALevel:       MFALevelMultiFactorCertified,
+				AMR:            []string{"user"},
+				IPRanges:       []string{"10.0.0.0/8", "2001:db8::/32"},
+				TimeOfDayStart: "22:00",
+				TimeOfDayEnd:   "06:00",
+				TimeZone:       "Europe/Zurich",
+			},
+			true,
+		},
+		{
+			"unknown mfa level, invalid",
+			&ProjectRoleCondition{MFALevel: MFALevelMultiFactorCertified + 1},
+			false,
+		},
+		{
+			"ip instead of range, invalid",
+			&ProjectRoleCondition{IPRanges: []string{"10.0.0.1"}},
+			false,
+		},
+		{
+			"start without end, invalid",
+			&ProjectRoleCondition{TimeOfDayStart: "08:00"},
+			false,
+		},
+		{
+			"wrong time format, invalid",
+			&ProjectRoleCondition{TimeOfDayStart: "8am", TimeOfDayEnd: "18:00"},
+			false,
+		},
+		{
+			"time zone without period, invalid",
+			&ProjectRoleCondition{TimeZone: "Europe/Zurich"},
+			false,
+		},
+		{
+			"unknown time zone, invalid",
+			&ProjectRoleCondition{TimeOfDayStart: "08:00", TimeOfDayEnd: "18:00", TimeZone: "Mars/Olympus"},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.condition.IsValid())
+		})
+	}
+}
+
+func TestProjectRoleCondition_Satisfied(t *testing.T) {
+	noon := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	type args struct {
+		orgID   string
+		session *RoleConditionContext
+	}
+	tests := []struct {
+		name      string
+		condition *ProjectRoleCondition
+		args      args
+		want      bool
+	}{
+		{
+			"no condition, satisfied",
+			nil,
+			args{orgID: "org1"},
+			true,
+		},
+		{
+			"other org, not satisfied",
+			&ProjectRoleCondition{OrgIDs: []string{"org1"}},
+			args{orgID: "org2", session: &RoleConditionContext{}},
+			false,
+		},
+		{
+			"org, satisfied",
+			&ProjectRoleCondition{OrgIDs: []string{"org1"}},
+			args{orgID: "org1", session: &RoleConditionContext{}},
+			true,
+		},
+		{
+			"mfa level without session, not satisfied",
+			&ProjectRoleCondition{MFALevel: MFALevelSecondFactor},
+			args{orgID: "org1"},
+			false,
+		},
+		{
+			"certified mfa with otp, not satisfied",
+			&ProjectRoleCondition{MFALevel: MFALevelMultiFactorCertified},
+			args{orgID: "org1", session: &RoleConditionContext{
+				AuthMethods: []UserAuthMethodType{UserAuthMethodTypePassword, UserAuthMethodTypeTOTP},
+			}},
+			false,
+		},
+		{
+			"certified mfa with u2f, satisfied",
+			&ProjectRoleCondition{MFALevel: MFALevelMultiFactorCertified},
+			args{orgID: "org1", session: &RoleConditionContext{
+				AuthMethods: []UserAuthMethodType{UserAuthMethodTypePassword, UserAuthMethodTypeU2F},
+			}},
+			true,
+		},
+		{
+			"amr missing, not satisfied",
+			&ProjectRoleCondition{AMR: []string{"user", "hwk"}},
+			args{orgID: "org1", session: &RoleConditionContext{AMR: []string{"pwd"}}},
+			false,
+		},
+		{
+			"amr, satisfied",
+			&ProjectRoleCondition{AMR: []string{"user", "hwk"}},
+			args{orgID: "org1", session: &RoleConditionContext{AMR: []string{"pwd", "user"}}},
+			true,
+		},
+		{
+			"unknown ip, not satisfied",
+			&ProjectRoleCondition{IPRanges: []string{"10.0.0.0/8"}},
+			args{orgID: "org1", session: &RoleConditionContext{}},
+			false,
+		},
+		{
+			"ip outside of range, not satisfied",
+			&ProjectRoleCondition{IPRanges: []string{"10.0.0.0/8"}},
+			args{orgID: "org1", session: &RoleConditionContext{IP: net.ParseIP("192.168.1.1")}},
+			false,
+		},
+		{
+			"ip in range, satisfied",
+			&ProjectRoleCondition{IPRanges: []string{"192.168.0.0/16", "10.0.0.0/8"}},
+			args{orgID: "org1", session: &RoleConditionContext{IP: net.ParseIP("10.1.2.3")}},
+			true,
+		},
+		{
+			"outside of time of day, not satisfied",
+			&ProjectRoleCondition{TimeOfDayStart: "08:00", TimeOfDayEnd: "12:00"},
+			args{orgID: "org1", session: &RoleConditionContext{Time: noon}},
+			false,
+		},
+		{
+			"in time of day of time zone, satisfied",
+			&ProjectRoleCondition{TimeOfDayStart: "06:00", TimeOfDayEnd: "08:00", TimeZone: "America/New_York"},
+			args{orgID: "org1", session: &RoleConditionContext{Time: noon}},
+			true,
+		},
+		{
+			"in time of day spanning midnight, satisfied",
+			&ProjectRoleCondition{TimeOfDayStart: "22:00", TimeOfDayEnd: "06:00"},
+			args{orgID: "org1", session: &RoleConditionContext{Time: noon.Add(-11 * time.Hour)}},
+			true,
+		},
+		{
+			"outside of time of day spanning midnight, not satisfied",
+			&ProjectRoleCondition{TimeOfDayStart: "22:00", TimeOfDayEnd: "06:00"},
+			args{orgID: "org1", session: &RoleConditionContext{Time: noon}},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.condition.Satisfied(tt.args.orgID, tt.args.session))
+		})
+	}
+}
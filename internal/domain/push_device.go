@@ -0,0 +1,72 @@
+package domain
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type PushPlatform int32
+
+const (
+	PushPlatformUnspecified PushPlatform = iota
+	PushPlatformFCM
+	PushPlatformAPNs
+
+	pushPlatformCount
+)
+
+func (p PushPlatform) Valid() bool {
+	return p > PushPlatformUnspecified && p < pushPlatformCount
+}
+
+type PushDeviceState int32
+
+const (
+	PushDeviceStateUnspecified PushDeviceState = iota
+	PushDeviceStateActive
+	PushDeviceStateRemoved
+)
+
+// ParsePushDevicePublicKey parses the PKIX, ASN.1 DER encoded public key of a push device.
+// Supported are ECDSA, Ed25519 and RSA keys.
+func ParsePushDevicePublicKey(publicKey []byte) (crypto.PublicKey, error) {
+	key, err := x509.ParsePKIXPublicKey(publicKey)
+	if err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "DOMAIN-Pu1ky", "Errors.User.MFA.Push.InvalidPublicKey")
+	}
+	switch key.(type) {
+	case *ecdsa.PublicKey, ed25519.PublicKey, *rsa.PublicKey:
+		return key, nil
+	default:
+		return nil, zerrors.ThrowInvalidArgument(nil, "DOMAIN-Pu2ty", "Errors.User.MFA.Push.InvalidPublicKey")
+	}
+}
+
+// VerifyPushApproval verifies the signature the device created over the challenge with its private key.
+// ECDSA signatures are expected ASN.1 encoded and RSA signatures as PKCS #1 v1.5, both using SHA-256.
+func VerifyPushApproval(publicKey []byte, challenge string, signature []byte) error {
+	key, err := ParsePushDevicePublicKey(publicKey)
+	if err != nil {
+		return err
+	}
+	digest := sha256.Sum256([]byte(challenge))
+	var valid bool
+	switch k := key.(type) {
+	case *ecdsa.PublicKey:
+		valid = ecdsa.VerifyASN1(k, digest[:], signature)
+	case ed25519.PublicKey:
+		valid = ed25519.Verify(k, []byte(challenge), signature)
+	case *rsa.PublicKey:
+		valid = rsa.VerifyPKCS1v15(k, crypto.SHA256, digest[:], signature) == nil
+	}
+	if !valid {
+		return zerrors.ThrowInvalidArgument(nil, "DOMAIN-Pu3sg", "Errors.User.MFA.Push.InvalidSignature")
+	}
+	return nil
+}
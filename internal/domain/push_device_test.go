@@ -0,0 +1,112 @@
+package domain
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestVerifyPushApproval(t *testing.T) {
+	challenge := "challenge"
+	digest := sha256.Sum256([]byte(challenge))
+
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	ecPublic, err := x509.MarshalPKIXPublicKey(&ecKey.PublicKey)
+	require.NoError(t, err)
+	ecSignature, err := ecdsa.SignASN1(rand.Reader, ecKey, digest[:])
+	require.NoError(t, err)
+
+	edPublicKey, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	edPublic, err := x509.MarshalPKIXPublicKey(edPublicKey)
+	require.NoError(t, err)
+	edSignature := ed25519.Sign(edKey, []byte(challenge))
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	rsaPublic, err := x509.MarshalPKIXPublicKey(&rsaKey.PublicKey)
+	require.NoError(t, err)
+	rsaSignature, err := rsa.SignPKCS1v15(rand.Reader, rsaKey, crypto.SHA256, digest[:])
+	require.NoError(t, err)
+
+	type args struct {
+		publicKey []byte
+		challenge string
+		signature []byte
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr error
+	}{
+		{
+			name: "invalid public key",
+			args: args{
+				publicKey: []byte("key"),
+				challenge: challenge,
+				signature: ecSignature,
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "DOMAIN-Pu1ky", "Errors.User.MFA.Push.InvalidPublicKey"),
+		},
+		{
+			name: "ecdsa, wrong challenge",
+			args: args{
+				publicKey: ecPublic,
+				challenge: "other",
+				signature: ecSignature,
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "DOMAIN-Pu3sg", "Errors.User.MFA.Push.InvalidSignature"),
+		},
+		{
+			name: "ecdsa, signature of other key",
+			args: args{
+				publicKey: ecPublic,
+				challenge: challenge,
+				signature: rsaSignature,
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "DOMAIN-Pu3sg", "Errors.User.MFA.Push.InvalidSignature"),
+		},
+		{
+			name: "ecdsa, ok",
+			args: args{
+				publicKey: ecPublic,
+				challenge: challenge,
+				signature: ecSignature,
+			},
+		},
+		{
+			name: "ed25519, ok",
+			args: args{
+				publicKey: edPublic,
+				challenge: challenge,
+				signature: edSignature,
+			},
+		},
+		{
+			name: "rsa, ok",
+			args: args{
+				publicKey: rsaPublic,
+				challenge: challenge,
+				signature: rsaSignature,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := VerifyPushApproval(tt.args.publicKey, tt.args.challenge, tt.args.signature)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
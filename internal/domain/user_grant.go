@@ -10,6 +10,7 @@ type UserGrant struct {
 	ProjectID      string
 	ProjectGrantID string
 	RoleKeys       []string
+	Validity       Validity
 }
 
 type UserGrantState int32
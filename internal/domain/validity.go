@@ -0,0 +1,34 @@
+package domain
+
+import "time"
+
+// Validity restricts user grants and memberships to a time window.
+// A nil bound leaves the window open on its side.
+type Validity struct {
+	From  *time.Time
+	Until *time.Time
+}
+
+func (v Validity) IsSet() bool {
+	return v.From != nil || v.Until != nil
+}
+
+func (v Validity) IsValid() bool {
+	return v.From == nil || v.Until == nil || v.From.Before(*v.Until)
+}
+
+// ExpiredAt reports if the window ended before or at t.
+func (v Validity) ExpiredAt(t time.Time) bool {
+	return v.Until != nil && !v.Until.After(t)
+}
+
+func (v Validity) Equal(o Validity) bool {
+	return equalTimePointer(v.From, o.From) && equalTimePointer(v.Until, o.Until)
+}
+
+func equalTimePointer(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
@@ -0,0 +1,11 @@
+package expiry
+
+import "time"
+
+type Config struct {
+	// Enabled starts the worker removing the time-bound user grants and memberships after their validity
+	// and completing the access reviews after their deadline
+	Enabled bool
+	// Interval defines how often the expired user grants and memberships are searched
+	Interval time.Duration
+}
@@ -0,0 +1,170 @@
+package expiry
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/scheduler"
+)
+
+const (
+	// WorkerUserID is set as editor of the events pushed by the worker
+	WorkerUserID = "ACCESS_EXPIRY"
+
+	schedulerName = "access_expiry"
+)
+
+// Worker periodically removes the user grants and memberships of all instances
+// whose validity ended, so the expiry is recorded as event.
+// It also records the end of the elevations of members by access requests
+// and completes the access reviews whose deadline is reached.
+type Worker struct {
+	config     Config
+	commands   *command.Commands
+	queries    *query.Queries
+	eventstore *eventstore.Eventstore
+	now        func() time.Time
+}
+
+// Start expires the access in the configured interval on one of the running replicas.
+func Start(ctx context.Context, config Config, client *database.DB, commands *command.Commands, queries *query.Queries, es *eventstore.Eventstore) {
+	if !config.Enabled {
+		return
+	}
+	w := &Worker{
+		config:     config,
+		commands:   commands,
+		queries:    queries,
+		eventstore: es,
+		now:        time.Now,
+	}
+	scheduler.New(client, schedulerName, config.Interval, w.expireInstances).Start(ctx)
+}
+
+func (w *Worker) expireInstances(ctx context.Context) error {
+	instanceIDs, err := w.eventstore.InstanceIDs(
+		ctx,
+		0,
+		true,
+		eventstore.NewSearchQueryBuilder(eventstore.ColumnsInstanceIDs).
+			OrderDesc().
+			AddQuery().
+			AggregateTypes(instance.AggregateType).
+			EventTypes(instance.InstanceAddedEventType).
+			Builder(),
+	)
+	if err != nil {
+		return err
+	}
+	for _, instanceID := range instanceIDs {
+		ctx := authz.SetCtxData(authz.WithInstanceID(ctx, instanceID), authz.CtxData{UserID: WorkerUserID})
+		err = w.expireUserGrants(ctx)
+		logging.WithFields("instance", instanceID).OnError(err).Warn("unable to expire user grants of instance")
+		err = w.expireMemberships(ctx)
+		logging.WithFields("instance", instanceID).OnError(err).Warn("unable to expire memberships of instance")
+		err = w.endAccessRequestElevations(ctx)
+		logging.WithFields("instance", instanceID).OnError(err).Warn("unable to end access request elevations of instance")
+		err = w.completeAccessReviews(ctx)
+		logging.WithFields("instance", instanceID).OnError(err).Warn("unable to complete access reviews of instance")
+	}
+	return nil
+}
+
+func (w *Worker) expireUserGrants(ctx context.Context) error {
+	expiredQuery, err := query.NewUserGrantExpiredQuery(w.now())
+	if err != nil {
+		return err
+	}
+	grants, err := w.queries.UserGrants(ctx, &query.UserGrantsQueries{
+		Queries: []query.SearchQuery{expiredQuery},
+	}, true)
+	if err != nil {
+		return err
+	}
+	for _, grant := range grants.UserGrants {
+		_, err = w.commands.ExpireUserGrant(ctx, grant.ID, grant.ResourceOwner)
+		logging.WithFields("user grant", grant.ID).OnError(err).Warn("unable to expire user grant")
+	}
+	return nil
+}
+
+func (w *Worker) expireMemberships(ctx context.Context) error {
+	expiredQuery, err := query.NewMembershipExpiredQuery(w.now())
+	if err != nil {
+		return err
+	}
+	memberships, err := w.queries.Memberships(ctx, &query.MembershipSearchQuery{
+		Queries: []query.SearchQuery{expiredQuery},
+	}, true)
+	if err != nil {
+		return err
+	}
+	for _, membership := range memberships.Memberships {
+		err = w.expireMembership(ctx, membership)
+		logging.WithFields("user", membership.UserID, "resource owner", membership.ResourceOwner).OnError(err).Warn("unable to expire membership")
+	}
+	return nil
+}
+
+func (w *Worker) endAccessRequestElevations(ctx context.Context) error {
+	expiredQuery, err := query.NewAccessRequestElevationExpiredQuery(w.now())
+	if err != nil {
+		return err
+	}
+	requests, err := w.queries.SearchAccessRequests(ctx, &query.AccessRequestSearchQueries{
+		Queries: []query.SearchQuery{expiredQuery},
+	})
+	if err != nil {
+		return err
+	}
+	for _, request := range requests.AccessRequests {
+		_, err = w.commands.EndAccessRequestElevation(ctx, request.ID, request.ResourceOwner)
+		logging.WithFields("access request", request.ID).OnError(err).Warn("unable to end access request elevation")
+	}
+	return nil
+}
+
+func (w *Worker) completeAccessReviews(ctx context.Context) error {
+	deadlineQuery, err := query.NewAccessReviewDeadlineReachedQuery(w.now())
+	if err != nil {
+		return err
+	}
+	activeQuery, err := query.NewAccessReviewStateSearchQuery(domain.AccessReviewStateActive)
+	if err != nil {
+		return err
+	}
+	reviews, err := w.queries.SearchAccessReviews(ctx, &query.AccessReviewSearchQueries{
+		Queries: []query.SearchQuery{deadlineQuery, activeQuery},
+	})
+	if err != nil {
+		return err
+	}
+	for _, review := range reviews.AccessReviews {
+		_, err = w.commands.CompleteAccessReview(ctx, review.ID, review.ResourceOwner)
+		logging.WithFields("access review", review.ID).OnError(err).Warn("unable to complete access review")
+	}
+	return nil
+}
+
+func (w *Worker) expireMembership(ctx context.Context, membership *query.Membership) (err error) {
+	switch {
+	case membership.IAM != nil:
+		_, err = w.commands.ExpireInstanceMember(ctx, membership.UserID)
+	case membership.Org != nil:
+		_, err = w.commands.ExpireOrgMember(ctx, membership.Org.OrgID, membership.UserID)
+	case membership.Project != nil:
+		_, err = w.commands.ExpireProjectMember(ctx, membership.Project.ProjectID, membership.UserID, membership.ResourceOwner)
+	case membership.ProjectGrant != nil:
+		_, err = w.commands.ExpireProjectGrantMember(ctx, membership.ProjectGrant.ProjectID, membership.ProjectGrant.GrantID, membership.UserID)
+	}
+	return err
+}
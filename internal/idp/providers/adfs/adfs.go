@@ -0,0 +1,35 @@
+package adfs
+
+import (
+	openid "github.com/zitadel/oidc/v3/pkg/oidc"
+
+	"github.com/zitadel/zitadel/internal/idp"
+	"github.com/zitadel/zitadel/internal/idp/providers/oidc"
+)
+
+var _ idp.Provider = (*Provider)(nil)
+
+// Provider is the [idp.Provider] implementation for Microsoft Active Directory Federation Services (AD FS)
+type Provider struct {
+	*oidc.Provider
+}
+
+// New creates an AD FS provider using the [oidc.Provider] (OIDC generic provider).
+// The issuer is the federation service endpoint, e.g. https://adfs.example.com/adfs
+func New(name, issuer, clientID, clientSecret, redirectURI string, scopes []string, options ...oidc.ProviderOpts) (*Provider, error) {
+	if len(scopes) == 0 {
+		// AD FS does not know the `phone` scope, which the OIDC provider would set as default,
+		// so we will just set a separate default list
+		scopes = []string{openid.ScopeOpenID, openid.ScopeProfile, openid.ScopeEmail}
+	}
+	// the userinfo endpoint of AD FS only returns the `sub` claim,
+	// so the information of the user is always taken from the id_token
+	options = append([]oidc.ProviderOpts{oidc.WithIDTokenMapping()}, options...)
+	rp, err := oidc.New(name, issuer, clientID, clientSecret, redirectURI, scopes, oidc.DefaultMapper, options...)
+	if err != nil {
+		return nil, err
+	}
+	return &Provider{
+		Provider: rp,
+	}, nil
+}
@@ -0,0 +1,152 @@
+package bitbucket
+
+import (
+	"golang.org/x/oauth2"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/idp"
+	"github.com/zitadel/zitadel/internal/idp/providers/oauth"
+)
+
+const (
+	authURL      = "https://bitbucket.org/site/oauth2/authorize"
+	tokenURL     = "https://bitbucket.org/site/oauth2/access_token"
+	profileURL   = "https://api.bitbucket.org/2.0/user"
+	emailsURL    = "https://api.bitbucket.org/2.0/user/emails"
+	name         = "Bitbucket"
+	scopeAccount = "account"
+	scopeEmail   = "email"
+)
+
+var _ idp.Provider = (*Provider)(nil)
+
+// Provider is the [idp.Provider] implementation for Bitbucket Cloud
+type Provider struct {
+	*oauth.Provider
+}
+
+// New creates a Bitbucket provider using the [oauth.Provider] (OAuth 2.0 generic provider).
+// If no scopes are provided, `account` and `email` will be requested.
+func New(clientID, secret, callbackURL string, scopes []string, options ...oauth.ProviderOpts) (*Provider, error) {
+	if len(scopes) == 0 {
+		scopes = []string{scopeAccount, scopeEmail}
+	}
+	rp, err := oauth.New(
+		&oauth2.Config{
+			ClientID:     clientID,
+			ClientSecret: secret,
+			RedirectURL:  callbackURL,
+			Endpoint: oauth2.Endpoint{
+				AuthURL:  authURL,
+				TokenURL: tokenURL,
+			},
+			Scopes: scopes,
+		},
+		name,
+		profileURL,
+		func() idp.User {
+			return new(User)
+		},
+		options...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &Provider{
+		Provider: rp,
+	}, nil
+}
+
+// User is a representation of the authenticated Bitbucket user and implements the [idp.User] interface
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-users/#api-user-get
+type User struct {
+	AccountID   string `json:"account_id"`
+	UUID        string `json:"uuid"`
+	Username    string `json:"username"`
+	Nickname    string `json:"nickname"`
+	DisplayName string `json:"display_name"`
+	Links       struct {
+		Avatar Link `json:"avatar"`
+		HTML   Link `json:"html"`
+	} `json:"links"`
+	// Email and EmailVerified are not part of the user resource,
+	// but are set from the primary address of the emails endpoint
+	Email         domain.EmailAddress `json:"-"`
+	EmailVerified bool                `json:"-"`
+}
+
+type Link struct {
+	Href string `json:"href"`
+}
+
+// GetID is an implementation of the [idp.User] interface.
+func (u *User) GetID() string {
+	return u.AccountID
+}
+
+// GetFirstName is an implementation of the [idp.User] interface.
+// It returns an empty string because Bitbucket does not provide the user's firstname.
+func (u *User) GetFirstName() string {
+	return ""
+}
+
+// GetLastName is an implementation of the [idp.User] interface.
+// It returns an empty string because Bitbucket does not provide the user's lastname.
+func (u *User) GetLastName() string {
+	return ""
+}
+
+// GetDisplayName is an implementation of the [idp.User] interface.
+func (u *User) GetDisplayName() string {
+	return u.DisplayName
+}
+
+// GetNickname is an implementation of the [idp.User] interface.
+func (u *User) GetNickname() string {
+	return u.Nickname
+}
+
+// GetPreferredUsername is an implementation of the [idp.User] interface
+// returning the username of the Bitbucket user.
+func (u *User) GetPreferredUsername() string {
+	return u.Username
+}
+
+// GetEmail is an implementation of the [idp.User] interface.
+func (u *User) GetEmail() domain.EmailAddress {
+	return u.Email
+}
+
+// IsEmailVerified is an implementation of the [idp.User] interface.
+func (u *User) IsEmailVerified() bool {
+	return u.EmailVerified
+}
+
+// GetPhone is an implementation of the [idp.User] interface.
+// It returns an empty string because Bitbucket does not provide the user's phone.
+func (u *User) GetPhone() domain.PhoneNumber {
+	return ""
+}
+
+// IsPhoneVerified is an implementation of the [idp.User] interface
+// it returns false because Bitbucket does not provide the user's phone
+func (u *User) IsPhoneVerified() bool {
+	return false
+}
+
+// GetPreferredLanguage is an implementation of the [idp.User] interface.
+// It returns [language.Und] because Bitbucket does not provide the user's language.
+func (u *User) GetPreferredLanguage() language.Tag {
+	return language.Und
+}
+
+// GetProfile is an implementation of the [idp.User] interface.
+func (u *User) GetProfile() string {
+	return u.Links.HTML.Href
+}
+
+// GetAvatarURL is an implementation of the [idp.User] interface.
+func (u *User) GetAvatarURL() string {
+	return u.Links.Avatar.Href
+}
@@ -0,0 +1,83 @@
+package bitbucket
+
+import (
+	"context"
+	"net/http"
+
+	httphelper "github.com/zitadel/oidc/v3/pkg/http"
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/idp"
+	"github.com/zitadel/zitadel/internal/idp/providers/oauth"
+)
+
+var _ idp.Session = (*Session)(nil)
+
+// Session extends the [oauth.Session] to retrieve the email address of the user,
+// which is not part of the Bitbucket user resource
+type Session struct {
+	*Provider
+	Code string
+
+	OAuthSession *oauth.Session
+}
+
+// GetAuth implements the [idp.Session] interface by calling the wrapped [oauth.Session].
+func (s *Session) GetAuth(ctx context.Context) (string, bool) {
+	return s.oauth().GetAuth(ctx)
+}
+
+// FetchUser implements the [idp.Session] interface.
+// It will retrieve the user by calling the wrapped [oauth.Session]
+// and additionally set the primary email address from the emails endpoint.
+func (s *Session) FetchUser(ctx context.Context) (idp.User, error) {
+	fetched, err := s.oauth().FetchUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	user := fetched.(*User)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, emailsURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("authorization", s.oauth().Tokens.TokenType+" "+s.oauth().Tokens.AccessToken)
+	emails := new(emailsResponse)
+	if err := httphelper.HttpRequest(s.Provider.HttpClient(), req, emails); err != nil {
+		return nil, err
+	}
+	for _, email := range emails.Values {
+		if email.IsPrimary {
+			user.Email = email.Email
+			user.EmailVerified = email.IsConfirmed
+			break
+		}
+	}
+	return user, nil
+}
+
+// Tokens returns the [oidc.Tokens] of the underlying [oauth.Session].
+func (s *Session) Tokens() *oidc.Tokens[*oidc.IDTokenClaims] {
+	return s.oauth().Tokens
+}
+
+func (s *Session) oauth() *oauth.Session {
+	if s.OAuthSession != nil {
+		return s.OAuthSession
+	}
+	s.OAuthSession = &oauth.Session{
+		Code:     s.Code,
+		Provider: s.Provider.Provider,
+	}
+	return s.OAuthSession
+}
+
+// emailsResponse is the (paginated) response of the emails endpoint
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-users/#api-user-emails-get
+type emailsResponse struct {
+	Values []struct {
+		Email       domain.EmailAddress `json:"email"`
+		IsPrimary   bool                `json:"is_primary"`
+		IsConfirmed bool                `json:"is_confirmed"`
+	} `json:"values"`
+}
@@ -0,0 +1,25 @@
+package keycloak
+
+import (
+	"github.com/zitadel/zitadel/internal/idp"
+	"github.com/zitadel/zitadel/internal/idp/providers/oidc"
+)
+
+var _ idp.Provider = (*Provider)(nil)
+
+// Provider is the [idp.Provider] implementation for Keycloak
+type Provider struct {
+	*oidc.Provider
+}
+
+// New creates a Keycloak provider using the [oidc.Provider] (OIDC generic provider).
+// The issuer is the URL of the realm, e.g. https://keycloak.example.com/realms/myrealm
+func New(name, issuer, clientID, clientSecret, redirectURI string, scopes []string, options ...oidc.ProviderOpts) (*Provider, error) {
+	rp, err := oidc.New(name, issuer, clientID, clientSecret, redirectURI, scopes, oidc.DefaultMapper, options...)
+	if err != nil {
+		return nil, err
+	}
+	return &Provider{
+		Provider: rp,
+	}, nil
+}
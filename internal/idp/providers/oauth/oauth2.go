@@ -2,6 +2,9 @@ package oauth
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
 
 	"github.com/zitadel/oidc/v3/pkg/client/rp"
 	"github.com/zitadel/oidc/v3/pkg/oidc"
@@ -23,6 +26,7 @@ type Provider struct {
 	isCreationAllowed bool
 	isAutoCreation    bool
 	isAutoUpdate      bool
+	codeVerifierKey   []byte
 }
 
 type ProviderOpts func(provider *Provider)
@@ -63,6 +67,16 @@ func WithRelyingPartyOption(option rp.Option) ProviderOpts {
 	}
 }
 
+// WithPKCE enables the use of PKCE (Proof Key for Code Exchange).
+// The code verifier is derived from the state of the auth request using the provided key,
+// so it does not need to be stored in between the auth request and the code exchange.
+func WithPKCE(codeVerifierKey []byte) ProviderOpts {
+	return func(p *Provider) {
+		p.codeVerifierKey = codeVerifierKey
+		p.options = append(p.options, rp.WithPKCE(nil))
+	}
+}
+
 // New creates a generic OAuth 2.0 provider
 func New(config *oauth2.Config, name, userEndpoint string, userMapper func() idp.User, options ...ProviderOpts) (provider *Provider, err error) {
 	provider = &Provider{
@@ -99,8 +113,18 @@ func (p *Provider) BeginAuth(ctx context.Context, state string, params ...idp.Pa
 	if !loginHintSet {
 		opts = append(opts, rp.WithPrompt(oidc.PromptSelectAccount))
 	}
+	if p.RelyingParty.IsPKCE() {
+		opts = append(opts, rp.WithCodeChallenge(oidc.NewSHACodeChallenge(p.codeVerifier(state))))
+	}
 	url := rp.AuthURL(state, p.RelyingParty, opts...)
-	return &Session{AuthURL: url, Provider: p}, nil
+	return &Session{AuthURL: url, State: state, Provider: p}, nil
+}
+
+// codeVerifier returns the PKCE code verifier for the provided state
+func (p *Provider) codeVerifier(state string) string {
+	mac := hmac.New(sha256.New, p.codeVerifierKey)
+	mac.Write([]byte(state))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
 }
 
 func loginHint(hint string) rp.AuthURLOpt {
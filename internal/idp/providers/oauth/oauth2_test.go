@@ -18,6 +18,7 @@ func TestProvider_BeginAuth(t *testing.T) {
 		name         string
 		userEndpoint string
 		userMapper   func() idp.User
+		options      []ProviderOpts
 	}
 	tests := []struct {
 		name   string
@@ -40,13 +41,29 @@ func TestProvider_BeginAuth(t *testing.T) {
 			},
 			want: &Session{AuthURL: "https://oauth2.com/authorize?client_id=clientID&prompt=select_account&redirect_uri=redirectURI&response_type=code&scope=user&state=testState"},
 		},
+		{
+			name: "successful auth with pkce",
+			fields: fields{
+				config: &oauth2.Config{
+					ClientID: "clientID",
+					Endpoint: oauth2.Endpoint{
+						AuthURL:  "https://oauth2.com/authorize",
+						TokenURL: "https://oauth2.com/token",
+					},
+					RedirectURL: "redirectURI",
+					Scopes:      []string{"user"},
+				},
+				options: []ProviderOpts{WithPKCE([]byte("codeVerifierKey"))},
+			},
+			want: &Session{AuthURL: "https://oauth2.com/authorize?client_id=clientID&code_challenge=Vc524_GidutDsMq2Ez3t_fhPLziC4v6O8MrEs21QFKk&code_challenge_method=S256&prompt=select_account&redirect_uri=redirectURI&response_type=code&scope=user&state=testState"},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			a := assert.New(t)
 			r := require.New(t)
 
-			provider, err := New(tt.fields.config, tt.fields.name, tt.fields.userEndpoint, tt.fields.userMapper)
+			provider, err := New(tt.fields.config, tt.fields.name, tt.fields.userEndpoint, tt.fields.userMapper, tt.fields.options...)
 			r.NoError(err)
 
 			ctx := context.Background()
@@ -20,7 +20,9 @@ var _ idp.Session = (*Session)(nil)
 type Session struct {
 	AuthURL string
 	Code    string
-	Tokens  *oidc.Tokens[*oidc.IDTokenClaims]
+	// State is required to compute the code verifier, if the [Provider] uses PKCE
+	State  string
+	Tokens *oidc.Tokens[*oidc.IDTokenClaims]
 
 	Provider *Provider
 }
@@ -55,7 +57,11 @@ func (s *Session) authorize(ctx context.Context) (err error) {
 	if s.Code == "" {
 		return ErrCodeMissing
 	}
-	s.Tokens, err = rp.CodeExchange[*oidc.IDTokenClaims](ctx, s.Code, s.Provider.RelyingParty)
+	var opts []rp.CodeExchangeOpt
+	if s.Provider.RelyingParty.IsPKCE() {
+		opts = append(opts, rp.WithCodeVerifier(s.Provider.codeVerifier(s.State)))
+	}
+	s.Tokens, err = rp.CodeExchange[*oidc.IDTokenClaims](ctx, s.Code, s.Provider.RelyingParty, opts...)
 
 	return err
 }
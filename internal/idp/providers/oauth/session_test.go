@@ -24,8 +24,10 @@ func TestProvider_FetchUser(t *testing.T) {
 		userEndpoint string
 		httpMock     func(issuer string)
 		userMapper   func() idp.User
+		options      []ProviderOpts
 		authURL      string
 		code         string
+		state        string
 		tokens       *oidc.Tokens[*oidc.IDTokenClaims]
 	}
 	type want struct {
@@ -231,6 +233,55 @@ func TestProvider_FetchUser(t *testing.T) {
 				profile:           "",
 			},
 		},
+		{
+			name: "successful fetch with code exchange using pkce",
+			fields: fields{
+				config: &oauth2.Config{
+					ClientID: "clientID",
+					Endpoint: oauth2.Endpoint{
+						AuthURL:  "https://oauth2.com/authorize",
+						TokenURL: "https://oauth2.com/token",
+					},
+					RedirectURL: "redirectURI",
+					Scopes:      []string{"user"},
+				},
+				userEndpoint: "https://oauth2.com/user",
+				httpMock: func(issuer string) {
+					gock.New(issuer).
+						Post("/token").
+						BodyString("client_id=clientID&code=code&code_verifier=vdPgnMxNBke0-bpURUMt-IjJWmmNqU92ujyvKH7iRyc&grant_type=authorization_code&redirect_uri=redirectURI").
+						Reply(200).
+						JSON(&oidc.AccessTokenResponse{
+							AccessToken: "accessToken",
+							TokenType:   oidc.BearerToken,
+							ExpiresIn:   3600,
+							State:       "testState"})
+					gock.New(issuer).
+						Get("/user").
+						Reply(200).
+						JSON(map[string]interface{}{
+							"userID": "id",
+						})
+				},
+				userMapper: func() idp.User {
+					return NewUserMapper("userID")
+				},
+				options: []ProviderOpts{WithPKCE([]byte("codeVerifierKey"))},
+				tokens:  nil,
+				code:    "code",
+				state:   "testState",
+			},
+			want: want{
+				user: &UserMapper{
+					idAttribute: "userID",
+					RawInfo: map[string]interface{}{
+						"userID": "id",
+					},
+				},
+				id:                "id",
+				preferredLanguage: language.Und,
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -238,12 +289,13 @@ func TestProvider_FetchUser(t *testing.T) {
 			tt.fields.httpMock("https://oauth2.com")
 			a := assert.New(t)
 
-			provider, err := New(tt.fields.config, tt.fields.name, tt.fields.userEndpoint, tt.fields.userMapper)
+			provider, err := New(tt.fields.config, tt.fields.name, tt.fields.userEndpoint, tt.fields.userMapper, tt.fields.options...)
 			require.NoError(t, err)
 
 			session := &Session{
 				AuthURL:  tt.fields.authURL,
 				Code:     tt.fields.code,
+				State:    tt.fields.state,
 				Tokens:   tt.fields.tokens,
 				Provider: provider,
 			}
@@ -0,0 +1,26 @@
+package okta
+
+import (
+	"github.com/zitadel/zitadel/internal/idp"
+	"github.com/zitadel/zitadel/internal/idp/providers/oidc"
+)
+
+var _ idp.Provider = (*Provider)(nil)
+
+// Provider is the [idp.Provider] implementation for Okta
+type Provider struct {
+	*oidc.Provider
+}
+
+// New creates an Okta provider using the [oidc.Provider] (OIDC generic provider).
+// The issuer is either the Okta org, e.g. https://example.okta.com
+// or an authorization server, e.g. https://example.okta.com/oauth2/default
+func New(name, issuer, clientID, clientSecret, redirectURI string, scopes []string, options ...oidc.ProviderOpts) (*Provider, error) {
+	rp, err := oidc.New(name, issuer, clientID, clientSecret, redirectURI, scopes, oidc.DefaultMapper, options...)
+	if err != nil {
+		return nil, err
+	}
+	return &Provider{
+		Provider: rp,
+	}, nil
+}
@@ -0,0 +1,11 @@
+package lifecycle
+
+import "time"
+
+type Config struct {
+	// Enabled starts the worker evaluating the user lifecycle policies of the organizations
+	Enabled bool
+	// Interval defines how often the user lifecycle policies are evaluated.
+	// The policies are evaluated by only one of the running replicas per interval.
+	Interval time.Duration
+}
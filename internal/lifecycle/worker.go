@@ -0,0 +1,177 @@
+package lifecycle
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/scheduler"
+)
+
+const (
+	// WorkerUserID is set as editor of the events pushed by the worker
+	WorkerUserID = "USER_LIFECYCLE"
+
+	schedulerName = "user_lifecycle"
+)
+
+// Worker periodically evaluates the user lifecycle policies of all organizations
+// and warns, deactivates and deletes inactive users accordingly.
+type Worker struct {
+	config     Config
+	commands   *command.Commands
+	queries    *query.Queries
+	eventstore *eventstore.Eventstore
+	now        func() time.Time
+}
+
+// Start evaluates the policies in the configured interval on one of the running replicas.
+func Start(ctx context.Context, config Config, client *database.DB, commands *command.Commands, queries *query.Queries, es *eventstore.Eventstore) {
+	if !config.Enabled {
+		return
+	}
+	w := &Worker{
+		config:     config,
+		commands:   commands,
+		queries:    queries,
+		eventstore: es,
+		now:        time.Now,
+	}
+	scheduler.New(client, schedulerName, config.Interval, w.evaluateInstances).Start(ctx)
+}
+
+func (w *Worker) evaluateInstances(ctx context.Context) error {
+	instanceIDs, err := w.eventstore.InstanceIDs(
+		ctx,
+		0,
+		true,
+		eventstore.NewSearchQueryBuilder(eventstore.ColumnsInstanceIDs).
+			OrderDesc().
+			AddQuery().
+			AggregateTypes(instance.AggregateType).
+			EventTypes(instance.InstanceAddedEventType).
+			Builder(),
+	)
+	if err != nil {
+		return err
+	}
+	for _, instanceID := range instanceIDs {
+		err = w.evaluateInstance(ctx, instanceID)
+		logging.WithFields("instance", instanceID).OnError(err).Warn("unable to evaluate user lifecycle policies of instance")
+	}
+	return nil
+}
+
+func (w *Worker) evaluateInstance(ctx context.Context, instanceID string) error {
+	ctx = authz.WithInstanceID(ctx, instanceID)
+	policies, err := w.queries.UserLifecyclePolicies(ctx)
+	if err != nil {
+		return err
+	}
+	for _, policy := range policies {
+		ctx := authz.SetCtxData(ctx, authz.CtxData{UserID: WorkerUserID, OrgID: policy.ID})
+		candidates, err := w.queries.UserLifecycleCandidates(ctx, true, policy, w.now())
+		if err != nil {
+			return err
+		}
+		for _, candidate := range candidates {
+			err = w.apply(ctx, candidate)
+			logging.WithFields("instance", instanceID, "user", candidate.UserID, "action", candidate.Action).OnError(err).Warn("unable to apply user lifecycle policy")
+		}
+	}
+	return nil
+}
+
+func (w *Worker) apply(ctx context.Context, candidate *query.UserLifecycleCandidate) (err error) {
+	switch candidate.Action {
+	case domain.UserLifecycleActionWarnDeactivation:
+		_, err = w.commands.AddUserDeactivationWarning(ctx, candidate.UserID, candidate.ResourceOwner, candidate.DueDate)
+	case domain.UserLifecycleActionDeactivate:
+		_, err = w.commands.DeactivateUser(ctx, candidate.UserID, candidate.ResourceOwner)
+	case domain.UserLifecycleActionWarnDeletion:
+		_, err = w.commands.AddUserDeletionWarning(ctx, candidate.UserID, candidate.ResourceOwner, candidate.DueDate)
+	case domain.UserLifecycleActionDelete:
+		err = w.removeUser(ctx, candidate)
+	}
+	return err
+}
+
+func (w *Worker) removeUser(ctx context.Context, candidate *query.UserLifecycleCandidate) error {
+	userGrantUserQuery, err := query.NewUserGrantUserIDSearchQuery(candidate.UserID)
+	if err != nil {
+		return err
+	}
+	grants, err := w.queries.UserGrants(ctx, &query.UserGrantsQueries{
+		Queries: []query.SearchQuery{userGrantUserQuery},
+	}, true)
+	if err != nil {
+		return err
+	}
+	membershipsUserQuery, err := query.NewMembershipUserIDQuery(candidate.UserID)
+	if err != nil {
+		return err
+	}
+	memberships, err := w.queries.Memberships(ctx, &query.MembershipSearchQuery{
+		Queries: []query.SearchQuery{membershipsUserQuery},
+	}, false)
+	if err != nil {
+		return err
+	}
+	_, err = w.commands.RemoveUser(ctx, candidate.UserID, candidate.ResourceOwner, cascadingMemberships(memberships.Memberships), userGrantsToIDs(grants.UserGrants)...)
+	return err
+}
+
+func cascadingMemberships(memberships []*query.Membership) []*command.CascadingMembership {
+	cascades := make([]*command.CascadingMembership, len(memberships))
+	for i, membership := range memberships {
+		cascades[i] = &command.CascadingMembership{
+			UserID:        membership.UserID,
+			ResourceOwner: membership.ResourceOwner,
+			IAM:           cascadingIAMMembership(membership.IAM),
+			Org:           cascadingOrgMembership(membership.Org),
+			Project:       cascadingProjectMembership(membership.Project),
+			ProjectGrant:  cascadingProjectGrantMembership(membership.ProjectGrant),
+		}
+	}
+	return cascades
+}
+func cascadingIAMMembership(membership *query.IAMMembership) *command.CascadingIAMMembership {
+	if membership == nil {
+		return nil
+	}
+	return &command.CascadingIAMMembership{IAMID: membership.IAMID}
+}
+func cascadingOrgMembership(membership *query.OrgMembership) *command.CascadingOrgMembership {
+	if membership == nil {
+		return nil
+	}
+	return &command.CascadingOrgMembership{OrgID: membership.OrgID}
+}
+func cascadingProjectMembership(membership *query.ProjectMembership) *command.CascadingProjectMembership {
+	if membership == nil {
+		return nil
+	}
+	return &command.CascadingProjectMembership{ProjectID: membership.ProjectID}
+}
+func cascadingProjectGrantMembership(membership *query.ProjectGrantMembership) *command.CascadingProjectGrantMembership {
+	if membership == nil {
+		return nil
+	}
+	return &command.CascadingProjectGrantMembership{ProjectID: membership.ProjectID, GrantID: membership.GrantID}
+}
+
+func userGrantsToIDs(userGrants []*query.UserGrant) []string {
+	converted := make([]string, len(userGrants))
+	for i, grant := range userGrants {
+		converted[i] = grant.ID
+	}
+	return converted
+}
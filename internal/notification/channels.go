@@ -5,12 +5,16 @@ import (
 
 	"github.com/zitadel/logging"
 
-	"github.com/zitadel/zitadel/internal/notification/channels/smtp"
-	"github.com/zitadel/zitadel/internal/notification/channels/twilio"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/notification/channels/email"
+	"github.com/zitadel/zitadel/internal/notification/channels/push"
+	"github.com/zitadel/zitadel/internal/notification/channels/sms"
 	"github.com/zitadel/zitadel/internal/notification/channels/webhook"
 	"github.com/zitadel/zitadel/internal/notification/handlers"
 	"github.com/zitadel/zitadel/internal/notification/senders"
 	"github.com/zitadel/zitadel/internal/notification/types"
+	"github.com/zitadel/zitadel/internal/query"
 	"github.com/zitadel/zitadel/internal/telemetry/metrics"
 )
 
@@ -25,35 +29,48 @@ type deliveryMetrics struct {
 	email string
 	sms   string
 	json  string
+	push  string
 }
 
 type channels struct {
-	q        *handlers.NotificationQueries
-	counters counters
+	q          *handlers.NotificationQueries
+	commands   handlers.Commands
+	pushConfig push.Config
+	pushStub   *push.Stub
+	counters   counters
 }
 
-func newChannels(q *handlers.NotificationQueries) *channels {
+func newChannels(q *handlers.NotificationQueries, commands handlers.Commands, pushConfig push.Config) *channels {
 	c := &channels{
-		q: q,
+		q:          q,
+		commands:   commands,
+		pushConfig: pushConfig,
 		counters: counters{
 			success: deliveryMetrics{
 				email: "successful_deliveries_email",
 				sms:   "successful_deliveries_sms",
 				json:  "successful_deliveries_json",
+				push:  "successful_deliveries_push",
 			},
 			failed: deliveryMetrics{
 				email: "failed_deliveries_email",
 				sms:   "failed_deliveries_sms",
 				json:  "failed_deliveries_json",
+				push:  "failed_deliveries_push",
 			},
 		},
 	}
+	if pushConfig.Stub {
+		c.pushStub = new(push.Stub)
+	}
 	registerCounter(c.counters.success.email, "Successfully delivered emails")
 	registerCounter(c.counters.failed.email, "Failed email deliveries")
 	registerCounter(c.counters.success.sms, "Successfully delivered SMS")
 	registerCounter(c.counters.failed.sms, "Failed SMS deliveries")
 	registerCounter(c.counters.success.json, "Successfully delivered JSON messages")
 	registerCounter(c.counters.failed.json, "Failed JSON message deliveries")
+	registerCounter(c.counters.success.push, "Successfully delivered push messages")
+	registerCounter(c.counters.failed.push, "Failed push message deliveries")
 	return c
 }
 
@@ -62,36 +79,36 @@ func registerCounter(counter, desc string) {
 	logging.WithFields("metric", counter).OnError(err).Panic("unable to register counter")
 }
 
-func (c *channels) Email(ctx context.Context) (*senders.Chain, *smtp.Config, error) {
-	smtpCfg, err := c.q.GetSMTPConfig(ctx)
+func (c *channels) Email(ctx context.Context, resourceOwner string) (*senders.Chain, *email.Config, error) {
+	emailCfg, err := c.q.GetActiveEmailConfig(ctx, resourceOwner)
 	if err != nil {
 		return nil, nil, err
 	}
 	chain, err := senders.EmailChannels(
 		ctx,
-		smtpCfg,
+		emailCfg,
 		c.q.GetFileSystemProvider,
 		c.q.GetLogProvider,
 		c.counters.success.email,
 		c.counters.failed.email,
 	)
-	return chain, smtpCfg, err
+	return chain, emailCfg, err
 }
 
-func (c *channels) SMS(ctx context.Context) (*senders.Chain, *twilio.Config, error) {
-	twilioCfg, err := c.q.GetTwilioConfig(ctx)
+func (c *channels) SMS(ctx context.Context, resourceOwner string) (*senders.Chain, *sms.Config, error) {
+	smsCfg, err := c.q.GetActiveSMSConfig(ctx, resourceOwner)
 	if err != nil {
 		return nil, nil, err
 	}
 	chain, err := senders.SMSChannels(
 		ctx,
-		twilioCfg,
+		smsCfg,
 		c.q.GetFileSystemProvider,
 		c.q.GetLogProvider,
 		c.counters.success.sms,
 		c.counters.failed.sms,
 	)
-	return chain, twilioCfg, err
+	return chain, smsCfg, err
 }
 
 func (c *channels) Webhook(ctx context.Context, cfg webhook.Config) (*senders.Chain, error) {
@@ -104,3 +121,21 @@ func (c *channels) Webhook(ctx context.Context, cfg webhook.Config) (*senders.Ch
 		c.counters.failed.json,
 	)
 }
+
+func (c *channels) Push(ctx context.Context, platform domain.PushPlatform) (*senders.Chain, error) {
+	return senders.PushChannels(
+		ctx,
+		c.pushConfig,
+		c.pushStub,
+		platform,
+		c.q.GetFileSystemProvider,
+		c.q.GetLogProvider,
+		c.counters.success.push,
+		c.counters.failed.push,
+	)
+}
+
+func (c *channels) RecordDelivery(ctx context.Context, user *query.NotifyUser, delivery *domain.NotificationDelivery, triggeringEvent eventstore.Event, sendErr error) {
+	err := c.commands.RecordNotificationDelivery(ctx, user.ID, user.ResourceOwner, delivery, triggeringEvent.Type(), c.q.AuditLogRetention(ctx), sendErr)
+	logging.WithFields("user", user.ID, "event", triggeringEvent.Type()).OnError(err).Error("unable to record notification delivery")
+}
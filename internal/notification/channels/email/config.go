@@ -0,0 +1,15 @@
+package email
+
+import (
+	"github.com/zitadel/zitadel/internal/notification/channels/smtp"
+	"github.com/zitadel/zitadel/internal/notification/channels/webhook"
+)
+
+// Config is the configuration of the active email provider,
+// exactly one of SMTPConfig and HTTPConfig is set
+type Config struct {
+	// ProviderID is the id of the provider config, which is recorded in the delivery history of the users
+	ProviderID string
+	SMTPConfig *smtp.Config
+	HTTPConfig *webhook.Config
+}
@@ -0,0 +1,160 @@
+package push
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/notification/channels"
+	"github.com/zitadel/zitadel/internal/notification/messages"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	apnsEndpoint        = "https://api.push.apple.com"
+	apnsSandboxEndpoint = "https://api.sandbox.push.apple.com"
+	// apnsTokenLifetime is below the hour APNs accepts a provider token,
+	// but above the 20 minutes it may be refreshed at most
+	apnsTokenLifetime = 50 * time.Minute
+)
+
+type apnsPayload struct {
+	APS  apnsAPS           `json:"aps"`
+	Data map[string]string `json:"data,omitempty"`
+}
+
+type apnsAPS struct {
+	Alert apnsAlert `json:"alert"`
+}
+
+type apnsAlert struct {
+	Title string `json:"title"`
+	Body  string `json:"body"`
+}
+
+// apnsToken creates and caches the provider token used to authenticate at APNs
+type apnsToken struct {
+	signer   jose.Signer
+	teamID   string
+	mu       sync.Mutex
+	token    string
+	issuedAt time.Time
+}
+
+func (t *apnsToken) get() (string, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.token != "" && time.Since(t.issuedAt) < apnsTokenLifetime {
+		return t.token, nil
+	}
+	issuedAt := time.Now()
+	claims, err := json.Marshal(map[string]interface{}{
+		"iss": t.teamID,
+		"iat": issuedAt.Unix(),
+	})
+	if err != nil {
+		return "", err
+	}
+	signed, err := t.signer.Sign(claims)
+	if err != nil {
+		return "", err
+	}
+	t.token, err = signed.CompactSerialize()
+	if err != nil {
+		return "", err
+	}
+	t.issuedAt = issuedAt
+	return t.token, nil
+}
+
+func InitAPNsChannel(ctx context.Context, cfg APNsConfig) (channels.NotificationChannel, error) {
+	if !cfg.IsConfigured() {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "PUSH-Aq3nc", "Errors.Notification.Push.NotConfigured")
+	}
+	signer, err := apnsSigner(cfg.PrivateKey, cfg.KeyID)
+	if err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "PUSH-Aq4ky", "Errors.Notification.Push.InvalidConfig")
+	}
+	token := &apnsToken{signer: signer, teamID: cfg.TeamID}
+	endpoint := cfg.Endpoint
+	if endpoint == "" {
+		endpoint = apnsEndpoint
+		if cfg.Sandbox {
+			endpoint = apnsSandboxEndpoint
+		}
+	}
+
+	logging.Debug("successfully initialized apns push channel")
+	return channels.HandleMessageFunc(func(message channels.Message) error {
+		msg, ok := message.(*messages.Push)
+		if !ok {
+			return zerrors.ThrowInternal(nil, "PUSH-Aq5ms", "message is not a push message")
+		}
+		payload, err := json.Marshal(&apnsPayload{
+			APS: apnsAPS{
+				Alert: apnsAlert{
+					Title: msg.Title,
+					Body:  msg.Body,
+				},
+			},
+			Data: msg.Data,
+		})
+		if err != nil {
+			return err
+		}
+		bearer, err := token.get()
+		if err != nil {
+			return err
+		}
+		requestCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		defer cancel()
+		req, err := http.NewRequestWithContext(requestCtx, http.MethodPost, endpoint+"/3/device/"+msg.PushToken, bytes.NewReader(payload))
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "bearer "+bearer)
+		req.Header.Set("apns-topic", cfg.BundleID)
+		req.Header.Set("apns-push-type", "alert")
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return err
+		}
+		if err = resp.Body.Close(); err != nil {
+			return err
+		}
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			return zerrors.ThrowUnknown(fmt.Errorf("apns returned %s", resp.Status), "PUSH-Aq6st", "push notification couldn't be delivered")
+		}
+		msg.ProviderMessageID = resp.Header.Get("apns-id")
+		logging.WithFields("apns_id", msg.ProviderMessageID).Debug("apns push notification sent")
+		return nil
+	}), nil
+}
+
+func apnsSigner(privateKey, keyID string) (jose.Signer, error) {
+	block, _ := pem.Decode([]byte(privateKey))
+	if block == nil {
+		return nil, zerrors.ThrowInvalidArgument(nil, "PUSH-Aq7pm", "private key is not PEM encoded")
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	return jose.NewSigner(
+		jose.SigningKey{
+			Algorithm: jose.ES256,
+			Key:       &jose.JSONWebKey{Key: key, KeyID: keyID},
+		},
+		(&jose.SignerOptions{}).WithType("JWT"),
+	)
+}
@@ -0,0 +1,60 @@
+package push
+
+import (
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+// Config defines the providers the push notifications are delivered through.
+// Devices of a platform without a configured provider can't be challenged.
+type Config struct {
+	FCM  FCMConfig
+	APNs APNsConfig
+	// Stub records and logs the messages instead of delivering them and must only be used for tests
+	Stub bool
+}
+
+// IsConfigured returns true if the messages for the platform can be delivered
+func (c *Config) IsConfigured(platform domain.PushPlatform) bool {
+	if c.Stub {
+		return true
+	}
+	switch platform {
+	case domain.PushPlatformFCM:
+		return c.FCM.IsConfigured()
+	case domain.PushPlatformAPNs:
+		return c.APNs.IsConfigured()
+	case domain.PushPlatformUnspecified:
+		return false
+	}
+	return false
+}
+
+// FCMConfig configures the Firebase Cloud Messaging HTTP v1 API
+type FCMConfig struct {
+	ProjectID string
+	// ServiceAccountKey is the JSON key of the service account used to call the API
+	ServiceAccountKey string
+	// Endpoint overwrites the default API url
+	Endpoint string
+}
+
+func (c *FCMConfig) IsConfigured() bool {
+	return c.ProjectID != "" && c.ServiceAccountKey != ""
+}
+
+// APNsConfig configures the Apple Push Notification service using token based authentication
+type APNsConfig struct {
+	TeamID   string
+	KeyID    string
+	BundleID string
+	// PrivateKey is the PEM encoded (.p8) key used to sign the provider tokens
+	PrivateKey string
+	// Sandbox delivers the messages through the development environment
+	Sandbox bool
+	// Endpoint overwrites the default API url
+	Endpoint string
+}
+
+func (c *APNsConfig) IsConfigured() bool {
+	return c.TeamID != "" && c.KeyID != "" && c.BundleID != "" && c.PrivateKey != ""
+}
@@ -0,0 +1,92 @@
+package push
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/zitadel/logging"
+	"golang.org/x/oauth2/google"
+
+	"github.com/zitadel/zitadel/internal/notification/channels"
+	"github.com/zitadel/zitadel/internal/notification/messages"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	fcmScope       = "https://www.googleapis.com/auth/firebase.messaging"
+	fcmEndpointFmt = "https://fcm.googleapis.com/v1/projects/%s/messages:send"
+)
+
+type fcmRequest struct {
+	Message fcmMessage `json:"message"`
+}
+
+type fcmMessage struct {
+	Token        string            `json:"token"`
+	Notification fcmNotification   `json:"notification"`
+	Data         map[string]string `json:"data,omitempty"`
+}
+
+type fcmNotification struct {
+	Title string `json:"title"`
+	Body  string `json:"body"`
+}
+
+func InitFCMChannel(ctx context.Context, cfg FCMConfig) (channels.NotificationChannel, error) {
+	if !cfg.IsConfigured() {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "PUSH-Fq3nc", "Errors.Notification.Push.NotConfigured")
+	}
+	jwtConfig, err := google.JWTConfigFromJSON([]byte(cfg.ServiceAccountKey), fcmScope)
+	if err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "PUSH-Fq4ky", "Errors.Notification.Push.InvalidConfig")
+	}
+	endpoint := cfg.Endpoint
+	if endpoint == "" {
+		endpoint = fmt.Sprintf(fcmEndpointFmt, cfg.ProjectID)
+	}
+	client := jwtConfig.Client(ctx)
+
+	logging.Debug("successfully initialized fcm push channel")
+	return channels.HandleMessageFunc(func(message channels.Message) error {
+		msg, ok := message.(*messages.Push)
+		if !ok {
+			return zerrors.ThrowInternal(nil, "PUSH-Fq5ms", "message is not a push message")
+		}
+		payload, err := json.Marshal(&fcmRequest{
+			Message: fcmMessage{
+				Token: msg.PushToken,
+				Notification: fcmNotification{
+					Title: msg.Title,
+					Body:  msg.Body,
+				},
+				Data: msg.Data,
+			},
+		})
+		if err != nil {
+			return err
+		}
+		requestCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		defer cancel()
+		req, err := http.NewRequestWithContext(requestCtx, http.MethodPost, endpoint, bytes.NewReader(payload))
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := client.Do(req)
+		if err != nil {
+			return err
+		}
+		if err = resp.Body.Close(); err != nil {
+			return err
+		}
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			return zerrors.ThrowUnknown(fmt.Errorf("fcm returned %s", resp.Status), "PUSH-Fq6st", "push notification couldn't be delivered")
+		}
+		logging.Debug("fcm push notification sent")
+		return nil
+	}), nil
+}
@@ -0,0 +1,39 @@
+package push
+
+import (
+	"sync"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/notification/channels"
+	"github.com/zitadel/zitadel/internal/notification/messages"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var _ channels.NotificationChannel = (*Stub)(nil)
+
+// Stub records and logs the push messages instead of delivering them.
+// It allows testing the push approval without FCM or APNs.
+type Stub struct {
+	mu       sync.Mutex
+	messages []*messages.Push
+}
+
+func (s *Stub) HandleMessage(message channels.Message) error {
+	msg, ok := message.(*messages.Push)
+	if !ok {
+		return zerrors.ThrowInternal(nil, "PUSH-Sq1ms", "message is not a push message")
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.messages = append(s.messages, msg)
+	logging.WithFields("platform", msg.Platform, "token", msg.PushToken, "data", msg.Data).Info("push notification stubbed")
+	return nil
+}
+
+// Messages returns all messages recorded by the stub
+func (s *Stub) Messages() []*messages.Push {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*messages.Push(nil), s.messages...)
+}
@@ -0,0 +1,15 @@
+package sms
+
+import (
+	"github.com/zitadel/zitadel/internal/notification/channels/twilio"
+	"github.com/zitadel/zitadel/internal/notification/channels/webhook"
+)
+
+// Config is the configuration of the active SMS provider,
+// exactly one of TwilioConfig and HTTPConfig is set
+type Config struct {
+	// ProviderID is the id of the provider config, which is recorded in the delivery history of the users
+	ProviderID   string
+	TwilioConfig *twilio.Config
+	HTTPConfig   *webhook.Config
+}
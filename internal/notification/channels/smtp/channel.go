@@ -8,6 +8,7 @@ import (
 
 	"github.com/zitadel/logging"
 
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/notification/channels"
 	"github.com/zitadel/zitadel/internal/notification/messages"
 	"github.com/zitadel/zitadel/internal/zerrors"
@@ -20,6 +21,8 @@ type Email struct {
 	senderAddress  string
 	senderName     string
 	replyToAddress string
+	dkim           *DKIM
+	headers        map[string]string
 }
 
 func InitChannel(cfg *Config) (*Email, error) {
@@ -34,6 +37,8 @@ func InitChannel(cfg *Config) (*Email, error) {
 		senderName:     cfg.FromName,
 		senderAddress:  cfg.From,
 		replyToAddress: cfg.ReplyToAddress,
+		dkim:           cfg.DKIM,
+		headers:        cfg.Headers,
 	}, nil
 }
 
@@ -50,6 +55,9 @@ func (email *Email) HandleMessage(message channels.Message) error {
 	emailMsg.SenderEmail = email.senderAddress
 	emailMsg.SenderName = email.senderName
 	emailMsg.ReplyToAddress = email.replyToAddress
+	if !domain.IsCriticalMessageType(emailMsg.TemplateType) {
+		emailMsg.Headers = email.headers
+	}
 	// To && From
 	if err := email.smtpClient.Mail(emailMsg.SenderEmail); err != nil {
 		return zerrors.ThrowInternal(err, "EMAIL-s3is3", "Errors.SMTP.CouldNotSetSender")
@@ -66,12 +74,12 @@ func (email *Email) HandleMessage(message channels.Message) error {
 		return err
 	}
 
-	content, err := emailMsg.GetContent()
+	content, err := signedContent(emailMsg, email.dkim)
 	if err != nil {
 		return err
 	}
 
-	_, err = w.Write([]byte(content))
+	_, err = w.Write(content)
 	if err != nil {
 		return err
 	}
@@ -84,6 +92,18 @@ func (email *Email) HandleMessage(message channels.Message) error {
 	return email.smtpClient.Quit()
 }
 
+// signedContent returns the content of the message, signed if DKIM is configured
+func signedContent(message *messages.Email, dkim *DKIM) ([]byte, error) {
+	content, err := message.GetContent()
+	if err != nil {
+		return nil, err
+	}
+	if dkim == nil {
+		return []byte(content), nil
+	}
+	return dkim.Sign([]byte(content))
+}
+
 func (smtpConfig SMTP) connectToSMTP(tlsRequired bool) (client *smtp.Client, err error) {
 	host, _, err := net.SplitHostPort(smtpConfig.Host)
 	if err != nil {
@@ -190,11 +210,11 @@ func TestConfiguration(cfg *Config, testEmail string) error {
 	}
 
 	// Send content
-	content, err := message.GetContent()
+	content, err := signedContent(message, cfg.DKIM)
 	if err != nil {
 		return err
 	}
-	_, err = w.Write([]byte(content))
+	_, err = w.Write(content)
 	if err != nil {
 		return err
 	}
@@ -7,6 +7,10 @@ type Config struct {
 	From           string
 	FromName       string
 	ReplyToAddress string
+	// DKIM signs the outgoing messages if set
+	DKIM *DKIM
+	// Headers are added to the non-critical messages, e.g. List-Unsubscribe
+	Headers map[string]string
 }
 
 type SMTP struct {
@@ -18,3 +22,9 @@ type SMTP struct {
 func (smtp *SMTP) HasAuth() bool {
 	return smtp.User != "" && smtp.Password != ""
 }
+
+type DKIM struct {
+	Domain     string
+	Selector   string
+	PrivateKey string
+}
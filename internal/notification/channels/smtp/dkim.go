@@ -0,0 +1,166 @@
+package smtp
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const lineBreak = "\r\n"
+
+// dkimSignedHeaders are the headers included in the signature if they are present in the message
+var dkimSignedHeaders = []string{
+	"From",
+	"Reply-To",
+	"To",
+	"Cc",
+	"Subject",
+	"Date",
+	"Message-ID",
+	"MIME-Version",
+	"Content-Type",
+	"List-Unsubscribe",
+	"List-Unsubscribe-Post",
+}
+
+var whitespaceRgx = regexp.MustCompile(`[ \t]+`)
+
+// Validate checks that all attributes are set and the private key can be used for signing.
+func (d *DKIM) Validate() error {
+	if d.Domain == "" || d.Selector == "" {
+		return zerrors.ThrowInvalidArgument(nil, "EMAIL-Dk2va", "Errors.SMTPConfig.DKIMInvalid")
+	}
+	_, _, err := d.signer()
+	return err
+}
+
+// Sign prepends a DKIM-Signature header (RFC 6376) to the message.
+// Header and body are canonicalized using the relaxed algorithm.
+func (d *DKIM) Sign(message []byte) ([]byte, error) {
+	signer, algorithm, err := d.signer()
+	if err != nil {
+		return nil, err
+	}
+	header, body, found := bytes.Cut(message, []byte(lineBreak+lineBreak))
+	if !found {
+		return nil, zerrors.ThrowInternal(nil, "EMAIL-Dk5bd", "Errors.SMTP.CouldNotSign")
+	}
+	fields := splitHeaderFields(string(header) + lineBreak)
+
+	bodyHash := sha256.Sum256([]byte(relaxedBody(string(body))))
+	names := make([]string, 0, len(dkimSignedHeaders))
+	hash := sha256.New()
+	for _, name := range dkimSignedHeaders {
+		field, ok := lastHeaderField(fields, name)
+		if !ok {
+			continue
+		}
+		names = append(names, strings.ToLower(name))
+		hash.Write([]byte(relaxedHeader(field) + lineBreak))
+	}
+	value := fmt.Sprintf("v=1; a=%s; c=relaxed/relaxed; d=%s; s=%s;"+lineBreak+" t=%d; h=%s;"+lineBreak+" bh=%s;"+lineBreak+" b=",
+		algorithm,
+		d.Domain,
+		d.Selector,
+		time.Now().Unix(),
+		strings.Join(names, ":"),
+		base64.StdEncoding.EncodeToString(bodyHash[:]),
+	)
+	hash.Write([]byte(relaxedHeader("DKIM-Signature: " + value)))
+
+	opts := crypto.Hash(0)
+	if algorithm == "rsa-sha256" {
+		opts = crypto.SHA256
+	}
+	signature, err := signer.Sign(rand.Reader, hash.Sum(nil), opts)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "EMAIL-Dk6sg", "Errors.SMTP.CouldNotSign")
+	}
+	signed := "DKIM-Signature: " + value + base64.StdEncoding.EncodeToString(signature) + lineBreak
+	return append([]byte(signed), message...), nil
+}
+
+// signer parses the PEM encoded private key, which is either an RSA (PKCS #1 or #8) or an Ed25519 (PKCS #8) key.
+func (d *DKIM) signer() (crypto.Signer, string, error) {
+	block, _ := pem.Decode([]byte(d.PrivateKey))
+	if block == nil {
+		return nil, "", zerrors.ThrowInvalidArgument(nil, "EMAIL-Dk3pm", "Errors.SMTPConfig.DKIMInvalid")
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, "rsa-sha256", nil
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, "", zerrors.ThrowInvalidArgument(err, "EMAIL-Dk4pk", "Errors.SMTPConfig.DKIMInvalid")
+	}
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		return k, "rsa-sha256", nil
+	case ed25519.PrivateKey:
+		return k, "ed25519-sha256", nil
+	default:
+		return nil, "", zerrors.ThrowInvalidArgument(nil, "EMAIL-Dk7kt", "Errors.SMTPConfig.DKIMInvalid")
+	}
+}
+
+// splitHeaderFields returns the header fields including their folded continuation lines
+func splitHeaderFields(header string) []string {
+	fields := make([]string, 0)
+	for _, line := range strings.SplitAfter(header, lineBreak) {
+		if line == "" {
+			continue
+		}
+		if (line[0] == ' ' || line[0] == '\t') && len(fields) > 0 {
+			fields[len(fields)-1] += line
+			continue
+		}
+		fields = append(fields, line)
+	}
+	return fields
+}
+
+func lastHeaderField(fields []string, name string) (string, bool) {
+	for i := len(fields) - 1; i >= 0; i-- {
+		key, _, found := strings.Cut(fields[i], ":")
+		if found && strings.EqualFold(strings.TrimSpace(key), name) {
+			return fields[i], true
+		}
+	}
+	return "", false
+}
+
+// relaxedHeader canonicalizes the header field as described in RFC 6376 section 3.4.2
+// without the trailing line break
+func relaxedHeader(field string) string {
+	key, value, _ := strings.Cut(field, ":")
+	value = strings.ReplaceAll(value, lineBreak, "")
+	value = whitespaceRgx.ReplaceAllString(value, " ")
+	return strings.ToLower(strings.TrimSpace(key)) + ":" + strings.TrimSpace(value)
+}
+
+// relaxedBody canonicalizes the body as described in RFC 6376 section 3.4.4
+func relaxedBody(body string) string {
+	lines := strings.Split(body, lineBreak)
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(whitespaceRgx.ReplaceAllString(line, " "), " ")
+	}
+	for len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	if len(lines) == 0 {
+		return ""
+	}
+	return strings.Join(lines, lineBreak) + lineBreak
+}
@@ -0,0 +1,168 @@
+package smtp
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const testMessage = "From: Joe SixPack <joe@football.example.com>\r\n" +
+	"To: Suzie Q <suzie@shopping.example.net>\r\n" +
+	"Subject: Is dinner ready?\r\n" +
+	"X-Custom: not signed\r\n" +
+	"\r\n" +
+	"Hi.\r\n" +
+	"\r\n" +
+	"We lost the game. Are you hungry yet?\r\n" +
+	"\r\n" +
+	"Joe.\r\n"
+
+func Test_relaxedHeader(t *testing.T) {
+	assert.Equal(t, "a:X", relaxedHeader("A: X\r\n"))
+	assert.Equal(t, "b:Y Z", relaxedHeader("B : Y\t\r\n\tZ  \r\n"))
+}
+
+func Test_relaxedBody(t *testing.T) {
+	assert.Equal(t, " C\r\nD E\r\n", relaxedBody(" C \r\nD \t E\r\n\r\n\r\n"))
+	assert.Equal(t, "", relaxedBody("\r\n\r\n"))
+}
+
+func TestDKIM_Sign(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	rsaPEM := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(rsaKey)})
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	edDER, err := x509.MarshalPKCS8PrivateKey(edKey)
+	require.NoError(t, err)
+	edPEM := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: edDER})
+
+	tests := []struct {
+		name      string
+		key       string
+		algorithm string
+		verify    func(t *testing.T, digest, signature []byte)
+	}{
+		{
+			name:      "rsa",
+			key:       string(rsaPEM),
+			algorithm: "rsa-sha256",
+			verify: func(t *testing.T, digest, signature []byte) {
+				assert.NoError(t, rsa.VerifyPKCS1v15(&rsaKey.PublicKey, crypto.SHA256, digest, signature))
+			},
+		},
+		{
+			name:      "ed25519",
+			key:       string(edPEM),
+			algorithm: "ed25519-sha256",
+			verify: func(t *testing.T, digest, signature []byte) {
+				assert.True(t, ed25519.Verify(edKey.Public().(ed25519.PublicKey), digest, signature))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dkim := &DKIM{Domain: "example.com", Selector: "zitadel", PrivateKey: tt.key}
+			signed, err := dkim.Sign([]byte(testMessage))
+			require.NoError(t, err)
+			require.True(t, strings.HasSuffix(string(signed), testMessage))
+
+			fields := splitHeaderFields(strings.TrimSuffix(string(signed), testMessage))
+			require.Len(t, fields, 1)
+			value, signature, found := strings.Cut(fields[0], " b=")
+			require.True(t, found)
+			assert.Contains(t, value, "a="+tt.algorithm+";")
+			assert.Contains(t, value, "d=example.com; s=zitadel;")
+			assert.Contains(t, value, "h=from:to:subject;")
+			bodyHash := sha256.Sum256([]byte(relaxedBody("Hi.\r\n\r\nWe lost the game. Are you hungry yet?\r\n\r\nJoe.\r\n")))
+			assert.Contains(t, value, "bh="+base64.StdEncoding.EncodeToString(bodyHash[:])+";")
+
+			hash := sha256.New()
+			hash.Write([]byte("from:Joe SixPack <joe@football.example.com>\r\n"))
+			hash.Write([]byte("to:Suzie Q <suzie@shopping.example.net>\r\n"))
+			hash.Write([]byte("subject:Is dinner ready?\r\n"))
+			hash.Write([]byte(relaxedHeader(value + " b=")))
+			decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(signature))
+			require.NoError(t, err)
+			tt.verify(t, hash.Sum(nil), decoded)
+		})
+	}
+}
+
+func TestDKIM_Validate(t *testing.T) {
+	tests := []struct {
+		name string
+		dkim *DKIM
+	}{
+		{
+			name: "domain missing",
+			dkim: &DKIM{Selector: "zitadel", PrivateKey: "key"},
+		},
+		{
+			name: "selector missing",
+			dkim: &DKIM{Domain: "example.com", PrivateKey: "key"},
+		},
+		{
+			name: "private key invalid",
+			dkim: &DKIM{Domain: "example.com", Selector: "zitadel", PrivateKey: "key"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.True(t, zerrors.IsErrorInvalidArgument(tt.dkim.Validate()))
+		})
+	}
+}
+
+func TestValidateHeaders(t *testing.T) {
+	tests := []struct {
+		name    string
+		headers map[string]string
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			headers: map[string]string{
+				"List-Unsubscribe":      "<https://example.com/unsubscribe>",
+				"List-Unsubscribe-Post": "List-Unsubscribe=One-Click",
+			},
+		},
+		{
+			name:    "reserved",
+			headers: map[string]string{"from": "someone@example.com"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid name",
+			headers: map[string]string{"List Unsubscribe": "value"},
+			wantErr: true,
+		},
+		{
+			name:    "line break in value",
+			headers: map[string]string{"X-Header": "value\r\nBcc: someone@example.com"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateHeaders(tt.headers)
+			if tt.wantErr {
+				assert.True(t, zerrors.IsErrorInvalidArgument(err))
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
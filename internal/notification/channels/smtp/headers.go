@@ -0,0 +1,44 @@
+package smtp
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var headerNameRgx = regexp.MustCompile(`^[!-9;-~]+$`)
+
+// reservedHeaders are set by the channel itself and can't be overwritten by the configuration
+var reservedHeaders = []string{
+	"from",
+	"sender",
+	"to",
+	"cc",
+	"bcc",
+	"reply-to",
+	"return-path",
+	"subject",
+	"date",
+	"message-id",
+	"mime-version",
+	"content-type",
+	"content-transfer-encoding",
+	"dkim-signature",
+}
+
+// ValidateHeaders checks that the additional headers are valid header fields
+// and don't overwrite one of the headers set by the channel.
+func ValidateHeaders(headers map[string]string) error {
+	for name, value := range headers {
+		if !headerNameRgx.MatchString(name) || value == "" || strings.ContainsAny(value, "\r\n") {
+			return zerrors.ThrowInvalidArgument(nil, "EMAIL-Hd2va", "Errors.SMTPConfig.HeaderInvalid")
+		}
+		for _, reserved := range reservedHeaders {
+			if strings.EqualFold(name, reserved) {
+				return zerrors.ThrowInvalidArgument(nil, "EMAIL-Hd3rs", "Errors.SMTPConfig.HeaderInvalid")
+			}
+		}
+	}
+	return nil
+}
@@ -28,6 +28,7 @@ func InitChannel(config Config) channels.NotificationChannel {
 			return zerrors.ThrowInternal(err, "TWILI-osk3S", "could not send message")
 		}
 		logging.WithFields("message_sid", m.Sid, "status", m.Status).Debug("sms sent")
+		twilioMsg.ProviderMessageID = m.Sid
 		return nil
 	})
 }
@@ -36,9 +36,12 @@ func InitChannel(ctx context.Context, cfg Config) (channels.NotificationChannel,
 			return err
 		}
 		if cfg.Headers != nil {
-			req.Header = cfg.Headers
+			req.Header = cfg.Headers.Clone()
 		}
 		req.Header.Set("Content-Type", "application/json")
+		if cfg.SigningKey != "" {
+			req.Header.Set(SignatureHeader, ComputeSignatureHeader(time.Now(), []byte(payload), cfg.SigningKey))
+		}
 		resp, err := http.DefaultClient.Do(req)
 		if err != nil {
 			return err
@@ -53,3 +56,32 @@ func InitChannel(ctx context.Context, cfg Config) (channels.NotificationChannel,
 		return nil
 	}), nil
 }
+
+// InitProviderChannel initializes a channel for generic HTTP email and SMS providers.
+// Email and SMS messages are converted to a [messages.Provider] and sent as JSON.
+func InitProviderChannel(ctx context.Context, cfg Config) (channels.NotificationChannel, error) {
+	channel, err := InitChannel(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	return channels.HandleMessageFunc(func(message channels.Message) error {
+		payload, err := messages.ProviderFromMessage(message)
+		if err != nil {
+			return err
+		}
+		return channel.HandleMessage(&messages.JSON{
+			Serializable:    payload,
+			TriggeringEvent: message.GetTriggeringEvent(),
+		})
+	}), nil
+}
+
+// TestProviderConfiguration sends the message to the provider
+// to check the configuration before it's saved or activated.
+func TestProviderConfiguration(ctx context.Context, cfg Config, message channels.Message) error {
+	channel, err := InitProviderChannel(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	return channel.HandleMessage(message)
+}
@@ -9,6 +9,9 @@ type Config struct {
 	CallURL string
 	Method  string
 	Headers http.Header
+	// SigningKey is optional, if set, the payload is signed and the signature
+	// is sent in the SignatureHeader
+	SigningKey string
 }
 
 func (w *Config) Validate() error {
@@ -0,0 +1,38 @@
+package webhook
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// EncryptHeaders encrypts the headers, as they might contain credentials of the provider.
+// If no headers are passed, nil is returned.
+func EncryptHeaders(headers http.Header, alg crypto.EncryptionAlgorithm) (*crypto.CryptoValue, error) {
+	if len(headers) == 0 {
+		return nil, nil
+	}
+	value, err := json.Marshal(headers)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "WEBH-3mXsd", "Errors.Internal")
+	}
+	return crypto.Encrypt(value, alg)
+}
+
+// DecryptHeaders decrypts the headers encrypted by [EncryptHeaders].
+func DecryptHeaders(value *crypto.CryptoValue, alg crypto.EncryptionAlgorithm) (http.Header, error) {
+	if value == nil {
+		return nil, nil
+	}
+	decrypted, err := crypto.Decrypt(value, alg)
+	if err != nil {
+		return nil, err
+	}
+	headers := make(http.Header)
+	if err := json.Unmarshal(decrypted, &headers); err != nil {
+		return nil, zerrors.ThrowInternal(err, "WEBH-Bd2lq", "Errors.Internal")
+	}
+	return headers, nil
+}
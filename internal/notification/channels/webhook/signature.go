@@ -0,0 +1,27 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"time"
+)
+
+const SignatureHeader = "ZITADEL-Signature"
+
+// ComputeSignatureHeader returns the value of the SignatureHeader in the form `t=<timestamp>,v1=<signature>`.
+// The signature is the hex encoded HMAC-SHA256 of `<timestamp>.<payload>` using the signing key,
+// so receivers can verify the origin of the request and reject replayed requests.
+func ComputeSignatureHeader(t time.Time, payload []byte, signingKey string) string {
+	timestamp := strconv.FormatInt(t.Unix(), 10)
+	return "t=" + timestamp + ",v1=" + computeSignature(timestamp, payload, signingKey)
+}
+
+func computeSignature(timestamp string, payload []byte, signingKey string) string {
+	mac := hmac.New(sha256.New, []byte(signingKey))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -2,8 +2,12 @@ package handlers
 
 import (
 	"context"
+	"time"
 
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/repository/milestone"
+	"github.com/zitadel/zitadel/internal/repository/notification"
 	"github.com/zitadel/zitadel/internal/repository/quota"
 )
 
@@ -15,10 +19,22 @@ type Commands interface {
 	HumanOTPEmailCodeSent(ctx context.Context, userID, resourceOwner string) error
 	OTPSMSSent(ctx context.Context, sessionID, resourceOwner string) error
 	OTPEmailSent(ctx context.Context, sessionID, resourceOwner string) error
+	PushChallengeSent(ctx context.Context, sessionID, resourceOwner string) error
 	UserDomainClaimedSent(ctx context.Context, orgID, userID string) error
 	HumanPasswordlessInitCodeSent(ctx context.Context, userID, resourceOwner, codeID string) error
 	PasswordChangeSent(ctx context.Context, orgID, userID string) error
+	UserDeactivationWarningSent(ctx context.Context, orgID, userID string) error
+	UserDeletionWarningSent(ctx context.Context, orgID, userID string) error
+	SecurityAlertSent(ctx context.Context, orgID, userID, alertType string, triggeringSequence uint64) error
+	SecurityAlertDelivered(ctx context.Context, orgID, userID, alertType string, triggeringSequence uint64, recipientID, targetID string) error
 	HumanPhoneVerificationCodeSent(ctx context.Context, orgID, userID string) error
+	OrgTestMessageSent(ctx context.Context, orgID string, requestSequence uint64) error
 	UsageNotificationSent(ctx context.Context, dueEvent *quota.NotificationDueEvent) error
 	MilestonePushed(ctx context.Context, msType milestone.Type, endpoints []string, primaryDomain string) error
+	RequestNotification(ctx context.Context, request *notification.Request) error
+	NotificationSent(ctx context.Context, id string, attempt uint16) error
+	NotificationRetryRequested(ctx context.Context, id string, attempt uint16, nextAttempt time.Time, sendErr error) error
+	NotificationCanceled(ctx context.Context, id, reason string) error
+	NotificationFailed(ctx context.Context, id string, attempt uint16, sendErr error) error
+	RecordNotificationDelivery(ctx context.Context, userID, resourceOwner string, delivery *domain.NotificationDelivery, triggeringEventType eventstore.EventType, retention time.Duration, sendErr error) error
 }
@@ -0,0 +1,85 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/notification/channels/email"
+	"github.com/zitadel/zitadel/internal/notification/channels/smtp"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// GetActiveEmailConfig reads the active email provider config of the organization
+// and falls back to the one of the instance if the organization has none.
+func (n *NotificationQueries) GetActiveEmailConfig(ctx context.Context, resourceOwner string) (*email.Config, error) {
+	config, err := n.activeSMTPConfig(ctx, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if config.HTTPConfig != nil {
+		httpConfig, err := httpProviderConfig(config.HTTPConfig, n.SMTPPasswordCrypto)
+		if err != nil {
+			return nil, err
+		}
+		return &email.Config{
+			ProviderID: config.ID,
+			HTTPConfig: httpConfig,
+		}, nil
+	}
+	password, err := crypto.DecryptString(config.Password, n.SMTPPasswordCrypto)
+	if err != nil {
+		return nil, err
+	}
+	dkim, err := smtpDKIMConfig(config.DKIM, n.SMTPPasswordCrypto)
+	if err != nil {
+		return nil, err
+	}
+	return &email.Config{
+		ProviderID: config.ID,
+		SMTPConfig: &smtp.Config{
+			Description:    config.Description,
+			From:           config.SenderAddress,
+			FromName:       config.SenderName,
+			ReplyToAddress: config.ReplyToAddress,
+			Tls:            config.TLS,
+			SMTP: smtp.SMTP{
+				Host:     config.Host,
+				User:     config.User,
+				Password: password,
+			},
+			DKIM:    dkim,
+			Headers: config.Headers,
+		},
+	}, nil
+}
+
+func smtpDKIMConfig(config *query.SMTPConfigDKIM, alg crypto.EncryptionAlgorithm) (*smtp.DKIM, error) {
+	if config == nil {
+		return nil, nil
+	}
+	privateKey, err := crypto.DecryptString(config.PrivateKey, alg)
+	if err != nil {
+		return nil, err
+	}
+	return &smtp.DKIM{
+		Domain:     config.Domain,
+		Selector:   config.Selector,
+		PrivateKey: privateKey,
+	}, nil
+}
+
+func (n *NotificationQueries) activeSMTPConfig(ctx context.Context, resourceOwner string) (*query.SMTPConfig, error) {
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	if resourceOwner != "" && resourceOwner != instanceID {
+		config, err := n.SMTPConfigActive(ctx, resourceOwner)
+		if err == nil {
+			return config, nil
+		}
+		if !zerrors.IsNotFound(err) {
+			return nil, err
+		}
+	}
+	return n.SMTPConfigActive(ctx, instanceID)
+}
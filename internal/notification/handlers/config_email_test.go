@@ -0,0 +1,128 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/notification/channels/webhook"
+	"github.com/zitadel/zitadel/internal/notification/handlers/mock"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestNotificationQueries_GetActiveEmailConfig(t *testing.T) {
+	tests := []struct {
+		name          string
+		resourceOwner string
+		expect        func(queries *mock.MockQueries)
+		want          *webhook.Config
+		wantErr       func(error) bool
+	}{
+		{
+			name:          "organization config",
+			resourceOwner: "org1",
+			expect: func(queries *mock.MockQueries) {
+				queries.EXPECT().SMTPConfigActive(gomock.Any(), "org1").Return(&query.SMTPConfig{
+					HTTPConfig: &query.HTTP{Endpoint: "https://org.example.com"},
+				}, nil)
+			},
+			want: &webhook.Config{CallURL: "https://org.example.com", Method: http.MethodPost},
+		},
+		{
+			name:          "no organization config, instance fallback",
+			resourceOwner: "org1",
+			expect: func(queries *mock.MockQueries) {
+				queries.EXPECT().SMTPConfigActive(gomock.Any(), "org1").Return(nil, zerrors.ThrowNotFound(nil, "id", "not found"))
+				queries.EXPECT().SMTPConfigActive(gomock.Any(), "instance").Return(&query.SMTPConfig{
+					HTTPConfig: &query.HTTP{Endpoint: "https://instance.example.com"},
+				}, nil)
+			},
+			want: &webhook.Config{CallURL: "https://instance.example.com", Method: http.MethodPost},
+		},
+		{
+			name:          "organization config error, no fallback",
+			resourceOwner: "org1",
+			expect: func(queries *mock.MockQueries) {
+				queries.EXPECT().SMTPConfigActive(gomock.Any(), "org1").Return(nil, zerrors.ThrowInternal(nil, "id", "internal"))
+			},
+			wantErr: zerrors.IsInternal,
+		},
+		{
+			name:          "instance resource owner",
+			resourceOwner: "instance",
+			expect: func(queries *mock.MockQueries) {
+				queries.EXPECT().SMTPConfigActive(gomock.Any(), "instance").Return(&query.SMTPConfig{
+					HTTPConfig: &query.HTTP{Endpoint: "https://instance.example.com"},
+				}, nil)
+			},
+			want: &webhook.Config{CallURL: "https://instance.example.com", Method: http.MethodPost},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			queries := mock.NewMockQueries(gomock.NewController(t))
+			tt.expect(queries)
+			n := &NotificationQueries{Queries: queries}
+			ctx := authz.WithInstanceID(context.Background(), "instance")
+
+			got, err := n.GetActiveEmailConfig(ctx, tt.resourceOwner)
+			if tt.wantErr != nil {
+				assert.True(t, tt.wantErr(err), "unexpected error: %v", err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got.HTTPConfig)
+		})
+	}
+}
+
+func TestNotificationQueries_GetActiveSMSConfig(t *testing.T) {
+	tests := []struct {
+		name          string
+		resourceOwner string
+		expect        func(queries *mock.MockQueries)
+		want          *webhook.Config
+	}{
+		{
+			name:          "organization config",
+			resourceOwner: "org1",
+			expect: func(queries *mock.MockQueries) {
+				queries.EXPECT().SMSProviderConfig(gomock.Any(), gomock.Any(), gomock.Any()).Return(&query.SMSConfig{
+					HTTPConfig: &query.HTTP{Endpoint: "https://org.example.com"},
+				}, nil)
+			},
+			want: &webhook.Config{CallURL: "https://org.example.com", Method: http.MethodPost},
+		},
+		{
+			name:          "no organization config, instance fallback",
+			resourceOwner: "org1",
+			expect: func(queries *mock.MockQueries) {
+				gomock.InOrder(
+					queries.EXPECT().SMSProviderConfig(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, zerrors.ThrowNotFound(nil, "id", "not found")),
+					queries.EXPECT().SMSProviderConfig(gomock.Any(), gomock.Any(), gomock.Any()).Return(&query.SMSConfig{
+						HTTPConfig: &query.HTTP{Endpoint: "https://instance.example.com"},
+					}, nil),
+				)
+			},
+			want: &webhook.Config{CallURL: "https://instance.example.com", Method: http.MethodPost},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			queries := mock.NewMockQueries(gomock.NewController(t))
+			tt.expect(queries)
+			n := &NotificationQueries{Queries: queries}
+			ctx := authz.WithInstanceID(context.Background(), "instance")
+
+			got, err := n.GetActiveSMSConfig(ctx, tt.resourceOwner)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got.HTTPConfig)
+		})
+	}
+}
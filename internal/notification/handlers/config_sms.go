@@ -0,0 +1,93 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/notification/channels/sms"
+	"github.com/zitadel/zitadel/internal/notification/channels/twilio"
+	"github.com/zitadel/zitadel/internal/notification/channels/webhook"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// GetActiveSMSConfig reads the active SMS provider config of the organization
+// and falls back to the one of the instance if the organization has none.
+func (n *NotificationQueries) GetActiveSMSConfig(ctx context.Context, resourceOwner string) (*sms.Config, error) {
+	config, err := n.activeSMSConfig(ctx, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if config.TwilioConfig != nil {
+		token, err := crypto.DecryptString(config.TwilioConfig.Token, n.SMSTokenCrypto)
+		if err != nil {
+			return nil, err
+		}
+		return &sms.Config{
+			ProviderID: config.ID,
+			TwilioConfig: &twilio.Config{
+				SID:          config.TwilioConfig.SID,
+				Token:        token,
+				SenderNumber: config.TwilioConfig.SenderNumber,
+			},
+		}, nil
+	}
+	if config.HTTPConfig != nil {
+		httpConfig, err := httpProviderConfig(config.HTTPConfig, n.SMSTokenCrypto)
+		if err != nil {
+			return nil, err
+		}
+		return &sms.Config{
+			ProviderID: config.ID,
+			HTTPConfig: httpConfig,
+		}, nil
+	}
+	return nil, zerrors.ThrowNotFound(nil, "HANDLER-8nfow", "Errors.SMSConfig.NotFound")
+}
+
+func (n *NotificationQueries) activeSMSConfig(ctx context.Context, resourceOwner string) (*query.SMSConfig, error) {
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	if resourceOwner != "" && resourceOwner != instanceID {
+		config, err := n.smsConfigActive(ctx, resourceOwner)
+		if err == nil {
+			return config, nil
+		}
+		if !zerrors.IsNotFound(err) {
+			return nil, err
+		}
+	}
+	return n.smsConfigActive(ctx, instanceID)
+}
+
+func (n *NotificationQueries) smsConfigActive(ctx context.Context, resourceOwner string) (*query.SMSConfig, error) {
+	active, err := query.NewSMSProviderStateQuery(domain.SMSConfigStateActive)
+	if err != nil {
+		return nil, err
+	}
+	owner, err := query.NewSMSProviderResourceOwnerQuery(resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	return n.SMSProviderConfig(ctx, active, owner)
+}
+
+func httpProviderConfig(config *query.HTTP, alg crypto.EncryptionAlgorithm) (_ *webhook.Config, err error) {
+	httpConfig := &webhook.Config{
+		CallURL: config.Endpoint,
+		Method:  http.MethodPost,
+	}
+	httpConfig.Headers, err = webhook.DecryptHeaders(config.Headers, alg)
+	if err != nil {
+		return nil, err
+	}
+	if config.SigningKey != nil {
+		httpConfig.SigningKey, err = crypto.DecryptString(config.SigningKey, alg)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return httpConfig, nil
+}
@@ -0,0 +1,25 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/notification/types"
+)
+
+// GetMailTemplates returns the templates the emails of the organization are rendered with:
+// the custom templates of the organization and the instance and the template of the mail template policy as fallback.
+func (n *NotificationQueries) GetMailTemplates(ctx context.Context, orgID string) (*types.MailTemplates, error) {
+	template, err := n.MailTemplateByOrg(ctx, orgID, false)
+	if err != nil {
+		return nil, err
+	}
+	custom, err := n.MessageTemplatesByOrg(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MailTemplates{
+		Default:         string(template.Template),
+		Custom:          custom,
+		DefaultLanguage: n.GetDefaultLanguage(ctx),
+	}, nil
+}
@@ -12,8 +12,12 @@ package mock
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
+	domain "github.com/zitadel/zitadel/internal/domain"
+	eventstore "github.com/zitadel/zitadel/internal/eventstore"
 	milestone "github.com/zitadel/zitadel/internal/repository/milestone"
+	notification "github.com/zitadel/zitadel/internal/repository/notification"
 	quota "github.com/zitadel/zitadel/internal/repository/quota"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -139,6 +143,62 @@ func (mr *MockCommandsMockRecorder) MilestonePushed(arg0, arg1, arg2, arg3 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MilestonePushed", reflect.TypeOf((*MockCommands)(nil).MilestonePushed), arg0, arg1, arg2, arg3)
 }
 
+// NotificationCanceled mocks base method.
+func (m *MockCommands) NotificationCanceled(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotificationCanceled", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NotificationCanceled indicates an expected call of NotificationCanceled.
+func (mr *MockCommandsMockRecorder) NotificationCanceled(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotificationCanceled", reflect.TypeOf((*MockCommands)(nil).NotificationCanceled), arg0, arg1, arg2)
+}
+
+// NotificationFailed mocks base method.
+func (m *MockCommands) NotificationFailed(arg0 context.Context, arg1 string, arg2 uint16, arg3 error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotificationFailed", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NotificationFailed indicates an expected call of NotificationFailed.
+func (mr *MockCommandsMockRecorder) NotificationFailed(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotificationFailed", reflect.TypeOf((*MockCommands)(nil).NotificationFailed), arg0, arg1, arg2, arg3)
+}
+
+// NotificationRetryRequested mocks base method.
+func (m *MockCommands) NotificationRetryRequested(arg0 context.Context, arg1 string, arg2 uint16, arg3 time.Time, arg4 error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotificationRetryRequested", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NotificationRetryRequested indicates an expected call of NotificationRetryRequested.
+func (mr *MockCommandsMockRecorder) NotificationRetryRequested(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotificationRetryRequested", reflect.TypeOf((*MockCommands)(nil).NotificationRetryRequested), arg0, arg1, arg2, arg3, arg4)
+}
+
+// NotificationSent mocks base method.
+func (m *MockCommands) NotificationSent(arg0 context.Context, arg1 string, arg2 uint16) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotificationSent", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NotificationSent indicates an expected call of NotificationSent.
+func (mr *MockCommandsMockRecorder) NotificationSent(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotificationSent", reflect.TypeOf((*MockCommands)(nil).NotificationSent), arg0, arg1, arg2)
+}
+
 // OTPEmailSent mocks base method.
 func (m *MockCommands) OTPEmailSent(arg0 context.Context, arg1, arg2 string) error {
 	m.ctrl.T.Helper()
@@ -167,6 +227,20 @@ func (mr *MockCommandsMockRecorder) OTPSMSSent(arg0, arg1, arg2 any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OTPSMSSent", reflect.TypeOf((*MockCommands)(nil).OTPSMSSent), arg0, arg1, arg2)
 }
 
+// OrgTestMessageSent mocks base method.
+func (m *MockCommands) OrgTestMessageSent(arg0 context.Context, arg1 string, arg2 uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrgTestMessageSent", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// OrgTestMessageSent indicates an expected call of OrgTestMessageSent.
+func (mr *MockCommandsMockRecorder) OrgTestMessageSent(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrgTestMessageSent", reflect.TypeOf((*MockCommands)(nil).OrgTestMessageSent), arg0, arg1, arg2)
+}
+
 // PasswordChangeSent mocks base method.
 func (m *MockCommands) PasswordChangeSent(arg0 context.Context, arg1, arg2 string) error {
 	m.ctrl.T.Helper()
@@ -195,6 +269,76 @@ func (mr *MockCommandsMockRecorder) PasswordCodeSent(arg0, arg1, arg2 any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PasswordCodeSent", reflect.TypeOf((*MockCommands)(nil).PasswordCodeSent), arg0, arg1, arg2)
 }
 
+// PushChallengeSent mocks base method.
+func (m *MockCommands) PushChallengeSent(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PushChallengeSent", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PushChallengeSent indicates an expected call of PushChallengeSent.
+func (mr *MockCommandsMockRecorder) PushChallengeSent(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PushChallengeSent", reflect.TypeOf((*MockCommands)(nil).PushChallengeSent), arg0, arg1, arg2)
+}
+
+// RecordNotificationDelivery mocks base method.
+func (m *MockCommands) RecordNotificationDelivery(arg0 context.Context, arg1, arg2 string, arg3 *domain.NotificationDelivery, arg4 eventstore.EventType, arg5 time.Duration, arg6 error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordNotificationDelivery", arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordNotificationDelivery indicates an expected call of RecordNotificationDelivery.
+func (mr *MockCommandsMockRecorder) RecordNotificationDelivery(arg0, arg1, arg2, arg3, arg4, arg5, arg6 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordNotificationDelivery", reflect.TypeOf((*MockCommands)(nil).RecordNotificationDelivery), arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+}
+
+// RequestNotification mocks base method.
+func (m *MockCommands) RequestNotification(arg0 context.Context, arg1 *notification.Request) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestNotification", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RequestNotification indicates an expected call of RequestNotification.
+func (mr *MockCommandsMockRecorder) RequestNotification(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestNotification", reflect.TypeOf((*MockCommands)(nil).RequestNotification), arg0, arg1)
+}
+
+// SecurityAlertDelivered mocks base method.
+func (m *MockCommands) SecurityAlertDelivered(arg0 context.Context, arg1, arg2, arg3 string, arg4 uint64, arg5, arg6 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SecurityAlertDelivered", arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SecurityAlertDelivered indicates an expected call of SecurityAlertDelivered.
+func (mr *MockCommandsMockRecorder) SecurityAlertDelivered(arg0, arg1, arg2, arg3, arg4, arg5, arg6 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SecurityAlertDelivered", reflect.TypeOf((*MockCommands)(nil).SecurityAlertDelivered), arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+}
+
+// SecurityAlertSent mocks base method.
+func (m *MockCommands) SecurityAlertSent(arg0 context.Context, arg1, arg2, arg3 string, arg4 uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SecurityAlertSent", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SecurityAlertSent indicates an expected call of SecurityAlertSent.
+func (mr *MockCommandsMockRecorder) SecurityAlertSent(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SecurityAlertSent", reflect.TypeOf((*MockCommands)(nil).SecurityAlertSent), arg0, arg1, arg2, arg3, arg4)
+}
+
 // UsageNotificationSent mocks base method.
 func (m *MockCommands) UsageNotificationSent(arg0 context.Context, arg1 *quota.NotificationDueEvent) error {
 	m.ctrl.T.Helper()
@@ -209,6 +353,34 @@ func (mr *MockCommandsMockRecorder) UsageNotificationSent(arg0, arg1 any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UsageNotificationSent", reflect.TypeOf((*MockCommands)(nil).UsageNotificationSent), arg0, arg1)
 }
 
+// UserDeactivationWarningSent mocks base method.
+func (m *MockCommands) UserDeactivationWarningSent(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserDeactivationWarningSent", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UserDeactivationWarningSent indicates an expected call of UserDeactivationWarningSent.
+func (mr *MockCommandsMockRecorder) UserDeactivationWarningSent(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserDeactivationWarningSent", reflect.TypeOf((*MockCommands)(nil).UserDeactivationWarningSent), arg0, arg1, arg2)
+}
+
+// UserDeletionWarningSent mocks base method.
+func (m *MockCommands) UserDeletionWarningSent(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserDeletionWarningSent", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UserDeletionWarningSent indicates an expected call of UserDeletionWarningSent.
+func (mr *MockCommandsMockRecorder) UserDeletionWarningSent(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserDeletionWarningSent", reflect.TypeOf((*MockCommands)(nil).UserDeletionWarningSent), arg0, arg1, arg2)
+}
+
 // UserDomainClaimedSent mocks base method.
 func (m *MockCommands) UserDomainClaimedSent(arg0 context.Context, arg1, arg2 string) error {
 	m.ctrl.T.Helper()
@@ -11,7 +11,9 @@ package mock
 
 import (
 	context "context"
+	sql "database/sql"
 	reflect "reflect"
+	time "time"
 
 	domain "github.com/zitadel/zitadel/internal/domain"
 	query "github.com/zitadel/zitadel/internal/query"
@@ -57,6 +59,64 @@ func (mr *MockQueriesMockRecorder) ActiveLabelPolicyByOrg(arg0, arg1, arg2 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActiveLabelPolicyByOrg", reflect.TypeOf((*MockQueries)(nil).ActiveLabelPolicyByOrg), arg0, arg1, arg2)
 }
 
+// AuditLogRetention mocks base method.
+func (m *MockQueries) AuditLogRetention(arg0 context.Context) time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuditLogRetention", arg0)
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// AuditLogRetention indicates an expected call of AuditLogRetention.
+func (mr *MockQueriesMockRecorder) AuditLogRetention(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuditLogRetention", reflect.TypeOf((*MockQueries)(nil).AuditLogRetention), arg0)
+}
+
+// BreakGlassPolicy mocks base method.
+func (m *MockQueries) BreakGlassPolicy(arg0 context.Context) (*domain.BreakGlassPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BreakGlassPolicy", arg0)
+	ret0, _ := ret[0].(*domain.BreakGlassPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BreakGlassPolicy indicates an expected call of BreakGlassPolicy.
+func (mr *MockQueriesMockRecorder) BreakGlassPolicy(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BreakGlassPolicy", reflect.TypeOf((*MockQueries)(nil).BreakGlassPolicy), arg0)
+}
+
+// CheckUserReadPermission mocks base method.
+func (m *MockQueries) CheckUserReadPermission(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckUserReadPermission", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckUserReadPermission indicates an expected call of CheckUserReadPermission.
+func (mr *MockQueriesMockRecorder) CheckUserReadPermission(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckUserReadPermission", reflect.TypeOf((*MockQueries)(nil).CheckUserReadPermission), arg0, arg1, arg2)
+}
+
+// ClaimDueNotifications mocks base method.
+func (m *MockQueries) ClaimDueNotifications(arg0 context.Context, arg1 *sql.Tx, arg2 uint64, arg3 time.Duration) ([]*query.DueNotification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimDueNotifications", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*query.DueNotification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimDueNotifications indicates an expected call of ClaimDueNotifications.
+func (mr *MockQueriesMockRecorder) ClaimDueNotifications(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimDueNotifications", reflect.TypeOf((*MockQueries)(nil).ClaimDueNotifications), arg0, arg1, arg2, arg3)
+}
+
 // CustomTextListByTemplate mocks base method.
 func (m *MockQueries) CustomTextListByTemplate(arg0 context.Context, arg1, arg2 string, arg3 bool) (*query.CustomTexts, error) {
 	m.ctrl.T.Helper()
@@ -131,6 +191,21 @@ func (mr *MockQueriesMockRecorder) MailTemplateByOrg(arg0, arg1, arg2 any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MailTemplateByOrg", reflect.TypeOf((*MockQueries)(nil).MailTemplateByOrg), arg0, arg1, arg2)
 }
 
+// MessageTemplatesByOrg mocks base method.
+func (m *MockQueries) MessageTemplatesByOrg(arg0 context.Context, arg1 string) (*query.MessageTemplates, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MessageTemplatesByOrg", arg0, arg1)
+	ret0, _ := ret[0].(*query.MessageTemplates)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MessageTemplatesByOrg indicates an expected call of MessageTemplatesByOrg.
+func (mr *MockQueriesMockRecorder) MessageTemplatesByOrg(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MessageTemplatesByOrg", reflect.TypeOf((*MockQueries)(nil).MessageTemplatesByOrg), arg0, arg1)
+}
+
 // NotificationPolicyByOrg mocks base method.
 func (m *MockQueries) NotificationPolicyByOrg(arg0 context.Context, arg1 bool, arg2 string, arg3 bool) (*query.NotificationPolicy, error) {
 	m.ctrl.T.Helper()
@@ -226,6 +301,21 @@ func (mr *MockQueriesMockRecorder) SearchMilestones(arg0, arg1, arg2 any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchMilestones", reflect.TypeOf((*MockQueries)(nil).SearchMilestones), arg0, arg1, arg2)
 }
 
+// SearchTargets mocks base method.
+func (m *MockQueries) SearchTargets(arg0 context.Context, arg1 *query.TargetSearchQueries) (*query.Targets, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchTargets", arg0, arg1)
+	ret0, _ := ret[0].(*query.Targets)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchTargets indicates an expected call of SearchTargets.
+func (mr *MockQueriesMockRecorder) SearchTargets(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchTargets", reflect.TypeOf((*MockQueries)(nil).SearchTargets), arg0, arg1)
+}
+
 // SessionByID mocks base method.
 func (m *MockQueries) SessionByID(arg0 context.Context, arg1 bool, arg2, arg3 string) (*query.Session, error) {
 	m.ctrl.T.Helper()
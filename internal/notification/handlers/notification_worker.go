@@ -0,0 +1,326 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/zitadel/logging"
+	"golang.org/x/time/rate"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/notification/channels/push"
+	"github.com/zitadel/zitadel/internal/notification/types"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/repository/notification"
+)
+
+type WorkerConfig struct {
+	// LegacyEnabled sends the notifications directly from the notification projection
+	// instead of enqueuing them for the worker
+	LegacyEnabled bool
+	// Workers is the amount of notifications delivered concurrently
+	Workers uint8
+	// BulkLimit is the maximum amount of notifications picked up per run
+	BulkLimit uint16
+	// RequeueEvery defines how often the queue is checked for due notifications
+	RequeueEvery time.Duration
+	// ClaimDuration is the maximum duration of a run, other workers do not pick up the claimed notifications during that time
+	ClaimDuration time.Duration
+	// MaxAttempts is the maximum amount of delivery attempts, before the notification is marked as failed
+	MaxAttempts uint16
+	// MaxTtl is the maximum age of a notification, older notifications are canceled
+	MaxTtl time.Duration
+	// MinRetryDelay is the delay before the first retry
+	MinRetryDelay time.Duration
+	// MaxRetryDelay caps the exponentially growing delay between retries
+	MaxRetryDelay time.Duration
+	// RetryDelayFactor is the factor the delay grows with each attempt
+	RetryDelayFactor float32
+	Email            RateLimitConfig
+	SMS              RateLimitConfig
+	Push             RateLimitConfig
+	// PushProviders configures the delivery of push messages to the devices of the users
+	PushProviders push.Config
+}
+
+// RateLimitConfig limits the notifications sent through a channel.
+// If PerSecond is 0, the channel is not limited.
+type RateLimitConfig struct {
+	PerSecond float64
+	Burst     int
+}
+
+func (c RateLimitConfig) limiter() *rate.Limiter {
+	if c.PerSecond <= 0 {
+		return rate.NewLimiter(rate.Inf, 0)
+	}
+	return rate.NewLimiter(rate.Limit(c.PerSecond), max(c.Burst, 1))
+}
+
+// NotificationWorker delivers the notifications enqueued by the [userNotifier],
+// so slow or unavailable providers do not stall the projection.
+type NotificationWorker struct {
+	config   WorkerConfig
+	commands Commands
+	queries  *NotificationQueries
+	client   *database.DB
+	notifier *userNotifier
+	reducers map[eventstore.AggregateType]map[eventstore.EventType]handler.Reduce
+	limiters map[domain.NotificationType]*rate.Limiter
+	now      func() time.Time
+}
+
+func NewNotificationWorker(
+	config WorkerConfig,
+	client *database.DB,
+	commands Commands,
+	queries *NotificationQueries,
+	channels types.ChannelChains,
+	otpEmailTmpl string,
+) *NotificationWorker {
+	w := &NotificationWorker{
+		config:   config,
+		commands: commands,
+		queries:  queries,
+		client:   client,
+		notifier: &userNotifier{
+			commands:     commands,
+			queries:      queries,
+			channels:     channels,
+			otpEmailTmpl: otpEmailTmpl,
+			mode:         notifierModeWorker,
+		},
+		limiters: map[domain.NotificationType]*rate.Limiter{
+			domain.NotificationTypeEmail: config.Email.limiter(),
+			domain.NotificationTypeSms:   config.SMS.limiter(),
+			domain.NotificationTypePush:  config.Push.limiter(),
+		},
+		now: time.Now,
+	}
+	w.reducers = make(map[eventstore.AggregateType]map[eventstore.EventType]handler.Reduce)
+	for _, aggregate := range w.notifier.Reducers() {
+		w.reducers[aggregate.Aggregate] = make(map[eventstore.EventType]handler.Reduce, len(aggregate.EventReducers))
+		for _, reducer := range aggregate.EventReducers {
+			w.reducers[aggregate.Aggregate][reducer.Event] = reducer.Reduce
+		}
+	}
+	return w
+}
+
+func (w *NotificationWorker) Start(ctx context.Context) {
+	if w.config.LegacyEnabled {
+		return
+	}
+	go w.schedule(ctx)
+}
+
+func (w *NotificationWorker) schedule(ctx context.Context) {
+	ticker := time.NewTicker(w.config.RequeueEvery)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := w.trigger(ctx)
+			logging.OnError(err).Warn("unable to deliver notifications")
+		}
+	}
+}
+
+// trigger claims the due notifications and delivers them using the configured amount of workers.
+// No transaction is held during the delivery, if a notification is not handled within the claim duration,
+// it's picked up again by the next run.
+func (w *NotificationWorker) trigger(ctx context.Context) error {
+	jobs, err := w.claim(ctx)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, w.config.ClaimDuration)
+	defer cancel()
+	w.deliverAll(ctx, jobs)
+	return nil
+}
+
+func (w *NotificationWorker) claim(ctx context.Context) (_ []*query.DueNotification, err error) {
+	tx, err := w.client.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			rollbackErr := tx.Rollback()
+			logging.OnError(rollbackErr).Debug("unable to rollback notification worker transaction")
+			return
+		}
+		err = tx.Commit()
+	}()
+	return w.queries.ClaimDueNotifications(ctx, tx, uint64(w.config.BulkLimit), w.config.ClaimDuration)
+}
+
+func (w *NotificationWorker) deliverAll(ctx context.Context, jobs []*query.DueNotification) {
+	queue := make(chan *query.DueNotification)
+	var wg sync.WaitGroup
+	for i := 0; i < max(int(w.config.Workers), 1); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for job := range queue {
+				err := w.deliver(ctx, job)
+				logging.WithFields("instance", job.InstanceID, "notification", job.ID).OnError(err).Warn("unable to handle notification")
+			}
+		}()
+	}
+	for _, job := range jobs {
+		queue <- job
+	}
+	close(queue)
+	wg.Wait()
+}
+
+func (w *NotificationWorker) deliver(ctx context.Context, job *query.DueNotification) error {
+	ctx = authz.WithInstanceID(ctx, job.InstanceID)
+	ctx = authz.SetCtxData(ctx, authz.CtxData{UserID: NotifyUserID, OrgID: job.InstanceID})
+
+	// the queue projection might not be up-to-date, so the state is checked on the eventstore
+	state := newNotificationState(job.ID, job.InstanceID)
+	if err := w.queries.es.FilterToQueryReducer(ctx, state); err != nil {
+		return err
+	}
+	if !state.State.Pending() || state.NextAttempt.After(w.now()) {
+		return nil
+	}
+	if w.config.MaxTtl > 0 && state.CreationDate.Add(w.config.MaxTtl).Before(w.now()) {
+		return w.commands.NotificationCanceled(ctx, job.ID, "max ttl exceeded")
+	}
+	event, err := w.triggeringEvent(ctx, state)
+	if err != nil {
+		return err
+	}
+	if event == nil {
+		return w.commands.NotificationCanceled(ctx, job.ID, "triggering event not found")
+	}
+	reduce, ok := w.reducers[event.Aggregate().Type][event.Type()]
+	if !ok {
+		return w.commands.NotificationCanceled(ctx, job.ID, "triggering event is not handled")
+	}
+	attempt := state.Attempts + 1
+	stmt, err := reduce(event)
+	if err != nil {
+		return w.retry(ctx, job.ID, attempt, err)
+	}
+	if stmt.Execute == nil {
+		return w.commands.NotificationCanceled(ctx, job.ID, "nothing to send")
+	}
+	if err = w.limiters[state.Request.NotificationType].Wait(ctx); err != nil {
+		return err
+	}
+	err = stmt.Execute(nil, "")
+	if errors.Is(err, errNotificationCanceled) {
+		return w.commands.NotificationCanceled(ctx, job.ID, "code already used, expired or notification disabled")
+	}
+	if err != nil {
+		return w.retry(ctx, job.ID, attempt, err)
+	}
+	return w.commands.NotificationSent(ctx, job.ID, attempt)
+}
+
+func (w *NotificationWorker) retry(ctx context.Context, id string, attempt uint16, sendErr error) error {
+	if attempt >= w.config.MaxAttempts {
+		return w.commands.NotificationFailed(ctx, id, attempt, sendErr)
+	}
+	return w.commands.NotificationRetryRequested(ctx, id, attempt, w.now().Add(w.retryDelay(attempt)), sendErr)
+}
+
+// retryDelay grows exponentially with each attempt, starting at MinRetryDelay and capped at MaxRetryDelay
+func (w *NotificationWorker) retryDelay(attempt uint16) time.Duration {
+	delay := float64(w.config.MinRetryDelay) * math.Pow(float64(w.config.RetryDelayFactor), float64(attempt-1))
+	if delay > float64(w.config.MaxRetryDelay) {
+		return w.config.MaxRetryDelay
+	}
+	return time.Duration(delay)
+}
+
+func (w *NotificationWorker) triggeringEvent(ctx context.Context, state *notificationState) (eventstore.Event, error) {
+	events, err := w.queries.es.Filter(ctx, eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		InstanceID(state.InstanceID).
+		SequenceGreater(state.Request.TriggeringSequence-1).
+		OrderAsc().
+		Limit(1).
+		AddQuery().
+		AggregateTypes(state.Request.TriggeringAggregateType).
+		AggregateIDs(state.Request.TriggeringAggregateID).
+		EventTypes(state.Request.TriggeringEventType).
+		Builder(),
+	)
+	if err != nil || len(events) == 0 {
+		return nil, err
+	}
+	return events[0], nil
+}
+
+type notificationState struct {
+	ID         string
+	InstanceID string
+
+	Request      notification.Request
+	CreationDate time.Time
+	State        domain.NotificationState
+	Attempts     uint16
+	NextAttempt  time.Time
+
+	events []eventstore.Event
+}
+
+func newNotificationState(id, instanceID string) *notificationState {
+	return &notificationState{
+		ID:         id,
+		InstanceID: instanceID,
+	}
+}
+
+func (n *notificationState) AppendEvents(events ...eventstore.Event) {
+	n.events = append(n.events, events...)
+}
+
+func (n *notificationState) Reduce() error {
+	for _, event := range n.events {
+		switch e := event.(type) {
+		case *notification.RequestedEvent:
+			n.Request = e.Request
+			n.CreationDate = e.CreatedAt()
+			n.State = domain.NotificationStateRequested
+			n.NextAttempt = e.CreatedAt()
+		case *notification.RetryRequestedEvent:
+			n.State = domain.NotificationStateRetrying
+			n.Attempts = e.Attempt
+			n.NextAttempt = e.NextAttempt
+		case *notification.SentEvent:
+			n.State = domain.NotificationStateSent
+		case *notification.CanceledEvent:
+			n.State = domain.NotificationStateCanceled
+		case *notification.FailedEvent:
+			n.State = domain.NotificationStateFailed
+		}
+	}
+	n.events = nil
+	return nil
+}
+
+func (n *notificationState) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		InstanceID(n.InstanceID).
+		AddQuery().
+		AggregateTypes(notification.AggregateType).
+		AggregateIDs(n.ID).
+		Builder()
+}
+
+var _ eventstore.QueryReducer = (*notificationState)(nil)
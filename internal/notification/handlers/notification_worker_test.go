@@ -0,0 +1,196 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+	es_repo_mock "github.com/zitadel/zitadel/internal/eventstore/repository/mock"
+	"github.com/zitadel/zitadel/internal/notification/handlers/mock"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/repository/notification"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	notificationID = "notification1"
+	instanceID     = "instance1"
+)
+
+func Test_NotificationWorker_retryDelay(t *testing.T) {
+	w := &NotificationWorker{
+		config: WorkerConfig{
+			MinRetryDelay:    time.Second,
+			MaxRetryDelay:    10 * time.Second,
+			RetryDelayFactor: 2,
+		},
+	}
+	tests := []struct {
+		attempt uint16
+		want    time.Duration
+	}{
+		{attempt: 1, want: time.Second},
+		{attempt: 2, want: 2 * time.Second},
+		{attempt: 3, want: 4 * time.Second},
+		{attempt: 4, want: 8 * time.Second},
+		{attempt: 5, want: 10 * time.Second},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, w.retryDelay(tt.attempt), "attempt %d", tt.attempt)
+	}
+}
+
+func Test_NotificationWorker_deliver(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name string
+		test func(*mock.MockQueries, *mock.MockCommands) (config WorkerConfig, events []eventstore.Event)
+		// triggered is true if the triggering event is queried
+		triggered bool
+		err       assert.ErrorAssertionFunc
+	}{
+		{
+			name: "already sent, ignored",
+			test: func(queries *mock.MockQueries, commands *mock.MockCommands) (WorkerConfig, []eventstore.Event) {
+				return WorkerConfig{}, []eventstore.Event{
+					requestedEvent(t, now),
+					notificationEvent(t, notification.SentType, now, `{"attempt":1}`),
+				}
+			},
+			err: assert.NoError,
+		},
+		{
+			name: "retry not due yet, ignored",
+			test: func(queries *mock.MockQueries, commands *mock.MockCommands) (WorkerConfig, []eventstore.Event) {
+				return WorkerConfig{}, []eventstore.Event{
+					requestedEvent(t, now),
+					notificationEvent(t, notification.RetryRequestedType, now, `{"attempt":1,"nextAttempt":"`+now.Add(time.Hour).Format(time.RFC3339Nano)+`"}`),
+				}
+			},
+			err: assert.NoError,
+		},
+		{
+			name: "max ttl exceeded, canceled",
+			test: func(queries *mock.MockQueries, commands *mock.MockCommands) (WorkerConfig, []eventstore.Event) {
+				commands.EXPECT().NotificationCanceled(gomock.Any(), notificationID, "max ttl exceeded").Return(nil)
+				return WorkerConfig{MaxTtl: time.Minute}, []eventstore.Event{
+					requestedEvent(t, now.Add(-time.Hour)),
+				}
+			},
+			err: assert.NoError,
+		},
+		{
+			name: "notification disabled, canceled",
+			test: func(queries *mock.MockQueries, commands *mock.MockCommands) (WorkerConfig, []eventstore.Event) {
+				queries.EXPECT().NotificationPolicyByOrg(gomock.Any(), gomock.Any(), orgID, gomock.Any()).Return(&query.NotificationPolicy{PasswordChange: false}, nil)
+				commands.EXPECT().NotificationCanceled(gomock.Any(), notificationID, gomock.Any()).Return(nil)
+				return WorkerConfig{MaxAttempts: 3}, []eventstore.Event{
+					requestedEvent(t, now),
+				}
+			},
+			triggered: true,
+			err:       assert.NoError,
+		},
+		{
+			name: "send error, retry requested",
+			test: func(queries *mock.MockQueries, commands *mock.MockCommands) (WorkerConfig, []eventstore.Event) {
+				queries.EXPECT().NotificationPolicyByOrg(gomock.Any(), gomock.Any(), orgID, gomock.Any()).Return(nil, zerrors.ThrowInternal(nil, "ID", "Errors.Internal"))
+				commands.EXPECT().NotificationRetryRequested(gomock.Any(), notificationID, uint16(2), gomock.Any(), gomock.Any()).Return(nil)
+				return WorkerConfig{MaxAttempts: 3, MinRetryDelay: time.Second, MaxRetryDelay: time.Minute, RetryDelayFactor: 2}, []eventstore.Event{
+					requestedEvent(t, now),
+					notificationEvent(t, notification.RetryRequestedType, now, `{"attempt":1,"nextAttempt":"`+now.Add(-time.Second).Format(time.RFC3339Nano)+`"}`),
+				}
+			},
+			triggered: true,
+			err:       assert.NoError,
+		},
+		{
+			name: "send error on last attempt, failed",
+			test: func(queries *mock.MockQueries, commands *mock.MockCommands) (WorkerConfig, []eventstore.Event) {
+				queries.EXPECT().NotificationPolicyByOrg(gomock.Any(), gomock.Any(), orgID, gomock.Any()).Return(nil, zerrors.ThrowInternal(nil, "ID", "Errors.Internal"))
+				commands.EXPECT().NotificationFailed(gomock.Any(), notificationID, uint16(3), gomock.Any()).Return(nil)
+				return WorkerConfig{MaxAttempts: 3}, []eventstore.Event{
+					requestedEvent(t, now),
+					notificationEvent(t, notification.RetryRequestedType, now, `{"attempt":2,"nextAttempt":"`+now.Add(-time.Second).Format(time.RFC3339Nano)+`"}`),
+				}
+			},
+			triggered: true,
+			err:       assert.NoError,
+		},
+		{
+			name: "eventstore error, error",
+			test: func(queries *mock.MockQueries, commands *mock.MockCommands) (WorkerConfig, []eventstore.Event) {
+				return WorkerConfig{}, nil
+			},
+			err: assert.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			queries := mock.NewMockQueries(ctrl)
+			commands := mock.NewMockCommands(ctrl)
+			config, events := tt.test(queries, commands)
+			repo := es_repo_mock.NewRepo(t)
+			if events == nil {
+				repo.ExpectFilterEventsError(errors.New("unavailable"))
+			} else {
+				repo.ExpectFilterEvents(events...)
+			}
+			if tt.triggered {
+				// triggering event and already handled check
+				repo.ExpectFilterEvents(passwordChangedEvent(t)).
+					ExpectFilterEvents()
+			}
+			q := NewNotificationQueries(queries, eventstore.NewEventstore(&eventstore.Config{Querier: repo.MockQuerier}), externalDomain, externalPort, externalSecure, "", nil, nil, nil)
+			w := NewNotificationWorker(config, nil, commands, q, nil, defaultOTPEmailTemplate)
+			w.now = func() time.Time { return now }
+			tt.err(t, w.deliver(context.Background(), &query.DueNotification{
+				ID:         notificationID,
+				InstanceID: instanceID,
+				Channel:    domain.NotificationTypeEmail,
+			}))
+		})
+	}
+}
+
+func requestedEvent(t *testing.T, creationDate time.Time) eventstore.Event {
+	return notificationEvent(t, notification.RequestedType, creationDate, `{"request":{"triggeringAggregateType":"user","triggeringAggregateId":"`+userID+`","triggeringEventType":"`+string(user.HumanPasswordChangedType)+`","triggeringSequence":5,"triggeringResourceOwner":"`+orgID+`","notificationType":0}}`)
+}
+
+func notificationEvent(t *testing.T, typ eventstore.EventType, creationDate time.Time, data string) eventstore.Event {
+	return &repository.Event{
+		AggregateID:   notificationID,
+		AggregateType: notification.AggregateType,
+		InstanceID:    instanceID,
+		ResourceOwner: sql.NullString{String: instanceID, Valid: true},
+		Typ:           typ,
+		Seq:           1,
+		CreationDate:  creationDate,
+		Data:          []byte(data),
+		Version:       notification.AggregateVersion,
+	}
+}
+
+func passwordChangedEvent(t *testing.T) eventstore.Event {
+	return &repository.Event{
+		AggregateID:   userID,
+		AggregateType: user.AggregateType,
+		InstanceID:    instanceID,
+		ResourceOwner: sql.NullString{String: orgID, Valid: true},
+		Typ:           user.HumanPasswordChangedType,
+		Seq:           5,
+		CreationDate:  time.Now(),
+		Data:          []byte(`{}`),
+		Version:       user.AggregateVersion,
+	}
+}
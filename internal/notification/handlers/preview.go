@@ -0,0 +1,119 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	http_utils "github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/notification/types"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	previewCode = "ABCDEF"
+	previewOTP  = "123456"
+)
+
+// PreviewMessage renders the message of the messageType with the texts and branding of the organization,
+// the same way it is sent to the users of the organization.
+// If no userID is passed, the message is rendered for a sample user.
+// Otherwise the authenticated user must be allowed to read the user.
+func (n *NotificationQueries) PreviewMessage(ctx context.Context, orgID, messageType, lang, userID string) (*types.Message, error) {
+	if !domain.IsMessageTextType(messageType) {
+		return nil, zerrors.ThrowInvalidArgument(nil, "HANDL-Pv3mt", "Errors.CustomMessageText.Invalid")
+	}
+	// the preview contains the personal data of the user
+	if userID != "" {
+		if err := n.CheckUserReadPermission(ctx, orgID, userID); err != nil {
+			return nil, err
+		}
+	}
+	user, err := n.previewUser(ctx, orgID, lang, userID)
+	if err != nil {
+		return nil, err
+	}
+	colors, err := n.ActiveLabelPolicyByOrg(ctx, orgID, false)
+	if err != nil {
+		return nil, err
+	}
+	template, err := n.GetMailTemplates(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	translator, err := n.GetTranslatorWithOrgTexts(ctx, orgID, messageType)
+	if err != nil {
+		return nil, err
+	}
+	return types.RenderMessage(ctx, template, translator, user, colors, http_utils.ComposedOrigin(ctx), previewArgs(ctx, user), messageType)
+}
+
+// previewUser returns the user of the organization the preview is rendered for.
+// If no userID is passed, a sample user is returned.
+func (n *NotificationQueries) previewUser(ctx context.Context, orgID, lang, userID string) (_ *query.NotifyUser, err error) {
+	user := sampleNotifyUser(orgID)
+	if userID != "" {
+		user, err = n.GetNotifyUserByID(ctx, true, userID)
+		if err != nil {
+			return nil, err
+		}
+		if user.ResourceOwner != orgID {
+			return nil, zerrors.ThrowNotFound(nil, "HANDL-Pv4nu", "Errors.User.NotFoundOnOrg")
+		}
+	}
+	if lang != "" {
+		tag, err := language.Parse(lang)
+		if err != nil {
+			return nil, zerrors.ThrowInvalidArgument(err, "HANDL-Pv5lg", "Errors.Language.NotParsed")
+		}
+		user.PreferredLanguage = tag
+	}
+	return user, nil
+}
+
+func sampleNotifyUser(orgID string) *query.NotifyUser {
+	now := time.Now().UTC()
+	return &query.NotifyUser{
+		ID:                 "preview",
+		CreationDate:       now,
+		ChangeDate:         now,
+		ResourceOwner:      orgID,
+		State:              domain.UserStateActive,
+		Type:               domain.UserTypeHuman,
+		Username:           "jane.doe",
+		LoginNames:         database.TextArray[string]{"jane.doe@example.com"},
+		PreferredLoginName: "jane.doe@example.com",
+		FirstName:          "Jane",
+		LastName:           "Doe",
+		NickName:           "Jane",
+		DisplayName:        "Jane Doe",
+		LastEmail:          "jane.doe@example.com",
+		VerifiedEmail:      "jane.doe@example.com",
+		LastPhone:          "+41000000000",
+		VerifiedPhone:      "+41000000000",
+	}
+}
+
+// previewArgs returns sample values for the arguments of all message types,
+// as the codes, dates and request information of a real notification are not available.
+func previewArgs(ctx context.Context, user *query.NotifyUser) map[string]interface{} {
+	now := time.Now().UTC()
+	return map[string]interface{}{
+		"Code":             previewCode,
+		"OTP":              previewOTP,
+		"Expiry":           5 * time.Minute,
+		"Origin":           http_utils.ComposedOrigin(ctx),
+		"Domain":           authz.GetInstance(ctx).RequestedDomain(),
+		"TempUsername":     user.Username,
+		"Date":             now.Format(time.RFC1123),
+		"DeactivationDate": now.AddDate(0, 0, 7).Format(time.DateOnly),
+		"DeletionDate":     now.AddDate(0, 0, 7).Format(time.DateOnly),
+		"UserAgent":        "Mozilla/5.0",
+		"RemoteIP":         "127.0.0.1",
+	}
+}
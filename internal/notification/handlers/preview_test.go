@@ -0,0 +1,157 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/notification/handlers/mock"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestNotificationQueries_PreviewMessage(t *testing.T) {
+	type args struct {
+		messageType string
+		lang        string
+		userID      string
+	}
+	tests := []struct {
+		name    string
+		expect  func(queries *mock.MockQueries)
+		args    args
+		want    func(t *testing.T, subject, html, text string)
+		wantErr func(error) bool
+	}{
+		{
+			name:   "invalid message type",
+			expect: func(queries *mock.MockQueries) {},
+			args: args{
+				messageType: "Unknown",
+			},
+			wantErr: zerrors.IsErrorInvalidArgument,
+		},
+		{
+			name: "user without permission",
+			expect: func(queries *mock.MockQueries) {
+				queries.EXPECT().CheckUserReadPermission(gomock.Any(), orgID, userID).Return(zerrors.ThrowPermissionDenied(nil, "AUTHZ-HKJD33", "Errors.PermissionDenied"))
+			},
+			args: args{
+				messageType: domain.InitCodeMessageType,
+				userID:      userID,
+			},
+			wantErr: zerrors.IsPermissionDenied,
+		},
+		{
+			name: "user of other org",
+			expect: func(queries *mock.MockQueries) {
+				queries.EXPECT().CheckUserReadPermission(gomock.Any(), orgID, userID).Return(nil)
+				queries.EXPECT().GetNotifyUserByID(gomock.Any(), true, userID).Return(&query.NotifyUser{
+					ID:            userID,
+					ResourceOwner: "otherOrg",
+				}, nil)
+			},
+			args: args{
+				messageType: domain.InitCodeMessageType,
+				userID:      userID,
+			},
+			wantErr: zerrors.IsNotFound,
+		},
+		{
+			name: "sample user",
+			expect: func(queries *mock.MockQueries) {
+				expectPreviewQueries(queries, "{{.Greeting}}|{{.Text}}")
+			},
+			args: args{
+				messageType: domain.InitCodeMessageType,
+				lang:        "en",
+			},
+			want: func(t *testing.T, subject, html, text string) {
+				assert.Equal(t, "Initialize User", subject)
+				assert.Contains(t, html, "Hello Jane Doe,")
+				assert.Contains(t, text, previewCode)
+				assert.Contains(t, html, text)
+			},
+		},
+		{
+			name: "user of org",
+			expect: func(queries *mock.MockQueries) {
+				queries.EXPECT().CheckUserReadPermission(gomock.Any(), orgID, userID).Return(nil)
+				queries.EXPECT().GetNotifyUserByID(gomock.Any(), true, userID).Return(&query.NotifyUser{
+					ID:            userID,
+					ResourceOwner: orgID,
+					DisplayName:   "John Doe",
+				}, nil)
+				expectPreviewQueries(queries, "{{.Greeting}}")
+			},
+			args: args{
+				messageType: domain.InitCodeMessageType,
+				lang:        "en",
+				userID:      userID,
+			},
+			want: func(t *testing.T, subject, html, text string) {
+				assert.Equal(t, "Hello John Doe,", html)
+			},
+		},
+		{
+			name: "custom template",
+			expect: func(queries *mock.MockQueries) {
+				expectPreviewQueries(queries, "{{.Greeting}}",
+					&query.MessageTemplate{
+						AggregateID: orgID,
+						Name:        domain.InitCodeMessageType,
+						Language:    language.English,
+						Template:    []byte(`{{template "header" .}}<p>{{.Greeting}}</p>`),
+					},
+					&query.MessageTemplate{
+						AggregateID: instanceID,
+						IsDefault:   true,
+						Name:        domain.MessageTemplatePartialHeader,
+						Language:    language.English,
+						Template:    []byte(`<h1>{{.Title}}</h1>`),
+					},
+				)
+			},
+			args: args{
+				messageType: domain.InitCodeMessageType,
+				lang:        "en",
+			},
+			want: func(t *testing.T, subject, html, text string) {
+				assert.Equal(t, "<h1>Initialize User</h1><p>Hello Jane Doe,</p>", html)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			queries := mock.NewMockQueries(gomock.NewController(t))
+			tt.expect(queries)
+			n := &NotificationQueries{Queries: queries}
+			ctx := authz.WithInstanceID(context.Background(), instanceID)
+
+			got, err := n.PreviewMessage(ctx, orgID, tt.args.messageType, tt.args.lang, tt.args.userID)
+			if tt.wantErr != nil {
+				assert.True(t, tt.wantErr(err), "unexpected error: %v", err)
+				return
+			}
+			require.NoError(t, err)
+			tt.want(t, got.Subject, got.HTML, got.Text)
+		})
+	}
+}
+
+func expectPreviewQueries(queries *mock.MockQueries, template string, messageTemplates ...*query.MessageTemplate) {
+	queries.EXPECT().ActiveLabelPolicyByOrg(gomock.Any(), orgID, false).Return(&query.LabelPolicy{ID: policyID}, nil)
+	queries.EXPECT().MailTemplateByOrg(gomock.Any(), orgID, false).Return(&query.MailTemplate{Template: []byte(template)}, nil)
+	queries.EXPECT().MessageTemplatesByOrg(gomock.Any(), orgID).Return(&query.MessageTemplates{MessageTemplates: messageTemplates}, nil)
+	queries.EXPECT().GetInstanceRestrictions(gomock.Any()).Return(query.Restrictions{
+		AllowedLanguages: []language.Tag{language.English},
+	}, nil)
+	queries.EXPECT().GetDefaultLanguage(gomock.Any()).Times(2).Return(language.English)
+	queries.EXPECT().CustomTextListByTemplate(gomock.Any(), gomock.Any(), domain.InitCodeMessageType, false).Times(2).Return(&query.CustomTexts{}, nil)
+}
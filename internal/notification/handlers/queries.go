@@ -2,6 +2,8 @@ package handlers
 
 import (
 	"context"
+	"database/sql"
+	"time"
 
 	"golang.org/x/text/language"
 
@@ -14,6 +16,7 @@ import (
 type Queries interface {
 	ActiveLabelPolicyByOrg(ctx context.Context, orgID string, withOwnerRemoved bool) (*query.LabelPolicy, error)
 	MailTemplateByOrg(ctx context.Context, orgID string, withOwnerRemoved bool) (*query.MailTemplate, error)
+	MessageTemplatesByOrg(ctx context.Context, orgID string) (*query.MessageTemplates, error)
 	GetNotifyUserByID(ctx context.Context, shouldTriggered bool, userID string) (*query.NotifyUser, error)
 	CustomTextListByTemplate(ctx context.Context, aggregateID, template string, withOwnerRemoved bool) (*query.CustomTexts, error)
 	SearchInstanceDomains(ctx context.Context, queries *query.InstanceDomainSearchQueries) (*query.InstanceDomains, error)
@@ -25,6 +28,11 @@ type Queries interface {
 	SMTPConfigActive(ctx context.Context, resourceOwner string) (*query.SMTPConfig, error)
 	GetDefaultLanguage(ctx context.Context) language.Tag
 	GetInstanceRestrictions(ctx context.Context) (restrictions query.Restrictions, err error)
+	ClaimDueNotifications(ctx context.Context, tx *sql.Tx, limit uint64, claim time.Duration) ([]*query.DueNotification, error)
+	BreakGlassPolicy(ctx context.Context) (*domain.BreakGlassPolicy, error)
+	SearchTargets(ctx context.Context, queries *query.TargetSearchQueries) (*query.Targets, error)
+	AuditLogRetention(ctx context.Context) time.Duration
+	CheckUserReadPermission(ctx context.Context, resourceOwner, userID string) error
 }
 
 type NotificationQueries struct {
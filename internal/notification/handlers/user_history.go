@@ -0,0 +1,150 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+type loginsBefore struct {
+	event eventstore.Event
+	limit uint64
+
+	browsers []*user.BrowserInfo
+}
+
+func (l *loginsBefore) Reduce() error {
+	return nil
+}
+
+func (l *loginsBefore) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		var info *user.AuthRequestInfo
+		switch e := event.(type) {
+		case *user.HumanPasswordCheckSucceededEvent:
+			info = e.AuthRequestInfo
+		case *user.HumanPasswordlessCheckSucceededEvent:
+			info = e.AuthRequestInfo
+		}
+		var browser *user.BrowserInfo
+		if info != nil {
+			browser = info.BrowserInfo
+		}
+		l.browsers = append(l.browsers, browser)
+	}
+}
+
+func (l *loginsBefore) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		InstanceID(l.event.Aggregate().InstanceID).
+		OrderDesc().
+		Limit(l.limit).
+		CreationDateBefore(l.event.CreatedAt()).
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(l.event.Aggregate().ID).
+		EventTypes(
+			user.HumanPasswordCheckSucceededType,
+			user.HumanPasswordlessTokenCheckSucceededType,
+		).
+		Builder()
+}
+
+// LoginsBefore returns the browsers of the latest logins of the user of the event,
+// which happened before the event, starting with the most recent one.
+// The browser is nil for logins without browser information.
+func (n *NotificationQueries) LoginsBefore(ctx context.Context, event eventstore.Event, limit uint64) ([]*user.BrowserInfo, error) {
+	before := &loginsBefore{
+		event: event,
+		limit: limit,
+	}
+	err := n.es.FilterToQueryReducer(ctx, before)
+	if err != nil {
+		return nil, err
+	}
+	return before.browsers, nil
+}
+
+// VerifiedContact is the verified email address and phone number of a user at a specific point in time.
+type VerifiedContact struct {
+	Email string
+	Phone string
+}
+
+type verifiedContactBefore struct {
+	event eventstore.Event
+
+	lastEmail string
+	lastPhone string
+	VerifiedContact
+}
+
+func (v *verifiedContactBefore) Reduce() error {
+	return nil
+}
+
+func (v *verifiedContactBefore) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		if event.Sequence() >= v.event.Sequence() {
+			return
+		}
+		switch e := event.(type) {
+		case *user.HumanAddedEvent:
+			v.lastEmail = string(e.EmailAddress)
+			v.lastPhone = string(e.PhoneNumber)
+		case *user.HumanRegisteredEvent:
+			v.lastEmail = string(e.EmailAddress)
+			v.lastPhone = string(e.PhoneNumber)
+		case *user.HumanEmailChangedEvent:
+			v.lastEmail = string(e.EmailAddress)
+		case *user.HumanEmailVerifiedEvent:
+			v.Email = v.lastEmail
+		case *user.HumanPhoneChangedEvent:
+			v.lastPhone = string(e.PhoneNumber)
+		case *user.HumanPhoneVerifiedEvent:
+			v.Phone = v.lastPhone
+		case *user.HumanPhoneRemovedEvent:
+			v.lastPhone = ""
+			v.Phone = ""
+		}
+	}
+}
+
+func (v *verifiedContactBefore) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		InstanceID(v.event.Aggregate().InstanceID).
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(v.event.Aggregate().ID).
+		EventTypes(
+			user.UserV1AddedType,
+			user.HumanAddedType,
+			user.UserV1RegisteredType,
+			user.HumanRegisteredType,
+			user.UserV1EmailChangedType,
+			user.HumanEmailChangedType,
+			user.UserV1EmailVerifiedType,
+			user.HumanEmailVerifiedType,
+			user.UserV1PhoneChangedType,
+			user.HumanPhoneChangedType,
+			user.UserV1PhoneVerifiedType,
+			user.HumanPhoneVerifiedType,
+			user.UserV1PhoneRemovedType,
+			user.HumanPhoneRemovedType,
+		).
+		Builder()
+}
+
+// VerifiedContactBefore returns the verified contact of the user of the event,
+// as it was before the event was pushed.
+func (n *NotificationQueries) VerifiedContactBefore(ctx context.Context, event eventstore.Event) (*VerifiedContact, error) {
+	before := &verifiedContactBefore{
+		event: event,
+	}
+	err := n.es.FilterToQueryReducer(ctx, before)
+	if err != nil {
+		return nil, err
+	}
+	return &before.VerifiedContact, nil
+}
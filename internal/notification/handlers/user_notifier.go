@@ -2,17 +2,25 @@ package handlers
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"slices"
 	"strings"
 	"time"
 
+	"github.com/zitadel/logging"
+
 	http_util "github.com/zitadel/zitadel/internal/api/http"
 	"github.com/zitadel/zitadel/internal/api/ui/login"
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/execution"
 	"github.com/zitadel/zitadel/internal/notification/types"
 	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/repository/notification"
+	"github.com/zitadel/zitadel/internal/repository/org"
 	"github.com/zitadel/zitadel/internal/repository/session"
 	"github.com/zitadel/zitadel/internal/repository/user"
 	"github.com/zitadel/zitadel/internal/zerrors"
@@ -22,13 +30,33 @@ const (
 	UserNotificationsProjectionTable = "projections.notifications"
 )
 
+var (
+	// errNotificationCanceled is returned by the send functions of the reducers
+	// if the notification must not be sent (anymore), e.g. because the code was already used or is expired
+	errNotificationCanceled = errors.New("notification canceled")
+)
+
 type userNotifier struct {
 	commands     Commands
 	queries      *NotificationQueries
 	channels     types.ChannelChains
 	otpEmailTmpl string
+	mode         notifierMode
 }
 
+type notifierMode int
+
+const (
+	// notifierModeLegacy sends the notifications directly from the projection
+	notifierModeLegacy notifierMode = iota
+	// notifierModeEnqueue enqueues the notifications as [notification.RequestedEvent]
+	// to be delivered by the [NotificationWorker]
+	notifierModeEnqueue
+	// notifierModeWorker sends the notifications on behalf of the [NotificationWorker],
+	// which handles canceled notifications itself
+	notifierModeWorker
+)
+
 func NewUserNotifier(
 	ctx context.Context,
 	config handler.Config,
@@ -36,12 +64,18 @@ func NewUserNotifier(
 	queries *NotificationQueries,
 	channels types.ChannelChains,
 	otpEmailTmpl string,
+	enqueue bool,
 ) *handler.Handler {
+	mode := notifierModeLegacy
+	if enqueue {
+		mode = notifierModeEnqueue
+	}
 	return handler.NewHandler(ctx, &config, &userNotifier{
 		commands:     commands,
 		queries:      queries,
 		otpEmailTmpl: otpEmailTmpl,
 		channels:     channels,
+		mode:         mode,
 	})
 }
 
@@ -106,6 +140,86 @@ func (u *userNotifier) Reducers() []handler.AggregateReducer {
 					Event:  user.HumanOTPEmailCodeAddedType,
 					Reduce: u.reduceOTPEmailCodeAdded,
 				},
+				{
+					Event:  user.UserDeactivationWarningAddedType,
+					Reduce: u.reduceUserDeactivationWarningAdded,
+				},
+				{
+					Event:  user.UserDeletionWarningAddedType,
+					Reduce: u.reduceUserDeletionWarningAdded,
+				},
+				{
+					Event:  user.HumanMFAOTPVerifiedType,
+					Reduce: u.reduceMFAAdded,
+				},
+				{
+					Event:  user.HumanU2FTokenVerifiedType,
+					Reduce: u.reduceMFAAdded,
+				},
+				{
+					Event:  user.HumanOTPSMSAddedType,
+					Reduce: u.reduceMFAAdded,
+				},
+				{
+					Event:  user.HumanOTPEmailAddedType,
+					Reduce: u.reduceMFAAdded,
+				},
+				{
+					Event:  user.HumanMFAOTPRemovedType,
+					Reduce: u.reduceMFARemoved,
+				},
+				{
+					Event:  user.HumanU2FTokenRemovedType,
+					Reduce: u.reduceMFARemoved,
+				},
+				{
+					Event:  user.HumanOTPSMSRemovedType,
+					Reduce: u.reduceMFARemoved,
+				},
+				{
+					Event:  user.HumanOTPEmailRemovedType,
+					Reduce: u.reduceMFARemoved,
+				},
+				{
+					Event:  user.UserV1EmailChangedType,
+					Reduce: u.reduceEmailChanged,
+				},
+				{
+					Event:  user.HumanEmailChangedType,
+					Reduce: u.reduceEmailChanged,
+				},
+				{
+					Event:  user.UserV1PhoneChangedType,
+					Reduce: u.reducePhoneChanged,
+				},
+				{
+					Event:  user.HumanPhoneChangedType,
+					Reduce: u.reducePhoneChanged,
+				},
+				{
+					Event:  user.HumanPasswordlessTokenVerifiedType,
+					Reduce: u.reducePasskeyAdded,
+				},
+				{
+					Event:  user.HumanPasswordCheckSucceededType,
+					Reduce: u.reduceLoginSucceeded,
+				},
+				{
+					Event:  user.HumanPasswordlessTokenCheckSucceededType,
+					Reduce: u.reduceLoginSucceeded,
+				},
+				{
+					Event:  user.PersonalAccessTokenAddedType,
+					Reduce: u.reducePersonalAccessTokenAdded,
+				},
+				{
+					Event:  user.UserLockedType,
+					Reduce: u.reduceUserLocked,
+				},
+				{
+					Event:  user.HumanBreakGlassUsedType,
+					Reduce: u.reduceBreakGlassUsed,
+				},
 			},
 		},
 		{
@@ -119,6 +233,19 @@ func (u *userNotifier) Reducers() []handler.AggregateReducer {
 					Event:  session.OTPEmailChallengedType,
 					Reduce: u.reduceSessionOTPEmailChallenged,
 				},
+				{
+					Event:  session.PushChallengedType,
+					Reduce: u.reduceSessionPushChallenged,
+				},
+			},
+		},
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.TestMessageRequestedType,
+					Reduce: u.reduceTestMessageRequested,
+				},
 			},
 		},
 	}
@@ -130,16 +257,16 @@ func (u *userNotifier) reduceInitCodeAdded(event eventstore.Event) (*handler.Sta
 		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-EFe2f", "reduce.wrong.event.type %s", user.HumanInitialCodeAddedType)
 	}
 
-	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
+	return u.newStatement(event, domain.NotificationTypeEmail, func(ex handler.Executer, projectionName string) error {
 		ctx := HandlerContext(event.Aggregate())
 		alreadyHandled, err := u.checkIfCodeAlreadyHandledOrExpired(ctx, event, e.Expiry, nil,
-			user.UserV1InitialCodeAddedType, user.UserV1InitialCodeSentType,
-			user.HumanInitialCodeAddedType, user.HumanInitialCodeSentType)
+			user.UserV1InitialCodeAddedType, user.UserV1InitialCodeSentType, user.UserV1InitializedCheckSucceededType,
+			user.HumanInitialCodeAddedType, user.HumanInitialCodeSentType, user.HumanInitializedCheckSucceededType)
 		if err != nil {
 			return err
 		}
 		if alreadyHandled {
-			return nil
+			return errNotificationCanceled
 		}
 		code, err := crypto.DecryptString(e.Code, u.queries.UserDataCrypto)
 		if err != nil {
@@ -150,7 +277,7 @@ func (u *userNotifier) reduceInitCodeAdded(event eventstore.Event) (*handler.Sta
 			return err
 		}
 
-		template, err := u.queries.MailTemplateByOrg(ctx, e.Aggregate().ResourceOwner, false)
+		template, err := u.queries.GetMailTemplates(ctx, e.Aggregate().ResourceOwner)
 		if err != nil {
 			return err
 		}
@@ -168,7 +295,7 @@ func (u *userNotifier) reduceInitCodeAdded(event eventstore.Event) (*handler.Sta
 		if err != nil {
 			return err
 		}
-		err = types.SendEmail(ctx, u.channels, string(template.Template), translator, notifyUser, colors, e).
+		err = types.SendEmail(ctx, u.channels, template, translator, notifyUser, colors, e).
 			SendUserInitCode(ctx, notifyUser, code, e.AuthRequestID)
 		if err != nil {
 			return err
@@ -187,16 +314,16 @@ func (u *userNotifier) reduceEmailCodeAdded(event eventstore.Event) (*handler.St
 		return handler.NewNoOpStatement(e), nil
 	}
 
-	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
+	return u.newStatement(event, domain.NotificationTypeEmail, func(ex handler.Executer, projectionName string) error {
 		ctx := HandlerContext(event.Aggregate())
 		alreadyHandled, err := u.checkIfCodeAlreadyHandledOrExpired(ctx, event, e.Expiry, nil,
-			user.UserV1EmailCodeAddedType, user.UserV1EmailCodeSentType,
-			user.HumanEmailCodeAddedType, user.HumanEmailCodeSentType)
+			user.UserV1EmailCodeAddedType, user.UserV1EmailCodeSentType, user.UserV1EmailVerifiedType,
+			user.HumanEmailCodeAddedType, user.HumanEmailCodeSentType, user.HumanEmailVerifiedType)
 		if err != nil {
 			return err
 		}
 		if alreadyHandled {
-			return nil
+			return errNotificationCanceled
 		}
 		code, err := crypto.DecryptString(e.Code, u.queries.UserDataCrypto)
 		if err != nil {
@@ -207,7 +334,7 @@ func (u *userNotifier) reduceEmailCodeAdded(event eventstore.Event) (*handler.St
 			return err
 		}
 
-		template, err := u.queries.MailTemplateByOrg(ctx, e.Aggregate().ResourceOwner, false)
+		template, err := u.queries.GetMailTemplates(ctx, e.Aggregate().ResourceOwner)
 		if err != nil {
 			return err
 		}
@@ -225,7 +352,7 @@ func (u *userNotifier) reduceEmailCodeAdded(event eventstore.Event) (*handler.St
 		if err != nil {
 			return err
 		}
-		err = types.SendEmail(ctx, u.channels, string(template.Template), translator, notifyUser, colors, e).
+		err = types.SendEmail(ctx, u.channels, template, translator, notifyUser, colors, e).
 			SendEmailVerificationCode(ctx, notifyUser, code, e.URLTemplate, e.AuthRequestID)
 		if err != nil {
 			return err
@@ -243,16 +370,16 @@ func (u *userNotifier) reducePasswordCodeAdded(event eventstore.Event) (*handler
 		return handler.NewNoOpStatement(e), nil
 	}
 
-	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
+	return u.newStatement(event, domain.NotificationTypeEmail, func(ex handler.Executer, projectionName string) error {
 		ctx := HandlerContext(event.Aggregate())
 		alreadyHandled, err := u.checkIfCodeAlreadyHandledOrExpired(ctx, event, e.Expiry, nil,
-			user.UserV1PasswordCodeAddedType, user.UserV1PasswordCodeSentType,
-			user.HumanPasswordCodeAddedType, user.HumanPasswordCodeSentType)
+			user.UserV1PasswordCodeAddedType, user.UserV1PasswordCodeSentType, user.UserV1PasswordChangedType,
+			user.HumanPasswordCodeAddedType, user.HumanPasswordCodeSentType, user.HumanPasswordChangedType)
 		if err != nil {
 			return err
 		}
 		if alreadyHandled {
-			return nil
+			return errNotificationCanceled
 		}
 		code, err := crypto.DecryptString(e.Code, u.queries.UserDataCrypto)
 		if err != nil {
@@ -263,7 +390,7 @@ func (u *userNotifier) reducePasswordCodeAdded(event eventstore.Event) (*handler
 			return err
 		}
 
-		template, err := u.queries.MailTemplateByOrg(ctx, e.Aggregate().ResourceOwner, false)
+		template, err := u.queries.GetMailTemplates(ctx, e.Aggregate().ResourceOwner)
 		if err != nil {
 			return err
 		}
@@ -281,7 +408,7 @@ func (u *userNotifier) reducePasswordCodeAdded(event eventstore.Event) (*handler
 		if err != nil {
 			return err
 		}
-		notify := types.SendEmail(ctx, u.channels, string(template.Template), translator, notifyUser, colors, e)
+		notify := types.SendEmail(ctx, u.channels, template, translator, notifyUser, colors, e)
 		if e.NotificationType == domain.NotificationTypeSms {
 			notify = types.SendSMSTwilio(ctx, u.channels, translator, notifyUser, colors, e)
 		}
@@ -307,6 +434,7 @@ func (u *userNotifier) reduceOTPSMSCodeAdded(event eventstore.Event) (*handler.S
 		u.commands.HumanOTPSMSCodeSent,
 		user.HumanOTPSMSCodeAddedType,
 		user.HumanOTPSMSCodeSentType,
+		user.HumanOTPSMSCheckSucceededType,
 	)
 }
 
@@ -332,6 +460,7 @@ func (u *userNotifier) reduceSessionOTPSMSChallenged(event eventstore.Event) (*h
 		u.commands.OTPSMSSent,
 		session.OTPSMSChallengedType,
 		session.OTPSMSSentType,
+		session.OTPSMSCheckedType,
 	)
 }
 
@@ -344,45 +473,89 @@ func (u *userNotifier) reduceOTPSMS(
 	sentCommand func(ctx context.Context, userID string, resourceOwner string) (err error),
 	eventTypes ...eventstore.EventType,
 ) (*handler.Statement, error) {
-	ctx := HandlerContext(event.Aggregate())
-	alreadyHandled, err := u.checkIfCodeAlreadyHandledOrExpired(ctx, event, expiry, nil, eventTypes...)
-	if err != nil {
-		return nil, err
-	}
-	if alreadyHandled {
-		return handler.NewNoOpStatement(event), nil
-	}
-	plainCode, err := crypto.DecryptString(code, u.queries.UserDataCrypto)
-	if err != nil {
-		return nil, err
-	}
-	colors, err := u.queries.ActiveLabelPolicyByOrg(ctx, resourceOwner, false)
-	if err != nil {
-		return nil, err
-	}
+	return u.newStatement(event, domain.NotificationTypeSms, func(ex handler.Executer, projectionName string) error {
+		ctx := HandlerContext(event.Aggregate())
+		alreadyHandled, err := u.checkIfCodeAlreadyHandledOrExpired(ctx, event, expiry, nil, eventTypes...)
+		if err != nil {
+			return err
+		}
+		if alreadyHandled {
+			return errNotificationCanceled
+		}
+		plainCode, err := crypto.DecryptString(code, u.queries.UserDataCrypto)
+		if err != nil {
+			return err
+		}
+		colors, err := u.queries.ActiveLabelPolicyByOrg(ctx, resourceOwner, false)
+		if err != nil {
+			return err
+		}
 
-	notifyUser, err := u.queries.GetNotifyUserByID(ctx, true, userID)
-	if err != nil {
-		return nil, err
-	}
-	translator, err := u.queries.GetTranslatorWithOrgTexts(ctx, notifyUser.ResourceOwner, domain.VerifySMSOTPMessageType)
-	if err != nil {
-		return nil, err
-	}
-	ctx, err = u.queries.Origin(ctx, event)
-	if err != nil {
-		return nil, err
-	}
-	notify := types.SendSMSTwilio(ctx, u.channels, translator, notifyUser, colors, event)
-	err = notify.SendOTPSMSCode(ctx, plainCode, expiry)
-	if err != nil {
-		return nil, err
-	}
-	err = sentCommand(ctx, event.Aggregate().ID, event.Aggregate().ResourceOwner)
-	if err != nil {
-		return nil, err
+		notifyUser, err := u.queries.GetNotifyUserByID(ctx, true, userID)
+		if err != nil {
+			return err
+		}
+		translator, err := u.queries.GetTranslatorWithOrgTexts(ctx, notifyUser.ResourceOwner, domain.VerifySMSOTPMessageType)
+		if err != nil {
+			return err
+		}
+		ctx, err = u.queries.Origin(ctx, event)
+		if err != nil {
+			return err
+		}
+		notify := types.SendSMSTwilio(ctx, u.channels, translator, notifyUser, colors, event)
+		err = notify.SendOTPSMSCode(ctx, plainCode, expiry)
+		if err != nil {
+			return err
+		}
+		return sentCommand(ctx, event.Aggregate().ID, event.Aggregate().ResourceOwner)
+	}), nil
+}
+
+func (u *userNotifier) reduceSessionPushChallenged(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*session.PushChallengedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Pq1wt", "reduce.wrong.event.type %s", session.PushChallengedType)
 	}
-	return handler.NewNoOpStatement(event), nil
+	return u.newStatement(e, domain.NotificationTypePush, func(ex handler.Executer, projectionName string) error {
+		ctx := HandlerContext(event.Aggregate())
+		alreadyHandled, err := u.checkIfCodeAlreadyHandledOrExpired(ctx, event, e.Expiry, nil,
+			session.PushChallengedType, session.PushSentType, session.PushCheckedType)
+		if err != nil {
+			return err
+		}
+		if alreadyHandled {
+			return errNotificationCanceled
+		}
+		s, err := u.queries.SessionByID(ctx, true, e.Aggregate().ID, "")
+		if err != nil {
+			return err
+		}
+		notifyUser, err := u.queries.GetNotifyUserByID(ctx, true, s.UserFactor.UserID)
+		if err != nil {
+			return err
+		}
+		translator, err := u.queries.GetTranslatorWithOrgTexts(ctx, notifyUser.ResourceOwner, domain.VerifyPushMessageType)
+		if err != nil {
+			return err
+		}
+		ctx, err = u.queries.Origin(ctx, e)
+		if err != nil {
+			return err
+		}
+		// the challenge is sent to all devices, as the user approves it on any of them
+		var sent bool
+		for _, device := range e.Devices {
+			err = types.SendPushChallenge(ctx, u.channels, translator, notifyUser, device, e.Aggregate().ID, e.Challenge, e)
+			logging.WithFields("instance", e.Aggregate().InstanceID, "session", e.Aggregate().ID, "device", device.DeviceID).
+				OnError(err).Warn("unable to send push challenge to device")
+			sent = sent || err == nil
+		}
+		if !sent {
+			return err
+		}
+		return u.commands.PushChallengeSent(ctx, e.Aggregate().ID, e.Aggregate().ResourceOwner)
+	}), nil
 }
 
 func (u *userNotifier) reduceOTPEmailCodeAdded(event eventstore.Event) (*handler.Statement, error) {
@@ -407,6 +580,7 @@ func (u *userNotifier) reduceOTPEmailCodeAdded(event eventstore.Event) (*handler
 		u.commands.HumanOTPEmailCodeSent,
 		user.HumanOTPEmailCodeAddedType,
 		user.HumanOTPEmailCodeSentType,
+		user.HumanOTPEmailCheckSucceededType,
 	)
 }
 
@@ -457,54 +631,52 @@ func (u *userNotifier) reduceOTPEmail(
 	sentCommand func(ctx context.Context, userID string, resourceOwner string) (err error),
 	eventTypes ...eventstore.EventType,
 ) (*handler.Statement, error) {
-	ctx := HandlerContext(event.Aggregate())
-	alreadyHandled, err := u.checkIfCodeAlreadyHandledOrExpired(ctx, event, expiry, nil, eventTypes...)
-	if err != nil {
-		return nil, err
-	}
-	if alreadyHandled {
-		return handler.NewNoOpStatement(event), nil
-	}
-	plainCode, err := crypto.DecryptString(code, u.queries.UserDataCrypto)
-	if err != nil {
-		return nil, err
-	}
-	colors, err := u.queries.ActiveLabelPolicyByOrg(ctx, resourceOwner, false)
-	if err != nil {
-		return nil, err
-	}
+	return u.newStatement(event, domain.NotificationTypeEmail, func(ex handler.Executer, projectionName string) error {
+		ctx := HandlerContext(event.Aggregate())
+		alreadyHandled, err := u.checkIfCodeAlreadyHandledOrExpired(ctx, event, expiry, nil, eventTypes...)
+		if err != nil {
+			return err
+		}
+		if alreadyHandled {
+			return errNotificationCanceled
+		}
+		plainCode, err := crypto.DecryptString(code, u.queries.UserDataCrypto)
+		if err != nil {
+			return err
+		}
+		colors, err := u.queries.ActiveLabelPolicyByOrg(ctx, resourceOwner, false)
+		if err != nil {
+			return err
+		}
 
-	template, err := u.queries.MailTemplateByOrg(ctx, resourceOwner, false)
-	if err != nil {
-		return nil, err
-	}
+		template, err := u.queries.GetMailTemplates(ctx, resourceOwner)
+		if err != nil {
+			return err
+		}
 
-	notifyUser, err := u.queries.GetNotifyUserByID(ctx, true, userID)
-	if err != nil {
-		return nil, err
-	}
-	translator, err := u.queries.GetTranslatorWithOrgTexts(ctx, resourceOwner, domain.VerifyEmailOTPMessageType)
-	if err != nil {
-		return nil, err
-	}
-	ctx, err = u.queries.Origin(ctx, event)
-	if err != nil {
-		return nil, err
-	}
-	url, err := urlTmpl(plainCode, http_util.ComposedOrigin(ctx), notifyUser)
-	if err != nil {
-		return nil, err
-	}
-	notify := types.SendEmail(ctx, u.channels, string(template.Template), translator, notifyUser, colors, event)
-	err = notify.SendOTPEmailCode(ctx, url, plainCode, expiry)
-	if err != nil {
-		return nil, err
-	}
-	err = sentCommand(ctx, event.Aggregate().ID, event.Aggregate().ResourceOwner)
-	if err != nil {
-		return nil, err
-	}
-	return handler.NewNoOpStatement(event), nil
+		notifyUser, err := u.queries.GetNotifyUserByID(ctx, true, userID)
+		if err != nil {
+			return err
+		}
+		translator, err := u.queries.GetTranslatorWithOrgTexts(ctx, resourceOwner, domain.VerifyEmailOTPMessageType)
+		if err != nil {
+			return err
+		}
+		ctx, err = u.queries.Origin(ctx, event)
+		if err != nil {
+			return err
+		}
+		url, err := urlTmpl(plainCode, http_util.ComposedOrigin(ctx), notifyUser)
+		if err != nil {
+			return err
+		}
+		notify := types.SendEmail(ctx, u.channels, template, translator, notifyUser, colors, event)
+		err = notify.SendOTPEmailCode(ctx, url, plainCode, expiry)
+		if err != nil {
+			return err
+		}
+		return sentCommand(ctx, event.Aggregate().ID, event.Aggregate().ResourceOwner)
+	}), nil
 }
 
 func (u *userNotifier) reduceDomainClaimed(event eventstore.Event) (*handler.Statement, error) {
@@ -512,7 +684,7 @@ func (u *userNotifier) reduceDomainClaimed(event eventstore.Event) (*handler.Sta
 	if !ok {
 		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Drh5w", "reduce.wrong.event.type %s", user.UserDomainClaimedType)
 	}
-	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
+	return u.newStatement(event, domain.NotificationTypeEmail, func(ex handler.Executer, projectionName string) error {
 		ctx := HandlerContext(event.Aggregate())
 		alreadyHandled, err := u.queries.IsAlreadyHandled(ctx, event, nil,
 			user.UserDomainClaimedType, user.UserDomainClaimedSentType)
@@ -520,14 +692,14 @@ func (u *userNotifier) reduceDomainClaimed(event eventstore.Event) (*handler.Sta
 			return err
 		}
 		if alreadyHandled {
-			return nil
+			return errNotificationCanceled
 		}
 		colors, err := u.queries.ActiveLabelPolicyByOrg(ctx, e.Aggregate().ResourceOwner, false)
 		if err != nil {
 			return err
 		}
 
-		template, err := u.queries.MailTemplateByOrg(ctx, e.Aggregate().ResourceOwner, false)
+		template, err := u.queries.GetMailTemplates(ctx, e.Aggregate().ResourceOwner)
 		if err != nil {
 			return err
 		}
@@ -545,7 +717,7 @@ func (u *userNotifier) reduceDomainClaimed(event eventstore.Event) (*handler.Sta
 		if err != nil {
 			return err
 		}
-		err = types.SendEmail(ctx, u.channels, string(template.Template), translator, notifyUser, colors, e).
+		err = types.SendEmail(ctx, u.channels, template, translator, notifyUser, colors, e).
 			SendDomainClaimed(ctx, notifyUser, e.UserName)
 		if err != nil {
 			return err
@@ -563,14 +735,14 @@ func (u *userNotifier) reducePasswordlessCodeRequested(event eventstore.Event) (
 		return handler.NewNoOpStatement(e), nil
 	}
 
-	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
+	return u.newStatement(event, domain.NotificationTypeEmail, func(ex handler.Executer, projectionName string) error {
 		ctx := HandlerContext(event.Aggregate())
 		alreadyHandled, err := u.checkIfCodeAlreadyHandledOrExpired(ctx, event, e.Expiry, map[string]interface{}{"id": e.ID}, user.HumanPasswordlessInitCodeSentType)
 		if err != nil {
 			return err
 		}
 		if alreadyHandled {
-			return nil
+			return errNotificationCanceled
 		}
 		code, err := crypto.DecryptString(e.Code, u.queries.UserDataCrypto)
 		if err != nil {
@@ -581,7 +753,7 @@ func (u *userNotifier) reducePasswordlessCodeRequested(event eventstore.Event) (
 			return err
 		}
 
-		template, err := u.queries.MailTemplateByOrg(ctx, e.Aggregate().ResourceOwner, false)
+		template, err := u.queries.GetMailTemplates(ctx, e.Aggregate().ResourceOwner)
 		if err != nil {
 			return err
 		}
@@ -599,7 +771,7 @@ func (u *userNotifier) reducePasswordlessCodeRequested(event eventstore.Event) (
 		if err != nil {
 			return err
 		}
-		err = types.SendEmail(ctx, u.channels, string(template.Template), translator, notifyUser, colors, e).
+		err = types.SendEmail(ctx, u.channels, template, translator, notifyUser, colors, e).
 			SendPasswordlessRegistrationLink(ctx, notifyUser, code, e.ID, e.URLTemplate)
 		if err != nil {
 			return err
@@ -614,26 +786,26 @@ func (u *userNotifier) reducePasswordChanged(event eventstore.Event) (*handler.S
 		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Yko2z8", "reduce.wrong.event.type %s", user.HumanPasswordChangedType)
 	}
 
-	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
+	return u.newStatement(event, domain.NotificationTypeEmail, func(ex handler.Executer, projectionName string) error {
 		ctx := HandlerContext(event.Aggregate())
 		alreadyHandled, err := u.queries.IsAlreadyHandled(ctx, event, nil, user.HumanPasswordChangeSentType)
 		if err != nil {
 			return err
 		}
 		if alreadyHandled {
-			return nil
+			return errNotificationCanceled
 		}
 
 		notificationPolicy, err := u.queries.NotificationPolicyByOrg(ctx, true, e.Aggregate().ResourceOwner, false)
 		if zerrors.IsNotFound(err) {
-			return nil
+			return errNotificationCanceled
 		}
 		if err != nil {
 			return err
 		}
 
 		if !notificationPolicy.PasswordChange {
-			return nil
+			return errNotificationCanceled
 		}
 
 		colors, err := u.queries.ActiveLabelPolicyByOrg(ctx, e.Aggregate().ResourceOwner, false)
@@ -641,7 +813,7 @@ func (u *userNotifier) reducePasswordChanged(event eventstore.Event) (*handler.S
 			return err
 		}
 
-		template, err := u.queries.MailTemplateByOrg(ctx, e.Aggregate().ResourceOwner, false)
+		template, err := u.queries.GetMailTemplates(ctx, e.Aggregate().ResourceOwner)
 		if err != nil {
 			return err
 		}
@@ -658,7 +830,7 @@ func (u *userNotifier) reducePasswordChanged(event eventstore.Event) (*handler.S
 		if err != nil {
 			return err
 		}
-		err = types.SendEmail(ctx, u.channels, string(template.Template), translator, notifyUser, colors, e).
+		err = types.SendEmail(ctx, u.channels, template, translator, notifyUser, colors, e).
 			SendPasswordChange(ctx, notifyUser)
 		if err != nil {
 			return err
@@ -667,6 +839,519 @@ func (u *userNotifier) reducePasswordChanged(event eventstore.Event) (*handler.S
 	}), nil
 }
 
+func (u *userNotifier) reduceUserDeactivationWarningAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.UserDeactivationWarningAddedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Tb4ke", "reduce.wrong.event.type %s", user.UserDeactivationWarningAddedType)
+	}
+
+	return u.newStatement(event, domain.NotificationTypeEmail, func(ex handler.Executer, projectionName string) error {
+		ctx := HandlerContext(event.Aggregate())
+		alreadyHandled, err := u.queries.IsAlreadyHandled(ctx, event, nil, user.UserDeactivationWarningSentType)
+		if err != nil {
+			return err
+		}
+		if alreadyHandled {
+			return errNotificationCanceled
+		}
+		colors, err := u.queries.ActiveLabelPolicyByOrg(ctx, e.Aggregate().ResourceOwner, false)
+		if err != nil {
+			return err
+		}
+
+		template, err := u.queries.GetMailTemplates(ctx, e.Aggregate().ResourceOwner)
+		if err != nil {
+			return err
+		}
+
+		notifyUser, err := u.queries.GetNotifyUserByID(ctx, true, e.Aggregate().ID)
+		if err != nil {
+			return err
+		}
+		translator, err := u.queries.GetTranslatorWithOrgTexts(ctx, notifyUser.ResourceOwner, domain.UserDeactivationWarningMessageType)
+		if err != nil {
+			return err
+		}
+		ctx, err = u.queries.Origin(ctx, e)
+		if err != nil {
+			return err
+		}
+		err = types.SendEmail(ctx, u.channels, template, translator, notifyUser, colors, e).
+			SendUserDeactivationWarning(ctx, notifyUser, e.DeactivationDate)
+		if err != nil {
+			return err
+		}
+		return u.commands.UserDeactivationWarningSent(ctx, e.Aggregate().ResourceOwner, e.Aggregate().ID)
+	}), nil
+}
+
+func (u *userNotifier) reduceUserDeletionWarningAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.UserDeletionWarningAddedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Ie6zp", "reduce.wrong.event.type %s", user.UserDeletionWarningAddedType)
+	}
+
+	return u.newStatement(event, domain.NotificationTypeEmail, func(ex handler.Executer, projectionName string) error {
+		ctx := HandlerContext(event.Aggregate())
+		alreadyHandled, err := u.queries.IsAlreadyHandled(ctx, event, nil, user.UserDeletionWarningSentType)
+		if err != nil {
+			return err
+		}
+		if alreadyHandled {
+			return errNotificationCanceled
+		}
+		colors, err := u.queries.ActiveLabelPolicyByOrg(ctx, e.Aggregate().ResourceOwner, false)
+		if err != nil {
+			return err
+		}
+
+		template, err := u.queries.GetMailTemplates(ctx, e.Aggregate().ResourceOwner)
+		if err != nil {
+			return err
+		}
+
+		notifyUser, err := u.queries.GetNotifyUserByID(ctx, true, e.Aggregate().ID)
+		if err != nil {
+			return err
+		}
+		translator, err := u.queries.GetTranslatorWithOrgTexts(ctx, notifyUser.ResourceOwner, domain.UserDeletionWarningMessageType)
+		if err != nil {
+			return err
+		}
+		ctx, err = u.queries.Origin(ctx, e)
+		if err != nil {
+			return err
+		}
+		err = types.SendEmail(ctx, u.channels, template, translator, notifyUser, colors, e).
+			SendUserDeletionWarning(ctx, notifyUser, e.DeletionDate)
+		if err != nil {
+			return err
+		}
+		return u.commands.UserDeletionWarningSent(ctx, e.Aggregate().ResourceOwner, e.Aggregate().ID)
+	}), nil
+}
+
+func (u *userNotifier) reduceMFAAdded(event eventstore.Event) (*handler.Statement, error) {
+	switch event.(type) {
+	case *user.HumanOTPVerifiedEvent,
+		*user.HumanU2FVerifiedEvent,
+		*user.HumanOTPSMSAddedEvent,
+		*user.HumanOTPEmailAddedEvent:
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Ma3ds", "reduce.wrong.event.type %v", []eventstore.EventType{user.HumanMFAOTPVerifiedType, user.HumanU2FTokenVerifiedType, user.HumanOTPSMSAddedType, user.HumanOTPEmailAddedType})
+	}
+	return u.securityAlertStatement(event, domain.MFAAddedMessageType, domain.NotificationTypeEmail, nil), nil
+}
+
+func (u *userNotifier) reduceMFARemoved(event eventstore.Event) (*handler.Statement, error) {
+	switch event.(type) {
+	case *user.HumanOTPRemovedEvent,
+		*user.HumanU2FRemovedEvent,
+		*user.HumanOTPSMSRemovedEvent,
+		*user.HumanOTPEmailRemovedEvent:
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Mr8wq", "reduce.wrong.event.type %v", []eventstore.EventType{user.HumanMFAOTPRemovedType, user.HumanU2FTokenRemovedType, user.HumanOTPSMSRemovedType, user.HumanOTPEmailRemovedType})
+	}
+	return u.securityAlertStatement(event, domain.MFARemovedMessageType, domain.NotificationTypeEmail, nil), nil
+}
+
+func (u *userNotifier) reduceEmailChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.HumanEmailChangedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Ec4lx", "reduce.wrong.event.type %s", user.HumanEmailChangedType)
+	}
+	// the alert is sent to the previously verified address, so the owner of the account is informed
+	// even if the new address is controlled by somebody else
+	return u.securityAlertStatement(event, domain.EmailChangedMessageType, domain.NotificationTypeEmail,
+		func(ctx context.Context, notifyUser *query.NotifyUser) (*user.BrowserInfo, error) {
+			contact, err := u.queries.VerifiedContactBefore(ctx, e)
+			if err != nil {
+				return nil, err
+			}
+			if contact.Email == "" || contact.Email == string(e.EmailAddress) {
+				return nil, errNotificationCanceled
+			}
+			notifyUser.VerifiedEmail = contact.Email
+			return nil, nil
+		},
+	), nil
+}
+
+func (u *userNotifier) reducePhoneChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.HumanPhoneChangedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Pc7vn", "reduce.wrong.event.type %s", user.HumanPhoneChangedType)
+	}
+	// the alert is sent to the previously verified number, see reduceEmailChanged
+	return u.securityAlertStatement(event, domain.PhoneChangedMessageType, domain.NotificationTypeSms,
+		func(ctx context.Context, notifyUser *query.NotifyUser) (*user.BrowserInfo, error) {
+			contact, err := u.queries.VerifiedContactBefore(ctx, e)
+			if err != nil {
+				return nil, err
+			}
+			if contact.Phone == "" || contact.Phone == string(e.PhoneNumber) {
+				return nil, errNotificationCanceled
+			}
+			notifyUser.VerifiedPhone = contact.Phone
+			return nil, nil
+		},
+	), nil
+}
+
+func (u *userNotifier) reducePasskeyAdded(event eventstore.Event) (*handler.Statement, error) {
+	if _, ok := event.(*user.HumanPasswordlessVerifiedEvent); !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Pk2ha", "reduce.wrong.event.type %s", user.HumanPasswordlessTokenVerifiedType)
+	}
+	return u.securityAlertStatement(event, domain.PasskeyAddedMessageType, domain.NotificationTypeEmail, nil), nil
+}
+
+// newLoginComparedLogins is the number of the user's latest logins a login is compared with,
+// to decide whether it's from a new device.
+const newLoginComparedLogins = 50
+
+func (u *userNotifier) reduceLoginSucceeded(event eventstore.Event) (*handler.Statement, error) {
+	var authRequest *user.AuthRequestInfo
+	switch e := event.(type) {
+	case *user.HumanPasswordCheckSucceededEvent:
+		authRequest = e.AuthRequestInfo
+	case *user.HumanPasswordlessCheckSucceededEvent:
+		authRequest = e.AuthRequestInfo
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Nl5rt", "reduce.wrong.event.type %v", []eventstore.EventType{user.HumanPasswordCheckSucceededType, user.HumanPasswordlessTokenCheckSucceededType})
+	}
+	return u.securityAlertStatement(event, domain.NewLoginMessageType, domain.NotificationTypeEmail,
+		func(ctx context.Context, _ *query.NotifyUser) (*user.BrowserInfo, error) {
+			if authRequest == nil || authRequest.BrowserInfo == nil {
+				return nil, errNotificationCanceled
+			}
+			logins, err := u.queries.LoginsBefore(ctx, event, newLoginComparedLogins)
+			if err != nil {
+				return nil, err
+			}
+			// the very first login of a user is not reported
+			if len(logins) == 0 {
+				return nil, errNotificationCanceled
+			}
+			knownBrowser := slices.ContainsFunc(logins, func(browser *user.BrowserInfo) bool {
+				return browser != nil &&
+					browser.UserAgent == authRequest.UserAgent &&
+					browser.RemoteIP.Equal(authRequest.RemoteIP)
+			})
+			if knownBrowser {
+				return nil, errNotificationCanceled
+			}
+			return authRequest.BrowserInfo, nil
+		},
+	), nil
+}
+
+func (u *userNotifier) reducePersonalAccessTokenAdded(event eventstore.Event) (*handler.Statement, error) {
+	if _, ok := event.(*user.PersonalAccessTokenAddedEvent); !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Pt6zu", "reduce.wrong.event.type %s", user.PersonalAccessTokenAddedType)
+	}
+	return u.securityAlertStatement(event, domain.PersonalAccessTokenAddedMessageType, domain.NotificationTypeEmail, nil), nil
+}
+
+func (u *userNotifier) reduceUserLocked(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.UserLockedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Ul1ko", "reduce.wrong.event.type %s", user.UserLockedType)
+	}
+	// only lockouts after failed checks are reported, not users locked by an administrator
+	if !e.Lockout {
+		return handler.NewNoOpStatement(e), nil
+	}
+	return u.securityAlertStatement(event, domain.AccountLockedMessageType, domain.NotificationTypeEmail, nil), nil
+}
+
+// reduceBreakGlassUsed alerts the recipients and calls the Actions targets of the break-glass policy
+// on every login of an emergency access account.
+// Other than the security alerts, it is sent regardless of the notification policy.
+// Every delivery is marked on its own, so a retry of the statement only repeats the failed ones.
+func (u *userNotifier) reduceBreakGlassUsed(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.HumanBreakGlassUsedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Bg1us", "reduce.wrong.event.type %s", user.HumanBreakGlassUsedType)
+	}
+	return u.newStatement(event, domain.NotificationTypeEmail, func(ex handler.Executer, projectionName string) error {
+		ctx := HandlerContext(event.Aggregate())
+		policy, err := u.queries.BreakGlassPolicy(ctx)
+		if err != nil {
+			return err
+		}
+		breakGlassUser, err := u.queries.GetNotifyUserByID(ctx, true, e.Aggregate().ID)
+		if err != nil {
+			return err
+		}
+		ctx, err = u.queries.Origin(ctx, event)
+		if err != nil {
+			return err
+		}
+		var deliveryErr error
+		for _, recipientID := range policy.RecipientUserIDs {
+			err = u.deliverBreakGlassAlert(ctx, e, recipientID, "", func() error {
+				return u.sendBreakGlassUsed(ctx, e, recipientID, breakGlassUser.PreferredLoginName)
+			})
+			if err != nil {
+				logging.WithFields("instance", e.Aggregate().InstanceID, "recipient", recipientID).WithError(err).Error("could not send break-glass alert")
+				deliveryErr = err
+			}
+		}
+		if err = u.callBreakGlassTargets(ctx, e, policy.TargetIDs, breakGlassUser.PreferredLoginName); err != nil {
+			deliveryErr = err
+		}
+		return deliveryErr
+	}), nil
+}
+
+// deliverBreakGlassAlert sends the alert to either the recipient or the target, unless it was already delivered,
+// and marks the delivery afterward.
+func (u *userNotifier) deliverBreakGlassAlert(ctx context.Context, e *user.HumanBreakGlassUsedEvent, recipientID, targetID string, send func() error) error {
+	data := map[string]interface{}{
+		"alertType":          domain.BreakGlassUsedMessageType,
+		"triggeringSequence": e.Sequence(),
+	}
+	if recipientID != "" {
+		data["recipientID"] = recipientID
+	}
+	if targetID != "" {
+		data["targetID"] = targetID
+	}
+	alreadyHandled, err := u.queries.IsAlreadyHandled(ctx, e, data, user.HumanSecurityAlertSentType)
+	if err != nil || alreadyHandled {
+		return err
+	}
+	if err = send(); err != nil {
+		return err
+	}
+	return u.commands.SecurityAlertDelivered(ctx, e.Aggregate().ResourceOwner, e.Aggregate().ID, domain.BreakGlassUsedMessageType, e.Sequence(), recipientID, targetID)
+}
+
+func (u *userNotifier) sendBreakGlassUsed(ctx context.Context, e *user.HumanBreakGlassUsedEvent, recipientID, loginName string) error {
+	recipient, err := u.queries.GetNotifyUserByID(ctx, true, recipientID)
+	if err != nil {
+		return err
+	}
+	if recipient.VerifiedEmail == "" {
+		return nil
+	}
+	colors, err := u.queries.ActiveLabelPolicyByOrg(ctx, recipient.ResourceOwner, false)
+	if err != nil {
+		return err
+	}
+	translator, err := u.queries.GetTranslatorWithOrgTexts(ctx, recipient.ResourceOwner, domain.BreakGlassUsedMessageType)
+	if err != nil {
+		return err
+	}
+	template, err := u.queries.GetMailTemplates(ctx, recipient.ResourceOwner)
+	if err != nil {
+		return err
+	}
+	var browserInfo *user.BrowserInfo
+	if e.AuthRequestInfo != nil {
+		browserInfo = e.BrowserInfo
+	}
+	return types.SendEmail(ctx, u.channels, template, translator, recipient, colors, e).
+		SendBreakGlassUsed(ctx, recipient, loginName, e.CreatedAt(), browserInfo)
+}
+
+// breakGlassUsedRequest is the body sent to the Actions targets of the break-glass policy.
+type breakGlassUsedRequest struct {
+	InstanceID    string    `json:"instanceID"`
+	UserID        string    `json:"userID"`
+	ResourceOwner string    `json:"resourceOwner"`
+	LoginName     string    `json:"loginName"`
+	SessionID     string    `json:"sessionID,omitempty"`
+	Expiration    time.Time `json:"expiration"`
+	CreationDate  time.Time `json:"creationDate"`
+	UserAgent     string    `json:"userAgent,omitempty"`
+	RemoteIP      string    `json:"remoteIP,omitempty"`
+}
+
+func (r *breakGlassUsedRequest) GetHTTPRequestBody() []byte {
+	data, err := json.Marshal(r)
+	if err != nil {
+		return nil
+	}
+	return data
+}
+
+// callBreakGlassTargets calls the targets with the details of the login.
+// A failing target does not block the other targets, its error is returned after all targets were called.
+func (u *userNotifier) callBreakGlassTargets(ctx context.Context, e *user.HumanBreakGlassUsedEvent, targetIDs []string, loginName string) error {
+	if len(targetIDs) == 0 {
+		return nil
+	}
+	idQuery, err := query.NewTargetInIDsSearchQuery(targetIDs)
+	if err != nil {
+		return err
+	}
+	targets, err := u.queries.SearchTargets(ctx, &query.TargetSearchQueries{Queries: []query.SearchQuery{idQuery}})
+	if err != nil {
+		return err
+	}
+	request := &breakGlassUsedRequest{
+		InstanceID:    e.Aggregate().InstanceID,
+		UserID:        e.Aggregate().ID,
+		ResourceOwner: e.Aggregate().ResourceOwner,
+		LoginName:     loginName,
+		SessionID:     e.SessionID,
+		Expiration:    e.Expiration,
+		CreationDate:  e.CreatedAt(),
+	}
+	if e.AuthRequestInfo != nil && e.BrowserInfo != nil {
+		request.UserAgent = e.UserAgent
+		if e.RemoteIP != nil {
+			request.RemoteIP = e.RemoteIP.String()
+		}
+	}
+	var callErr error
+	for _, target := range targets.Targets {
+		err = u.deliverBreakGlassAlert(ctx, e, "", target.ID, func() error {
+			_, err := execution.CallTarget(ctx, target, request)
+			return err
+		})
+		if err != nil {
+			logging.WithFields("instance", e.Aggregate().InstanceID, "target", target.ID).WithError(err).Error("could not call break-glass target")
+			callErr = err
+		}
+	}
+	return callErr
+}
+
+// securityAlertStatement sends the security alert of the alertType to the verified email address or phone number of the user,
+// if security alerts are enabled in the notification policy.
+// The optional prepare function can change the recipient and returns the browser info of alerts about logins.
+func (u *userNotifier) securityAlertStatement(
+	event eventstore.Event,
+	alertType string,
+	notificationType domain.NotificationType,
+	prepare func(ctx context.Context, notifyUser *query.NotifyUser) (*user.BrowserInfo, error),
+) *handler.Statement {
+	return u.newStatement(event, notificationType, func(ex handler.Executer, projectionName string) error {
+		ctx := HandlerContext(event.Aggregate())
+		alreadyHandled, err := u.queries.IsAlreadyHandled(ctx, event,
+			map[string]interface{}{
+				"alertType":          alertType,
+				"triggeringSequence": event.Sequence(),
+			},
+			user.HumanSecurityAlertSentType,
+		)
+		if err != nil {
+			return err
+		}
+		if alreadyHandled {
+			return errNotificationCanceled
+		}
+
+		notificationPolicy, err := u.queries.NotificationPolicyByOrg(ctx, true, event.Aggregate().ResourceOwner, false)
+		if zerrors.IsNotFound(err) {
+			return errNotificationCanceled
+		}
+		if err != nil {
+			return err
+		}
+		if !notificationPolicy.SecurityAlerts {
+			return errNotificationCanceled
+		}
+
+		notifyUser, err := u.queries.GetNotifyUserByID(ctx, true, event.Aggregate().ID)
+		if err != nil {
+			return err
+		}
+		var browserInfo *user.BrowserInfo
+		if prepare != nil {
+			browserInfo, err = prepare(ctx, notifyUser)
+			if err != nil {
+				return err
+			}
+		}
+		if notificationType == domain.NotificationTypeEmail && notifyUser.VerifiedEmail == "" ||
+			notificationType == domain.NotificationTypeSms && notifyUser.VerifiedPhone == "" {
+			return errNotificationCanceled
+		}
+
+		colors, err := u.queries.ActiveLabelPolicyByOrg(ctx, event.Aggregate().ResourceOwner, false)
+		if err != nil {
+			return err
+		}
+		translator, err := u.queries.GetTranslatorWithOrgTexts(ctx, notifyUser.ResourceOwner, alertType)
+		if err != nil {
+			return err
+		}
+		ctx, err = u.queries.Origin(ctx, event)
+		if err != nil {
+			return err
+		}
+		var notify types.Notify
+		if notificationType == domain.NotificationTypeSms {
+			notify = types.SendSMSTwilio(ctx, u.channels, translator, notifyUser, colors, event)
+		} else {
+			template, err := u.queries.GetMailTemplates(ctx, event.Aggregate().ResourceOwner)
+			if err != nil {
+				return err
+			}
+			notify = types.SendEmail(ctx, u.channels, template, translator, notifyUser, colors, event)
+		}
+		err = notify.SendSecurityAlert(ctx, notifyUser, alertType, event.CreatedAt(), browserInfo)
+		if err != nil {
+			return err
+		}
+		return u.commands.SecurityAlertSent(ctx, event.Aggregate().ResourceOwner, event.Aggregate().ID, alertType, event.Sequence())
+	})
+}
+
+func (u *userNotifier) reduceTestMessageRequested(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.TestMessageRequestedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Tm7rq", "reduce.wrong.event.type %s", org.TestMessageRequestedType)
+	}
+
+	return u.newStatement(event, e.NotificationType, func(ex handler.Executer, projectionName string) error {
+		ctx := HandlerContext(event.Aggregate())
+		alreadyHandled, err := u.queries.IsAlreadyHandled(ctx, event, map[string]interface{}{"requestSequence": e.Sequence()}, org.TestMessageSentType)
+		if err != nil {
+			return err
+		}
+		if alreadyHandled {
+			return errNotificationCanceled
+		}
+		notifyUser, err := u.queries.previewUser(ctx, e.Aggregate().ResourceOwner, e.Language, e.UserID)
+		if err != nil {
+			return err
+		}
+		colors, err := u.queries.ActiveLabelPolicyByOrg(ctx, e.Aggregate().ResourceOwner, false)
+		if err != nil {
+			return err
+		}
+		translator, err := u.queries.GetTranslatorWithOrgTexts(ctx, e.Aggregate().ResourceOwner, e.MessageType)
+		if err != nil {
+			return err
+		}
+		ctx, err = u.queries.Origin(ctx, e)
+		if err != nil {
+			return err
+		}
+		var notify types.Notify
+		if e.NotificationType == domain.NotificationTypeSms {
+			notifyUser.VerifiedPhone = e.Recipient
+			notify = types.SendSMSTwilio(ctx, u.channels, translator, notifyUser, colors, e)
+		} else {
+			template, err := u.queries.GetMailTemplates(ctx, e.Aggregate().ResourceOwner)
+			if err != nil {
+				return err
+			}
+			notifyUser.VerifiedEmail = e.Recipient
+			notify = types.SendEmail(ctx, u.channels, template, translator, notifyUser, colors, e)
+		}
+		err = notify(http_util.ComposedOrigin(ctx), previewArgs(ctx, notifyUser), e.MessageType, false)
+		if err != nil {
+			return err
+		}
+		return u.commands.OrgTestMessageSent(ctx, e.Aggregate().ResourceOwner, e.Sequence())
+	}), nil
+}
+
 func (u *userNotifier) reducePhoneCodeAdded(event eventstore.Event) (*handler.Statement, error) {
 	e, ok := event.(*user.HumanPhoneCodeAddedEvent)
 	if !ok {
@@ -676,16 +1361,16 @@ func (u *userNotifier) reducePhoneCodeAdded(event eventstore.Event) (*handler.St
 		return handler.NewNoOpStatement(e), nil
 	}
 
-	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
+	return u.newStatement(event, domain.NotificationTypeSms, func(ex handler.Executer, projectionName string) error {
 		ctx := HandlerContext(event.Aggregate())
 		alreadyHandled, err := u.checkIfCodeAlreadyHandledOrExpired(ctx, event, e.Expiry, nil,
-			user.UserV1PhoneCodeAddedType, user.UserV1PhoneCodeSentType,
-			user.HumanPhoneCodeAddedType, user.HumanPhoneCodeSentType)
+			user.UserV1PhoneCodeAddedType, user.UserV1PhoneCodeSentType, user.UserV1PhoneVerifiedType,
+			user.HumanPhoneCodeAddedType, user.HumanPhoneCodeSentType, user.HumanPhoneVerifiedType)
 		if err != nil {
 			return err
 		}
 		if alreadyHandled {
-			return nil
+			return errNotificationCanceled
 		}
 		code, err := crypto.DecryptString(e.Code, u.queries.UserDataCrypto)
 		if err != nil {
@@ -718,6 +1403,35 @@ func (u *userNotifier) reducePhoneCodeAdded(event eventstore.Event) (*handler.St
 	}), nil
 }
 
+// newStatement either enqueues the notification of the event to be sent by the [NotificationWorker]
+// or sends it directly by executing the passed send function, depending on the mode of the notifier.
+func (u *userNotifier) newStatement(event eventstore.Event, notificationType domain.NotificationType, send handler.Exec) *handler.Statement {
+	switch u.mode {
+	case notifierModeWorker:
+		return handler.NewStatement(event, send)
+	case notifierModeEnqueue:
+		return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
+			ctx := HandlerContext(event.Aggregate())
+			return u.commands.RequestNotification(ctx, &notification.Request{
+				TriggeringAggregateType: event.Aggregate().Type,
+				TriggeringAggregateID:   event.Aggregate().ID,
+				TriggeringEventType:     event.Type(),
+				TriggeringSequence:      event.Sequence(),
+				TriggeringResourceOwner: event.Aggregate().ResourceOwner,
+				NotificationType:        notificationType,
+			})
+		})
+	default:
+		return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
+			err := send(ex, projectionName)
+			if errors.Is(err, errNotificationCanceled) {
+				return nil
+			}
+			return err
+		})
+	}
+}
+
 func (u *userNotifier) checkIfCodeAlreadyHandledOrExpired(ctx context.Context, event eventstore.Event, expiry time.Duration, data map[string]interface{}, eventTypes ...eventstore.EventType) (bool, error) {
 	if event.CreatedAt().Add(expiry).Before(time.Now().UTC()) {
 		return true, nil
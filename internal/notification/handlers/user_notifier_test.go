@@ -12,19 +12,22 @@ import (
 	"golang.org/x/text/language"
 
 	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/eventstore/repository"
 	es_repo_mock "github.com/zitadel/zitadel/internal/eventstore/repository/mock"
 	channel_mock "github.com/zitadel/zitadel/internal/notification/channels/mock"
-	"github.com/zitadel/zitadel/internal/notification/channels/smtp"
-	"github.com/zitadel/zitadel/internal/notification/channels/twilio"
+	"github.com/zitadel/zitadel/internal/notification/channels/email"
+	"github.com/zitadel/zitadel/internal/notification/channels/sms"
 	"github.com/zitadel/zitadel/internal/notification/channels/webhook"
 	"github.com/zitadel/zitadel/internal/notification/handlers/mock"
 	"github.com/zitadel/zitadel/internal/notification/messages"
 	"github.com/zitadel/zitadel/internal/notification/senders"
 	"github.com/zitadel/zitadel/internal/notification/types"
 	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/repository/notification"
 	"github.com/zitadel/zitadel/internal/repository/session"
+	"github.com/zitadel/zitadel/internal/repository/org"
 	"github.com/zitadel/zitadel/internal/repository/user"
 )
 
@@ -59,9 +62,11 @@ func Test_userNotifier_reduceInitCodeAdded(t *testing.T) {
 			givenTemplate := "{{.LogoURL}}"
 			expectContent := fmt.Sprintf("%s%s/%s/%s", eventOrigin, assetsPath, policyID, logoURL)
 			w.message = messages.Email{
-				Recipients: []string{lastEmail},
-				Subject:    expectMailSubject,
-				Content:    expectContent,
+				Recipients:   []string{lastEmail},
+				Subject:      expectMailSubject,
+				Content:      expectContent,
+				TemplateType: domain.InitCodeMessageType,
+				Code:         "testcode",
 			}
 			codeAlg, code := cryptoValue(t, ctrl, "testcode")
 			expectTemplateQueries(queries, givenTemplate)
@@ -92,9 +97,11 @@ func Test_userNotifier_reduceInitCodeAdded(t *testing.T) {
 			givenTemplate := "{{.LogoURL}}"
 			expectContent := fmt.Sprintf("%s://%s:%d%s/%s/%s", externalProtocol, instancePrimaryDomain, externalPort, assetsPath, policyID, logoURL)
 			w.message = messages.Email{
-				Recipients: []string{lastEmail},
-				Subject:    expectMailSubject,
-				Content:    expectContent,
+				Recipients:   []string{lastEmail},
+				Subject:      expectMailSubject,
+				Content:      expectContent,
+				TemplateType: domain.InitCodeMessageType,
+				Code:         "testcode",
 			}
 			codeAlg, code := cryptoValue(t, ctrl, "testcode")
 			queries.EXPECT().SearchInstanceDomains(gomock.Any(), gomock.Any()).Return(&query.InstanceDomains{
@@ -131,9 +138,11 @@ func Test_userNotifier_reduceInitCodeAdded(t *testing.T) {
 			testCode := "testcode"
 			expectContent := fmt.Sprintf("%s/ui/login/user/init?authRequestID=%s&code=%s&loginname=%s&orgID=%s&passwordset=%t&userID=%s", eventOrigin, "", testCode, preferredLoginName, orgID, false, userID)
 			w.message = messages.Email{
-				Recipients: []string{lastEmail},
-				Subject:    expectMailSubject,
-				Content:    expectContent,
+				Recipients:   []string{lastEmail},
+				Subject:      expectMailSubject,
+				Content:      expectContent,
+				TemplateType: domain.InitCodeMessageType,
+				Code:         "testcode",
 			}
 			codeAlg, code := cryptoValue(t, ctrl, testCode)
 			expectTemplateQueries(queries, givenTemplate)
@@ -165,9 +174,11 @@ func Test_userNotifier_reduceInitCodeAdded(t *testing.T) {
 			testCode := "testcode"
 			expectContent := fmt.Sprintf("%s://%s:%d/ui/login/user/init?authRequestID=%s&code=%s&loginname=%s&orgID=%s&passwordset=%t&userID=%s", externalProtocol, instancePrimaryDomain, externalPort, "", testCode, preferredLoginName, orgID, false, userID)
 			w.message = messages.Email{
-				Recipients: []string{lastEmail},
-				Subject:    expectMailSubject,
-				Content:    expectContent,
+				Recipients:   []string{lastEmail},
+				Subject:      expectMailSubject,
+				Content:      expectContent,
+				TemplateType: domain.InitCodeMessageType,
+				Code:         "testcode",
 			}
 			codeAlg, code := cryptoValue(t, ctrl, testCode)
 			queries.EXPECT().SearchInstanceDomains(gomock.Any(), gomock.Any()).Return(&query.InstanceDomains{
@@ -204,9 +215,11 @@ func Test_userNotifier_reduceInitCodeAdded(t *testing.T) {
 			testCode := "testcode"
 			expectContent := fmt.Sprintf("%s://%s:%d/ui/login/user/init?authRequestID=%s&code=%s&loginname=%s&orgID=%s&passwordset=%t&userID=%s", externalProtocol, instancePrimaryDomain, externalPort, authRequestID, testCode, preferredLoginName, orgID, false, userID)
 			w.message = messages.Email{
-				Recipients: []string{lastEmail},
-				Subject:    expectMailSubject,
-				Content:    expectContent,
+				Recipients:   []string{lastEmail},
+				Subject:      expectMailSubject,
+				Content:      expectContent,
+				TemplateType: domain.InitCodeMessageType,
+				Code:         "testcode",
 			}
 			codeAlg, code := cryptoValue(t, ctrl, testCode)
 			queries.EXPECT().SearchInstanceDomains(gomock.Any(), gomock.Any()).Return(&query.InstanceDomains{
@@ -272,9 +285,11 @@ func Test_userNotifier_reduceEmailCodeAdded(t *testing.T) {
 			givenTemplate := "{{.LogoURL}}"
 			expectContent := fmt.Sprintf("%s%s/%s/%s", eventOrigin, assetsPath, policyID, logoURL)
 			w.message = messages.Email{
-				Recipients: []string{lastEmail},
-				Subject:    expectMailSubject,
-				Content:    expectContent,
+				Recipients:   []string{lastEmail},
+				Subject:      expectMailSubject,
+				Content:      expectContent,
+				TemplateType: domain.VerifyEmailMessageType,
+				Code:         "testcode",
 			}
 			codeAlg, code := cryptoValue(t, ctrl, "testcode")
 			expectTemplateQueries(queries, givenTemplate)
@@ -307,9 +322,11 @@ func Test_userNotifier_reduceEmailCodeAdded(t *testing.T) {
 			givenTemplate := "{{.LogoURL}}"
 			expectContent := fmt.Sprintf("%s://%s:%d%s/%s/%s", externalProtocol, instancePrimaryDomain, externalPort, assetsPath, policyID, logoURL)
 			w.message = messages.Email{
-				Recipients: []string{lastEmail},
-				Subject:    expectMailSubject,
-				Content:    expectContent,
+				Recipients:   []string{lastEmail},
+				Subject:      expectMailSubject,
+				Content:      expectContent,
+				TemplateType: domain.VerifyEmailMessageType,
+				Code:         "testcode",
 			}
 			codeAlg, code := cryptoValue(t, ctrl, "testcode")
 			queries.EXPECT().SearchInstanceDomains(gomock.Any(), gomock.Any()).Return(&query.InstanceDomains{
@@ -348,9 +365,11 @@ func Test_userNotifier_reduceEmailCodeAdded(t *testing.T) {
 			testCode := "testcode"
 			expectContent := fmt.Sprintf("%s/ui/login/mail/verification?authRequestID=%s&code=%s&orgID=%s&userID=%s", eventOrigin, "", testCode, orgID, userID)
 			w.message = messages.Email{
-				Recipients: []string{lastEmail},
-				Subject:    expectMailSubject,
-				Content:    expectContent,
+				Recipients:   []string{lastEmail},
+				Subject:      expectMailSubject,
+				Content:      expectContent,
+				TemplateType: domain.VerifyEmailMessageType,
+				Code:         "testcode",
 			}
 			codeAlg, code := cryptoValue(t, ctrl, testCode)
 			expectTemplateQueries(queries, givenTemplate)
@@ -385,9 +404,11 @@ func Test_userNotifier_reduceEmailCodeAdded(t *testing.T) {
 			testCode := "testcode"
 			expectContent := fmt.Sprintf("%s://%s:%d/ui/login/mail/verification?authRequestID=%s&code=%s&orgID=%s&userID=%s", externalProtocol, instancePrimaryDomain, externalPort, "", testCode, orgID, userID)
 			w.message = messages.Email{
-				Recipients: []string{lastEmail},
-				Subject:    expectMailSubject,
-				Content:    expectContent,
+				Recipients:   []string{lastEmail},
+				Subject:      expectMailSubject,
+				Content:      expectContent,
+				TemplateType: domain.VerifyEmailMessageType,
+				Code:         "testcode",
 			}
 			codeAlg, code := cryptoValue(t, ctrl, testCode)
 			queries.EXPECT().SearchInstanceDomains(gomock.Any(), gomock.Any()).Return(&query.InstanceDomains{
@@ -426,9 +447,11 @@ func Test_userNotifier_reduceEmailCodeAdded(t *testing.T) {
 			testCode := "testcode"
 			expectContent := fmt.Sprintf("%s://%s:%d/ui/login/mail/verification?authRequestID=%s&code=%s&orgID=%s&userID=%s", externalProtocol, instancePrimaryDomain, externalPort, authRequestID, testCode, orgID, userID)
 			w.message = messages.Email{
-				Recipients: []string{lastEmail},
-				Subject:    expectMailSubject,
-				Content:    expectContent,
+				Recipients:   []string{lastEmail},
+				Subject:      expectMailSubject,
+				Content:      expectContent,
+				TemplateType: domain.VerifyEmailMessageType,
+				Code:         "testcode",
 			}
 			codeAlg, code := cryptoValue(t, ctrl, testCode)
 			queries.EXPECT().SearchInstanceDomains(gomock.Any(), gomock.Any()).Return(&query.InstanceDomains{
@@ -469,9 +492,11 @@ func Test_userNotifier_reduceEmailCodeAdded(t *testing.T) {
 			testCode := "testcode"
 			expectContent := fmt.Sprintf("https://my.custom.url/org/%s/user/%s/verify/%s", orgID, userID, testCode)
 			w.message = messages.Email{
-				Recipients: []string{lastEmail},
-				Subject:    expectMailSubject,
-				Content:    expectContent,
+				Recipients:   []string{lastEmail},
+				Subject:      expectMailSubject,
+				Content:      expectContent,
+				TemplateType: domain.VerifyEmailMessageType,
+				Code:         "testcode",
 			}
 			codeAlg, code := cryptoValue(t, ctrl, testCode)
 			expectTemplateQueries(queries, givenTemplate)
@@ -533,9 +558,11 @@ func Test_userNotifier_reducePasswordCodeAdded(t *testing.T) {
 			givenTemplate := "{{.LogoURL}}"
 			expectContent := fmt.Sprintf("%s%s/%s/%s", eventOrigin, assetsPath, policyID, logoURL)
 			w.message = messages.Email{
-				Recipients: []string{lastEmail},
-				Subject:    expectMailSubject,
-				Content:    expectContent,
+				Recipients:   []string{lastEmail},
+				Subject:      expectMailSubject,
+				Content:      expectContent,
+				TemplateType: domain.PasswordResetMessageType,
+				Code:         "testcode",
 			}
 			codeAlg, code := cryptoValue(t, ctrl, "testcode")
 			expectTemplateQueries(queries, givenTemplate)
@@ -568,9 +595,11 @@ func Test_userNotifier_reducePasswordCodeAdded(t *testing.T) {
 			givenTemplate := "{{.LogoURL}}"
 			expectContent := fmt.Sprintf("%s://%s:%d%s/%s/%s", externalProtocol, instancePrimaryDomain, externalPort, assetsPath, policyID, logoURL)
 			w.message = messages.Email{
-				Recipients: []string{lastEmail},
-				Subject:    expectMailSubject,
-				Content:    expectContent,
+				Recipients:   []string{lastEmail},
+				Subject:      expectMailSubject,
+				Content:      expectContent,
+				TemplateType: domain.PasswordResetMessageType,
+				Code:         "testcode",
 			}
 			codeAlg, code := cryptoValue(t, ctrl, "testcode")
 			queries.EXPECT().SearchInstanceDomains(gomock.Any(), gomock.Any()).Return(&query.InstanceDomains{
@@ -609,9 +638,11 @@ func Test_userNotifier_reducePasswordCodeAdded(t *testing.T) {
 			testCode := "testcode"
 			expectContent := fmt.Sprintf("%s/ui/login/password/init?authRequestID=%s&code=%s&orgID=%s&userID=%s", eventOrigin, "", testCode, orgID, userID)
 			w.message = messages.Email{
-				Recipients: []string{lastEmail},
-				Subject:    expectMailSubject,
-				Content:    expectContent,
+				Recipients:   []string{lastEmail},
+				Subject:      expectMailSubject,
+				Content:      expectContent,
+				TemplateType: domain.PasswordResetMessageType,
+				Code:         "testcode",
 			}
 			codeAlg, code := cryptoValue(t, ctrl, testCode)
 			expectTemplateQueries(queries, givenTemplate)
@@ -646,9 +677,11 @@ func Test_userNotifier_reducePasswordCodeAdded(t *testing.T) {
 			testCode := "testcode"
 			expectContent := fmt.Sprintf("%s://%s:%d/ui/login/password/init?authRequestID=%s&code=%s&orgID=%s&userID=%s", externalProtocol, instancePrimaryDomain, externalPort, "", testCode, orgID, userID)
 			w.message = messages.Email{
-				Recipients: []string{lastEmail},
-				Subject:    expectMailSubject,
-				Content:    expectContent,
+				Recipients:   []string{lastEmail},
+				Subject:      expectMailSubject,
+				Content:      expectContent,
+				TemplateType: domain.PasswordResetMessageType,
+				Code:         "testcode",
 			}
 			codeAlg, code := cryptoValue(t, ctrl, testCode)
 			queries.EXPECT().SearchInstanceDomains(gomock.Any(), gomock.Any()).Return(&query.InstanceDomains{
@@ -687,9 +720,11 @@ func Test_userNotifier_reducePasswordCodeAdded(t *testing.T) {
 			testCode := "testcode"
 			expectContent := fmt.Sprintf("%s://%s:%d/ui/login/password/init?authRequestID=%s&code=%s&orgID=%s&userID=%s", externalProtocol, instancePrimaryDomain, externalPort, authRequestID, testCode, orgID, userID)
 			w.message = messages.Email{
-				Recipients: []string{lastEmail},
-				Subject:    expectMailSubject,
-				Content:    expectContent,
+				Recipients:   []string{lastEmail},
+				Subject:      expectMailSubject,
+				Content:      expectContent,
+				TemplateType: domain.PasswordResetMessageType,
+				Code:         "testcode",
 			}
 			codeAlg, code := cryptoValue(t, ctrl, testCode)
 			queries.EXPECT().SearchInstanceDomains(gomock.Any(), gomock.Any()).Return(&query.InstanceDomains{
@@ -730,9 +765,11 @@ func Test_userNotifier_reducePasswordCodeAdded(t *testing.T) {
 			testCode := "testcode"
 			expectContent := fmt.Sprintf("https://my.custom.url/org/%s/user/%s/verify/%s", orgID, userID, testCode)
 			w.message = messages.Email{
-				Recipients: []string{lastEmail},
-				Subject:    expectMailSubject,
-				Content:    expectContent,
+				Recipients:   []string{lastEmail},
+				Subject:      expectMailSubject,
+				Content:      expectContent,
+				TemplateType: domain.PasswordResetMessageType,
+				Code:         "testcode",
 			}
 			codeAlg, code := cryptoValue(t, ctrl, testCode)
 			expectTemplateQueries(queries, givenTemplate)
@@ -794,9 +831,10 @@ func Test_userNotifier_reduceDomainClaimed(t *testing.T) {
 			givenTemplate := "{{.LogoURL}}"
 			expectContent := fmt.Sprintf("%s%s/%s/%s", eventOrigin, assetsPath, policyID, logoURL)
 			w.message = messages.Email{
-				Recipients: []string{lastEmail},
-				Subject:    expectMailSubject,
-				Content:    expectContent,
+				Recipients:   []string{lastEmail},
+				Subject:      expectMailSubject,
+				Content:      expectContent,
+				TemplateType: domain.DomainClaimedMessageType,
 			}
 			expectTemplateQueries(queries, givenTemplate)
 			commands.EXPECT().UserDomainClaimedSent(gomock.Any(), orgID, userID).Return(nil)
@@ -823,9 +861,10 @@ func Test_userNotifier_reduceDomainClaimed(t *testing.T) {
 			givenTemplate := "{{.LogoURL}}"
 			expectContent := fmt.Sprintf("%s://%s:%d%s/%s/%s", externalProtocol, instancePrimaryDomain, externalPort, assetsPath, policyID, logoURL)
 			w.message = messages.Email{
-				Recipients: []string{lastEmail},
-				Subject:    expectMailSubject,
-				Content:    expectContent,
+				Recipients:   []string{lastEmail},
+				Subject:      expectMailSubject,
+				Content:      expectContent,
+				TemplateType: domain.DomainClaimedMessageType,
 			}
 			queries.EXPECT().SearchInstanceDomains(gomock.Any(), gomock.Any()).Return(&query.InstanceDomains{
 				Domains: []*query.InstanceDomain{{
@@ -885,9 +924,10 @@ func Test_userNotifier_reducePasswordlessCodeRequested(t *testing.T) {
 			givenTemplate := "{{.LogoURL}}"
 			expectContent := fmt.Sprintf("%s%s/%s/%s", eventOrigin, assetsPath, policyID, logoURL)
 			w.message = messages.Email{
-				Recipients: []string{lastEmail},
-				Subject:    expectMailSubject,
-				Content:    expectContent,
+				Recipients:   []string{lastEmail},
+				Subject:      expectMailSubject,
+				Content:      expectContent,
+				TemplateType: domain.PasswordlessRegistrationMessageType,
 			}
 			codeAlg, code := cryptoValue(t, ctrl, "testcode")
 			expectTemplateQueries(queries, givenTemplate)
@@ -921,9 +961,10 @@ func Test_userNotifier_reducePasswordlessCodeRequested(t *testing.T) {
 			givenTemplate := "{{.LogoURL}}"
 			expectContent := fmt.Sprintf("%s://%s:%d%s/%s/%s", externalProtocol, instancePrimaryDomain, externalPort, assetsPath, policyID, logoURL)
 			w.message = messages.Email{
-				Recipients: []string{lastEmail},
-				Subject:    expectMailSubject,
-				Content:    expectContent,
+				Recipients:   []string{lastEmail},
+				Subject:      expectMailSubject,
+				Content:      expectContent,
+				TemplateType: domain.PasswordlessRegistrationMessageType,
 			}
 			codeAlg, code := cryptoValue(t, ctrl, "testcode")
 			queries.EXPECT().SearchInstanceDomains(gomock.Any(), gomock.Any()).Return(&query.InstanceDomains{
@@ -964,9 +1005,10 @@ func Test_userNotifier_reducePasswordlessCodeRequested(t *testing.T) {
 			codeAlg, code := cryptoValue(t, ctrl, testCode)
 			expectContent := fmt.Sprintf("%s/ui/login/login/passwordless/init?userID=%s&orgID=%s&codeID=%s&code=%s", eventOrigin, userID, orgID, codeID, testCode)
 			w.message = messages.Email{
-				Recipients: []string{lastEmail},
-				Subject:    expectMailSubject,
-				Content:    expectContent,
+				Recipients:   []string{lastEmail},
+				Subject:      expectMailSubject,
+				Content:      expectContent,
+				TemplateType: domain.PasswordlessRegistrationMessageType,
 			}
 			expectTemplateQueries(queries, givenTemplate)
 			commands.EXPECT().HumanPasswordlessInitCodeSent(gomock.Any(), userID, orgID, codeID).Return(nil)
@@ -1002,9 +1044,10 @@ func Test_userNotifier_reducePasswordlessCodeRequested(t *testing.T) {
 			codeAlg, code := cryptoValue(t, ctrl, testCode)
 			expectContent := fmt.Sprintf("%s://%s:%d/ui/login/login/passwordless/init?userID=%s&orgID=%s&codeID=%s&code=%s", externalProtocol, instancePrimaryDomain, externalPort, userID, orgID, codeID, testCode)
 			w.message = messages.Email{
-				Recipients: []string{lastEmail},
-				Subject:    expectMailSubject,
-				Content:    expectContent,
+				Recipients:   []string{lastEmail},
+				Subject:      expectMailSubject,
+				Content:      expectContent,
+				TemplateType: domain.PasswordlessRegistrationMessageType,
 			}
 			queries.EXPECT().SearchInstanceDomains(gomock.Any(), gomock.Any()).Return(&query.InstanceDomains{
 				Domains: []*query.InstanceDomain{{
@@ -1044,9 +1087,10 @@ func Test_userNotifier_reducePasswordlessCodeRequested(t *testing.T) {
 			testCode := "testcode"
 			expectContent := fmt.Sprintf("https://my.custom.url/org/%s/user/%s/verify/%s", orgID, userID, testCode)
 			w.message = messages.Email{
-				Recipients: []string{lastEmail},
-				Subject:    expectMailSubject,
-				Content:    expectContent,
+				Recipients:   []string{lastEmail},
+				Subject:      expectMailSubject,
+				Content:      expectContent,
+				TemplateType: domain.PasswordlessRegistrationMessageType,
 			}
 			codeAlg, code := cryptoValue(t, ctrl, testCode)
 			expectTemplateQueries(queries, givenTemplate)
@@ -1109,9 +1153,10 @@ func Test_userNotifier_reducePasswordChanged(t *testing.T) {
 			givenTemplate := "{{.LogoURL}}"
 			expectContent := fmt.Sprintf("%s%s/%s/%s", eventOrigin, assetsPath, policyID, logoURL)
 			w.message = messages.Email{
-				Recipients: []string{lastEmail},
-				Subject:    expectMailSubject,
-				Content:    expectContent,
+				Recipients:   []string{lastEmail},
+				Subject:      expectMailSubject,
+				Content:      expectContent,
+				TemplateType: domain.PasswordChangeMessageType,
 			}
 			queries.EXPECT().NotificationPolicyByOrg(gomock.Any(), gomock.Any(), orgID, gomock.Any()).Return(&query.NotificationPolicy{
 				PasswordChange: true,
@@ -1141,9 +1186,10 @@ func Test_userNotifier_reducePasswordChanged(t *testing.T) {
 			givenTemplate := "{{.LogoURL}}"
 			expectContent := fmt.Sprintf("%s://%s:%d%s/%s/%s", externalProtocol, instancePrimaryDomain, externalPort, assetsPath, policyID, logoURL)
 			w.message = messages.Email{
-				Recipients: []string{lastEmail},
-				Subject:    expectMailSubject,
-				Content:    expectContent,
+				Recipients:   []string{lastEmail},
+				Subject:      expectMailSubject,
+				Content:      expectContent,
+				TemplateType: domain.PasswordChangeMessageType,
 			}
 			queries.EXPECT().NotificationPolicyByOrg(gomock.Any(), gomock.Any(), orgID, gomock.Any()).Return(&query.NotificationPolicy{
 				PasswordChange: true,
@@ -1195,6 +1241,378 @@ func Test_userNotifier_reducePasswordChanged(t *testing.T) {
 	}
 }
 
+func Test_userNotifier_reduceUserDeactivationWarningAdded(t *testing.T) {
+	expectMailSubject := "Your user will be deactivated"
+	tests := []struct {
+		name string
+		test func(*gomock.Controller, *mock.MockQueries, *mock.MockCommands) (fields, args, want)
+	}{{
+		name: "asset url without event trigger url",
+		test: func(ctrl *gomock.Controller, queries *mock.MockQueries, commands *mock.MockCommands) (f fields, a args, w want) {
+			givenTemplate := "{{.LogoURL}}"
+			expectContent := fmt.Sprintf("%s://%s:%d%s/%s/%s", externalProtocol, instancePrimaryDomain, externalPort, assetsPath, policyID, logoURL)
+			w.message = messages.Email{
+				Recipients:   []string{lastEmail},
+				Subject:      expectMailSubject,
+				Content:      expectContent,
+				TemplateType: domain.UserDeactivationWarningMessageType,
+			}
+			queries.EXPECT().SearchInstanceDomains(gomock.Any(), gomock.Any()).Return(&query.InstanceDomains{
+				Domains: []*query.InstanceDomain{{
+					Domain:    instancePrimaryDomain,
+					IsPrimary: true,
+				}},
+			}, nil)
+			expectTemplateQueries(queries, givenTemplate)
+			commands.EXPECT().UserDeactivationWarningSent(gomock.Any(), orgID, userID).Return(nil)
+			return fields{
+					queries:  queries,
+					commands: commands,
+					es: eventstore.NewEventstore(&eventstore.Config{
+						Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+					}),
+				}, args{
+					event: &user.UserDeactivationWarningAddedEvent{
+						BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+							AggregateID:   userID,
+							ResourceOwner: sql.NullString{String: orgID},
+							CreationDate:  time.Now().UTC(),
+						}),
+						DeactivationDate: time.Now().UTC().AddDate(0, 0, 7),
+					},
+				}, w
+		},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			queries := mock.NewMockQueries(ctrl)
+			commands := mock.NewMockCommands(ctrl)
+			f, a, w := tt.test(ctrl, queries, commands)
+			stmt, err := newUserNotifier(t, ctrl, queries, f, a, w).reduceUserDeactivationWarningAdded(a.event)
+			if w.err != nil {
+				w.err(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			err = stmt.Execute(nil, "")
+			if w.err != nil {
+				w.err(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_userNotifier_reduceMFAAdded(t *testing.T) {
+	expectMailSubject := "A new authentication factor has been added"
+	tests := []struct {
+		name string
+		test func(*gomock.Controller, *mock.MockQueries, *mock.MockCommands) (fields, args, want)
+	}{{
+		name: "security alerts disabled",
+		test: func(ctrl *gomock.Controller, queries *mock.MockQueries, commands *mock.MockCommands) (f fields, a args, w want) {
+			w.err = assert.NoError
+			queries.EXPECT().NotificationPolicyByOrg(gomock.Any(), gomock.Any(), orgID, gomock.Any()).Return(&query.NotificationPolicy{
+				SecurityAlerts: false,
+			}, nil)
+			return fields{
+					queries:  queries,
+					commands: commands,
+					es: eventstore.NewEventstore(&eventstore.Config{
+						Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+					}),
+				}, args{
+					event: &user.HumanOTPVerifiedEvent{
+						BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+							AggregateID:   userID,
+							ResourceOwner: sql.NullString{String: orgID},
+							CreationDate:  time.Now().UTC(),
+						}),
+					},
+				}, w
+		},
+	}, {
+		name: "security alerts enabled",
+		test: func(ctrl *gomock.Controller, queries *mock.MockQueries, commands *mock.MockCommands) (f fields, a args, w want) {
+			givenTemplate := "{{.LogoURL}}"
+			expectContent := fmt.Sprintf("%s://%s:%d%s/%s/%s", externalProtocol, instancePrimaryDomain, externalPort, assetsPath, policyID, logoURL)
+			w.message = messages.Email{
+				Recipients:   []string{verifiedEmail},
+				Subject:      expectMailSubject,
+				Content:      expectContent,
+				TemplateType: domain.MFAAddedMessageType,
+			}
+			queries.EXPECT().NotificationPolicyByOrg(gomock.Any(), gomock.Any(), orgID, gomock.Any()).Return(&query.NotificationPolicy{
+				SecurityAlerts: true,
+			}, nil)
+			queries.EXPECT().SearchInstanceDomains(gomock.Any(), gomock.Any()).Return(&query.InstanceDomains{
+				Domains: []*query.InstanceDomain{{
+					Domain:    instancePrimaryDomain,
+					IsPrimary: true,
+				}},
+			}, nil)
+			expectTemplateQueries(queries, givenTemplate)
+			commands.EXPECT().SecurityAlertSent(gomock.Any(), orgID, userID, domain.MFAAddedMessageType, gomock.Any()).Return(nil)
+			return fields{
+					queries:  queries,
+					commands: commands,
+					es: eventstore.NewEventstore(&eventstore.Config{
+						Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+					}),
+				}, args{
+					event: &user.HumanOTPVerifiedEvent{
+						BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+							AggregateID:   userID,
+							ResourceOwner: sql.NullString{String: orgID},
+							CreationDate:  time.Now().UTC(),
+						}),
+					},
+				}, w
+		},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			queries := mock.NewMockQueries(ctrl)
+			commands := mock.NewMockCommands(ctrl)
+			f, a, w := tt.test(ctrl, queries, commands)
+			stmt, err := newUserNotifier(t, ctrl, queries, f, a, w).reduceMFAAdded(a.event)
+			if w.err != nil {
+				w.err(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			err = stmt.Execute(nil, "")
+			if w.err != nil {
+				w.err(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_userNotifier_reduceUserLocked(t *testing.T) {
+	tests := []struct {
+		name string
+		test func(*gomock.Controller, *mock.MockQueries, *mock.MockCommands) (fields, args, want)
+	}{{
+		name: "locked by administrator, no-op",
+		test: func(ctrl *gomock.Controller, queries *mock.MockQueries, commands *mock.MockCommands) (f fields, a args, w want) {
+			w.err = assert.NoError
+			return fields{
+					queries:  queries,
+					commands: commands,
+					es: eventstore.NewEventstore(&eventstore.Config{
+						Querier: es_repo_mock.NewRepo(t).MockQuerier,
+					}),
+				}, args{
+					event: &user.UserLockedEvent{
+						BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+							AggregateID:   userID,
+							ResourceOwner: sql.NullString{String: orgID},
+							CreationDate:  time.Now().UTC(),
+						}),
+					},
+				}, w
+		},
+	}, {
+		name: "lockout, security alerts disabled",
+		test: func(ctrl *gomock.Controller, queries *mock.MockQueries, commands *mock.MockCommands) (f fields, a args, w want) {
+			w.err = assert.NoError
+			queries.EXPECT().NotificationPolicyByOrg(gomock.Any(), gomock.Any(), orgID, gomock.Any()).Return(&query.NotificationPolicy{
+				SecurityAlerts: false,
+			}, nil)
+			return fields{
+					queries:  queries,
+					commands: commands,
+					es: eventstore.NewEventstore(&eventstore.Config{
+						Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+					}),
+				}, args{
+					event: &user.UserLockedEvent{
+						BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+							AggregateID:   userID,
+							ResourceOwner: sql.NullString{String: orgID},
+							CreationDate:  time.Now().UTC(),
+						}),
+						Lockout: true,
+					},
+				}, w
+		},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			queries := mock.NewMockQueries(ctrl)
+			commands := mock.NewMockCommands(ctrl)
+			f, a, w := tt.test(ctrl, queries, commands)
+			stmt, err := newUserNotifier(t, ctrl, queries, f, a, w).reduceUserLocked(a.event)
+			assert.NoError(t, err)
+			if stmt.Execute == nil {
+				return
+			}
+			err = stmt.Execute(nil, "")
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func Test_userNotifier_reduceBreakGlassUsed(t *testing.T) {
+	expectMailSubject := "Emergency access account breakglass1 used"
+	tests := []struct {
+		name string
+		test func(*gomock.Controller, *mock.MockQueries, *mock.MockCommands) (fields, args, want)
+	}{{
+		name: "no recipients",
+		test: func(ctrl *gomock.Controller, queries *mock.MockQueries, commands *mock.MockCommands) (f fields, a args, w want) {
+			w.err = assert.NoError
+			queries.EXPECT().BreakGlassPolicy(gomock.Any()).Return(domain.DefaultBreakGlassPolicy(), nil)
+			queries.EXPECT().GetNotifyUserByID(gomock.Any(), gomock.Any(), userID).Return(&query.NotifyUser{
+				ID:                 userID,
+				ResourceOwner:      orgID,
+				PreferredLoginName: "breakglass1",
+			}, nil)
+			queries.EXPECT().SearchInstanceDomains(gomock.Any(), gomock.Any()).Return(&query.InstanceDomains{
+				Domains: []*query.InstanceDomain{{
+					Domain:    instancePrimaryDomain,
+					IsPrimary: true,
+				}},
+			}, nil)
+			return fields{
+					queries:  queries,
+					commands: commands,
+					es: eventstore.NewEventstore(&eventstore.Config{
+						Querier: es_repo_mock.NewRepo(t).MockQuerier,
+					}),
+				}, args{
+					event: &user.HumanBreakGlassUsedEvent{
+						BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+							AggregateID:   userID,
+							ResourceOwner: sql.NullString{String: orgID},
+							CreationDate:  time.Now().UTC(),
+						}),
+						SessionID:  "session1",
+						Expiration: time.Now().UTC().Add(domain.BreakGlassSessionLifetimeDefault),
+					},
+				}, w
+		},
+	}, {
+		name: "recipient alerted",
+		test: func(ctrl *gomock.Controller, queries *mock.MockQueries, commands *mock.MockCommands) (f fields, a args, w want) {
+			givenTemplate := "{{.LogoURL}}"
+			expectContent := fmt.Sprintf("%s://%s:%d%s/%s/%s", externalProtocol, instancePrimaryDomain, externalPort, assetsPath, policyID, logoURL)
+			w.message = messages.Email{
+				Recipients:   []string{verifiedEmail},
+				Subject:      expectMailSubject,
+				Content:      expectContent,
+				TemplateType: domain.BreakGlassUsedMessageType,
+			}
+			queries.EXPECT().BreakGlassPolicy(gomock.Any()).Return(&domain.BreakGlassPolicy{
+				RecipientUserIDs: []string{"recipient1"},
+				SessionLifetime:  domain.BreakGlassSessionLifetimeDefault,
+			}, nil)
+			queries.EXPECT().GetNotifyUserByID(gomock.Any(), gomock.Any(), userID).Return(&query.NotifyUser{
+				ID:                 userID,
+				ResourceOwner:      orgID,
+				PreferredLoginName: "breakglass1",
+			}, nil)
+			queries.EXPECT().SearchInstanceDomains(gomock.Any(), gomock.Any()).Return(&query.InstanceDomains{
+				Domains: []*query.InstanceDomain{{
+					Domain:    instancePrimaryDomain,
+					IsPrimary: true,
+				}},
+			}, nil)
+			expectTemplateQueries(queries, givenTemplate)
+			commands.EXPECT().SecurityAlertDelivered(gomock.Any(), orgID, userID, domain.BreakGlassUsedMessageType, gomock.Any(), "recipient1", "").Return(nil)
+			return fields{
+					queries:  queries,
+					commands: commands,
+					es: eventstore.NewEventstore(&eventstore.Config{
+						Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+					}),
+				}, args{
+					event: &user.HumanBreakGlassUsedEvent{
+						BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+							AggregateID:   userID,
+							ResourceOwner: sql.NullString{String: orgID},
+							CreationDate:  time.Now().UTC(),
+						}),
+						SessionID:  "session1",
+						Expiration: time.Now().UTC().Add(domain.BreakGlassSessionLifetimeDefault),
+					},
+				}, w
+		},
+	}, {
+		name: "recipient already alerted",
+		test: func(ctrl *gomock.Controller, queries *mock.MockQueries, commands *mock.MockCommands) (f fields, a args, w want) {
+			w.err = assert.NoError
+			queries.EXPECT().BreakGlassPolicy(gomock.Any()).Return(&domain.BreakGlassPolicy{
+				RecipientUserIDs: []string{"recipient1"},
+				SessionLifetime:  domain.BreakGlassSessionLifetimeDefault,
+			}, nil)
+			queries.EXPECT().GetNotifyUserByID(gomock.Any(), gomock.Any(), userID).Return(&query.NotifyUser{
+				ID:                 userID,
+				ResourceOwner:      orgID,
+				PreferredLoginName: "breakglass1",
+			}, nil)
+			queries.EXPECT().SearchInstanceDomains(gomock.Any(), gomock.Any()).Return(&query.InstanceDomains{
+				Domains: []*query.InstanceDomain{{
+					Domain:    instancePrimaryDomain,
+					IsPrimary: true,
+				}},
+			}, nil)
+			return fields{
+					queries:  queries,
+					commands: commands,
+					es: eventstore.NewEventstore(&eventstore.Config{
+						Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents(
+							user.NewHumanSecurityAlertDeliveredEvent(context.Background(),
+								&user.NewAggregate(userID, orgID).Aggregate,
+								domain.BreakGlassUsedMessageType,
+								0,
+								"recipient1",
+								"",
+							),
+						).MockQuerier,
+					}),
+				}, args{
+					event: &user.HumanBreakGlassUsedEvent{
+						BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+							AggregateID:   userID,
+							ResourceOwner: sql.NullString{String: orgID},
+							CreationDate:  time.Now().UTC(),
+						}),
+						SessionID:  "session1",
+						Expiration: time.Now().UTC().Add(domain.BreakGlassSessionLifetimeDefault),
+					},
+				}, w
+		},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			queries := mock.NewMockQueries(ctrl)
+			commands := mock.NewMockCommands(ctrl)
+			f, a, w := tt.test(ctrl, queries, commands)
+			stmt, err := newUserNotifier(t, ctrl, queries, f, a, w).reduceBreakGlassUsed(a.event)
+			if w.err != nil {
+				w.err(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			err = stmt.Execute(nil, "")
+			if w.err != nil {
+				w.err(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
 func Test_userNotifier_reduceOTPEmailChallenged(t *testing.T) {
 	expectMailSubject := "Verify One-Time Password"
 	tests := []struct {
@@ -1206,9 +1624,11 @@ func Test_userNotifier_reduceOTPEmailChallenged(t *testing.T) {
 			givenTemplate := "{{.LogoURL}}"
 			expectContent := fmt.Sprintf("%s%s/%s/%s", eventOrigin, assetsPath, policyID, logoURL)
 			w.message = messages.Email{
-				Recipients: []string{verifiedEmail},
-				Subject:    expectMailSubject,
-				Content:    expectContent,
+				Recipients:   []string{verifiedEmail},
+				Subject:      expectMailSubject,
+				Content:      expectContent,
+				TemplateType: domain.VerifyEmailOTPMessageType,
+				Code:         "testcode",
 			}
 			codeAlg, code := cryptoValue(t, ctrl, "testcode")
 			expectTemplateQueries(queries, givenTemplate)
@@ -1242,9 +1662,11 @@ func Test_userNotifier_reduceOTPEmailChallenged(t *testing.T) {
 			givenTemplate := "{{.LogoURL}}"
 			expectContent := fmt.Sprintf("%s://%s:%d%s/%s/%s", externalProtocol, instancePrimaryDomain, externalPort, assetsPath, policyID, logoURL)
 			w.message = messages.Email{
-				Recipients: []string{verifiedEmail},
-				Subject:    expectMailSubject,
-				Content:    expectContent,
+				Recipients:   []string{verifiedEmail},
+				Subject:      expectMailSubject,
+				Content:      expectContent,
+				TemplateType: domain.VerifyEmailOTPMessageType,
+				Code:         "testcode",
 			}
 			codeAlg, code := cryptoValue(t, ctrl, "testcode")
 			expectTemplateQueries(queries, givenTemplate)
@@ -1284,9 +1706,11 @@ func Test_userNotifier_reduceOTPEmailChallenged(t *testing.T) {
 			testCode := "testcode"
 			expectContent := fmt.Sprintf("%s/otp/verify?loginName=%s&code=%s", eventOrigin, preferredLoginName, testCode)
 			w.message = messages.Email{
-				Recipients: []string{verifiedEmail},
-				Subject:    expectMailSubject,
-				Content:    expectContent,
+				Recipients:   []string{verifiedEmail},
+				Subject:      expectMailSubject,
+				Content:      expectContent,
+				TemplateType: domain.VerifyEmailOTPMessageType,
+				Code:         "testcode",
 			}
 			codeAlg, code := cryptoValue(t, ctrl, testCode)
 			expectTemplateQueries(queries, givenTemplate)
@@ -1322,9 +1746,11 @@ func Test_userNotifier_reduceOTPEmailChallenged(t *testing.T) {
 			testCode := "testcode"
 			expectContent := fmt.Sprintf("%s://%s:%d/otp/verify?loginName=%s&code=%s", externalProtocol, instancePrimaryDomain, externalPort, preferredLoginName, testCode)
 			w.message = messages.Email{
-				Recipients: []string{verifiedEmail},
-				Subject:    expectMailSubject,
-				Content:    expectContent,
+				Recipients:   []string{verifiedEmail},
+				Subject:      expectMailSubject,
+				Content:      expectContent,
+				TemplateType: domain.VerifyEmailOTPMessageType,
+				Code:         "testcode",
 			}
 			codeAlg, code := cryptoValue(t, ctrl, testCode)
 			queries.EXPECT().SearchInstanceDomains(gomock.Any(), gomock.Any()).Return(&query.InstanceDomains{
@@ -1364,9 +1790,11 @@ func Test_userNotifier_reduceOTPEmailChallenged(t *testing.T) {
 			testCode := "testcode"
 			expectContent := fmt.Sprintf("https://my.custom.url/user/%s/verify", preferredLoginName)
 			w.message = messages.Email{
-				Recipients: []string{verifiedEmail},
-				Subject:    expectMailSubject,
-				Content:    expectContent,
+				Recipients:   []string{verifiedEmail},
+				Subject:      expectMailSubject,
+				Content:      expectContent,
+				TemplateType: domain.VerifyEmailOTPMessageType,
+				Code:         "testcode",
 			}
 			codeAlg, code := cryptoValue(t, ctrl, testCode)
 			expectTemplateQueries(queries, givenTemplate)
@@ -1402,7 +1830,13 @@ func Test_userNotifier_reduceOTPEmailChallenged(t *testing.T) {
 			queries := mock.NewMockQueries(ctrl)
 			commands := mock.NewMockCommands(ctrl)
 			f, a, w := tt.test(ctrl, queries, commands)
-			_, err := newUserNotifier(t, ctrl, queries, f, a, w).reduceSessionOTPEmailChallenged(a.event)
+			stmt, err := newUserNotifier(t, ctrl, queries, f, a, w).reduceSessionOTPEmailChallenged(a.event)
+			if w.err != nil {
+				w.err(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			err = stmt.Execute(nil, "")
 			if w.err != nil {
 				w.err(t, err)
 			} else {
@@ -1412,6 +1846,80 @@ func Test_userNotifier_reduceOTPEmailChallenged(t *testing.T) {
 	}
 }
 
+func Test_userNotifier_reduceSessionPushChallenged(t *testing.T) {
+	const (
+		sessionID  = "session1"
+		instanceID = "instance1"
+	)
+	ctrl := gomock.NewController(t)
+	queries := mock.NewMockQueries(ctrl)
+	commands := mock.NewMockCommands(ctrl)
+	event := &session.PushChallengedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+			AggregateID:   sessionID,
+			ResourceOwner: sql.NullString{String: instanceID},
+			CreationDate:  time.Now().UTC(),
+		}),
+		Challenge: "challenge",
+		Expiry:    time.Minute,
+		Devices: []*session.PushDevice{{
+			DeviceID:  "deviceID",
+			Platform:  domain.PushPlatformFCM,
+			PushToken: "pushToken",
+		}},
+		TriggeredAtOrigin: eventOrigin,
+	}
+	queries.EXPECT().NotificationProviderByIDAndType(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(&query.DebugNotificationProvider{}, nil)
+	queries.EXPECT().SessionByID(gomock.Any(), gomock.Any(), sessionID, gomock.Any()).Return(&query.Session{
+		UserFactor: query.SessionUserFactor{UserID: userID, ResourceOwner: orgID},
+	}, nil)
+	queries.EXPECT().GetNotifyUserByID(gomock.Any(), gomock.Any(), userID).Return(&query.NotifyUser{
+		ID:            userID,
+		ResourceOwner: orgID,
+	}, nil)
+	queries.EXPECT().GetInstanceRestrictions(gomock.Any()).Return(query.Restrictions{
+		AllowedLanguages: []language.Tag{language.English},
+	}, nil)
+	queries.EXPECT().GetDefaultLanguage(gomock.Any()).AnyTimes().Return(language.English)
+	queries.EXPECT().CustomTextListByTemplate(gomock.Any(), gomock.Any(), domain.VerifyPushMessageType, gomock.Any()).Times(2).Return(&query.CustomTexts{}, nil)
+	commands.EXPECT().PushChallengeSent(gomock.Any(), sessionID, instanceID).Return(nil)
+	channel := channel_mock.NewMockNotificationChannel(ctrl)
+	channel.EXPECT().HandleMessage(&messages.Push{
+		Platform:  domain.PushPlatformFCM,
+		PushToken: "pushToken",
+		Title:     "Approve login",
+		Body:      "Confirm the login to triggered.here in the app.",
+		Data: map[string]string{
+			"deviceId":  "deviceID",
+			"sessionId": sessionID,
+			"challenge": "challenge",
+		},
+		TriggeringEvent: event,
+	}).Return(nil)
+	smtpAlg, _ := cryptoValue(t, ctrl, "smtppw")
+	notifier := &userNotifier{
+		commands: commands,
+		queries: NewNotificationQueries(
+			queries,
+			eventstore.NewEventstore(&eventstore.Config{
+				Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+			}),
+			externalDomain,
+			externalPort,
+			externalSecure,
+			"",
+			nil,
+			smtpAlg,
+			nil,
+		),
+		channels: &channels{Chain: *senders.ChainChannels(channel)},
+	}
+	stmt, err := notifier.reduceSessionPushChallenged(event)
+	assert.NoError(t, err)
+	err = stmt.Execute(nil, "")
+	assert.NoError(t, err)
+}
+
 type fields struct {
 	queries        *mock.MockQueries
 	commands       *mock.MockCommands
@@ -1459,11 +1967,11 @@ type channels struct {
 	senders.Chain
 }
 
-func (c *channels) Email(context.Context) (*senders.Chain, *smtp.Config, error) {
+func (c *channels) Email(context.Context, string) (*senders.Chain, *email.Config, error) {
 	return &c.Chain, nil, nil
 }
 
-func (c *channels) SMS(context.Context) (*senders.Chain, *twilio.Config, error) {
+func (c *channels) SMS(context.Context, string) (*senders.Chain, *sms.Config, error) {
 	return &c.Chain, nil, nil
 }
 
@@ -1471,6 +1979,103 @@ func (c *channels) Webhook(context.Context, webhook.Config) (*senders.Chain, err
 	return &c.Chain, nil
 }
 
+func (c *channels) Push(context.Context, domain.PushPlatform) (*senders.Chain, error) {
+	return &c.Chain, nil
+}
+
+func (c *channels) RecordDelivery(context.Context, *query.NotifyUser, *domain.NotificationDelivery, eventstore.Event, error) {}
+
+func Test_userNotifier_reduceTestMessageRequested(t *testing.T) {
+	expectMailSubject := "Password of user has changed"
+	testRecipient := "test@example.com"
+	tests := []struct {
+		name string
+		test func(*gomock.Controller, *mock.MockQueries, *mock.MockCommands) (fields, args, want)
+	}{{
+		name: "test message sent",
+		test: func(ctrl *gomock.Controller, queries *mock.MockQueries, commands *mock.MockCommands) (f fields, a args, w want) {
+			givenTemplate := "{{.LogoURL}}"
+			expectContent := fmt.Sprintf("%s%s/%s/%s", eventOrigin, assetsPath, policyID, logoURL)
+			w.message = messages.Email{
+				Recipients:   []string{testRecipient},
+				Subject:      expectMailSubject,
+				Content:      expectContent,
+				TemplateType: domain.PasswordChangeMessageType,
+				Code:         previewCode,
+			}
+			expectTemplateQueries(queries, givenTemplate)
+			commands.EXPECT().OrgTestMessageSent(gomock.Any(), orgID, uint64(0)).Return(nil)
+			return fields{
+					queries:  queries,
+					commands: commands,
+					es: eventstore.NewEventstore(&eventstore.Config{
+						Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+					}),
+				}, args{
+					event: &org.TestMessageRequestedEvent{
+						BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+							AggregateID:   orgID,
+							ResourceOwner: sql.NullString{String: orgID},
+							CreationDate:  time.Now().UTC(),
+						}),
+						MessageType:       domain.PasswordChangeMessageType,
+						UserID:            userID,
+						NotificationType:  domain.NotificationTypeEmail,
+						Recipient:         testRecipient,
+						TriggeredAtOrigin: eventOrigin,
+					},
+				}, w
+		},
+	}, {
+		name: "test message already sent",
+		test: func(ctrl *gomock.Controller, queries *mock.MockQueries, commands *mock.MockCommands) (f fields, a args, w want) {
+			w.err = assert.NoError
+			return fields{
+					queries:  queries,
+					commands: commands,
+					es: eventstore.NewEventstore(&eventstore.Config{
+						Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents(
+							org.NewTestMessageSentEvent(context.Background(), &org.NewAggregate(orgID).Aggregate, 0),
+						).MockQuerier,
+					}),
+				}, args{
+					event: &org.TestMessageRequestedEvent{
+						BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+							AggregateID:   orgID,
+							ResourceOwner: sql.NullString{String: orgID},
+							CreationDate:  time.Now().UTC(),
+						}),
+						MessageType:       domain.PasswordChangeMessageType,
+						UserID:            userID,
+						NotificationType:  domain.NotificationTypeEmail,
+						Recipient:         testRecipient,
+						TriggeredAtOrigin: eventOrigin,
+					},
+				}, w
+		},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			queries := mock.NewMockQueries(ctrl)
+			commands := mock.NewMockCommands(ctrl)
+			f, a, w := tt.test(ctrl, queries, commands)
+			stmt, err := newUserNotifier(t, ctrl, queries, f, a, w).reduceTestMessageRequested(a.event)
+			if w.err != nil {
+				w.err(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			err = stmt.Execute(nil, "")
+			if w.err != nil {
+				w.err(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
 func expectTemplateQueries(queries *mock.MockQueries, template string) {
 	queries.EXPECT().GetInstanceRestrictions(gomock.Any()).Return(query.Restrictions{
 		AllowedLanguages: []language.Tag{language.English},
@@ -1482,6 +2087,7 @@ func expectTemplateQueries(queries *mock.MockQueries, template string) {
 		},
 	}, nil)
 	queries.EXPECT().MailTemplateByOrg(gomock.Any(), gomock.Any(), gomock.Any()).Return(&query.MailTemplate{Template: []byte(template)}, nil)
+	queries.EXPECT().MessageTemplatesByOrg(gomock.Any(), gomock.Any()).Return(&query.MessageTemplates{}, nil)
 	queries.EXPECT().GetNotifyUserByID(gomock.Any(), gomock.Any(), gomock.Any()).Return(&query.NotifyUser{
 		ID:                 userID,
 		ResourceOwner:      orgID,
@@ -1489,7 +2095,7 @@ func expectTemplateQueries(queries *mock.MockQueries, template string) {
 		VerifiedEmail:      verifiedEmail,
 		PreferredLoginName: preferredLoginName,
 	}, nil)
-	queries.EXPECT().GetDefaultLanguage(gomock.Any()).Return(language.English)
+	queries.EXPECT().GetDefaultLanguage(gomock.Any()).Times(2).Return(language.English)
 	queries.EXPECT().CustomTextListByTemplate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(2).Return(&query.CustomTexts{}, nil)
 }
 
@@ -1504,3 +2110,34 @@ func cryptoValue(t *testing.T, ctrl *gomock.Controller, value string) (*crypto.M
 	assert.NoError(t, err)
 	return encAlg, code
 }
+
+func Test_userNotifier_enqueue(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	commands := mock.NewMockCommands(ctrl)
+	event := &user.HumanPhoneCodeAddedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+			AggregateID:   userID,
+			AggregateType: user.AggregateType,
+			ResourceOwner: sql.NullString{String: orgID},
+			Typ:           user.HumanPhoneCodeAddedType,
+			Seq:           5,
+			CreationDate:  time.Now().UTC(),
+		}),
+		Expiry: time.Hour,
+	}
+	commands.EXPECT().RequestNotification(gomock.Any(), &notification.Request{
+		TriggeringAggregateType: user.AggregateType,
+		TriggeringAggregateID:   userID,
+		TriggeringEventType:     user.HumanPhoneCodeAddedType,
+		TriggeringSequence:      5,
+		TriggeringResourceOwner: orgID,
+		NotificationType:        domain.NotificationTypeSms,
+	}).Return(nil)
+	u := &userNotifier{
+		commands: commands,
+		mode:     notifierModeEnqueue,
+	}
+	stmt, err := u.reducePhoneCodeAdded(event)
+	assert.NoError(t, err)
+	assert.NoError(t, stmt.Execute(nil, ""))
+}
@@ -1,12 +1,20 @@
 package messages
 
 import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
 	"fmt"
 	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/textproto"
 	"regexp"
 	"strings"
 	"time"
 
+	"github.com/k3a/html2text"
+
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/notification/channels"
 )
@@ -19,19 +27,28 @@ var (
 var _ channels.Message = (*Email)(nil)
 
 type Email struct {
-	Recipients      []string
-	BCC             []string
-	CC              []string
-	SenderEmail     string
-	SenderName      string
-	ReplyToAddress  string
-	Subject         string
-	Content         string
+	Recipients     []string
+	BCC            []string
+	CC             []string
+	SenderEmail    string
+	SenderName     string
+	ReplyToAddress string
+	Subject        string
+	Content        string
+	TemplateType   string
+	Code           string
+	// Headers are additional headers of the message, e.g. List-Unsubscribe
+	Headers         map[string]string
 	TriggeringEvent eventstore.Event
+	// ProviderMessageID is the Message-ID of the rendered message
+	ProviderMessageID string
 }
 
 func (msg *Email) GetContent() (string, error) {
 	headers := make(map[string]string)
+	for k, v := range msg.Headers {
+		headers[k] = v
+	}
 	from := msg.SenderEmail
 	if msg.SenderName != "" {
 		from = fmt.Sprintf("%s <%s>", bEncodeWord(msg.SenderName), msg.SenderEmail)
@@ -44,21 +61,76 @@ func (msg *Email) GetContent() (string, error) {
 	headers["To"] = strings.Join(msg.Recipients, ", ")
 	headers["Cc"] = strings.Join(msg.CC, ", ")
 	headers["Date"] = time.Now().Format(time.RFC1123Z)
+	messageID, err := msg.messageID()
+	if err != nil {
+		return "", err
+	}
+	headers["Message-ID"] = messageID
+	msg.ProviderMessageID = messageID
 
 	message := ""
 	for k, v := range headers {
 		message += fmt.Sprintf("%s: %s"+lineBreak, k, v)
 	}
 
-	//default mime-type is html
-	mime := "MIME-Version: 1.0" + lineBreak + "Content-Type: text/html; charset=\"UTF-8\"" + lineBreak + lineBreak
+	subject := "Subject: " + bEncodeWord(msg.Subject) + lineBreak
 	if !isHTML(msg.Content) {
-		mime = "MIME-Version: 1.0" + lineBreak + "Content-Type: text/plain; charset=\"UTF-8\"" + lineBreak + lineBreak
+		mime := "MIME-Version: 1.0" + lineBreak + "Content-Type: text/plain; charset=\"UTF-8\"" + lineBreak + lineBreak
+		return message + subject + mime + lineBreak + msg.Content, nil
 	}
-	subject := "Subject: " + bEncodeWord(msg.Subject) + lineBreak
-	message += subject + mime + lineBreak + msg.Content
 
-	return message, nil
+	// html messages are sent with a plain text alternative for clients not able to display html
+	body, boundary, err := multipartAlternative(html2text.HTML2Text(msg.Content), msg.Content)
+	if err != nil {
+		return "", err
+	}
+	mime := "MIME-Version: 1.0" + lineBreak + "Content-Type: multipart/alternative; boundary=\"" + boundary + "\"" + lineBreak + lineBreak
+	return message + subject + mime + body, nil
+}
+
+// messageID returns a unique id for the message using the domain of the sender
+func (msg *Email) messageID() (string, error) {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return "", err
+	}
+	domain := "localhost"
+	if _, senderDomain, found := strings.Cut(msg.SenderEmail, "@"); found {
+		domain = senderDomain
+	}
+	return fmt.Sprintf("<%s@%s>", hex.EncodeToString(id), domain), nil
+}
+
+func multipartAlternative(text, html string) (string, string, error) {
+	body := new(bytes.Buffer)
+	writer := multipart.NewWriter(body)
+	for _, part := range []struct {
+		contentType string
+		content     string
+	}{
+		// the preferred alternative must be the last part
+		{contentType: "text/plain; charset=\"UTF-8\"", content: text},
+		{contentType: "text/html; charset=\"UTF-8\"", content: html},
+	} {
+		w, err := writer.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return "", "", err
+		}
+		qp := quotedprintable.NewWriter(w)
+		if _, err = qp.Write([]byte(part.content)); err != nil {
+			return "", "", err
+		}
+		if err = qp.Close(); err != nil {
+			return "", "", err
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return "", "", err
+	}
+	return body.String(), writer.Boundary(), nil
 }
 
 func (msg *Email) GetTriggeringEvent() eventstore.Event {
@@ -0,0 +1,39 @@
+package messages
+
+import (
+	"github.com/zitadel/zitadel/internal/notification/channels"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// Provider is the payload sent to generic HTTP email and SMS providers
+type Provider struct {
+	Recipients        []string `json:"recipients"`
+	SenderPhoneNumber string   `json:"senderPhoneNumber,omitempty"`
+	Subject           string   `json:"subject,omitempty"`
+	Content           string   `json:"content"`
+	TemplateType      string   `json:"templateType,omitempty"`
+	Code              string   `json:"code,omitempty"`
+}
+
+func ProviderFromMessage(message channels.Message) (*Provider, error) {
+	switch msg := message.(type) {
+	case *Email:
+		return &Provider{
+			Recipients:   msg.Recipients,
+			Subject:      msg.Subject,
+			Content:      msg.Content,
+			TemplateType: msg.TemplateType,
+			Code:         msg.Code,
+		}, nil
+	case *SMS:
+		return &Provider{
+			Recipients:        []string{msg.RecipientPhoneNumber},
+			SenderPhoneNumber: msg.SenderPhoneNumber,
+			Content:           msg.Content,
+			TemplateType:      msg.TemplateType,
+			Code:              msg.Code,
+		}, nil
+	default:
+		return nil, zerrors.ThrowInternal(nil, "MESSA-Pq8ds", "message is neither email nor sms")
+	}
+}
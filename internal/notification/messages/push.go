@@ -0,0 +1,29 @@
+package messages
+
+import (
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/notification/channels"
+)
+
+var _ channels.Message = (*Push)(nil)
+
+type Push struct {
+	Platform  domain.PushPlatform
+	PushToken string
+	Title     string
+	Body      string
+	// Data is passed to the app on the device, e.g. the challenge to be signed
+	Data            map[string]string
+	TriggeringEvent eventstore.Event
+	// ProviderMessageID is set by the channel to the id the provider returned for the sent message
+	ProviderMessageID string
+}
+
+func (msg *Push) GetContent() (string, error) {
+	return msg.Body, nil
+}
+
+func (msg *Push) GetTriggeringEvent() eventstore.Event {
+	return msg.TriggeringEvent
+}
@@ -11,7 +11,11 @@ type SMS struct {
 	SenderPhoneNumber    string
 	RecipientPhoneNumber string
 	Content              string
+	TemplateType         string
+	Code                 string
 	TriggeringEvent      eventstore.Event
+	// ProviderMessageID is set by the channel to the id the provider returned for the sent message
+	ProviderMessageID string
 }
 
 func (msg *SMS) GetContent() (string, error) {
@@ -13,12 +13,16 @@ import (
 	"github.com/zitadel/zitadel/internal/query/projection"
 )
 
-var projections []*handler.Handler
+var (
+	projections []*handler.Handler
+	worker      *handlers.NotificationWorker
+)
 
 func Register(
 	ctx context.Context,
 	userHandlerCustomConfig, quotaHandlerCustomConfig, telemetryHandlerCustomConfig projection.CustomConfig,
 	telemetryCfg handlers.TelemetryPusherConfig,
+	workerConfig handlers.WorkerConfig,
 	externalDomain string,
 	externalPort uint16,
 	externalSecure bool,
@@ -28,20 +32,24 @@ func Register(
 	otpEmailTmpl string,
 	fileSystemPath string,
 	userEncryption, smtpEncryption, smsEncryption crypto.EncryptionAlgorithm,
-) {
+) *handlers.NotificationQueries {
 	q := handlers.NewNotificationQueries(queries, es, externalDomain, externalPort, externalSecure, fileSystemPath, userEncryption, smtpEncryption, smsEncryption)
-	c := newChannels(q)
-	projections = append(projections, handlers.NewUserNotifier(ctx, projection.ApplyCustomConfig(userHandlerCustomConfig), commands, q, c, otpEmailTmpl))
+	c := newChannels(q, commands, workerConfig.PushProviders)
+	userHandlerConfig := projection.ApplyCustomConfig(userHandlerCustomConfig)
+	projections = append(projections, handlers.NewUserNotifier(ctx, userHandlerConfig, commands, q, c, otpEmailTmpl, !workerConfig.LegacyEnabled))
+	worker = handlers.NewNotificationWorker(workerConfig, userHandlerConfig.Client, commands, q, c, otpEmailTmpl)
 	projections = append(projections, handlers.NewQuotaNotifier(ctx, projection.ApplyCustomConfig(quotaHandlerCustomConfig), commands, q, c))
 	if telemetryCfg.Enabled {
 		projections = append(projections, handlers.NewTelemetryPusher(ctx, telemetryCfg, projection.ApplyCustomConfig(telemetryHandlerCustomConfig), commands, q, c))
 	}
+	return q
 }
 
 func Start(ctx context.Context) {
 	for _, projection := range projections {
 		projection.Start(ctx)
 	}
+	worker.Start(ctx)
 }
 
 func ProjectInstance(ctx context.Context) error {
@@ -0,0 +1,70 @@
+package senders
+
+import (
+	"context"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/notification/channels"
+	"github.com/zitadel/zitadel/internal/notification/channels/fs"
+	"github.com/zitadel/zitadel/internal/notification/channels/instrumenting"
+	"github.com/zitadel/zitadel/internal/notification/channels/log"
+	"github.com/zitadel/zitadel/internal/notification/channels/push"
+)
+
+const (
+	fcmSpanName  = "push.fcm.NotificationChannel"
+	apnsSpanName = "push.apns.NotificationChannel"
+)
+
+// PushChannels returns the channels delivering push messages to devices of the platform.
+// If stub is set, the messages are only recorded by it.
+func PushChannels(
+	ctx context.Context,
+	pushConfig push.Config,
+	stub *push.Stub,
+	platform domain.PushPlatform,
+	getFileSystemProvider func(ctx context.Context) (*fs.Config, error),
+	getLogProvider func(ctx context.Context) (*log.Config, error),
+	successMetricName,
+	failureMetricName string,
+) (*Chain, error) {
+	if stub != nil {
+		return ChainChannels(stub), nil
+	}
+	var (
+		channel  channels.NotificationChannel
+		spanName string
+		err      error
+	)
+	channels := make([]channels.NotificationChannel, 0, 3)
+	switch platform {
+	case domain.PushPlatformFCM:
+		channel, err = push.InitFCMChannel(ctx, pushConfig.FCM)
+		spanName = fcmSpanName
+	case domain.PushPlatformAPNs:
+		channel, err = push.InitAPNsChannel(ctx, pushConfig.APNs)
+		spanName = apnsSpanName
+	case domain.PushPlatformUnspecified:
+	}
+	logging.WithFields(
+		"instance", authz.GetInstance(ctx).InstanceID(),
+		"platform", platform,
+	).OnError(err).Debug("initializing push channel failed")
+	if err == nil && channel != nil {
+		channels = append(
+			channels,
+			instrumenting.Wrap(
+				ctx,
+				channel,
+				spanName,
+				successMetricName,
+				failureMetricName,
+			),
+		)
+	}
+	channels = append(channels, debugChannels(ctx, getFileSystemProvider, getLogProvider)...)
+	return ChainChannels(channels...), nil
+}
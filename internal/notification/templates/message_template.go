@@ -0,0 +1,222 @@
+package templates
+
+import (
+	"bytes"
+	"errors"
+	"html/template"
+	"text/template/parse"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	// MaxMessageTemplateSize is the maximum size in bytes of an uploaded message template or partial
+	MaxMessageTemplateSize = 256 << 10
+	// maxRenderedSize is the maximum size in bytes of a rendered message
+	maxRenderedSize = 2 << 20
+
+	messageTemplateName = "message"
+)
+
+var errRenderedTooLarge = errors.New("rendered message exceeds the maximum size")
+
+// sandboxedFuncs are the only functions a custom template can call.
+// Functions like printf could produce output of arbitrary size before the limited writer can reject it.
+var sandboxedFuncs = map[string]bool{
+	"eq":  true,
+	"ne":  true,
+	"not": true,
+	"and": true,
+	"or":  true,
+}
+
+// ValidateMessageTemplate checks that the custom template of a message type or a partial
+// can be rendered with the [TemplateData] of a message.
+func ValidateMessageTemplate(name, content string) error {
+	if !domain.IsMessageTemplateName(name) {
+		return zerrors.ThrowInvalidArgument(nil, "TEMPL-Mt1nm", "Errors.MessageTemplate.NameInvalid")
+	}
+	if len(content) > MaxMessageTemplateSize {
+		return zerrors.ThrowInvalidArgument(nil, "TEMPL-Mt2sz", "Errors.MessageTemplate.TooLarge")
+	}
+	message, partials := content, map[string]string{}
+	if domain.IsMessageTemplatePartial(name) {
+		message, partials[name] = `{{template "`+name+`" .}}`, content
+	}
+	_, err := RenderMessageTemplate(message, partials, sampleTemplateData())
+	return err
+}
+
+// RenderMessageTemplate renders the custom template of a message type including the partials (header and footer).
+// Partials which are not passed are rendered empty.
+//
+// The templates are rendered in a sandbox:
+// they can only reference the fields of the [TemplateData], compare them and include the partials,
+// but neither define templates, use loops nor call other functions, and the size of the rendered message is limited.
+func RenderMessageTemplate(message string, partials map[string]string, data TemplateData) (string, error) {
+	tmpl, err := sandboxedTemplate(messageTemplateName, message, true)
+	if err != nil {
+		return "", err
+	}
+	for _, name := range domain.MessageTemplatePartials {
+		partial, err := sandboxedTemplate(name, partials[name], false)
+		if err != nil {
+			return "", err
+		}
+		if _, err = tmpl.AddParseTree(name, partial.Tree); err != nil {
+			return "", zerrors.ThrowInvalidArgument(err, "TEMPL-Mt3pt", "Errors.MessageTemplate.Invalid")
+		}
+	}
+	rendered, err := executeSandboxed(tmpl, data)
+	if err != nil {
+		return "", err
+	}
+	// the texts of the message can contain placeholders as well, which are resolved in a second pass
+	// the same way as for the default template
+	tmpl, err = sandboxedTemplate(messageTemplateName, rendered, false)
+	if err != nil {
+		return "", err
+	}
+	return executeSandboxed(tmpl, data)
+}
+
+// sandboxedTemplate parses the content as template with the name
+// and checks that it only contains allowed actions.
+func sandboxedTemplate(name, content string, allowPartials bool) (*template.Template, error) {
+	tmpl, err := template.New(name).Parse(content)
+	if err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "TEMPL-Mt4ps", "Errors.MessageTemplate.Invalid")
+	}
+	for _, t := range tmpl.Templates() {
+		if t.Name() != name {
+			return nil, zerrors.ThrowInvalidArgument(nil, "TEMPL-Mt5df", "Errors.MessageTemplate.Invalid")
+		}
+	}
+	if tmpl.Tree == nil {
+		tmpl, err = template.New(name).Parse("{{/* empty */}}")
+		if err != nil {
+			return nil, zerrors.ThrowInternal(err, "TEMPL-Mt6em", "Errors.Internal")
+		}
+		return tmpl, nil
+	}
+	if err = checkSandboxedNode(tmpl.Tree.Root, allowPartials); err != nil {
+		return nil, err
+	}
+	return tmpl, nil
+}
+
+func checkSandboxedNode(node parse.Node, allowPartials bool) error {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return nil
+		}
+		for _, child := range n.Nodes {
+			if err := checkSandboxedNode(child, allowPartials); err != nil {
+				return err
+			}
+		}
+	case *parse.ActionNode:
+		return checkSandboxedPipe(n.Pipe)
+	case *parse.IfNode:
+		return checkSandboxedBranch(&n.BranchNode, allowPartials)
+	case *parse.WithNode:
+		return checkSandboxedBranch(&n.BranchNode, allowPartials)
+	case *parse.RangeNode:
+		return zerrors.ThrowInvalidArgument(nil, "TEMPL-Mt7rg", "Errors.MessageTemplate.Invalid")
+	case *parse.TemplateNode:
+		if !allowPartials || !domain.IsMessageTemplatePartial(n.Name) {
+			return zerrors.ThrowInvalidArgument(nil, "TEMPL-Mt8tn", "Errors.MessageTemplate.Invalid")
+		}
+		return checkSandboxedPipe(n.Pipe)
+	}
+	return nil
+}
+
+// checkSandboxedPipe checks that the commands of the pipe only call the [sandboxedFuncs].
+func checkSandboxedPipe(pipe *parse.PipeNode) error {
+	if pipe == nil {
+		return nil
+	}
+	for _, cmd := range pipe.Cmds {
+		for _, arg := range cmd.Args {
+			if err := checkSandboxedArg(arg); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func checkSandboxedArg(arg parse.Node) error {
+	switch a := arg.(type) {
+	case *parse.IdentifierNode:
+		if !sandboxedFuncs[a.Ident] {
+			return zerrors.ThrowInvalidArgument(nil, "TEMPL-Mu2fn", "Errors.MessageTemplate.Invalid")
+		}
+	case *parse.PipeNode:
+		return checkSandboxedPipe(a)
+	case *parse.ChainNode:
+		return checkSandboxedArg(a.Node)
+	}
+	return nil
+}
+
+func checkSandboxedBranch(branch *parse.BranchNode, allowPartials bool) error {
+	if err := checkSandboxedPipe(branch.Pipe); err != nil {
+		return err
+	}
+	if err := checkSandboxedNode(branch.List, allowPartials); err != nil {
+		return err
+	}
+	return checkSandboxedNode(branch.ElseList, allowPartials)
+}
+
+func executeSandboxed(tmpl *template.Template, data TemplateData) (string, error) {
+	w := &limitedWriter{remaining: maxRenderedSize}
+	if err := tmpl.Execute(w, data); err != nil {
+		if errors.Is(err, errRenderedTooLarge) {
+			return "", zerrors.ThrowInvalidArgument(err, "TEMPL-Mt9sz", "Errors.MessageTemplate.TooLarge")
+		}
+		return "", zerrors.ThrowInvalidArgument(err, "TEMPL-Mu1ex", "Errors.MessageTemplate.Invalid")
+	}
+	return w.buf.String(), nil
+}
+
+// limitedWriter fails as soon as more than the remaining bytes are written
+type limitedWriter struct {
+	buf       bytes.Buffer
+	remaining int
+}
+
+func (w *limitedWriter) Write(p []byte) (int, error) {
+	if len(p) > w.remaining {
+		return 0, errRenderedTooLarge
+	}
+	w.remaining -= len(p)
+	return w.buf.Write(p)
+}
+
+// sampleTemplateData returns data with all fields set,
+// so templates can be validated against the data available when a message is rendered.
+func sampleTemplateData() TemplateData {
+	return TemplateData{
+		Title:           "Title",
+		PreHeader:       "PreHeader",
+		Subject:         "Subject",
+		Greeting:        "Hello",
+		Text:            "Text",
+		URL:             "https://example.com",
+		ButtonText:      "Button",
+		PrimaryColor:    DefaultPrimaryColor,
+		BackgroundColor: DefaultBackgroundColor,
+		FontColor:       DefaultFontColor,
+		LogoURL:         "https://example.com/logo.png",
+		FontURL:         "https://example.com/font.ttf",
+		FontFaceFamily:  "font",
+		FontFamily:      DefaultFontFamily,
+		IncludeFooter:   true,
+		FooterText:      "Footer",
+	}
+}
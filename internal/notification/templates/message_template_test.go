@@ -0,0 +1,195 @@
+package templates
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestRenderMessageTemplate(t *testing.T) {
+	type args struct {
+		message  string
+		partials map[string]string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr func(error) bool
+	}{
+		{
+			name: "without partials",
+			args: args{
+				message: `{{template "header" .}}<p>{{.Greeting}}</p>{{template "footer" .}}`,
+			},
+			want: "<p>Hello</p>",
+		},
+		{
+			name: "with partials",
+			args: args{
+				message: `{{template "header" .}}<p>{{.Greeting}}</p>{{template "footer" .}}`,
+				partials: map[string]string{
+					domain.MessageTemplatePartialHeader: `<h1>{{.Title}}</h1>`,
+					domain.MessageTemplatePartialFooter: `{{if .IncludeFooter}}<small>{{.FooterText}}</small>{{end}}`,
+				},
+			},
+			want: "<h1>Title</h1><p>Hello</p><small>Footer</small>",
+		},
+		{
+			name: "unknown field",
+			args: args{
+				message: `{{.Password}}`,
+			},
+			wantErr: zerrors.IsErrorInvalidArgument,
+		},
+		{
+			name: "range",
+			args: args{
+				message: `{{range 10}}{{.Text}}{{end}}`,
+			},
+			wantErr: zerrors.IsErrorInvalidArgument,
+		},
+		{
+			name: "compare",
+			args: args{
+				message: `{{if and .IncludeFooter (ne .FooterText "")}}{{.FooterText}}{{end}}`,
+			},
+			want: "Footer",
+		},
+		{
+			name: "printf",
+			args: args{
+				message: `{{printf "%01000000d" 0}}`,
+			},
+			wantErr: zerrors.IsErrorInvalidArgument,
+		},
+		{
+			name: "function in condition",
+			args: args{
+				message: `{{if print .Text}}{{.Text}}{{end}}`,
+			},
+			wantErr: zerrors.IsErrorInvalidArgument,
+		},
+		{
+			name: "function in pipeline",
+			args: args{
+				message: `{{.Text | html | urlquery}}`,
+			},
+			wantErr: zerrors.IsErrorInvalidArgument,
+		},
+		{
+			name: "function in partial",
+			args: args{
+				message: `{{template "header" .}}`,
+				partials: map[string]string{
+					domain.MessageTemplatePartialHeader: `{{js .Title}}`,
+				},
+			},
+			wantErr: zerrors.IsErrorInvalidArgument,
+		},
+		{
+			name: "define",
+			args: args{
+				message: `{{define "header"}}{{.Text}}{{end}}`,
+			},
+			wantErr: zerrors.IsErrorInvalidArgument,
+		},
+		{
+			name: "unknown template",
+			args: args{
+				message: `{{template "message" .}}`,
+			},
+			wantErr: zerrors.IsErrorInvalidArgument,
+		},
+		{
+			name: "partial including partial",
+			args: args{
+				message: `{{template "header" .}}`,
+				partials: map[string]string{
+					domain.MessageTemplatePartialHeader: `{{template "footer" .}}`,
+				},
+			},
+			wantErr: zerrors.IsErrorInvalidArgument,
+		},
+		{
+			name: "too large",
+			args: args{
+				message: strings.Repeat(`{{template "header" .}}`, 10),
+				partials: map[string]string{
+					domain.MessageTemplatePartialHeader: strings.Repeat("a", MaxMessageTemplateSize),
+				},
+			},
+			wantErr: zerrors.IsErrorInvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := RenderMessageTemplate(tt.args.message, tt.args.partials, sampleTemplateData())
+			if tt.wantErr != nil {
+				assert.True(t, tt.wantErr(err), "unexpected error: %v", err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestValidateMessageTemplate(t *testing.T) {
+	tests := []struct {
+		name         string
+		templateName string
+		content      string
+		wantErr      bool
+	}{
+		{
+			name:         "message type",
+			templateName: domain.InitCodeMessageType,
+			content:      `{{template "header" .}}<a href="{{.URL}}">{{.ButtonText}}</a>`,
+		},
+		{
+			name:         "partial",
+			templateName: domain.MessageTemplatePartialFooter,
+			content:      `<small>{{.FooterText}}</small>`,
+		},
+		{
+			name:         "invalid name",
+			templateName: "unknown",
+			content:      `{{.Text}}`,
+			wantErr:      true,
+		},
+		{
+			name:         "partial including partial",
+			templateName: domain.MessageTemplatePartialFooter,
+			content:      `{{template "header" .}}`,
+			wantErr:      true,
+		},
+		{
+			name:         "syntax error",
+			templateName: domain.InitCodeMessageType,
+			content:      `{{.Text`,
+			wantErr:      true,
+		},
+		{
+			name:         "too large",
+			templateName: domain.InitCodeMessageType,
+			content:      strings.Repeat("a", MaxMessageTemplateSize+1),
+			wantErr:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateMessageTemplate(tt.templateName, tt.content)
+			if tt.wantErr {
+				assert.True(t, zerrors.IsErrorInvalidArgument(err), "unexpected error: %v", err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
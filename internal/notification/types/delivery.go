@@ -0,0 +1,46 @@
+package types
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/repository/org"
+)
+
+// recordDelivery adds the message to the delivery history of the user.
+// Test messages of the organization aren't recorded, as they're not sent to the user's address.
+// Only the expiry of the code is recorded, never the code itself.
+func recordDelivery(
+	ctx context.Context,
+	channels ChannelChains,
+	user *query.NotifyUser,
+	delivery domain.NotificationDelivery,
+	hasCode bool,
+	triggeringEvent eventstore.Event,
+	sendErr error,
+) {
+	if user == nil || triggeringEvent == nil || triggeringEvent.Type() == org.TestMessageRequestedType {
+		return
+	}
+	if hasCode {
+		delivery.CodeExpiry = codeExpiry(triggeringEvent)
+	}
+	channels.RecordDelivery(ctx, user, &delivery, triggeringEvent, sendErr)
+}
+
+// codeExpiry reads the expiry of the code from the event which added it.
+func codeExpiry(event eventstore.Event) time.Duration {
+	code := struct {
+		Expiry time.Duration `json:"expiry"`
+	}{}
+	if err := event.Unmarshal(&code); err != nil {
+		logging.WithFields("event", event.Type()).OnError(err).Debug("unable to read code expiry")
+		return 0
+	}
+	return code.Expiry
+}
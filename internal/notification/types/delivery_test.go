@@ -0,0 +1,112 @@
+package types
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/notification/channels/email"
+	"github.com/zitadel/zitadel/internal/notification/channels/sms"
+	"github.com/zitadel/zitadel/internal/notification/channels/webhook"
+	"github.com/zitadel/zitadel/internal/notification/senders"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+type recordedDelivery struct {
+	delivery *domain.NotificationDelivery
+	sendErr  error
+}
+
+type recordingChannels struct {
+	recorded []recordedDelivery
+}
+
+func (c *recordingChannels) Email(context.Context, string) (*senders.Chain, *email.Config, error) {
+	return nil, nil, nil
+}
+
+func (c *recordingChannels) SMS(context.Context, string) (*senders.Chain, *sms.Config, error) {
+	return nil, nil, nil
+}
+
+func (c *recordingChannels) Webhook(context.Context, webhook.Config) (*senders.Chain, error) {
+	return nil, nil
+}
+
+func (c *recordingChannels) Push(context.Context, domain.PushPlatform) (*senders.Chain, error) {
+	return nil, nil
+}
+
+func (c *recordingChannels) RecordDelivery(_ context.Context, _ *query.NotifyUser, delivery *domain.NotificationDelivery, _ eventstore.Event, sendErr error) {
+	c.recorded = append(c.recorded, recordedDelivery{delivery: delivery, sendErr: sendErr})
+}
+
+func Test_recordDelivery(t *testing.T) {
+	codeEvent := &eventstore.BaseEvent{
+		EventType: user.HumanEmailCodeAddedType,
+		Data:      []byte(`{"code":{"keyId":"id"},"expiry":3600000000000}`),
+	}
+	sendErr := errors.New("provider unavailable")
+	type args struct {
+		user            *query.NotifyUser
+		hasCode         bool
+		triggeringEvent eventstore.Event
+		sendErr         error
+	}
+	tests := []struct {
+		name string
+		args args
+		want []recordedDelivery
+	}{
+		{
+			name: "code, expiry recorded",
+			args: args{
+				user:            &query.NotifyUser{ID: "user1"},
+				hasCode:         true,
+				triggeringEvent: codeEvent,
+			},
+			want: []recordedDelivery{{
+				delivery: &domain.NotificationDelivery{Channel: domain.NotificationTypeEmail, CodeExpiry: time.Hour},
+			}},
+		},
+		{
+			name: "no code, no expiry recorded",
+			args: args{
+				user:            &query.NotifyUser{ID: "user1"},
+				triggeringEvent: codeEvent,
+				sendErr:         sendErr,
+			},
+			want: []recordedDelivery{{
+				delivery: &domain.NotificationDelivery{Channel: domain.NotificationTypeEmail},
+				sendErr:  sendErr,
+			}},
+		},
+		{
+			name: "test message, not recorded",
+			args: args{
+				user:            &query.NotifyUser{ID: "user1"},
+				triggeringEvent: &eventstore.BaseEvent{EventType: org.TestMessageRequestedType},
+			},
+		},
+		{
+			name: "no user, not recorded",
+			args: args{
+				triggeringEvent: codeEvent,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			channels := new(recordingChannels)
+			recordDelivery(context.Background(), channels, tt.args.user, domain.NotificationDelivery{Channel: domain.NotificationTypeEmail}, tt.args.hasCode, tt.args.triggeringEvent, tt.args.sendErr)
+			assert.Equal(t, tt.want, channels.recorded)
+		})
+	}
+}
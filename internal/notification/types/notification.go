@@ -2,11 +2,15 @@ package types
 
 import (
 	"context"
+	"html"
 
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/i18n"
-	"github.com/zitadel/zitadel/internal/notification/channels/smtp"
-	"github.com/zitadel/zitadel/internal/notification/channels/twilio"
+	"github.com/zitadel/zitadel/internal/notification/channels/email"
+	"github.com/zitadel/zitadel/internal/notification/channels/sms"
 	"github.com/zitadel/zitadel/internal/notification/channels/webhook"
 	"github.com/zitadel/zitadel/internal/notification/senders"
 	"github.com/zitadel/zitadel/internal/notification/templates"
@@ -21,15 +25,24 @@ type Notify func(
 ) error
 
 type ChannelChains interface {
-	Email(context.Context) (*senders.Chain, *smtp.Config, error)
-	SMS(context.Context) (*senders.Chain, *twilio.Config, error)
+	// Email returns the email channels of the resource owner,
+	// which fall back to the ones of the instance if the organization has no active provider.
+	Email(ctx context.Context, resourceOwner string) (*senders.Chain, *email.Config, error)
+	// SMS returns the SMS channels of the resource owner,
+	// which fall back to the ones of the instance if the organization has no active provider.
+	SMS(ctx context.Context, resourceOwner string) (*senders.Chain, *sms.Config, error)
 	Webhook(context.Context, webhook.Config) (*senders.Chain, error)
+	// Push returns the channels delivering push messages to devices of the platform.
+	Push(ctx context.Context, platform domain.PushPlatform) (*senders.Chain, error)
+	// RecordDelivery adds the attempt to send a message to the delivery history of the user.
+	// Errors are only logged, so they don't cause the message to be sent again.
+	RecordDelivery(ctx context.Context, user *query.NotifyUser, delivery *domain.NotificationDelivery, triggeringEvent eventstore.Event, sendErr error)
 }
 
 func SendEmail(
 	ctx context.Context,
 	channels ChannelChains,
-	mailhtml string,
+	mailTemplates *MailTemplates,
 	translator *i18n.Translator,
 	user *query.NotifyUser,
 	colors *query.LabelPolicy,
@@ -41,9 +54,7 @@ func SendEmail(
 		messageType string,
 		allowUnverifiedNotificationChannel bool,
 	) error {
-		args = mapNotifyUserToArgs(user, args)
-		data := GetTemplateData(ctx, translator, args, url, messageType, user.PreferredLanguage.String(), colors)
-		template, err := templates.GetParsedTemplate(mailhtml, data)
+		message, err := RenderMessage(ctx, mailTemplates, translator, user, colors, url, args, messageType)
 		if err != nil {
 			return err
 		}
@@ -51,8 +62,10 @@ func SendEmail(
 			ctx,
 			channels,
 			user,
-			data.Subject,
-			template,
+			message.Subject,
+			message.HTML,
+			messageType,
+			codeFromArgs(args),
 			allowUnverifiedNotificationChannel,
 			triggeringEvent,
 		)
@@ -80,6 +93,8 @@ func SendSMSTwilio(
 			channels,
 			user,
 			data.Text,
+			messageType,
+			codeFromArgs(args),
 			allowUnverifiedNotificationChannel,
 			triggeringEvent,
 		)
@@ -103,3 +118,77 @@ func SendJSON(
 		)
 	}
 }
+
+// Message is a notification message rendered for a user.
+type Message struct {
+	Subject string
+	// HTML is the content of emails
+	HTML string
+	// Text is the plain text of the message, which is also the content of SMS
+	Text string
+}
+
+// MailTemplates are the templates the emails of an organization are rendered with.
+type MailTemplates struct {
+	// Default is the template of the mail template policy,
+	// which is used for message types without a custom template.
+	Default string
+	// Custom are the custom templates of the message types and partials of the organization and the instance.
+	Custom *query.MessageTemplates
+	// DefaultLanguage is used if there is no custom template in the preferred language of the user.
+	DefaultLanguage language.Tag
+}
+
+// render renders the custom template of the messageType in the language
+// or the default template if there is none.
+func (t *MailTemplates) render(messageType string, lang language.Tag, data templates.TemplateData) (string, error) {
+	langs := []language.Tag{lang, t.DefaultLanguage}
+	message, ok := t.Custom.Template(messageType, langs...)
+	if !ok {
+		return templates.GetParsedTemplate(t.Default, data)
+	}
+	partials := make(map[string]string, len(domain.MessageTemplatePartials))
+	for _, name := range domain.MessageTemplatePartials {
+		if partial, ok := t.Custom.Template(name, langs...); ok {
+			partials[name] = partial
+		}
+	}
+	return templates.RenderMessageTemplate(message, partials, data)
+}
+
+// RenderMessage renders the message of the messageType for the user
+// the same way it is sent by [SendEmail] and [SendSMSTwilio].
+func RenderMessage(
+	ctx context.Context,
+	mailTemplates *MailTemplates,
+	translator *i18n.Translator,
+	user *query.NotifyUser,
+	colors *query.LabelPolicy,
+	url string,
+	args map[string]interface{},
+	messageType string,
+) (*Message, error) {
+	args = mapNotifyUserToArgs(user, args)
+	data := GetTemplateData(ctx, translator, args, url, messageType, user.PreferredLanguage.String(), colors)
+	template, err := mailTemplates.render(messageType, user.PreferredLanguage, data)
+	if err != nil {
+		return nil, err
+	}
+	return &Message{
+		Subject: data.Subject,
+		HTML:    html.UnescapeString(template),
+		Text:    data.Text,
+	}, nil
+}
+
+// codeFromArgs returns the code or one-time password of the message,
+// so it can be passed to providers which render the message themselves.
+func codeFromArgs(args map[string]interface{}) string {
+	if code, ok := args["Code"].(string); ok {
+		return code
+	}
+	if otp, ok := args["OTP"].(string); ok {
+		return otp
+	}
+	return ""
+}
@@ -0,0 +1,55 @@
+package types
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/i18n"
+	"github.com/zitadel/zitadel/internal/notification/messages"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/repository/session"
+)
+
+// SendPushChallenge sends the challenge of the session to the device,
+// where the user approves the login by signing the challenge with the key of the device.
+func SendPushChallenge(
+	ctx context.Context,
+	channels ChannelChains,
+	translator *i18n.Translator,
+	user *query.NotifyUser,
+	device *session.PushDevice,
+	sessionID,
+	challenge string,
+	triggeringEvent eventstore.Event,
+) error {
+	args := mapNotifyUserToArgs(user, map[string]interface{}{
+		"Domain": authz.GetInstance(ctx).RequestedDomain(),
+	})
+	lang := user.PreferredLanguage.String()
+	message := &messages.Push{
+		Platform:  device.Platform,
+		PushToken: device.PushToken,
+		Title:     translator.Localize(fmt.Sprintf("%s.%s", domain.VerifyPushMessageType, domain.MessageTitle), args, lang),
+		Body:      translator.Localize(fmt.Sprintf("%s.%s", domain.VerifyPushMessageType, domain.MessageText), args, lang),
+		Data: map[string]string{
+			"deviceId":  device.DeviceID,
+			"sessionId": sessionID,
+			"challenge": challenge,
+		},
+		TriggeringEvent: triggeringEvent,
+	}
+	pushChannels, err := channels.Push(ctx, device.Platform)
+	if err != nil {
+		return err
+	}
+	err = pushChannels.HandleMessage(message)
+	recordDelivery(ctx, channels, user, domain.NotificationDelivery{
+		Channel:           domain.NotificationTypePush,
+		MessageType:       domain.VerifyPushMessageType,
+		ProviderMessageID: message.ProviderMessageID,
+	}, false, triggeringEvent, err)
+	return err
+}
@@ -0,0 +1,40 @@
+package types
+
+import (
+	"context"
+	"time"
+
+	http_utils "github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/api/ui/console"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	user_repo "github.com/zitadel/zitadel/internal/repository/user"
+)
+
+// SendSecurityAlert notifies the user about a security relevant change of the account at date.
+// The browserInfo is only passed for alerts about logins.
+func (notify Notify) SendSecurityAlert(ctx context.Context, user *query.NotifyUser, alertType string, date time.Time, browserInfo *user_repo.BrowserInfo) error {
+	url := console.LoginHintLink(http_utils.ComposedOrigin(ctx), user.PreferredLoginName)
+	args := make(map[string]interface{})
+	args["Date"] = date.UTC().Format(time.RFC1123)
+	if browserInfo != nil {
+		args["UserAgent"] = browserInfo.UserAgent
+		args["RemoteIP"] = browserInfo.RemoteIP.String()
+	}
+	return notify(url, args, alertType, false)
+}
+
+// SendBreakGlassUsed alerts the recipient about the login of the emergency access account with loginName at date.
+func (notify Notify) SendBreakGlassUsed(ctx context.Context, recipient *query.NotifyUser, loginName string, date time.Time, browserInfo *user_repo.BrowserInfo) error {
+	url := console.LoginHintLink(http_utils.ComposedOrigin(ctx), recipient.PreferredLoginName)
+	args := make(map[string]interface{})
+	args["BreakGlassUser"] = loginName
+	args["Date"] = date.UTC().Format(time.RFC1123)
+	args["UserAgent"] = ""
+	args["RemoteIP"] = ""
+	if browserInfo != nil {
+		args["UserAgent"] = browserInfo.UserAgent
+		args["RemoteIP"] = browserInfo.RemoteIP.String()
+	}
+	return notify(url, args, domain.BreakGlassUsedMessageType, false)
+}
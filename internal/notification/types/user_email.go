@@ -2,8 +2,8 @@ package types
 
 import (
 	"context"
-	"html"
 
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/notification/messages"
 	"github.com/zitadel/zitadel/internal/query"
@@ -15,28 +15,41 @@ func generateEmail(
 	channels ChannelChains,
 	user *query.NotifyUser,
 	subject,
-	content string,
+	content,
+	templateType,
+	code string,
 	lastEmail bool,
 	triggeringEvent eventstore.Event,
 ) error {
-	content = html.UnescapeString(content)
 	message := &messages.Email{
 		Recipients:      []string{user.VerifiedEmail},
 		Subject:         subject,
 		Content:         content,
+		TemplateType:    templateType,
+		Code:            code,
 		TriggeringEvent: triggeringEvent,
 	}
 	if lastEmail {
 		message.Recipients = []string{user.LastEmail}
 	}
-	emailChannels, _, err := channels.Email(ctx)
+	emailChannels, emailConfig, err := channels.Email(ctx, user.ResourceOwner)
 	if err != nil {
 		return err
 	}
 	if emailChannels == nil || emailChannels.Len() == 0 {
 		return zerrors.ThrowPreconditionFailed(nil, "MAIL-83nof", "Errors.Notification.Channels.NotPresent")
 	}
-	return emailChannels.HandleMessage(message)
+	err = emailChannels.HandleMessage(message)
+	delivery := domain.NotificationDelivery{
+		Channel:           domain.NotificationTypeEmail,
+		MessageType:       templateType,
+		ProviderMessageID: message.ProviderMessageID,
+	}
+	if emailConfig != nil {
+		delivery.ProviderID = emailConfig.ProviderID
+	}
+	recordDelivery(ctx, channels, user, delivery, code != "", triggeringEvent, err)
+	return err
 }
 
 func mapNotifyUserToArgs(user *query.NotifyUser, args map[string]interface{}) map[string]interface{} {
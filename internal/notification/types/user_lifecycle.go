@@ -0,0 +1,25 @@
+package types
+
+import (
+	"context"
+	"time"
+
+	http_utils "github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/api/ui/login"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+func (notify Notify) SendUserDeactivationWarning(ctx context.Context, user *query.NotifyUser, deactivationDate time.Time) error {
+	url := login.LoginLink(http_utils.ComposedOrigin(ctx), user.ResourceOwner)
+	args := make(map[string]interface{})
+	args["DeactivationDate"] = deactivationDate.Format(time.DateOnly)
+	return notify(url, args, domain.UserDeactivationWarningMessageType, true)
+}
+
+func (notify Notify) SendUserDeletionWarning(ctx context.Context, user *query.NotifyUser, deletionDate time.Time) error {
+	url := login.LoginLink(http_utils.ComposedOrigin(ctx), user.ResourceOwner)
+	args := make(map[string]interface{})
+	args["DeletionDate"] = deletionDate.Format(time.DateOnly)
+	return notify(url, args, domain.UserDeletionWarningMessageType, true)
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/zitadel/logging"
 
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/notification/messages"
 	"github.com/zitadel/zitadel/internal/query"
@@ -15,27 +16,41 @@ func generateSms(
 	ctx context.Context,
 	channels ChannelChains,
 	user *query.NotifyUser,
-	content string,
+	content,
+	templateType,
+	code string,
 	lastPhone bool,
 	triggeringEvent eventstore.Event,
 ) error {
 	number := ""
-	smsChannels, twilioConfig, err := channels.SMS(ctx)
+	smsChannels, smsConfig, err := channels.SMS(ctx, user.ResourceOwner)
 	logging.OnError(err).Error("could not create sms channel")
 	if smsChannels == nil || smsChannels.Len() == 0 {
 		return zerrors.ThrowPreconditionFailed(nil, "PHONE-w8nfow", "Errors.Notification.Channels.NotPresent")
 	}
-	if err == nil {
-		number = twilioConfig.SenderNumber
+	if err == nil && smsConfig != nil && smsConfig.TwilioConfig != nil {
+		number = smsConfig.TwilioConfig.SenderNumber
 	}
 	message := &messages.SMS{
 		SenderPhoneNumber:    number,
 		RecipientPhoneNumber: user.VerifiedPhone,
 		Content:              content,
+		TemplateType:         templateType,
+		Code:                 code,
 		TriggeringEvent:      triggeringEvent,
 	}
 	if lastPhone {
 		message.RecipientPhoneNumber = user.LastPhone
 	}
-	return smsChannels.HandleMessage(message)
+	err = smsChannels.HandleMessage(message)
+	delivery := domain.NotificationDelivery{
+		Channel:           domain.NotificationTypeSms,
+		MessageType:       templateType,
+		ProviderMessageID: message.ProviderMessageID,
+	}
+	if smsConfig != nil {
+		delivery.ProviderID = smsConfig.ProviderID
+	}
+	recordDelivery(ctx, channels, user, delivery, code != "", triggeringEvent, err)
+	return err
 }
@@ -0,0 +1,223 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	accessRequestTable = table{
+		name:          projection.AccessRequestTable,
+		instanceIDCol: projection.AccessRequestInstanceIDCol,
+	}
+	AccessRequestColumnID = Column{
+		name:  projection.AccessRequestIDCol,
+		table: accessRequestTable,
+	}
+	AccessRequestColumnCreationDate = Column{
+		name:  projection.AccessRequestCreationDateCol,
+		table: accessRequestTable,
+	}
+	AccessRequestColumnChangeDate = Column{
+		name:  projection.AccessRequestChangeDateCol,
+		table: accessRequestTable,
+	}
+	AccessRequestColumnSequence = Column{
+		name:  projection.AccessRequestSequenceCol,
+		table: accessRequestTable,
+	}
+	AccessRequestColumnState = Column{
+		name:  projection.AccessRequestStateCol,
+		table: accessRequestTable,
+	}
+	AccessRequestColumnResourceOwner = Column{
+		name:  projection.AccessRequestResourceOwnerCol,
+		table: accessRequestTable,
+	}
+	AccessRequestColumnInstanceID = Column{
+		name:  projection.AccessRequestInstanceIDCol,
+		table: accessRequestTable,
+	}
+	AccessRequestColumnUserID = Column{
+		name:  projection.AccessRequestUserIDCol,
+		table: accessRequestTable,
+	}
+	AccessRequestColumnScope = Column{
+		name:  projection.AccessRequestScopeCol,
+		table: accessRequestTable,
+	}
+	AccessRequestColumnRoles = Column{
+		name:  projection.AccessRequestRolesCol,
+		table: accessRequestTable,
+	}
+	AccessRequestColumnDuration = Column{
+		name:  projection.AccessRequestDurationCol,
+		table: accessRequestTable,
+	}
+	AccessRequestColumnReason = Column{
+		name:  projection.AccessRequestReasonCol,
+		table: accessRequestTable,
+	}
+	AccessRequestColumnDecidedBy = Column{
+		name:  projection.AccessRequestDecidedByCol,
+		table: accessRequestTable,
+	}
+	AccessRequestColumnValidUntil = Column{
+		name:  projection.AccessRequestValidUntilCol,
+		table: accessRequestTable,
+	}
+	AccessRequestColumnElevation = Column{
+		name:  projection.AccessRequestElevationCol,
+		table: accessRequestTable,
+	}
+)
+
+type AccessRequests struct {
+	SearchResponse
+	AccessRequests []*AccessRequest
+}
+
+func (r *AccessRequests) SetState(s *State) {
+	r.State = s
+}
+
+type AccessRequest struct {
+	ID            string
+	CreationDate  time.Time
+	ChangeDate    time.Time
+	Sequence      uint64
+	State         domain.AccessRequestState
+	ResourceOwner string
+
+	UserID    string
+	Scope     domain.AccessRequestScope
+	Roles     database.TextArray[string]
+	Duration  time.Duration
+	Reason    string
+	DecidedBy string
+	// ValidUntil is the end of the membership granted by the approval
+	ValidUntil time.Time
+}
+
+type AccessRequestSearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+func (q *AccessRequestSearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+// SearchAccessRequests returns the requests of users for time-bound memberships.
+func (q *Queries) SearchAccessRequests(ctx context.Context, queries *AccessRequestSearchQueries) (_ *AccessRequests, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	where := sq.Eq{AccessRequestColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID()}
+	query, scan := prepareAccessRequestsQuery(ctx, q.client)
+	return genericRowsQueryWithState[*AccessRequests](ctx, q.client, accessRequestTable, combineToWhereStmt(query, queries.toQuery, where), scan)
+}
+
+func NewAccessRequestUserIDSearchQuery(userID string) (SearchQuery, error) {
+	return NewTextQuery(AccessRequestColumnUserID, userID, TextEquals)
+}
+
+func NewAccessRequestResourceOwnerSearchQuery(resourceOwner string) (SearchQuery, error) {
+	return NewTextQuery(AccessRequestColumnResourceOwner, resourceOwner, TextEquals)
+}
+
+func NewAccessRequestStateSearchQuery(state domain.AccessRequestState) (SearchQuery, error) {
+	return NewNumberQuery(AccessRequestColumnState, state, NumberEquals)
+}
+
+func NewAccessRequestScopeSearchQuery(scope domain.AccessRequestScope) (SearchQuery, error) {
+	return NewNumberQuery(AccessRequestColumnScope, scope, NumberEquals)
+}
+
+// NewAccessRequestElevationExpiredQuery returns the approved requests whose elevation of an existing member ended before the time
+// and whose end is not recorded yet.
+func NewAccessRequestElevationExpiredQuery(at time.Time) (SearchQuery, error) {
+	return NewAndQuery(
+		&NumberQuery{Column: AccessRequestColumnState, Number: domain.AccessRequestStateApproved, Compare: NumberEquals},
+		&BoolQuery{Column: AccessRequestColumnElevation, Value: true},
+		&TimestampQuery{Column: AccessRequestColumnValidUntil, Compare: TimestampLessOrEquals, Value: at},
+	)
+}
+
+func prepareAccessRequestsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(rows *sql.Rows) (*AccessRequests, error)) {
+	return sq.Select(
+			AccessRequestColumnID.identifier(),
+			AccessRequestColumnCreationDate.identifier(),
+			AccessRequestColumnChangeDate.identifier(),
+			AccessRequestColumnSequence.identifier(),
+			AccessRequestColumnState.identifier(),
+			AccessRequestColumnResourceOwner.identifier(),
+			AccessRequestColumnUserID.identifier(),
+			AccessRequestColumnScope.identifier(),
+			AccessRequestColumnRoles.identifier(),
+			AccessRequestColumnDuration.identifier(),
+			AccessRequestColumnReason.identifier(),
+			AccessRequestColumnDecidedBy.identifier(),
+			AccessRequestColumnValidUntil.identifier(),
+			countColumn.identifier(),
+		).From(accessRequestTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*AccessRequests, error) {
+			requests := make([]*AccessRequest, 0)
+			var count uint64
+			for rows.Next() {
+				var (
+					request    = new(AccessRequest)
+					duration   database.NullDuration
+					validUntil sql.NullTime
+				)
+				err := rows.Scan(
+					&request.ID,
+					&request.CreationDate,
+					&request.ChangeDate,
+					&request.Sequence,
+					&request.State,
+					&request.ResourceOwner,
+					&request.UserID,
+					&request.Scope,
+					&request.Roles,
+					&duration,
+					&request.Reason,
+					&request.DecidedBy,
+					&validUntil,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				request.Duration = duration.Duration
+				request.ValidUntil = validUntil.Time
+				requests = append(requests, request)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Ar5cr", "Errors.Query.CloseRows")
+			}
+
+			return &AccessRequests{
+				AccessRequests: requests,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
@@ -0,0 +1,174 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+var (
+	prepareAccessRequestsStmt = `SELECT projections.access_requests3.id,` +
+		` projections.access_requests3.creation_date,` +
+		` projections.access_requests3.change_date,` +
+		` projections.access_requests3.sequence,` +
+		` projections.access_requests3.state,` +
+		` projections.access_requests3.resource_owner,` +
+		` projections.access_requests3.user_id,` +
+		` projections.access_requests3.scope,` +
+		` projections.access_requests3.roles,` +
+		` projections.access_requests3.duration,` +
+		` projections.access_requests3.reason,` +
+		` projections.access_requests3.decided_by,` +
+		` projections.access_requests3.valid_until,` +
+		` COUNT(*) OVER ()` +
+		` FROM projections.access_requests3`
+	prepareAccessRequestsCols = []string{
+		"id",
+		"creation_date",
+		"change_date",
+		"sequence",
+		"state",
+		"resource_owner",
+		"user_id",
+		"scope",
+		"roles",
+		"duration",
+		"reason",
+		"decided_by",
+		"valid_until",
+		"count",
+	}
+)
+
+func Test_AccessRequestPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareAccessRequestsQuery no result",
+			prepare: prepareAccessRequestsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareAccessRequestsStmt),
+					nil,
+					nil,
+				),
+			},
+			object: &AccessRequests{AccessRequests: []*AccessRequest{}},
+		},
+		{
+			name:    "prepareAccessRequestsQuery multiple result",
+			prepare: prepareAccessRequestsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareAccessRequestsStmt),
+					prepareAccessRequestsCols,
+					[][]driver.Value{
+						{
+							"request-1",
+							testNow,
+							testNow,
+							uint64(20211109),
+							domain.AccessRequestStatePending,
+							"ro",
+							"user-1",
+							domain.AccessRequestScopeOrg,
+							database.TextArray[string]{"ORG_OWNER"},
+							&pgtype.Interval{Microseconds: time.Hour.Microseconds(), Valid: true},
+							"incident",
+							"",
+							nil,
+						},
+						{
+							"request-2",
+							testNow,
+							testNow,
+							uint64(20211110),
+							domain.AccessRequestStateApproved,
+							"instance",
+							"user-1",
+							domain.AccessRequestScopeInstance,
+							database.TextArray[string]{"IAM_OWNER"},
+							&pgtype.Interval{Microseconds: time.Hour.Microseconds(), Valid: true},
+							"",
+							"approver-1",
+							testNow,
+						},
+					},
+				),
+			},
+			object: &AccessRequests{
+				SearchResponse: SearchResponse{
+					Count: 2,
+				},
+				AccessRequests: []*AccessRequest{
+					{
+						ID:            "request-1",
+						CreationDate:  testNow,
+						ChangeDate:    testNow,
+						Sequence:      20211109,
+						State:         domain.AccessRequestStatePending,
+						ResourceOwner: "ro",
+						UserID:        "user-1",
+						Scope:         domain.AccessRequestScopeOrg,
+						Roles:         database.TextArray[string]{"ORG_OWNER"},
+						Duration:      time.Hour,
+						Reason:        "incident",
+					},
+					{
+						ID:            "request-2",
+						CreationDate:  testNow,
+						ChangeDate:    testNow,
+						Sequence:      20211110,
+						State:         domain.AccessRequestStateApproved,
+						ResourceOwner: "instance",
+						UserID:        "user-1",
+						Scope:         domain.AccessRequestScopeInstance,
+						Roles:         database.TextArray[string]{"IAM_OWNER"},
+						Duration:      time.Hour,
+						DecidedBy:     "approver-1",
+						ValidUntil:    testNow,
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareAccessRequestsQuery sql err",
+			prepare: prepareAccessRequestsQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareAccessRequestsStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*AccessRequests)(nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err, defaultPrepareArgs...)
+		})
+	}
+}
@@ -0,0 +1,539 @@
+package query
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	accessReviewTable = table{
+		name:          projection.AccessReviewTable,
+		instanceIDCol: projection.AccessReviewInstanceIDCol,
+	}
+	AccessReviewColumnID = Column{
+		name:  projection.AccessReviewIDCol,
+		table: accessReviewTable,
+	}
+	AccessReviewColumnCreationDate = Column{
+		name:  projection.AccessReviewCreationDateCol,
+		table: accessReviewTable,
+	}
+	AccessReviewColumnChangeDate = Column{
+		name:  projection.AccessReviewChangeDateCol,
+		table: accessReviewTable,
+	}
+	AccessReviewColumnSequence = Column{
+		name:  projection.AccessReviewSequenceCol,
+		table: accessReviewTable,
+	}
+	AccessReviewColumnState = Column{
+		name:  projection.AccessReviewStateCol,
+		table: accessReviewTable,
+	}
+	AccessReviewColumnResourceOwner = Column{
+		name:  projection.AccessReviewResourceOwnerCol,
+		table: accessReviewTable,
+	}
+	AccessReviewColumnInstanceID = Column{
+		name:  projection.AccessReviewInstanceIDCol,
+		table: accessReviewTable,
+	}
+	AccessReviewColumnName = Column{
+		name:  projection.AccessReviewNameCol,
+		table: accessReviewTable,
+	}
+	AccessReviewColumnScope = Column{
+		name:  projection.AccessReviewScopeCol,
+		table: accessReviewTable,
+	}
+	AccessReviewColumnProjectID = Column{
+		name:  projection.AccessReviewProjectIDCol,
+		table: accessReviewTable,
+	}
+	AccessReviewColumnRoleKey = Column{
+		name:  projection.AccessReviewRoleKeyCol,
+		table: accessReviewTable,
+	}
+	AccessReviewColumnReviewerType = Column{
+		name:  projection.AccessReviewReviewerTypeCol,
+		table: accessReviewTable,
+	}
+	AccessReviewColumnManagerMetadataKey = Column{
+		name:  projection.AccessReviewManagerMetadataKeyCol,
+		table: accessReviewTable,
+	}
+	AccessReviewColumnDeadline = Column{
+		name:  projection.AccessReviewDeadlineCol,
+		table: accessReviewTable,
+	}
+	AccessReviewColumnDeadlineAction = Column{
+		name:  projection.AccessReviewDeadlineActionCol,
+		table: accessReviewTable,
+	}
+)
+
+var (
+	accessReviewItemTable = table{
+		name:          projection.AccessReviewItemTable,
+		instanceIDCol: projection.AccessReviewItemInstanceIDCol,
+	}
+	AccessReviewItemColumnInstanceID = Column{
+		name:  projection.AccessReviewItemInstanceIDCol,
+		table: accessReviewItemTable,
+	}
+	AccessReviewItemColumnReviewID = Column{
+		name:  projection.AccessReviewItemReviewIDCol,
+		table: accessReviewItemTable,
+	}
+	AccessReviewItemColumnID = Column{
+		name:  projection.AccessReviewItemIDCol,
+		table: accessReviewItemTable,
+	}
+	AccessReviewItemColumnCreationDate = Column{
+		name:  projection.AccessReviewItemCreationDateCol,
+		table: accessReviewItemTable,
+	}
+	AccessReviewItemColumnChangeDate = Column{
+		name:  projection.AccessReviewItemChangeDateCol,
+		table: accessReviewItemTable,
+	}
+	AccessReviewItemColumnSequence = Column{
+		name:  projection.AccessReviewItemSequenceCol,
+		table: accessReviewItemTable,
+	}
+	AccessReviewItemColumnType = Column{
+		name:  projection.AccessReviewItemTypeCol,
+		table: accessReviewItemTable,
+	}
+	AccessReviewItemColumnObjectID = Column{
+		name:  projection.AccessReviewItemObjectIDCol,
+		table: accessReviewItemTable,
+	}
+	AccessReviewItemColumnResourceOwner = Column{
+		name:  projection.AccessReviewItemResourceOwnerCol,
+		table: accessReviewItemTable,
+	}
+	AccessReviewItemColumnUserID = Column{
+		name:  projection.AccessReviewItemUserIDCol,
+		table: accessReviewItemTable,
+	}
+	AccessReviewItemColumnProjectID = Column{
+		name:  projection.AccessReviewItemProjectIDCol,
+		table: accessReviewItemTable,
+	}
+	AccessReviewItemColumnRoles = Column{
+		name:  projection.AccessReviewItemRolesCol,
+		table: accessReviewItemTable,
+	}
+	AccessReviewItemColumnReviewers = Column{
+		name:  projection.AccessReviewItemReviewersCol,
+		table: accessReviewItemTable,
+	}
+	AccessReviewItemColumnDecision = Column{
+		name:  projection.AccessReviewItemDecisionCol,
+		table: accessReviewItemTable,
+	}
+	AccessReviewItemColumnDecidedBy = Column{
+		name:  projection.AccessReviewItemDecidedByCol,
+		table: accessReviewItemTable,
+	}
+	AccessReviewItemColumnComment = Column{
+		name:  projection.AccessReviewItemCommentCol,
+		table: accessReviewItemTable,
+	}
+)
+
+type AccessReviews struct {
+	SearchResponse
+	AccessReviews []*AccessReview
+}
+
+func (r *AccessReviews) SetState(s *State) {
+	r.State = s
+}
+
+type AccessReview struct {
+	ID            string
+	CreationDate  time.Time
+	ChangeDate    time.Time
+	Sequence      uint64
+	State         domain.AccessReviewState
+	ResourceOwner string
+
+	Name               string
+	Scope              domain.AccessReviewScope
+	ProjectID          string
+	RoleKey            string
+	ReviewerType       domain.AccessReviewerType
+	ManagerMetadataKey string
+	Deadline           time.Time
+	DeadlineAction     domain.AccessReviewDeadlineAction
+}
+
+type AccessReviewSearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+func (q *AccessReviewSearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+type AccessReviewItems struct {
+	SearchResponse
+	Items []*AccessReviewItem
+}
+
+func (r *AccessReviewItems) SetState(s *State) {
+	r.State = s
+}
+
+type AccessReviewItem struct {
+	ReviewID     string
+	ID           string
+	CreationDate time.Time
+	ChangeDate   time.Time
+	Sequence     uint64
+
+	Type          domain.AccessReviewItemType
+	ObjectID      string
+	ResourceOwner string
+	UserID        string
+	ProjectID     string
+	Roles         database.TextArray[string]
+	Reviewers     database.TextArray[string]
+	Decision      domain.AccessReviewDecision
+	DecidedBy     string
+	Comment       string
+
+	// ReviewName and Deadline are the properties of the campaign of the item
+	ReviewName string
+	Deadline   time.Time
+}
+
+type AccessReviewItemSearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+func (q *AccessReviewItemSearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+// SearchAccessReviews returns the campaigns to recertify user grants and memberships.
+func (q *Queries) SearchAccessReviews(ctx context.Context, queries *AccessReviewSearchQueries) (_ *AccessReviews, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	where := sq.Eq{AccessReviewColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID()}
+	query, scan := prepareAccessReviewsQuery(ctx, q.client)
+	return genericRowsQueryWithState[*AccessReviews](ctx, q.client, accessReviewTable, combineToWhereStmt(query, queries.toQuery, where), scan)
+}
+
+// AccessReviewByID returns the campaign of the organization (resourceOwner).
+func (q *Queries) AccessReviewByID(ctx context.Context, id, resourceOwner string) (_ *AccessReview, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	query, scan := prepareAccessReviewQuery(ctx, q.client)
+	return genericRowQuery[*AccessReview](ctx, q.client, query.Where(
+		sq.Eq{
+			AccessReviewColumnID.identifier():            id,
+			AccessReviewColumnResourceOwner.identifier(): resourceOwner,
+			AccessReviewColumnInstanceID.identifier():    authz.GetInstance(ctx).InstanceID(),
+		},
+	), scan)
+}
+
+// SearchAccessReviewItems returns the user grants and memberships to recertify.
+func (q *Queries) SearchAccessReviewItems(ctx context.Context, queries *AccessReviewItemSearchQueries) (_ *AccessReviewItems, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	where := sq.Eq{AccessReviewItemColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID()}
+	query, scan := prepareAccessReviewItemsQuery(ctx, q.client)
+	return genericRowsQueryWithState[*AccessReviewItems](ctx, q.client, accessReviewTable, combineToWhereStmt(query, queries.toQuery, where), scan)
+}
+
+func NewAccessReviewResourceOwnerSearchQuery(resourceOwner string) (SearchQuery, error) {
+	return NewTextQuery(AccessReviewColumnResourceOwner, resourceOwner, TextEquals)
+}
+
+func NewAccessReviewStateSearchQuery(state domain.AccessReviewState) (SearchQuery, error) {
+	return NewNumberQuery(AccessReviewColumnState, state, NumberEquals)
+}
+
+func NewAccessReviewProjectIDSearchQuery(projectID string) (SearchQuery, error) {
+	return NewTextQuery(AccessReviewColumnProjectID, projectID, TextEquals)
+}
+
+// NewAccessReviewDeadlineReachedQuery matches the campaigns whose deadline is before the time
+func NewAccessReviewDeadlineReachedQuery(at time.Time) (SearchQuery, error) {
+	return NewTimestampQuery(AccessReviewColumnDeadline, at, TimestampLessOrEquals)
+}
+
+func NewAccessReviewItemReviewIDSearchQuery(reviewID string) (SearchQuery, error) {
+	return NewTextQuery(AccessReviewItemColumnReviewID, reviewID, TextEquals)
+}
+
+// NewAccessReviewItemReviewerSearchQuery matches the items the user is a reviewer of
+func NewAccessReviewItemReviewerSearchQuery(userID string) (SearchQuery, error) {
+	return NewTextQuery(AccessReviewItemColumnReviewers, userID, TextListContains)
+}
+
+func NewAccessReviewItemUserIDSearchQuery(userID string) (SearchQuery, error) {
+	return NewTextQuery(AccessReviewItemColumnUserID, userID, TextEquals)
+}
+
+func NewAccessReviewItemDecisionSearchQuery(decision domain.AccessReviewDecision) (SearchQuery, error) {
+	return NewNumberQuery(AccessReviewItemColumnDecision, decision, NumberEquals)
+}
+
+// NewAccessReviewItemReviewStateSearchQuery matches the items by the state of their campaign
+func NewAccessReviewItemReviewStateSearchQuery(state domain.AccessReviewState) (SearchQuery, error) {
+	return NewNumberQuery(AccessReviewColumnState, state, NumberEquals)
+}
+
+var accessReviewReportHeader = []string{"item_id", "type", "object_id", "resource_owner", "user_id", "project_id", "roles", "reviewers", "decision", "decided_by", "decided_at", "comment"}
+
+// CSV returns the items as report of the campaign.
+func (r *AccessReviewItems) CSV() ([]byte, error) {
+	buf := new(bytes.Buffer)
+	w := csv.NewWriter(buf)
+	if err := w.Write(accessReviewReportHeader); err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Rr1cs", "Errors.Internal")
+	}
+	for _, item := range r.Items {
+		decidedAt := ""
+		if item.Decision != domain.AccessReviewDecisionPending {
+			decidedAt = item.ChangeDate.UTC().Format(time.RFC3339)
+		}
+		err := w.Write([]string{
+			item.ID,
+			accessReviewItemTypeName(item.Type),
+			item.ObjectID,
+			item.ResourceOwner,
+			item.UserID,
+			item.ProjectID,
+			strings.Join(item.Roles, " "),
+			strings.Join(item.Reviewers, " "),
+			accessReviewDecisionName(item.Decision),
+			item.DecidedBy,
+			decidedAt,
+			item.Comment,
+		})
+		if err != nil {
+			return nil, zerrors.ThrowInternal(err, "QUERY-Rr2cs", "Errors.Internal")
+		}
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Rr3cs", "Errors.Internal")
+	}
+	return buf.Bytes(), nil
+}
+
+func accessReviewItemTypeName(t domain.AccessReviewItemType) string {
+	switch t {
+	case domain.AccessReviewItemTypeUserGrant:
+		return "user_grant"
+	case domain.AccessReviewItemTypeOrgMember:
+		return "org_member"
+	case domain.AccessReviewItemTypeProjectMember:
+		return "project_member"
+	case domain.AccessReviewItemTypeUnspecified:
+		return ""
+	}
+	return strconv.Itoa(int(t))
+}
+
+func accessReviewDecisionName(d domain.AccessReviewDecision) string {
+	switch d {
+	case domain.AccessReviewDecisionPending:
+		return "pending"
+	case domain.AccessReviewDecisionApproved:
+		return "approved"
+	case domain.AccessReviewDecisionRevoked:
+		return "revoked"
+	case domain.AccessReviewDecisionFlagged:
+		return "flagged"
+	case domain.AccessReviewDecisionExpired:
+		return "expired"
+	case domain.AccessReviewDecisionUnspecified:
+		return ""
+	}
+	return strconv.Itoa(int(d))
+}
+
+func accessReviewColumns() []string {
+	return []string{
+		AccessReviewColumnID.identifier(),
+		AccessReviewColumnCreationDate.identifier(),
+		AccessReviewColumnChangeDate.identifier(),
+		AccessReviewColumnSequence.identifier(),
+		AccessReviewColumnState.identifier(),
+		AccessReviewColumnResourceOwner.identifier(),
+		AccessReviewColumnName.identifier(),
+		AccessReviewColumnScope.identifier(),
+		AccessReviewColumnProjectID.identifier(),
+		AccessReviewColumnRoleKey.identifier(),
+		AccessReviewColumnReviewerType.identifier(),
+		AccessReviewColumnManagerMetadataKey.identifier(),
+		AccessReviewColumnDeadline.identifier(),
+		AccessReviewColumnDeadlineAction.identifier(),
+	}
+}
+
+func scanAccessReview(scan func(dest ...any) error, review *AccessReview, additional ...any) error {
+	return scan(append([]any{
+		&review.ID,
+		&review.CreationDate,
+		&review.ChangeDate,
+		&review.Sequence,
+		&review.State,
+		&review.ResourceOwner,
+		&review.Name,
+		&review.Scope,
+		&review.ProjectID,
+		&review.RoleKey,
+		&review.ReviewerType,
+		&review.ManagerMetadataKey,
+		&review.Deadline,
+		&review.DeadlineAction,
+	}, additional...)...)
+}
+
+func prepareAccessReviewQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Row) (*AccessReview, error)) {
+	return sq.Select(accessReviewColumns()...).
+			From(accessReviewTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(row *sql.Row) (*AccessReview, error) {
+			review := new(AccessReview)
+			err := scanAccessReview(row.Scan, review)
+			if err != nil {
+				if errors.Is(err, sql.ErrNoRows) {
+					return nil, zerrors.ThrowNotFound(err, "QUERY-Rq1nf", "Errors.AccessReview.NotFound")
+				}
+				return nil, zerrors.ThrowInternal(err, "QUERY-Rq2sc", "Errors.Internal")
+			}
+			return review, nil
+		}
+}
+
+func prepareAccessReviewsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) (*AccessReviews, error)) {
+	return sq.Select(append(accessReviewColumns(), countColumn.identifier())...).
+			From(accessReviewTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*AccessReviews, error) {
+			reviews := make([]*AccessReview, 0)
+			var count uint64
+			for rows.Next() {
+				review := new(AccessReview)
+				if err := scanAccessReview(rows.Scan, review, &count); err != nil {
+					return nil, err
+				}
+				reviews = append(reviews, review)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Rq3cr", "Errors.Query.CloseRows")
+			}
+
+			return &AccessReviews{
+				AccessReviews: reviews,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
+
+func prepareAccessReviewItemsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) (*AccessReviewItems, error)) {
+	return sq.Select(
+			AccessReviewItemColumnReviewID.identifier(),
+			AccessReviewItemColumnID.identifier(),
+			AccessReviewItemColumnCreationDate.identifier(),
+			AccessReviewItemColumnChangeDate.identifier(),
+			AccessReviewItemColumnSequence.identifier(),
+			AccessReviewItemColumnType.identifier(),
+			AccessReviewItemColumnObjectID.identifier(),
+			AccessReviewItemColumnResourceOwner.identifier(),
+			AccessReviewItemColumnUserID.identifier(),
+			AccessReviewItemColumnProjectID.identifier(),
+			AccessReviewItemColumnRoles.identifier(),
+			AccessReviewItemColumnReviewers.identifier(),
+			AccessReviewItemColumnDecision.identifier(),
+			AccessReviewItemColumnDecidedBy.identifier(),
+			AccessReviewItemColumnComment.identifier(),
+			AccessReviewColumnName.identifier(),
+			AccessReviewColumnDeadline.identifier(),
+			countColumn.identifier(),
+		).From(accessReviewItemTable.identifier()).
+			Join(join(AccessReviewColumnID, AccessReviewItemColumnReviewID)).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*AccessReviewItems, error) {
+			items := make([]*AccessReviewItem, 0)
+			var count uint64
+			for rows.Next() {
+				item := new(AccessReviewItem)
+				err := rows.Scan(
+					&item.ReviewID,
+					&item.ID,
+					&item.CreationDate,
+					&item.ChangeDate,
+					&item.Sequence,
+					&item.Type,
+					&item.ObjectID,
+					&item.ResourceOwner,
+					&item.UserID,
+					&item.ProjectID,
+					&item.Roles,
+					&item.Reviewers,
+					&item.Decision,
+					&item.DecidedBy,
+					&item.Comment,
+					&item.ReviewName,
+					&item.Deadline,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				items = append(items, item)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Rq4cr", "Errors.Query.CloseRows")
+			}
+
+			return &AccessReviewItems{
+				Items: items,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
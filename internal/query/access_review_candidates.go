@@ -0,0 +1,190 @@
+package query
+
+import (
+	"context"
+	"slices"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// AccessReviewCandidates returns the current user grants and memberships in the scope of the campaign
+// together with their reviewers.
+func (q *Queries) AccessReviewCandidates(ctx context.Context, review *domain.AccessReview, orgID string) (_ []*domain.AccessReviewItem, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	items, err := q.accessReviewUserGrants(ctx, review, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if review.Scope != domain.AccessReviewScopeRole {
+		memberships, err := q.accessReviewMemberships(ctx, review, orgID)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, memberships...)
+	}
+
+	reviewers := &accessReviewReviewers{queries: q, review: review, owners: make(map[string][]string)}
+	for _, item := range items {
+		item.Reviewers, err = reviewers.of(ctx, item)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return items, nil
+}
+
+func (q *Queries) accessReviewUserGrants(ctx context.Context, review *domain.AccessReview, orgID string) ([]*domain.AccessReviewItem, error) {
+	queries := make([]SearchQuery, 0, 3)
+	if review.Scope == domain.AccessReviewScopeOrg {
+		grantedQuery, err := NewUserGrantWithGrantedQuery(orgID)
+		if err != nil {
+			return nil, err
+		}
+		queries = append(queries, grantedQuery)
+	} else {
+		projectQuery, err := NewUserGrantProjectIDSearchQuery(review.ProjectID)
+		if err != nil {
+			return nil, err
+		}
+		ownerQuery, err := NewUserGrantProjectOwnerSearchQuery(orgID)
+		if err != nil {
+			return nil, err
+		}
+		queries = append(queries, projectQuery, ownerQuery)
+	}
+	if review.Scope == domain.AccessReviewScopeRole {
+		roleQuery, err := NewUserGrantRoleQuery(review.RoleKey)
+		if err != nil {
+			return nil, err
+		}
+		queries = append(queries, roleQuery)
+	}
+	grants, err := q.UserGrants(ctx, &UserGrantsQueries{Queries: queries}, true)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*domain.AccessReviewItem, 0, len(grants.UserGrants))
+	for _, grant := range grants.UserGrants {
+		items = append(items, &domain.AccessReviewItem{
+			Type:          domain.AccessReviewItemTypeUserGrant,
+			ObjectID:      grant.ID,
+			ResourceOwner: grant.ResourceOwner,
+			UserID:        grant.UserID,
+			ProjectID:     grant.ProjectID,
+			Roles:         grant.Roles,
+		})
+	}
+	return items, nil
+}
+
+func (q *Queries) accessReviewMemberships(ctx context.Context, review *domain.AccessReview, orgID string) ([]*domain.AccessReviewItem, error) {
+	ownerQuery, err := NewMembershipResourceOwnersSearchQuery(orgID)
+	if err != nil {
+		return nil, err
+	}
+	queries := []SearchQuery{ownerQuery}
+	if review.Scope == domain.AccessReviewScopeProject {
+		projectQuery, err := NewMembershipProjectIDQuery(review.ProjectID)
+		if err != nil {
+			return nil, err
+		}
+		queries = append(queries, projectQuery)
+	}
+	memberships, err := q.Memberships(ctx, &MembershipSearchQuery{Queries: queries}, true)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*domain.AccessReviewItem, 0, len(memberships.Memberships))
+	for _, membership := range memberships.Memberships {
+		item := &domain.AccessReviewItem{
+			ResourceOwner: membership.ResourceOwner,
+			UserID:        membership.UserID,
+			Roles:         membership.Roles,
+		}
+		switch {
+		case membership.Org != nil:
+			item.Type = domain.AccessReviewItemTypeOrgMember
+			item.ObjectID = membership.Org.OrgID
+		case membership.Project != nil:
+			item.Type = domain.AccessReviewItemTypeProjectMember
+			item.ObjectID = membership.Project.ProjectID
+			item.ProjectID = membership.Project.ProjectID
+		default:
+			// memberships of the instance and of project grants are not part of the organization
+			continue
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// accessReviewReviewers resolves the reviewers of the items
+// and caches the owners of the organization and projects
+type accessReviewReviewers struct {
+	queries *Queries
+	review  *domain.AccessReview
+	owners  map[string][]string
+}
+
+func (r *accessReviewReviewers) of(ctx context.Context, item *domain.AccessReviewItem) (reviewers []string, err error) {
+	if r.review.ReviewerType == domain.AccessReviewerTypeManager {
+		reviewers, err = r.manager(ctx, item.UserID)
+	} else {
+		reviewers, err = r.projectOwners(ctx, item)
+	}
+	if err != nil {
+		return nil, err
+	}
+	// nobody reviews their own access
+	return slices.DeleteFunc(slices.Clone(reviewers), func(reviewer string) bool {
+		return reviewer == item.UserID
+	}), nil
+}
+
+func (r *accessReviewReviewers) manager(ctx context.Context, userID string) ([]string, error) {
+	metadata, err := r.queries.GetUserMetadataByKey(ctx, false, userID, r.review.ManagerMetadataKey, false)
+	if zerrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(metadata.Value) == 0 {
+		return nil, nil
+	}
+	return []string{string(metadata.Value)}, nil
+}
+
+func (r *accessReviewReviewers) projectOwners(ctx context.Context, item *domain.AccessReviewItem) ([]string, error) {
+	// the owners of the organization review its members
+	if item.Type == domain.AccessReviewItemTypeOrgMember {
+		return r.cached(item.ObjectID, func() (*Members, error) {
+			return r.queries.OrgMembers(ctx, &OrgMembersQuery{OrgID: item.ObjectID})
+		}, domain.RoleOrgOwner)
+	}
+	return r.cached(item.ProjectID, func() (*Members, error) {
+		return r.queries.ProjectMembers(ctx, &ProjectMembersQuery{ProjectID: item.ProjectID})
+	}, domain.RoleProjectOwner)
+}
+
+func (r *accessReviewReviewers) cached(id string, members func() (*Members, error), role string) ([]string, error) {
+	if owners, ok := r.owners[id]; ok {
+		return owners, nil
+	}
+	result, err := members()
+	if err != nil {
+		return nil, err
+	}
+	owners := make([]string, 0, len(result.Members))
+	for _, member := range result.Members {
+		if slices.Contains(member.Roles, role) {
+			owners = append(owners, member.UserID)
+		}
+	}
+	r.owners[id] = owners
+	return owners, nil
+}
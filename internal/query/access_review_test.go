@@ -0,0 +1,287 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+var (
+	prepareAccessReviewsStmt = `SELECT projections.access_reviews.id,` +
+		` projections.access_reviews.creation_date,` +
+		` projections.access_reviews.change_date,` +
+		` projections.access_reviews.sequence,` +
+		` projections.access_reviews.state,` +
+		` projections.access_reviews.resource_owner,` +
+		` projections.access_reviews.name,` +
+		` projections.access_reviews.scope,` +
+		` projections.access_reviews.project_id,` +
+		` projections.access_reviews.role_key,` +
+		` projections.access_reviews.reviewer_type,` +
+		` projections.access_reviews.manager_metadata_key,` +
+		` projections.access_reviews.deadline,` +
+		` projections.access_reviews.deadline_action,` +
+		` COUNT(*) OVER ()` +
+		` FROM projections.access_reviews`
+	prepareAccessReviewsCols = []string{
+		"id",
+		"creation_date",
+		"change_date",
+		"sequence",
+		"state",
+		"resource_owner",
+		"name",
+		"scope",
+		"project_id",
+		"role_key",
+		"reviewer_type",
+		"manager_metadata_key",
+		"deadline",
+		"deadline_action",
+		"count",
+	}
+	prepareAccessReviewItemsStmt = `SELECT projections.access_reviews_items.review_id,` +
+		` projections.access_reviews_items.id,` +
+		` projections.access_reviews_items.creation_date,` +
+		` projections.access_reviews_items.change_date,` +
+		` projections.access_reviews_items.sequence,` +
+		` projections.access_reviews_items.item_type,` +
+		` projections.access_reviews_items.object_id,` +
+		` projections.access_reviews_items.item_resource_owner,` +
+		` projections.access_reviews_items.user_id,` +
+		` projections.access_reviews_items.project_id,` +
+		` projections.access_reviews_items.roles,` +
+		` projections.access_reviews_items.reviewers,` +
+		` projections.access_reviews_items.decision,` +
+		` projections.access_reviews_items.decided_by,` +
+		` projections.access_reviews_items.comment,` +
+		` projections.access_reviews.name,` +
+		` projections.access_reviews.deadline,` +
+		` COUNT(*) OVER ()` +
+		` FROM projections.access_reviews_items` +
+		` JOIN projections.access_reviews ON projections.access_reviews_items.review_id = projections.access_reviews.id AND projections.access_reviews_items.instance_id = projections.access_reviews.instance_id`
+	prepareAccessReviewItemsCols = []string{
+		"review_id",
+		"id",
+		"creation_date",
+		"change_date",
+		"sequence",
+		"item_type",
+		"object_id",
+		"item_resource_owner",
+		"user_id",
+		"project_id",
+		"roles",
+		"reviewers",
+		"decision",
+		"decided_by",
+		"comment",
+		"name",
+		"deadline",
+		"count",
+	}
+)
+
+func Test_AccessReviewPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareAccessReviewsQuery no result",
+			prepare: prepareAccessReviewsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareAccessReviewsStmt),
+					nil,
+					nil,
+				),
+			},
+			object: &AccessReviews{AccessReviews: []*AccessReview{}},
+		},
+		{
+			name:    "prepareAccessReviewsQuery one result",
+			prepare: prepareAccessReviewsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareAccessReviewsStmt),
+					prepareAccessReviewsCols,
+					[][]driver.Value{
+						{
+							"review-1",
+							testNow,
+							testNow,
+							uint64(20211109),
+							domain.AccessReviewStateActive,
+							"ro",
+							"Q3",
+							domain.AccessReviewScopeRole,
+							"project-1",
+							"admin",
+							domain.AccessReviewerTypeManager,
+							"manager",
+							testNow,
+							domain.AccessReviewDeadlineActionRevoke,
+						},
+					},
+				),
+			},
+			object: &AccessReviews{
+				SearchResponse: SearchResponse{
+					Count: 1,
+				},
+				AccessReviews: []*AccessReview{
+					{
+						ID:                 "review-1",
+						CreationDate:       testNow,
+						ChangeDate:         testNow,
+						Sequence:           20211109,
+						State:              domain.AccessReviewStateActive,
+						ResourceOwner:      "ro",
+						Name:               "Q3",
+						Scope:              domain.AccessReviewScopeRole,
+						ProjectID:          "project-1",
+						RoleKey:            "admin",
+						ReviewerType:       domain.AccessReviewerTypeManager,
+						ManagerMetadataKey: "manager",
+						Deadline:           testNow,
+						DeadlineAction:     domain.AccessReviewDeadlineActionRevoke,
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareAccessReviewsQuery sql err",
+			prepare: prepareAccessReviewsQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareAccessReviewsStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*AccessReviews)(nil),
+		},
+		{
+			name:    "prepareAccessReviewItemsQuery one result",
+			prepare: prepareAccessReviewItemsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareAccessReviewItemsStmt),
+					prepareAccessReviewItemsCols,
+					[][]driver.Value{
+						{
+							"review-1",
+							"item-1",
+							testNow,
+							testNow,
+							uint64(20211110),
+							domain.AccessReviewItemTypeUserGrant,
+							"grant-1",
+							"ro",
+							"user-1",
+							"project-1",
+							database.TextArray[string]{"admin"},
+							database.TextArray[string]{"owner-1"},
+							domain.AccessReviewDecisionRevoked,
+							"owner-1",
+							"left the team",
+							"Q3",
+							testNow,
+						},
+					},
+				),
+			},
+			object: &AccessReviewItems{
+				SearchResponse: SearchResponse{
+					Count: 1,
+				},
+				Items: []*AccessReviewItem{
+					{
+						ReviewID:      "review-1",
+						ID:            "item-1",
+						CreationDate:  testNow,
+						ChangeDate:    testNow,
+						Sequence:      20211110,
+						Type:          domain.AccessReviewItemTypeUserGrant,
+						ObjectID:      "grant-1",
+						ResourceOwner: "ro",
+						UserID:        "user-1",
+						ProjectID:     "project-1",
+						Roles:         database.TextArray[string]{"admin"},
+						Reviewers:     database.TextArray[string]{"owner-1"},
+						Decision:      domain.AccessReviewDecisionRevoked,
+						DecidedBy:     "owner-1",
+						Comment:       "left the team",
+						ReviewName:    "Q3",
+						Deadline:      testNow,
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err, defaultPrepareArgs...)
+		})
+	}
+}
+
+func TestAccessReviewItems_CSV(t *testing.T) {
+	items := &AccessReviewItems{
+		Items: []*AccessReviewItem{
+			{
+				ID:            "item-1",
+				ChangeDate:    testNow,
+				Type:          domain.AccessReviewItemTypeUserGrant,
+				ObjectID:      "grant-1",
+				ResourceOwner: "ro",
+				UserID:        "user-1",
+				ProjectID:     "project-1",
+				Roles:         database.TextArray[string]{"admin", "viewer"},
+				Reviewers:     database.TextArray[string]{"owner-1"},
+				Decision:      domain.AccessReviewDecisionRevoked,
+				DecidedBy:     "owner-1",
+				Comment:       "left the team, moved to sales",
+			},
+			{
+				ID:            "item-2",
+				ChangeDate:    testNow,
+				Type:          domain.AccessReviewItemTypeOrgMember,
+				ObjectID:      "ro",
+				ResourceOwner: "ro",
+				UserID:        "user-2",
+				Roles:         database.TextArray[string]{"ORG_OWNER"},
+				Decision:      domain.AccessReviewDecisionPending,
+			},
+		},
+	}
+	report, err := items.CSV()
+	require.NoError(t, err)
+	assert.Equal(t,
+		"item_id,type,object_id,resource_owner,user_id,project_id,roles,reviewers,decision,decided_by,decided_at,comment\n"+
+			"item-1,user_grant,grant-1,ro,user-1,project-1,admin viewer,owner-1,revoked,owner-1,"+testNow.UTC().Format("2006-01-02T15:04:05Z07:00")+",\"left the team, moved to sales\"\n"+
+			"item-2,org_member,ro,ro,user-2,,ORG_OWNER,,pending,,,\n",
+		string(report),
+	)
+}
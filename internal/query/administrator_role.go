@@ -0,0 +1,143 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	administratorRoleTable = table{
+		name:          projection.AdministratorRoleTable,
+		instanceIDCol: projection.AdministratorRoleInstanceIDCol,
+	}
+	AdministratorRoleColumnInstanceID = Column{
+		name:  projection.AdministratorRoleInstanceIDCol,
+		table: administratorRoleTable,
+	}
+	AdministratorRoleColumnKey = Column{
+		name:  projection.AdministratorRoleKeyCol,
+		table: administratorRoleTable,
+	}
+	AdministratorRoleColumnCreationDate = Column{
+		name:  projection.AdministratorRoleCreationDateCol,
+		table: administratorRoleTable,
+	}
+	AdministratorRoleColumnChangeDate = Column{
+		name:  projection.AdministratorRoleChangeDateCol,
+		table: administratorRoleTable,
+	}
+	AdministratorRoleColumnSequence = Column{
+		name:  projection.AdministratorRoleSequenceCol,
+		table: administratorRoleTable,
+	}
+	AdministratorRoleColumnDisplayName = Column{
+		name:  projection.AdministratorRoleDisplayNameCol,
+		table: administratorRoleTable,
+	}
+	AdministratorRoleColumnPermissions = Column{
+		name:  projection.AdministratorRolePermissionsCol,
+		table: administratorRoleTable,
+	}
+)
+
+type AdministratorRoles struct {
+	SearchResponse
+	Roles []*AdministratorRole
+}
+
+func (r *AdministratorRoles) SetState(s *State) {
+	r.State = s
+}
+
+type AdministratorRole struct {
+	Key           string
+	CreationDate  time.Time
+	ChangeDate    time.Time
+	Sequence      uint64
+	ResourceOwner string
+
+	DisplayName string
+	Permissions database.TextArray[string]
+}
+
+type AdministratorRoleSearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+func (q *AdministratorRoleSearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+// SearchAdministratorRoles returns the custom roles of the instance.
+func (q *Queries) SearchAdministratorRoles(ctx context.Context, queries *AdministratorRoleSearchQueries) (_ *AdministratorRoles, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	where := sq.Eq{AdministratorRoleColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID()}
+	query, scan := prepareAdministratorRolesQuery(ctx, q.client)
+	return genericRowsQueryWithState[*AdministratorRoles](ctx, q.client, administratorRoleTable, combineToWhereStmt(query, queries.toQuery, where), scan)
+}
+
+func NewAdministratorRoleKeySearchQuery(value string, method TextComparison) (SearchQuery, error) {
+	return NewTextQuery(AdministratorRoleColumnKey, value, method)
+}
+
+func prepareAdministratorRolesQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(rows *sql.Rows) (*AdministratorRoles, error)) {
+	return sq.Select(
+			AdministratorRoleColumnKey.identifier(),
+			AdministratorRoleColumnCreationDate.identifier(),
+			AdministratorRoleColumnChangeDate.identifier(),
+			AdministratorRoleColumnSequence.identifier(),
+			AdministratorRoleColumnInstanceID.identifier(),
+			AdministratorRoleColumnDisplayName.identifier(),
+			AdministratorRoleColumnPermissions.identifier(),
+			countColumn.identifier(),
+		).From(administratorRoleTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*AdministratorRoles, error) {
+			roles := make([]*AdministratorRole, 0)
+			var count uint64
+			for rows.Next() {
+				role := new(AdministratorRole)
+				err := rows.Scan(
+					&role.Key,
+					&role.CreationDate,
+					&role.ChangeDate,
+					&role.Sequence,
+					&role.ResourceOwner,
+					&role.DisplayName,
+					&role.Permissions,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				roles = append(roles, role)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Ar0cr", "Errors.Query.CloseRows")
+			}
+
+			return &AdministratorRoles{
+				Roles: roles,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
@@ -0,0 +1,136 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/database"
+)
+
+var (
+	prepareAdministratorRolesStmt = `SELECT projections.administrator_roles.role_key,` +
+		` projections.administrator_roles.creation_date,` +
+		` projections.administrator_roles.change_date,` +
+		` projections.administrator_roles.sequence,` +
+		` projections.administrator_roles.instance_id,` +
+		` projections.administrator_roles.display_name,` +
+		` projections.administrator_roles.permissions,` +
+		` COUNT(*) OVER ()` +
+		` FROM projections.administrator_roles`
+	prepareAdministratorRolesCols = []string{
+		"role_key",
+		"creation_date",
+		"change_date",
+		"sequence",
+		"instance_id",
+		"display_name",
+		"permissions",
+		"count",
+	}
+)
+
+func Test_AdministratorRolePrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareAdministratorRolesQuery no result",
+			prepare: prepareAdministratorRolesQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareAdministratorRolesStmt),
+					nil,
+					nil,
+				),
+			},
+			object: &AdministratorRoles{Roles: []*AdministratorRole{}},
+		},
+		{
+			name:    "prepareAdministratorRolesQuery multiple result",
+			prepare: prepareAdministratorRolesQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareAdministratorRolesStmt),
+					prepareAdministratorRolesCols,
+					[][]driver.Value{
+						{
+							"ORG_USER_SUPPORT",
+							testNow,
+							testNow,
+							uint64(20211109),
+							"instance-id",
+							"User Support",
+							database.TextArray[string]{"user.read", "user.credential.write"},
+						},
+						{
+							"PROJECT_VIEWER",
+							testNow,
+							testNow,
+							uint64(20211110),
+							"instance-id",
+							"",
+							database.TextArray[string]{"project.read"},
+						},
+					},
+				),
+			},
+			object: &AdministratorRoles{
+				SearchResponse: SearchResponse{
+					Count: 2,
+				},
+				Roles: []*AdministratorRole{
+					{
+						Key:           "ORG_USER_SUPPORT",
+						CreationDate:  testNow,
+						ChangeDate:    testNow,
+						Sequence:      20211109,
+						ResourceOwner: "instance-id",
+						DisplayName:   "User Support",
+						Permissions:   database.TextArray[string]{"user.read", "user.credential.write"},
+					},
+					{
+						Key:           "PROJECT_VIEWER",
+						CreationDate:  testNow,
+						ChangeDate:    testNow,
+						Sequence:      20211110,
+						ResourceOwner: "instance-id",
+						Permissions:   database.TextArray[string]{"project.read"},
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareAdministratorRolesQuery sql err",
+			prepare: prepareAdministratorRolesQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareAdministratorRolesStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*AdministratorRoles)(nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err, defaultPrepareArgs...)
+		})
+	}
+}
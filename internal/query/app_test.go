@@ -15,98 +15,100 @@ import (
 )
 
 var (
-	expectedAppQuery = regexp.QuoteMeta(`SELECT projections.apps7.id,` +
-		` projections.apps7.name,` +
-		` projections.apps7.project_id,` +
-		` projections.apps7.creation_date,` +
-		` projections.apps7.change_date,` +
-		` projections.apps7.resource_owner,` +
-		` projections.apps7.state,` +
-		` projections.apps7.sequence,` +
+	expectedAppQuery = regexp.QuoteMeta(`SELECT projections.apps8.id,` +
+		` projections.apps8.name,` +
+		` projections.apps8.project_id,` +
+		` projections.apps8.creation_date,` +
+		` projections.apps8.change_date,` +
+		` projections.apps8.resource_owner,` +
+		` projections.apps8.state,` +
+		` projections.apps8.sequence,` +
 		// api config
-		` projections.apps7_api_configs.app_id,` +
-		` projections.apps7_api_configs.client_id,` +
-		` projections.apps7_api_configs.auth_method,` +
+		` projections.apps8_api_configs.app_id,` +
+		` projections.apps8_api_configs.client_id,` +
+		` projections.apps8_api_configs.auth_method,` +
 		// oidc config
-		` projections.apps7_oidc_configs.app_id,` +
-		` projections.apps7_oidc_configs.version,` +
-		` projections.apps7_oidc_configs.client_id,` +
-		` projections.apps7_oidc_configs.redirect_uris,` +
-		` projections.apps7_oidc_configs.response_types,` +
-		` projections.apps7_oidc_configs.grant_types,` +
-		` projections.apps7_oidc_configs.application_type,` +
-		` projections.apps7_oidc_configs.auth_method_type,` +
-		` projections.apps7_oidc_configs.post_logout_redirect_uris,` +
-		` projections.apps7_oidc_configs.is_dev_mode,` +
-		` projections.apps7_oidc_configs.access_token_type,` +
-		` projections.apps7_oidc_configs.access_token_role_assertion,` +
-		` projections.apps7_oidc_configs.id_token_role_assertion,` +
-		` projections.apps7_oidc_configs.id_token_userinfo_assertion,` +
-		` projections.apps7_oidc_configs.clock_skew,` +
-		` projections.apps7_oidc_configs.additional_origins,` +
-		` projections.apps7_oidc_configs.skip_native_app_success_page,` +
+		` projections.apps8_oidc_configs.app_id,` +
+		` projections.apps8_oidc_configs.version,` +
+		` projections.apps8_oidc_configs.client_id,` +
+		` projections.apps8_oidc_configs.redirect_uris,` +
+		` projections.apps8_oidc_configs.response_types,` +
+		` projections.apps8_oidc_configs.grant_types,` +
+		` projections.apps8_oidc_configs.application_type,` +
+		` projections.apps8_oidc_configs.auth_method_type,` +
+		` projections.apps8_oidc_configs.post_logout_redirect_uris,` +
+		` projections.apps8_oidc_configs.is_dev_mode,` +
+		` projections.apps8_oidc_configs.access_token_type,` +
+		` projections.apps8_oidc_configs.access_token_role_assertion,` +
+		` projections.apps8_oidc_configs.id_token_role_assertion,` +
+		` projections.apps8_oidc_configs.id_token_userinfo_assertion,` +
+		` projections.apps8_oidc_configs.clock_skew,` +
+		` projections.apps8_oidc_configs.additional_origins,` +
+		` projections.apps8_oidc_configs.skip_native_app_success_page,` +
+		` projections.apps8_oidc_configs.required_level_of_assurance,` +
 		//saml config
-		` projections.apps7_saml_configs.app_id,` +
-		` projections.apps7_saml_configs.entity_id,` +
-		` projections.apps7_saml_configs.metadata,` +
-		` projections.apps7_saml_configs.metadata_url` +
-		` FROM projections.apps7` +
-		` LEFT JOIN projections.apps7_api_configs ON projections.apps7.id = projections.apps7_api_configs.app_id AND projections.apps7.instance_id = projections.apps7_api_configs.instance_id` +
-		` LEFT JOIN projections.apps7_oidc_configs ON projections.apps7.id = projections.apps7_oidc_configs.app_id AND projections.apps7.instance_id = projections.apps7_oidc_configs.instance_id` +
-		` LEFT JOIN projections.apps7_saml_configs ON projections.apps7.id = projections.apps7_saml_configs.app_id AND projections.apps7.instance_id = projections.apps7_saml_configs.instance_id` +
+		` projections.apps8_saml_configs.app_id,` +
+		` projections.apps8_saml_configs.entity_id,` +
+		` projections.apps8_saml_configs.metadata,` +
+		` projections.apps8_saml_configs.metadata_url` +
+		` FROM projections.apps8` +
+		` LEFT JOIN projections.apps8_api_configs ON projections.apps8.id = projections.apps8_api_configs.app_id AND projections.apps8.instance_id = projections.apps8_api_configs.instance_id` +
+		` LEFT JOIN projections.apps8_oidc_configs ON projections.apps8.id = projections.apps8_oidc_configs.app_id AND projections.apps8.instance_id = projections.apps8_oidc_configs.instance_id` +
+		` LEFT JOIN projections.apps8_saml_configs ON projections.apps8.id = projections.apps8_saml_configs.app_id AND projections.apps8.instance_id = projections.apps8_saml_configs.instance_id` +
 		` AS OF SYSTEM TIME '-1 ms'`)
-	expectedAppsQuery = regexp.QuoteMeta(`SELECT projections.apps7.id,` +
-		` projections.apps7.name,` +
-		` projections.apps7.project_id,` +
-		` projections.apps7.creation_date,` +
-		` projections.apps7.change_date,` +
-		` projections.apps7.resource_owner,` +
-		` projections.apps7.state,` +
-		` projections.apps7.sequence,` +
+	expectedAppsQuery = regexp.QuoteMeta(`SELECT projections.apps8.id,` +
+		` projections.apps8.name,` +
+		` projections.apps8.project_id,` +
+		` projections.apps8.creation_date,` +
+		` projections.apps8.change_date,` +
+		` projections.apps8.resource_owner,` +
+		` projections.apps8.state,` +
+		` projections.apps8.sequence,` +
 		// api config
-		` projections.apps7_api_configs.app_id,` +
-		` projections.apps7_api_configs.client_id,` +
-		` projections.apps7_api_configs.auth_method,` +
+		` projections.apps8_api_configs.app_id,` +
+		` projections.apps8_api_configs.client_id,` +
+		` projections.apps8_api_configs.auth_method,` +
 		// oidc config
-		` projections.apps7_oidc_configs.app_id,` +
-		` projections.apps7_oidc_configs.version,` +
-		` projections.apps7_oidc_configs.client_id,` +
-		` projections.apps7_oidc_configs.redirect_uris,` +
-		` projections.apps7_oidc_configs.response_types,` +
-		` projections.apps7_oidc_configs.grant_types,` +
-		` projections.apps7_oidc_configs.application_type,` +
-		` projections.apps7_oidc_configs.auth_method_type,` +
-		` projections.apps7_oidc_configs.post_logout_redirect_uris,` +
-		` projections.apps7_oidc_configs.is_dev_mode,` +
-		` projections.apps7_oidc_configs.access_token_type,` +
-		` projections.apps7_oidc_configs.access_token_role_assertion,` +
-		` projections.apps7_oidc_configs.id_token_role_assertion,` +
-		` projections.apps7_oidc_configs.id_token_userinfo_assertion,` +
-		` projections.apps7_oidc_configs.clock_skew,` +
-		` projections.apps7_oidc_configs.additional_origins,` +
-		` projections.apps7_oidc_configs.skip_native_app_success_page,` +
+		` projections.apps8_oidc_configs.app_id,` +
+		` projections.apps8_oidc_configs.version,` +
+		` projections.apps8_oidc_configs.client_id,` +
+		` projections.apps8_oidc_configs.redirect_uris,` +
+		` projections.apps8_oidc_configs.response_types,` +
+		` projections.apps8_oidc_configs.grant_types,` +
+		` projections.apps8_oidc_configs.application_type,` +
+		` projections.apps8_oidc_configs.auth_method_type,` +
+		` projections.apps8_oidc_configs.post_logout_redirect_uris,` +
+		` projections.apps8_oidc_configs.is_dev_mode,` +
+		` projections.apps8_oidc_configs.access_token_type,` +
+		` projections.apps8_oidc_configs.access_token_role_assertion,` +
+		` projections.apps8_oidc_configs.id_token_role_assertion,` +
+		` projections.apps8_oidc_configs.id_token_userinfo_assertion,` +
+		` projections.apps8_oidc_configs.clock_skew,` +
+		` projections.apps8_oidc_configs.additional_origins,` +
+		` projections.apps8_oidc_configs.skip_native_app_success_page,` +
+		` projections.apps8_oidc_configs.required_level_of_assurance,` +
 		//saml config
-		` projections.apps7_saml_configs.app_id,` +
-		` projections.apps7_saml_configs.entity_id,` +
-		` projections.apps7_saml_configs.metadata,` +
-		` projections.apps7_saml_configs.metadata_url,` +
+		` projections.apps8_saml_configs.app_id,` +
+		` projections.apps8_saml_configs.entity_id,` +
+		` projections.apps8_saml_configs.metadata,` +
+		` projections.apps8_saml_configs.metadata_url,` +
 		` COUNT(*) OVER ()` +
-		` FROM projections.apps7` +
-		` LEFT JOIN projections.apps7_api_configs ON projections.apps7.id = projections.apps7_api_configs.app_id AND projections.apps7.instance_id = projections.apps7_api_configs.instance_id` +
-		` LEFT JOIN projections.apps7_oidc_configs ON projections.apps7.id = projections.apps7_oidc_configs.app_id AND projections.apps7.instance_id = projections.apps7_oidc_configs.instance_id` +
-		` LEFT JOIN projections.apps7_saml_configs ON projections.apps7.id = projections.apps7_saml_configs.app_id AND projections.apps7.instance_id = projections.apps7_saml_configs.instance_id` +
+		` FROM projections.apps8` +
+		` LEFT JOIN projections.apps8_api_configs ON projections.apps8.id = projections.apps8_api_configs.app_id AND projections.apps8.instance_id = projections.apps8_api_configs.instance_id` +
+		` LEFT JOIN projections.apps8_oidc_configs ON projections.apps8.id = projections.apps8_oidc_configs.app_id AND projections.apps8.instance_id = projections.apps8_oidc_configs.instance_id` +
+		` LEFT JOIN projections.apps8_saml_configs ON projections.apps8.id = projections.apps8_saml_configs.app_id AND projections.apps8.instance_id = projections.apps8_saml_configs.instance_id` +
 		` AS OF SYSTEM TIME '-1 ms'`)
-	expectedAppIDsQuery = regexp.QuoteMeta(`SELECT projections.apps7_api_configs.client_id,` +
-		` projections.apps7_oidc_configs.client_id` +
-		` FROM projections.apps7` +
-		` LEFT JOIN projections.apps7_api_configs ON projections.apps7.id = projections.apps7_api_configs.app_id AND projections.apps7.instance_id = projections.apps7_api_configs.instance_id` +
-		` LEFT JOIN projections.apps7_oidc_configs ON projections.apps7.id = projections.apps7_oidc_configs.app_id AND projections.apps7.instance_id = projections.apps7_oidc_configs.instance_id` +
+	expectedAppIDsQuery = regexp.QuoteMeta(`SELECT projections.apps8_api_configs.client_id,` +
+		` projections.apps8_oidc_configs.client_id` +
+		` FROM projections.apps8` +
+		` LEFT JOIN projections.apps8_api_configs ON projections.apps8.id = projections.apps8_api_configs.app_id AND projections.apps8.instance_id = projections.apps8_api_configs.instance_id` +
+		` LEFT JOIN projections.apps8_oidc_configs ON projections.apps8.id = projections.apps8_oidc_configs.app_id AND projections.apps8.instance_id = projections.apps8_oidc_configs.instance_id` +
 		` AS OF SYSTEM TIME '-1 ms'`)
-	expectedProjectIDByAppQuery = regexp.QuoteMeta(`SELECT projections.apps7.project_id` +
-		` FROM projections.apps7` +
-		` LEFT JOIN projections.apps7_api_configs ON projections.apps7.id = projections.apps7_api_configs.app_id AND projections.apps7.instance_id = projections.apps7_api_configs.instance_id` +
-		` LEFT JOIN projections.apps7_oidc_configs ON projections.apps7.id = projections.apps7_oidc_configs.app_id AND projections.apps7.instance_id = projections.apps7_oidc_configs.instance_id` +
-		` LEFT JOIN projections.apps7_saml_configs ON projections.apps7.id = projections.apps7_saml_configs.app_id AND projections.apps7.instance_id = projections.apps7_saml_configs.instance_id` +
+	expectedProjectIDByAppQuery = regexp.QuoteMeta(`SELECT projections.apps8.project_id` +
+		` FROM projections.apps8` +
+		` LEFT JOIN projections.apps8_api_configs ON projections.apps8.id = projections.apps8_api_configs.app_id AND projections.apps8.instance_id = projections.apps8_api_configs.instance_id` +
+		` LEFT JOIN projections.apps8_oidc_configs ON projections.apps8.id = projections.apps8_oidc_configs.app_id AND projections.apps8.instance_id = projections.apps8_oidc_configs.instance_id` +
+		` LEFT JOIN projections.apps8_saml_configs ON projections.apps8.id = projections.apps8_saml_configs.app_id AND projections.apps8.instance_id = projections.apps8_saml_configs.instance_id` +
 		` AS OF SYSTEM TIME '-1 ms'`)
 	expectedProjectByAppQuery = regexp.QuoteMeta(`SELECT projections.projects4.id,` +
 		` projections.projects4.creation_date,` +
@@ -120,10 +122,10 @@ var (
 		` projections.projects4.has_project_check,` +
 		` projections.projects4.private_labeling_setting` +
 		` FROM projections.projects4` +
-		` JOIN projections.apps7 ON projections.projects4.id = projections.apps7.project_id AND projections.projects4.instance_id = projections.apps7.instance_id` +
-		` LEFT JOIN projections.apps7_api_configs ON projections.apps7.id = projections.apps7_api_configs.app_id AND projections.apps7.instance_id = projections.apps7_api_configs.instance_id` +
-		` LEFT JOIN projections.apps7_oidc_configs ON projections.apps7.id = projections.apps7_oidc_configs.app_id AND projections.apps7.instance_id = projections.apps7_oidc_configs.instance_id` +
-		` LEFT JOIN projections.apps7_saml_configs ON projections.apps7.id = projections.apps7_saml_configs.app_id AND projections.apps7.instance_id = projections.apps7_saml_configs.instance_id` +
+		` JOIN projections.apps8 ON projections.projects4.id = projections.apps8.project_id AND projections.projects4.instance_id = projections.apps8.instance_id` +
+		` LEFT JOIN projections.apps8_api_configs ON projections.apps8.id = projections.apps8_api_configs.app_id AND projections.apps8.instance_id = projections.apps8_api_configs.instance_id` +
+		` LEFT JOIN projections.apps8_oidc_configs ON projections.apps8.id = projections.apps8_oidc_configs.app_id AND projections.apps8.instance_id = projections.apps8_oidc_configs.instance_id` +
+		` LEFT JOIN projections.apps8_saml_configs ON projections.apps8.id = projections.apps8_saml_configs.app_id AND projections.apps8.instance_id = projections.apps8_saml_configs.instance_id` +
 		` AS OF SYSTEM TIME '-1 ms'`)
 
 	appCols = database.TextArray[string]{
@@ -157,6 +159,7 @@ var (
 		"clock_skew",
 		"additional_origins",
 		"skip_native_app_success_page",
+		"required_level_of_assurance",
 		//saml config
 		"app_id",
 		"entity_id",
@@ -228,6 +231,7 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -294,6 +298,7 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -363,6 +368,7 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
 							// saml config
 							"app-id",
 							"https://test.com/saml/metadata",
@@ -434,6 +440,7 @@ func Test_AppsPrepare(t *testing.T) {
 							1 * time.Second,
 							database.TextArray[string]{"additional.origin"},
 							false,
+							domain.LevelOfAssuranceNone,
 							// saml config
 							nil,
 							nil,
@@ -520,6 +527,7 @@ func Test_AppsPrepare(t *testing.T) {
 							1 * time.Second,
 							database.TextArray[string]{"additional.origin"},
 							false,
+							domain.LevelOfAssuranceNone,
 							// saml config
 							nil,
 							nil,
@@ -606,6 +614,7 @@ func Test_AppsPrepare(t *testing.T) {
 							1 * time.Second,
 							database.TextArray[string]{"additional.origin"},
 							false,
+							domain.LevelOfAssuranceNone,
 							// saml config
 							nil,
 							nil,
@@ -692,6 +701,7 @@ func Test_AppsPrepare(t *testing.T) {
 							1 * time.Second,
 							database.TextArray[string]{"additional.origin"},
 							false,
+							domain.LevelOfAssuranceNone,
 							// saml config
 							nil,
 							nil,
@@ -778,6 +788,7 @@ func Test_AppsPrepare(t *testing.T) {
 							1 * time.Second,
 							database.TextArray[string]{"additional.origin"},
 							false,
+							domain.LevelOfAssuranceNone,
 							// saml config
 							nil,
 							nil,
@@ -864,6 +875,7 @@ func Test_AppsPrepare(t *testing.T) {
 							1 * time.Second,
 							database.TextArray[string]{"additional.origin"},
 							true,
+							domain.LevelOfAssuranceMFA,
 							// saml config
 							nil,
 							nil,
@@ -906,6 +918,7 @@ func Test_AppsPrepare(t *testing.T) {
 							ComplianceProblems:       nil,
 							AllowedOrigins:           database.TextArray[string]{"https://redirect.to", "additional.origin"},
 							SkipNativeAppSuccessPage: true,
+							RequiredLevelOfAssurance: domain.LevelOfAssuranceMFA,
 						},
 					},
 				},
@@ -950,6 +963,7 @@ func Test_AppsPrepare(t *testing.T) {
 							1 * time.Second,
 							database.TextArray[string]{"additional.origin"},
 							false,
+							domain.LevelOfAssuranceNone,
 							// saml config
 							nil,
 							nil,
@@ -987,6 +1001,7 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -1024,6 +1039,7 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
 							// saml config
 							"saml-app-id",
 							"https://test.com/saml/metadata",
@@ -1195,6 +1211,7 @@ func Test_AppPrepare(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
 						// saml config
 						nil,
 						nil,
@@ -1253,6 +1270,7 @@ func Test_AppPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -1316,6 +1334,7 @@ func Test_AppPrepare(t *testing.T) {
 							1 * time.Second,
 							database.TextArray[string]{"additional.origin"},
 							false,
+							domain.LevelOfAssuranceNone,
 							// saml config
 							nil,
 							nil,
@@ -1394,6 +1413,7 @@ func Test_AppPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
 							// saml config
 							"app-id",
 							"https://test.com/saml/metadata",
@@ -1458,6 +1478,7 @@ func Test_AppPrepare(t *testing.T) {
 							1 * time.Second,
 							database.TextArray[string]{"additional.origin"},
 							false,
+							domain.LevelOfAssuranceNone,
 							// saml config
 							nil,
 							nil,
@@ -1537,6 +1558,7 @@ func Test_AppPrepare(t *testing.T) {
 							1 * time.Second,
 							database.TextArray[string]{"additional.origin"},
 							false,
+							domain.LevelOfAssuranceNone,
 							// saml config
 							nil,
 							nil,
@@ -1616,6 +1638,7 @@ func Test_AppPrepare(t *testing.T) {
 							1 * time.Second,
 							database.TextArray[string]{"additional.origin"},
 							false,
+							domain.LevelOfAssuranceNone,
 							// saml config
 							nil,
 							nil,
@@ -1695,6 +1718,7 @@ func Test_AppPrepare(t *testing.T) {
 							1 * time.Second,
 							database.TextArray[string]{"additional.origin"},
 							false,
+							domain.LevelOfAssuranceNone,
 							// saml config
 							nil,
 							nil,
@@ -32,6 +32,8 @@ type AuthRequest struct {
 	LoginHint    *string
 	MaxAge       *time.Duration
 	HintUserID   *string
+
+	RequiredLevelOfAssurance domain.LevelOfAssurance
 }
 
 func (a *AuthRequest) checkLoginClient(ctx context.Context) error {
@@ -71,7 +73,7 @@ func (q *Queries) AuthRequestByID(ctx context.Context, shouldTriggerBulk bool, i
 		func(row *sql.Row) error {
 			return row.Scan(
 				&dst.ID, &dst.CreationDate, &dst.LoginClient, &dst.ClientID, &scope, &dst.RedirectURI,
-				&prompt, &locales, &dst.LoginHint, &dst.MaxAge, &dst.HintUserID,
+				&prompt, &locales, &dst.LoginHint, &dst.MaxAge, &dst.HintUserID, &dst.RequiredLevelOfAssurance,
 			)
 		},
 		q.authRequestByIDQuery(ctx),
@@ -9,7 +9,8 @@ select
     ui_locales,
     login_hint,
     max_age,
-    hint_user_id
-from projections.auth_requests %s
+    hint_user_id,
+    required_level_of_assurance
+from projections.auth_requests2 %s
 where id = $1 and instance_id = $2
 limit 1;
@@ -0,0 +1,190 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	breakGlassSessionTable = table{
+		name:          projection.BreakGlassSessionTable,
+		instanceIDCol: projection.BreakGlassSessionInstanceIDCol,
+	}
+	BreakGlassSessionColumnInstanceID = Column{
+		name:  projection.BreakGlassSessionInstanceIDCol,
+		table: breakGlassSessionTable,
+	}
+	BreakGlassSessionColumnUserID = Column{
+		name:  projection.BreakGlassSessionUserIDCol,
+		table: breakGlassSessionTable,
+	}
+	BreakGlassSessionColumnSequence = Column{
+		name:  projection.BreakGlassSessionSequenceCol,
+		table: breakGlassSessionTable,
+	}
+	BreakGlassSessionColumnCreationDate = Column{
+		name:  projection.BreakGlassSessionCreationDateCol,
+		table: breakGlassSessionTable,
+	}
+	BreakGlassSessionColumnResourceOwner = Column{
+		name:  projection.BreakGlassSessionResourceOwnerCol,
+		table: breakGlassSessionTable,
+	}
+	BreakGlassSessionColumnSessionID = Column{
+		name:  projection.BreakGlassSessionSessionIDCol,
+		table: breakGlassSessionTable,
+	}
+	BreakGlassSessionColumnExpiration = Column{
+		name:  projection.BreakGlassSessionExpirationCol,
+		table: breakGlassSessionTable,
+	}
+	BreakGlassSessionColumnUserAgent = Column{
+		name:  projection.BreakGlassSessionUserAgentCol,
+		table: breakGlassSessionTable,
+	}
+	BreakGlassSessionColumnRemoteIP = Column{
+		name:  projection.BreakGlassSessionRemoteIPCol,
+		table: breakGlassSessionTable,
+	}
+)
+
+type BreakGlassSessions struct {
+	SearchResponse
+	Sessions []*BreakGlassSession
+}
+
+func (s *BreakGlassSessions) SetState(state *State) {
+	s.State = state
+}
+
+// BreakGlassSession is a login of an emergency access account.
+type BreakGlassSession struct {
+	UserID        string
+	Sequence      uint64
+	CreationDate  time.Time
+	ResourceOwner string
+	SessionID     string
+	Expiration    time.Time
+	UserAgent     string
+	RemoteIP      string
+}
+
+type BreakGlassSessionSearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+func (q *BreakGlassSessionSearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+// BreakGlassPolicy returns the policy of the instance for the emergency access accounts
+// or the default policy if none was set.
+func (q *Queries) BreakGlassPolicy(ctx context.Context) (_ *domain.BreakGlassPolicy, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	m := NewBreakGlassPolicyReadModel(ctx)
+	if err = q.eventstore.FilterToQueryReducer(ctx, m); err != nil {
+		return nil, err
+	}
+	return m.policy, nil
+}
+
+// BreakGlassPolicyByUser returns the policy of the instance if the user is an emergency access account,
+// nil otherwise.
+func (q *Queries) BreakGlassPolicyByUser(ctx context.Context, userID string) (_ *domain.BreakGlassPolicy, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	m := NewBreakGlassUserReadModel(userID)
+	if err = q.eventstore.FilterToQueryReducer(ctx, m); err != nil {
+		return nil, err
+	}
+	if !m.BreakGlass {
+		return nil, nil
+	}
+	return q.BreakGlassPolicy(ctx)
+}
+
+// SearchBreakGlassSessions returns the logins of the emergency access accounts of the instance.
+func (q *Queries) SearchBreakGlassSessions(ctx context.Context, queries *BreakGlassSessionSearchQueries) (_ *BreakGlassSessions, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	eq := sq.Eq{
+		BreakGlassSessionColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}
+	query, scan := prepareBreakGlassSessionsQuery(ctx, q.client)
+	return genericRowsQueryWithState[*BreakGlassSessions](ctx, q.client, breakGlassSessionTable, combineToWhereStmt(query, queries.toQuery, eq), scan)
+}
+
+func NewBreakGlassSessionUserIDSearchQuery(userID string) (SearchQuery, error) {
+	return NewTextQuery(BreakGlassSessionColumnUserID, userID, TextEquals)
+}
+
+// NewBreakGlassSessionActiveSearchQuery only returns the sessions which are not expired yet.
+func NewBreakGlassSessionActiveSearchQuery() (SearchQuery, error) {
+	return NewTimestampQuery(BreakGlassSessionColumnExpiration, time.Now(), TimestampGreater)
+}
+
+func prepareBreakGlassSessionsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(rows *sql.Rows) (*BreakGlassSessions, error)) {
+	return sq.Select(
+			BreakGlassSessionColumnUserID.identifier(),
+			BreakGlassSessionColumnSequence.identifier(),
+			BreakGlassSessionColumnCreationDate.identifier(),
+			BreakGlassSessionColumnResourceOwner.identifier(),
+			BreakGlassSessionColumnSessionID.identifier(),
+			BreakGlassSessionColumnExpiration.identifier(),
+			BreakGlassSessionColumnUserAgent.identifier(),
+			BreakGlassSessionColumnRemoteIP.identifier(),
+			countColumn.identifier(),
+		).From(breakGlassSessionTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*BreakGlassSessions, error) {
+			sessions := make([]*BreakGlassSession, 0)
+			var count uint64
+			for rows.Next() {
+				session := new(BreakGlassSession)
+				err := rows.Scan(
+					&session.UserID,
+					&session.Sequence,
+					&session.CreationDate,
+					&session.ResourceOwner,
+					&session.SessionID,
+					&session.Expiration,
+					&session.UserAgent,
+					&session.RemoteIP,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				sessions = append(sessions, session)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Bg1cr", "Errors.Query.CloseRows")
+			}
+
+			return &BreakGlassSessions{
+				Sessions: sessions,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
@@ -0,0 +1,83 @@
+package query
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+type BreakGlassPolicyReadModel struct {
+	*eventstore.ReadModel
+	policy *domain.BreakGlassPolicy
+}
+
+func NewBreakGlassPolicyReadModel(ctx context.Context) *BreakGlassPolicyReadModel {
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	return &BreakGlassPolicyReadModel{
+		ReadModel: &eventstore.ReadModel{
+			AggregateID:   instanceID,
+			ResourceOwner: instanceID,
+		},
+		policy: domain.DefaultBreakGlassPolicy(),
+	}
+}
+
+func (m *BreakGlassPolicyReadModel) Reduce() error {
+	for _, event := range m.Events {
+		if e, ok := event.(*instance.BreakGlassPolicySetEvent); ok {
+			m.policy = e.Policy()
+		}
+	}
+	return m.ReadModel.Reduce()
+}
+
+func (m *BreakGlassPolicyReadModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(m.ResourceOwner).
+		AddQuery().
+		AggregateTypes(instance.AggregateType).
+		AggregateIDs(m.AggregateID).
+		EventTypes(instance.BreakGlassPolicySetEventType).
+		Builder()
+}
+
+type BreakGlassUserReadModel struct {
+	*eventstore.ReadModel
+	BreakGlass bool
+}
+
+func NewBreakGlassUserReadModel(userID string) *BreakGlassUserReadModel {
+	return &BreakGlassUserReadModel{
+		ReadModel: &eventstore.ReadModel{
+			AggregateID: userID,
+		},
+	}
+}
+
+func (m *BreakGlassUserReadModel) Reduce() error {
+	for _, event := range m.Events {
+		switch e := event.(type) {
+		case *user.HumanBreakGlassSetEvent:
+			m.BreakGlass = e.BreakGlass
+		case *user.UserRemovedEvent:
+			m.BreakGlass = false
+		}
+	}
+	return m.ReadModel.Reduce()
+}
+
+func (m *BreakGlassUserReadModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(m.AggregateID).
+		EventTypes(
+			user.HumanBreakGlassSetType,
+			user.UserRemovedType,
+		).
+		Builder()
+}
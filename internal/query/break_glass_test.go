@@ -0,0 +1,121 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+)
+
+var (
+	prepareBreakGlassSessionsStmt = `SELECT projections.break_glass_sessions.user_id,` +
+		` projections.break_glass_sessions.sequence,` +
+		` projections.break_glass_sessions.creation_date,` +
+		` projections.break_glass_sessions.resource_owner,` +
+		` projections.break_glass_sessions.session_id,` +
+		` projections.break_glass_sessions.expiration,` +
+		` projections.break_glass_sessions.user_agent,` +
+		` projections.break_glass_sessions.remote_ip,` +
+		` COUNT(*) OVER ()` +
+		` FROM projections.break_glass_sessions`
+	prepareBreakGlassSessionsCols = []string{
+		"user_id",
+		"sequence",
+		"creation_date",
+		"resource_owner",
+		"session_id",
+		"expiration",
+		"user_agent",
+		"remote_ip",
+		"count",
+	}
+)
+
+func Test_BreakGlassSessionPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareBreakGlassSessionsQuery no result",
+			prepare: prepareBreakGlassSessionsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareBreakGlassSessionsStmt),
+					nil,
+					nil,
+				),
+			},
+			object: &BreakGlassSessions{Sessions: []*BreakGlassSession{}},
+		},
+		{
+			name:    "prepareBreakGlassSessionsQuery one result",
+			prepare: prepareBreakGlassSessionsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareBreakGlassSessionsStmt),
+					prepareBreakGlassSessionsCols,
+					[][]driver.Value{
+						{
+							"user-id",
+							uint64(20211109),
+							testNow,
+							"ro",
+							"session-id",
+							testNow,
+							"Mozilla/5.0",
+							"10.0.0.1",
+						},
+					},
+				),
+			},
+			object: &BreakGlassSessions{
+				SearchResponse: SearchResponse{
+					Count: 1,
+				},
+				Sessions: []*BreakGlassSession{
+					{
+						UserID:        "user-id",
+						Sequence:      20211109,
+						CreationDate:  testNow,
+						ResourceOwner: "ro",
+						SessionID:     "session-id",
+						Expiration:    testNow,
+						UserAgent:     "Mozilla/5.0",
+						RemoteIP:      "10.0.0.1",
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareBreakGlassSessionsQuery sql err",
+			prepare: prepareBreakGlassSessionsQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareBreakGlassSessionsStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*BreakGlassSessions)(nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err, defaultPrepareArgs...)
+		})
+	}
+}
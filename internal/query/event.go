@@ -43,11 +43,7 @@ func (r *eventsReducer) Reduce() error { return nil }
 func (q *Queries) SearchEvents(ctx context.Context, query *eventstore.SearchQueryBuilder) (_ []*Event, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
-	auditLogRetention := q.defaultAuditLogRetention
-	if instanceAuditLogRetention := authz.GetInstance(ctx).AuditLogRetention(); instanceAuditLogRetention != nil {
-		auditLogRetention = *instanceAuditLogRetention
-	}
-	if auditLogRetention != 0 {
+	if auditLogRetention := q.AuditLogRetention(ctx); auditLogRetention != 0 {
 		query = filterAuditLogRetention(ctx, auditLogRetention, query)
 	}
 	reducer := &eventsReducer{ctx: ctx, q: q, editors: make(map[string]*EventEditor, query.GetLimit())}
@@ -57,12 +53,26 @@ func (q *Queries) SearchEvents(ctx context.Context, query *eventstore.SearchQuer
 	return reducer.events, nil
 }
 
-func filterAuditLogRetention(ctx context.Context, auditLogRetention time.Duration, builder *eventstore.SearchQueryBuilder) *eventstore.SearchQueryBuilder {
+// AuditLogRetention returns the retention of the audit log of the instance,
+// which overwrites the default of the system if it's limited.
+func (q *Queries) AuditLogRetention(ctx context.Context) time.Duration {
+	if instanceAuditLogRetention := authz.GetInstance(ctx).AuditLogRetention(); instanceAuditLogRetention != nil {
+		return *instanceAuditLogRetention
+	}
+	return q.defaultAuditLogRetention
+}
+
+// oldestAllowedByRetention returns the creation date of the oldest entry of the audit log, which can be queried.
+func oldestAllowedByRetention(ctx context.Context, auditLogRetention time.Duration) time.Time {
 	callTime := call.FromContext(ctx)
 	if callTime.IsZero() {
 		callTime = time.Now()
 	}
-	oldestAllowed := callTime.Add(-auditLogRetention)
+	return callTime.Add(-auditLogRetention)
+}
+
+func filterAuditLogRetention(ctx context.Context, auditLogRetention time.Duration, builder *eventstore.SearchQueryBuilder) *eventstore.SearchQueryBuilder {
+	oldestAllowed := oldestAllowedByRetention(ctx, auditLogRetention)
 	// The audit log retention time should overwrite the creation date after query only if it is older
 	// For example API calls should still be able to restrict the creation date after to a more recent date
 	if builder.GetCreationDateAfter().Before(oldestAllowed) {
@@ -0,0 +1,442 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	groupTable = table{
+		name:          projection.GroupTable,
+		instanceIDCol: projection.GroupInstanceIDCol,
+	}
+	GroupColumnID = Column{
+		name:  projection.GroupIDCol,
+		table: groupTable,
+	}
+	GroupColumnCreationDate = Column{
+		name:  projection.GroupCreationDateCol,
+		table: groupTable,
+	}
+	GroupColumnChangeDate = Column{
+		name:  projection.GroupChangeDateCol,
+		table: groupTable,
+	}
+	GroupColumnSequence = Column{
+		name:  projection.GroupSequenceCol,
+		table: groupTable,
+	}
+	GroupColumnState = Column{
+		name:  projection.GroupStateCol,
+		table: groupTable,
+	}
+	GroupColumnResourceOwner = Column{
+		name:  projection.GroupResourceOwnerCol,
+		table: groupTable,
+	}
+	GroupColumnInstanceID = Column{
+		name:  projection.GroupInstanceIDCol,
+		table: groupTable,
+	}
+	GroupColumnName = Column{
+		name:           projection.GroupNameCol,
+		table:          groupTable,
+		isOrderByLower: true,
+	}
+	GroupColumnDescription = Column{
+		name:  projection.GroupDescriptionCol,
+		table: groupTable,
+	}
+	GroupColumnExternalID = Column{
+		name:  projection.GroupExternalIDCol,
+		table: groupTable,
+	}
+)
+
+var (
+	groupMemberTable = table{
+		name:          projection.GroupMemberTable,
+		instanceIDCol: projection.GroupMemberInstanceIDCol,
+	}
+	GroupMemberColumnInstanceID = Column{
+		name:  projection.GroupMemberInstanceIDCol,
+		table: groupMemberTable,
+	}
+	GroupMemberColumnGroupID = Column{
+		name:  projection.GroupMemberGroupIDCol,
+		table: groupMemberTable,
+	}
+	GroupMemberColumnMemberID = Column{
+		name:  projection.GroupMemberMemberIDCol,
+		table: groupMemberTable,
+	}
+	GroupMemberColumnType = Column{
+		name:  projection.GroupMemberTypeCol,
+		table: groupMemberTable,
+	}
+	GroupMemberColumnCreationDate = Column{
+		name:  projection.GroupMemberCreationDateCol,
+		table: groupMemberTable,
+	}
+	GroupMemberColumnSequence = Column{
+		name:  projection.GroupMemberSequenceCol,
+		table: groupMemberTable,
+	}
+)
+
+var (
+	groupGrantTable = table{
+		name:          projection.GroupGrantTable,
+		instanceIDCol: projection.GroupGrantInstanceIDCol,
+	}
+	GroupGrantColumnInstanceID = Column{
+		name:  projection.GroupGrantInstanceIDCol,
+		table: groupGrantTable,
+	}
+	GroupGrantColumnGroupID = Column{
+		name:  projection.GroupGrantGroupIDCol,
+		table: groupGrantTable,
+	}
+	GroupGrantColumnProjectID = Column{
+		name:  projection.GroupGrantProjectIDCol,
+		table: groupGrantTable,
+	}
+	GroupGrantColumnGrantID = Column{
+		name:  projection.GroupGrantGrantIDCol,
+		table: groupGrantTable,
+	}
+	GroupGrantColumnRoles = Column{
+		name:  projection.GroupGrantRolesCol,
+		table: groupGrantTable,
+	}
+	GroupGrantColumnCreationDate = Column{
+		name:  projection.GroupGrantCreationDateCol,
+		table: groupGrantTable,
+	}
+	GroupGrantColumnChangeDate = Column{
+		name:  projection.GroupGrantChangeDateCol,
+		table: groupGrantTable,
+	}
+	GroupGrantColumnSequence = Column{
+		name:  projection.GroupGrantSequenceCol,
+		table: groupGrantTable,
+	}
+)
+
+type Groups struct {
+	SearchResponse
+	Groups []*Group
+}
+
+func (g *Groups) SetState(s *State) {
+	g.State = s
+}
+
+type Group struct {
+	ID            string
+	CreationDate  time.Time
+	ChangeDate    time.Time
+	ResourceOwner string
+	Sequence      uint64
+	State         domain.GroupState
+
+	Name        string
+	Description string
+	ExternalID  string
+}
+
+type GroupSearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+func (q *GroupSearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+type GroupMembers struct {
+	SearchResponse
+	Members []*GroupMember
+}
+
+func (m *GroupMembers) SetState(s *State) {
+	m.State = s
+}
+
+// GroupMember is a direct member of a group, either a user or a nested group.
+type GroupMember struct {
+	GroupID      string
+	MemberID     string
+	Type         domain.GroupMemberType
+	CreationDate time.Time
+	Sequence     uint64
+}
+
+type GroupGrants struct {
+	SearchResponse
+	Grants []*GroupGrant
+}
+
+func (g *GroupGrants) SetState(s *State) {
+	g.State = s
+}
+
+type GroupGrant struct {
+	GroupID        string
+	ProjectID      string
+	ProjectGrantID string
+	Roles          database.TextArray[string]
+	CreationDate   time.Time
+	ChangeDate     time.Time
+	Sequence       uint64
+}
+
+func (q *Queries) GroupByID(ctx context.Context, id, resourceOwner string) (_ *Group, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	eq := sq.Eq{
+		GroupColumnID.identifier():         id,
+		GroupColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}
+	if resourceOwner != "" {
+		eq[GroupColumnResourceOwner.identifier()] = resourceOwner
+	}
+	query, scan := prepareGroupQuery()
+	return genericRowQuery[*Group](ctx, q.client, query.Where(eq), scan)
+}
+
+func (q *Queries) SearchGroups(ctx context.Context, queries *GroupSearchQueries) (_ *Groups, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	eq := sq.Eq{GroupColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID()}
+	query, scan := prepareGroupsQuery()
+	return genericRowsQueryWithState[*Groups](ctx, q.client, groupTable, combineToWhereStmt(query, queries.toQuery, eq), scan)
+}
+
+// SearchGroupMembers returns the direct members of the group.
+func (q *Queries) SearchGroupMembers(ctx context.Context, groupID string, queries *SearchRequest) (_ *GroupMembers, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	eq := sq.Eq{
+		GroupMemberColumnGroupID.identifier():    groupID,
+		GroupMemberColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}
+	query, scan := prepareGroupMembersQuery()
+	return genericRowsQueryWithState[*GroupMembers](ctx, q.client, groupTable, combineToWhereStmt(query, queries.toQuery, eq), scan)
+}
+
+func (q *Queries) SearchGroupGrants(ctx context.Context, groupID string, queries *SearchRequest) (_ *GroupGrants, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	eq := sq.Eq{
+		GroupGrantColumnGroupID.identifier():    groupID,
+		GroupGrantColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}
+	query, scan := prepareGroupGrantsQuery()
+	return genericRowsQueryWithState[*GroupGrants](ctx, q.client, groupTable, combineToWhereStmt(query, queries.toQuery, eq), scan)
+}
+
+func NewGroupNameSearchQuery(value string, method TextComparison) (SearchQuery, error) {
+	return NewTextQuery(GroupColumnName, value, method)
+}
+
+func NewGroupExternalIDSearchQuery(value string, method TextComparison) (SearchQuery, error) {
+	return NewTextQuery(GroupColumnExternalID, value, method)
+}
+
+func NewGroupResourceOwnerSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(GroupColumnResourceOwner, value, TextEquals)
+}
+
+func prepareGroupQuery() (sq.SelectBuilder, func(*sql.Row) (*Group, error)) {
+	return sq.Select(
+			GroupColumnID.identifier(),
+			GroupColumnCreationDate.identifier(),
+			GroupColumnChangeDate.identifier(),
+			GroupColumnResourceOwner.identifier(),
+			GroupColumnSequence.identifier(),
+			GroupColumnState.identifier(),
+			GroupColumnName.identifier(),
+			GroupColumnDescription.identifier(),
+			GroupColumnExternalID.identifier(),
+		).From(groupTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(row *sql.Row) (*Group, error) {
+			g := new(Group)
+			err := row.Scan(
+				&g.ID,
+				&g.CreationDate,
+				&g.ChangeDate,
+				&g.ResourceOwner,
+				&g.Sequence,
+				&g.State,
+				&g.Name,
+				&g.Description,
+				&g.ExternalID,
+			)
+			if err != nil {
+				if errors.Is(err, sql.ErrNoRows) {
+					return nil, zerrors.ThrowNotFound(err, "QUERY-Gr1nf", "Errors.Group.NotFound")
+				}
+				return nil, zerrors.ThrowInternal(err, "QUERY-Gr2sc", "Errors.Internal")
+			}
+			return g, nil
+		}
+}
+
+func prepareGroupsQuery() (sq.SelectBuilder, func(*sql.Rows) (*Groups, error)) {
+	return sq.Select(
+			GroupColumnID.identifier(),
+			GroupColumnCreationDate.identifier(),
+			GroupColumnChangeDate.identifier(),
+			GroupColumnResourceOwner.identifier(),
+			GroupColumnSequence.identifier(),
+			GroupColumnState.identifier(),
+			GroupColumnName.identifier(),
+			GroupColumnDescription.identifier(),
+			GroupColumnExternalID.identifier(),
+			countColumn.identifier(),
+		).From(groupTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*Groups, error) {
+			groups := make([]*Group, 0)
+			var count uint64
+			for rows.Next() {
+				g := new(Group)
+				err := rows.Scan(
+					&g.ID,
+					&g.CreationDate,
+					&g.ChangeDate,
+					&g.ResourceOwner,
+					&g.Sequence,
+					&g.State,
+					&g.Name,
+					&g.Description,
+					&g.ExternalID,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				groups = append(groups, g)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Gr3cr", "Errors.Query.CloseRows")
+			}
+
+			return &Groups{
+				Groups: groups,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
+
+func prepareGroupMembersQuery() (sq.SelectBuilder, func(*sql.Rows) (*GroupMembers, error)) {
+	return sq.Select(
+			GroupMemberColumnGroupID.identifier(),
+			GroupMemberColumnMemberID.identifier(),
+			GroupMemberColumnType.identifier(),
+			GroupMemberColumnCreationDate.identifier(),
+			GroupMemberColumnSequence.identifier(),
+			countColumn.identifier(),
+		).From(groupMemberTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*GroupMembers, error) {
+			members := make([]*GroupMember, 0)
+			var count uint64
+			for rows.Next() {
+				m := new(GroupMember)
+				err := rows.Scan(
+					&m.GroupID,
+					&m.MemberID,
+					&m.Type,
+					&m.CreationDate,
+					&m.Sequence,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				members = append(members, m)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Gm1cr", "Errors.Query.CloseRows")
+			}
+
+			return &GroupMembers{
+				Members: members,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
+
+func prepareGroupGrantsQuery() (sq.SelectBuilder, func(*sql.Rows) (*GroupGrants, error)) {
+	return sq.Select(
+			GroupGrantColumnGroupID.identifier(),
+			GroupGrantColumnProjectID.identifier(),
+			GroupGrantColumnGrantID.identifier(),
+			GroupGrantColumnRoles.identifier(),
+			GroupGrantColumnCreationDate.identifier(),
+			GroupGrantColumnChangeDate.identifier(),
+			GroupGrantColumnSequence.identifier(),
+			countColumn.identifier(),
+		).From(groupGrantTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*GroupGrants, error) {
+			grants := make([]*GroupGrant, 0)
+			var count uint64
+			for rows.Next() {
+				g := new(GroupGrant)
+				err := rows.Scan(
+					&g.GroupID,
+					&g.ProjectID,
+					&g.ProjectGrantID,
+					&g.Roles,
+					&g.CreationDate,
+					&g.ChangeDate,
+					&g.Sequence,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				grants = append(grants, g)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Gg1cr", "Errors.Query.CloseRows")
+			}
+
+			return &GroupGrants{
+				Grants: grants,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
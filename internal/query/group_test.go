@@ -0,0 +1,306 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	prepareGroupStmt = `SELECT projections.groups.id,` +
+		` projections.groups.creation_date,` +
+		` projections.groups.change_date,` +
+		` projections.groups.resource_owner,` +
+		` projections.groups.sequence,` +
+		` projections.groups.state,` +
+		` projections.groups.name,` +
+		` projections.groups.description,` +
+		` projections.groups.external_id` +
+		` FROM projections.groups`
+	prepareGroupCols = []string{
+		"id",
+		"creation_date",
+		"change_date",
+		"resource_owner",
+		"sequence",
+		"state",
+		"name",
+		"description",
+		"external_id",
+	}
+	prepareGroupsStmt = `SELECT projections.groups.id,` +
+		` projections.groups.creation_date,` +
+		` projections.groups.change_date,` +
+		` projections.groups.resource_owner,` +
+		` projections.groups.sequence,` +
+		` projections.groups.state,` +
+		` projections.groups.name,` +
+		` projections.groups.description,` +
+		` projections.groups.external_id,` +
+		` COUNT(*) OVER ()` +
+		` FROM projections.groups`
+	prepareGroupsCols = append(prepareGroupCols, "count")
+
+	prepareGroupMembersStmt = `SELECT projections.groups_members.group_id,` +
+		` projections.groups_members.member_id,` +
+		` projections.groups_members.member_type,` +
+		` projections.groups_members.creation_date,` +
+		` projections.groups_members.sequence,` +
+		` COUNT(*) OVER ()` +
+		` FROM projections.groups_members`
+	prepareGroupMembersCols = []string{
+		"group_id",
+		"member_id",
+		"member_type",
+		"creation_date",
+		"sequence",
+		"count",
+	}
+
+	prepareGroupGrantsStmt = `SELECT projections.groups_grants.group_id,` +
+		` projections.groups_grants.project_id,` +
+		` projections.groups_grants.grant_id,` +
+		` projections.groups_grants.roles,` +
+		` projections.groups_grants.creation_date,` +
+		` projections.groups_grants.change_date,` +
+		` projections.groups_grants.sequence,` +
+		` COUNT(*) OVER ()` +
+		` FROM projections.groups_grants`
+	prepareGroupGrantsCols = []string{
+		"group_id",
+		"project_id",
+		"grant_id",
+		"roles",
+		"creation_date",
+		"change_date",
+		"sequence",
+		"count",
+	}
+)
+
+func Test_GroupPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareGroupQuery no result",
+			prepare: prepareGroupQuery,
+			want: want{
+				sqlExpectations: mockQueriesScanErr(
+					regexp.QuoteMeta(prepareGroupStmt),
+					nil,
+					nil,
+				),
+				err: func(err error) (error, bool) {
+					if !zerrors.IsNotFound(err) {
+						return fmt.Errorf("err should be zitadel.NotFoundError got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*Group)(nil),
+		},
+		{
+			name:    "prepareGroupQuery found",
+			prepare: prepareGroupQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					regexp.QuoteMeta(prepareGroupStmt),
+					prepareGroupCols,
+					[]driver.Value{
+						"group-id",
+						testNow,
+						testNow,
+						"ro",
+						uint64(20211109),
+						domain.GroupStateActive,
+						"developers",
+						"all developers",
+						"external-id",
+					},
+				),
+			},
+			object: &Group{
+				ID:            "group-id",
+				CreationDate:  testNow,
+				ChangeDate:    testNow,
+				ResourceOwner: "ro",
+				Sequence:      20211109,
+				State:         domain.GroupStateActive,
+				Name:          "developers",
+				Description:   "all developers",
+				ExternalID:    "external-id",
+			},
+		},
+		{
+			name:    "prepareGroupsQuery no result",
+			prepare: prepareGroupsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareGroupsStmt),
+					nil,
+					nil,
+				),
+			},
+			object: &Groups{Groups: []*Group{}},
+		},
+		{
+			name:    "prepareGroupsQuery one result",
+			prepare: prepareGroupsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareGroupsStmt),
+					prepareGroupsCols,
+					[][]driver.Value{
+						{
+							"group-id",
+							testNow,
+							testNow,
+							"ro",
+							uint64(20211109),
+							domain.GroupStateActive,
+							"developers",
+							"",
+							"",
+						},
+					},
+				),
+			},
+			object: &Groups{
+				SearchResponse: SearchResponse{
+					Count: 1,
+				},
+				Groups: []*Group{
+					{
+						ID:            "group-id",
+						CreationDate:  testNow,
+						ChangeDate:    testNow,
+						ResourceOwner: "ro",
+						Sequence:      20211109,
+						State:         domain.GroupStateActive,
+						Name:          "developers",
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareGroupsQuery sql err",
+			prepare: prepareGroupsQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareGroupsStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*Groups)(nil),
+		},
+		{
+			name:    "prepareGroupMembersQuery multiple result",
+			prepare: prepareGroupMembersQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareGroupMembersStmt),
+					prepareGroupMembersCols,
+					[][]driver.Value{
+						{
+							"group-id",
+							"user-id",
+							domain.GroupMemberTypeUser,
+							testNow,
+							uint64(20211109),
+						},
+						{
+							"group-id",
+							"subgroup-id",
+							domain.GroupMemberTypeGroup,
+							testNow,
+							uint64(20211110),
+						},
+					},
+				),
+			},
+			object: &GroupMembers{
+				SearchResponse: SearchResponse{
+					Count: 2,
+				},
+				Members: []*GroupMember{
+					{
+						GroupID:      "group-id",
+						MemberID:     "user-id",
+						Type:         domain.GroupMemberTypeUser,
+						CreationDate: testNow,
+						Sequence:     20211109,
+					},
+					{
+						GroupID:      "group-id",
+						MemberID:     "subgroup-id",
+						Type:         domain.GroupMemberTypeGroup,
+						CreationDate: testNow,
+						Sequence:     20211110,
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareGroupGrantsQuery one result",
+			prepare: prepareGroupGrantsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareGroupGrantsStmt),
+					prepareGroupGrantsCols,
+					[][]driver.Value{
+						{
+							"group-id",
+							"project-id",
+							"",
+							database.TextArray[string]{"role"},
+							testNow,
+							testNow,
+							uint64(20211109),
+						},
+					},
+				),
+			},
+			object: &GroupGrants{
+				SearchResponse: SearchResponse{
+					Count: 1,
+				},
+				Grants: []*GroupGrant{
+					{
+						GroupID:      "group-id",
+						ProjectID:    "project-id",
+						Roles:        database.TextArray[string]{"role"},
+						CreationDate: testNow,
+						ChangeDate:   testNow,
+						Sequence:     20211109,
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err)
+		})
+	}
+}
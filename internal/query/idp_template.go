@@ -48,6 +48,11 @@ type IDPTemplate struct {
 	*LDAPIDPTemplate
 	*AppleIDPTemplate
 	*SAMLIDPTemplate
+	*ADFSIDPTemplate
+	*KeycloakIDPTemplate
+	*OktaIDPTemplate
+	*BitbucketIDPTemplate
+	*DiscordIDPTemplate
 }
 
 type IDPTemplates struct {
@@ -64,6 +69,8 @@ type OAuthIDPTemplate struct {
 	UserEndpoint          string
 	Scopes                database.TextArray[string]
 	IDAttribute           string
+	UsePKCE               bool
+	CodeVerifierKey       *crypto.CryptoValue
 }
 
 type OIDCIDPTemplate struct {
@@ -165,6 +172,44 @@ type SAMLIDPTemplate struct {
 	TransientMappingAttributeName string
 }
 
+type ADFSIDPTemplate struct {
+	IDPID        string
+	Issuer       string
+	ClientID     string
+	ClientSecret *crypto.CryptoValue
+	Scopes       database.TextArray[string]
+}
+
+type KeycloakIDPTemplate struct {
+	IDPID        string
+	Issuer       string
+	ClientID     string
+	ClientSecret *crypto.CryptoValue
+	Scopes       database.TextArray[string]
+}
+
+type OktaIDPTemplate struct {
+	IDPID        string
+	Issuer       string
+	ClientID     string
+	ClientSecret *crypto.CryptoValue
+	Scopes       database.TextArray[string]
+}
+
+type BitbucketIDPTemplate struct {
+	IDPID        string
+	ClientID     string
+	ClientSecret *crypto.CryptoValue
+	Scopes       database.TextArray[string]
+}
+
+type DiscordIDPTemplate struct {
+	IDPID        string
+	ClientID     string
+	ClientSecret *crypto.CryptoValue
+	Scopes       database.TextArray[string]
+}
+
 var (
 	idpTemplateTable = table{
 		name:          projection.IDPTemplateTable,
@@ -277,6 +322,14 @@ var (
 		name:  projection.OAuthIDAttributeCol,
 		table: oauthIdpTemplateTable,
 	}
+	OAuthUsePKCECol = Column{
+		name:  projection.OAuthUsePKCECol,
+		table: oauthIdpTemplateTable,
+	}
+	OAuthCodeVerifierKeyCol = Column{
+		name:  projection.OAuthCodeVerifierKeyCol,
+		table: oauthIdpTemplateTable,
+	}
 )
 
 var (
@@ -710,6 +763,143 @@ var (
 		name:  projection.SAMLTransientMappingAttributeName,
 		table: samlIdpTemplateTable,
 	}
+
+	adfsIdpTemplateTable = table{
+		name:          projection.IDPTemplateADFSTable,
+		instanceIDCol: projection.ADFSInstanceIDCol,
+	}
+	ADFSIDCol = Column{
+		name:  projection.ADFSIDCol,
+		table: adfsIdpTemplateTable,
+	}
+	ADFSInstanceIDCol = Column{
+		name:  projection.ADFSInstanceIDCol,
+		table: adfsIdpTemplateTable,
+	}
+	ADFSIssuerCol = Column{
+		name:  projection.ADFSIssuerCol,
+		table: adfsIdpTemplateTable,
+	}
+	ADFSClientIDCol = Column{
+		name:  projection.ADFSClientIDCol,
+		table: adfsIdpTemplateTable,
+	}
+	ADFSClientSecretCol = Column{
+		name:  projection.ADFSClientSecretCol,
+		table: adfsIdpTemplateTable,
+	}
+	ADFSScopesCol = Column{
+		name:  projection.ADFSScopesCol,
+		table: adfsIdpTemplateTable,
+	}
+
+	keycloakIdpTemplateTable = table{
+		name:          projection.IDPTemplateKeycloakTable,
+		instanceIDCol: projection.KeycloakInstanceIDCol,
+	}
+	KeycloakIDCol = Column{
+		name:  projection.KeycloakIDCol,
+		table: keycloakIdpTemplateTable,
+	}
+	KeycloakInstanceIDCol = Column{
+		name:  projection.KeycloakInstanceIDCol,
+		table: keycloakIdpTemplateTable,
+	}
+	KeycloakIssuerCol = Column{
+		name:  projection.KeycloakIssuerCol,
+		table: keycloakIdpTemplateTable,
+	}
+	KeycloakClientIDCol = Column{
+		name:  projection.KeycloakClientIDCol,
+		table: keycloakIdpTemplateTable,
+	}
+	KeycloakClientSecretCol = Column{
+		name:  projection.KeycloakClientSecretCol,
+		table: keycloakIdpTemplateTable,
+	}
+	KeycloakScopesCol = Column{
+		name:  projection.KeycloakScopesCol,
+		table: keycloakIdpTemplateTable,
+	}
+
+	oktaIdpTemplateTable = table{
+		name:          projection.IDPTemplateOktaTable,
+		instanceIDCol: projection.OktaInstanceIDCol,
+	}
+	OktaIDCol = Column{
+		name:  projection.OktaIDCol,
+		table: oktaIdpTemplateTable,
+	}
+	OktaInstanceIDCol = Column{
+		name:  projection.OktaInstanceIDCol,
+		table: oktaIdpTemplateTable,
+	}
+	OktaIssuerCol = Column{
+		name:  projection.OktaIssuerCol,
+		table: oktaIdpTemplateTable,
+	}
+	OktaClientIDCol = Column{
+		name:  projection.OktaClientIDCol,
+		table: oktaIdpTemplateTable,
+	}
+	OktaClientSecretCol = Column{
+		name:  projection.OktaClientSecretCol,
+		table: oktaIdpTemplateTable,
+	}
+	OktaScopesCol = Column{
+		name:  projection.OktaScopesCol,
+		table: oktaIdpTemplateTable,
+	}
+
+	bitbucketIdpTemplateTable = table{
+		name:          projection.IDPTemplateBitbucketTable,
+		instanceIDCol: projection.BitbucketInstanceIDCol,
+	}
+	BitbucketIDCol = Column{
+		name:  projection.BitbucketIDCol,
+		table: bitbucketIdpTemplateTable,
+	}
+	BitbucketInstanceIDCol = Column{
+		name:  projection.BitbucketInstanceIDCol,
+		table: bitbucketIdpTemplateTable,
+	}
+	BitbucketClientIDCol = Column{
+		name:  projection.BitbucketClientIDCol,
+		table: bitbucketIdpTemplateTable,
+	}
+	BitbucketClientSecretCol = Column{
+		name:  projection.BitbucketClientSecretCol,
+		table: bitbucketIdpTemplateTable,
+	}
+	BitbucketScopesCol = Column{
+		name:  projection.BitbucketScopesCol,
+		table: bitbucketIdpTemplateTable,
+	}
+
+	discordIdpTemplateTable = table{
+		name:          projection.IDPTemplateDiscordTable,
+		instanceIDCol: projection.DiscordInstanceIDCol,
+	}
+	DiscordIDCol = Column{
+		name:  projection.DiscordIDCol,
+		table: discordIdpTemplateTable,
+	}
+	DiscordInstanceIDCol = Column{
+		name:  projection.DiscordInstanceIDCol,
+		table: discordIdpTemplateTable,
+	}
+	DiscordClientIDCol = Column{
+		name:  projection.DiscordClientIDCol,
+		table: discordIdpTemplateTable,
+	}
+	DiscordClientSecretCol = Column{
+		name:  projection.DiscordClientSecretCol,
+		table: discordIdpTemplateTable,
+	}
+	DiscordScopesCol = Column{
+		name:  projection.DiscordScopesCol,
+		table: discordIdpTemplateTable,
+	}
 )
 
 // IDPTemplateByID searches for the requested id
@@ -837,6 +1027,8 @@ func prepareIDPTemplateByIDQuery(ctx context.Context, db prepareDatabase) (sq.Se
 			OAuthUserEndpointCol.identifier(),
 			OAuthScopesCol.identifier(),
 			OAuthIDAttributeCol.identifier(),
+			OAuthUsePKCECol.identifier(),
+			OAuthCodeVerifierKeyCol.identifier(),
 			// oidc
 			OIDCIDCol.identifier(),
 			OIDCIssuerCol.identifier(),
@@ -926,6 +1118,34 @@ func prepareIDPTemplateByIDQuery(ctx context.Context, db prepareDatabase) (sq.Se
 			AppleKeyIDCol.identifier(),
 			ApplePrivateKeyCol.identifier(),
 			AppleScopesCol.identifier(),
+			// adfs
+			ADFSIDCol.identifier(),
+			ADFSIssuerCol.identifier(),
+			ADFSClientIDCol.identifier(),
+			ADFSClientSecretCol.identifier(),
+			ADFSScopesCol.identifier(),
+			// keycloak
+			KeycloakIDCol.identifier(),
+			KeycloakIssuerCol.identifier(),
+			KeycloakClientIDCol.identifier(),
+			KeycloakClientSecretCol.identifier(),
+			KeycloakScopesCol.identifier(),
+			// okta
+			OktaIDCol.identifier(),
+			OktaIssuerCol.identifier(),
+			OktaClientIDCol.identifier(),
+			OktaClientSecretCol.identifier(),
+			OktaScopesCol.identifier(),
+			// bitbucket
+			BitbucketIDCol.identifier(),
+			BitbucketClientIDCol.identifier(),
+			BitbucketClientSecretCol.identifier(),
+			BitbucketScopesCol.identifier(),
+			// discord
+			DiscordIDCol.identifier(),
+			DiscordClientIDCol.identifier(),
+			DiscordClientSecretCol.identifier(),
+			DiscordScopesCol.identifier(),
 		).From(idpTemplateTable.identifier()).
 			LeftJoin(join(OAuthIDCol, IDPTemplateIDCol)).
 			LeftJoin(join(OIDCIDCol, IDPTemplateIDCol)).
@@ -938,7 +1158,12 @@ func prepareIDPTemplateByIDQuery(ctx context.Context, db prepareDatabase) (sq.Se
 			LeftJoin(join(GoogleIDCol, IDPTemplateIDCol)).
 			LeftJoin(join(SAMLIDCol, IDPTemplateIDCol)).
 			LeftJoin(join(LDAPIDCol, IDPTemplateIDCol)).
-			LeftJoin(join(AppleIDCol, IDPTemplateIDCol) + db.Timetravel(call.Took(ctx))).
+			LeftJoin(join(AppleIDCol, IDPTemplateIDCol)).
+			LeftJoin(join(ADFSIDCol, IDPTemplateIDCol)).
+			LeftJoin(join(KeycloakIDCol, IDPTemplateIDCol)).
+			LeftJoin(join(OktaIDCol, IDPTemplateIDCol)).
+			LeftJoin(join(BitbucketIDCol, IDPTemplateIDCol)).
+			LeftJoin(join(DiscordIDCol, IDPTemplateIDCol) + db.Timetravel(call.Took(ctx))).
 			PlaceholderFormat(sq.Dollar),
 		func(row *sql.Row) (*IDPTemplate, error) {
 			idpTemplate := new(IDPTemplate)
@@ -953,6 +1178,8 @@ func prepareIDPTemplateByIDQuery(ctx context.Context, db prepareDatabase) (sq.Se
 			oauthUserEndpoint := sql.NullString{}
 			oauthScopes := database.TextArray[string]{}
 			oauthIDAttribute := sql.NullString{}
+			oauthUsePKCE := sql.NullBool{}
+			oauthCodeVerifierKey := new(crypto.CryptoValue)
 
 			oidcID := sql.NullString{}
 			oidcIssuer := sql.NullString{}
@@ -1043,6 +1270,34 @@ func prepareIDPTemplateByIDQuery(ctx context.Context, db prepareDatabase) (sq.Se
 			applePrivateKey := new(crypto.CryptoValue)
 			appleScopes := database.TextArray[string]{}
 
+			adfsID := sql.NullString{}
+			adfsIssuer := sql.NullString{}
+			adfsClientID := sql.NullString{}
+			adfsClientSecret := new(crypto.CryptoValue)
+			adfsScopes := database.TextArray[string]{}
+
+			keycloakID := sql.NullString{}
+			keycloakIssuer := sql.NullString{}
+			keycloakClientID := sql.NullString{}
+			keycloakClientSecret := new(crypto.CryptoValue)
+			keycloakScopes := database.TextArray[string]{}
+
+			oktaID := sql.NullString{}
+			oktaIssuer := sql.NullString{}
+			oktaClientID := sql.NullString{}
+			oktaClientSecret := new(crypto.CryptoValue)
+			oktaScopes := database.TextArray[string]{}
+
+			bitbucketID := sql.NullString{}
+			bitbucketClientID := sql.NullString{}
+			bitbucketClientSecret := new(crypto.CryptoValue)
+			bitbucketScopes := database.TextArray[string]{}
+
+			discordID := sql.NullString{}
+			discordClientID := sql.NullString{}
+			discordClientSecret := new(crypto.CryptoValue)
+			discordScopes := database.TextArray[string]{}
+
 			err := row.Scan(
 				&idpTemplate.ID,
 				&idpTemplate.ResourceOwner,
@@ -1067,6 +1322,8 @@ func prepareIDPTemplateByIDQuery(ctx context.Context, db prepareDatabase) (sq.Se
 				&oauthUserEndpoint,
 				&oauthScopes,
 				&oauthIDAttribute,
+				&oauthUsePKCE,
+				&oauthCodeVerifierKey,
 				// oidc
 				&oidcID,
 				&oidcIssuer,
@@ -1156,6 +1413,34 @@ func prepareIDPTemplateByIDQuery(ctx context.Context, db prepareDatabase) (sq.Se
 				&appleKeyID,
 				&applePrivateKey,
 				&appleScopes,
+				// adfs
+				&adfsID,
+				&adfsIssuer,
+				&adfsClientID,
+				&adfsClientSecret,
+				&adfsScopes,
+				// keycloak
+				&keycloakID,
+				&keycloakIssuer,
+				&keycloakClientID,
+				&keycloakClientSecret,
+				&keycloakScopes,
+				// okta
+				&oktaID,
+				&oktaIssuer,
+				&oktaClientID,
+				&oktaClientSecret,
+				&oktaScopes,
+				// bitbucket
+				&bitbucketID,
+				&bitbucketClientID,
+				&bitbucketClientSecret,
+				&bitbucketScopes,
+				// discord
+				&discordID,
+				&discordClientID,
+				&discordClientSecret,
+				&discordScopes,
 			)
 			if err != nil {
 				if errors.Is(err, sql.ErrNoRows) {
@@ -1176,6 +1461,8 @@ func prepareIDPTemplateByIDQuery(ctx context.Context, db prepareDatabase) (sq.Se
 					UserEndpoint:          oauthUserEndpoint.String,
 					Scopes:                oauthScopes,
 					IDAttribute:           oauthIDAttribute.String,
+					UsePKCE:               oauthUsePKCE.Bool,
+					CodeVerifierKey:       oauthCodeVerifierKey,
 				}
 			}
 			if oidcID.Valid {
@@ -1302,6 +1589,49 @@ func prepareIDPTemplateByIDQuery(ctx context.Context, db prepareDatabase) (sq.Se
 					Scopes:     appleScopes,
 				}
 			}
+			if adfsID.Valid {
+				idpTemplate.ADFSIDPTemplate = &ADFSIDPTemplate{
+					IDPID:        adfsID.String,
+					Issuer:       adfsIssuer.String,
+					ClientID:     adfsClientID.String,
+					ClientSecret: adfsClientSecret,
+					Scopes:       adfsScopes,
+				}
+			}
+			if keycloakID.Valid {
+				idpTemplate.KeycloakIDPTemplate = &KeycloakIDPTemplate{
+					IDPID:        keycloakID.String,
+					Issuer:       keycloakIssuer.String,
+					ClientID:     keycloakClientID.String,
+					ClientSecret: keycloakClientSecret,
+					Scopes:       keycloakScopes,
+				}
+			}
+			if oktaID.Valid {
+				idpTemplate.OktaIDPTemplate = &OktaIDPTemplate{
+					IDPID:        oktaID.String,
+					Issuer:       oktaIssuer.String,
+					ClientID:     oktaClientID.String,
+					ClientSecret: oktaClientSecret,
+					Scopes:       oktaScopes,
+				}
+			}
+			if bitbucketID.Valid {
+				idpTemplate.BitbucketIDPTemplate = &BitbucketIDPTemplate{
+					IDPID:        bitbucketID.String,
+					ClientID:     bitbucketClientID.String,
+					ClientSecret: bitbucketClientSecret,
+					Scopes:       bitbucketScopes,
+				}
+			}
+			if discordID.Valid {
+				idpTemplate.DiscordIDPTemplate = &DiscordIDPTemplate{
+					IDPID:        discordID.String,
+					ClientID:     discordClientID.String,
+					ClientSecret: discordClientSecret,
+					Scopes:       discordScopes,
+				}
+			}
 
 			return idpTemplate, nil
 		}
@@ -1332,6 +1662,8 @@ func prepareIDPTemplatesQuery(ctx context.Context, db prepareDatabase) (sq.Selec
 			OAuthUserEndpointCol.identifier(),
 			OAuthScopesCol.identifier(),
 			OAuthIDAttributeCol.identifier(),
+			OAuthUsePKCECol.identifier(),
+			OAuthCodeVerifierKeyCol.identifier(),
 			// oidc
 			OIDCIDCol.identifier(),
 			OIDCIssuerCol.identifier(),
@@ -1421,6 +1753,34 @@ func prepareIDPTemplatesQuery(ctx context.Context, db prepareDatabase) (sq.Selec
 			AppleKeyIDCol.identifier(),
 			ApplePrivateKeyCol.identifier(),
 			AppleScopesCol.identifier(),
+			// adfs
+			ADFSIDCol.identifier(),
+			ADFSIssuerCol.identifier(),
+			ADFSClientIDCol.identifier(),
+			ADFSClientSecretCol.identifier(),
+			ADFSScopesCol.identifier(),
+			// keycloak
+			KeycloakIDCol.identifier(),
+			KeycloakIssuerCol.identifier(),
+			KeycloakClientIDCol.identifier(),
+			KeycloakClientSecretCol.identifier(),
+			KeycloakScopesCol.identifier(),
+			// okta
+			OktaIDCol.identifier(),
+			OktaIssuerCol.identifier(),
+			OktaClientIDCol.identifier(),
+			OktaClientSecretCol.identifier(),
+			OktaScopesCol.identifier(),
+			// bitbucket
+			BitbucketIDCol.identifier(),
+			BitbucketClientIDCol.identifier(),
+			BitbucketClientSecretCol.identifier(),
+			BitbucketScopesCol.identifier(),
+			// discord
+			DiscordIDCol.identifier(),
+			DiscordClientIDCol.identifier(),
+			DiscordClientSecretCol.identifier(),
+			DiscordScopesCol.identifier(),
 			// count
 			countColumn.identifier(),
 		).From(idpTemplateTable.identifier()).
@@ -1435,7 +1795,12 @@ func prepareIDPTemplatesQuery(ctx context.Context, db prepareDatabase) (sq.Selec
 			LeftJoin(join(GoogleIDCol, IDPTemplateIDCol)).
 			LeftJoin(join(SAMLIDCol, IDPTemplateIDCol)).
 			LeftJoin(join(LDAPIDCol, IDPTemplateIDCol)).
-			LeftJoin(join(AppleIDCol, IDPTemplateIDCol) + db.Timetravel(call.Took(ctx))).
+			LeftJoin(join(AppleIDCol, IDPTemplateIDCol)).
+			LeftJoin(join(ADFSIDCol, IDPTemplateIDCol)).
+			LeftJoin(join(KeycloakIDCol, IDPTemplateIDCol)).
+			LeftJoin(join(OktaIDCol, IDPTemplateIDCol)).
+			LeftJoin(join(BitbucketIDCol, IDPTemplateIDCol)).
+			LeftJoin(join(DiscordIDCol, IDPTemplateIDCol) + db.Timetravel(call.Took(ctx))).
 			PlaceholderFormat(sq.Dollar),
 		func(rows *sql.Rows) (*IDPTemplates, error) {
 			templates := make([]*IDPTemplate, 0)
@@ -1453,6 +1818,8 @@ func prepareIDPTemplatesQuery(ctx context.Context, db prepareDatabase) (sq.Selec
 				oauthUserEndpoint := sql.NullString{}
 				oauthScopes := database.TextArray[string]{}
 				oauthIDAttribute := sql.NullString{}
+				oauthUsePKCE := sql.NullBool{}
+				oauthCodeVerifierKey := new(crypto.CryptoValue)
 
 				oidcID := sql.NullString{}
 				oidcIssuer := sql.NullString{}
@@ -1543,6 +1910,34 @@ func prepareIDPTemplatesQuery(ctx context.Context, db prepareDatabase) (sq.Selec
 				applePrivateKey := new(crypto.CryptoValue)
 				appleScopes := database.TextArray[string]{}
 
+				adfsID := sql.NullString{}
+				adfsIssuer := sql.NullString{}
+				adfsClientID := sql.NullString{}
+				adfsClientSecret := new(crypto.CryptoValue)
+				adfsScopes := database.TextArray[string]{}
+
+				keycloakID := sql.NullString{}
+				keycloakIssuer := sql.NullString{}
+				keycloakClientID := sql.NullString{}
+				keycloakClientSecret := new(crypto.CryptoValue)
+				keycloakScopes := database.TextArray[string]{}
+
+				oktaID := sql.NullString{}
+				oktaIssuer := sql.NullString{}
+				oktaClientID := sql.NullString{}
+				oktaClientSecret := new(crypto.CryptoValue)
+				oktaScopes := database.TextArray[string]{}
+
+				bitbucketID := sql.NullString{}
+				bitbucketClientID := sql.NullString{}
+				bitbucketClientSecret := new(crypto.CryptoValue)
+				bitbucketScopes := database.TextArray[string]{}
+
+				discordID := sql.NullString{}
+				discordClientID := sql.NullString{}
+				discordClientSecret := new(crypto.CryptoValue)
+				discordScopes := database.TextArray[string]{}
+
 				err := rows.Scan(
 					&idpTemplate.ID,
 					&idpTemplate.ResourceOwner,
@@ -1567,6 +1962,8 @@ func prepareIDPTemplatesQuery(ctx context.Context, db prepareDatabase) (sq.Selec
 					&oauthUserEndpoint,
 					&oauthScopes,
 					&oauthIDAttribute,
+					&oauthUsePKCE,
+					&oauthCodeVerifierKey,
 					// oidc
 					&oidcID,
 					&oidcIssuer,
@@ -1656,6 +2053,34 @@ func prepareIDPTemplatesQuery(ctx context.Context, db prepareDatabase) (sq.Selec
 					&appleKeyID,
 					&applePrivateKey,
 					&appleScopes,
+					// adfs
+					&adfsID,
+					&adfsIssuer,
+					&adfsClientID,
+					&adfsClientSecret,
+					&adfsScopes,
+					// keycloak
+					&keycloakID,
+					&keycloakIssuer,
+					&keycloakClientID,
+					&keycloakClientSecret,
+					&keycloakScopes,
+					// okta
+					&oktaID,
+					&oktaIssuer,
+					&oktaClientID,
+					&oktaClientSecret,
+					&oktaScopes,
+					// bitbucket
+					&bitbucketID,
+					&bitbucketClientID,
+					&bitbucketClientSecret,
+					&bitbucketScopes,
+					// discord
+					&discordID,
+					&discordClientID,
+					&discordClientSecret,
+					&discordScopes,
 					&count,
 				)
 
@@ -1675,6 +2100,8 @@ func prepareIDPTemplatesQuery(ctx context.Context, db prepareDatabase) (sq.Selec
 						UserEndpoint:          oauthUserEndpoint.String,
 						Scopes:                oauthScopes,
 						IDAttribute:           oauthIDAttribute.String,
+						UsePKCE:               oauthUsePKCE.Bool,
+						CodeVerifierKey:       oauthCodeVerifierKey,
 					}
 				}
 				if oidcID.Valid {
@@ -1801,6 +2228,49 @@ func prepareIDPTemplatesQuery(ctx context.Context, db prepareDatabase) (sq.Selec
 						Scopes:     appleScopes,
 					}
 				}
+				if adfsID.Valid {
+					idpTemplate.ADFSIDPTemplate = &ADFSIDPTemplate{
+						IDPID:        adfsID.String,
+						Issuer:       adfsIssuer.String,
+						ClientID:     adfsClientID.String,
+						ClientSecret: adfsClientSecret,
+						Scopes:       adfsScopes,
+					}
+				}
+				if keycloakID.Valid {
+					idpTemplate.KeycloakIDPTemplate = &KeycloakIDPTemplate{
+						IDPID:        keycloakID.String,
+						Issuer:       keycloakIssuer.String,
+						ClientID:     keycloakClientID.String,
+						ClientSecret: keycloakClientSecret,
+						Scopes:       keycloakScopes,
+					}
+				}
+				if oktaID.Valid {
+					idpTemplate.OktaIDPTemplate = &OktaIDPTemplate{
+						IDPID:        oktaID.String,
+						Issuer:       oktaIssuer.String,
+						ClientID:     oktaClientID.String,
+						ClientSecret: oktaClientSecret,
+						Scopes:       oktaScopes,
+					}
+				}
+				if bitbucketID.Valid {
+					idpTemplate.BitbucketIDPTemplate = &BitbucketIDPTemplate{
+						IDPID:        bitbucketID.String,
+						ClientID:     bitbucketClientID.String,
+						ClientSecret: bitbucketClientSecret,
+						Scopes:       bitbucketScopes,
+					}
+				}
+				if discordID.Valid {
+					idpTemplate.DiscordIDPTemplate = &DiscordIDPTemplate{
+						IDPID:        discordID.String,
+						ClientID:     discordClientID.String,
+						ClientSecret: discordClientSecret,
+						Scopes:       discordScopes,
+					}
+				}
 				templates = append(templates, idpTemplate)
 			}
 
@@ -39,6 +39,8 @@ var (
 		` projections.idp_templates6_oauth2.user_endpoint,` +
 		` projections.idp_templates6_oauth2.scopes,` +
 		` projections.idp_templates6_oauth2.id_attribute,` +
+		` projections.idp_templates6_oauth2.use_pkce,` +
+		` projections.idp_templates6_oauth2.code_verifier_key,` +
 		// oidc
 		` projections.idp_templates6_oidc.idp_id,` +
 		` projections.idp_templates6_oidc.issuer,` +
@@ -127,7 +129,35 @@ var (
 		` projections.idp_templates6_apple.team_id,` +
 		` projections.idp_templates6_apple.key_id,` +
 		` projections.idp_templates6_apple.private_key,` +
-		` projections.idp_templates6_apple.scopes` +
+		` projections.idp_templates6_apple.scopes,` +
+		// adfs
+		` projections.idp_templates6_adfs.idp_id,` +
+		` projections.idp_templates6_adfs.issuer,` +
+		` projections.idp_templates6_adfs.client_id,` +
+		` projections.idp_templates6_adfs.client_secret,` +
+		` projections.idp_templates6_adfs.scopes,` +
+		// keycloak
+		` projections.idp_templates6_keycloak.idp_id,` +
+		` projections.idp_templates6_keycloak.issuer,` +
+		` projections.idp_templates6_keycloak.client_id,` +
+		` projections.idp_templates6_keycloak.client_secret,` +
+		` projections.idp_templates6_keycloak.scopes,` +
+		// okta
+		` projections.idp_templates6_okta.idp_id,` +
+		` projections.idp_templates6_okta.issuer,` +
+		` projections.idp_templates6_okta.client_id,` +
+		` projections.idp_templates6_okta.client_secret,` +
+		` projections.idp_templates6_okta.scopes,` +
+		// bitbucket
+		` projections.idp_templates6_bitbucket.idp_id,` +
+		` projections.idp_templates6_bitbucket.client_id,` +
+		` projections.idp_templates6_bitbucket.client_secret,` +
+		` projections.idp_templates6_bitbucket.scopes,` +
+		// discord
+		` projections.idp_templates6_discord.idp_id,` +
+		` projections.idp_templates6_discord.client_id,` +
+		` projections.idp_templates6_discord.client_secret,` +
+		` projections.idp_templates6_discord.scopes` +
 		` FROM projections.idp_templates6` +
 		` LEFT JOIN projections.idp_templates6_oauth2 ON projections.idp_templates6.id = projections.idp_templates6_oauth2.idp_id AND projections.idp_templates6.instance_id = projections.idp_templates6_oauth2.instance_id` +
 		` LEFT JOIN projections.idp_templates6_oidc ON projections.idp_templates6.id = projections.idp_templates6_oidc.idp_id AND projections.idp_templates6.instance_id = projections.idp_templates6_oidc.instance_id` +
@@ -141,6 +171,11 @@ var (
 		` LEFT JOIN projections.idp_templates6_saml ON projections.idp_templates6.id = projections.idp_templates6_saml.idp_id AND projections.idp_templates6.instance_id = projections.idp_templates6_saml.instance_id` +
 		` LEFT JOIN projections.idp_templates6_ldap2 ON projections.idp_templates6.id = projections.idp_templates6_ldap2.idp_id AND projections.idp_templates6.instance_id = projections.idp_templates6_ldap2.instance_id` +
 		` LEFT JOIN projections.idp_templates6_apple ON projections.idp_templates6.id = projections.idp_templates6_apple.idp_id AND projections.idp_templates6.instance_id = projections.idp_templates6_apple.instance_id` +
+		` LEFT JOIN projections.idp_templates6_adfs ON projections.idp_templates6.id = projections.idp_templates6_adfs.idp_id AND projections.idp_templates6.instance_id = projections.idp_templates6_adfs.instance_id` +
+		` LEFT JOIN projections.idp_templates6_keycloak ON projections.idp_templates6.id = projections.idp_templates6_keycloak.idp_id AND projections.idp_templates6.instance_id = projections.idp_templates6_keycloak.instance_id` +
+		` LEFT JOIN projections.idp_templates6_okta ON projections.idp_templates6.id = projections.idp_templates6_okta.idp_id AND projections.idp_templates6.instance_id = projections.idp_templates6_okta.instance_id` +
+		` LEFT JOIN projections.idp_templates6_bitbucket ON projections.idp_templates6.id = projections.idp_templates6_bitbucket.idp_id AND projections.idp_templates6.instance_id = projections.idp_templates6_bitbucket.instance_id` +
+		` LEFT JOIN projections.idp_templates6_discord ON projections.idp_templates6.id = projections.idp_templates6_discord.idp_id AND projections.idp_templates6.instance_id = projections.idp_templates6_discord.instance_id` +
 		` AS OF SYSTEM TIME '-1 ms'`
 	idpTemplateCols = []string{
 		"id",
@@ -166,6 +201,8 @@ var (
 		"user_endpoint",
 		"scopes",
 		"id_attribute",
+		"use_pkce",
+		"code_verifier_key",
 		// oidc config
 		"id_id",
 		"issuer",
@@ -255,6 +292,34 @@ var (
 		"key_id",
 		"private_key",
 		"scopes",
+		// adfs config
+		"idp_id",
+		"issuer",
+		"client_id",
+		"client_secret",
+		"scopes",
+		// keycloak config
+		"idp_id",
+		"issuer",
+		"client_id",
+		"client_secret",
+		"scopes",
+		// okta config
+		"idp_id",
+		"issuer",
+		"client_id",
+		"client_secret",
+		"scopes",
+		// bitbucket config
+		"idp_id",
+		"client_id",
+		"client_secret",
+		"scopes",
+		// discord config
+		"idp_id",
+		"client_id",
+		"client_secret",
+		"scopes",
 	}
 	idpTemplatesQuery = `SELECT projections.idp_templates6.id,` +
 		` projections.idp_templates6.resource_owner,` +
@@ -279,6 +344,8 @@ var (
 		` projections.idp_templates6_oauth2.user_endpoint,` +
 		` projections.idp_templates6_oauth2.scopes,` +
 		` projections.idp_templates6_oauth2.id_attribute,` +
+		` projections.idp_templates6_oauth2.use_pkce,` +
+		` projections.idp_templates6_oauth2.code_verifier_key,` +
 		// oidc
 		` projections.idp_templates6_oidc.idp_id,` +
 		` projections.idp_templates6_oidc.issuer,` +
@@ -368,6 +435,34 @@ var (
 		` projections.idp_templates6_apple.key_id,` +
 		` projections.idp_templates6_apple.private_key,` +
 		` projections.idp_templates6_apple.scopes,` +
+		// adfs
+		` projections.idp_templates6_adfs.idp_id,` +
+		` projections.idp_templates6_adfs.issuer,` +
+		` projections.idp_templates6_adfs.client_id,` +
+		` projections.idp_templates6_adfs.client_secret,` +
+		` projections.idp_templates6_adfs.scopes,` +
+		// keycloak
+		` projections.idp_templates6_keycloak.idp_id,` +
+		` projections.idp_templates6_keycloak.issuer,` +
+		` projections.idp_templates6_keycloak.client_id,` +
+		` projections.idp_templates6_keycloak.client_secret,` +
+		` projections.idp_templates6_keycloak.scopes,` +
+		// okta
+		` projections.idp_templates6_okta.idp_id,` +
+		` projections.idp_templates6_okta.issuer,` +
+		` projections.idp_templates6_okta.client_id,` +
+		` projections.idp_templates6_okta.client_secret,` +
+		` projections.idp_templates6_okta.scopes,` +
+		// bitbucket
+		` projections.idp_templates6_bitbucket.idp_id,` +
+		` projections.idp_templates6_bitbucket.client_id,` +
+		` projections.idp_templates6_bitbucket.client_secret,` +
+		` projections.idp_templates6_bitbucket.scopes,` +
+		// discord
+		` projections.idp_templates6_discord.idp_id,` +
+		` projections.idp_templates6_discord.client_id,` +
+		` projections.idp_templates6_discord.client_secret,` +
+		` projections.idp_templates6_discord.scopes,` +
 		` COUNT(*) OVER ()` +
 		` FROM projections.idp_templates6` +
 		` LEFT JOIN projections.idp_templates6_oauth2 ON projections.idp_templates6.id = projections.idp_templates6_oauth2.idp_id AND projections.idp_templates6.instance_id = projections.idp_templates6_oauth2.instance_id` +
@@ -382,6 +477,11 @@ var (
 		` LEFT JOIN projections.idp_templates6_saml ON projections.idp_templates6.id = projections.idp_templates6_saml.idp_id AND projections.idp_templates6.instance_id = projections.idp_templates6_saml.instance_id` +
 		` LEFT JOIN projections.idp_templates6_ldap2 ON projections.idp_templates6.id = projections.idp_templates6_ldap2.idp_id AND projections.idp_templates6.instance_id = projections.idp_templates6_ldap2.instance_id` +
 		` LEFT JOIN projections.idp_templates6_apple ON projections.idp_templates6.id = projections.idp_templates6_apple.idp_id AND projections.idp_templates6.instance_id = projections.idp_templates6_apple.instance_id` +
+		` LEFT JOIN projections.idp_templates6_adfs ON projections.idp_templates6.id = projections.idp_templates6_adfs.idp_id AND projections.idp_templates6.instance_id = projections.idp_templates6_adfs.instance_id` +
+		` LEFT JOIN projections.idp_templates6_keycloak ON projections.idp_templates6.id = projections.idp_templates6_keycloak.idp_id AND projections.idp_templates6.instance_id = projections.idp_templates6_keycloak.instance_id` +
+		` LEFT JOIN projections.idp_templates6_okta ON projections.idp_templates6.id = projections.idp_templates6_okta.idp_id AND projections.idp_templates6.instance_id = projections.idp_templates6_okta.instance_id` +
+		` LEFT JOIN projections.idp_templates6_bitbucket ON projections.idp_templates6.id = projections.idp_templates6_bitbucket.idp_id AND projections.idp_templates6.instance_id = projections.idp_templates6_bitbucket.instance_id` +
+		` LEFT JOIN projections.idp_templates6_discord ON projections.idp_templates6.id = projections.idp_templates6_discord.idp_id AND projections.idp_templates6.instance_id = projections.idp_templates6_discord.instance_id` +
 		` AS OF SYSTEM TIME '-1 ms'`
 	idpTemplatesCols = []string{
 		"id",
@@ -407,6 +507,8 @@ var (
 		"user_endpoint",
 		"scopes",
 		"id_attribute",
+		"use_pkce",
+		"code_verifier_key",
 		// oidc config
 		"id_id",
 		"issuer",
@@ -496,6 +598,34 @@ var (
 		"key_id",
 		"private_key",
 		"scopes",
+		// adfs config
+		"idp_id",
+		"issuer",
+		"client_id",
+		"client_secret",
+		"scopes",
+		// keycloak config
+		"idp_id",
+		"issuer",
+		"client_id",
+		"client_secret",
+		"scopes",
+		// okta config
+		"idp_id",
+		"issuer",
+		"client_id",
+		"client_secret",
+		"scopes",
+		// bitbucket config
+		"idp_id",
+		"client_id",
+		"client_secret",
+		"scopes",
+		// discord config
+		"idp_id",
+		"client_id",
+		"client_secret",
+		"scopes",
 		"count",
 	}
 )
@@ -560,6 +690,8 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						"user",
 						database.TextArray[string]{"profile"},
 						"id-attribute",
+						true,
+						nil,
 						// oidc
 						nil,
 						nil,
@@ -649,6 +781,34 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						// adfs
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// keycloak
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// okta
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// bitbucket
+						nil,
+						nil,
+						nil,
+						nil,
+						// discord
+						nil,
+						nil,
+						nil,
+						nil,
 					},
 				),
 			},
@@ -676,6 +836,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 					UserEndpoint:          "user",
 					Scopes:                []string{"profile"},
 					IDAttribute:           "id-attribute",
+					UsePKCE:               true,
 				},
 			},
 		},
@@ -710,6 +871,8 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
+						nil,
 						// oidc
 						"idp-id",
 						"issuer",
@@ -799,6 +962,34 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						// adfs
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// keycloak
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// okta
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// bitbucket
+						nil,
+						nil,
+						nil,
+						nil,
+						// discord
+						nil,
+						nil,
+						nil,
+						nil,
 					},
 				),
 			},
@@ -858,6 +1049,8 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
+						nil,
 						// oidc
 						nil,
 						nil,
@@ -947,6 +1140,34 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						// adfs
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// keycloak
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// okta
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// bitbucket
+						nil,
+						nil,
+						nil,
+						nil,
+						// discord
+						nil,
+						nil,
+						nil,
+						nil,
 					},
 				),
 			},
@@ -1005,6 +1226,8 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
+						nil,
 						// oidc
 						nil,
 						nil,
@@ -1094,6 +1317,34 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						// adfs
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// keycloak
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// okta
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// bitbucket
+						nil,
+						nil,
+						nil,
+						nil,
+						// discord
+						nil,
+						nil,
+						nil,
+						nil,
 					},
 				),
 			},
@@ -1151,6 +1402,8 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
+						nil,
 						// oidc
 						nil,
 						nil,
@@ -1240,6 +1493,34 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						// adfs
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// keycloak
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// okta
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// bitbucket
+						nil,
+						nil,
+						nil,
+						nil,
+						// discord
+						nil,
+						nil,
+						nil,
+						nil,
 					},
 				),
 			},
@@ -1297,6 +1578,8 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
+						nil,
 						// oidc
 						nil,
 						nil,
@@ -1386,6 +1669,34 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						// adfs
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// keycloak
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// okta
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// bitbucket
+						nil,
+						nil,
+						nil,
+						nil,
+						// discord
+						nil,
+						nil,
+						nil,
+						nil,
 					},
 				),
 			},
@@ -1444,6 +1755,8 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
+						nil,
 						// oidc
 						nil,
 						nil,
@@ -1533,6 +1846,34 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						// adfs
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// keycloak
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// okta
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// bitbucket
+						nil,
+						nil,
+						nil,
+						nil,
+						// discord
+						nil,
+						nil,
+						nil,
+						nil,
 					},
 				),
 			},
@@ -1590,6 +1931,8 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
+						nil,
 						// oidc
 						nil,
 						nil,
@@ -1679,6 +2022,34 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						// adfs
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// keycloak
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// okta
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// bitbucket
+						nil,
+						nil,
+						nil,
+						nil,
+						// discord
+						nil,
+						nil,
+						nil,
+						nil,
 					},
 				),
 			},
@@ -1740,6 +2111,8 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
+						nil,
 						// oidc
 						nil,
 						nil,
@@ -1829,13 +2202,41 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
-					},
-				),
-			},
-			object: &IDPTemplate{
-				CreationDate:      testNow,
-				ChangeDate:        testNow,
-				Sequence:          20211109,
+						// adfs
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// keycloak
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// okta
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// bitbucket
+						nil,
+						nil,
+						nil,
+						nil,
+						// discord
+						nil,
+						nil,
+						nil,
+						nil,
+					},
+				),
+			},
+			object: &IDPTemplate{
+				CreationDate:      testNow,
+				ChangeDate:        testNow,
+				Sequence:          20211109,
 				ResourceOwner:     "ro",
 				ID:                "idp-id",
 				State:             domain.IDPStateActive,
@@ -1906,93 +2307,478 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
-						// oidc
+						nil,
+						nil,
+						// oidc
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// jwt
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// azure
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// github
+						nil,
+						nil,
+						nil,
+						nil,
+						// github enterprise
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// gitlab
+						nil,
+						nil,
+						nil,
+						nil,
+						// gitlab self hosted
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// google
+						nil,
+						nil,
+						nil,
+						nil,
+						// saml
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// ldap config
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// apple
+						"idp-id",
+						"client_id",
+						"team_id",
+						"key_id",
+						nil,
+						database.TextArray[string]{"profile"},
+						// adfs
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// keycloak
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// okta
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// bitbucket
+						nil,
+						nil,
+						nil,
+						nil,
+						// discord
+						nil,
+						nil,
+						nil,
+						nil,
+					},
+				),
+			},
+			object: &IDPTemplate{
+				CreationDate:      testNow,
+				ChangeDate:        testNow,
+				Sequence:          20211109,
+				ResourceOwner:     "ro",
+				ID:                "idp-id",
+				State:             domain.IDPStateActive,
+				Name:              "idp-name",
+				Type:              domain.IDPTypeApple,
+				OwnerType:         domain.IdentityProviderTypeOrg,
+				IsCreationAllowed: true,
+				IsLinkingAllowed:  true,
+				IsAutoCreation:    true,
+				IsAutoUpdate:      true,
+				AutoLinking:       domain.AutoLinkingOptionUsername,
+				AppleIDPTemplate: &AppleIDPTemplate{
+					IDPID:      "idp-id",
+					ClientID:   "client_id",
+					TeamID:     "team_id",
+					KeyID:      "key_id",
+					PrivateKey: nil,
+					Scopes:     []string{"profile"},
+				},
+			},
+		},
+		{
+			name:    "prepareIDPTemplateByIDQuery keycloak idp",
+			prepare: prepareIDPTemplateByIDQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					regexp.QuoteMeta(idpTemplateQuery),
+					idpTemplateCols,
+					[]driver.Value{
+						"idp-id",
+						"ro",
+						testNow,
+						testNow,
+						uint64(20211109),
+						domain.IDPConfigStateActive,
+						"idp-name",
+						domain.IDPTypeKeycloak,
+						domain.IdentityProviderTypeOrg,
+						true,
+						true,
+						true,
+						true,
+						domain.AutoLinkingOptionUsername,
+						// oauth
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// oidc
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// jwt
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// azure
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// github
+						nil,
+						nil,
+						nil,
+						nil,
+						// github enterprise
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// gitlab
+						nil,
+						nil,
+						nil,
+						nil,
+						// gitlab self hosted
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// google
+						nil,
+						nil,
+						nil,
+						nil,
+						// saml
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// ldap config
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// apple
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// adfs
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// keycloak
+						"idp-id",
+						"issuer",
+						"client_id",
+						nil,
+						database.TextArray[string]{"profile"},
+						// okta
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// bitbucket
+						nil,
+						nil,
+						nil,
+						nil,
+						// discord
+						nil,
+						nil,
+						nil,
+						nil,
+					},
+				),
+			},
+			object: &IDPTemplate{
+				CreationDate:      testNow,
+				ChangeDate:        testNow,
+				Sequence:          20211109,
+				ResourceOwner:     "ro",
+				ID:                "idp-id",
+				State:             domain.IDPStateActive,
+				Name:              "idp-name",
+				Type:              domain.IDPTypeKeycloak,
+				OwnerType:         domain.IdentityProviderTypeOrg,
+				IsCreationAllowed: true,
+				IsLinkingAllowed:  true,
+				IsAutoCreation:    true,
+				IsAutoUpdate:      true,
+				AutoLinking:       domain.AutoLinkingOptionUsername,
+				KeycloakIDPTemplate: &KeycloakIDPTemplate{
+					IDPID:        "idp-id",
+					Issuer:       "issuer",
+					ClientID:     "client_id",
+					ClientSecret: nil,
+					Scopes:       []string{"profile"},
+				},
+			},
+		},
+		{
+			name:    "prepareIDPTemplateByIDQuery discord idp",
+			prepare: prepareIDPTemplateByIDQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					regexp.QuoteMeta(idpTemplateQuery),
+					idpTemplateCols,
+					[]driver.Value{
+						"idp-id",
+						"ro",
+						testNow,
+						testNow,
+						uint64(20211109),
+						domain.IDPConfigStateActive,
+						"idp-name",
+						domain.IDPTypeDiscord,
+						domain.IdentityProviderTypeOrg,
+						true,
+						true,
+						true,
+						true,
+						domain.AutoLinkingOptionUsername,
+						// oauth
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// oidc
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// jwt
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// azure
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// github
+						nil,
+						nil,
+						nil,
+						nil,
+						// github enterprise
+						nil,
+						nil,
+						nil,
+						nil,
 						nil,
 						nil,
 						nil,
+						// gitlab
 						nil,
 						nil,
 						nil,
-						// jwt
 						nil,
+						// gitlab self hosted
 						nil,
 						nil,
 						nil,
 						nil,
-						// azure
 						nil,
+						// google
 						nil,
 						nil,
 						nil,
 						nil,
+						// saml
 						nil,
-						// github
 						nil,
 						nil,
 						nil,
 						nil,
-						// github enterprise
 						nil,
 						nil,
 						nil,
+						// ldap config
 						nil,
 						nil,
 						nil,
 						nil,
-						// gitlab
 						nil,
 						nil,
 						nil,
 						nil,
-						// gitlab self hosted
 						nil,
 						nil,
 						nil,
 						nil,
 						nil,
-						// google
 						nil,
 						nil,
 						nil,
 						nil,
-						// saml
 						nil,
 						nil,
 						nil,
 						nil,
 						nil,
 						nil,
+						// apple
 						nil,
 						nil,
-						// ldap config
 						nil,
 						nil,
 						nil,
 						nil,
+						// adfs
 						nil,
 						nil,
 						nil,
 						nil,
 						nil,
+						// keycloak
 						nil,
 						nil,
 						nil,
 						nil,
 						nil,
+						// okta
 						nil,
 						nil,
 						nil,
 						nil,
 						nil,
+						// bitbucket
 						nil,
 						nil,
 						nil,
 						nil,
-						// apple
+						// discord
 						"idp-id",
 						"client_id",
-						"team_id",
-						"key_id",
 						nil,
 						database.TextArray[string]{"profile"},
 					},
@@ -2006,20 +2792,18 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 				ID:                "idp-id",
 				State:             domain.IDPStateActive,
 				Name:              "idp-name",
-				Type:              domain.IDPTypeApple,
+				Type:              domain.IDPTypeDiscord,
 				OwnerType:         domain.IdentityProviderTypeOrg,
 				IsCreationAllowed: true,
 				IsLinkingAllowed:  true,
 				IsAutoCreation:    true,
 				IsAutoUpdate:      true,
 				AutoLinking:       domain.AutoLinkingOptionUsername,
-				AppleIDPTemplate: &AppleIDPTemplate{
-					IDPID:      "idp-id",
-					ClientID:   "client_id",
-					TeamID:     "team_id",
-					KeyID:      "key_id",
-					PrivateKey: nil,
-					Scopes:     []string{"profile"},
+				DiscordIDPTemplate: &DiscordIDPTemplate{
+					IDPID:        "idp-id",
+					ClientID:     "client_id",
+					ClientSecret: nil,
+					Scopes:       []string{"profile"},
 				},
 			},
 		},
@@ -2054,6 +2838,8 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
+						nil,
 						// oidc
 						nil,
 						nil,
@@ -2143,6 +2929,34 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						// adfs
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// keycloak
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// okta
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// bitbucket
+						nil,
+						nil,
+						nil,
+						nil,
+						// discord
+						nil,
+						nil,
+						nil,
+						nil,
 					},
 				),
 			},
@@ -2230,6 +3044,8 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
 							// oidc
 							nil,
 							nil,
@@ -2319,6 +3135,34 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							// adfs
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// keycloak
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// okta
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// bitbucket
+							nil,
+							nil,
+							nil,
+							nil,
+							// discord
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -2405,6 +3249,8 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
 							// oidc
 							nil,
 							nil,
@@ -2494,6 +3340,34 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							// adfs
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// keycloak
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// okta
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// bitbucket
+							nil,
+							nil,
+							nil,
+							nil,
+							// discord
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -2554,6 +3428,8 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
 							// oidc
 							nil,
 							nil,
@@ -2643,6 +3519,34 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							// adfs
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// keycloak
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// okta
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// bitbucket
+							nil,
+							nil,
+							nil,
+							nil,
+							// discord
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 						{
 							"idp-id-saml",
@@ -2668,6 +3572,8 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
 							// oidc
 							nil,
 							nil,
@@ -2757,6 +3663,34 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							// adfs
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// keycloak
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// okta
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// bitbucket
+							nil,
+							nil,
+							nil,
+							nil,
+							// discord
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 						{
 							"idp-id-google",
@@ -2782,6 +3716,8 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
 							// oidc
 							nil,
 							nil,
@@ -2871,6 +3807,34 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							// adfs
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// keycloak
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// okta
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// bitbucket
+							nil,
+							nil,
+							nil,
+							nil,
+							// discord
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 						{
 							"idp-id-oauth",
@@ -2896,6 +3860,8 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							"user",
 							database.TextArray[string]{"profile"},
 							"id-attribute",
+							false,
+							nil,
 							// oidc
 							nil,
 							nil,
@@ -2985,6 +3951,34 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							// adfs
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// keycloak
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// okta
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// bitbucket
+							nil,
+							nil,
+							nil,
+							nil,
+							// discord
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 						{
 							"idp-id-oidc",
@@ -3010,6 +4004,8 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
 							// oidc
 							"idp-id-oidc",
 							"issuer",
@@ -3099,6 +4095,34 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							// adfs
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// keycloak
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// okta
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// bitbucket
+							nil,
+							nil,
+							nil,
+							nil,
+							// discord
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 						{
 							"idp-id-jwt",
@@ -3124,6 +4148,8 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
 							// oidc
 							nil,
 							nil,
@@ -3213,6 +4239,34 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							// adfs
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// keycloak
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// okta
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// bitbucket
+							nil,
+							nil,
+							nil,
+							nil,
+							// discord
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -430,6 +430,7 @@ type authzInstance struct {
 	block               *bool
 	auditLogRetention   *time.Duration
 	features            feature.Features
+	administratorRoles  []authz.RoleMapping
 }
 
 type csp struct {
@@ -492,6 +493,10 @@ func (i *authzInstance) Features() feature.Features {
 	return i.features
 }
 
+func (i *authzInstance) AdministratorRoles() []authz.RoleMapping {
+	return i.administratorRoles
+}
+
 func scanAuthzInstance(host, domain string) (*authzInstance, func(row *sql.Row) error) {
 	instance := &authzInstance{
 		host:   host,
@@ -505,6 +510,7 @@ func scanAuthzInstance(host, domain string) (*authzInstance, func(row *sql.Row)
 			auditLogRetention     database.NullDuration
 			block                 sql.NullBool
 			features              []byte
+			administratorRoles    []byte
 		)
 		err := row.Scan(
 			&instance.id,
@@ -519,6 +525,7 @@ func scanAuthzInstance(host, domain string) (*authzInstance, func(row *sql.Row)
 			&auditLogRetention,
 			&block,
 			&features,
+			&administratorRoles,
 		)
 		if errors.Is(err, sql.ErrNoRows) {
 			return zerrors.ThrowNotFound(nil, "QUERY-1kIjX", "Errors.IAM.NotFound")
@@ -535,6 +542,11 @@ func scanAuthzInstance(host, domain string) (*authzInstance, func(row *sql.Row)
 		}
 		instance.csp.enableIframeEmbedding = enableIframeEmbedding.Bool
 		instance.enableImpersonation = enableImpersonation.Bool
+		if len(administratorRoles) > 0 {
+			if err = json.Unmarshal(administratorRoles, &instance.administratorRoles); err != nil {
+				return zerrors.ThrowInternal(err, "QUERY-Ar9js", "Errors.Internal")
+			}
+		}
 		if len(features) == 0 {
 			return nil
 		}
@@ -20,9 +20,14 @@ select
 	s.enable_impersonation,
     l.audit_log_retention,
     l.block,
-	f.features
+	f.features,
+	(
+		select json_agg(json_build_object('role', r.role_key, 'permissions', r.permissions))
+		from projections.administrator_roles r
+		where r.instance_id = i.id
+	) administrator_roles
 from projections.instances i
-left join projections.security_policies2 s on i.id = s.instance_id
+left join projections.security_policies3 s on i.id = s.instance_id
 left join projections.limits l on i.id = l.instance_id
 left join features f on i.id = f.instance_id
 where i.id = $1;
@@ -15,31 +15,32 @@ import (
 )
 
 var (
-	loginPolicyQuery = `SELECT projections.login_policies5.aggregate_id,` +
-		` projections.login_policies5.creation_date,` +
-		` projections.login_policies5.change_date,` +
-		` projections.login_policies5.sequence,` +
-		` projections.login_policies5.allow_register,` +
-		` projections.login_policies5.allow_username_password,` +
-		` projections.login_policies5.allow_external_idps,` +
-		` projections.login_policies5.force_mfa,` +
-		` projections.login_policies5.force_mfa_local_only,` +
-		` projections.login_policies5.second_factors,` +
-		` projections.login_policies5.multi_factors,` +
-		` projections.login_policies5.passwordless_type,` +
-		` projections.login_policies5.is_default,` +
-		` projections.login_policies5.hide_password_reset,` +
-		` projections.login_policies5.ignore_unknown_usernames,` +
-		` projections.login_policies5.allow_domain_discovery,` +
-		` projections.login_policies5.disable_login_with_email,` +
-		` projections.login_policies5.disable_login_with_phone,` +
-		` projections.login_policies5.default_redirect_uri,` +
-		` projections.login_policies5.password_check_lifetime,` +
-		` projections.login_policies5.external_login_check_lifetime,` +
-		` projections.login_policies5.mfa_init_skip_lifetime,` +
-		` projections.login_policies5.second_factor_check_lifetime,` +
-		` projections.login_policies5.multi_factor_check_lifetime` +
-		` FROM projections.login_policies5` +
+	loginPolicyQuery = `SELECT projections.login_policies6.aggregate_id,` +
+		` projections.login_policies6.creation_date,` +
+		` projections.login_policies6.change_date,` +
+		` projections.login_policies6.sequence,` +
+		` projections.login_policies6.allow_register,` +
+		` projections.login_policies6.allow_username_password,` +
+		` projections.login_policies6.allow_external_idps,` +
+		` projections.login_policies6.force_mfa,` +
+		` projections.login_policies6.force_mfa_local_only,` +
+		` projections.login_policies6.second_factors,` +
+		` projections.login_policies6.multi_factors,` +
+		` projections.login_policies6.passwordless_type,` +
+		` projections.login_policies6.is_default,` +
+		` projections.login_policies6.hide_password_reset,` +
+		` projections.login_policies6.ignore_unknown_usernames,` +
+		` projections.login_policies6.allow_domain_discovery,` +
+		` projections.login_policies6.disable_login_with_email,` +
+		` projections.login_policies6.disable_login_with_phone,` +
+		` projections.login_policies6.default_redirect_uri,` +
+		` projections.login_policies6.password_check_lifetime,` +
+		` projections.login_policies6.external_login_check_lifetime,` +
+		` projections.login_policies6.mfa_init_skip_lifetime,` +
+		` projections.login_policies6.second_factor_check_lifetime,` +
+		` projections.login_policies6.multi_factor_check_lifetime,` +
+		` projections.login_policies6.force_passkey_registration` +
+		` FROM projections.login_policies6` +
 		` AS OF SYSTEM TIME '-1 ms'`
 	loginPolicyCols = []string{
 		"aggregate_id",
@@ -66,17 +67,18 @@ var (
 		"mfa_init_skip_lifetime",
 		"second_factor_check_lifetime",
 		"multi_factor_check_lifetime",
+		"force_passkey_registration",
 	}
 
-	prepareLoginPolicy2FAsStmt = `SELECT projections.login_policies5.second_factors` +
-		` FROM projections.login_policies5` +
+	prepareLoginPolicy2FAsStmt = `SELECT projections.login_policies6.second_factors` +
+		` FROM projections.login_policies6` +
 		` AS OF SYSTEM TIME '-1 ms'`
 	prepareLoginPolicy2FAsCols = []string{
 		"second_factors",
 	}
 
-	prepareLoginPolicyMFAsStmt = `SELECT projections.login_policies5.multi_factors` +
-		` FROM projections.login_policies5` +
+	prepareLoginPolicyMFAsStmt = `SELECT projections.login_policies6.multi_factors` +
+		` FROM projections.login_policies6` +
 		` AS OF SYSTEM TIME '-1 ms'`
 	prepareLoginPolicyMFAsCols = []string{
 		"multi_factors",
@@ -145,6 +147,7 @@ func Test_LoginPolicyPrepares(t *testing.T) {
 						&duration,
 						&duration,
 						&duration,
+						true,
 					},
 				),
 			},
@@ -173,6 +176,7 @@ func Test_LoginPolicyPrepares(t *testing.T) {
 				MFAInitSkipLifetime:        database.Duration(duration),
 				SecondFactorCheckLifetime:  database.Duration(duration),
 				MultiFactorCheckLifetime:   database.Duration(duration),
+				ForcePasskeyRegistration:   true,
 			},
 		},
 		{
@@ -8,9 +8,9 @@ import (
 	"github.com/zitadel/zitadel/internal/domain"
 )
 
-func (q *Queries) GetIAMMemberRoles() []string {
+func (q *Queries) GetIAMMemberRoles(ctx context.Context) []string {
 	roles := make([]string, 0)
-	for _, roleMap := range q.zitadelRoles {
+	for _, roleMap := range q.memberRoles(ctx) {
 		if strings.HasPrefix(roleMap.Role, "IAM") {
 			roles = append(roles, roleMap.Role)
 		}
@@ -18,9 +18,9 @@ func (q *Queries) GetIAMMemberRoles() []string {
 	return roles
 }
 
-func (q *Queries) GetOrgMemberRoles(isGlobal bool) []string {
+func (q *Queries) GetOrgMemberRoles(ctx context.Context, isGlobal bool) []string {
 	roles := make([]string, 0)
-	for _, roleMap := range q.zitadelRoles {
+	for _, roleMap := range q.memberRoles(ctx) {
 		if strings.HasPrefix(roleMap.Role, "ORG") {
 			roles = append(roles, roleMap.Role)
 		}
@@ -38,7 +38,7 @@ func (q *Queries) GetProjectMemberRoles(ctx context.Context) ([]string, error) {
 	}
 	roles := make([]string, 0)
 	defaultOrg := authz.GetCtxData(ctx).OrgID == instance.DefaultOrgID
-	for _, roleMap := range q.zitadelRoles {
+	for _, roleMap := range q.memberRoles(ctx) {
 		if strings.HasPrefix(roleMap.Role, "PROJECT") && !strings.HasPrefix(roleMap.Role, "PROJECT_GRANT") {
 			if defaultOrg && !strings.HasSuffix(roleMap.Role, "GLOBAL") {
 				continue
@@ -49,12 +49,23 @@ func (q *Queries) GetProjectMemberRoles(ctx context.Context) ([]string, error) {
 	return roles, nil
 }
 
-func (q *Queries) GetProjectGrantMemberRoles() []string {
+func (q *Queries) GetProjectGrantMemberRoles(ctx context.Context) []string {
 	roles := make([]string, 0)
-	for _, roleMap := range q.zitadelRoles {
+	for _, roleMap := range q.memberRoles(ctx) {
 		if strings.HasPrefix(roleMap.Role, "PROJECT_GRANT") {
 			roles = append(roles, roleMap.Role)
 		}
 	}
 	return roles
 }
+
+// memberRoles returns the roles of the config and the custom roles of the instance.
+func (q *Queries) memberRoles(ctx context.Context) []authz.RoleMapping {
+	administratorRoles := authz.GetInstance(ctx).AdministratorRoles()
+	if len(administratorRoles) == 0 {
+		return q.zitadelRoles
+	}
+	roles := make([]authz.RoleMapping, 0, len(q.zitadelRoles)+len(administratorRoles))
+	roles = append(roles, q.zitadelRoles...)
+	return append(roles, administratorRoles...)
+}
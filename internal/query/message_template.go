@@ -0,0 +1,184 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type MessageTemplates struct {
+	SearchResponse
+	MessageTemplates []*MessageTemplate
+}
+
+type MessageTemplate struct {
+	AggregateID  string
+	Sequence     uint64
+	CreationDate time.Time
+	ChangeDate   time.Time
+	IsDefault    bool
+
+	// Name is either a message type or a partial (header or footer)
+	Name     string
+	Language language.Tag
+	Template []byte
+}
+
+// Template returns the template with the name in the first of the languages it is defined in.
+// Templates of the organization take precedence over the ones of the instance.
+func (t *MessageTemplates) Template(name string, langs ...language.Tag) (string, bool) {
+	if t == nil {
+		return "", false
+	}
+	for _, lang := range langs {
+		var found *MessageTemplate
+		for _, tmpl := range t.MessageTemplates {
+			if tmpl.Name != name || tmpl.Language != lang {
+				continue
+			}
+			if found == nil || found.IsDefault {
+				found = tmpl
+			}
+		}
+		if found != nil {
+			return string(found.Template), true
+		}
+	}
+	return "", false
+}
+
+var (
+	messageTemplateTable = table{
+		name:          projection.MessageTemplateTable,
+		instanceIDCol: projection.MessageTemplateInstanceIDCol,
+	}
+	MessageTemplateColAggregateID = Column{
+		name:  projection.MessageTemplateAggregateIDCol,
+		table: messageTemplateTable,
+	}
+	MessageTemplateColInstanceID = Column{
+		name:  projection.MessageTemplateInstanceIDCol,
+		table: messageTemplateTable,
+	}
+	MessageTemplateColSequence = Column{
+		name:  projection.MessageTemplateSequenceCol,
+		table: messageTemplateTable,
+	}
+	MessageTemplateColCreationDate = Column{
+		name:  projection.MessageTemplateCreationDateCol,
+		table: messageTemplateTable,
+	}
+	MessageTemplateColChangeDate = Column{
+		name:  projection.MessageTemplateChangeDateCol,
+		table: messageTemplateTable,
+	}
+	MessageTemplateColIsDefault = Column{
+		name:  projection.MessageTemplateIsDefaultCol,
+		table: messageTemplateTable,
+	}
+	MessageTemplateColName = Column{
+		name:  projection.MessageTemplateNameCol,
+		table: messageTemplateTable,
+	}
+	MessageTemplateColLanguage = Column{
+		name:  projection.MessageTemplateLanguageCol,
+		table: messageTemplateTable,
+	}
+	MessageTemplateColTemplate = Column{
+		name:  projection.MessageTemplateTemplateCol,
+		table: messageTemplateTable,
+	}
+)
+
+// MessageTemplatesByOrg returns the custom message templates of the organization and the instance.
+// Passing the ID of the instance as orgID returns the templates of the instance only.
+func (q *Queries) MessageTemplatesByOrg(ctx context.Context, orgID string) (templates *MessageTemplates, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	stmt, scan := prepareMessageTemplatesQuery(ctx, q.client)
+	query, args, err := stmt.Where(
+		sq.And{
+			sq.Eq{MessageTemplateColInstanceID.identifier(): instanceID},
+			sq.Or{
+				sq.Eq{MessageTemplateColAggregateID.identifier(): orgID},
+				sq.Eq{MessageTemplateColAggregateID.identifier(): instanceID},
+			},
+		}).
+		OrderBy(MessageTemplateColIsDefault.identifier(), MessageTemplateColName.identifier(), MessageTemplateColLanguage.identifier()).
+		ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Mt1sq", "Errors.Query.SQLStatement")
+	}
+
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		templates, err = scan(rows)
+		return err
+	}, query, args...)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Mt2ex", "Errors.Internal")
+	}
+
+	templates.State, err = q.latestState(ctx, messageTemplateTable)
+	return templates, err
+}
+
+func prepareMessageTemplatesQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) (*MessageTemplates, error)) {
+	return sq.Select(
+			MessageTemplateColAggregateID.identifier(),
+			MessageTemplateColSequence.identifier(),
+			MessageTemplateColCreationDate.identifier(),
+			MessageTemplateColChangeDate.identifier(),
+			MessageTemplateColIsDefault.identifier(),
+			MessageTemplateColName.identifier(),
+			MessageTemplateColLanguage.identifier(),
+			MessageTemplateColTemplate.identifier(),
+			countColumn.identifier()).
+			From(messageTemplateTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*MessageTemplates, error) {
+			templates := make([]*MessageTemplate, 0)
+			var count uint64
+			for rows.Next() {
+				template := new(MessageTemplate)
+				lang := ""
+				err := rows.Scan(
+					&template.AggregateID,
+					&template.Sequence,
+					&template.CreationDate,
+					&template.ChangeDate,
+					&template.IsDefault,
+					&template.Name,
+					&lang,
+					&template.Template,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				template.Language = language.Make(lang)
+				templates = append(templates, template)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Mt3cl", "Errors.Query.CloseRows")
+			}
+
+			return &MessageTemplates{
+				MessageTemplates: templates,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
@@ -0,0 +1,184 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	prepareMessageTemplatesStmt = `SELECT projections.message_templates.aggregate_id,` +
+		` projections.message_templates.sequence,` +
+		` projections.message_templates.creation_date,` +
+		` projections.message_templates.change_date,` +
+		` projections.message_templates.is_default,` +
+		` projections.message_templates.name,` +
+		` projections.message_templates.language,` +
+		` projections.message_templates.template,` +
+		` COUNT(*) OVER ()` +
+		` FROM projections.message_templates` +
+		` AS OF SYSTEM TIME '-1 ms'`
+	prepareMessageTemplatesCols = []string{
+		"aggregate_id",
+		"sequence",
+		"creation_date",
+		"change_date",
+		"is_default",
+		"name",
+		"language",
+		"template",
+		"count",
+	}
+)
+
+func Test_MessageTemplatePrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareMessageTemplatesQuery no result",
+			prepare: prepareMessageTemplatesQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareMessageTemplatesStmt),
+					nil,
+					nil,
+				),
+				err: func(err error) (error, bool) {
+					if !zerrors.IsNotFound(err) {
+						return fmt.Errorf("err should be zitadel.NotFoundError got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: &MessageTemplates{MessageTemplates: []*MessageTemplate{}},
+		},
+		{
+			name:    "prepareMessageTemplatesQuery one result",
+			prepare: prepareMessageTemplatesQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareMessageTemplatesStmt),
+					prepareMessageTemplatesCols,
+					[][]driver.Value{
+						{
+							"agg-id",
+							uint64(20211109),
+							testNow,
+							testNow,
+							false,
+							"InitCode",
+							"en",
+							[]byte("{{.Text}}"),
+						},
+					},
+				),
+			},
+			object: &MessageTemplates{
+				SearchResponse: SearchResponse{
+					Count: 1,
+				},
+				MessageTemplates: []*MessageTemplate{
+					{
+						AggregateID:  "agg-id",
+						CreationDate: testNow,
+						ChangeDate:   testNow,
+						Sequence:     20211109,
+						IsDefault:    false,
+						Name:         "InitCode",
+						Language:     language.English,
+						Template:     []byte("{{.Text}}"),
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareMessageTemplatesQuery sql err",
+			prepare: prepareMessageTemplatesQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareMessageTemplatesStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*MessageTemplates)(nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err, defaultPrepareArgs...)
+		})
+	}
+}
+
+func TestMessageTemplates_Template(t *testing.T) {
+	templates := &MessageTemplates{
+		MessageTemplates: []*MessageTemplate{
+			{IsDefault: true, Name: "InitCode", Language: language.English, Template: []byte("instance en")},
+			{IsDefault: true, Name: "InitCode", Language: language.German, Template: []byte("instance de")},
+			{IsDefault: false, Name: "InitCode", Language: language.English, Template: []byte("org en")},
+		},
+	}
+	tests := []struct {
+		name      string
+		tmplName  string
+		langs     []language.Tag
+		want      string
+		wantFound bool
+	}{
+		{
+			name:      "organization before instance",
+			tmplName:  "InitCode",
+			langs:     []language.Tag{language.English},
+			want:      "org en",
+			wantFound: true,
+		},
+		{
+			name:      "instance",
+			tmplName:  "InitCode",
+			langs:     []language.Tag{language.German, language.English},
+			want:      "instance de",
+			wantFound: true,
+		},
+		{
+			name:      "fallback language",
+			tmplName:  "InitCode",
+			langs:     []language.Tag{language.French, language.English},
+			want:      "org en",
+			wantFound: true,
+		},
+		{
+			name:     "not found",
+			tmplName: "header",
+			langs:    []language.Tag{language.English},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, found := templates.Template(tt.tmplName, tt.langs...)
+			assert.Equal(t, tt.wantFound, found)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
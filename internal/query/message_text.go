@@ -30,9 +30,21 @@ type MessageTexts struct {
 	VerifyPhone              MessageText
 	VerifySMSOTP             MessageText
 	VerifyEmailOTP           MessageText
+	VerifyPush               MessageText
 	DomainClaimed            MessageText
 	PasswordlessRegistration MessageText
 	PasswordChange           MessageText
+	UserDeactivationWarning  MessageText
+	UserDeletionWarning      MessageText
+	MFAAdded                 MessageText
+	MFARemoved               MessageText
+	EmailChanged             MessageText
+	PhoneChanged             MessageText
+	PasskeyAdded             MessageText
+	NewLogin                 MessageText
+	PersonalAccessTokenAdded MessageText
+	AccountLocked            MessageText
+	BreakGlassUsed           MessageText
 }
 
 type MessageText struct {
@@ -340,12 +352,36 @@ func (m *MessageTexts) GetMessageTextByType(msgType string) *MessageText {
 		return &m.VerifySMSOTP
 	case domain.VerifyEmailOTPMessageType:
 		return &m.VerifyEmailOTP
+	case domain.VerifyPushMessageType:
+		return &m.VerifyPush
 	case domain.DomainClaimedMessageType:
 		return &m.DomainClaimed
 	case domain.PasswordlessRegistrationMessageType:
 		return &m.PasswordlessRegistration
 	case domain.PasswordChangeMessageType:
 		return &m.PasswordChange
+	case domain.UserDeactivationWarningMessageType:
+		return &m.UserDeactivationWarning
+	case domain.UserDeletionWarningMessageType:
+		return &m.UserDeletionWarning
+	case domain.MFAAddedMessageType:
+		return &m.MFAAdded
+	case domain.MFARemovedMessageType:
+		return &m.MFARemoved
+	case domain.EmailChangedMessageType:
+		return &m.EmailChanged
+	case domain.PhoneChangedMessageType:
+		return &m.PhoneChanged
+	case domain.PasskeyAddedMessageType:
+		return &m.PasskeyAdded
+	case domain.NewLoginMessageType:
+		return &m.NewLogin
+	case domain.PersonalAccessTokenAddedMessageType:
+		return &m.PersonalAccessTokenAdded
+	case domain.AccountLockedMessageType:
+		return &m.AccountLocked
+	case domain.BreakGlassUsedMessageType:
+		return &m.BreakGlassUsed
 	}
 	return nil
 }
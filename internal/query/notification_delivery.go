@@ -0,0 +1,200 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	notificationDeliveryTable = table{
+		name:          projection.NotificationDeliveryTable,
+		instanceIDCol: projection.NotificationDeliveryInstanceIDCol,
+	}
+	NotificationDeliveryColumnInstanceID = Column{
+		name:  projection.NotificationDeliveryInstanceIDCol,
+		table: notificationDeliveryTable,
+	}
+	NotificationDeliveryColumnUserID = Column{
+		name:  projection.NotificationDeliveryUserIDCol,
+		table: notificationDeliveryTable,
+	}
+	NotificationDeliveryColumnSequence = Column{
+		name:  projection.NotificationDeliverySequenceCol,
+		table: notificationDeliveryTable,
+	}
+	NotificationDeliveryColumnCreationDate = Column{
+		name:  projection.NotificationDeliveryCreationDateCol,
+		table: notificationDeliveryTable,
+	}
+	NotificationDeliveryColumnResourceOwner = Column{
+		name:  projection.NotificationDeliveryResourceOwnerCol,
+		table: notificationDeliveryTable,
+	}
+	NotificationDeliveryColumnChannel = Column{
+		name:  projection.NotificationDeliveryChannelCol,
+		table: notificationDeliveryTable,
+	}
+	NotificationDeliveryColumnMessageType = Column{
+		name:  projection.NotificationDeliveryMessageTypeCol,
+		table: notificationDeliveryTable,
+	}
+	NotificationDeliveryColumnProviderID = Column{
+		name:  projection.NotificationDeliveryProviderIDCol,
+		table: notificationDeliveryTable,
+	}
+	NotificationDeliveryColumnProviderMessageID = Column{
+		name:  projection.NotificationDeliveryProviderMessageIDCol,
+		table: notificationDeliveryTable,
+	}
+	NotificationDeliveryColumnCodeExpiry = Column{
+		name:  projection.NotificationDeliveryCodeExpiryCol,
+		table: notificationDeliveryTable,
+	}
+	NotificationDeliveryColumnResult = Column{
+		name:  projection.NotificationDeliveryResultCol,
+		table: notificationDeliveryTable,
+	}
+	NotificationDeliveryColumnError = Column{
+		name:  projection.NotificationDeliveryErrorCol,
+		table: notificationDeliveryTable,
+	}
+	NotificationDeliveryColumnTriggeringEventType = Column{
+		name:  projection.NotificationDeliveryTriggeringEventTypeCol,
+		table: notificationDeliveryTable,
+	}
+)
+
+type NotificationDeliveries struct {
+	SearchResponse
+	Deliveries []*NotificationDelivery
+}
+
+func (d *NotificationDeliveries) SetState(s *State) {
+	d.State = s
+}
+
+type NotificationDelivery struct {
+	UserID        string
+	CreationDate  time.Time
+	Sequence      uint64
+	ResourceOwner string
+
+	Channel             domain.NotificationType
+	MessageType         string
+	ProviderID          string
+	ProviderMessageID   string
+	CodeExpiry          time.Duration
+	Result              domain.NotificationDeliveryResult
+	Error               string
+	TriggeringEventType eventstore.EventType
+}
+
+type NotificationDeliverySearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+func (q *NotificationDeliverySearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+// SearchNotificationDeliveries returns the delivery history of the notifications sent to users.
+// Deliveries older than the audit log retention of the instance are not returned.
+func (q *Queries) SearchNotificationDeliveries(ctx context.Context, queries *NotificationDeliverySearchQueries) (_ *NotificationDeliveries, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	where := sq.And{
+		sq.Eq{NotificationDeliveryColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID()},
+	}
+	if auditLogRetention := q.AuditLogRetention(ctx); auditLogRetention != 0 {
+		where = append(where, sq.Gt{NotificationDeliveryColumnCreationDate.identifier(): oldestAllowedByRetention(ctx, auditLogRetention)})
+	}
+	query, scan := prepareNotificationDeliveriesQuery(ctx, q.client)
+	return genericRowsQueryWithState[*NotificationDeliveries](ctx, q.client, notificationDeliveryTable, combineToWhereStmt(query, queries.toQuery, where), scan)
+}
+
+func NewNotificationDeliveryUserIDSearchQuery(userID string) (SearchQuery, error) {
+	return NewTextQuery(NotificationDeliveryColumnUserID, userID, TextEquals)
+}
+
+func NewNotificationDeliveryResourceOwnerSearchQuery(resourceOwner string) (SearchQuery, error) {
+	return NewTextQuery(NotificationDeliveryColumnResourceOwner, resourceOwner, TextEquals)
+}
+
+func NewNotificationDeliveryChannelSearchQuery(channel domain.NotificationType) (SearchQuery, error) {
+	return NewNumberQuery(NotificationDeliveryColumnChannel, channel, NumberEquals)
+}
+
+func prepareNotificationDeliveriesQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(rows *sql.Rows) (*NotificationDeliveries, error)) {
+	return sq.Select(
+			NotificationDeliveryColumnUserID.identifier(),
+			NotificationDeliveryColumnCreationDate.identifier(),
+			NotificationDeliveryColumnSequence.identifier(),
+			NotificationDeliveryColumnResourceOwner.identifier(),
+			NotificationDeliveryColumnChannel.identifier(),
+			NotificationDeliveryColumnMessageType.identifier(),
+			NotificationDeliveryColumnProviderID.identifier(),
+			NotificationDeliveryColumnProviderMessageID.identifier(),
+			NotificationDeliveryColumnCodeExpiry.identifier(),
+			NotificationDeliveryColumnResult.identifier(),
+			NotificationDeliveryColumnError.identifier(),
+			NotificationDeliveryColumnTriggeringEventType.identifier(),
+			countColumn.identifier(),
+		).From(notificationDeliveryTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*NotificationDeliveries, error) {
+			deliveries := make([]*NotificationDelivery, 0)
+			var count uint64
+			for rows.Next() {
+				delivery := new(NotificationDelivery)
+				var codeExpiry database.NullDuration
+				err := rows.Scan(
+					&delivery.UserID,
+					&delivery.CreationDate,
+					&delivery.Sequence,
+					&delivery.ResourceOwner,
+					&delivery.Channel,
+					&delivery.MessageType,
+					&delivery.ProviderID,
+					&delivery.ProviderMessageID,
+					&codeExpiry,
+					&delivery.Result,
+					&delivery.Error,
+					&delivery.TriggeringEventType,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				delivery.CodeExpiry = codeExpiry.Duration
+				deliveries = append(deliveries, delivery)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Nd5cr", "Errors.Query.CloseRows")
+			}
+
+			return &NotificationDeliveries{
+				Deliveries: deliveries,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
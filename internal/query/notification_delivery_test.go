@@ -0,0 +1,167 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+var (
+	prepareNotificationDeliveriesStmt = `SELECT projections.notification_deliveries.user_id,` +
+		` projections.notification_deliveries.creation_date,` +
+		` projections.notification_deliveries.sequence,` +
+		` projections.notification_deliveries.resource_owner,` +
+		` projections.notification_deliveries.channel,` +
+		` projections.notification_deliveries.message_type,` +
+		` projections.notification_deliveries.provider_id,` +
+		` projections.notification_deliveries.provider_message_id,` +
+		` projections.notification_deliveries.code_expiry,` +
+		` projections.notification_deliveries.result,` +
+		` projections.notification_deliveries.error,` +
+		` projections.notification_deliveries.triggering_event_type,` +
+		` COUNT(*) OVER ()` +
+		` FROM projections.notification_deliveries`
+	prepareNotificationDeliveriesCols = []string{
+		"user_id",
+		"creation_date",
+		"sequence",
+		"resource_owner",
+		"channel",
+		"message_type",
+		"provider_id",
+		"provider_message_id",
+		"code_expiry",
+		"result",
+		"error",
+		"triggering_event_type",
+		"count",
+	}
+)
+
+func Test_NotificationDeliveryPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareNotificationDeliveriesQuery no result",
+			prepare: prepareNotificationDeliveriesQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareNotificationDeliveriesStmt),
+					nil,
+					nil,
+				),
+			},
+			object: &NotificationDeliveries{Deliveries: []*NotificationDelivery{}},
+		},
+		{
+			name:    "prepareNotificationDeliveriesQuery multiple result",
+			prepare: prepareNotificationDeliveriesQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareNotificationDeliveriesStmt),
+					prepareNotificationDeliveriesCols,
+					[][]driver.Value{
+						{
+							"user-1",
+							testNow,
+							uint64(20211109),
+							"ro",
+							domain.NotificationTypeEmail,
+							"VerifyEmail",
+							"provider-1",
+							"<message-1@zitadel.cloud>",
+							&pgtype.Interval{Microseconds: time.Hour.Microseconds(), Valid: true},
+							domain.NotificationDeliveryResultSent,
+							"",
+							"user.human.email.code.added",
+						},
+						{
+							"user-1",
+							testNow,
+							uint64(20211110),
+							"ro",
+							domain.NotificationTypeSms,
+							"PasswordChange",
+							"provider-2",
+							"",
+							nil,
+							domain.NotificationDeliveryResultFailed,
+							"provider unavailable",
+							"user.human.password.changed",
+						},
+					},
+				),
+			},
+			object: &NotificationDeliveries{
+				SearchResponse: SearchResponse{
+					Count: 2,
+				},
+				Deliveries: []*NotificationDelivery{
+					{
+						UserID:              "user-1",
+						CreationDate:        testNow,
+						Sequence:            20211109,
+						ResourceOwner:       "ro",
+						Channel:             domain.NotificationTypeEmail,
+						MessageType:         "VerifyEmail",
+						ProviderID:          "provider-1",
+						ProviderMessageID:   "<message-1@zitadel.cloud>",
+						CodeExpiry:          time.Hour,
+						Result:              domain.NotificationDeliveryResultSent,
+						TriggeringEventType: "user.human.email.code.added",
+					},
+					{
+						UserID:              "user-1",
+						CreationDate:        testNow,
+						Sequence:            20211110,
+						ResourceOwner:       "ro",
+						Channel:             domain.NotificationTypeSms,
+						MessageType:         "PasswordChange",
+						ProviderID:          "provider-2",
+						Result:              domain.NotificationDeliveryResultFailed,
+						Error:               "provider unavailable",
+						TriggeringEventType: "user.human.password.changed",
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareNotificationDeliveriesQuery sql err",
+			prepare: prepareNotificationDeliveriesQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareNotificationDeliveriesStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*NotificationDeliveries)(nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err, defaultPrepareArgs...)
+		})
+	}
+}
@@ -26,6 +26,7 @@ type NotificationPolicy struct {
 	State         domain.PolicyState
 
 	PasswordChange bool
+	SecurityAlerts bool
 
 	IsDefault bool
 }
@@ -63,6 +64,10 @@ var (
 		name:  projection.NotificationPolicyColumnPasswordChange,
 		table: notificationPolicyTable,
 	}
+	NotificationPolicyColSecurityAlerts = Column{
+		name:  projection.NotificationPolicyColumnSecurityAlerts,
+		table: notificationPolicyTable,
+	}
 	NotificationPolicyColIsDefault = Column{
 		name:  projection.NotificationPolicyColumnIsDefault,
 		table: notificationPolicyTable,
@@ -153,6 +158,7 @@ func prepareNotificationPolicyQuery(ctx context.Context, db prepareDatabase) (sq
 			NotificationPolicyColChangeDate.identifier(),
 			NotificationPolicyColResourceOwner.identifier(),
 			NotificationPolicyColPasswordChange.identifier(),
+			NotificationPolicyColSecurityAlerts.identifier(),
 			NotificationPolicyColIsDefault.identifier(),
 			NotificationPolicyColState.identifier(),
 		).
@@ -167,6 +173,7 @@ func prepareNotificationPolicyQuery(ctx context.Context, db prepareDatabase) (sq
 				&policy.ChangeDate,
 				&policy.ResourceOwner,
 				&policy.PasswordChange,
+				&policy.SecurityAlerts,
 				&policy.IsDefault,
 				&policy.State,
 			)
@@ -19,6 +19,7 @@ var (
 		` projections.notification_policies.change_date,` +
 		` projections.notification_policies.resource_owner,` +
 		` projections.notification_policies.password_change,` +
+		` projections.notification_policies.security_alerts,` +
 		` projections.notification_policies.is_default,` +
 		` projections.notification_policies.state` +
 		` FROM projections.notification_policies` +
@@ -30,6 +31,7 @@ var (
 		"change_date",
 		"resource_owner",
 		"password_change",
+		"security_alerts",
 		"is_default",
 		"state",
 	}
@@ -79,6 +81,7 @@ func Test_NotificationPolicyPrepares(t *testing.T) {
 						"ro",
 						true,
 						true,
+						true,
 						domain.PolicyStateActive,
 					},
 				),
@@ -91,6 +94,7 @@ func Test_NotificationPolicyPrepares(t *testing.T) {
 				ResourceOwner:  "ro",
 				State:          domain.PolicyStateActive,
 				PasswordChange: true,
+				SecurityAlerts: true,
 				IsDefault:      true,
 			},
 		},
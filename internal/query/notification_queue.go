@@ -0,0 +1,274 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	notificationQueueTable = table{
+		name:          projection.NotificationQueueProjectionTable,
+		instanceIDCol: projection.NotificationQueueColumnInstanceID,
+	}
+	NotificationQueueColumnID = Column{
+		name:  projection.NotificationQueueColumnID,
+		table: notificationQueueTable,
+	}
+	NotificationQueueColumnCreationDate = Column{
+		name:  projection.NotificationQueueColumnCreationDate,
+		table: notificationQueueTable,
+	}
+	NotificationQueueColumnChangeDate = Column{
+		name:  projection.NotificationQueueColumnChangeDate,
+		table: notificationQueueTable,
+	}
+	NotificationQueueColumnSequence = Column{
+		name:  projection.NotificationQueueColumnSequence,
+		table: notificationQueueTable,
+	}
+	NotificationQueueColumnResourceOwner = Column{
+		name:  projection.NotificationQueueColumnResourceOwner,
+		table: notificationQueueTable,
+	}
+	NotificationQueueColumnInstanceID = Column{
+		name:  projection.NotificationQueueColumnInstanceID,
+		table: notificationQueueTable,
+	}
+	NotificationQueueColumnState = Column{
+		name:  projection.NotificationQueueColumnState,
+		table: notificationQueueTable,
+	}
+	NotificationQueueColumnChannel = Column{
+		name:  projection.NotificationQueueColumnChannel,
+		table: notificationQueueTable,
+	}
+	NotificationQueueColumnTriggeringAggregateID = Column{
+		name:  projection.NotificationQueueColumnTriggeringAggregateID,
+		table: notificationQueueTable,
+	}
+	NotificationQueueColumnTriggeringEventType = Column{
+		name:  projection.NotificationQueueColumnTriggeringEventType,
+		table: notificationQueueTable,
+	}
+	NotificationQueueColumnAttempts = Column{
+		name:  projection.NotificationQueueColumnAttempts,
+		table: notificationQueueTable,
+	}
+	NotificationQueueColumnNextAttemptDate = Column{
+		name:  projection.NotificationQueueColumnNextAttemptDate,
+		table: notificationQueueTable,
+	}
+	NotificationQueueColumnLastError = Column{
+		name:  projection.NotificationQueueColumnLastError,
+		table: notificationQueueTable,
+	}
+)
+
+type Notifications struct {
+	SearchResponse
+	Notifications []*Notification
+}
+
+func (n *Notifications) SetState(s *State) {
+	n.State = s
+}
+
+type Notification struct {
+	ID string
+	domain.ObjectDetails
+
+	State                 domain.NotificationState
+	Channel               domain.NotificationType
+	TriggeringAggregateID string
+	TriggeringEventType   eventstore.EventType
+	Attempts              uint16
+	NextAttemptDate       time.Time
+	LastError             string
+}
+
+type NotificationSearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+func (q *NotificationSearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+// SearchNotifications returns the delivered, retried, failed and pending notifications of the instance
+func (q *Queries) SearchNotifications(ctx context.Context, queries *NotificationSearchQueries) (_ *Notifications, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	eq := sq.Eq{
+		NotificationQueueColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}
+	query, scan := prepareNotificationsQuery(ctx, q.client)
+	return genericRowsQueryWithState[*Notifications](ctx, q.client, notificationQueueTable, combineToWhereStmt(query, queries.toQuery, eq), scan)
+}
+
+func NewNotificationStateSearchQuery(state domain.NotificationState) (SearchQuery, error) {
+	return NewNumberQuery(NotificationQueueColumnState, state, NumberEquals)
+}
+
+func NewNotificationChannelSearchQuery(channel domain.NotificationType) (SearchQuery, error) {
+	return NewNumberQuery(NotificationQueueColumnChannel, channel, NumberEquals)
+}
+
+func NewNotificationTriggeringAggregateIDSearchQuery(id string) (SearchQuery, error) {
+	return NewTextQuery(NotificationQueueColumnTriggeringAggregateID, id, TextEquals)
+}
+
+func prepareNotificationsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(rows *sql.Rows) (*Notifications, error)) {
+	return sq.Select(
+			NotificationQueueColumnID.identifier(),
+			NotificationQueueColumnChangeDate.identifier(),
+			NotificationQueueColumnResourceOwner.identifier(),
+			NotificationQueueColumnSequence.identifier(),
+			NotificationQueueColumnState.identifier(),
+			NotificationQueueColumnChannel.identifier(),
+			NotificationQueueColumnTriggeringAggregateID.identifier(),
+			NotificationQueueColumnTriggeringEventType.identifier(),
+			NotificationQueueColumnAttempts.identifier(),
+			NotificationQueueColumnNextAttemptDate.identifier(),
+			NotificationQueueColumnLastError.identifier(),
+			countColumn.identifier(),
+		).From(notificationQueueTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*Notifications, error) {
+			notifications := make([]*Notification, 0)
+			var count uint64
+			for rows.Next() {
+				notification := new(Notification)
+				var (
+					nextAttemptDate sql.NullTime
+					lastError       sql.NullString
+				)
+				err := rows.Scan(
+					&notification.ID,
+					&notification.EventDate,
+					&notification.ResourceOwner,
+					&notification.Sequence,
+					&notification.State,
+					&notification.Channel,
+					&notification.TriggeringAggregateID,
+					&notification.TriggeringEventType,
+					&notification.Attempts,
+					&nextAttemptDate,
+					&lastError,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				notification.NextAttemptDate = nextAttemptDate.Time
+				notification.LastError = lastError.String
+				notifications = append(notifications, notification)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Nq0sk", "Errors.Query.CloseRows")
+			}
+
+			return &Notifications{
+				Notifications: notifications,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
+
+// DueNotification is a pending notification of any instance, which has to be delivered.
+type DueNotification struct {
+	ID         string
+	InstanceID string
+	Channel    domain.NotificationType
+}
+
+// ClaimDueNotifications returns the pending notifications of all instances which are due for delivery.
+// The next attempt of the returned notifications is postponed by the claim duration,
+// so concurrent workers skip them after the passed transaction is committed.
+func (q *Queries) ClaimDueNotifications(ctx context.Context, tx *sql.Tx, limit uint64, claim time.Duration) (_ []*DueNotification, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	query, scan := prepareDueNotificationsQuery()
+	stmt, args, err := query.Limit(limit).Suffix("FOR UPDATE SKIP LOCKED").ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Dq2lf", "Errors.Query.SQLStatement")
+	}
+	rows, err := tx.QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Lw8ds", "Errors.Internal")
+	}
+	notifications, err := scan(rows)
+	if err != nil || len(notifications) == 0 {
+		return notifications, err
+	}
+	ids := make(sq.Or, len(notifications))
+	for i, notification := range notifications {
+		ids[i] = sq.Eq{
+			NotificationQueueColumnInstanceID.name: notification.InstanceID,
+			NotificationQueueColumnID.name:         notification.ID,
+		}
+	}
+	stmt, args, err = sq.Update(notificationQueueTable.name).
+		Set(NotificationQueueColumnNextAttemptDate.name, sq.Expr("now() + make_interval(secs => ?)", claim.Seconds())).
+		Where(ids).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Cl3sd", "Errors.Query.SQLStatement")
+	}
+	if _, err = tx.ExecContext(ctx, stmt, args...); err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Cl4sd", "Errors.Internal")
+	}
+	return notifications, nil
+}
+
+func prepareDueNotificationsQuery() (sq.SelectBuilder, func(rows *sql.Rows) ([]*DueNotification, error)) {
+	return sq.Select(
+			NotificationQueueColumnID.identifier(),
+			NotificationQueueColumnInstanceID.identifier(),
+			NotificationQueueColumnChannel.identifier(),
+		).From(notificationQueueTable.identifier()).
+			Where(sq.And{
+				sq.Eq{NotificationQueueColumnState.identifier(): []domain.NotificationState{domain.NotificationStateRequested, domain.NotificationStateRetrying}},
+				sq.Expr(NotificationQueueColumnNextAttemptDate.identifier() + " <= now()"),
+			}).
+			OrderBy(NotificationQueueColumnNextAttemptDate.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) ([]*DueNotification, error) {
+			notifications := make([]*DueNotification, 0)
+			for rows.Next() {
+				notification := new(DueNotification)
+				if err := rows.Scan(
+					&notification.ID,
+					&notification.InstanceID,
+					&notification.Channel,
+				); err != nil {
+					return nil, err
+				}
+				notifications = append(notifications, notification)
+			}
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Ke9sl", "Errors.Query.CloseRows")
+			}
+			return notifications, nil
+		}
+}
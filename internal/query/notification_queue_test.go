@@ -0,0 +1,163 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+var (
+	prepareNotificationsStmt = `SELECT projections.notification_queue.id,` +
+		` projections.notification_queue.change_date,` +
+		` projections.notification_queue.resource_owner,` +
+		` projections.notification_queue.sequence,` +
+		` projections.notification_queue.state,` +
+		` projections.notification_queue.channel,` +
+		` projections.notification_queue.triggering_aggregate_id,` +
+		` projections.notification_queue.triggering_event_type,` +
+		` projections.notification_queue.attempts,` +
+		` projections.notification_queue.next_attempt_date,` +
+		` projections.notification_queue.last_error,` +
+		` COUNT(*) OVER ()` +
+		` FROM projections.notification_queue`
+	prepareNotificationsCols = []string{
+		"id",
+		"change_date",
+		"resource_owner",
+		"sequence",
+		"state",
+		"channel",
+		"triggering_aggregate_id",
+		"triggering_event_type",
+		"attempts",
+		"next_attempt_date",
+		"last_error",
+		"count",
+	}
+)
+
+func Test_NotificationQueuePrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareNotificationsQuery no result",
+			prepare: prepareNotificationsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareNotificationsStmt),
+					nil,
+					nil,
+				),
+			},
+			object: &Notifications{Notifications: []*Notification{}},
+		},
+		{
+			name:    "prepareNotificationsQuery multiple result",
+			prepare: prepareNotificationsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareNotificationsStmt),
+					prepareNotificationsCols,
+					[][]driver.Value{
+						{
+							"id-1",
+							testNow,
+							"ro",
+							uint64(20211109),
+							domain.NotificationStateSent,
+							domain.NotificationTypeEmail,
+							"user-1",
+							"user.human.email.code.added",
+							uint16(1),
+							nil,
+							nil,
+						},
+						{
+							"id-2",
+							testNow,
+							"ro",
+							uint64(20211110),
+							domain.NotificationStateRetrying,
+							domain.NotificationTypeSms,
+							"user-2",
+							"user.human.phone.code.added",
+							uint16(2),
+							testNow,
+							"provider unavailable",
+						},
+					},
+				),
+			},
+			object: &Notifications{
+				SearchResponse: SearchResponse{
+					Count: 2,
+				},
+				Notifications: []*Notification{
+					{
+						ID: "id-1",
+						ObjectDetails: domain.ObjectDetails{
+							EventDate:     testNow,
+							ResourceOwner: "ro",
+							Sequence:      20211109,
+						},
+						State:                 domain.NotificationStateSent,
+						Channel:               domain.NotificationTypeEmail,
+						TriggeringAggregateID: "user-1",
+						TriggeringEventType:   "user.human.email.code.added",
+						Attempts:              1,
+					},
+					{
+						ID: "id-2",
+						ObjectDetails: domain.ObjectDetails{
+							EventDate:     testNow,
+							ResourceOwner: "ro",
+							Sequence:      20211110,
+						},
+						State:                 domain.NotificationStateRetrying,
+						Channel:               domain.NotificationTypeSms,
+						TriggeringAggregateID: "user-2",
+						TriggeringEventType:   "user.human.phone.code.added",
+						Attempts:              2,
+						NextAttemptDate:       testNow,
+						LastError:             "provider unavailable",
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareNotificationsQuery sql err",
+			prepare: prepareNotificationsQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareNotificationsStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*Notifications)(nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err, defaultPrepareArgs...)
+		})
+	}
+}
@@ -33,6 +33,7 @@ type OIDCClient struct {
 	IDTokenUserinfoAssertion bool                       `json:"id_token_userinfo_assertion,omitempty"`
 	ClockSkew                time.Duration              `json:"clock_skew,omitempty"`
 	AdditionalOrigins        []string                   `json:"additional_origins,omitempty"`
+	RequiredLevelOfAssurance domain.LevelOfAssurance    `json:"required_level_of_assurance,omitempty"`
 	PublicKeys               map[string][]byte          `json:"public_keys,omitempty"`
 	ProjectID                string                     `json:"project_id,omitempty"`
 	ProjectRoleAssertion     bool                       `json:"project_role_assertion,omitempty"`
@@ -63,6 +63,10 @@ var (
 		name:  projection.OrgColumnDomain,
 		table: orgsTable,
 	}
+	OrgColumnParentID = Column{
+		name:  projection.OrgColumnParentID,
+		table: orgsTable,
+	}
 )
 
 type Orgs struct {
@@ -80,6 +84,8 @@ type Org struct {
 
 	Name   string
 	Domain string
+	// ParentID is empty for top level organizations
+	ParentID string
 }
 
 type OrgSearchQueries struct {
@@ -129,6 +135,7 @@ func (q *Queries) OrgByID(ctx context.Context, shouldTriggerBulk bool, id string
 		Sequence:      uint64(foundOrg.Sequence),
 		Name:          foundOrg.Name,
 		Domain:        foundOrg.PrimaryDomain.Domain,
+		ParentID:      foundOrg.ParentID,
 	}, nil
 }
 
@@ -301,6 +308,10 @@ func NewOrgIDsSearchQuery(ids ...string) (SearchQuery, error) {
 	return NewListQuery(OrgColumnID, list, ListIn)
 }
 
+func NewOrgParentIDSearchQuery(parentID string) (SearchQuery, error) {
+	return NewTextQuery(OrgColumnParentID, parentID, TextEquals)
+}
+
 func prepareOrgsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) (*Orgs, error)) {
 	return sq.Select(
 			OrgColumnID.identifier(),
@@ -311,6 +322,7 @@ func prepareOrgsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder
 			OrgColumnSequence.identifier(),
 			OrgColumnName.identifier(),
 			OrgColumnDomain.identifier(),
+			OrgColumnParentID.identifier(),
 			countColumn.identifier()).
 			From(orgsTable.identifier() + db.Timetravel(call.Took(ctx))).
 			PlaceholderFormat(sq.Dollar),
@@ -328,6 +340,7 @@ func prepareOrgsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder
 					&org.Sequence,
 					&org.Name,
 					&org.Domain,
+					&org.ParentID,
 					&count,
 				)
 				if err != nil {
@@ -359,6 +372,7 @@ func prepareOrgQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder,
 			OrgColumnSequence.identifier(),
 			OrgColumnName.identifier(),
 			OrgColumnDomain.identifier(),
+			OrgColumnParentID.identifier(),
 		).
 			From(orgsTable.identifier() + db.Timetravel(call.Took(ctx))).
 			PlaceholderFormat(sq.Dollar),
@@ -373,6 +387,7 @@ func prepareOrgQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder,
 				&o.Sequence,
 				&o.Name,
 				&o.Domain,
+				&o.ParentID,
 			)
 			if err != nil {
 				if errors.Is(err, sql.ErrNoRows) {
@@ -394,6 +409,7 @@ func prepareOrgWithDomainsQuery(ctx context.Context, db prepareDatabase) (sq.Sel
 			OrgColumnSequence.identifier(),
 			OrgColumnName.identifier(),
 			OrgColumnDomain.identifier(),
+			OrgColumnParentID.identifier(),
 		).
 			From(orgsTable.identifier()).
 			LeftJoin(join(OrgDomainOrgIDCol, OrgColumnID) + db.Timetravel(call.Took(ctx))).
@@ -409,6 +425,7 @@ func prepareOrgWithDomainsQuery(ctx context.Context, db prepareDatabase) (sq.Sel
 				&o.Sequence,
 				&o.Name,
 				&o.Domain,
+				&o.ParentID,
 			)
 			if err != nil {
 				if errors.Is(err, sql.ErrNoRows) {
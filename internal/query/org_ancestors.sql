@@ -0,0 +1,19 @@
+with recursive
+-- walk up the hierarchy starting at the parent of the organization
+ancestors (id, parent_id, depth) as (
+	select p.id, p.parent_id, 1
+	from projections.orgs2 o
+	join projections.orgs2 p on p.instance_id = o.instance_id and p.id = o.parent_id
+	where o.instance_id = $1
+	and o.id = $2
+	union
+	select p.id, p.parent_id, a.depth + 1
+	from projections.orgs2 p
+	join ancestors a on p.id = a.parent_id
+	where p.instance_id = $1
+	-- the commands prevent cycles, the limit protects against inconsistent data
+	and a.depth < 100
+)
+select id
+from ancestors
+order by depth;
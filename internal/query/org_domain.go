@@ -3,6 +3,7 @@ package query
 import (
 	"context"
 	"database/sql"
+	"strings"
 	"time"
 
 	sq "github.com/Masterminds/squirrel"
@@ -24,6 +25,7 @@ type Domain struct {
 	IsVerified     bool
 	IsPrimary      bool
 	ValidationType domain.OrgDomainValidationType
+	IDPID          string
 }
 
 type Domains struct {
@@ -82,6 +84,36 @@ func (q *Queries) SearchOrgDomains(ctx context.Context, queries *OrgDomainSearch
 	return domains, err
 }
 
+// DomainIDPByLoginName returns the verified domain of the login name,
+// if it is routed to an identity provider (home-realm discovery).
+func (q *Queries) DomainIDPByLoginName(ctx context.Context, loginName string) (_ *Domain, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	index := strings.LastIndex(loginName, "@")
+	if index < 0 || index == len(loginName)-1 {
+		return nil, zerrors.ThrowNotFound(nil, "QUERY-Rb3ke", "Errors.Org.DomainNotFound")
+	}
+	domainQuery, err := NewOrgDomainDomainSearchQuery(TextEqualsIgnoreCase, strings.TrimSpace(loginName[index+1:]))
+	if err != nil {
+		return nil, err
+	}
+	verifiedQuery, err := NewOrgDomainVerifiedSearchQuery(true)
+	if err != nil {
+		return nil, err
+	}
+	domains, err := q.SearchOrgDomains(ctx, &OrgDomainSearchQueries{Queries: []SearchQuery{domainQuery, verifiedQuery}}, false)
+	if err != nil {
+		return nil, err
+	}
+	for _, domain := range domains.Domains {
+		if domain.IDPID != "" {
+			return domain, nil
+		}
+	}
+	return nil, zerrors.ThrowNotFound(nil, "QUERY-Rb3kf", "Errors.Org.DomainNotFound")
+}
+
 func prepareDomainsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) (*Domains, error)) {
 	return sq.Select(
 			OrgDomainCreationDateCol.identifier(),
@@ -92,6 +124,7 @@ func prepareDomainsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuil
 			OrgDomainIsVerifiedCol.identifier(),
 			OrgDomainIsPrimaryCol.identifier(),
 			OrgDomainValidationTypeCol.identifier(),
+			OrgDomainIDPIDCol.identifier(),
 			countColumn.identifier(),
 		).From(orgDomainsTable.identifier() + db.Timetravel(call.Took(ctx))).
 			PlaceholderFormat(sq.Dollar),
@@ -109,6 +142,7 @@ func prepareDomainsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuil
 					&domain.IsVerified,
 					&domain.IsPrimary,
 					&domain.ValidationType,
+					&domain.IDPID,
 					&count,
 				)
 				if err != nil {
@@ -176,4 +210,8 @@ var (
 		name:  projection.OrgDomainOwnerRemovedCol,
 		table: orgDomainsTable,
 	}
+	OrgDomainIDPIDCol = Column{
+		name:  projection.OrgDomainIDPIDCol,
+		table: orgDomainsTable,
+	}
 )
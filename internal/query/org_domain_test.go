@@ -12,16 +12,17 @@ import (
 )
 
 var (
-	prepareOrgDomainsStmt = `SELECT projections.org_domains2.creation_date,` +
-		` projections.org_domains2.change_date,` +
-		` projections.org_domains2.sequence,` +
-		` projections.org_domains2.domain,` +
-		` projections.org_domains2.org_id,` +
-		` projections.org_domains2.is_verified,` +
-		` projections.org_domains2.is_primary,` +
-		` projections.org_domains2.validation_type,` +
+	prepareOrgDomainsStmt = `SELECT projections.org_domains3.creation_date,` +
+		` projections.org_domains3.change_date,` +
+		` projections.org_domains3.sequence,` +
+		` projections.org_domains3.domain,` +
+		` projections.org_domains3.org_id,` +
+		` projections.org_domains3.is_verified,` +
+		` projections.org_domains3.is_primary,` +
+		` projections.org_domains3.validation_type,` +
+		` projections.org_domains3.idp_id,` +
 		` COUNT(*) OVER ()` +
-		` FROM projections.org_domains2` +
+		` FROM projections.org_domains3` +
 		` AS OF SYSTEM TIME '-1 ms'`
 	prepareOrgDomainsCols = []string{
 		"id",
@@ -32,6 +33,7 @@ var (
 		"sequence",
 		"name",
 		"primary_domain",
+		"idp_id",
 		"count",
 	}
 )
@@ -76,6 +78,7 @@ func Test_OrgDomainPrepares(t *testing.T) {
 							true,
 							true,
 							domain.OrgDomainValidationTypeHTTP,
+							"",
 						},
 					},
 				),
@@ -115,6 +118,7 @@ func Test_OrgDomainPrepares(t *testing.T) {
 							true,
 							true,
 							domain.OrgDomainValidationTypeHTTP,
+							"idp-id",
 						},
 						{
 							testNow,
@@ -125,6 +129,7 @@ func Test_OrgDomainPrepares(t *testing.T) {
 							false,
 							false,
 							domain.OrgDomainValidationTypeDNS,
+							"",
 						},
 					},
 				),
@@ -143,6 +148,7 @@ func Test_OrgDomainPrepares(t *testing.T) {
 						IsVerified:     true,
 						IsPrimary:      true,
 						ValidationType: domain.OrgDomainValidationTypeHTTP,
+						IDPID:          "idp-id",
 					},
 					{
 						CreationDate:   testNow,
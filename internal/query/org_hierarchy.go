@@ -0,0 +1,88 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+//go:embed org_ancestors.sql
+var orgAncestorsQuery string
+
+// OrgAncestorIDs returns the ids of the parent organizations of the organization,
+// ordered from the direct parent up to the top level organization.
+func (q *Queries) OrgAncestorIDs(ctx context.Context, orgID string) (ancestors []string, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	ancestors = make([]string, 0)
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		for rows.Next() {
+			var id string
+			if err := rows.Scan(&id); err != nil {
+				return err
+			}
+			ancestors = append(ancestors, id)
+		}
+		return rows.Err()
+	}, orgAncestorsQuery, authz.GetInstance(ctx).InstanceID(), orgID)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Oa1nc", "Errors.Internal")
+	}
+	return ancestors, nil
+}
+
+// policyOwnerIDs returns the possible owners of a policy of the organization:
+// the organization itself, its ancestors and the instance as default.
+func (q *Queries) policyOwnerIDs(ctx context.Context, orgID string) ([]string, error) {
+	ancestors, err := q.OrgAncestorIDs(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	return append(append([]string{orgID}, ancestors...), authz.GetInstance(ctx).InstanceID()), nil
+}
+
+// nearestPolicyOwner orders the policies by the position of their owner in the owners,
+// so the policy of the nearest owner is returned first.
+func nearestPolicyOwner(column Column, owners []string) sq.Sqlizer {
+	return sq.Expr("array_position(?::TEXT[], "+column.identifier()+")", database.TextArray[string](owners))
+}
+
+const orgSubtreeStmt = "WITH RECURSIVE subtree (id, instance_id) AS (" +
+	"SELECT id, instance_id FROM " + projection.OrgProjectionTable + " WHERE id = ?" +
+	" UNION" +
+	" SELECT o.id, o.instance_id FROM " + projection.OrgProjectionTable + " o" +
+	" JOIN subtree s ON o.parent_id = s.id AND o.instance_id = s.instance_id" +
+	") SELECT id FROM subtree"
+
+type orgSubtreeQuery struct {
+	orgID string
+}
+
+// NewOrgSubtreeSearchQuery returns the organization and all organizations below it
+func NewOrgSubtreeSearchQuery(orgID string) (SearchQuery, error) {
+	if orgID == "" {
+		return nil, ErrNothingSelected
+	}
+	return &orgSubtreeQuery{orgID: orgID}, nil
+}
+
+func (q *orgSubtreeQuery) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	return query.Where(q.comp())
+}
+
+func (q *orgSubtreeQuery) comp() sq.Sqlizer {
+	return sq.Expr(OrgColumnID.identifier()+" IN ("+orgSubtreeStmt+")", q.orgID)
+}
+
+func (q *orgSubtreeQuery) Col() Column {
+	return OrgColumnID
+}
@@ -0,0 +1,300 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	orgMemberScopeTable = table{
+		name:          projection.OrgMemberScopeProjectionTable,
+		instanceIDCol: projection.OrgMemberScopeInstanceIDCol,
+	}
+	OrgMemberScopeColumnOrgID = Column{
+		name:  projection.OrgMemberScopeOrgIDCol,
+		table: orgMemberScopeTable,
+	}
+	OrgMemberScopeColumnUserID = Column{
+		name:  projection.OrgMemberScopeUserIDCol,
+		table: orgMemberScopeTable,
+	}
+	OrgMemberScopeColumnInstanceID = Column{
+		name:  projection.OrgMemberScopeInstanceIDCol,
+		table: orgMemberScopeTable,
+	}
+	OrgMemberScopeColumnMetadataKey = Column{
+		name:  projection.OrgMemberScopeMetadataKeyCol,
+		table: orgMemberScopeTable,
+	}
+	OrgMemberScopeColumnMetadataValue = Column{
+		name:  projection.OrgMemberScopeMetadataValueCol,
+		table: orgMemberScopeTable,
+	}
+	OrgMemberScopeColumnGroupID = Column{
+		name:  projection.OrgMemberScopeGroupIDCol,
+		table: orgMemberScopeTable,
+	}
+)
+
+// OrgMemberScopes are the scopes of the organization memberships of a user by organization id.
+type OrgMemberScopes map[string]*domain.OrgMemberScope
+
+// OrgMemberScope returns the scope of the organization member or nil if the member is not restricted.
+func (q *Queries) OrgMemberScope(ctx context.Context, orgID, userID string) (_ *domain.OrgMemberScope, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	query, scan := prepareOrgMemberScopesQuery()
+	scopes, err := genericRowsQuery[OrgMemberScopes](ctx, q.client, query.Where(sq.Eq{
+		OrgMemberScopeColumnOrgID.identifier():      orgID,
+		OrgMemberScopeColumnUserID.identifier():     userID,
+		OrgMemberScopeColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}), scan)
+	if err != nil {
+		return nil, err
+	}
+	return scopes[orgID], nil
+}
+
+// OrgMemberScopes returns the scopes of the organization memberships of the user.
+// Scopes of instance members are not returned, as the instance membership is not restricted.
+func (q *Queries) OrgMemberScopes(ctx context.Context, userID string) (_ OrgMemberScopes, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	instanceMemberQuery, err := instanceMemberUserQuery(instanceID, userID)
+	if err != nil {
+		return nil, err
+	}
+	query, scan := prepareOrgMemberScopesQuery()
+	eq := sq.Eq{
+		OrgMemberScopeColumnUserID.identifier():     userID,
+		OrgMemberScopeColumnInstanceID.identifier(): instanceID,
+	}
+	return genericRowsQuery[OrgMemberScopes](ctx, q.client, instanceMemberQuery.toQuery(query).Where(eq), scan)
+}
+
+// AppendUserMemberScopeQuery restricts the user search to the users
+// in the scopes of the organization memberships of the authenticated user.
+// Searches of unrestricted users (including system users) are not changed.
+func (q *Queries) AppendUserMemberScopeQuery(ctx context.Context, queries *UserSearchQueries) error {
+	ctxData := authz.GetCtxData(ctx)
+	if ctxData.UserID == "" || ctxData.SystemMemberships != nil {
+		return nil
+	}
+	scopes, err := q.OrgMemberScopes(ctx, ctxData.UserID)
+	if err != nil || len(scopes) == 0 {
+		return err
+	}
+	scopeQuery, err := scopes.userQuery(authz.GetInstance(ctx).InstanceID())
+	if err != nil {
+		return err
+	}
+	queries.Queries = append(queries.Queries, scopeQuery)
+	return nil
+}
+
+// CheckUserInMemberScope returns a not found error if the user is not in the scopes
+// of the organization memberships of the authenticated user,
+// the same way [Queries.AppendUserMemberScopeQuery] hides the user in searches.
+func (q *Queries) CheckUserInMemberScope(ctx context.Context, userID string) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if userID == authz.GetCtxData(ctx).UserID {
+		return nil
+	}
+	queries := &UserSearchQueries{SearchRequest: SearchRequest{Limit: 1}}
+	if err = q.AppendUserMemberScopeQuery(ctx, queries); err != nil || len(queries.Queries) == 0 {
+		return err
+	}
+	idQuery, err := NewUserInUserIdsSearchQuery([]string{userID})
+	if err != nil {
+		return err
+	}
+	queries.Queries = append(queries.Queries, idQuery)
+	users, err := q.SearchUsers(ctx, queries)
+	if err != nil {
+		return err
+	}
+	if len(users.Users) == 0 {
+		return zerrors.ThrowNotFound(nil, "QUERY-Ms5nf", "Errors.User.NotFound")
+	}
+	return nil
+}
+
+// CheckUserReadPermission checks if the authenticated user is allowed to read the user,
+// including the scope of its organization membership.
+func (q *Queries) CheckUserReadPermission(ctx context.Context, resourceOwner, userID string) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if userID == authz.GetCtxData(ctx).UserID {
+		return nil
+	}
+	if err = q.checkPermission(ctx, domain.PermissionUserRead, resourceOwner, userID); err != nil {
+		return err
+	}
+	return q.CheckUserInMemberScope(ctx, userID)
+}
+
+// userQuery returns the query for users of organizations without scope or in the scope of their organization.
+func (s OrgMemberScopes) userQuery(instanceID string) (SearchQuery, error) {
+	orgIDs := make([]string, 0, len(s))
+	for orgID := range s {
+		orgIDs = append(orgIDs, orgID)
+	}
+	scopedOrgs, err := NewInTextQuery(UserResourceOwnerCol, orgIDs)
+	if err != nil {
+		return nil, err
+	}
+	unscoped, err := NewNotQuery(scopedOrgs)
+	if err != nil {
+		return nil, err
+	}
+	queries := []SearchQuery{unscoped}
+	for orgID, scope := range s {
+		scoped, err := scopeUserQuery(instanceID, orgID, scope)
+		if err != nil {
+			return nil, err
+		}
+		queries = append(queries, scoped)
+	}
+	return NewOrQuery(queries...)
+}
+
+func scopeUserQuery(instanceID, orgID string, scope *domain.OrgMemberScope) (SearchQuery, error) {
+	orgQuery, err := NewTextQuery(UserResourceOwnerCol, orgID, TextEquals)
+	if err != nil {
+		return nil, err
+	}
+	queries := []SearchQuery{orgQuery}
+	if scope.MetadataKey != "" {
+		metadataQueries := make([]SearchQuery, 0, 3)
+		instanceQuery, err := NewTextQuery(UserMetadataInstanceIDCol, instanceID, TextEquals)
+		if err != nil {
+			return nil, err
+		}
+		keyQuery, err := NewTextQuery(UserMetadataKeyCol, scope.MetadataKey, TextEquals)
+		if err != nil {
+			return nil, err
+		}
+		metadataQueries = append(metadataQueries, instanceQuery, keyQuery)
+		if scope.MetadataValue != "" {
+			metadataQueries = append(metadataQueries, &bytesEqualsQuery{Column: UserMetadataValueCol, Value: []byte(scope.MetadataValue)})
+		}
+		metadataQuery, err := userIDInSubSelect(UserMetadataUserIDCol, metadataQueries)
+		if err != nil {
+			return nil, err
+		}
+		queries = append(queries, metadataQuery)
+	}
+	if scope.GroupID != "" {
+		instanceQuery, err := NewTextQuery(GroupMemberColumnInstanceID, instanceID, TextEquals)
+		if err != nil {
+			return nil, err
+		}
+		groupQuery, err := NewTextQuery(GroupMemberColumnGroupID, scope.GroupID, TextEquals)
+		if err != nil {
+			return nil, err
+		}
+		typeQuery, err := NewNumberQuery(GroupMemberColumnType, domain.GroupMemberTypeUser, NumberEquals)
+		if err != nil {
+			return nil, err
+		}
+		memberQuery, err := userIDInSubSelect(GroupMemberColumnMemberID, []SearchQuery{instanceQuery, groupQuery, typeQuery})
+		if err != nil {
+			return nil, err
+		}
+		queries = append(queries, memberQuery)
+	}
+	return NewAndQuery(queries...)
+}
+
+func userIDInSubSelect(column Column, queries []SearchQuery) (SearchQuery, error) {
+	subSelect, err := NewSubSelect(column, queries)
+	if err != nil {
+		return nil, err
+	}
+	return NewListQuery(UserIDCol, subSelect, ListIn)
+}
+
+func instanceMemberUserQuery(instanceID, userID string) (SearchQuery, error) {
+	instanceQuery, err := NewTextQuery(InstanceMemberInstanceID, instanceID, TextEquals)
+	if err != nil {
+		return nil, err
+	}
+	userQuery, err := NewTextQuery(InstanceMemberUserID, userID, TextEquals)
+	if err != nil {
+		return nil, err
+	}
+	subSelect, err := NewSubSelect(InstanceMemberUserID, []SearchQuery{instanceQuery, userQuery})
+	if err != nil {
+		return nil, err
+	}
+	memberQuery, err := NewListQuery(OrgMemberScopeColumnUserID, subSelect, ListIn)
+	if err != nil {
+		return nil, err
+	}
+	return NewNotQuery(memberQuery)
+}
+
+// bytesEqualsQuery compares a bytea column, e.g. the value of metadata
+type bytesEqualsQuery struct {
+	Column Column
+	Value  []byte
+}
+
+func (q *bytesEqualsQuery) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	return query.Where(q.comp())
+}
+
+func (q *bytesEqualsQuery) comp() sq.Sqlizer {
+	return sq.Expr(q.Column.identifier()+" = ?", q.Value)
+}
+
+func (q *bytesEqualsQuery) Col() Column {
+	return q.Column
+}
+
+func prepareOrgMemberScopesQuery() (sq.SelectBuilder, func(*sql.Rows) (OrgMemberScopes, error)) {
+	return sq.Select(
+			OrgMemberScopeColumnOrgID.identifier(),
+			OrgMemberScopeColumnMetadataKey.identifier(),
+			OrgMemberScopeColumnMetadataValue.identifier(),
+			OrgMemberScopeColumnGroupID.identifier(),
+		).From(orgMemberScopeTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (OrgMemberScopes, error) {
+			scopes := make(OrgMemberScopes)
+			for rows.Next() {
+				var (
+					orgID string
+					scope = new(domain.OrgMemberScope)
+				)
+				err := rows.Scan(
+					&orgID,
+					&scope.MetadataKey,
+					&scope.MetadataValue,
+					&scope.GroupID,
+				)
+				if err != nil {
+					return nil, err
+				}
+				scopes[orgID] = scope
+			}
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Ms1cr", "Errors.Query.CloseRows")
+			}
+			return scopes, nil
+		}
+}
@@ -0,0 +1,138 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+var (
+	prepareOrgMemberScopesStmt = `SELECT projections.org_member_scopes.org_id,` +
+		` projections.org_member_scopes.metadata_key,` +
+		` projections.org_member_scopes.metadata_value,` +
+		` projections.org_member_scopes.group_id` +
+		` FROM projections.org_member_scopes`
+	prepareOrgMemberScopesCols = []string{
+		"org_id",
+		"metadata_key",
+		"metadata_value",
+		"group_id",
+	}
+)
+
+func Test_OrgMemberScopePrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareOrgMemberScopesQuery no result",
+			prepare: prepareOrgMemberScopesQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareOrgMemberScopesStmt),
+					nil,
+					nil,
+				),
+			},
+			object: OrgMemberScopes{},
+		},
+		{
+			name:    "prepareOrgMemberScopesQuery multiple result",
+			prepare: prepareOrgMemberScopesQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareOrgMemberScopesStmt),
+					prepareOrgMemberScopesCols,
+					[][]driver.Value{
+						{
+							"org-id",
+							"region",
+							"EMEA",
+							"",
+						},
+						{
+							"org-id2",
+							"",
+							"",
+							"group-id",
+						},
+					},
+				),
+			},
+			object: OrgMemberScopes{
+				"org-id": {
+					MetadataKey:   "region",
+					MetadataValue: "EMEA",
+				},
+				"org-id2": {
+					GroupID: "group-id",
+				},
+			},
+		},
+		{
+			name:    "prepareOrgMemberScopesQuery sql err",
+			prepare: prepareOrgMemberScopesQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareOrgMemberScopesStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (OrgMemberScopes)(nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err)
+		})
+	}
+}
+
+func TestOrgMemberScopes_userQuery(t *testing.T) {
+	scopes := OrgMemberScopes{
+		"org-id": {MetadataKey: "region", MetadataValue: "EMEA", GroupID: "group-id"},
+	}
+	query, err := scopes.userQuery("instance-id")
+	require.NoError(t, err)
+	stmt, args, err := query.comp().ToSql()
+	require.NoError(t, err)
+	assert.Equal(t, "(NOT (projections.users13.resource_owner IN (?))"+
+		" OR (projections.users13.resource_owner = ?"+
+		" AND projections.users13.id IN ( SELECT projections.user_metadata5.user_id FROM projections.user_metadata5"+
+		" WHERE projections.user_metadata5.instance_id = ? AND projections.user_metadata5.key = ? AND projections.user_metadata5.value = ? )"+
+		" AND projections.users13.id IN ( SELECT projections.groups_members.member_id FROM projections.groups_members"+
+		" WHERE projections.groups_members.instance_id = ? AND projections.groups_members.group_id = ? AND projections.groups_members.member_type = ? )))",
+		stmt,
+	)
+	assert.Equal(t, []interface{}{
+		"org-id",
+		"org-id",
+		"instance-id",
+		"region",
+		[]byte("EMEA"),
+		"instance-id",
+		"group-id",
+		domain.GroupMemberTypeUser,
+	}, args)
+}
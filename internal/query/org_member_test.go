@@ -29,7 +29,7 @@ var (
 		", projections.users13_humans.avatar_key" +
 		", projections.users13.type" +
 		", COUNT(*) OVER () " +
-		"FROM projections.org_members4 AS members " +
+		"FROM projections.org_members5 AS members " +
 		"LEFT JOIN projections.users13_humans " +
 		"ON members.user_id = projections.users13_humans.user_id " +
 		"AND members.instance_id = projections.users13_humans.instance_id " +
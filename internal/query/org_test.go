@@ -18,19 +18,20 @@ import (
 )
 
 var (
-	orgUniqueQuery = "SELECT COUNT(*) = 0 FROM projections.orgs1 LEFT JOIN projections.org_domains2 ON projections.orgs1.id = projections.org_domains2.org_id AND projections.orgs1.instance_id = projections.org_domains2.instance_id AS OF SYSTEM TIME '-1 ms' WHERE (projections.org_domains2.is_verified = $1 AND projections.orgs1.instance_id = $2 AND (projections.org_domains2.domain ILIKE $3 OR projections.orgs1.name ILIKE $4) AND projections.orgs1.org_state <> $5)"
+	orgUniqueQuery = "SELECT COUNT(*) = 0 FROM projections.orgs2 LEFT JOIN projections.org_domains3 ON projections.orgs2.id = projections.org_domains3.org_id AND projections.orgs2.instance_id = projections.org_domains3.instance_id AS OF SYSTEM TIME '-1 ms' WHERE (projections.org_domains3.is_verified = $1 AND projections.orgs2.instance_id = $2 AND (projections.org_domains3.domain ILIKE $3 OR projections.orgs2.name ILIKE $4) AND projections.orgs2.org_state <> $5)"
 	orgUniqueCols  = []string{"is_unique"}
 
-	prepareOrgsQueryStmt = `SELECT projections.orgs1.id,` +
-		` projections.orgs1.creation_date,` +
-		` projections.orgs1.change_date,` +
-		` projections.orgs1.resource_owner,` +
-		` projections.orgs1.org_state,` +
-		` projections.orgs1.sequence,` +
-		` projections.orgs1.name,` +
-		` projections.orgs1.primary_domain,` +
+	prepareOrgsQueryStmt = `SELECT projections.orgs2.id,` +
+		` projections.orgs2.creation_date,` +
+		` projections.orgs2.change_date,` +
+		` projections.orgs2.resource_owner,` +
+		` projections.orgs2.org_state,` +
+		` projections.orgs2.sequence,` +
+		` projections.orgs2.name,` +
+		` projections.orgs2.primary_domain,` +
+		` projections.orgs2.parent_id,` +
 		` COUNT(*) OVER ()` +
-		` FROM projections.orgs1` +
+		` FROM projections.orgs2` +
 		` AS OF SYSTEM TIME '-1 ms' `
 	prepareOrgsQueryCols = []string{
 		"id",
@@ -41,18 +42,20 @@ var (
 		"sequence",
 		"name",
 		"primary_domain",
+		"parent_id",
 		"count",
 	}
 
-	prepareOrgQueryStmt = `SELECT projections.orgs1.id,` +
-		` projections.orgs1.creation_date,` +
-		` projections.orgs1.change_date,` +
-		` projections.orgs1.resource_owner,` +
-		` projections.orgs1.org_state,` +
-		` projections.orgs1.sequence,` +
-		` projections.orgs1.name,` +
-		` projections.orgs1.primary_domain` +
-		` FROM projections.orgs1` +
+	prepareOrgQueryStmt = `SELECT projections.orgs2.id,` +
+		` projections.orgs2.creation_date,` +
+		` projections.orgs2.change_date,` +
+		` projections.orgs2.resource_owner,` +
+		` projections.orgs2.org_state,` +
+		` projections.orgs2.sequence,` +
+		` projections.orgs2.name,` +
+		` projections.orgs2.primary_domain,` +
+		` projections.orgs2.parent_id` +
+		` FROM projections.orgs2` +
 		` AS OF SYSTEM TIME '-1 ms' `
 	prepareOrgQueryCols = []string{
 		"id",
@@ -63,11 +66,12 @@ var (
 		"sequence",
 		"name",
 		"primary_domain",
+		"parent_id",
 	}
 
 	prepareOrgUniqueStmt = `SELECT COUNT(*) = 0` +
-		` FROM projections.orgs1` +
-		` LEFT JOIN projections.org_domains2 ON projections.orgs1.id = projections.org_domains2.org_id AND projections.orgs1.instance_id = projections.org_domains2.instance_id` +
+		` FROM projections.orgs2` +
+		` LEFT JOIN projections.org_domains3 ON projections.orgs2.id = projections.org_domains3.org_id AND projections.orgs2.instance_id = projections.org_domains3.instance_id` +
 		` AS OF SYSTEM TIME '-1 ms' `
 	prepareOrgUniqueCols = []string{
 		"count",
@@ -114,6 +118,7 @@ func Test_OrgPrepares(t *testing.T) {
 							uint64(20211109),
 							"org-name",
 							"zitadel.ch",
+							"parent-id",
 						},
 					},
 				),
@@ -132,6 +137,7 @@ func Test_OrgPrepares(t *testing.T) {
 						Sequence:      20211109,
 						Name:          "org-name",
 						Domain:        "zitadel.ch",
+						ParentID:      "parent-id",
 					},
 				},
 			},
@@ -153,6 +159,7 @@ func Test_OrgPrepares(t *testing.T) {
 							uint64(20211108),
 							"org-name-1",
 							"zitadel.ch",
+							"",
 						},
 						{
 							"id-2",
@@ -163,6 +170,7 @@ func Test_OrgPrepares(t *testing.T) {
 							uint64(20211108),
 							"org-name-2",
 							"caos.ch",
+							"",
 						},
 					},
 				),
@@ -246,6 +254,7 @@ func Test_OrgPrepares(t *testing.T) {
 						uint64(20211108),
 						"org-name",
 						"zitadel.ch",
+						"",
 					},
 				),
 			},
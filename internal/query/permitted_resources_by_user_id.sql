@@ -0,0 +1,63 @@
+with recursive
+-- only enabled (active or initial) users are granted permissions
+enabled_user as (
+	select id
+	from projections.users13
+	where instance_id = $1
+	and id = $2
+	and state in (1, 6)
+),
+-- find the user's groups, including the groups they are nested in
+memberships as (
+	select m.group_id
+	from projections.groups_members m
+	join enabled_user u on m.member_id = u.id
+	where m.member_type = 1
+	and m.instance_id = $1
+	union
+	select m.group_id
+	from projections.groups_members m
+	join memberships s on m.member_id = s.group_id
+	where m.member_type = 2
+	and m.instance_id = $1
+),
+-- the roles granted to the user directly or through its groups by the organization, scoped by the project grant
+granted_roles as (
+	select ug.resource_owner, ug.grant_id, unnest(ug.roles) as role_key
+	from projections.user_grants6 ug
+	join enabled_user u on ug.user_id = u.id
+	where ug.instance_id = $1
+	and ug.project_id = $3
+	and ug.resource_owner = $5
+	and ug.state = 1
+	and (ug.valid_from is null or ug.valid_from <= now())
+	and (ug.valid_until is null or ug.valid_until > now())
+	union
+	select g.resource_owner, gg.grant_id, unnest(gg.roles)
+	from projections.groups_grants gg
+	join memberships s on gg.group_id = s.group_id
+	join projections.groups g on g.id = gg.group_id and g.instance_id = gg.instance_id
+	where gg.instance_id = $1
+	and gg.project_id = $3
+	and g.resource_owner = $5
+	-- group grants have no state or validity of their own, they are granted as long as the group is active
+	and g.state = 1
+),
+-- expand the granted roles by the roles they include, keeping the granted role for the evaluation of its condition
+effective_roles as (
+	select resource_owner, grant_id, role_key as granted_role_key, role_key
+	from granted_roles
+	union
+	select r.resource_owner, r.grant_id, r.granted_role_key, i.included_role_key
+	from effective_roles r
+	join projections.project_permissions_role_inclusions i on i.role_key = r.role_key
+	where i.instance_id = $1
+	and i.project_id = $3
+)
+select distinct r.resource_owner, r.grant_id, r.granted_role_key
+from effective_roles r
+join projections.project_permissions p on r.role_key = any(p.role_keys)
+where p.instance_id = $1
+and p.project_id = $3
+and p.permission_key = $4
+order by r.resource_owner, r.grant_id, r.granted_role_key;
@@ -29,8 +29,8 @@ var (
 		` COUNT(*) OVER () ` +
 		` FROM projections.project_grants4 ` +
 		` LEFT JOIN projections.projects4 ON projections.project_grants4.project_id = projections.projects4.id AND projections.project_grants4.instance_id = projections.projects4.instance_id ` +
-		` LEFT JOIN projections.orgs1 AS r ON projections.project_grants4.resource_owner = r.id AND projections.project_grants4.instance_id = r.instance_id` +
-		` LEFT JOIN projections.orgs1 AS o ON projections.project_grants4.granted_org_id = o.id AND projections.project_grants4.instance_id = o.instance_id` +
+		` LEFT JOIN projections.orgs2 AS r ON projections.project_grants4.resource_owner = r.id AND projections.project_grants4.instance_id = r.instance_id` +
+		` LEFT JOIN projections.orgs2 AS o ON projections.project_grants4.granted_org_id = o.id AND projections.project_grants4.instance_id = o.instance_id` +
 		` AS OF SYSTEM TIME '-1 ms'`
 	projectGrantsCols = []string{
 		"project_id",
@@ -61,8 +61,8 @@ var (
 		` r.name` +
 		` FROM projections.project_grants4 ` +
 		` LEFT JOIN projections.projects4 ON projections.project_grants4.project_id = projections.projects4.id AND projections.project_grants4.instance_id = projections.projects4.instance_id ` +
-		` LEFT JOIN projections.orgs1 AS r ON projections.project_grants4.resource_owner = r.id AND projections.project_grants4.instance_id = r.instance_id` +
-		` LEFT JOIN projections.orgs1 AS o ON projections.project_grants4.granted_org_id = o.id AND projections.project_grants4.instance_id = o.instance_id` +
+		` LEFT JOIN projections.orgs2 AS r ON projections.project_grants4.resource_owner = r.id AND projections.project_grants4.instance_id = r.instance_id` +
+		` LEFT JOIN projections.orgs2 AS o ON projections.project_grants4.granted_org_id = o.id AND projections.project_grants4.instance_id = o.instance_id` +
 		` AS OF SYSTEM TIME '-1 ms'`
 	projectGrantCols = []string{
 		"project_id",
@@ -0,0 +1,348 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"slices"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	projectPermissionTable = table{
+		name:          projection.ProjectPermissionProjectionTable,
+		instanceIDCol: projection.ProjectPermissionInstanceIDCol,
+	}
+	ProjectPermissionColumnProjectID = Column{
+		name:  projection.ProjectPermissionProjectIDCol,
+		table: projectPermissionTable,
+	}
+	ProjectPermissionColumnKey = Column{
+		name:  projection.ProjectPermissionKeyCol,
+		table: projectPermissionTable,
+	}
+	ProjectPermissionColumnCreationDate = Column{
+		name:  projection.ProjectPermissionCreationDateCol,
+		table: projectPermissionTable,
+	}
+	ProjectPermissionColumnChangeDate = Column{
+		name:  projection.ProjectPermissionChangeDateCol,
+		table: projectPermissionTable,
+	}
+	ProjectPermissionColumnSequence = Column{
+		name:  projection.ProjectPermissionSequenceCol,
+		table: projectPermissionTable,
+	}
+	ProjectPermissionColumnResourceOwner = Column{
+		name:  projection.ProjectPermissionResourceOwnerCol,
+		table: projectPermissionTable,
+	}
+	ProjectPermissionColumnInstanceID = Column{
+		name:  projection.ProjectPermissionInstanceIDCol,
+		table: projectPermissionTable,
+	}
+	ProjectPermissionColumnDisplayName = Column{
+		name:  projection.ProjectPermissionDisplayNameCol,
+		table: projectPermissionTable,
+	}
+	ProjectPermissionColumnRoleKeys = Column{
+		name:  projection.ProjectPermissionRoleKeysCol,
+		table: projectPermissionTable,
+	}
+
+	projectRoleInclusionTable = table{
+		name:          projection.ProjectRoleInclusionTable,
+		instanceIDCol: projection.ProjectRoleInclusionInstanceIDCol,
+	}
+	ProjectRoleInclusionColumnProjectID = Column{
+		name:  projection.ProjectRoleInclusionProjectIDCol,
+		table: projectRoleInclusionTable,
+	}
+	ProjectRoleInclusionColumnRoleKey = Column{
+		name:  projection.ProjectRoleInclusionRoleKeyCol,
+		table: projectRoleInclusionTable,
+	}
+	ProjectRoleInclusionColumnIncludedKey = Column{
+		name:  projection.ProjectRoleInclusionIncludedKeyCol,
+		table: projectRoleInclusionTable,
+	}
+	ProjectRoleInclusionColumnResourceOwner = Column{
+		name:  projection.ProjectRoleInclusionResourceOwnerCol,
+		table: projectRoleInclusionTable,
+	}
+	ProjectRoleInclusionColumnInstanceID = Column{
+		name:  projection.ProjectRoleInclusionInstanceIDCol,
+		table: projectRoleInclusionTable,
+	}
+)
+
+type ProjectPermissions struct {
+	SearchResponse
+	Permissions []*ProjectPermission
+}
+
+func (p *ProjectPermissions) SetState(s *State) {
+	p.State = s
+}
+
+type ProjectPermission struct {
+	ProjectID     string
+	Key           string
+	CreationDate  time.Time
+	ChangeDate    time.Time
+	Sequence      uint64
+	ResourceOwner string
+	DisplayName   string
+	RoleKeys      database.TextArray[string]
+}
+
+type ProjectPermissionSearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+func (q *ProjectPermissionSearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+type ProjectRoleInclusion struct {
+	RoleKey         string
+	IncludedRoleKey string
+}
+
+// PermittedResource is the scope in which a user was granted a permission:
+// the organization granting the roles and, if granted through a project grant, the project grant.
+type PermittedResource struct {
+	OrgID          string
+	ProjectGrantID string
+}
+
+func (q *Queries) SearchProjectPermissions(ctx context.Context, projectID string, queries *ProjectPermissionSearchQueries) (_ *ProjectPermissions, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	eq := sq.Eq{
+		ProjectPermissionColumnProjectID.identifier():  projectID,
+		ProjectPermissionColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}
+	query, scan := prepareProjectPermissionsQuery()
+	return genericRowsQueryWithState[*ProjectPermissions](ctx, q.client, projectPermissionTable, combineToWhereStmt(query, queries.toQuery, eq), scan)
+}
+
+// ProjectRoleInclusions returns the roles directly included in the roles of the project.
+func (q *Queries) ProjectRoleInclusions(ctx context.Context, resourceOwner, projectID string) (_ []*ProjectRoleInclusion, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	eq := sq.Eq{
+		ProjectRoleInclusionColumnProjectID.identifier():  projectID,
+		ProjectRoleInclusionColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}
+	if resourceOwner != "" {
+		eq[ProjectRoleInclusionColumnResourceOwner.identifier()] = resourceOwner
+	}
+	query, scan := prepareProjectRoleInclusionsQuery()
+	return genericRowsQuery[[]*ProjectRoleInclusion](ctx, q.client, query.Where(eq), scan)
+}
+
+//go:embed permitted_resources_by_user_id.sql
+var permittedResourcesByUserIDQuery string
+
+// permittedRole is a role granted to the user, which is or includes a role mapped to the permission.
+type permittedRole struct {
+	resource PermittedResource
+	roleKey  string
+}
+
+// PermittedResources returns the resources (organizations and project grants) on which
+// the user is granted the permission of the project by the organization (resourceOwner).
+// The project must be owned by or granted to the organization.
+// The permission is granted if any role granted to the enabled user, directly or through its groups,
+// is mapped to the permission or includes (through further inclusions) a mapped role
+// and the condition of the granted role is satisfied.
+func (q *Queries) PermittedResources(ctx context.Context, resourceOwner, userID, projectID, permission string) (_ []*PermittedResource, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if err = q.checkProjectOwnedOrGranted(ctx, projectID, resourceOwner); err != nil {
+		return nil, err
+	}
+	roles := make([]*permittedRole, 0)
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		for rows.Next() {
+			role := new(permittedRole)
+			if err := rows.Scan(&role.resource.OrgID, &role.resource.ProjectGrantID, &role.roleKey); err != nil {
+				return err
+			}
+			roles = append(roles, role)
+		}
+		return rows.Err()
+	}, permittedResourcesByUserIDQuery, authz.GetInstance(ctx).InstanceID(), userID, projectID, permission, resourceOwner)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Pr1us", "Errors.Internal")
+	}
+	conditions, err := q.ProjectRoleConditions(ctx, "", projectID)
+	if err != nil {
+		return nil, err
+	}
+	return filterPermittedResources(projectID, roles, conditions, time.Now()), nil
+}
+
+// CheckPermission reports if the user is granted the permission of the project by the organization (resourceOwner),
+// see [Queries.PermittedResources].
+// The check can be scoped to a project grant, an empty value matches any project grant.
+func (q *Queries) CheckPermission(ctx context.Context, resourceOwner, userID, projectID, permission, projectGrantID string) (_ bool, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	resources, err := q.PermittedResources(ctx, resourceOwner, userID, projectID, permission)
+	if err != nil {
+		return false, err
+	}
+	for _, resource := range resources {
+		if projectGrantID == "" || resource.ProjectGrantID == projectGrantID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// checkProjectOwnedOrGranted returns a permission denied error
+// if the project is neither owned by nor actively granted to the organization.
+func (q *Queries) checkProjectOwnedOrGranted(ctx context.Context, projectID, orgID string) error {
+	project, err := q.ProjectByID(ctx, false, projectID)
+	if err != nil {
+		return err
+	}
+	if project.ResourceOwner == orgID {
+		return nil
+	}
+	projectQuery, err := NewProjectGrantProjectIDSearchQuery(projectID)
+	if err != nil {
+		return err
+	}
+	grantedOrgQuery, err := NewProjectGrantGrantedOrgIDSearchQuery(orgID)
+	if err != nil {
+		return err
+	}
+	grants, err := q.SearchProjectGrants(ctx, &ProjectGrantSearchQueries{Queries: []SearchQuery{projectQuery, grantedOrgQuery}})
+	if err != nil {
+		return err
+	}
+	for _, grant := range grants.ProjectGrants {
+		if grant.State == domain.ProjectGrantStateActive {
+			return nil
+		}
+	}
+	return zerrors.ThrowPermissionDenied(nil, "QUERY-Pr2pd", "Errors.PermissionDenied")
+}
+
+// filterPermittedResources returns the distinct resources of the roles whose condition is satisfied at the time.
+// The user's session is unknown, so conditions on the authentication or the network of the session aren't satisfied.
+func filterPermittedResources(projectID string, roles []*permittedRole, conditions ProjectRoleConditions, now time.Time) []*PermittedResource {
+	session := &domain.RoleConditionContext{Time: now}
+	resources := make([]*PermittedResource, 0, len(roles))
+	for _, role := range roles {
+		if !conditions.Get(projectID, role.roleKey).Satisfied(role.resource.OrgID, session) {
+			continue
+		}
+		if slices.ContainsFunc(resources, func(resource *PermittedResource) bool { return *resource == role.resource }) {
+			continue
+		}
+		resource := role.resource
+		resources = append(resources, &resource)
+	}
+	return resources
+}
+
+func NewProjectPermissionKeySearchQuery(value string, method TextComparison) (SearchQuery, error) {
+	return NewTextQuery(ProjectPermissionColumnKey, value, method)
+}
+
+func NewProjectPermissionResourceOwnerSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(ProjectPermissionColumnResourceOwner, value, TextEquals)
+}
+
+func prepareProjectPermissionsQuery() (sq.SelectBuilder, func(*sql.Rows) (*ProjectPermissions, error)) {
+	return sq.Select(
+			ProjectPermissionColumnProjectID.identifier(),
+			ProjectPermissionColumnKey.identifier(),
+			ProjectPermissionColumnCreationDate.identifier(),
+			ProjectPermissionColumnChangeDate.identifier(),
+			ProjectPermissionColumnSequence.identifier(),
+			ProjectPermissionColumnResourceOwner.identifier(),
+			ProjectPermissionColumnDisplayName.identifier(),
+			ProjectPermissionColumnRoleKeys.identifier(),
+			countColumn.identifier(),
+		).From(projectPermissionTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*ProjectPermissions, error) {
+			permissions := make([]*ProjectPermission, 0)
+			var count uint64
+			for rows.Next() {
+				p := new(ProjectPermission)
+				err := rows.Scan(
+					&p.ProjectID,
+					&p.Key,
+					&p.CreationDate,
+					&p.ChangeDate,
+					&p.Sequence,
+					&p.ResourceOwner,
+					&p.DisplayName,
+					&p.RoleKeys,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				permissions = append(permissions, p)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Pp1cr", "Errors.Query.CloseRows")
+			}
+
+			return &ProjectPermissions{
+				Permissions: permissions,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
+
+func prepareProjectRoleInclusionsQuery() (sq.SelectBuilder, func(*sql.Rows) ([]*ProjectRoleInclusion, error)) {
+	return sq.Select(
+			ProjectRoleInclusionColumnRoleKey.identifier(),
+			ProjectRoleInclusionColumnIncludedKey.identifier(),
+		).From(projectRoleInclusionTable.identifier()).
+			OrderBy(ProjectRoleInclusionColumnRoleKey.identifier(), ProjectRoleInclusionColumnIncludedKey.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) ([]*ProjectRoleInclusion, error) {
+			inclusions := make([]*ProjectRoleInclusion, 0)
+			for rows.Next() {
+				i := new(ProjectRoleInclusion)
+				if err := rows.Scan(&i.RoleKey, &i.IncludedRoleKey); err != nil {
+					return nil, err
+				}
+				inclusions = append(inclusions, i)
+			}
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Ri1cr", "Errors.Query.CloseRows")
+			}
+			return inclusions, nil
+		}
+}
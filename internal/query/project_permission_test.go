@@ -0,0 +1,220 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+var (
+	prepareProjectPermissionsStmt = `SELECT projections.project_permissions.project_id,` +
+		` projections.project_permissions.permission_key,` +
+		` projections.project_permissions.creation_date,` +
+		` projections.project_permissions.change_date,` +
+		` projections.project_permissions.sequence,` +
+		` projections.project_permissions.resource_owner,` +
+		` projections.project_permissions.display_name,` +
+		` projections.project_permissions.role_keys,` +
+		` COUNT(*) OVER ()` +
+		` FROM projections.project_permissions`
+	prepareProjectPermissionsCols = []string{
+		"project_id",
+		"permission_key",
+		"creation_date",
+		"change_date",
+		"sequence",
+		"resource_owner",
+		"display_name",
+		"role_keys",
+		"count",
+	}
+
+	prepareProjectRoleInclusionsStmt = `SELECT projections.project_permissions_role_inclusions.role_key,` +
+		` projections.project_permissions_role_inclusions.included_role_key` +
+		` FROM projections.project_permissions_role_inclusions` +
+		` ORDER BY projections.project_permissions_role_inclusions.role_key, projections.project_permissions_role_inclusions.included_role_key`
+	prepareProjectRoleInclusionsCols = []string{
+		"role_key",
+		"included_role_key",
+	}
+)
+
+func Test_ProjectPermissionPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareProjectPermissionsQuery no result",
+			prepare: prepareProjectPermissionsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareProjectPermissionsStmt),
+					nil,
+					nil,
+				),
+			},
+			object: &ProjectPermissions{Permissions: []*ProjectPermission{}},
+		},
+		{
+			name:    "prepareProjectPermissionsQuery one result",
+			prepare: prepareProjectPermissionsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareProjectPermissionsStmt),
+					prepareProjectPermissionsCols,
+					[][]driver.Value{
+						{
+							"project-id",
+							"document.read",
+							testNow,
+							testNow,
+							uint64(20211109),
+							"ro",
+							"Read documents",
+							database.TextArray[string]{"reader"},
+						},
+					},
+				),
+			},
+			object: &ProjectPermissions{
+				SearchResponse: SearchResponse{
+					Count: 1,
+				},
+				Permissions: []*ProjectPermission{
+					{
+						ProjectID:     "project-id",
+						Key:           "document.read",
+						CreationDate:  testNow,
+						ChangeDate:    testNow,
+						Sequence:      20211109,
+						ResourceOwner: "ro",
+						DisplayName:   "Read documents",
+						RoleKeys:      database.TextArray[string]{"reader"},
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareProjectPermissionsQuery sql err",
+			prepare: prepareProjectPermissionsQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareProjectPermissionsStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*ProjectPermissions)(nil),
+		},
+		{
+			name:    "prepareProjectRoleInclusionsQuery multiple result",
+			prepare: prepareProjectRoleInclusionsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareProjectRoleInclusionsStmt),
+					prepareProjectRoleInclusionsCols,
+					[][]driver.Value{
+						{"admin", "writer"},
+						{"writer", "reader"},
+					},
+				),
+			},
+			object: []*ProjectRoleInclusion{
+				{RoleKey: "admin", IncludedRoleKey: "writer"},
+				{RoleKey: "writer", IncludedRoleKey: "reader"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err)
+		})
+	}
+}
+
+func Test_filterPermittedResources(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	conditions := ProjectRoleConditions{
+		"project": {
+			"mfa":         {MFALevel: domain.MFALevelMultiFactor},
+			"office":      {TimeOfDayStart: "08:00", TimeOfDayEnd: "18:00", TimeZone: "UTC"},
+			"night":       {TimeOfDayStart: "22:00", TimeOfDayEnd: "06:00", TimeZone: "UTC"},
+			"other-org":   {OrgIDs: []string{"org2"}},
+			"granted-org": {OrgIDs: []string{"org1"}},
+		},
+	}
+	tests := []struct {
+		name  string
+		roles []*permittedRole
+		want  []*PermittedResource
+	}{
+		{
+			name: "no conditions",
+			roles: []*permittedRole{
+				{resource: PermittedResource{OrgID: "org1"}, roleKey: "admin"},
+				{resource: PermittedResource{OrgID: "org1", ProjectGrantID: "grant1"}, roleKey: "admin"},
+			},
+			want: []*PermittedResource{
+				{OrgID: "org1"},
+				{OrgID: "org1", ProjectGrantID: "grant1"},
+			},
+		},
+		{
+			name: "distinct resources",
+			roles: []*permittedRole{
+				{resource: PermittedResource{OrgID: "org1"}, roleKey: "admin"},
+				{resource: PermittedResource{OrgID: "org1"}, roleKey: "office"},
+			},
+			want: []*PermittedResource{
+				{OrgID: "org1"},
+			},
+		},
+		{
+			name: "session conditions not satisfied",
+			roles: []*permittedRole{
+				{resource: PermittedResource{OrgID: "org1"}, roleKey: "mfa"},
+			},
+			want: []*PermittedResource{},
+		},
+		{
+			name: "time and org conditions",
+			roles: []*permittedRole{
+				{resource: PermittedResource{OrgID: "org1"}, roleKey: "night"},
+				{resource: PermittedResource{OrgID: "org1"}, roleKey: "other-org"},
+				{resource: PermittedResource{OrgID: "org1", ProjectGrantID: "grant1"}, roleKey: "office"},
+				{resource: PermittedResource{OrgID: "org1", ProjectGrantID: "grant2"}, roleKey: "granted-org"},
+			},
+			want: []*PermittedResource{
+				{OrgID: "org1", ProjectGrantID: "grant1"},
+				{OrgID: "org1", ProjectGrantID: "grant2"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := filterPermittedResources("project", tt.roles, conditions, now)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
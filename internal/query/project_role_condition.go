@@ -0,0 +1,181 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"slices"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	projectRoleConditionTable = table{
+		name:          projection.ProjectRoleConditionProjectionTable,
+		instanceIDCol: projection.ProjectRoleConditionInstanceIDCol,
+	}
+	ProjectRoleConditionColumnProjectID = Column{
+		name:  projection.ProjectRoleConditionProjectIDCol,
+		table: projectRoleConditionTable,
+	}
+	ProjectRoleConditionColumnRoleKey = Column{
+		name:  projection.ProjectRoleConditionRoleKeyCol,
+		table: projectRoleConditionTable,
+	}
+	ProjectRoleConditionColumnResourceOwner = Column{
+		name:  projection.ProjectRoleConditionResourceOwnerCol,
+		table: projectRoleConditionTable,
+	}
+	ProjectRoleConditionColumnInstanceID = Column{
+		name:  projection.ProjectRoleConditionInstanceIDCol,
+		table: projectRoleConditionTable,
+	}
+	ProjectRoleConditionColumnOrgIDs = Column{
+		name:  projection.ProjectRoleConditionOrgIDsCol,
+		table: projectRoleConditionTable,
+	}
+	ProjectRoleConditionColumnMFALevel = Column{
+		name:  projection.ProjectRoleConditionMFALevelCol,
+		table: projectRoleConditionTable,
+	}
+	ProjectRoleConditionColumnAMR = Column{
+		name:  projection.ProjectRoleConditionAMRCol,
+		table: projectRoleConditionTable,
+	}
+	ProjectRoleConditionColumnIPRanges = Column{
+		name:  projection.ProjectRoleConditionIPRangesCol,
+		table: projectRoleConditionTable,
+	}
+	ProjectRoleConditionColumnTimeOfDayStart = Column{
+		name:  projection.ProjectRoleConditionTimeOfDayStartCol,
+		table: projectRoleConditionTable,
+	}
+	ProjectRoleConditionColumnTimeOfDayEnd = Column{
+		name:  projection.ProjectRoleConditionTimeOfDayEndCol,
+		table: projectRoleConditionTable,
+	}
+	ProjectRoleConditionColumnTimeZone = Column{
+		name:  projection.ProjectRoleConditionTimeZoneCol,
+		table: projectRoleConditionTable,
+	}
+)
+
+// ProjectRoleConditions are the conditions of project roles by project id and role key.
+type ProjectRoleConditions map[string]map[string]*domain.ProjectRoleCondition
+
+// Get returns the condition of the role or nil if the role has no condition.
+func (c ProjectRoleConditions) Get(projectID, roleKey string) *domain.ProjectRoleCondition {
+	return c[projectID][roleKey]
+}
+
+// FilterRoles returns the roles granted by the organization (orgID) which can be asserted for the session.
+func (c ProjectRoleConditions) FilterRoles(projectID, orgID string, roles []string, session *domain.RoleConditionContext) []string {
+	conditions := c[projectID]
+	if len(conditions) == 0 {
+		return roles
+	}
+	filtered := make([]string, 0, len(roles))
+	for _, role := range roles {
+		if conditions[role].Satisfied(orgID, session) {
+			filtered = append(filtered, role)
+		}
+	}
+	return filtered
+}
+
+// ProjectRoleConditions returns the conditions of the roles of the projects.
+// If resourceOwner is set, only conditions of projects owned by the organization are returned.
+func (q *Queries) ProjectRoleConditions(ctx context.Context, resourceOwner string, projectIDs ...string) (_ ProjectRoleConditions, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if len(projectIDs) == 0 {
+		return ProjectRoleConditions{}, nil
+	}
+	eq := sq.Eq{
+		ProjectRoleConditionColumnProjectID.identifier():  projectIDs,
+		ProjectRoleConditionColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}
+	if resourceOwner != "" {
+		eq[ProjectRoleConditionColumnResourceOwner.identifier()] = resourceOwner
+	}
+	query, scan := prepareProjectRoleConditionsQuery()
+	return genericRowsQuery[ProjectRoleConditions](ctx, q.client, query.Where(eq), scan)
+}
+
+// FilterUserGrantRoles removes the roles which can't be asserted for the session from the user grants.
+func (q *Queries) FilterUserGrantRoles(ctx context.Context, session *domain.RoleConditionContext, grants ...*UserGrant) error {
+	if len(grants) == 0 {
+		return nil
+	}
+	projectIDs := make([]string, 0, len(grants))
+	for _, grant := range grants {
+		if !slices.Contains(projectIDs, grant.ProjectID) {
+			projectIDs = append(projectIDs, grant.ProjectID)
+		}
+	}
+	conditions, err := q.ProjectRoleConditions(ctx, "", projectIDs...)
+	if err != nil {
+		return err
+	}
+	for _, grant := range grants {
+		grant.Roles = conditions.FilterRoles(grant.ProjectID, grant.ResourceOwner, grant.Roles, session)
+	}
+	return nil
+}
+
+func prepareProjectRoleConditionsQuery() (sq.SelectBuilder, func(*sql.Rows) (ProjectRoleConditions, error)) {
+	return sq.Select(
+			ProjectRoleConditionColumnProjectID.identifier(),
+			ProjectRoleConditionColumnRoleKey.identifier(),
+			ProjectRoleConditionColumnOrgIDs.identifier(),
+			ProjectRoleConditionColumnMFALevel.identifier(),
+			ProjectRoleConditionColumnAMR.identifier(),
+			ProjectRoleConditionColumnIPRanges.identifier(),
+			ProjectRoleConditionColumnTimeOfDayStart.identifier(),
+			ProjectRoleConditionColumnTimeOfDayEnd.identifier(),
+			ProjectRoleConditionColumnTimeZone.identifier(),
+		).From(projectRoleConditionTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (ProjectRoleConditions, error) {
+			conditions := make(ProjectRoleConditions)
+			for rows.Next() {
+				var (
+					projectID, roleKey    string
+					orgIDs, amr, ipRanges database.TextArray[string]
+					condition             = new(domain.ProjectRoleCondition)
+				)
+				err := rows.Scan(
+					&projectID,
+					&roleKey,
+					&orgIDs,
+					&condition.MFALevel,
+					&amr,
+					&ipRanges,
+					&condition.TimeOfDayStart,
+					&condition.TimeOfDayEnd,
+					&condition.TimeZone,
+				)
+				if err != nil {
+					return nil, err
+				}
+				condition.OrgIDs = orgIDs
+				condition.AMR = amr
+				condition.IPRanges = ipRanges
+				if conditions[projectID] == nil {
+					conditions[projectID] = make(map[string]*domain.ProjectRoleCondition)
+				}
+				conditions[projectID][roleKey] = condition
+			}
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Rc1cr", "Errors.Query.CloseRows")
+			}
+			return conditions, nil
+		}
+}
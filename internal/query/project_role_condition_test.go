@@ -0,0 +1,153 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+var (
+	prepareProjectRoleConditionsStmt = `SELECT projections.project_role_conditions.project_id,` +
+		` projections.project_role_conditions.role_key,` +
+		` projections.project_role_conditions.org_ids,` +
+		` projections.project_role_conditions.mfa_level,` +
+		` projections.project_role_conditions.amr,` +
+		` projections.project_role_conditions.ip_ranges,` +
+		` projections.project_role_conditions.time_of_day_start,` +
+		` projections.project_role_conditions.time_of_day_end,` +
+		` projections.project_role_conditions.time_zone` +
+		` FROM projections.project_role_conditions`
+	prepareProjectRoleConditionsCols = []string{
+		"project_id",
+		"role_key",
+		"org_ids",
+		"mfa_level",
+		"amr",
+		"ip_ranges",
+		"time_of_day_start",
+		"time_of_day_end",
+		"time_zone",
+	}
+)
+
+func Test_ProjectRoleConditionPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareProjectRoleConditionsQuery no result",
+			prepare: prepareProjectRoleConditionsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareProjectRoleConditionsStmt),
+					nil,
+					nil,
+				),
+			},
+			object: ProjectRoleConditions{},
+		},
+		{
+			name:    "prepareProjectRoleConditionsQuery multiple result",
+			prepare: prepareProjectRoleConditionsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareProjectRoleConditionsStmt),
+					prepareProjectRoleConditionsCols,
+					[][]driver.Value{
+						{
+							"project-id",
+							"admin",
+							database.TextArray[string]{"org-id"},
+							domain.MFALevelMultiFactorCertified,
+							database.TextArray[string]{"user"},
+							database.TextArray[string]{"10.0.0.0/8"},
+							"08:00",
+							"18:00",
+							"Europe/Zurich",
+						},
+						{
+							"project-id",
+							"auditor",
+							nil,
+							domain.MFALevelSecondFactor,
+							nil,
+							nil,
+							"",
+							"",
+							"",
+						},
+					},
+				),
+			},
+			object: ProjectRoleConditions{
+				"project-id": {
+					"admin": {
+						OrgIDs:         database.TextArray[string]{"org-id"},
+						MFALevel:       domain.MFALevelMultiFactorCertified,
+						AMR:            database.TextArray[string]{"user"},
+						IPRanges:       database.TextArray[string]{"10.0.0.0/8"},
+						TimeOfDayStart: "08:00",
+						TimeOfDayEnd:   "18:00",
+						TimeZone:       "Europe/Zurich",
+					},
+					"auditor": {
+						OrgIDs:   []string{},
+						MFALevel: domain.MFALevelSecondFactor,
+						AMR:      []string{},
+						IPRanges: []string{},
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareProjectRoleConditionsQuery sql err",
+			prepare: prepareProjectRoleConditionsQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareProjectRoleConditionsStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (ProjectRoleConditions)(nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err)
+		})
+	}
+}
+
+func TestProjectRoleConditions_FilterRoles(t *testing.T) {
+	conditions := ProjectRoleConditions{
+		"project-id": {
+			"admin": {MFALevel: domain.MFALevelMultiFactorCertified},
+		},
+	}
+	assert.Equal(t, []string{"admin", "reader"}, conditions.FilterRoles("other-project", "org-id", []string{"admin", "reader"}, nil))
+	assert.Equal(t, []string{"reader"}, conditions.FilterRoles("project-id", "org-id", []string{"admin", "reader"}, nil))
+	assert.Equal(t, []string{"admin", "reader"}, conditions.FilterRoles("project-id", "org-id", []string{"admin", "reader"}, &domain.RoleConditionContext{
+		AuthMethods: []domain.UserAuthMethodType{domain.UserAuthMethodTypePassword, domain.UserAuthMethodTypeU2F},
+	}))
+}
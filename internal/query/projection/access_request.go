@@ -0,0 +1,238 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/accessrequest"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+const (
+	AccessRequestTable = "projections.access_requests3"
+
+	AccessRequestIDCol            = "id"
+	AccessRequestCreationDateCol  = "creation_date"
+	AccessRequestChangeDateCol    = "change_date"
+	AccessRequestSequenceCol      = "sequence"
+	AccessRequestStateCol         = "state"
+	AccessRequestResourceOwnerCol = "resource_owner"
+	AccessRequestInstanceIDCol    = "instance_id"
+	AccessRequestUserIDCol        = "user_id"
+	AccessRequestScopeCol         = "scope"
+	AccessRequestRolesCol         = "roles"
+	AccessRequestDurationCol      = "duration"
+	AccessRequestReasonCol        = "reason"
+	AccessRequestDecidedByCol     = "decided_by"
+	AccessRequestValidUntilCol    = "valid_until"
+	AccessRequestElevationCol     = "elevation"
+)
+
+type accessRequestProjection struct{}
+
+func newAccessRequestProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(accessRequestProjection))
+}
+
+func (*accessRequestProjection) Name() string {
+	return AccessRequestTable
+}
+
+func (*accessRequestProjection) Init() *old_handler.Check {
+	return handler.NewTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(AccessRequestIDCol, handler.ColumnTypeText),
+			handler.NewColumn(AccessRequestCreationDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(AccessRequestChangeDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(AccessRequestSequenceCol, handler.ColumnTypeInt64),
+			handler.NewColumn(AccessRequestStateCol, handler.ColumnTypeEnum),
+			handler.NewColumn(AccessRequestResourceOwnerCol, handler.ColumnTypeText),
+			handler.NewColumn(AccessRequestInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(AccessRequestUserIDCol, handler.ColumnTypeText),
+			handler.NewColumn(AccessRequestScopeCol, handler.ColumnTypeEnum),
+			handler.NewColumn(AccessRequestRolesCol, handler.ColumnTypeTextArray),
+			handler.NewColumn(AccessRequestDurationCol, handler.ColumnTypeInterval),
+			handler.NewColumn(AccessRequestReasonCol, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(AccessRequestDecidedByCol, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(AccessRequestValidUntilCol, handler.ColumnTypeTimestamp, handler.Nullable()),
+			handler.NewColumn(AccessRequestElevationCol, handler.ColumnTypeBool, handler.Default(false)),
+		},
+			handler.NewPrimaryKey(AccessRequestInstanceIDCol, AccessRequestIDCol),
+			handler.WithIndex(handler.NewIndex("resource_owner", []string{AccessRequestResourceOwnerCol})),
+			handler.WithIndex(handler.NewIndex("user_id", []string{AccessRequestUserIDCol})),
+		),
+	)
+}
+
+func (p *accessRequestProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: accessrequest.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  accessrequest.RequestedEventType,
+					Reduce: p.reduceRequested,
+				},
+				{
+					Event:  accessrequest.ApprovedEventType,
+					Reduce: p.reduceApproved,
+				},
+				{
+					Event:  accessrequest.DeniedEventType,
+					Reduce: p.reduceDenied,
+				},
+				{
+					Event:  accessrequest.ElevationEndedEventType,
+					Reduce: p.reduceElevationEnded,
+				},
+			},
+		},
+		{
+			Aggregate: user.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  user.UserRemovedType,
+					Reduce: p.reduceUserRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(AccessRequestInstanceIDCol),
+				},
+			},
+		},
+	}
+}
+
+func (p *accessRequestProjection) reduceRequested(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*accessrequest.RequestedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewCreateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(AccessRequestIDCol, e.Aggregate().ID),
+			handler.NewCol(AccessRequestCreationDateCol, e.CreatedAt()),
+			handler.NewCol(AccessRequestChangeDateCol, e.CreatedAt()),
+			handler.NewCol(AccessRequestSequenceCol, e.Sequence()),
+			handler.NewCol(AccessRequestStateCol, domain.AccessRequestStatePending),
+			handler.NewCol(AccessRequestResourceOwnerCol, e.Aggregate().ResourceOwner),
+			handler.NewCol(AccessRequestInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCol(AccessRequestUserIDCol, e.UserID),
+			handler.NewCol(AccessRequestScopeCol, e.Scope),
+			handler.NewCol(AccessRequestRolesCol, database.TextArray[string](e.Roles)),
+			handler.NewCol(AccessRequestDurationCol, e.Duration),
+			handler.NewCol(AccessRequestReasonCol, e.Reason),
+		},
+	), nil
+}
+
+func (p *accessRequestProjection) reduceApproved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*accessrequest.ApprovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(AccessRequestChangeDateCol, e.CreatedAt()),
+			handler.NewCol(AccessRequestSequenceCol, e.Sequence()),
+			handler.NewCol(AccessRequestStateCol, domain.AccessRequestStateApproved),
+			handler.NewCol(AccessRequestDecidedByCol, e.Creator()),
+			handler.NewCol(AccessRequestValidUntilCol, e.ValidUntil),
+			handler.NewCol(AccessRequestElevationCol, e.Elevation),
+		},
+		[]handler.Condition{
+			handler.NewCond(AccessRequestIDCol, e.Aggregate().ID),
+			handler.NewCond(AccessRequestInstanceIDCol, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *accessRequestProjection) reduceDenied(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*accessrequest.DeniedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(AccessRequestChangeDateCol, e.CreatedAt()),
+			handler.NewCol(AccessRequestSequenceCol, e.Sequence()),
+			handler.NewCol(AccessRequestStateCol, domain.AccessRequestStateDenied),
+			handler.NewCol(AccessRequestDecidedByCol, e.Creator()),
+		},
+		[]handler.Condition{
+			handler.NewCond(AccessRequestIDCol, e.Aggregate().ID),
+			handler.NewCond(AccessRequestInstanceIDCol, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+// reduceElevationEnded clears the elevation, so the requested roles are no longer granted in addition to the membership.
+func (p *accessRequestProjection) reduceElevationEnded(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*accessrequest.ElevationEndedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(AccessRequestChangeDateCol, e.CreatedAt()),
+			handler.NewCol(AccessRequestSequenceCol, e.Sequence()),
+			handler.NewCol(AccessRequestElevationCol, false),
+		},
+		[]handler.Condition{
+			handler.NewCond(AccessRequestIDCol, e.Aggregate().ID),
+			handler.NewCond(AccessRequestInstanceIDCol, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *accessRequestProjection) reduceUserRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*user.UserRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(AccessRequestUserIDCol, e.Aggregate().ID),
+			handler.NewCond(AccessRequestInstanceIDCol, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *accessRequestProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.OrgRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(AccessRequestResourceOwnerCol, e.Aggregate().ID),
+			handler.NewCond(AccessRequestInstanceIDCol, e.Aggregate().InstanceID),
+		},
+	), nil
+}
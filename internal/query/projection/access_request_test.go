@@ -0,0 +1,232 @@
+package projection
+
+import (
+	"testing"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/accessrequest"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestAccessRequestProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceRequested",
+			args: args{
+				event: getEvent(testEvent(
+					accessrequest.RequestedEventType,
+					accessrequest.AggregateType,
+					[]byte(`{
+						"userId": "user-id",
+						"scope": 2,
+						"roles": ["ORG_OWNER"],
+						"duration": 3600000000000,
+						"reason": "incident"
+					}`),
+				), eventstore.GenericEventMapper[accessrequest.RequestedEvent]),
+			},
+			reduce: (&accessRequestProjection{}).reduceRequested,
+			want: wantReduce{
+				aggregateType: accessrequest.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.access_requests3 (id, creation_date, change_date, sequence, state, resource_owner, instance_id, user_id, scope, roles, duration, reason) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								domain.AccessRequestStatePending,
+								"ro-id",
+								"instance-id",
+								"user-id",
+								domain.AccessRequestScopeOrg,
+								database.TextArray[string]{"ORG_OWNER"},
+								time.Hour,
+								"incident",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceApproved",
+			args: args{
+				event: getEvent(testEvent(
+					accessrequest.ApprovedEventType,
+					accessrequest.AggregateType,
+					[]byte(`{
+						"validUntil": "2024-01-01T01:00:00Z",
+						"elevation": true
+					}`),
+				), eventstore.GenericEventMapper[accessrequest.ApprovedEvent]),
+			},
+			reduce: (&accessRequestProjection{}).reduceApproved,
+			want: wantReduce{
+				aggregateType: accessrequest.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.access_requests3 SET (change_date, sequence, state, decided_by, valid_until, elevation) = ($1, $2, $3, $4, $5, $6) WHERE (id = $7) AND (instance_id = $8)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								domain.AccessRequestStateApproved,
+								"editor-user",
+								time.Date(2024, 1, 1, 1, 0, 0, 0, time.UTC),
+								true,
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceDenied",
+			args: args{
+				event: getEvent(testEvent(
+					accessrequest.DeniedEventType,
+					accessrequest.AggregateType,
+					[]byte(`{
+						"reason": "not needed"
+					}`),
+				), eventstore.GenericEventMapper[accessrequest.DeniedEvent]),
+			},
+			reduce: (&accessRequestProjection{}).reduceDenied,
+			want: wantReduce{
+				aggregateType: accessrequest.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.access_requests3 SET (change_date, sequence, state, decided_by) = ($1, $2, $3, $4) WHERE (id = $5) AND (instance_id = $6)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								domain.AccessRequestStateDenied,
+								"editor-user",
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceElevationEnded",
+			args: args{
+				event: getEvent(testEvent(
+					accessrequest.ElevationEndedEventType,
+					accessrequest.AggregateType,
+					[]byte(`{}`),
+				), eventstore.GenericEventMapper[accessrequest.ElevationEndedEvent]),
+			},
+			reduce: (&accessRequestProjection{}).reduceElevationEnded,
+			want: wantReduce{
+				aggregateType: accessrequest.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.access_requests3 SET (change_date, sequence, elevation) = ($1, $2, $3) WHERE (id = $4) AND (instance_id = $5)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								false,
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "org reduceOwnerRemoved",
+			args: args{
+				event: getEvent(testEvent(
+					org.OrgRemovedEventType,
+					org.AggregateType,
+					nil,
+				), org.OrgRemovedEventMapper),
+			},
+			reduce: (&accessRequestProjection{}).reduceOwnerRemoved,
+			want: wantReduce{
+				aggregateType: org.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.access_requests3 WHERE (resource_owner = $1) AND (instance_id = $2)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance reduceInstanceRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.InstanceRemovedEventType,
+						instance.AggregateType,
+						nil,
+					),
+					instance.InstanceRemovedEventMapper,
+				),
+			},
+			reduce: reduceInstanceRemovedHelper(AccessRequestInstanceIDCol),
+			want: wantReduce{
+				aggregateType: instance.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.access_requests3 WHERE (instance_id = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if !zerrors.IsErrorInvalidArgument(err) {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, AccessRequestTable, tt.want)
+		})
+	}
+}
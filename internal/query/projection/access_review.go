@@ -0,0 +1,301 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/accessreview"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+)
+
+const (
+	AccessReviewTable     = "projections.access_reviews"
+	AccessReviewItemTable = AccessReviewTable + "_" + AccessReviewItemSuffix
+
+	AccessReviewIDCol                 = "id"
+	AccessReviewCreationDateCol       = "creation_date"
+	AccessReviewChangeDateCol         = "change_date"
+	AccessReviewSequenceCol           = "sequence"
+	AccessReviewStateCol              = "state"
+	AccessReviewResourceOwnerCol      = "resource_owner"
+	AccessReviewInstanceIDCol         = "instance_id"
+	AccessReviewNameCol               = "name"
+	AccessReviewScopeCol              = "scope"
+	AccessReviewProjectIDCol          = "project_id"
+	AccessReviewRoleKeyCol            = "role_key"
+	AccessReviewReviewerTypeCol       = "reviewer_type"
+	AccessReviewManagerMetadataKeyCol = "manager_metadata_key"
+	AccessReviewDeadlineCol           = "deadline"
+	AccessReviewDeadlineActionCol     = "deadline_action"
+
+	AccessReviewItemSuffix           = "items"
+	AccessReviewItemInstanceIDCol    = "instance_id"
+	AccessReviewItemReviewIDCol      = "review_id"
+	AccessReviewItemIDCol            = "id"
+	AccessReviewItemCreationDateCol  = "creation_date"
+	AccessReviewItemChangeDateCol    = "change_date"
+	AccessReviewItemSequenceCol      = "sequence"
+	AccessReviewItemTypeCol          = "item_type"
+	AccessReviewItemObjectIDCol      = "object_id"
+	AccessReviewItemResourceOwnerCol = "item_resource_owner"
+	AccessReviewItemUserIDCol        = "user_id"
+	AccessReviewItemProjectIDCol     = "project_id"
+	AccessReviewItemRolesCol         = "roles"
+	AccessReviewItemReviewersCol     = "reviewers"
+	AccessReviewItemDecisionCol      = "decision"
+	AccessReviewItemDecidedByCol     = "decided_by"
+	AccessReviewItemCommentCol       = "comment"
+)
+
+type accessReviewProjection struct{}
+
+func newAccessReviewProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(accessReviewProjection))
+}
+
+func (*accessReviewProjection) Name() string {
+	return AccessReviewTable
+}
+
+func (*accessReviewProjection) Init() *old_handler.Check {
+	return handler.NewMultiTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(AccessReviewIDCol, handler.ColumnTypeText),
+			handler.NewColumn(AccessReviewCreationDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(AccessReviewChangeDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(AccessReviewSequenceCol, handler.ColumnTypeInt64),
+			handler.NewColumn(AccessReviewStateCol, handler.ColumnTypeEnum),
+			handler.NewColumn(AccessReviewResourceOwnerCol, handler.ColumnTypeText),
+			handler.NewColumn(AccessReviewInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(AccessReviewNameCol, handler.ColumnTypeText),
+			handler.NewColumn(AccessReviewScopeCol, handler.ColumnTypeEnum),
+			handler.NewColumn(AccessReviewProjectIDCol, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(AccessReviewRoleKeyCol, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(AccessReviewReviewerTypeCol, handler.ColumnTypeEnum),
+			handler.NewColumn(AccessReviewManagerMetadataKeyCol, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(AccessReviewDeadlineCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(AccessReviewDeadlineActionCol, handler.ColumnTypeEnum),
+		},
+			handler.NewPrimaryKey(AccessReviewInstanceIDCol, AccessReviewIDCol),
+			handler.WithIndex(handler.NewIndex("resource_owner", []string{AccessReviewResourceOwnerCol})),
+		),
+		handler.NewSuffixedTable([]*handler.InitColumn{
+			handler.NewColumn(AccessReviewItemInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(AccessReviewItemReviewIDCol, handler.ColumnTypeText),
+			handler.NewColumn(AccessReviewItemIDCol, handler.ColumnTypeText),
+			handler.NewColumn(AccessReviewItemCreationDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(AccessReviewItemChangeDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(AccessReviewItemSequenceCol, handler.ColumnTypeInt64),
+			handler.NewColumn(AccessReviewItemTypeCol, handler.ColumnTypeEnum),
+			handler.NewColumn(AccessReviewItemObjectIDCol, handler.ColumnTypeText),
+			handler.NewColumn(AccessReviewItemResourceOwnerCol, handler.ColumnTypeText),
+			handler.NewColumn(AccessReviewItemUserIDCol, handler.ColumnTypeText),
+			handler.NewColumn(AccessReviewItemProjectIDCol, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(AccessReviewItemRolesCol, handler.ColumnTypeTextArray, handler.Nullable()),
+			handler.NewColumn(AccessReviewItemReviewersCol, handler.ColumnTypeTextArray, handler.Nullable()),
+			handler.NewColumn(AccessReviewItemDecisionCol, handler.ColumnTypeEnum),
+			handler.NewColumn(AccessReviewItemDecidedByCol, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(AccessReviewItemCommentCol, handler.ColumnTypeText, handler.Default("")),
+		},
+			handler.NewPrimaryKey(AccessReviewItemInstanceIDCol, AccessReviewItemReviewIDCol, AccessReviewItemIDCol),
+			AccessReviewItemSuffix,
+			handler.WithForeignKey(handler.NewForeignKey("review", []string{AccessReviewItemInstanceIDCol, AccessReviewItemReviewIDCol}, []string{AccessReviewInstanceIDCol, AccessReviewIDCol})),
+			handler.WithIndex(handler.NewIndex("reviewers", []string{AccessReviewItemReviewersCol})),
+		),
+	)
+}
+
+func (p *accessReviewProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: accessreview.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  accessreview.StartedEventType,
+					Reduce: p.reduceStarted,
+				},
+				{
+					Event:  accessreview.ItemAddedEventType,
+					Reduce: p.reduceItemAdded,
+				},
+				{
+					Event:  accessreview.ItemDecidedEventType,
+					Reduce: p.reduceItemDecided,
+				},
+				{
+					Event:  accessreview.CompletedEventType,
+					Reduce: p.reduceCompleted,
+				},
+				{
+					Event:  accessreview.CancelledEventType,
+					Reduce: p.reduceCancelled,
+				},
+			},
+		},
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(AccessReviewInstanceIDCol),
+				},
+			},
+		},
+	}
+}
+
+func (p *accessReviewProjection) reduceStarted(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*accessreview.StartedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewCreateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(AccessReviewIDCol, e.Aggregate().ID),
+			handler.NewCol(AccessReviewCreationDateCol, e.CreatedAt()),
+			handler.NewCol(AccessReviewChangeDateCol, e.CreatedAt()),
+			handler.NewCol(AccessReviewSequenceCol, e.Sequence()),
+			handler.NewCol(AccessReviewStateCol, domain.AccessReviewStateActive),
+			handler.NewCol(AccessReviewResourceOwnerCol, e.Aggregate().ResourceOwner),
+			handler.NewCol(AccessReviewInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCol(AccessReviewNameCol, e.Name),
+			handler.NewCol(AccessReviewScopeCol, e.Scope),
+			handler.NewCol(AccessReviewProjectIDCol, e.ProjectID),
+			handler.NewCol(AccessReviewRoleKeyCol, e.RoleKey),
+			handler.NewCol(AccessReviewReviewerTypeCol, e.ReviewerType),
+			handler.NewCol(AccessReviewManagerMetadataKeyCol, e.ManagerMetadataKey),
+			handler.NewCol(AccessReviewDeadlineCol, e.Deadline),
+			handler.NewCol(AccessReviewDeadlineActionCol, e.DeadlineAction),
+		},
+	), nil
+}
+
+func (p *accessReviewProjection) reduceItemAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*accessreview.ItemAddedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewMultiStatement(
+		e,
+		handler.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(AccessReviewItemInstanceIDCol, e.Aggregate().InstanceID),
+				handler.NewCol(AccessReviewItemReviewIDCol, e.Aggregate().ID),
+				handler.NewCol(AccessReviewItemIDCol, e.ItemID),
+				handler.NewCol(AccessReviewItemCreationDateCol, e.CreatedAt()),
+				handler.NewCol(AccessReviewItemChangeDateCol, e.CreatedAt()),
+				handler.NewCol(AccessReviewItemSequenceCol, e.Sequence()),
+				handler.NewCol(AccessReviewItemTypeCol, e.ItemType),
+				handler.NewCol(AccessReviewItemObjectIDCol, e.ObjectID),
+				handler.NewCol(AccessReviewItemResourceOwnerCol, e.ItemResourceOwner),
+				handler.NewCol(AccessReviewItemUserIDCol, e.UserID),
+				handler.NewCol(AccessReviewItemProjectIDCol, e.ProjectID),
+				handler.NewCol(AccessReviewItemRolesCol, database.TextArray[string](e.Roles)),
+				handler.NewCol(AccessReviewItemReviewersCol, database.TextArray[string](e.Reviewers)),
+				handler.NewCol(AccessReviewItemDecisionCol, domain.AccessReviewDecisionPending),
+			},
+			handler.WithTableSuffix(AccessReviewItemSuffix),
+		),
+		p.addReviewChanged(e),
+	), nil
+}
+
+func (p *accessReviewProjection) reduceItemDecided(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*accessreview.ItemDecidedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewMultiStatement(
+		e,
+		handler.AddUpdateStatement(
+			[]handler.Column{
+				handler.NewCol(AccessReviewItemChangeDateCol, e.CreatedAt()),
+				handler.NewCol(AccessReviewItemSequenceCol, e.Sequence()),
+				handler.NewCol(AccessReviewItemDecisionCol, e.Decision),
+				handler.NewCol(AccessReviewItemDecidedByCol, e.Creator()),
+				handler.NewCol(AccessReviewItemCommentCol, e.Comment),
+			},
+			[]handler.Condition{
+				handler.NewCond(AccessReviewItemInstanceIDCol, e.Aggregate().InstanceID),
+				handler.NewCond(AccessReviewItemReviewIDCol, e.Aggregate().ID),
+				handler.NewCond(AccessReviewItemIDCol, e.ItemID),
+			},
+			handler.WithTableSuffix(AccessReviewItemSuffix),
+		),
+		p.addReviewChanged(e),
+	), nil
+}
+
+func (p *accessReviewProjection) reduceCompleted(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*accessreview.CompletedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.updateState(e, domain.AccessReviewStateCompleted), nil
+}
+
+func (p *accessReviewProjection) reduceCancelled(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*accessreview.CancelledEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.updateState(e, domain.AccessReviewStateCancelled), nil
+}
+
+// reduceOwnerRemoved deletes the reviews of the organization, the items are removed by the foreign key.
+func (p *accessReviewProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.OrgRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(AccessReviewResourceOwnerCol, e.Aggregate().ID),
+			handler.NewCond(AccessReviewInstanceIDCol, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *accessReviewProjection) updateState(event eventstore.Event, state domain.AccessReviewState) *handler.Statement {
+	return handler.NewUpdateStatement(
+		event,
+		[]handler.Column{
+			handler.NewCol(AccessReviewChangeDateCol, event.CreatedAt()),
+			handler.NewCol(AccessReviewSequenceCol, event.Sequence()),
+			handler.NewCol(AccessReviewStateCol, state),
+		},
+		[]handler.Condition{
+			handler.NewCond(AccessReviewIDCol, event.Aggregate().ID),
+			handler.NewCond(AccessReviewInstanceIDCol, event.Aggregate().InstanceID),
+		},
+	)
+}
+
+func (p *accessReviewProjection) addReviewChanged(event eventstore.Event) func(eventstore.Event) handler.Exec {
+	return handler.AddUpdateStatement(
+		[]handler.Column{
+			handler.NewCol(AccessReviewChangeDateCol, event.CreatedAt()),
+			handler.NewCol(AccessReviewSequenceCol, event.Sequence()),
+		},
+		[]handler.Condition{
+			handler.NewCond(AccessReviewIDCol, event.Aggregate().ID),
+			handler.NewCond(AccessReviewInstanceIDCol, event.Aggregate().InstanceID),
+		},
+	)
+}
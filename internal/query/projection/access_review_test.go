@@ -0,0 +1,271 @@
+package projection
+
+import (
+	"testing"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/accessreview"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestAccessReviewProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceStarted",
+			args: args{
+				event: getEvent(testEvent(
+					accessreview.StartedEventType,
+					accessreview.AggregateType,
+					[]byte(`{
+						"name": "Q1",
+						"scope": 2,
+						"projectId": "project-id",
+						"reviewerType": 2,
+						"managerMetadataKey": "manager",
+						"deadline": "2024-04-01T00:00:00Z",
+						"deadlineAction": 1
+					}`),
+				), eventstore.GenericEventMapper[accessreview.StartedEvent]),
+			},
+			reduce: (&accessReviewProjection{}).reduceStarted,
+			want: wantReduce{
+				aggregateType: accessreview.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.access_reviews (id, creation_date, change_date, sequence, state, resource_owner, instance_id, name, scope, project_id, role_key, reviewer_type, manager_metadata_key, deadline, deadline_action) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								domain.AccessReviewStateActive,
+								"ro-id",
+								"instance-id",
+								"Q1",
+								domain.AccessReviewScopeProject,
+								"project-id",
+								"",
+								domain.AccessReviewerTypeManager,
+								"manager",
+								time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC),
+								domain.AccessReviewDeadlineActionRevoke,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceItemAdded",
+			args: args{
+				event: getEvent(testEvent(
+					accessreview.ItemAddedEventType,
+					accessreview.AggregateType,
+					[]byte(`{
+						"itemId": "item-id",
+						"itemType": 1,
+						"objectId": "grant-id",
+						"itemResourceOwner": "ro-id",
+						"userId": "user-id",
+						"projectId": "project-id",
+						"roles": ["role"],
+						"reviewers": ["reviewer-id"]
+					}`),
+				), eventstore.GenericEventMapper[accessreview.ItemAddedEvent]),
+			},
+			reduce: (&accessReviewProjection{}).reduceItemAdded,
+			want: wantReduce{
+				aggregateType: accessreview.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.access_reviews_items (instance_id, review_id, id, creation_date, change_date, sequence, item_type, object_id, item_resource_owner, user_id, project_id, roles, reviewers, decision) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+								"item-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								domain.AccessReviewItemTypeUserGrant,
+								"grant-id",
+								"ro-id",
+								"user-id",
+								"project-id",
+								database.TextArray[string]{"role"},
+								database.TextArray[string]{"reviewer-id"},
+								domain.AccessReviewDecisionPending,
+							},
+						},
+						{
+							expectedStmt: "UPDATE projections.access_reviews SET (change_date, sequence) = ($1, $2) WHERE (id = $3) AND (instance_id = $4)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceItemDecided",
+			args: args{
+				event: getEvent(testEvent(
+					accessreview.ItemDecidedEventType,
+					accessreview.AggregateType,
+					[]byte(`{
+						"itemId": "item-id",
+						"decision": 3,
+						"comment": "left the team"
+					}`),
+				), eventstore.GenericEventMapper[accessreview.ItemDecidedEvent]),
+			},
+			reduce: (&accessReviewProjection{}).reduceItemDecided,
+			want: wantReduce{
+				aggregateType: accessreview.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.access_reviews_items SET (change_date, sequence, decision, decided_by, comment) = ($1, $2, $3, $4, $5) WHERE (instance_id = $6) AND (review_id = $7) AND (id = $8)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								domain.AccessReviewDecisionRevoked,
+								"editor-user",
+								"left the team",
+								"instance-id",
+								"agg-id",
+								"item-id",
+							},
+						},
+						{
+							expectedStmt: "UPDATE projections.access_reviews SET (change_date, sequence) = ($1, $2) WHERE (id = $3) AND (instance_id = $4)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceCompleted",
+			args: args{
+				event: getEvent(testEvent(
+					accessreview.CompletedEventType,
+					accessreview.AggregateType,
+					nil,
+				), eventstore.GenericEventMapper[accessreview.CompletedEvent]),
+			},
+			reduce: (&accessReviewProjection{}).reduceCompleted,
+			want: wantReduce{
+				aggregateType: accessreview.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.access_reviews SET (change_date, sequence, state) = ($1, $2, $3) WHERE (id = $4) AND (instance_id = $5)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								domain.AccessReviewStateCompleted,
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceCancelled",
+			args: args{
+				event: getEvent(testEvent(
+					accessreview.CancelledEventType,
+					accessreview.AggregateType,
+					nil,
+				), eventstore.GenericEventMapper[accessreview.CancelledEvent]),
+			},
+			reduce: (&accessReviewProjection{}).reduceCancelled,
+			want: wantReduce{
+				aggregateType: accessreview.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.access_reviews SET (change_date, sequence, state) = ($1, $2, $3) WHERE (id = $4) AND (instance_id = $5)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								domain.AccessReviewStateCancelled,
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "org reduceOwnerRemoved",
+			args: args{
+				event: getEvent(testEvent(
+					org.OrgRemovedEventType,
+					org.AggregateType,
+					nil,
+				), org.OrgRemovedEventMapper),
+			},
+			reduce: (&accessReviewProjection{}).reduceOwnerRemoved,
+			want: wantReduce{
+				aggregateType: org.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.access_reviews WHERE (resource_owner = $1) AND (instance_id = $2)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if !zerrors.IsErrorInvalidArgument(err) {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, AccessReviewTable, tt.want)
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+)
+
+const (
+	AdministratorRoleTable = "projections.administrator_roles"
+
+	AdministratorRoleInstanceIDCol   = "instance_id"
+	AdministratorRoleKeyCol          = "role_key"
+	AdministratorRoleCreationDateCol = "creation_date"
+	AdministratorRoleChangeDateCol   = "change_date"
+	AdministratorRoleSequenceCol     = "sequence"
+	AdministratorRoleDisplayNameCol  = "display_name"
+	AdministratorRolePermissionsCol  = "permissions"
+)
+
+// administratorRoleProjection are the custom roles of the instances,
+// which are merged with the roles of the InternalAuthZ config on permission checks.
+type administratorRoleProjection struct{}
+
+func newAdministratorRoleProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(administratorRoleProjection))
+}
+
+func (*administratorRoleProjection) Name() string {
+	return AdministratorRoleTable
+}
+
+func (*administratorRoleProjection) Init() *old_handler.Check {
+	return handler.NewTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(AdministratorRoleInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(AdministratorRoleKeyCol, handler.ColumnTypeText),
+			handler.NewColumn(AdministratorRoleCreationDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(AdministratorRoleChangeDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(AdministratorRoleSequenceCol, handler.ColumnTypeInt64),
+			handler.NewColumn(AdministratorRoleDisplayNameCol, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(AdministratorRolePermissionsCol, handler.ColumnTypeTextArray),
+		},
+			handler.NewPrimaryKey(AdministratorRoleInstanceIDCol, AdministratorRoleKeyCol),
+		),
+	)
+}
+
+func (p *administratorRoleProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.AdministratorRoleAddedEventType,
+					Reduce: p.reduceAdded,
+				},
+				{
+					Event:  instance.AdministratorRoleChangedEventType,
+					Reduce: p.reduceChanged,
+				},
+				{
+					Event:  instance.AdministratorRoleRemovedEventType,
+					Reduce: p.reduceRemoved,
+				},
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(AdministratorRoleInstanceIDCol),
+				},
+			},
+		},
+	}
+}
+
+func (p *administratorRoleProjection) reduceAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*instance.AdministratorRoleAddedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewCreateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(AdministratorRoleInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCol(AdministratorRoleKeyCol, e.Key),
+			handler.NewCol(AdministratorRoleCreationDateCol, e.CreatedAt()),
+			handler.NewCol(AdministratorRoleChangeDateCol, e.CreatedAt()),
+			handler.NewCol(AdministratorRoleSequenceCol, e.Sequence()),
+			handler.NewCol(AdministratorRoleDisplayNameCol, e.DisplayName),
+			handler.NewCol(AdministratorRolePermissionsCol, database.TextArray[string](e.Permissions)),
+		},
+	), nil
+}
+
+func (p *administratorRoleProjection) reduceChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*instance.AdministratorRoleChangedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(AdministratorRoleChangeDateCol, e.CreatedAt()),
+			handler.NewCol(AdministratorRoleSequenceCol, e.Sequence()),
+			handler.NewCol(AdministratorRoleDisplayNameCol, e.DisplayName),
+			handler.NewCol(AdministratorRolePermissionsCol, database.TextArray[string](e.Permissions)),
+		},
+		[]handler.Condition{
+			handler.NewCond(AdministratorRoleInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(AdministratorRoleKeyCol, e.Key),
+		},
+	), nil
+}
+
+func (p *administratorRoleProjection) reduceRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*instance.AdministratorRoleRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(AdministratorRoleInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(AdministratorRoleKeyCol, e.Key),
+		},
+	), nil
+}
@@ -0,0 +1,161 @@
+package projection
+
+import (
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestAdministratorRoleProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceAdded",
+			args: args{
+				event: getEvent(testEvent(
+					instance.AdministratorRoleAddedEventType,
+					instance.AggregateType,
+					[]byte(`{
+						"key": "ORG_USER_SUPPORT",
+						"displayName": "User Support",
+						"permissions": ["user.read", "user.credential.write"]
+					}`),
+				), eventstore.GenericEventMapper[instance.AdministratorRoleAddedEvent]),
+			},
+			reduce: (&administratorRoleProjection{}).reduceAdded,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.administrator_roles (instance_id, role_key, creation_date, change_date, sequence, display_name, permissions) VALUES ($1, $2, $3, $4, $5, $6, $7)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"ORG_USER_SUPPORT",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"User Support",
+								database.TextArray[string]{"user.read", "user.credential.write"},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceChanged",
+			args: args{
+				event: getEvent(testEvent(
+					instance.AdministratorRoleChangedEventType,
+					instance.AggregateType,
+					[]byte(`{
+						"key": "ORG_USER_SUPPORT",
+						"displayName": "Support",
+						"permissions": ["user.read"]
+					}`),
+				), eventstore.GenericEventMapper[instance.AdministratorRoleChangedEvent]),
+			},
+			reduce: (&administratorRoleProjection{}).reduceChanged,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.administrator_roles SET (change_date, sequence, display_name, permissions) = ($1, $2, $3, $4) WHERE (instance_id = $5) AND (role_key = $6)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								"Support",
+								database.TextArray[string]{"user.read"},
+								"instance-id",
+								"ORG_USER_SUPPORT",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceRemoved",
+			args: args{
+				event: getEvent(testEvent(
+					instance.AdministratorRoleRemovedEventType,
+					instance.AggregateType,
+					[]byte(`{
+						"key": "ORG_USER_SUPPORT"
+					}`),
+				), eventstore.GenericEventMapper[instance.AdministratorRoleRemovedEvent]),
+			},
+			reduce: (&administratorRoleProjection{}).reduceRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.administrator_roles WHERE (instance_id = $1) AND (role_key = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"ORG_USER_SUPPORT",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance reduceInstanceRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.InstanceRemovedEventType,
+						instance.AggregateType,
+						nil,
+					),
+					instance.InstanceRemovedEventMapper,
+				),
+			},
+			reduce: reduceInstanceRemovedHelper(AdministratorRoleInstanceIDCol),
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.administrator_roles WHERE (instance_id = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if !zerrors.IsErrorInvalidArgument(err) {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, AdministratorRoleTable, tt.want)
+		})
+	}
+}
@@ -0,0 +1,149 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	BreakGlassSessionTable = "projections.break_glass_sessions"
+
+	BreakGlassSessionInstanceIDCol    = "instance_id"
+	BreakGlassSessionUserIDCol        = "user_id"
+	BreakGlassSessionSequenceCol      = "sequence"
+	BreakGlassSessionCreationDateCol  = "creation_date"
+	BreakGlassSessionResourceOwnerCol = "resource_owner"
+	BreakGlassSessionSessionIDCol     = "session_id"
+	BreakGlassSessionExpirationCol    = "expiration"
+	BreakGlassSessionUserAgentCol     = "user_agent"
+	BreakGlassSessionRemoteIPCol      = "remote_ip"
+)
+
+// breakGlassSessionProjection lists every login of the emergency access accounts.
+type breakGlassSessionProjection struct{}
+
+func newBreakGlassSessionProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(breakGlassSessionProjection))
+}
+
+func (*breakGlassSessionProjection) Name() string {
+	return BreakGlassSessionTable
+}
+
+func (*breakGlassSessionProjection) Init() *old_handler.Check {
+	return handler.NewTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(BreakGlassSessionInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(BreakGlassSessionUserIDCol, handler.ColumnTypeText),
+			handler.NewColumn(BreakGlassSessionSequenceCol, handler.ColumnTypeInt64),
+			handler.NewColumn(BreakGlassSessionCreationDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(BreakGlassSessionResourceOwnerCol, handler.ColumnTypeText),
+			handler.NewColumn(BreakGlassSessionSessionIDCol, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(BreakGlassSessionExpirationCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(BreakGlassSessionUserAgentCol, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(BreakGlassSessionRemoteIPCol, handler.ColumnTypeText, handler.Default("")),
+		},
+			handler.NewPrimaryKey(BreakGlassSessionInstanceIDCol, BreakGlassSessionUserIDCol, BreakGlassSessionSequenceCol),
+			handler.WithIndex(handler.NewIndex("expiration", []string{BreakGlassSessionExpirationCol})),
+		),
+	)
+}
+
+func (p *breakGlassSessionProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: user.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  user.HumanBreakGlassUsedType,
+					Reduce: p.reduceUsed,
+				},
+				{
+					Event:  user.UserRemovedType,
+					Reduce: p.reduceUserRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(BreakGlassSessionInstanceIDCol),
+				},
+			},
+		},
+	}
+}
+
+func (p *breakGlassSessionProjection) reduceUsed(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*user.HumanBreakGlassUsedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	var userAgent, remoteIP string
+	if e.AuthRequestInfo != nil && e.BrowserInfo != nil {
+		userAgent = e.UserAgent
+		if e.RemoteIP != nil {
+			remoteIP = e.RemoteIP.String()
+		}
+	}
+	return handler.NewCreateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(BreakGlassSessionInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCol(BreakGlassSessionUserIDCol, e.Aggregate().ID),
+			handler.NewCol(BreakGlassSessionSequenceCol, e.Sequence()),
+			handler.NewCol(BreakGlassSessionCreationDateCol, e.CreatedAt()),
+			handler.NewCol(BreakGlassSessionResourceOwnerCol, e.Aggregate().ResourceOwner),
+			handler.NewCol(BreakGlassSessionSessionIDCol, e.SessionID),
+			handler.NewCol(BreakGlassSessionExpirationCol, e.Expiration),
+			handler.NewCol(BreakGlassSessionUserAgentCol, userAgent),
+			handler.NewCol(BreakGlassSessionRemoteIPCol, remoteIP),
+		},
+	), nil
+}
+
+func (p *breakGlassSessionProjection) reduceUserRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.UserRemovedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Bg1ur", "reduce.wrong.event.type %s", user.UserRemovedType)
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(BreakGlassSessionInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(BreakGlassSessionUserIDCol, e.Aggregate().ID),
+		},
+	), nil
+}
+
+func (p *breakGlassSessionProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.OrgRemovedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Bg2or", "reduce.wrong.event.type %s", org.OrgRemovedEventType)
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(BreakGlassSessionInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(BreakGlassSessionResourceOwnerCol, e.Aggregate().ID),
+		},
+	), nil
+}
@@ -0,0 +1,190 @@
+package projection
+
+import (
+	"testing"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestBreakGlassSessionProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceUsed",
+			args: args{
+				event: getEvent(testEvent(
+					user.HumanBreakGlassUsedType,
+					user.AggregateType,
+					[]byte(`{
+						"userAgentID": "agent-id",
+						"userAgent": "Mozilla/5.0",
+						"remoteIP": "10.0.0.1",
+						"sessionID": "agent-id",
+						"expiration": "2026-10-19T12:15:00Z"
+					}`),
+				), eventstore.GenericEventMapper[user.HumanBreakGlassUsedEvent]),
+			},
+			reduce: (&breakGlassSessionProjection{}).reduceUsed,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("user"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.break_glass_sessions (instance_id, user_id, sequence, creation_date, resource_owner, session_id, expiration, user_agent, remote_ip) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+								uint64(15),
+								anyArg{},
+								"ro-id",
+								"agent-id",
+								time.Date(2026, 10, 19, 12, 15, 0, 0, time.UTC),
+								"Mozilla/5.0",
+								"10.0.0.1",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceUsed without browser info",
+			args: args{
+				event: getEvent(testEvent(
+					user.HumanBreakGlassUsedType,
+					user.AggregateType,
+					[]byte(`{
+						"sessionID": "session-id",
+						"expiration": "2026-10-19T12:15:00Z"
+					}`),
+				), eventstore.GenericEventMapper[user.HumanBreakGlassUsedEvent]),
+			},
+			reduce: (&breakGlassSessionProjection{}).reduceUsed,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("user"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.break_glass_sessions (instance_id, user_id, sequence, creation_date, resource_owner, session_id, expiration, user_agent, remote_ip) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+								uint64(15),
+								anyArg{},
+								"ro-id",
+								"session-id",
+								time.Date(2026, 10, 19, 12, 15, 0, 0, time.UTC),
+								"",
+								"",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceUserRemoved",
+			args: args{
+				event: getEvent(testEvent(
+					user.UserRemovedType,
+					user.AggregateType,
+					nil,
+				), user.UserRemovedEventMapper),
+			},
+			reduce: (&breakGlassSessionProjection{}).reduceUserRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("user"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.break_glass_sessions WHERE (instance_id = $1) AND (user_id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceOwnerRemoved",
+			args: args{
+				event: getEvent(testEvent(
+					org.OrgRemovedEventType,
+					org.AggregateType,
+					nil,
+				), org.OrgRemovedEventMapper),
+			},
+			reduce: (&breakGlassSessionProjection{}).reduceOwnerRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.break_glass_sessions WHERE (instance_id = $1) AND (resource_owner = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceInstanceRemoved",
+			args: args{
+				event: getEvent(testEvent(
+					instance.InstanceRemovedEventType,
+					instance.AggregateType,
+					nil,
+				), instance.InstanceRemovedEventMapper),
+			},
+			reduce: reduceInstanceRemovedHelper(BreakGlassSessionInstanceIDCol),
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.break_glass_sessions WHERE (instance_id = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if !zerrors.IsErrorInvalidArgument(err) {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, BreakGlassSessionTable, tt.want)
+		})
+	}
+}
@@ -0,0 +1,542 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/group"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	GroupTable            = "projections.groups"
+	GroupMemberTable      = GroupTable + "_" + GroupMemberSuffix
+	GroupGrantTable       = GroupTable + "_" + GroupGrantSuffix
+	GroupIDCol            = "id"
+	GroupCreationDateCol  = "creation_date"
+	GroupChangeDateCol    = "change_date"
+	GroupSequenceCol      = "sequence"
+	GroupStateCol         = "state"
+	GroupResourceOwnerCol = "resource_owner"
+	GroupInstanceIDCol    = "instance_id"
+	GroupNameCol          = "name"
+	GroupDescriptionCol   = "description"
+	GroupExternalIDCol    = "external_id"
+
+	GroupMemberSuffix          = "members"
+	GroupMemberInstanceIDCol   = "instance_id"
+	GroupMemberGroupIDCol      = "group_id"
+	GroupMemberMemberIDCol     = "member_id"
+	GroupMemberTypeCol         = "member_type"
+	GroupMemberCreationDateCol = "creation_date"
+	GroupMemberSequenceCol     = "sequence"
+
+	GroupGrantSuffix          = "grants"
+	GroupGrantInstanceIDCol   = "instance_id"
+	GroupGrantGroupIDCol      = "group_id"
+	GroupGrantProjectIDCol    = "project_id"
+	GroupGrantGrantIDCol      = "grant_id"
+	GroupGrantRolesCol        = "roles"
+	GroupGrantCreationDateCol = "creation_date"
+	GroupGrantChangeDateCol   = "change_date"
+	GroupGrantSequenceCol     = "sequence"
+)
+
+type groupProjection struct{}
+
+func newGroupProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(groupProjection))
+}
+
+func (*groupProjection) Name() string {
+	return GroupTable
+}
+
+func (*groupProjection) Init() *old_handler.Check {
+	return handler.NewMultiTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(GroupIDCol, handler.ColumnTypeText),
+			handler.NewColumn(GroupCreationDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(GroupChangeDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(GroupSequenceCol, handler.ColumnTypeInt64),
+			handler.NewColumn(GroupStateCol, handler.ColumnTypeEnum),
+			handler.NewColumn(GroupResourceOwnerCol, handler.ColumnTypeText),
+			handler.NewColumn(GroupInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(GroupNameCol, handler.ColumnTypeText),
+			handler.NewColumn(GroupDescriptionCol, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(GroupExternalIDCol, handler.ColumnTypeText, handler.Default("")),
+		},
+			handler.NewPrimaryKey(GroupInstanceIDCol, GroupIDCol),
+			handler.WithIndex(handler.NewIndex("resource_owner", []string{GroupResourceOwnerCol})),
+		),
+		handler.NewSuffixedTable([]*handler.InitColumn{
+			handler.NewColumn(GroupMemberInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(GroupMemberGroupIDCol, handler.ColumnTypeText),
+			handler.NewColumn(GroupMemberMemberIDCol, handler.ColumnTypeText),
+			handler.NewColumn(GroupMemberTypeCol, handler.ColumnTypeEnum),
+			handler.NewColumn(GroupMemberCreationDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(GroupMemberSequenceCol, handler.ColumnTypeInt64),
+		},
+			handler.NewPrimaryKey(GroupMemberInstanceIDCol, GroupMemberGroupIDCol, GroupMemberMemberIDCol),
+			GroupMemberSuffix,
+			handler.WithForeignKey(handler.NewForeignKey("group", []string{GroupMemberInstanceIDCol, GroupMemberGroupIDCol}, []string{GroupInstanceIDCol, GroupIDCol})),
+			handler.WithIndex(handler.NewIndex("member", []string{GroupMemberInstanceIDCol, GroupMemberMemberIDCol})),
+		),
+		handler.NewSuffixedTable([]*handler.InitColumn{
+			handler.NewColumn(GroupGrantInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(GroupGrantGroupIDCol, handler.ColumnTypeText),
+			handler.NewColumn(GroupGrantProjectIDCol, handler.ColumnTypeText),
+			handler.NewColumn(GroupGrantGrantIDCol, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(GroupGrantRolesCol, handler.ColumnTypeTextArray),
+			handler.NewColumn(GroupGrantCreationDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(GroupGrantChangeDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(GroupGrantSequenceCol, handler.ColumnTypeInt64),
+		},
+			handler.NewPrimaryKey(GroupGrantInstanceIDCol, GroupGrantGroupIDCol, GroupGrantProjectIDCol, GroupGrantGrantIDCol),
+			GroupGrantSuffix,
+			handler.WithForeignKey(handler.NewForeignKey("group", []string{GroupGrantInstanceIDCol, GroupGrantGroupIDCol}, []string{GroupInstanceIDCol, GroupIDCol})),
+			handler.WithIndex(handler.NewIndex("project", []string{GroupGrantInstanceIDCol, GroupGrantProjectIDCol})),
+		),
+	)
+}
+
+func (p *groupProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: group.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  group.AddedEventType,
+					Reduce: p.reduceAdded,
+				},
+				{
+					Event:  group.ChangedEventType,
+					Reduce: p.reduceChanged,
+				},
+				{
+					Event:  group.RemovedEventType,
+					Reduce: p.reduceRemoved,
+				},
+				{
+					Event:  group.MemberAddedEventType,
+					Reduce: p.reduceMemberAdded,
+				},
+				{
+					Event:  group.MemberRemovedEventType,
+					Reduce: p.reduceMemberRemoved,
+				},
+				{
+					Event:  group.SubgroupAddedEventType,
+					Reduce: p.reduceSubgroupAdded,
+				},
+				{
+					Event:  group.SubgroupRemovedEventType,
+					Reduce: p.reduceSubgroupRemoved,
+				},
+				{
+					Event:  group.GrantAddedEventType,
+					Reduce: p.reduceGrantAdded,
+				},
+				{
+					Event:  group.GrantChangedEventType,
+					Reduce: p.reduceGrantChanged,
+				},
+				{
+					Event:  group.GrantRemovedEventType,
+					Reduce: p.reduceGrantRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: user.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  user.UserRemovedType,
+					Reduce: p.reduceUserRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: project.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  project.ProjectRemovedType,
+					Reduce: p.reduceProjectRemoved,
+				},
+				{
+					Event:  project.GrantRemovedType,
+					Reduce: p.reduceProjectGrantRemoved,
+				},
+				{
+					Event:  project.RoleRemovedType,
+					Reduce: p.reduceRoleRemoved,
+				},
+				{
+					Event:  project.GrantChangedType,
+					Reduce: p.reduceProjectGrantChanged,
+				},
+				{
+					Event:  project.GrantCascadeChangedType,
+					Reduce: p.reduceProjectGrantChanged,
+				},
+			},
+		},
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(GroupInstanceIDCol),
+				},
+			},
+		},
+	}
+}
+
+func (p *groupProjection) reduceAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*group.AddedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewCreateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(GroupIDCol, e.Aggregate().ID),
+			handler.NewCol(GroupCreationDateCol, e.CreatedAt()),
+			handler.NewCol(GroupChangeDateCol, e.CreatedAt()),
+			handler.NewCol(GroupSequenceCol, e.Sequence()),
+			handler.NewCol(GroupStateCol, domain.GroupStateActive),
+			handler.NewCol(GroupResourceOwnerCol, e.Aggregate().ResourceOwner),
+			handler.NewCol(GroupInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCol(GroupNameCol, e.Name),
+			handler.NewCol(GroupDescriptionCol, e.Description),
+			handler.NewCol(GroupExternalIDCol, e.ExternalID),
+		},
+	), nil
+}
+
+func (p *groupProjection) reduceChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*group.ChangedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	if e.Name == nil && e.Description == nil && e.ExternalID == nil {
+		return handler.NewNoOpStatement(e), nil
+	}
+	columns := []handler.Column{
+		handler.NewCol(GroupChangeDateCol, e.CreatedAt()),
+		handler.NewCol(GroupSequenceCol, e.Sequence()),
+	}
+	if e.Name != nil {
+		columns = append(columns, handler.NewCol(GroupNameCol, *e.Name))
+	}
+	if e.Description != nil {
+		columns = append(columns, handler.NewCol(GroupDescriptionCol, *e.Description))
+	}
+	if e.ExternalID != nil {
+		columns = append(columns, handler.NewCol(GroupExternalIDCol, *e.ExternalID))
+	}
+	return handler.NewUpdateStatement(
+		e,
+		columns,
+		[]handler.Condition{
+			handler.NewCond(GroupIDCol, e.Aggregate().ID),
+			handler.NewCond(GroupInstanceIDCol, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+// reduceRemoved deletes the group, its members and grants are removed by the foreign key.
+// The memberships of the group in other groups are removed explicitly.
+func (p *groupProjection) reduceRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*group.RemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewMultiStatement(
+		e,
+		handler.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(GroupIDCol, e.Aggregate().ID),
+				handler.NewCond(GroupInstanceIDCol, e.Aggregate().InstanceID),
+			},
+		),
+		handler.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(GroupMemberMemberIDCol, e.Aggregate().ID),
+				handler.NewCond(GroupMemberTypeCol, domain.GroupMemberTypeGroup),
+				handler.NewCond(GroupMemberInstanceIDCol, e.Aggregate().InstanceID),
+			},
+			handler.WithTableSuffix(GroupMemberSuffix),
+		),
+	), nil
+}
+
+func (p *groupProjection) reduceMemberAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*group.MemberAddedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.addMember(e, e.UserID, domain.GroupMemberTypeUser), nil
+}
+
+func (p *groupProjection) reduceMemberRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*group.MemberRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.removeMember(e, e.UserID), nil
+}
+
+func (p *groupProjection) reduceSubgroupAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*group.SubgroupAddedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.addMember(e, e.GroupID, domain.GroupMemberTypeGroup), nil
+}
+
+func (p *groupProjection) reduceSubgroupRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*group.SubgroupRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.removeMember(e, e.GroupID), nil
+}
+
+func (p *groupProjection) addMember(event eventstore.Event, memberID string, memberType domain.GroupMemberType) *handler.Statement {
+	return handler.NewMultiStatement(
+		event,
+		handler.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(GroupMemberInstanceIDCol, event.Aggregate().InstanceID),
+				handler.NewCol(GroupMemberGroupIDCol, event.Aggregate().ID),
+				handler.NewCol(GroupMemberMemberIDCol, memberID),
+				handler.NewCol(GroupMemberTypeCol, memberType),
+				handler.NewCol(GroupMemberCreationDateCol, event.CreatedAt()),
+				handler.NewCol(GroupMemberSequenceCol, event.Sequence()),
+			},
+			handler.WithTableSuffix(GroupMemberSuffix),
+		),
+		p.addGroupChanged(event),
+	)
+}
+
+func (p *groupProjection) removeMember(event eventstore.Event, memberID string) *handler.Statement {
+	return handler.NewMultiStatement(
+		event,
+		handler.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(GroupMemberGroupIDCol, event.Aggregate().ID),
+				handler.NewCond(GroupMemberMemberIDCol, memberID),
+				handler.NewCond(GroupMemberInstanceIDCol, event.Aggregate().InstanceID),
+			},
+			handler.WithTableSuffix(GroupMemberSuffix),
+		),
+		p.addGroupChanged(event),
+	)
+}
+
+func (p *groupProjection) reduceGrantAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*group.GrantAddedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewMultiStatement(
+		e,
+		handler.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(GroupGrantInstanceIDCol, e.Aggregate().InstanceID),
+				handler.NewCol(GroupGrantGroupIDCol, e.Aggregate().ID),
+				handler.NewCol(GroupGrantProjectIDCol, e.ProjectID),
+				handler.NewCol(GroupGrantGrantIDCol, e.ProjectGrantID),
+				handler.NewCol(GroupGrantRolesCol, database.TextArray[string](e.RoleKeys)),
+				handler.NewCol(GroupGrantCreationDateCol, e.CreatedAt()),
+				handler.NewCol(GroupGrantChangeDateCol, e.CreatedAt()),
+				handler.NewCol(GroupGrantSequenceCol, e.Sequence()),
+			},
+			handler.WithTableSuffix(GroupGrantSuffix),
+		),
+		p.addGroupChanged(e),
+	), nil
+}
+
+func (p *groupProjection) reduceGrantChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*group.GrantChangedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewMultiStatement(
+		e,
+		handler.AddUpdateStatement(
+			[]handler.Column{
+				handler.NewCol(GroupGrantRolesCol, database.TextArray[string](e.RoleKeys)),
+				handler.NewCol(GroupGrantChangeDateCol, e.CreatedAt()),
+				handler.NewCol(GroupGrantSequenceCol, e.Sequence()),
+			},
+			[]handler.Condition{
+				handler.NewCond(GroupGrantGroupIDCol, e.Aggregate().ID),
+				handler.NewCond(GroupGrantProjectIDCol, e.ProjectID),
+				handler.NewCond(GroupGrantGrantIDCol, e.ProjectGrantID),
+				handler.NewCond(GroupGrantInstanceIDCol, e.Aggregate().InstanceID),
+			},
+			handler.WithTableSuffix(GroupGrantSuffix),
+		),
+		p.addGroupChanged(e),
+	), nil
+}
+
+func (p *groupProjection) reduceGrantRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*group.GrantRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewMultiStatement(
+		e,
+		handler.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(GroupGrantGroupIDCol, e.Aggregate().ID),
+				handler.NewCond(GroupGrantProjectIDCol, e.ProjectID),
+				handler.NewCond(GroupGrantGrantIDCol, e.ProjectGrantID),
+				handler.NewCond(GroupGrantInstanceIDCol, e.Aggregate().InstanceID),
+			},
+			handler.WithTableSuffix(GroupGrantSuffix),
+		),
+		p.addGroupChanged(e),
+	), nil
+}
+
+func (p *groupProjection) addGroupChanged(event eventstore.Event) func(eventstore.Event) handler.Exec {
+	return handler.AddUpdateStatement(
+		[]handler.Column{
+			handler.NewCol(GroupChangeDateCol, event.CreatedAt()),
+			handler.NewCol(GroupSequenceCol, event.Sequence()),
+		},
+		[]handler.Condition{
+			handler.NewCond(GroupIDCol, event.Aggregate().ID),
+			handler.NewCond(GroupInstanceIDCol, event.Aggregate().InstanceID),
+		},
+	)
+}
+
+func (p *groupProjection) reduceUserRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*user.UserRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(GroupMemberMemberIDCol, e.Aggregate().ID),
+			handler.NewCond(GroupMemberTypeCol, domain.GroupMemberTypeUser),
+			handler.NewCond(GroupMemberInstanceIDCol, e.Aggregate().InstanceID),
+		},
+		handler.WithTableSuffix(GroupMemberSuffix),
+	), nil
+}
+
+func (p *groupProjection) reduceProjectRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*project.ProjectRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(GroupGrantProjectIDCol, e.Aggregate().ID),
+			handler.NewCond(GroupGrantInstanceIDCol, e.Aggregate().InstanceID),
+		},
+		handler.WithTableSuffix(GroupGrantSuffix),
+	), nil
+}
+
+func (p *groupProjection) reduceProjectGrantRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*project.GrantRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(GroupGrantGrantIDCol, e.GrantID),
+			handler.NewCond(GroupGrantInstanceIDCol, e.Aggregate().InstanceID),
+		},
+		handler.WithTableSuffix(GroupGrantSuffix),
+	), nil
+}
+
+func (p *groupProjection) reduceRoleRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*project.RoleRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewArrayRemoveCol(GroupGrantRolesCol, e.Key),
+		},
+		[]handler.Condition{
+			handler.NewCond(GroupGrantProjectIDCol, e.Aggregate().ID),
+			handler.NewCond(GroupGrantInstanceIDCol, e.Aggregate().InstanceID),
+		},
+		handler.WithTableSuffix(GroupGrantSuffix),
+	), nil
+}
+
+func (p *groupProjection) reduceProjectGrantChanged(event eventstore.Event) (*handler.Statement, error) {
+	var grantID string
+	var keys database.TextArray[string]
+	switch e := event.(type) {
+	case *project.GrantChangedEvent:
+		grantID = e.GrantID
+		keys = e.RoleKeys
+	case *project.GrantCascadeChangedEvent:
+		grantID = e.GrantID
+		keys = e.RoleKeys
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Gr8pg", "reduce.wrong.event.type %v", []eventstore.EventType{project.GrantChangedType, project.GrantCascadeChangedType})
+	}
+	return handler.NewUpdateStatement(
+		event,
+		[]handler.Column{
+			handler.NewArrayIntersectCol(GroupGrantRolesCol, keys),
+		},
+		[]handler.Condition{
+			handler.NewCond(GroupGrantGrantIDCol, grantID),
+			handler.NewCond(GroupGrantInstanceIDCol, event.Aggregate().InstanceID),
+		},
+		handler.WithTableSuffix(GroupGrantSuffix),
+	), nil
+}
+
+// reduceOwnerRemoved deletes the groups of the organization, its members and grants are removed by the foreign key.
+func (p *groupProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.OrgRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(GroupResourceOwnerCol, e.Aggregate().ID),
+			handler.NewCond(GroupInstanceIDCol, e.Aggregate().InstanceID),
+		},
+	), nil
+}
@@ -30,6 +30,11 @@ const (
 	IDPTemplateLDAPTable             = IDPTemplateTable + "_" + IDPTemplateLDAPSuffix
 	IDPTemplateAppleTable            = IDPTemplateTable + "_" + IDPTemplateAppleSuffix
 	IDPTemplateSAMLTable             = IDPTemplateTable + "_" + IDPTemplateSAMLSuffix
+	IDPTemplateADFSTable             = IDPTemplateTable + "_" + IDPTemplateADFSSuffix
+	IDPTemplateKeycloakTable         = IDPTemplateTable + "_" + IDPTemplateKeycloakSuffix
+	IDPTemplateOktaTable             = IDPTemplateTable + "_" + IDPTemplateOktaSuffix
+	IDPTemplateBitbucketTable        = IDPTemplateTable + "_" + IDPTemplateBitbucketSuffix
+	IDPTemplateDiscordTable          = IDPTemplateTable + "_" + IDPTemplateDiscordSuffix
 
 	IDPTemplateOAuthSuffix            = "oauth2"
 	IDPTemplateOIDCSuffix             = "oidc"
@@ -43,6 +48,11 @@ const (
 	IDPTemplateLDAPSuffix             = "ldap2"
 	IDPTemplateAppleSuffix            = "apple"
 	IDPTemplateSAMLSuffix             = "saml"
+	IDPTemplateADFSSuffix             = "adfs"
+	IDPTemplateKeycloakSuffix         = "keycloak"
+	IDPTemplateOktaSuffix             = "okta"
+	IDPTemplateBitbucketSuffix        = "bitbucket"
+	IDPTemplateDiscordSuffix          = "discord"
 
 	IDPTemplateIDCol                = "id"
 	IDPTemplateCreationDateCol      = "creation_date"
@@ -70,6 +80,8 @@ const (
 	OAuthUserEndpointCol          = "user_endpoint"
 	OAuthScopesCol                = "scopes"
 	OAuthIDAttributeCol           = "id_attribute"
+	OAuthUsePKCECol               = "use_pkce"
+	OAuthCodeVerifierKeyCol       = "code_verifier_key"
 
 	OIDCIDCol             = "idp_id"
 	OIDCInstanceIDCol     = "instance_id"
@@ -170,6 +182,39 @@ const (
 	SAMLWithSignedRequestCol          = "with_signed_request"
 	SAMLNameIDFormatCol               = "name_id_format"
 	SAMLTransientMappingAttributeName = "transient_mapping_attribute_name"
+
+	ADFSIDCol           = "idp_id"
+	ADFSInstanceIDCol   = "instance_id"
+	ADFSIssuerCol       = "issuer"
+	ADFSClientIDCol     = "client_id"
+	ADFSClientSecretCol = "client_secret"
+	ADFSScopesCol       = "scopes"
+
+	KeycloakIDCol           = "idp_id"
+	KeycloakInstanceIDCol   = "instance_id"
+	KeycloakIssuerCol       = "issuer"
+	KeycloakClientIDCol     = "client_id"
+	KeycloakClientSecretCol = "client_secret"
+	KeycloakScopesCol       = "scopes"
+
+	OktaIDCol           = "idp_id"
+	OktaInstanceIDCol   = "instance_id"
+	OktaIssuerCol       = "issuer"
+	OktaClientIDCol     = "client_id"
+	OktaClientSecretCol = "client_secret"
+	OktaScopesCol       = "scopes"
+
+	BitbucketIDCol           = "idp_id"
+	BitbucketInstanceIDCol   = "instance_id"
+	BitbucketClientIDCol     = "client_id"
+	BitbucketClientSecretCol = "client_secret"
+	BitbucketScopesCol       = "scopes"
+
+	DiscordIDCol           = "idp_id"
+	DiscordInstanceIDCol   = "instance_id"
+	DiscordClientIDCol     = "client_id"
+	DiscordClientSecretCol = "client_secret"
+	DiscordScopesCol       = "scopes"
 )
 
 type idpTemplateProjection struct{}
@@ -210,12 +255,14 @@ func (*idpTemplateProjection) Init() *old_handler.Check {
 			handler.NewColumn(OAuthIDCol, handler.ColumnTypeText),
 			handler.NewColumn(OAuthInstanceIDCol, handler.ColumnTypeText),
 			handler.NewColumn(OAuthClientIDCol, handler.ColumnTypeText),
-			handler.NewColumn(OAuthClientSecretCol, handler.ColumnTypeJSONB),
+			handler.NewColumn(OAuthClientSecretCol, handler.ColumnTypeJSONB, handler.Nullable()),
 			handler.NewColumn(OAuthAuthorizationEndpointCol, handler.ColumnTypeText),
 			handler.NewColumn(OAuthTokenEndpointCol, handler.ColumnTypeText),
 			handler.NewColumn(OAuthUserEndpointCol, handler.ColumnTypeText),
 			handler.NewColumn(OAuthScopesCol, handler.ColumnTypeTextArray, handler.Nullable()),
 			handler.NewColumn(OAuthIDAttributeCol, handler.ColumnTypeText),
+			handler.NewColumn(OAuthUsePKCECol, handler.ColumnTypeBool, handler.Default(false)),
+			handler.NewColumn(OAuthCodeVerifierKeyCol, handler.ColumnTypeJSONB, handler.Nullable()),
 		},
 			handler.NewPrimaryKey(OAuthInstanceIDCol, OAuthIDCol),
 			IDPTemplateOAuthSuffix,
@@ -376,6 +423,64 @@ func (*idpTemplateProjection) Init() *old_handler.Check {
 			IDPTemplateSAMLSuffix,
 			handler.WithForeignKey(handler.NewForeignKeyOfPublicKeys()),
 		),
+		handler.NewSuffixedTable([]*handler.InitColumn{
+			handler.NewColumn(ADFSIDCol, handler.ColumnTypeText),
+			handler.NewColumn(ADFSInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(ADFSIssuerCol, handler.ColumnTypeText),
+			handler.NewColumn(ADFSClientIDCol, handler.ColumnTypeText),
+			handler.NewColumn(ADFSClientSecretCol, handler.ColumnTypeJSONB),
+			handler.NewColumn(ADFSScopesCol, handler.ColumnTypeTextArray, handler.Nullable()),
+		},
+			handler.NewPrimaryKey(ADFSInstanceIDCol, ADFSIDCol),
+			IDPTemplateADFSSuffix,
+			handler.WithForeignKey(handler.NewForeignKeyOfPublicKeys()),
+		),
+		handler.NewSuffixedTable([]*handler.InitColumn{
+			handler.NewColumn(KeycloakIDCol, handler.ColumnTypeText),
+			handler.NewColumn(KeycloakInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(KeycloakIssuerCol, handler.ColumnTypeText),
+			handler.NewColumn(KeycloakClientIDCol, handler.ColumnTypeText),
+			handler.NewColumn(KeycloakClientSecretCol, handler.ColumnTypeJSONB),
+			handler.NewColumn(KeycloakScopesCol, handler.ColumnTypeTextArray, handler.Nullable()),
+		},
+			handler.NewPrimaryKey(KeycloakInstanceIDCol, KeycloakIDCol),
+			IDPTemplateKeycloakSuffix,
+			handler.WithForeignKey(handler.NewForeignKeyOfPublicKeys()),
+		),
+		handler.NewSuffixedTable([]*handler.InitColumn{
+			handler.NewColumn(OktaIDCol, handler.ColumnTypeText),
+			handler.NewColumn(OktaInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(OktaIssuerCol, handler.ColumnTypeText),
+			handler.NewColumn(OktaClientIDCol, handler.ColumnTypeText),
+			handler.NewColumn(OktaClientSecretCol, handler.ColumnTypeJSONB),
+			handler.NewColumn(OktaScopesCol, handler.ColumnTypeTextArray, handler.Nullable()),
+		},
+			handler.NewPrimaryKey(OktaInstanceIDCol, OktaIDCol),
+			IDPTemplateOktaSuffix,
+			handler.WithForeignKey(handler.NewForeignKeyOfPublicKeys()),
+		),
+		handler.NewSuffixedTable([]*handler.InitColumn{
+			handler.NewColumn(BitbucketIDCol, handler.ColumnTypeText),
+			handler.NewColumn(BitbucketInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(BitbucketClientIDCol, handler.ColumnTypeText),
+			handler.NewColumn(BitbucketClientSecretCol, handler.ColumnTypeJSONB),
+			handler.NewColumn(BitbucketScopesCol, handler.ColumnTypeTextArray, handler.Nullable()),
+		},
+			handler.NewPrimaryKey(BitbucketInstanceIDCol, BitbucketIDCol),
+			IDPTemplateBitbucketSuffix,
+			handler.WithForeignKey(handler.NewForeignKeyOfPublicKeys()),
+		),
+		handler.NewSuffixedTable([]*handler.InitColumn{
+			handler.NewColumn(DiscordIDCol, handler.ColumnTypeText),
+			handler.NewColumn(DiscordInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(DiscordClientIDCol, handler.ColumnTypeText),
+			handler.NewColumn(DiscordClientSecretCol, handler.ColumnTypeJSONB),
+			handler.NewColumn(DiscordScopesCol, handler.ColumnTypeTextArray, handler.Nullable()),
+		},
+			handler.NewPrimaryKey(DiscordInstanceIDCol, DiscordIDCol),
+			IDPTemplateDiscordSuffix,
+			handler.WithForeignKey(handler.NewForeignKeyOfPublicKeys()),
+		),
 	)
 }
 
@@ -512,6 +617,46 @@ func (p *idpTemplateProjection) Reducers() []handler.AggregateReducer {
 					Event:  instance.SAMLIDPChangedEventType,
 					Reduce: p.reduceSAMLIDPChanged,
 				},
+				{
+					Event:  instance.ADFSIDPAddedEventType,
+					Reduce: p.reduceADFSIDPAdded,
+				},
+				{
+					Event:  instance.ADFSIDPChangedEventType,
+					Reduce: p.reduceADFSIDPChanged,
+				},
+				{
+					Event:  instance.KeycloakIDPAddedEventType,
+					Reduce: p.reduceKeycloakIDPAdded,
+				},
+				{
+					Event:  instance.KeycloakIDPChangedEventType,
+					Reduce: p.reduceKeycloakIDPChanged,
+				},
+				{
+					Event:  instance.OktaIDPAddedEventType,
+					Reduce: p.reduceOktaIDPAdded,
+				},
+				{
+					Event:  instance.OktaIDPChangedEventType,
+					Reduce: p.reduceOktaIDPChanged,
+				},
+				{
+					Event:  instance.BitbucketIDPAddedEventType,
+					Reduce: p.reduceBitbucketIDPAdded,
+				},
+				{
+					Event:  instance.BitbucketIDPChangedEventType,
+					Reduce: p.reduceBitbucketIDPChanged,
+				},
+				{
+					Event:  instance.DiscordIDPAddedEventType,
+					Reduce: p.reduceDiscordIDPAdded,
+				},
+				{
+					Event:  instance.DiscordIDPChangedEventType,
+					Reduce: p.reduceDiscordIDPChanged,
+				},
 				{
 					Event:  instance.IDPConfigRemovedEventType,
 					Reduce: p.reduceIDPConfigRemoved,
@@ -657,6 +802,46 @@ func (p *idpTemplateProjection) Reducers() []handler.AggregateReducer {
 					Event:  org.SAMLIDPChangedEventType,
 					Reduce: p.reduceSAMLIDPChanged,
 				},
+				{
+					Event:  org.ADFSIDPAddedEventType,
+					Reduce: p.reduceADFSIDPAdded,
+				},
+				{
+					Event:  org.ADFSIDPChangedEventType,
+					Reduce: p.reduceADFSIDPChanged,
+				},
+				{
+					Event:  org.KeycloakIDPAddedEventType,
+					Reduce: p.reduceKeycloakIDPAdded,
+				},
+				{
+					Event:  org.KeycloakIDPChangedEventType,
+					Reduce: p.reduceKeycloakIDPChanged,
+				},
+				{
+					Event:  org.OktaIDPAddedEventType,
+					Reduce: p.reduceOktaIDPAdded,
+				},
+				{
+					Event:  org.OktaIDPChangedEventType,
+					Reduce: p.reduceOktaIDPChanged,
+				},
+				{
+					Event:  org.BitbucketIDPAddedEventType,
+					Reduce: p.reduceBitbucketIDPAdded,
+				},
+				{
+					Event:  org.BitbucketIDPChangedEventType,
+					Reduce: p.reduceBitbucketIDPChanged,
+				},
+				{
+					Event:  org.DiscordIDPAddedEventType,
+					Reduce: p.reduceDiscordIDPAdded,
+				},
+				{
+					Event:  org.DiscordIDPChangedEventType,
+					Reduce: p.reduceDiscordIDPChanged,
+				},
 				{
 					Event:  org.IDPConfigRemovedEventType,
 					Reduce: p.reduceIDPConfigRemoved,
@@ -720,6 +905,8 @@ func (p *idpTemplateProjection) reduceOAuthIDPAdded(event eventstore.Event) (*ha
 				handler.NewCol(OAuthUserEndpointCol, idpEvent.UserEndpoint),
 				handler.NewCol(OAuthScopesCol, database.TextArray[string](idpEvent.Scopes)),
 				handler.NewCol(OAuthIDAttributeCol, idpEvent.IDAttribute),
+				handler.NewCol(OAuthUsePKCECol, idpEvent.UsePKCE),
+				handler.NewCol(OAuthCodeVerifierKeyCol, idpEvent.CodeVerifierKey),
 			},
 			handler.WithTableSuffix(IDPTemplateOAuthSuffix),
 		),
@@ -2228,7 +2415,7 @@ func reduceIDPChangedTemplateColumns(name *string, creationDate time.Time, seque
 }
 
 func reduceOAuthIDPChangedColumns(idpEvent idp.OAuthIDPChangedEvent) []handler.Column {
-	oauthCols := make([]handler.Column, 0, 7)
+	oauthCols := make([]handler.Column, 0, 9)
 	if idpEvent.ClientID != nil {
 		oauthCols = append(oauthCols, handler.NewCol(OAuthClientIDCol, *idpEvent.ClientID))
 	}
@@ -2250,6 +2437,12 @@ func reduceOAuthIDPChangedColumns(idpEvent idp.OAuthIDPChangedEvent) []handler.C
 	if idpEvent.IDAttribute != nil {
 		oauthCols = append(oauthCols, handler.NewCol(OAuthIDAttributeCol, *idpEvent.IDAttribute))
 	}
+	if idpEvent.UsePKCE != nil {
+		oauthCols = append(oauthCols, handler.NewCol(OAuthUsePKCECol, *idpEvent.UsePKCE))
+	}
+	if idpEvent.CodeVerifierKey != nil {
+		oauthCols = append(oauthCols, handler.NewCol(OAuthCodeVerifierKeyCol, idpEvent.CodeVerifierKey))
+	}
 	return oauthCols
 }
 
@@ -2324,6 +2517,212 @@ func reduceGitHubIDPChangedColumns(idpEvent idp.GitHubIDPChangedEvent) []handler
 	return oauthCols
 }
 
+func (p *idpTemplateProjection) reduceBitbucketIDPAdded(event eventstore.Event) (*handler.Statement, error) {
+	var idpEvent idp.BitbucketIDPAddedEvent
+	var idpOwnerType domain.IdentityProviderType
+	switch e := event.(type) {
+	case *org.BitbucketIDPAddedEvent:
+		idpEvent = e.BitbucketIDPAddedEvent
+		idpOwnerType = domain.IdentityProviderTypeOrg
+	case *instance.BitbucketIDPAddedEvent:
+		idpEvent = e.BitbucketIDPAddedEvent
+		idpOwnerType = domain.IdentityProviderTypeSystem
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-y2o9usX", "reduce.wrong.event.type %v", []eventstore.EventType{org.BitbucketIDPAddedEventType, instance.BitbucketIDPAddedEventType})
+	}
+
+	return handler.NewMultiStatement(
+		&idpEvent,
+		handler.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(IDPTemplateIDCol, idpEvent.ID),
+				handler.NewCol(IDPTemplateCreationDateCol, idpEvent.CreationDate()),
+				handler.NewCol(IDPTemplateChangeDateCol, idpEvent.CreationDate()),
+				handler.NewCol(IDPTemplateSequenceCol, idpEvent.Sequence()),
+				handler.NewCol(IDPTemplateResourceOwnerCol, idpEvent.Aggregate().ResourceOwner),
+				handler.NewCol(IDPTemplateInstanceIDCol, idpEvent.Aggregate().InstanceID),
+				handler.NewCol(IDPTemplateStateCol, domain.IDPStateActive),
+				handler.NewCol(IDPTemplateNameCol, idpEvent.Name),
+				handler.NewCol(IDPTemplateOwnerTypeCol, idpOwnerType),
+				handler.NewCol(IDPTemplateTypeCol, domain.IDPTypeBitbucket),
+				handler.NewCol(IDPTemplateIsCreationAllowedCol, idpEvent.IsCreationAllowed),
+				handler.NewCol(IDPTemplateIsLinkingAllowedCol, idpEvent.IsLinkingAllowed),
+				handler.NewCol(IDPTemplateIsAutoCreationCol, idpEvent.IsAutoCreation),
+				handler.NewCol(IDPTemplateIsAutoUpdateCol, idpEvent.IsAutoUpdate),
+				handler.NewCol(IDPTemplateAutoLinkingCol, idpEvent.AutoLinkingOption),
+			},
+		),
+		handler.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(BitbucketIDCol, idpEvent.ID),
+				handler.NewCol(BitbucketInstanceIDCol, idpEvent.Aggregate().InstanceID),
+				handler.NewCol(BitbucketClientIDCol, idpEvent.ClientID),
+				handler.NewCol(BitbucketClientSecretCol, idpEvent.ClientSecret),
+				handler.NewCol(BitbucketScopesCol, database.TextArray[string](idpEvent.Scopes)),
+			},
+			handler.WithTableSuffix(IDPTemplateBitbucketSuffix),
+		),
+	), nil
+}
+
+func (p *idpTemplateProjection) reduceBitbucketIDPChanged(event eventstore.Event) (*handler.Statement, error) {
+	var idpEvent idp.BitbucketIDPChangedEvent
+	switch e := event.(type) {
+	case *org.BitbucketIDPChangedEvent:
+		idpEvent = e.BitbucketIDPChangedEvent
+	case *instance.BitbucketIDPChangedEvent:
+		idpEvent = e.BitbucketIDPChangedEvent
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-g1jE4Ix", "reduce.wrong.event.type %v", []eventstore.EventType{org.BitbucketIDPChangedEventType, instance.BitbucketIDPChangedEventType})
+	}
+
+	ops := make([]func(eventstore.Event) handler.Exec, 0, 2)
+	ops = append(ops,
+		handler.AddUpdateStatement(
+			reduceIDPChangedTemplateColumns(idpEvent.Name, idpEvent.CreationDate(), idpEvent.Sequence(), idpEvent.OptionChanges),
+			[]handler.Condition{
+				handler.NewCond(IDPTemplateIDCol, idpEvent.ID),
+				handler.NewCond(IDPTemplateInstanceIDCol, idpEvent.Aggregate().InstanceID),
+			},
+		),
+	)
+	bitbucketCols := reduceBitbucketIDPChangedColumns(idpEvent)
+	if len(bitbucketCols) > 0 {
+		ops = append(ops,
+			handler.AddUpdateStatement(
+				bitbucketCols,
+				[]handler.Condition{
+					handler.NewCond(BitbucketIDCol, idpEvent.ID),
+					handler.NewCond(BitbucketInstanceIDCol, idpEvent.Aggregate().InstanceID),
+				},
+				handler.WithTableSuffix(IDPTemplateBitbucketSuffix),
+			),
+		)
+	}
+
+	return handler.NewMultiStatement(
+		&idpEvent,
+		ops...,
+	), nil
+}
+
+func reduceBitbucketIDPChangedColumns(idpEvent idp.BitbucketIDPChangedEvent) []handler.Column {
+	oauthCols := make([]handler.Column, 0, 3)
+	if idpEvent.ClientID != nil {
+		oauthCols = append(oauthCols, handler.NewCol(BitbucketClientIDCol, *idpEvent.ClientID))
+	}
+	if idpEvent.ClientSecret != nil {
+		oauthCols = append(oauthCols, handler.NewCol(BitbucketClientSecretCol, *idpEvent.ClientSecret))
+	}
+	if idpEvent.Scopes != nil {
+		oauthCols = append(oauthCols, handler.NewCol(BitbucketScopesCol, database.TextArray[string](idpEvent.Scopes)))
+	}
+	return oauthCols
+}
+
+func (p *idpTemplateProjection) reduceDiscordIDPAdded(event eventstore.Event) (*handler.Statement, error) {
+	var idpEvent idp.DiscordIDPAddedEvent
+	var idpOwnerType domain.IdentityProviderType
+	switch e := event.(type) {
+	case *org.DiscordIDPAddedEvent:
+		idpEvent = e.DiscordIDPAddedEvent
+		idpOwnerType = domain.IdentityProviderTypeOrg
+	case *instance.DiscordIDPAddedEvent:
+		idpEvent = e.DiscordIDPAddedEvent
+		idpOwnerType = domain.IdentityProviderTypeSystem
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-PPuZc3l", "reduce.wrong.event.type %v", []eventstore.EventType{org.DiscordIDPAddedEventType, instance.DiscordIDPAddedEventType})
+	}
+
+	return handler.NewMultiStatement(
+		&idpEvent,
+		handler.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(IDPTemplateIDCol, idpEvent.ID),
+				handler.NewCol(IDPTemplateCreationDateCol, idpEvent.CreationDate()),
+				handler.NewCol(IDPTemplateChangeDateCol, idpEvent.CreationDate()),
+				handler.NewCol(IDPTemplateSequenceCol, idpEvent.Sequence()),
+				handler.NewCol(IDPTemplateResourceOwnerCol, idpEvent.Aggregate().ResourceOwner),
+				handler.NewCol(IDPTemplateInstanceIDCol, idpEvent.Aggregate().InstanceID),
+				handler.NewCol(IDPTemplateStateCol, domain.IDPStateActive),
+				handler.NewCol(IDPTemplateNameCol, idpEvent.Name),
+				handler.NewCol(IDPTemplateOwnerTypeCol, idpOwnerType),
+				handler.NewCol(IDPTemplateTypeCol, domain.IDPTypeDiscord),
+				handler.NewCol(IDPTemplateIsCreationAllowedCol, idpEvent.IsCreationAllowed),
+				handler.NewCol(IDPTemplateIsLinkingAllowedCol, idpEvent.IsLinkingAllowed),
+				handler.NewCol(IDPTemplateIsAutoCreationCol, idpEvent.IsAutoCreation),
+				handler.NewCol(IDPTemplateIsAutoUpdateCol, idpEvent.IsAutoUpdate),
+				handler.NewCol(IDPTemplateAutoLinkingCol, idpEvent.AutoLinkingOption),
+			},
+		),
+		handler.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(DiscordIDCol, idpEvent.ID),
+				handler.NewCol(DiscordInstanceIDCol, idpEvent.Aggregate().InstanceID),
+				handler.NewCol(DiscordClientIDCol, idpEvent.ClientID),
+				handler.NewCol(DiscordClientSecretCol, idpEvent.ClientSecret),
+				handler.NewCol(DiscordScopesCol, database.TextArray[string](idpEvent.Scopes)),
+			},
+			handler.WithTableSuffix(IDPTemplateDiscordSuffix),
+		),
+	), nil
+}
+
+func (p *idpTemplateProjection) reduceDiscordIDPChanged(event eventstore.Event) (*handler.Statement, error) {
+	var idpEvent idp.DiscordIDPChangedEvent
+	switch e := event.(type) {
+	case *org.DiscordIDPChangedEvent:
+		idpEvent = e.DiscordIDPChangedEvent
+	case *instance.DiscordIDPChangedEvent:
+		idpEvent = e.DiscordIDPChangedEvent
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-XuvaKp3", "reduce.wrong.event.type %v", []eventstore.EventType{org.DiscordIDPChangedEventType, instance.DiscordIDPChangedEventType})
+	}
+
+	ops := make([]func(eventstore.Event) handler.Exec, 0, 2)
+	ops = append(ops,
+		handler.AddUpdateStatement(
+			reduceIDPChangedTemplateColumns(idpEvent.Name, idpEvent.CreationDate(), idpEvent.Sequence(), idpEvent.OptionChanges),
+			[]handler.Condition{
+				handler.NewCond(IDPTemplateIDCol, idpEvent.ID),
+				handler.NewCond(IDPTemplateInstanceIDCol, idpEvent.Aggregate().InstanceID),
+			},
+		),
+	)
+	discordCols := reduceDiscordIDPChangedColumns(idpEvent)
+	if len(discordCols) > 0 {
+		ops = append(ops,
+			handler.AddUpdateStatement(
+				discordCols,
+				[]handler.Condition{
+					handler.NewCond(DiscordIDCol, idpEvent.ID),
+					handler.NewCond(DiscordInstanceIDCol, idpEvent.Aggregate().InstanceID),
+				},
+				handler.WithTableSuffix(IDPTemplateDiscordSuffix),
+			),
+		)
+	}
+
+	return handler.NewMultiStatement(
+		&idpEvent,
+		ops...,
+	), nil
+}
+
+func reduceDiscordIDPChangedColumns(idpEvent idp.DiscordIDPChangedEvent) []handler.Column {
+	oauthCols := make([]handler.Column, 0, 3)
+	if idpEvent.ClientID != nil {
+		oauthCols = append(oauthCols, handler.NewCol(DiscordClientIDCol, *idpEvent.ClientID))
+	}
+	if idpEvent.ClientSecret != nil {
+		oauthCols = append(oauthCols, handler.NewCol(DiscordClientSecretCol, *idpEvent.ClientSecret))
+	}
+	if idpEvent.Scopes != nil {
+		oauthCols = append(oauthCols, handler.NewCol(DiscordScopesCol, database.TextArray[string](idpEvent.Scopes)))
+	}
+	return oauthCols
+}
+
 func reduceGitHubEnterpriseIDPChangedColumns(idpEvent idp.GitHubEnterpriseIDPChangedEvent) []handler.Column {
 	oauthCols := make([]handler.Column, 0, 6)
 	if idpEvent.ClientID != nil {
@@ -2378,6 +2777,327 @@ func reduceGitLabSelfHostedIDPChangedColumns(idpEvent idp.GitLabSelfHostedIDPCha
 	return gitlabCols
 }
 
+func (p *idpTemplateProjection) reduceADFSIDPAdded(event eventstore.Event) (*handler.Statement, error) {
+	var idpEvent idp.ADFSIDPAddedEvent
+	var idpOwnerType domain.IdentityProviderType
+	switch e := event.(type) {
+	case *org.ADFSIDPAddedEvent:
+		idpEvent = e.ADFSIDPAddedEvent
+		idpOwnerType = domain.IdentityProviderTypeOrg
+	case *instance.ADFSIDPAddedEvent:
+		idpEvent = e.ADFSIDPAddedEvent
+		idpOwnerType = domain.IdentityProviderTypeSystem
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-yDvrqe", "reduce.wrong.event.type %v", []eventstore.EventType{org.ADFSIDPAddedEventType, instance.ADFSIDPAddedEventType})
+	}
+
+	return handler.NewMultiStatement(
+		&idpEvent,
+		handler.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(IDPTemplateIDCol, idpEvent.ID),
+				handler.NewCol(IDPTemplateCreationDateCol, idpEvent.CreationDate()),
+				handler.NewCol(IDPTemplateChangeDateCol, idpEvent.CreationDate()),
+				handler.NewCol(IDPTemplateSequenceCol, idpEvent.Sequence()),
+				handler.NewCol(IDPTemplateResourceOwnerCol, idpEvent.Aggregate().ResourceOwner),
+				handler.NewCol(IDPTemplateInstanceIDCol, idpEvent.Aggregate().InstanceID),
+				handler.NewCol(IDPTemplateStateCol, domain.IDPStateActive),
+				handler.NewCol(IDPTemplateNameCol, idpEvent.Name),
+				handler.NewCol(IDPTemplateOwnerTypeCol, idpOwnerType),
+				handler.NewCol(IDPTemplateTypeCol, domain.IDPTypeADFS),
+				handler.NewCol(IDPTemplateIsCreationAllowedCol, idpEvent.IsCreationAllowed),
+				handler.NewCol(IDPTemplateIsLinkingAllowedCol, idpEvent.IsLinkingAllowed),
+				handler.NewCol(IDPTemplateIsAutoCreationCol, idpEvent.IsAutoCreation),
+				handler.NewCol(IDPTemplateIsAutoUpdateCol, idpEvent.IsAutoUpdate),
+				handler.NewCol(IDPTemplateAutoLinkingCol, idpEvent.AutoLinkingOption),
+			},
+		),
+		handler.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(ADFSIDCol, idpEvent.ID),
+				handler.NewCol(ADFSInstanceIDCol, idpEvent.Aggregate().InstanceID),
+				handler.NewCol(ADFSIssuerCol, idpEvent.Issuer),
+				handler.NewCol(ADFSClientIDCol, idpEvent.ClientID),
+				handler.NewCol(ADFSClientSecretCol, idpEvent.ClientSecret),
+				handler.NewCol(ADFSScopesCol, database.TextArray[string](idpEvent.Scopes)),
+			},
+			handler.WithTableSuffix(IDPTemplateADFSSuffix),
+		),
+	), nil
+}
+
+func (p *idpTemplateProjection) reduceADFSIDPChanged(event eventstore.Event) (*handler.Statement, error) {
+	var idpEvent idp.ADFSIDPChangedEvent
+	switch e := event.(type) {
+	case *org.ADFSIDPChangedEvent:
+		idpEvent = e.ADFSIDPChangedEvent
+	case *instance.ADFSIDPChangedEvent:
+		idpEvent = e.ADFSIDPChangedEvent
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-rICX6L", "reduce.wrong.event.type %v", []eventstore.EventType{org.ADFSIDPChangedEventType, instance.ADFSIDPChangedEventType})
+	}
+
+	ops := make([]func(eventstore.Event) handler.Exec, 0, 2)
+	ops = append(ops,
+		handler.AddUpdateStatement(
+			reduceIDPChangedTemplateColumns(idpEvent.Name, idpEvent.CreationDate(), idpEvent.Sequence(), idpEvent.OptionChanges),
+			[]handler.Condition{
+				handler.NewCond(IDPTemplateIDCol, idpEvent.ID),
+				handler.NewCond(IDPTemplateInstanceIDCol, idpEvent.Aggregate().InstanceID),
+			},
+		),
+	)
+	gitlabCols := reduceADFSIDPChangedColumns(idpEvent)
+	if len(gitlabCols) > 0 {
+		ops = append(ops,
+			handler.AddUpdateStatement(
+				gitlabCols,
+				[]handler.Condition{
+					handler.NewCond(ADFSIDCol, idpEvent.ID),
+					handler.NewCond(ADFSInstanceIDCol, idpEvent.Aggregate().InstanceID),
+				},
+				handler.WithTableSuffix(IDPTemplateADFSSuffix),
+			),
+		)
+	}
+
+	return handler.NewMultiStatement(
+		&idpEvent,
+		ops...,
+	), nil
+}
+
+func reduceADFSIDPChangedColumns(idpEvent idp.ADFSIDPChangedEvent) []handler.Column {
+	gitlabCols := make([]handler.Column, 0, 4)
+	if idpEvent.Issuer != nil {
+		gitlabCols = append(gitlabCols, handler.NewCol(ADFSIssuerCol, *idpEvent.Issuer))
+	}
+	if idpEvent.ClientID != nil {
+		gitlabCols = append(gitlabCols, handler.NewCol(ADFSClientIDCol, *idpEvent.ClientID))
+	}
+	if idpEvent.ClientSecret != nil {
+		gitlabCols = append(gitlabCols, handler.NewCol(ADFSClientSecretCol, *idpEvent.ClientSecret))
+	}
+	if idpEvent.Scopes != nil {
+		gitlabCols = append(gitlabCols, handler.NewCol(ADFSScopesCol, database.TextArray[string](idpEvent.Scopes)))
+	}
+	return gitlabCols
+}
+
+func (p *idpTemplateProjection) reduceKeycloakIDPAdded(event eventstore.Event) (*handler.Statement, error) {
+	var idpEvent idp.KeycloakIDPAddedEvent
+	var idpOwnerType domain.IdentityProviderType
+	switch e := event.(type) {
+	case *org.KeycloakIDPAddedEvent:
+		idpEvent = e.KeycloakIDPAddedEvent
+		idpOwnerType = domain.IdentityProviderTypeOrg
+	case *instance.KeycloakIDPAddedEvent:
+		idpEvent = e.KeycloakIDPAddedEvent
+		idpOwnerType = domain.IdentityProviderTypeSystem
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-rj31jg", "reduce.wrong.event.type %v", []eventstore.EventType{org.KeycloakIDPAddedEventType, instance.KeycloakIDPAddedEventType})
+	}
+
+	return handler.NewMultiStatement(
+		&idpEvent,
+		handler.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(IDPTemplateIDCol, idpEvent.ID),
+				handler.NewCol(IDPTemplateCreationDateCol, idpEvent.CreationDate()),
+				handler.NewCol(IDPTemplateChangeDateCol, idpEvent.CreationDate()),
+				handler.NewCol(IDPTemplateSequenceCol, idpEvent.Sequence()),
+				handler.NewCol(IDPTemplateResourceOwnerCol, idpEvent.Aggregate().ResourceOwner),
+				handler.NewCol(IDPTemplateInstanceIDCol, idpEvent.Aggregate().InstanceID),
+				handler.NewCol(IDPTemplateStateCol, domain.IDPStateActive),
+				handler.NewCol(IDPTemplateNameCol, idpEvent.Name),
+				handler.NewCol(IDPTemplateOwnerTypeCol, idpOwnerType),
+				handler.NewCol(IDPTemplateTypeCol, domain.IDPTypeKeycloak),
+				handler.NewCol(IDPTemplateIsCreationAllowedCol, idpEvent.IsCreationAllowed),
+				handler.NewCol(IDPTemplateIsLinkingAllowedCol, idpEvent.IsLinkingAllowed),
+				handler.NewCol(IDPTemplateIsAutoCreationCol, idpEvent.IsAutoCreation),
+				handler.NewCol(IDPTemplateIsAutoUpdateCol, idpEvent.IsAutoUpdate),
+				handler.NewCol(IDPTemplateAutoLinkingCol, idpEvent.AutoLinkingOption),
+			},
+		),
+		handler.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(KeycloakIDCol, idpEvent.ID),
+				handler.NewCol(KeycloakInstanceIDCol, idpEvent.Aggregate().InstanceID),
+				handler.NewCol(KeycloakIssuerCol, idpEvent.Issuer),
+				handler.NewCol(KeycloakClientIDCol, idpEvent.ClientID),
+				handler.NewCol(KeycloakClientSecretCol, idpEvent.ClientSecret),
+				handler.NewCol(KeycloakScopesCol, database.TextArray[string](idpEvent.Scopes)),
+			},
+			handler.WithTableSuffix(IDPTemplateKeycloakSuffix),
+		),
+	), nil
+}
+
+func (p *idpTemplateProjection) reduceKeycloakIDPChanged(event eventstore.Event) (*handler.Statement, error) {
+	var idpEvent idp.KeycloakIDPChangedEvent
+	switch e := event.(type) {
+	case *org.KeycloakIDPChangedEvent:
+		idpEvent = e.KeycloakIDPChangedEvent
+	case *instance.KeycloakIDPChangedEvent:
+		idpEvent = e.KeycloakIDPChangedEvent
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-uoEG47", "reduce.wrong.event.type %v", []eventstore.EventType{org.KeycloakIDPChangedEventType, instance.KeycloakIDPChangedEventType})
+	}
+
+	ops := make([]func(eventstore.Event) handler.Exec, 0, 2)
+	ops = append(ops,
+		handler.AddUpdateStatement(
+			reduceIDPChangedTemplateColumns(idpEvent.Name, idpEvent.CreationDate(), idpEvent.Sequence(), idpEvent.OptionChanges),
+			[]handler.Condition{
+				handler.NewCond(IDPTemplateIDCol, idpEvent.ID),
+				handler.NewCond(IDPTemplateInstanceIDCol, idpEvent.Aggregate().InstanceID),
+			},
+		),
+	)
+	gitlabCols := reduceKeycloakIDPChangedColumns(idpEvent)
+	if len(gitlabCols) > 0 {
+		ops = append(ops,
+			handler.AddUpdateStatement(
+				gitlabCols,
+				[]handler.Condition{
+					handler.NewCond(KeycloakIDCol, idpEvent.ID),
+					handler.NewCond(KeycloakInstanceIDCol, idpEvent.Aggregate().InstanceID),
+				},
+				handler.WithTableSuffix(IDPTemplateKeycloakSuffix),
+			),
+		)
+	}
+
+	return handler.NewMultiStatement(
+		&idpEvent,
+		ops...,
+	), nil
+}
+
+func reduceKeycloakIDPChangedColumns(idpEvent idp.KeycloakIDPChangedEvent) []handler.Column {
+	gitlabCols := make([]handler.Column, 0, 4)
+	if idpEvent.Issuer != nil {
+		gitlabCols = append(gitlabCols, handler.NewCol(KeycloakIssuerCol, *idpEvent.Issuer))
+	}
+	if idpEvent.ClientID != nil {
+		gitlabCols = append(gitlabCols, handler.NewCol(KeycloakClientIDCol, *idpEvent.ClientID))
+	}
+	if idpEvent.ClientSecret != nil {
+		gitlabCols = append(gitlabCols, handler.NewCol(KeycloakClientSecretCol, *idpEvent.ClientSecret))
+	}
+	if idpEvent.Scopes != nil {
+		gitlabCols = append(gitlabCols, handler.NewCol(KeycloakScopesCol, database.TextArray[string](idpEvent.Scopes)))
+	}
+	return gitlabCols
+}
+
+func (p *idpTemplateProjection) reduceOktaIDPAdded(event eventstore.Event) (*handler.Statement, error) {
+	var idpEvent idp.OktaIDPAddedEvent
+	var idpOwnerType domain.IdentityProviderType
+	switch e := event.(type) {
+	case *org.OktaIDPAddedEvent:
+		idpEvent = e.OktaIDPAddedEvent
+		idpOwnerType = domain.IdentityProviderTypeOrg
+	case *instance.OktaIDPAddedEvent:
+		idpEvent = e.OktaIDPAddedEvent
+		idpOwnerType = domain.IdentityProviderTypeSystem
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-G7KTkL", "reduce.wrong.event.type %v", []eventstore.EventType{org.OktaIDPAddedEventType, instance.OktaIDPAddedEventType})
+	}
+
+	return handler.NewMultiStatement(
+		&idpEvent,
+		handler.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(IDPTemplateIDCol, idpEvent.ID),
+				handler.NewCol(IDPTemplateCreationDateCol, idpEvent.CreationDate()),
+				handler.NewCol(IDPTemplateChangeDateCol, idpEvent.CreationDate()),
+				handler.NewCol(IDPTemplateSequenceCol, idpEvent.Sequence()),
+				handler.NewCol(IDPTemplateResourceOwnerCol, idpEvent.Aggregate().ResourceOwner),
+				handler.NewCol(IDPTemplateInstanceIDCol, idpEvent.Aggregate().InstanceID),
+				handler.NewCol(IDPTemplateStateCol, domain.IDPStateActive),
+				handler.NewCol(IDPTemplateNameCol, idpEvent.Name),
+				handler.NewCol(IDPTemplateOwnerTypeCol, idpOwnerType),
+				handler.NewCol(IDPTemplateTypeCol, domain.IDPTypeOkta),
+				handler.NewCol(IDPTemplateIsCreationAllowedCol, idpEvent.IsCreationAllowed),
+				handler.NewCol(IDPTemplateIsLinkingAllowedCol, idpEvent.IsLinkingAllowed),
+				handler.NewCol(IDPTemplateIsAutoCreationCol, idpEvent.IsAutoCreation),
+				handler.NewCol(IDPTemplateIsAutoUpdateCol, idpEvent.IsAutoUpdate),
+				handler.NewCol(IDPTemplateAutoLinkingCol, idpEvent.AutoLinkingOption),
+			},
+		),
+		handler.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(OktaIDCol, idpEvent.ID),
+				handler.NewCol(OktaInstanceIDCol, idpEvent.Aggregate().InstanceID),
+				handler.NewCol(OktaIssuerCol, idpEvent.Issuer),
+				handler.NewCol(OktaClientIDCol, idpEvent.ClientID),
+				handler.NewCol(OktaClientSecretCol, idpEvent.ClientSecret),
+				handler.NewCol(OktaScopesCol, database.TextArray[string](idpEvent.Scopes)),
+			},
+			handler.WithTableSuffix(IDPTemplateOktaSuffix),
+		),
+	), nil
+}
+
+func (p *idpTemplateProjection) reduceOktaIDPChanged(event eventstore.Event) (*handler.Statement, error) {
+	var idpEvent idp.OktaIDPChangedEvent
+	switch e := event.(type) {
+	case *org.OktaIDPChangedEvent:
+		idpEvent = e.OktaIDPChangedEvent
+	case *instance.OktaIDPChangedEvent:
+		idpEvent = e.OktaIDPChangedEvent
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-jjTT3D", "reduce.wrong.event.type %v", []eventstore.EventType{org.OktaIDPChangedEventType, instance.OktaIDPChangedEventType})
+	}
+
+	ops := make([]func(eventstore.Event) handler.Exec, 0, 2)
+	ops = append(ops,
+		handler.AddUpdateStatement(
+			reduceIDPChangedTemplateColumns(idpEvent.Name, idpEvent.CreationDate(), idpEvent.Sequence(), idpEvent.OptionChanges),
+			[]handler.Condition{
+				handler.NewCond(IDPTemplateIDCol, idpEvent.ID),
+				handler.NewCond(IDPTemplateInstanceIDCol, idpEvent.Aggregate().InstanceID),
+			},
+		),
+	)
+	gitlabCols := reduceOktaIDPChangedColumns(idpEvent)
+	if len(gitlabCols) > 0 {
+		ops = append(ops,
+			handler.AddUpdateStatement(
+				gitlabCols,
+				[]handler.Condition{
+					handler.NewCond(OktaIDCol, idpEvent.ID),
+					handler.NewCond(OktaInstanceIDCol, idpEvent.Aggregate().InstanceID),
+				},
+				handler.WithTableSuffix(IDPTemplateOktaSuffix),
+			),
+		)
+	}
+
+	return handler.NewMultiStatement(
+		&idpEvent,
+		ops...,
+	), nil
+}
+
+func reduceOktaIDPChangedColumns(idpEvent idp.OktaIDPChangedEvent) []handler.Column {
+	gitlabCols := make([]handler.Column, 0, 4)
+	if idpEvent.Issuer != nil {
+		gitlabCols = append(gitlabCols, handler.NewCol(OktaIssuerCol, *idpEvent.Issuer))
+	}
+	if idpEvent.ClientID != nil {
+		gitlabCols = append(gitlabCols, handler.NewCol(OktaClientIDCol, *idpEvent.ClientID))
+	}
+	if idpEvent.ClientSecret != nil {
+		gitlabCols = append(gitlabCols, handler.NewCol(OktaClientSecretCol, *idpEvent.ClientSecret))
+	}
+	if idpEvent.Scopes != nil {
+		gitlabCols = append(gitlabCols, handler.NewCol(OktaScopesCol, database.TextArray[string](idpEvent.Scopes)))
+	}
+	return gitlabCols
+}
+
 func reduceGoogleIDPChangedColumns(idpEvent idp.GoogleIDPChangedEvent) []handler.Column {
 	googleCols := make([]handler.Column, 0, 3)
 	if idpEvent.ClientID != nil {
@@ -192,6 +192,12 @@ func TestIDPTemplateProjection_reducesOAuth(t *testing.T) {
  	"userEndpoint": "user",
 	"scopes": ["profile"],
 	"idAttribute": "id-attribute",
+	"usePKCE": true,
+	"codeVerifierKey": {
+        "cryptoType": 0,
+        "algorithm": "RSA-265",
+        "keyId": "key-id"
+    },
 	"isCreationAllowed": true,
 	"isLinkingAllowed": true,
 	"isAutoCreation": true,
@@ -227,7 +233,7 @@ func TestIDPTemplateProjection_reducesOAuth(t *testing.T) {
 							},
 						},
 						{
-							expectedStmt: "INSERT INTO projections.idp_templates6_oauth2 (idp_id, instance_id, client_id, client_secret, authorization_endpoint, token_endpoint, user_endpoint, scopes, id_attribute) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)",
+							expectedStmt: "INSERT INTO projections.idp_templates6_oauth2 (idp_id, instance_id, client_id, client_secret, authorization_endpoint, token_endpoint, user_endpoint, scopes, id_attribute, use_pkce, code_verifier_key) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)",
 							expectedArgs: []interface{}{
 								"idp-id",
 								"instance-id",
@@ -238,6 +244,8 @@ func TestIDPTemplateProjection_reducesOAuth(t *testing.T) {
 								"user",
 								database.TextArray[string]{"profile"},
 								"id-attribute",
+								true,
+								anyArg{},
 							},
 						},
 					},
@@ -300,7 +308,7 @@ func TestIDPTemplateProjection_reducesOAuth(t *testing.T) {
 							},
 						},
 						{
-							expectedStmt: "INSERT INTO projections.idp_templates6_oauth2 (idp_id, instance_id, client_id, client_secret, authorization_endpoint, token_endpoint, user_endpoint, scopes, id_attribute) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)",
+							expectedStmt: "INSERT INTO projections.idp_templates6_oauth2 (idp_id, instance_id, client_id, client_secret, authorization_endpoint, token_endpoint, user_endpoint, scopes, id_attribute, use_pkce, code_verifier_key) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)",
 							expectedArgs: []interface{}{
 								"idp-id",
 								"instance-id",
@@ -311,6 +319,8 @@ func TestIDPTemplateProjection_reducesOAuth(t *testing.T) {
 								"user",
 								database.TextArray[string]{"profile"},
 								"id-attribute",
+								false,
+								anyArg{},
 							},
 						},
 					},
@@ -380,6 +390,12 @@ func TestIDPTemplateProjection_reducesOAuth(t *testing.T) {
  	"userEndpoint": "user",
 	"scopes": ["profile"],
 	"idAttribute": "id-attribute",
+	"usePKCE": true,
+	"codeVerifierKey": {
+        "cryptoType": 0,
+        "algorithm": "RSA-265",
+        "keyId": "key-id"
+    },
 	"isCreationAllowed": true,
 	"isLinkingAllowed": true,
 	"isAutoCreation": true,
@@ -410,7 +426,7 @@ func TestIDPTemplateProjection_reducesOAuth(t *testing.T) {
 							},
 						},
 						{
-							expectedStmt: "UPDATE projections.idp_templates6_oauth2 SET (client_id, client_secret, authorization_endpoint, token_endpoint, user_endpoint, scopes, id_attribute) = ($1, $2, $3, $4, $5, $6, $7) WHERE (idp_id = $8) AND (instance_id = $9)",
+							expectedStmt: "UPDATE projections.idp_templates6_oauth2 SET (client_id, client_secret, authorization_endpoint, token_endpoint, user_endpoint, scopes, id_attribute, use_pkce, code_verifier_key) = ($1, $2, $3, $4, $5, $6, $7, $8, $9) WHERE (idp_id = $10) AND (instance_id = $11)",
 							expectedArgs: []interface{}{
 								"client_id",
 								anyArg{},
@@ -419,6 +435,8 @@ func TestIDPTemplateProjection_reducesOAuth(t *testing.T) {
 								"user",
 								database.TextArray[string]{"profile"},
 								"id-attribute",
+								true,
+								anyArg{},
 								"idp-id",
 								"instance-id",
 							},
@@ -1032,7 +1050,7 @@ func TestIDPTemplateProjection_reducesGitHub(t *testing.T) {
 	}
 }
 
-func TestIDPTemplateProjection_reducesGitHubEnterprise(t *testing.T) {
+func TestIDPTemplateProjection_reducesBitbucket(t *testing.T) {
 	type args struct {
 		event func(t *testing.T) eventstore.Event
 	}
@@ -1043,11 +1061,11 @@ func TestIDPTemplateProjection_reducesGitHubEnterprise(t *testing.T) {
 		want   wantReduce
 	}{
 		{
-			name: "instance reduceGitHubEnterpriseIDPAdded",
+			name: "instance reduceBitbucketIDPAdded",
 			args: args{
 				event: getEvent(
 					testEvent(
-						instance.GitHubEnterpriseIDPAddedEventType,
+						instance.BitbucketIDPAddedEventType,
 						instance.AggregateType,
 						[]byte(`{
 	"id": "idp-id",
@@ -1058,9 +1076,6 @@ func TestIDPTemplateProjection_reducesGitHubEnterprise(t *testing.T) {
         "algorithm": "RSA-265",
         "keyId": "key-id"
     },
-	"authorizationEndpoint": "auth",
-	"tokenEndpoint": "token",
- 	"userEndpoint": "user",
 	"scopes": ["profile"],
 	"isCreationAllowed": true,
 	"isLinkingAllowed": true,
@@ -1068,9 +1083,9 @@ func TestIDPTemplateProjection_reducesGitHubEnterprise(t *testing.T) {
 	"isAutoUpdate": true,
 	"autoLinkingOption": 1
 }`),
-					), instance.GitHubEnterpriseIDPAddedEventMapper),
+					), instance.BitbucketIDPAddedEventMapper),
 			},
-			reduce: (&idpTemplateProjection{}).reduceGitHubEnterpriseIDPAdded,
+			reduce: (&idpTemplateProjection{}).reduceBitbucketIDPAdded,
 			want: wantReduce{
 				aggregateType: eventstore.AggregateType("instance"),
 				sequence:      15,
@@ -1088,7 +1103,7 @@ func TestIDPTemplateProjection_reducesGitHubEnterprise(t *testing.T) {
 								domain.IDPStateActive,
 								"name",
 								domain.IdentityProviderTypeSystem,
-								domain.IDPTypeGitHubEnterprise,
+								domain.IDPTypeBitbucket,
 								true,
 								true,
 								true,
@@ -1097,15 +1112,12 @@ func TestIDPTemplateProjection_reducesGitHubEnterprise(t *testing.T) {
 							},
 						},
 						{
-							expectedStmt: "INSERT INTO projections.idp_templates6_github_enterprise (idp_id, instance_id, client_id, client_secret, authorization_endpoint, token_endpoint, user_endpoint, scopes) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)",
+							expectedStmt: "INSERT INTO projections.idp_templates6_bitbucket (idp_id, instance_id, client_id, client_secret, scopes) VALUES ($1, $2, $3, $4, $5)",
 							expectedArgs: []interface{}{
 								"idp-id",
 								"instance-id",
 								"client_id",
 								anyArg{},
-								"auth",
-								"token",
-								"user",
 								database.TextArray[string]{"profile"},
 							},
 						},
@@ -1114,11 +1126,11 @@ func TestIDPTemplateProjection_reducesGitHubEnterprise(t *testing.T) {
 			},
 		},
 		{
-			name: "org reduceGitHubEnterpriseIDPAdded",
+			name: "org reduceBitbucketIDPAdded",
 			args: args{
 				event: getEvent(
 					testEvent(
-						org.GitHubEnterpriseIDPAddedEventType,
+						org.BitbucketIDPAddedEventType,
 						org.AggregateType,
 						[]byte(`{
 	"id": "idp-id",
@@ -1129,9 +1141,6 @@ func TestIDPTemplateProjection_reducesGitHubEnterprise(t *testing.T) {
         "algorithm": "RSA-265",
         "keyId": "key-id"
     },
-	"authorizationEndpoint": "auth",
-	"tokenEndpoint": "token",
- 	"userEndpoint": "user",
 	"scopes": ["profile"],
 	"isCreationAllowed": true,
 	"isLinkingAllowed": true,
@@ -1139,9 +1148,9 @@ func TestIDPTemplateProjection_reducesGitHubEnterprise(t *testing.T) {
 	"isAutoUpdate": true,
 	"autoLinkingOption": 1
 }`),
-					), org.GitHubEnterpriseIDPAddedEventMapper),
+					), org.BitbucketIDPAddedEventMapper),
 			},
-			reduce: (&idpTemplateProjection{}).reduceGitHubEnterpriseIDPAdded,
+			reduce: (&idpTemplateProjection{}).reduceBitbucketIDPAdded,
 			want: wantReduce{
 				aggregateType: eventstore.AggregateType("org"),
 				sequence:      15,
@@ -1159,7 +1168,7 @@ func TestIDPTemplateProjection_reducesGitHubEnterprise(t *testing.T) {
 								domain.IDPStateActive,
 								"name",
 								domain.IdentityProviderTypeOrg,
-								domain.IDPTypeGitHubEnterprise,
+								domain.IDPTypeBitbucket,
 								true,
 								true,
 								true,
@@ -1168,15 +1177,12 @@ func TestIDPTemplateProjection_reducesGitHubEnterprise(t *testing.T) {
 							},
 						},
 						{
-							expectedStmt: "INSERT INTO projections.idp_templates6_github_enterprise (idp_id, instance_id, client_id, client_secret, authorization_endpoint, token_endpoint, user_endpoint, scopes) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)",
+							expectedStmt: "INSERT INTO projections.idp_templates6_bitbucket (idp_id, instance_id, client_id, client_secret, scopes) VALUES ($1, $2, $3, $4, $5)",
 							expectedArgs: []interface{}{
 								"idp-id",
 								"instance-id",
 								"client_id",
 								anyArg{},
-								"auth",
-								"token",
-								"user",
 								database.TextArray[string]{"profile"},
 							},
 						},
@@ -1185,20 +1191,20 @@ func TestIDPTemplateProjection_reducesGitHubEnterprise(t *testing.T) {
 			},
 		},
 		{
-			name: "instance reduceGitHubEnterpriseIDPChanged minimal",
+			name: "instance reduceBitbucketIDPChanged minimal",
 			args: args{
 				event: getEvent(
 					testEvent(
-						instance.GitHubEnterpriseIDPChangedEventType,
+						instance.BitbucketIDPChangedEventType,
 						instance.AggregateType,
 						[]byte(`{
 	"id": "idp-id",
 	"isCreationAllowed": true,
 	"clientId": "id"
 }`),
-					), instance.GitHubEnterpriseIDPChangedEventMapper),
+					), instance.BitbucketIDPChangedEventMapper),
 			},
-			reduce: (&idpTemplateProjection{}).reduceGitHubEnterpriseIDPChanged,
+			reduce: (&idpTemplateProjection{}).reduceBitbucketIDPChanged,
 			want: wantReduce{
 				aggregateType: eventstore.AggregateType("instance"),
 				sequence:      15,
@@ -1215,7 +1221,7 @@ func TestIDPTemplateProjection_reducesGitHubEnterprise(t *testing.T) {
 							},
 						},
 						{
-							expectedStmt: "UPDATE projections.idp_templates6_github_enterprise SET client_id = $1 WHERE (idp_id = $2) AND (instance_id = $3)",
+							expectedStmt: "UPDATE projections.idp_templates6_bitbucket SET client_id = $1 WHERE (idp_id = $2) AND (instance_id = $3)",
 							expectedArgs: []interface{}{
 								"id",
 								"idp-id",
@@ -1227,11 +1233,11 @@ func TestIDPTemplateProjection_reducesGitHubEnterprise(t *testing.T) {
 			},
 		},
 		{
-			name: "instance reduceGitHubEnterpriseIDPChanged",
+			name: "instance reduceBitbucketIDPChanged",
 			args: args{
 				event: getEvent(
 					testEvent(
-						instance.GitHubEnterpriseIDPChangedEventType,
+						instance.BitbucketIDPChangedEventType,
 						instance.AggregateType,
 						[]byte(`{
 	"id": "idp-id",
@@ -1242,9 +1248,6 @@ func TestIDPTemplateProjection_reducesGitHubEnterprise(t *testing.T) {
         "algorithm": "RSA-265",
         "keyId": "key-id"
     },
-	"authorizationEndpoint": "auth",
-	"tokenEndpoint": "token",
- 	"userEndpoint": "user",
 	"scopes": ["profile"],
 	"isCreationAllowed": true,
 	"isLinkingAllowed": true,
@@ -1252,9 +1255,9 @@ func TestIDPTemplateProjection_reducesGitHubEnterprise(t *testing.T) {
 	"isAutoUpdate": true,
 	"autoLinkingOption": 1
 }`),
-					), instance.GitHubEnterpriseIDPChangedEventMapper),
+					), instance.BitbucketIDPChangedEventMapper),
 			},
-			reduce: (&idpTemplateProjection{}).reduceGitHubEnterpriseIDPChanged,
+			reduce: (&idpTemplateProjection{}).reduceBitbucketIDPChanged,
 			want: wantReduce{
 				aggregateType: eventstore.AggregateType("instance"),
 				sequence:      15,
@@ -1276,13 +1279,10 @@ func TestIDPTemplateProjection_reducesGitHubEnterprise(t *testing.T) {
 							},
 						},
 						{
-							expectedStmt: "UPDATE projections.idp_templates6_github_enterprise SET (client_id, client_secret, authorization_endpoint, token_endpoint, user_endpoint, scopes) = ($1, $2, $3, $4, $5, $6) WHERE (idp_id = $7) AND (instance_id = $8)",
+							expectedStmt: "UPDATE projections.idp_templates6_bitbucket SET (client_id, client_secret, scopes) = ($1, $2, $3) WHERE (idp_id = $4) AND (instance_id = $5)",
 							expectedArgs: []interface{}{
 								"client_id",
 								anyArg{},
-								"auth",
-								"token",
-								"user",
 								database.TextArray[string]{"profile"},
 								"idp-id",
 								"instance-id",
@@ -1308,7 +1308,7 @@ func TestIDPTemplateProjection_reducesGitHubEnterprise(t *testing.T) {
 	}
 }
 
-func TestIDPTemplateProjection_reducesGitLab(t *testing.T) {
+func TestIDPTemplateProjection_reducesDiscord(t *testing.T) {
 	type args struct {
 		event func(t *testing.T) eventstore.Event
 	}
@@ -1319,16 +1319,17 @@ func TestIDPTemplateProjection_reducesGitLab(t *testing.T) {
 		want   wantReduce
 	}{
 		{
-			name: "instance reduceGitLabIDPAdded",
+			name: "instance reduceDiscordIDPAdded",
 			args: args{
 				event: getEvent(
 					testEvent(
-						instance.GitLabIDPAddedEventType,
+						instance.DiscordIDPAddedEventType,
 						instance.AggregateType,
 						[]byte(`{
 	"id": "idp-id",
-	"client_id": "client_id",
-	"client_secret": {
+	"name": "name",
+	"clientId": "client_id",
+	"clientSecret": {
         "cryptoType": 0,
         "algorithm": "RSA-265",
         "keyId": "key-id"
@@ -1340,9 +1341,9 @@ func TestIDPTemplateProjection_reducesGitLab(t *testing.T) {
 	"isAutoUpdate": true,
 	"autoLinkingOption": 1
 }`),
-					), instance.GitLabIDPAddedEventMapper),
+					), instance.DiscordIDPAddedEventMapper),
 			},
-			reduce: (&idpTemplateProjection{}).reduceGitLabIDPAdded,
+			reduce: (&idpTemplateProjection{}).reduceDiscordIDPAdded,
 			want: wantReduce{
 				aggregateType: eventstore.AggregateType("instance"),
 				sequence:      15,
@@ -1358,9 +1359,9 @@ func TestIDPTemplateProjection_reducesGitLab(t *testing.T) {
 								"ro-id",
 								"instance-id",
 								domain.IDPStateActive,
-								"",
+								"name",
 								domain.IdentityProviderTypeSystem,
-								domain.IDPTypeGitLab,
+								domain.IDPTypeDiscord,
 								true,
 								true,
 								true,
@@ -1369,7 +1370,7 @@ func TestIDPTemplateProjection_reducesGitLab(t *testing.T) {
 							},
 						},
 						{
-							expectedStmt: "INSERT INTO projections.idp_templates6_gitlab (idp_id, instance_id, client_id, client_secret, scopes) VALUES ($1, $2, $3, $4, $5)",
+							expectedStmt: "INSERT INTO projections.idp_templates6_discord (idp_id, instance_id, client_id, client_secret, scopes) VALUES ($1, $2, $3, $4, $5)",
 							expectedArgs: []interface{}{
 								"idp-id",
 								"instance-id",
@@ -1383,16 +1384,17 @@ func TestIDPTemplateProjection_reducesGitLab(t *testing.T) {
 			},
 		},
 		{
-			name: "org reduceGitLabIDPAdded",
+			name: "org reduceDiscordIDPAdded",
 			args: args{
 				event: getEvent(
 					testEvent(
-						org.GitLabIDPAddedEventType,
+						org.DiscordIDPAddedEventType,
 						org.AggregateType,
 						[]byte(`{
 	"id": "idp-id",
-	"client_id": "client_id",
-	"client_secret": {
+	"name": "name",
+	"clientId": "client_id",
+	"clientSecret": {
         "cryptoType": 0,
         "algorithm": "RSA-265",
         "keyId": "key-id"
@@ -1404,9 +1406,9 @@ func TestIDPTemplateProjection_reducesGitLab(t *testing.T) {
 	"isAutoUpdate": true,
 	"autoLinkingOption": 1
 }`),
-					), org.GitLabIDPAddedEventMapper),
+					), org.DiscordIDPAddedEventMapper),
 			},
-			reduce: (&idpTemplateProjection{}).reduceGitLabIDPAdded,
+			reduce: (&idpTemplateProjection{}).reduceDiscordIDPAdded,
 			want: wantReduce{
 				aggregateType: eventstore.AggregateType("org"),
 				sequence:      15,
@@ -1422,9 +1424,9 @@ func TestIDPTemplateProjection_reducesGitLab(t *testing.T) {
 								"ro-id",
 								"instance-id",
 								domain.IDPStateActive,
-								"",
+								"name",
 								domain.IdentityProviderTypeOrg,
-								domain.IDPTypeGitLab,
+								domain.IDPTypeDiscord,
 								true,
 								true,
 								true,
@@ -1433,7 +1435,7 @@ func TestIDPTemplateProjection_reducesGitLab(t *testing.T) {
 							},
 						},
 						{
-							expectedStmt: "INSERT INTO projections.idp_templates6_gitlab (idp_id, instance_id, client_id, client_secret, scopes) VALUES ($1, $2, $3, $4, $5)",
+							expectedStmt: "INSERT INTO projections.idp_templates6_discord (idp_id, instance_id, client_id, client_secret, scopes) VALUES ($1, $2, $3, $4, $5)",
 							expectedArgs: []interface{}{
 								"idp-id",
 								"instance-id",
@@ -1447,20 +1449,20 @@ func TestIDPTemplateProjection_reducesGitLab(t *testing.T) {
 			},
 		},
 		{
-			name: "instance reduceGitLabIDPChanged minimal",
+			name: "instance reduceDiscordIDPChanged minimal",
 			args: args{
 				event: getEvent(
 					testEvent(
-						instance.GitLabIDPChangedEventType,
+						instance.DiscordIDPChangedEventType,
 						instance.AggregateType,
 						[]byte(`{
 	"id": "idp-id",
 	"isCreationAllowed": true,
-	"client_id": "id"
+	"clientId": "id"
 }`),
-					), instance.GitLabIDPChangedEventMapper),
+					), instance.DiscordIDPChangedEventMapper),
 			},
-			reduce: (&idpTemplateProjection{}).reduceGitLabIDPChanged,
+			reduce: (&idpTemplateProjection{}).reduceDiscordIDPChanged,
 			want: wantReduce{
 				aggregateType: eventstore.AggregateType("instance"),
 				sequence:      15,
@@ -1477,7 +1479,7 @@ func TestIDPTemplateProjection_reducesGitLab(t *testing.T) {
 							},
 						},
 						{
-							expectedStmt: "UPDATE projections.idp_templates6_gitlab SET client_id = $1 WHERE (idp_id = $2) AND (instance_id = $3)",
+							expectedStmt: "UPDATE projections.idp_templates6_discord SET client_id = $1 WHERE (idp_id = $2) AND (instance_id = $3)",
 							expectedArgs: []interface{}{
 								"id",
 								"idp-id",
@@ -1489,17 +1491,17 @@ func TestIDPTemplateProjection_reducesGitLab(t *testing.T) {
 			},
 		},
 		{
-			name: "instance reduceGitLabIDPChanged",
+			name: "instance reduceDiscordIDPChanged",
 			args: args{
 				event: getEvent(
 					testEvent(
-						instance.GitLabIDPChangedEventType,
+						instance.DiscordIDPChangedEventType,
 						instance.AggregateType,
 						[]byte(`{
 	"id": "idp-id",
 	"name": "name",
-	"client_id": "client_id",
-	"client_secret": {
+	"clientId": "client_id",
+	"clientSecret": {
         "cryptoType": 0,
         "algorithm": "RSA-265",
         "keyId": "key-id"
@@ -1511,9 +1513,9 @@ func TestIDPTemplateProjection_reducesGitLab(t *testing.T) {
 	"isAutoUpdate": true,
 	"autoLinkingOption": 1
 }`),
-					), instance.GitLabIDPChangedEventMapper),
+					), instance.DiscordIDPChangedEventMapper),
 			},
-			reduce: (&idpTemplateProjection{}).reduceGitLabIDPChanged,
+			reduce: (&idpTemplateProjection{}).reduceDiscordIDPChanged,
 			want: wantReduce{
 				aggregateType: eventstore.AggregateType("instance"),
 				sequence:      15,
@@ -1535,7 +1537,7 @@ func TestIDPTemplateProjection_reducesGitLab(t *testing.T) {
 							},
 						},
 						{
-							expectedStmt: "UPDATE projections.idp_templates6_gitlab SET (client_id, client_secret, scopes) = ($1, $2, $3) WHERE (idp_id = $4) AND (instance_id = $5)",
+							expectedStmt: "UPDATE projections.idp_templates6_discord SET (client_id, client_secret, scopes) = ($1, $2, $3) WHERE (idp_id = $4) AND (instance_id = $5)",
 							expectedArgs: []interface{}{
 								"client_id",
 								anyArg{},
@@ -1564,7 +1566,7 @@ func TestIDPTemplateProjection_reducesGitLab(t *testing.T) {
 	}
 }
 
-func TestIDPTemplateProjection_reducesGitLabSelfHosted(t *testing.T) {
+func TestIDPTemplateProjection_reducesGitHubEnterprise(t *testing.T) {
 	type args struct {
 		event func(t *testing.T) eventstore.Event
 	}
@@ -1575,22 +1577,24 @@ func TestIDPTemplateProjection_reducesGitLabSelfHosted(t *testing.T) {
 		want   wantReduce
 	}{
 		{
-			name: "instance reduceGitLabSelfHostedIDPAdded",
+			name: "instance reduceGitHubEnterpriseIDPAdded",
 			args: args{
 				event: getEvent(
 					testEvent(
-						instance.GitLabSelfHostedIDPAddedEventType,
+						instance.GitHubEnterpriseIDPAddedEventType,
 						instance.AggregateType,
 						[]byte(`{
 	"id": "idp-id",
 	"name": "name",
-	"issuer": "issuer",
-	"client_id": "client_id",
-	"client_secret": {
+	"clientId": "client_id",
+	"clientSecret": {
         "cryptoType": 0,
         "algorithm": "RSA-265",
         "keyId": "key-id"
     },
+	"authorizationEndpoint": "auth",
+	"tokenEndpoint": "token",
+ 	"userEndpoint": "user",
 	"scopes": ["profile"],
 	"isCreationAllowed": true,
 	"isLinkingAllowed": true,
@@ -1598,9 +1602,9 @@ func TestIDPTemplateProjection_reducesGitLabSelfHosted(t *testing.T) {
 	"isAutoUpdate": true,
 	"autoLinkingOption": 1
 }`),
-					), instance.GitLabSelfHostedIDPAddedEventMapper),
+					), instance.GitHubEnterpriseIDPAddedEventMapper),
 			},
-			reduce: (&idpTemplateProjection{}).reduceGitLabSelfHostedIDPAdded,
+			reduce: (&idpTemplateProjection{}).reduceGitHubEnterpriseIDPAdded,
 			want: wantReduce{
 				aggregateType: eventstore.AggregateType("instance"),
 				sequence:      15,
@@ -1618,7 +1622,7 @@ func TestIDPTemplateProjection_reducesGitLabSelfHosted(t *testing.T) {
 								domain.IDPStateActive,
 								"name",
 								domain.IdentityProviderTypeSystem,
-								domain.IDPTypeGitLabSelfHosted,
+								domain.IDPTypeGitHubEnterprise,
 								true,
 								true,
 								true,
@@ -1627,13 +1631,15 @@ func TestIDPTemplateProjection_reducesGitLabSelfHosted(t *testing.T) {
 							},
 						},
 						{
-							expectedStmt: "INSERT INTO projections.idp_templates6_gitlab_self_hosted (idp_id, instance_id, issuer, client_id, client_secret, scopes) VALUES ($1, $2, $3, $4, $5, $6)",
+							expectedStmt: "INSERT INTO projections.idp_templates6_github_enterprise (idp_id, instance_id, client_id, client_secret, authorization_endpoint, token_endpoint, user_endpoint, scopes) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)",
 							expectedArgs: []interface{}{
 								"idp-id",
 								"instance-id",
-								"issuer",
 								"client_id",
 								anyArg{},
+								"auth",
+								"token",
+								"user",
 								database.TextArray[string]{"profile"},
 							},
 						},
@@ -1642,22 +1648,24 @@ func TestIDPTemplateProjection_reducesGitLabSelfHosted(t *testing.T) {
 			},
 		},
 		{
-			name: "org reduceGitLabSelfHostedIDPAdded",
+			name: "org reduceGitHubEnterpriseIDPAdded",
 			args: args{
 				event: getEvent(
 					testEvent(
-						org.GitLabSelfHostedIDPAddedEventType,
+						org.GitHubEnterpriseIDPAddedEventType,
 						org.AggregateType,
 						[]byte(`{
 	"id": "idp-id",
 	"name": "name",
-	"issuer": "issuer",
-	"client_id": "client_id",
-	"client_secret": {
+	"clientId": "client_id",
+	"clientSecret": {
         "cryptoType": 0,
         "algorithm": "RSA-265",
         "keyId": "key-id"
     },
+	"authorizationEndpoint": "auth",
+	"tokenEndpoint": "token",
+ 	"userEndpoint": "user",
 	"scopes": ["profile"],
 	"isCreationAllowed": true,
 	"isLinkingAllowed": true,
@@ -1665,9 +1673,9 @@ func TestIDPTemplateProjection_reducesGitLabSelfHosted(t *testing.T) {
 	"isAutoUpdate": true,
 	"autoLinkingOption": 1
 }`),
-					), org.GitLabSelfHostedIDPAddedEventMapper),
+					), org.GitHubEnterpriseIDPAddedEventMapper),
 			},
-			reduce: (&idpTemplateProjection{}).reduceGitLabSelfHostedIDPAdded,
+			reduce: (&idpTemplateProjection{}).reduceGitHubEnterpriseIDPAdded,
 			want: wantReduce{
 				aggregateType: eventstore.AggregateType("org"),
 				sequence:      15,
@@ -1685,7 +1693,7 @@ func TestIDPTemplateProjection_reducesGitLabSelfHosted(t *testing.T) {
 								domain.IDPStateActive,
 								"name",
 								domain.IdentityProviderTypeOrg,
-								domain.IDPTypeGitLabSelfHosted,
+								domain.IDPTypeGitHubEnterprise,
 								true,
 								true,
 								true,
@@ -1694,13 +1702,15 @@ func TestIDPTemplateProjection_reducesGitLabSelfHosted(t *testing.T) {
 							},
 						},
 						{
-							expectedStmt: "INSERT INTO projections.idp_templates6_gitlab_self_hosted (idp_id, instance_id, issuer, client_id, client_secret, scopes) VALUES ($1, $2, $3, $4, $5, $6)",
+							expectedStmt: "INSERT INTO projections.idp_templates6_github_enterprise (idp_id, instance_id, client_id, client_secret, authorization_endpoint, token_endpoint, user_endpoint, scopes) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)",
 							expectedArgs: []interface{}{
 								"idp-id",
 								"instance-id",
-								"issuer",
 								"client_id",
 								anyArg{},
+								"auth",
+								"token",
+								"user",
 								database.TextArray[string]{"profile"},
 							},
 						},
@@ -1709,20 +1719,20 @@ func TestIDPTemplateProjection_reducesGitLabSelfHosted(t *testing.T) {
 			},
 		},
 		{
-			name: "instance reduceGitLabSelfHostedIDPChanged minimal",
+			name: "instance reduceGitHubEnterpriseIDPChanged minimal",
 			args: args{
 				event: getEvent(
 					testEvent(
-						instance.GitLabSelfHostedIDPChangedEventType,
+						instance.GitHubEnterpriseIDPChangedEventType,
 						instance.AggregateType,
 						[]byte(`{
 	"id": "idp-id",
 	"isCreationAllowed": true,
-	"issuer": "issuer"
+	"clientId": "id"
 }`),
-					), instance.GitLabSelfHostedIDPChangedEventMapper),
+					), instance.GitHubEnterpriseIDPChangedEventMapper),
 			},
-			reduce: (&idpTemplateProjection{}).reduceGitLabSelfHostedIDPChanged,
+			reduce: (&idpTemplateProjection{}).reduceGitHubEnterpriseIDPChanged,
 			want: wantReduce{
 				aggregateType: eventstore.AggregateType("instance"),
 				sequence:      15,
@@ -1739,9 +1749,9 @@ func TestIDPTemplateProjection_reducesGitLabSelfHosted(t *testing.T) {
 							},
 						},
 						{
-							expectedStmt: "UPDATE projections.idp_templates6_gitlab_self_hosted SET issuer = $1 WHERE (idp_id = $2) AND (instance_id = $3)",
+							expectedStmt: "UPDATE projections.idp_templates6_github_enterprise SET client_id = $1 WHERE (idp_id = $2) AND (instance_id = $3)",
 							expectedArgs: []interface{}{
-								"issuer",
+								"id",
 								"idp-id",
 								"instance-id",
 							},
@@ -1751,22 +1761,24 @@ func TestIDPTemplateProjection_reducesGitLabSelfHosted(t *testing.T) {
 			},
 		},
 		{
-			name: "instance reduceGitLabSelfHostedIDPChanged",
+			name: "instance reduceGitHubEnterpriseIDPChanged",
 			args: args{
 				event: getEvent(
 					testEvent(
-						instance.GitLabSelfHostedIDPChangedEventType,
+						instance.GitHubEnterpriseIDPChangedEventType,
 						instance.AggregateType,
 						[]byte(`{
 	"id": "idp-id",
 	"name": "name",
-	"issuer": "issuer",
-	"client_id": "client_id",
-	"client_secret": {
+	"clientId": "client_id",
+	"clientSecret": {
         "cryptoType": 0,
         "algorithm": "RSA-265",
         "keyId": "key-id"
     },
+	"authorizationEndpoint": "auth",
+	"tokenEndpoint": "token",
+ 	"userEndpoint": "user",
 	"scopes": ["profile"],
 	"isCreationAllowed": true,
 	"isLinkingAllowed": true,
@@ -1774,9 +1786,9 @@ func TestIDPTemplateProjection_reducesGitLabSelfHosted(t *testing.T) {
 	"isAutoUpdate": true,
 	"autoLinkingOption": 1
 }`),
-					), instance.GitLabSelfHostedIDPChangedEventMapper),
+					), instance.GitHubEnterpriseIDPChangedEventMapper),
 			},
-			reduce: (&idpTemplateProjection{}).reduceGitLabSelfHostedIDPChanged,
+			reduce: (&idpTemplateProjection{}).reduceGitHubEnterpriseIDPChanged,
 			want: wantReduce{
 				aggregateType: eventstore.AggregateType("instance"),
 				sequence:      15,
@@ -1798,7 +1810,1321 @@ func TestIDPTemplateProjection_reducesGitLabSelfHosted(t *testing.T) {
 							},
 						},
 						{
-							expectedStmt: "UPDATE projections.idp_templates6_gitlab_self_hosted SET (issuer, client_id, client_secret, scopes) = ($1, $2, $3, $4) WHERE (idp_id = $5) AND (instance_id = $6)",
+							expectedStmt: "UPDATE projections.idp_templates6_github_enterprise SET (client_id, client_secret, authorization_endpoint, token_endpoint, user_endpoint, scopes) = ($1, $2, $3, $4, $5, $6) WHERE (idp_id = $7) AND (instance_id = $8)",
+							expectedArgs: []interface{}{
+								"client_id",
+								anyArg{},
+								"auth",
+								"token",
+								"user",
+								database.TextArray[string]{"profile"},
+								"idp-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if !zerrors.IsErrorInvalidArgument(err) {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, IDPTemplateTable, tt.want)
+		})
+	}
+}
+
+func TestIDPTemplateProjection_reducesGitLab(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "instance reduceGitLabIDPAdded",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.GitLabIDPAddedEventType,
+						instance.AggregateType,
+						[]byte(`{
+	"id": "idp-id",
+	"client_id": "client_id",
+	"client_secret": {
+        "cryptoType": 0,
+        "algorithm": "RSA-265",
+        "keyId": "key-id"
+    },
+	"scopes": ["profile"],
+	"isCreationAllowed": true,
+	"isLinkingAllowed": true,
+	"isAutoCreation": true,
+	"isAutoUpdate": true,
+	"autoLinkingOption": 1
+}`),
+					), instance.GitLabIDPAddedEventMapper),
+			},
+			reduce: (&idpTemplateProjection{}).reduceGitLabIDPAdded,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: idpTemplateInsertStmt,
+							expectedArgs: []interface{}{
+								"idp-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"ro-id",
+								"instance-id",
+								domain.IDPStateActive,
+								"",
+								domain.IdentityProviderTypeSystem,
+								domain.IDPTypeGitLab,
+								true,
+								true,
+								true,
+								true,
+								domain.AutoLinkingOptionUsername,
+							},
+						},
+						{
+							expectedStmt: "INSERT INTO projections.idp_templates6_gitlab (idp_id, instance_id, client_id, client_secret, scopes) VALUES ($1, $2, $3, $4, $5)",
+							expectedArgs: []interface{}{
+								"idp-id",
+								"instance-id",
+								"client_id",
+								anyArg{},
+								database.TextArray[string]{"profile"},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "org reduceGitLabIDPAdded",
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.GitLabIDPAddedEventType,
+						org.AggregateType,
+						[]byte(`{
+	"id": "idp-id",
+	"client_id": "client_id",
+	"client_secret": {
+        "cryptoType": 0,
+        "algorithm": "RSA-265",
+        "keyId": "key-id"
+    },
+	"scopes": ["profile"],
+	"isCreationAllowed": true,
+	"isLinkingAllowed": true,
+	"isAutoCreation": true,
+	"isAutoUpdate": true,
+	"autoLinkingOption": 1
+}`),
+					), org.GitLabIDPAddedEventMapper),
+			},
+			reduce: (&idpTemplateProjection{}).reduceGitLabIDPAdded,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: idpTemplateInsertStmt,
+							expectedArgs: []interface{}{
+								"idp-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"ro-id",
+								"instance-id",
+								domain.IDPStateActive,
+								"",
+								domain.IdentityProviderTypeOrg,
+								domain.IDPTypeGitLab,
+								true,
+								true,
+								true,
+								true,
+								domain.AutoLinkingOptionUsername,
+							},
+						},
+						{
+							expectedStmt: "INSERT INTO projections.idp_templates6_gitlab (idp_id, instance_id, client_id, client_secret, scopes) VALUES ($1, $2, $3, $4, $5)",
+							expectedArgs: []interface{}{
+								"idp-id",
+								"instance-id",
+								"client_id",
+								anyArg{},
+								database.TextArray[string]{"profile"},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance reduceGitLabIDPChanged minimal",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.GitLabIDPChangedEventType,
+						instance.AggregateType,
+						[]byte(`{
+	"id": "idp-id",
+	"isCreationAllowed": true,
+	"client_id": "id"
+}`),
+					), instance.GitLabIDPChangedEventMapper),
+			},
+			reduce: (&idpTemplateProjection{}).reduceGitLabIDPChanged,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: idpTemplateUpdateMinimalStmt,
+							expectedArgs: []interface{}{
+								true,
+								anyArg{},
+								uint64(15),
+								"idp-id",
+								"instance-id",
+							},
+						},
+						{
+							expectedStmt: "UPDATE projections.idp_templates6_gitlab SET client_id = $1 WHERE (idp_id = $2) AND (instance_id = $3)",
+							expectedArgs: []interface{}{
+								"id",
+								"idp-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance reduceGitLabIDPChanged",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.GitLabIDPChangedEventType,
+						instance.AggregateType,
+						[]byte(`{
+	"id": "idp-id",
+	"name": "name",
+	"client_id": "client_id",
+	"client_secret": {
+        "cryptoType": 0,
+        "algorithm": "RSA-265",
+        "keyId": "key-id"
+    },
+	"scopes": ["profile"],
+	"isCreationAllowed": true,
+	"isLinkingAllowed": true,
+	"isAutoCreation": true,
+	"isAutoUpdate": true,
+	"autoLinkingOption": 1
+}`),
+					), instance.GitLabIDPChangedEventMapper),
+			},
+			reduce: (&idpTemplateProjection{}).reduceGitLabIDPChanged,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: idpTemplateUpdateStmt,
+							expectedArgs: []interface{}{
+								"name",
+								true,
+								true,
+								true,
+								true,
+								domain.AutoLinkingOptionUsername,
+								anyArg{},
+								uint64(15),
+								"idp-id",
+								"instance-id",
+							},
+						},
+						{
+							expectedStmt: "UPDATE projections.idp_templates6_gitlab SET (client_id, client_secret, scopes) = ($1, $2, $3) WHERE (idp_id = $4) AND (instance_id = $5)",
+							expectedArgs: []interface{}{
+								"client_id",
+								anyArg{},
+								database.TextArray[string]{"profile"},
+								"idp-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if !zerrors.IsErrorInvalidArgument(err) {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, IDPTemplateTable, tt.want)
+		})
+	}
+}
+
+func TestIDPTemplateProjection_reducesGitLabSelfHosted(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "instance reduceGitLabSelfHostedIDPAdded",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.GitLabSelfHostedIDPAddedEventType,
+						instance.AggregateType,
+						[]byte(`{
+	"id": "idp-id",
+	"name": "name",
+	"issuer": "issuer",
+	"client_id": "client_id",
+	"client_secret": {
+        "cryptoType": 0,
+        "algorithm": "RSA-265",
+        "keyId": "key-id"
+    },
+	"scopes": ["profile"],
+	"isCreationAllowed": true,
+	"isLinkingAllowed": true,
+	"isAutoCreation": true,
+	"isAutoUpdate": true,
+	"autoLinkingOption": 1
+}`),
+					), instance.GitLabSelfHostedIDPAddedEventMapper),
+			},
+			reduce: (&idpTemplateProjection{}).reduceGitLabSelfHostedIDPAdded,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: idpTemplateInsertStmt,
+							expectedArgs: []interface{}{
+								"idp-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"ro-id",
+								"instance-id",
+								domain.IDPStateActive,
+								"name",
+								domain.IdentityProviderTypeSystem,
+								domain.IDPTypeGitLabSelfHosted,
+								true,
+								true,
+								true,
+								true,
+								domain.AutoLinkingOptionUsername,
+							},
+						},
+						{
+							expectedStmt: "INSERT INTO projections.idp_templates6_gitlab_self_hosted (idp_id, instance_id, issuer, client_id, client_secret, scopes) VALUES ($1, $2, $3, $4, $5, $6)",
+							expectedArgs: []interface{}{
+								"idp-id",
+								"instance-id",
+								"issuer",
+								"client_id",
+								anyArg{},
+								database.TextArray[string]{"profile"},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "org reduceGitLabSelfHostedIDPAdded",
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.GitLabSelfHostedIDPAddedEventType,
+						org.AggregateType,
+						[]byte(`{
+	"id": "idp-id",
+	"name": "name",
+	"issuer": "issuer",
+	"client_id": "client_id",
+	"client_secret": {
+        "cryptoType": 0,
+        "algorithm": "RSA-265",
+        "keyId": "key-id"
+    },
+	"scopes": ["profile"],
+	"isCreationAllowed": true,
+	"isLinkingAllowed": true,
+	"isAutoCreation": true,
+	"isAutoUpdate": true,
+	"autoLinkingOption": 1
+}`),
+					), org.GitLabSelfHostedIDPAddedEventMapper),
+			},
+			reduce: (&idpTemplateProjection{}).reduceGitLabSelfHostedIDPAdded,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: idpTemplateInsertStmt,
+							expectedArgs: []interface{}{
+								"idp-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"ro-id",
+								"instance-id",
+								domain.IDPStateActive,
+								"name",
+								domain.IdentityProviderTypeOrg,
+								domain.IDPTypeGitLabSelfHosted,
+								true,
+								true,
+								true,
+								true,
+								domain.AutoLinkingOptionUsername,
+							},
+						},
+						{
+							expectedStmt: "INSERT INTO projections.idp_templates6_gitlab_self_hosted (idp_id, instance_id, issuer, client_id, client_secret, scopes) VALUES ($1, $2, $3, $4, $5, $6)",
+							expectedArgs: []interface{}{
+								"idp-id",
+								"instance-id",
+								"issuer",
+								"client_id",
+								anyArg{},
+								database.TextArray[string]{"profile"},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance reduceGitLabSelfHostedIDPChanged minimal",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.GitLabSelfHostedIDPChangedEventType,
+						instance.AggregateType,
+						[]byte(`{
+	"id": "idp-id",
+	"isCreationAllowed": true,
+	"issuer": "issuer"
+}`),
+					), instance.GitLabSelfHostedIDPChangedEventMapper),
+			},
+			reduce: (&idpTemplateProjection{}).reduceGitLabSelfHostedIDPChanged,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: idpTemplateUpdateMinimalStmt,
+							expectedArgs: []interface{}{
+								true,
+								anyArg{},
+								uint64(15),
+								"idp-id",
+								"instance-id",
+							},
+						},
+						{
+							expectedStmt: "UPDATE projections.idp_templates6_gitlab_self_hosted SET issuer = $1 WHERE (idp_id = $2) AND (instance_id = $3)",
+							expectedArgs: []interface{}{
+								"issuer",
+								"idp-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance reduceGitLabSelfHostedIDPChanged",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.GitLabSelfHostedIDPChangedEventType,
+						instance.AggregateType,
+						[]byte(`{
+	"id": "idp-id",
+	"name": "name",
+	"issuer": "issuer",
+	"client_id": "client_id",
+	"client_secret": {
+        "cryptoType": 0,
+        "algorithm": "RSA-265",
+        "keyId": "key-id"
+    },
+	"scopes": ["profile"],
+	"isCreationAllowed": true,
+	"isLinkingAllowed": true,
+	"isAutoCreation": true,
+	"isAutoUpdate": true,
+	"autoLinkingOption": 1
+}`),
+					), instance.GitLabSelfHostedIDPChangedEventMapper),
+			},
+			reduce: (&idpTemplateProjection{}).reduceGitLabSelfHostedIDPChanged,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: idpTemplateUpdateStmt,
+							expectedArgs: []interface{}{
+								"name",
+								true,
+								true,
+								true,
+								true,
+								domain.AutoLinkingOptionUsername,
+								anyArg{},
+								uint64(15),
+								"idp-id",
+								"instance-id",
+							},
+						},
+						{
+							expectedStmt: "UPDATE projections.idp_templates6_gitlab_self_hosted SET (issuer, client_id, client_secret, scopes) = ($1, $2, $3, $4) WHERE (idp_id = $5) AND (instance_id = $6)",
+							expectedArgs: []interface{}{
+								"issuer",
+								"client_id",
+								anyArg{},
+								database.TextArray[string]{"profile"},
+								"idp-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if !zerrors.IsErrorInvalidArgument(err) {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, IDPTemplateTable, tt.want)
+		})
+	}
+}
+
+func TestIDPTemplateProjection_reducesADFS(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "instance reduceADFSIDPAdded",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.ADFSIDPAddedEventType,
+						instance.AggregateType,
+						[]byte(`{
+	"id": "idp-id",
+	"name": "name",
+	"issuer": "issuer",
+	"client_id": "client_id",
+	"client_secret": {
+        "cryptoType": 0,
+        "algorithm": "RSA-265",
+        "keyId": "key-id"
+    },
+	"scopes": ["profile"],
+	"isCreationAllowed": true,
+	"isLinkingAllowed": true,
+	"isAutoCreation": true,
+	"isAutoUpdate": true,
+	"autoLinkingOption": 1
+}`),
+					), instance.ADFSIDPAddedEventMapper),
+			},
+			reduce: (&idpTemplateProjection{}).reduceADFSIDPAdded,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: idpTemplateInsertStmt,
+							expectedArgs: []interface{}{
+								"idp-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"ro-id",
+								"instance-id",
+								domain.IDPStateActive,
+								"name",
+								domain.IdentityProviderTypeSystem,
+								domain.IDPTypeADFS,
+								true,
+								true,
+								true,
+								true,
+								domain.AutoLinkingOptionUsername,
+							},
+						},
+						{
+							expectedStmt: "INSERT INTO projections.idp_templates6_adfs (idp_id, instance_id, issuer, client_id, client_secret, scopes) VALUES ($1, $2, $3, $4, $5, $6)",
+							expectedArgs: []interface{}{
+								"idp-id",
+								"instance-id",
+								"issuer",
+								"client_id",
+								anyArg{},
+								database.TextArray[string]{"profile"},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "org reduceADFSIDPAdded",
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.ADFSIDPAddedEventType,
+						org.AggregateType,
+						[]byte(`{
+	"id": "idp-id",
+	"name": "name",
+	"issuer": "issuer",
+	"client_id": "client_id",
+	"client_secret": {
+        "cryptoType": 0,
+        "algorithm": "RSA-265",
+        "keyId": "key-id"
+    },
+	"scopes": ["profile"],
+	"isCreationAllowed": true,
+	"isLinkingAllowed": true,
+	"isAutoCreation": true,
+	"isAutoUpdate": true,
+	"autoLinkingOption": 1
+}`),
+					), org.ADFSIDPAddedEventMapper),
+			},
+			reduce: (&idpTemplateProjection{}).reduceADFSIDPAdded,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: idpTemplateInsertStmt,
+							expectedArgs: []interface{}{
+								"idp-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"ro-id",
+								"instance-id",
+								domain.IDPStateActive,
+								"name",
+								domain.IdentityProviderTypeOrg,
+								domain.IDPTypeADFS,
+								true,
+								true,
+								true,
+								true,
+								domain.AutoLinkingOptionUsername,
+							},
+						},
+						{
+							expectedStmt: "INSERT INTO projections.idp_templates6_adfs (idp_id, instance_id, issuer, client_id, client_secret, scopes) VALUES ($1, $2, $3, $4, $5, $6)",
+							expectedArgs: []interface{}{
+								"idp-id",
+								"instance-id",
+								"issuer",
+								"client_id",
+								anyArg{},
+								database.TextArray[string]{"profile"},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance reduceADFSIDPChanged minimal",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.ADFSIDPChangedEventType,
+						instance.AggregateType,
+						[]byte(`{
+	"id": "idp-id",
+	"isCreationAllowed": true,
+	"issuer": "issuer"
+}`),
+					), instance.ADFSIDPChangedEventMapper),
+			},
+			reduce: (&idpTemplateProjection{}).reduceADFSIDPChanged,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: idpTemplateUpdateMinimalStmt,
+							expectedArgs: []interface{}{
+								true,
+								anyArg{},
+								uint64(15),
+								"idp-id",
+								"instance-id",
+							},
+						},
+						{
+							expectedStmt: "UPDATE projections.idp_templates6_adfs SET issuer = $1 WHERE (idp_id = $2) AND (instance_id = $3)",
+							expectedArgs: []interface{}{
+								"issuer",
+								"idp-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance reduceADFSIDPChanged",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.ADFSIDPChangedEventType,
+						instance.AggregateType,
+						[]byte(`{
+	"id": "idp-id",
+	"name": "name",
+	"issuer": "issuer",
+	"client_id": "client_id",
+	"client_secret": {
+        "cryptoType": 0,
+        "algorithm": "RSA-265",
+        "keyId": "key-id"
+    },
+	"scopes": ["profile"],
+	"isCreationAllowed": true,
+	"isLinkingAllowed": true,
+	"isAutoCreation": true,
+	"isAutoUpdate": true,
+	"autoLinkingOption": 1
+}`),
+					), instance.ADFSIDPChangedEventMapper),
+			},
+			reduce: (&idpTemplateProjection{}).reduceADFSIDPChanged,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: idpTemplateUpdateStmt,
+							expectedArgs: []interface{}{
+								"name",
+								true,
+								true,
+								true,
+								true,
+								domain.AutoLinkingOptionUsername,
+								anyArg{},
+								uint64(15),
+								"idp-id",
+								"instance-id",
+							},
+						},
+						{
+							expectedStmt: "UPDATE projections.idp_templates6_adfs SET (issuer, client_id, client_secret, scopes) = ($1, $2, $3, $4) WHERE (idp_id = $5) AND (instance_id = $6)",
+							expectedArgs: []interface{}{
+								"issuer",
+								"client_id",
+								anyArg{},
+								database.TextArray[string]{"profile"},
+								"idp-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if !zerrors.IsErrorInvalidArgument(err) {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, IDPTemplateTable, tt.want)
+		})
+	}
+}
+
+func TestIDPTemplateProjection_reducesKeycloak(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "instance reduceKeycloakIDPAdded",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.KeycloakIDPAddedEventType,
+						instance.AggregateType,
+						[]byte(`{
+	"id": "idp-id",
+	"name": "name",
+	"issuer": "issuer",
+	"client_id": "client_id",
+	"client_secret": {
+        "cryptoType": 0,
+        "algorithm": "RSA-265",
+        "keyId": "key-id"
+    },
+	"scopes": ["profile"],
+	"isCreationAllowed": true,
+	"isLinkingAllowed": true,
+	"isAutoCreation": true,
+	"isAutoUpdate": true,
+	"autoLinkingOption": 1
+}`),
+					), instance.KeycloakIDPAddedEventMapper),
+			},
+			reduce: (&idpTemplateProjection{}).reduceKeycloakIDPAdded,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: idpTemplateInsertStmt,
+							expectedArgs: []interface{}{
+								"idp-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"ro-id",
+								"instance-id",
+								domain.IDPStateActive,
+								"name",
+								domain.IdentityProviderTypeSystem,
+								domain.IDPTypeKeycloak,
+								true,
+								true,
+								true,
+								true,
+								domain.AutoLinkingOptionUsername,
+							},
+						},
+						{
+							expectedStmt: "INSERT INTO projections.idp_templates6_keycloak (idp_id, instance_id, issuer, client_id, client_secret, scopes) VALUES ($1, $2, $3, $4, $5, $6)",
+							expectedArgs: []interface{}{
+								"idp-id",
+								"instance-id",
+								"issuer",
+								"client_id",
+								anyArg{},
+								database.TextArray[string]{"profile"},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "org reduceKeycloakIDPAdded",
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.KeycloakIDPAddedEventType,
+						org.AggregateType,
+						[]byte(`{
+	"id": "idp-id",
+	"name": "name",
+	"issuer": "issuer",
+	"client_id": "client_id",
+	"client_secret": {
+        "cryptoType": 0,
+        "algorithm": "RSA-265",
+        "keyId": "key-id"
+    },
+	"scopes": ["profile"],
+	"isCreationAllowed": true,
+	"isLinkingAllowed": true,
+	"isAutoCreation": true,
+	"isAutoUpdate": true,
+	"autoLinkingOption": 1
+}`),
+					), org.KeycloakIDPAddedEventMapper),
+			},
+			reduce: (&idpTemplateProjection{}).reduceKeycloakIDPAdded,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: idpTemplateInsertStmt,
+							expectedArgs: []interface{}{
+								"idp-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"ro-id",
+								"instance-id",
+								domain.IDPStateActive,
+								"name",
+								domain.IdentityProviderTypeOrg,
+								domain.IDPTypeKeycloak,
+								true,
+								true,
+								true,
+								true,
+								domain.AutoLinkingOptionUsername,
+							},
+						},
+						{
+							expectedStmt: "INSERT INTO projections.idp_templates6_keycloak (idp_id, instance_id, issuer, client_id, client_secret, scopes) VALUES ($1, $2, $3, $4, $5, $6)",
+							expectedArgs: []interface{}{
+								"idp-id",
+								"instance-id",
+								"issuer",
+								"client_id",
+								anyArg{},
+								database.TextArray[string]{"profile"},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance reduceKeycloakIDPChanged minimal",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.KeycloakIDPChangedEventType,
+						instance.AggregateType,
+						[]byte(`{
+	"id": "idp-id",
+	"isCreationAllowed": true,
+	"issuer": "issuer"
+}`),
+					), instance.KeycloakIDPChangedEventMapper),
+			},
+			reduce: (&idpTemplateProjection{}).reduceKeycloakIDPChanged,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: idpTemplateUpdateMinimalStmt,
+							expectedArgs: []interface{}{
+								true,
+								anyArg{},
+								uint64(15),
+								"idp-id",
+								"instance-id",
+							},
+						},
+						{
+							expectedStmt: "UPDATE projections.idp_templates6_keycloak SET issuer = $1 WHERE (idp_id = $2) AND (instance_id = $3)",
+							expectedArgs: []interface{}{
+								"issuer",
+								"idp-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance reduceKeycloakIDPChanged",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.KeycloakIDPChangedEventType,
+						instance.AggregateType,
+						[]byte(`{
+	"id": "idp-id",
+	"name": "name",
+	"issuer": "issuer",
+	"client_id": "client_id",
+	"client_secret": {
+        "cryptoType": 0,
+        "algorithm": "RSA-265",
+        "keyId": "key-id"
+    },
+	"scopes": ["profile"],
+	"isCreationAllowed": true,
+	"isLinkingAllowed": true,
+	"isAutoCreation": true,
+	"isAutoUpdate": true,
+	"autoLinkingOption": 1
+}`),
+					), instance.KeycloakIDPChangedEventMapper),
+			},
+			reduce: (&idpTemplateProjection{}).reduceKeycloakIDPChanged,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: idpTemplateUpdateStmt,
+							expectedArgs: []interface{}{
+								"name",
+								true,
+								true,
+								true,
+								true,
+								domain.AutoLinkingOptionUsername,
+								anyArg{},
+								uint64(15),
+								"idp-id",
+								"instance-id",
+							},
+						},
+						{
+							expectedStmt: "UPDATE projections.idp_templates6_keycloak SET (issuer, client_id, client_secret, scopes) = ($1, $2, $3, $4) WHERE (idp_id = $5) AND (instance_id = $6)",
+							expectedArgs: []interface{}{
+								"issuer",
+								"client_id",
+								anyArg{},
+								database.TextArray[string]{"profile"},
+								"idp-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if !zerrors.IsErrorInvalidArgument(err) {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, IDPTemplateTable, tt.want)
+		})
+	}
+}
+
+func TestIDPTemplateProjection_reducesOkta(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "instance reduceOktaIDPAdded",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.OktaIDPAddedEventType,
+						instance.AggregateType,
+						[]byte(`{
+	"id": "idp-id",
+	"name": "name",
+	"issuer": "issuer",
+	"client_id": "client_id",
+	"client_secret": {
+        "cryptoType": 0,
+        "algorithm": "RSA-265",
+        "keyId": "key-id"
+    },
+	"scopes": ["profile"],
+	"isCreationAllowed": true,
+	"isLinkingAllowed": true,
+	"isAutoCreation": true,
+	"isAutoUpdate": true,
+	"autoLinkingOption": 1
+}`),
+					), instance.OktaIDPAddedEventMapper),
+			},
+			reduce: (&idpTemplateProjection{}).reduceOktaIDPAdded,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: idpTemplateInsertStmt,
+							expectedArgs: []interface{}{
+								"idp-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"ro-id",
+								"instance-id",
+								domain.IDPStateActive,
+								"name",
+								domain.IdentityProviderTypeSystem,
+								domain.IDPTypeOkta,
+								true,
+								true,
+								true,
+								true,
+								domain.AutoLinkingOptionUsername,
+							},
+						},
+						{
+							expectedStmt: "INSERT INTO projections.idp_templates6_okta (idp_id, instance_id, issuer, client_id, client_secret, scopes) VALUES ($1, $2, $3, $4, $5, $6)",
+							expectedArgs: []interface{}{
+								"idp-id",
+								"instance-id",
+								"issuer",
+								"client_id",
+								anyArg{},
+								database.TextArray[string]{"profile"},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "org reduceOktaIDPAdded",
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.OktaIDPAddedEventType,
+						org.AggregateType,
+						[]byte(`{
+	"id": "idp-id",
+	"name": "name",
+	"issuer": "issuer",
+	"client_id": "client_id",
+	"client_secret": {
+        "cryptoType": 0,
+        "algorithm": "RSA-265",
+        "keyId": "key-id"
+    },
+	"scopes": ["profile"],
+	"isCreationAllowed": true,
+	"isLinkingAllowed": true,
+	"isAutoCreation": true,
+	"isAutoUpdate": true,
+	"autoLinkingOption": 1
+}`),
+					), org.OktaIDPAddedEventMapper),
+			},
+			reduce: (&idpTemplateProjection{}).reduceOktaIDPAdded,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: idpTemplateInsertStmt,
+							expectedArgs: []interface{}{
+								"idp-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"ro-id",
+								"instance-id",
+								domain.IDPStateActive,
+								"name",
+								domain.IdentityProviderTypeOrg,
+								domain.IDPTypeOkta,
+								true,
+								true,
+								true,
+								true,
+								domain.AutoLinkingOptionUsername,
+							},
+						},
+						{
+							expectedStmt: "INSERT INTO projections.idp_templates6_okta (idp_id, instance_id, issuer, client_id, client_secret, scopes) VALUES ($1, $2, $3, $4, $5, $6)",
+							expectedArgs: []interface{}{
+								"idp-id",
+								"instance-id",
+								"issuer",
+								"client_id",
+								anyArg{},
+								database.TextArray[string]{"profile"},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance reduceOktaIDPChanged minimal",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.OktaIDPChangedEventType,
+						instance.AggregateType,
+						[]byte(`{
+	"id": "idp-id",
+	"isCreationAllowed": true,
+	"issuer": "issuer"
+}`),
+					), instance.OktaIDPChangedEventMapper),
+			},
+			reduce: (&idpTemplateProjection{}).reduceOktaIDPChanged,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: idpTemplateUpdateMinimalStmt,
+							expectedArgs: []interface{}{
+								true,
+								anyArg{},
+								uint64(15),
+								"idp-id",
+								"instance-id",
+							},
+						},
+						{
+							expectedStmt: "UPDATE projections.idp_templates6_okta SET issuer = $1 WHERE (idp_id = $2) AND (instance_id = $3)",
+							expectedArgs: []interface{}{
+								"issuer",
+								"idp-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance reduceOktaIDPChanged",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.OktaIDPChangedEventType,
+						instance.AggregateType,
+						[]byte(`{
+	"id": "idp-id",
+	"name": "name",
+	"issuer": "issuer",
+	"client_id": "client_id",
+	"client_secret": {
+        "cryptoType": 0,
+        "algorithm": "RSA-265",
+        "keyId": "key-id"
+    },
+	"scopes": ["profile"],
+	"isCreationAllowed": true,
+	"isLinkingAllowed": true,
+	"isAutoCreation": true,
+	"isAutoUpdate": true,
+	"autoLinkingOption": 1
+}`),
+					), instance.OktaIDPChangedEventMapper),
+			},
+			reduce: (&idpTemplateProjection{}).reduceOktaIDPChanged,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: idpTemplateUpdateStmt,
+							expectedArgs: []interface{}{
+								"name",
+								true,
+								true,
+								true,
+								true,
+								domain.AutoLinkingOptionUsername,
+								anyArg{},
+								uint64(15),
+								"idp-id",
+								"instance-id",
+							},
+						},
+						{
+							expectedStmt: "UPDATE projections.idp_templates6_okta SET (issuer, client_id, client_secret, scopes) = ($1, $2, $3, $4) WHERE (idp_id = $5) AND (instance_id = $6)",
 							expectedArgs: []interface{}{
 								"issuer",
 								"client_id",
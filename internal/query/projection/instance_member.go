@@ -13,7 +13,7 @@ import (
 )
 
 const (
-	InstanceMemberProjectionTable = "projections.instance_members4"
+	InstanceMemberProjectionTable = "projections.instance_members5"
 
 	InstanceMemberIAMIDCol = "id"
 )
@@ -64,6 +64,14 @@ func (p *instanceMemberProjection) Reducers() []handler.AggregateReducer {
 					Event:  instance.MemberChangedEventType,
 					Reduce: p.reduceChanged,
 				},
+				{
+					Event:  instance.MemberValiditySetEventType,
+					Reduce: p.reduceValiditySet,
+				},
+				{
+					Event:  instance.MemberExpiredEventType,
+					Reduce: p.reduceExpired,
+				},
 				{
 					Event:  instance.MemberCascadeRemovedEventType,
 					Reduce: p.reduceCascadeRemoved,
@@ -120,6 +128,22 @@ func (p *instanceMemberProjection) reduceChanged(event eventstore.Event) (*handl
 	return reduceMemberChanged(e.MemberChangedEvent)
 }
 
+func (p *instanceMemberProjection) reduceValiditySet(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*instance.MemberValiditySetEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Iv1ts", "reduce.wrong.event.type %s", instance.MemberValiditySetEventType)
+	}
+	return reduceMemberValiditySet(e.MemberValiditySetEvent)
+}
+
+func (p *instanceMemberProjection) reduceExpired(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*instance.MemberExpiredEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Ie2xp", "reduce.wrong.event.type %s", instance.MemberExpiredEventType)
+	}
+	return reduceMemberExpired(e.MemberExpiredEvent)
+}
+
 func (p *instanceMemberProjection) reduceCascadeRemoved(event eventstore.Event) (*handler.Statement, error) {
 	e, ok := event.(*instance.MemberCascadeRemovedEvent)
 	if !ok {
@@ -13,7 +13,7 @@ import (
 )
 
 const (
-	LoginPolicyTable = "projections.login_policies5"
+	LoginPolicyTable = "projections.login_policies6"
 
 	LoginPolicyIDCol                    = "aggregate_id"
 	LoginPolicyInstanceIDCol            = "instance_id"
@@ -40,6 +40,7 @@ const (
 	MFAInitSkipLifetimeCol              = "mfa_init_skip_lifetime"
 	SecondFactorCheckLifetimeCol        = "second_factor_check_lifetime"
 	MultiFactorCheckLifetimeCol         = "multi_factor_check_lifetime"
+	ForcePasskeyRegistrationCol         = "force_passkey_registration"
 	LoginPolicyOwnerRemovedCol          = "owner_removed"
 )
 
@@ -81,6 +82,7 @@ func (*loginPolicyProjection) Init() *old_handler.Check {
 			handler.NewColumn(MFAInitSkipLifetimeCol, handler.ColumnTypeInt64),
 			handler.NewColumn(SecondFactorCheckLifetimeCol, handler.ColumnTypeInt64),
 			handler.NewColumn(MultiFactorCheckLifetimeCol, handler.ColumnTypeInt64),
+			handler.NewColumn(ForcePasskeyRegistrationCol, handler.ColumnTypeBool, handler.Default(false)),
 			handler.NewColumn(LoginPolicyOwnerRemovedCol, handler.ColumnTypeBool, handler.Default(false)),
 		},
 			handler.NewPrimaryKey(LoginPolicyInstanceIDCol, LoginPolicyIDCol),
@@ -202,6 +204,7 @@ func (p *loginPolicyProjection) reduceLoginPolicyAdded(event eventstore.Event) (
 		handler.NewCol(MFAInitSkipLifetimeCol, policyEvent.MFAInitSkipLifetime),
 		handler.NewCol(SecondFactorCheckLifetimeCol, policyEvent.SecondFactorCheckLifetime),
 		handler.NewCol(MultiFactorCheckLifetimeCol, policyEvent.MultiFactorCheckLifetime),
+		handler.NewCol(ForcePasskeyRegistrationCol, policyEvent.ForcePasskeyRegistration),
 	}), nil
 }
 
@@ -271,6 +274,9 @@ func (p *loginPolicyProjection) reduceLoginPolicyChanged(event eventstore.Event)
 	if policyEvent.MultiFactorCheckLifetime != nil {
 		cols = append(cols, handler.NewCol(MultiFactorCheckLifetimeCol, *policyEvent.MultiFactorCheckLifetime))
 	}
+	if policyEvent.ForcePasskeyRegistration != nil {
+		cols = append(cols, handler.NewCol(ForcePasskeyRegistrationCol, *policyEvent.ForcePasskeyRegistration))
+	}
 
 	return handler.NewUpdateStatement(
 		&policyEvent,
@@ -14,6 +14,8 @@ const (
 	MemberUserIDCol         = "user_id"
 	MemberRolesCol          = "roles"
 	MemberUserResourceOwner = "user_resource_owner"
+	MemberValidFrom         = "valid_from"
+	MemberValidUntil        = "valid_until"
 
 	MemberCreationDate  = "creation_date"
 	MemberChangeDate    = "change_date"
@@ -32,6 +34,8 @@ var (
 		handler.NewColumn(MemberSequence, handler.ColumnTypeInt64),
 		handler.NewColumn(MemberResourceOwner, handler.ColumnTypeText),
 		handler.NewColumn(MemberInstanceID, handler.ColumnTypeText),
+		handler.NewColumn(MemberValidFrom, handler.ColumnTypeTimestamp, handler.Nullable()),
+		handler.NewColumn(MemberValidUntil, handler.ColumnTypeTimestamp, handler.Nullable()),
 	}
 )
 
@@ -95,6 +99,39 @@ func reduceMemberChanged(e member.MemberChangedEvent, opts ...reduceMemberOpt) (
 	return handler.NewUpdateStatement(&e, config.cols, config.conds), nil
 }
 
+func reduceMemberValiditySet(e member.MemberValiditySetEvent, opts ...reduceMemberOpt) (*handler.Statement, error) {
+	config := reduceMemberConfig{
+		cols: []handler.Column{
+			handler.NewCol(MemberValidFrom, e.ValidFrom),
+			handler.NewCol(MemberValidUntil, e.ValidUntil),
+			handler.NewCol(MemberChangeDate, e.CreatedAt()),
+			handler.NewCol(MemberSequence, e.Sequence()),
+		},
+		conds: []handler.Condition{
+			handler.NewCond(MemberInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(MemberUserIDCol, e.UserID),
+		}}
+
+	for _, opt := range opts {
+		config = opt(config)
+	}
+
+	return handler.NewUpdateStatement(&e, config.cols, config.conds), nil
+}
+
+func reduceMemberExpired(e member.MemberExpiredEvent, opts ...reduceMemberOpt) (*handler.Statement, error) {
+	config := reduceMemberConfig{
+		conds: []handler.Condition{
+			handler.NewCond(MemberInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(MemberUserIDCol, e.UserID),
+		}}
+
+	for _, opt := range opts {
+		config = opt(config)
+	}
+	return handler.NewDeleteStatement(&e, config.conds), nil
+}
+
 func reduceMemberCascadeRemoved(e member.MemberCascadeRemovedEvent, opts ...reduceMemberOpt) (*handler.Statement, error) {
 	config := reduceMemberConfig{
 		conds: []handler.Condition{
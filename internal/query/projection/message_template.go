@@ -0,0 +1,163 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/policy"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	MessageTemplateTable = "projections.message_templates"
+
+	MessageTemplateAggregateIDCol  = "aggregate_id"
+	MessageTemplateInstanceIDCol   = "instance_id"
+	MessageTemplateCreationDateCol = "creation_date"
+	MessageTemplateChangeDateCol   = "change_date"
+	MessageTemplateSequenceCol     = "sequence"
+	MessageTemplateIsDefaultCol    = "is_default"
+	MessageTemplateNameCol         = "name"
+	MessageTemplateLanguageCol     = "language"
+	MessageTemplateTemplateCol     = "template"
+)
+
+type messageTemplateProjection struct{}
+
+func newMessageTemplateProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(messageTemplateProjection))
+}
+
+func (*messageTemplateProjection) Name() string {
+	return MessageTemplateTable
+}
+
+func (*messageTemplateProjection) Init() *old_handler.Check {
+	return handler.NewTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(MessageTemplateAggregateIDCol, handler.ColumnTypeText),
+			handler.NewColumn(MessageTemplateInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(MessageTemplateCreationDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(MessageTemplateChangeDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(MessageTemplateSequenceCol, handler.ColumnTypeInt64),
+			handler.NewColumn(MessageTemplateIsDefaultCol, handler.ColumnTypeBool),
+			handler.NewColumn(MessageTemplateNameCol, handler.ColumnTypeText),
+			handler.NewColumn(MessageTemplateLanguageCol, handler.ColumnTypeText),
+			handler.NewColumn(MessageTemplateTemplateCol, handler.ColumnTypeBytes),
+		},
+			handler.NewPrimaryKey(MessageTemplateInstanceIDCol, MessageTemplateAggregateIDCol, MessageTemplateNameCol, MessageTemplateLanguageCol),
+		),
+	)
+}
+
+func (p *messageTemplateProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.MessageTemplateSetEventType,
+					Reduce: p.reduceSet,
+				},
+				{
+					Event:  org.MessageTemplateRemovedEventType,
+					Reduce: p.reduceRemoved,
+				},
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.MessageTemplateSetEventType,
+					Reduce: p.reduceSet,
+				},
+				{
+					Event:  instance.MessageTemplateRemovedEventType,
+					Reduce: p.reduceRemoved,
+				},
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(MessageTemplateInstanceIDCol),
+				},
+			},
+		},
+	}
+}
+
+func (p *messageTemplateProjection) reduceSet(event eventstore.Event) (*handler.Statement, error) {
+	var templateEvent policy.MessageTemplateSetEvent
+	var isDefault bool
+	switch e := event.(type) {
+	case *org.MessageTemplateSetEvent:
+		templateEvent = e.MessageTemplateSetEvent
+		isDefault = false
+	case *instance.MessageTemplateSetEvent:
+		templateEvent = e.MessageTemplateSetEvent
+		isDefault = true
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Mt1st", "reduce.wrong.event.type %v", []eventstore.EventType{org.MessageTemplateSetEventType, instance.MessageTemplateSetEventType})
+	}
+	return handler.NewUpsertStatement(
+		&templateEvent,
+		[]handler.Column{
+			handler.NewCol(MessageTemplateInstanceIDCol, nil),
+			handler.NewCol(MessageTemplateAggregateIDCol, nil),
+			handler.NewCol(MessageTemplateNameCol, nil),
+			handler.NewCol(MessageTemplateLanguageCol, nil),
+		},
+		[]handler.Column{
+			handler.NewCol(MessageTemplateAggregateIDCol, templateEvent.Aggregate().ID),
+			handler.NewCol(MessageTemplateInstanceIDCol, templateEvent.Aggregate().InstanceID),
+			handler.NewCol(MessageTemplateCreationDateCol, handler.OnlySetValueOnInsert(MessageTemplateTable, templateEvent.CreationDate())),
+			handler.NewCol(MessageTemplateChangeDateCol, templateEvent.CreationDate()),
+			handler.NewCol(MessageTemplateSequenceCol, templateEvent.Sequence()),
+			handler.NewCol(MessageTemplateIsDefaultCol, isDefault),
+			handler.NewCol(MessageTemplateNameCol, templateEvent.Name),
+			handler.NewCol(MessageTemplateLanguageCol, templateEvent.Language.String()),
+			handler.NewCol(MessageTemplateTemplateCol, templateEvent.Template),
+		}), nil
+}
+
+func (p *messageTemplateProjection) reduceRemoved(event eventstore.Event) (*handler.Statement, error) {
+	var templateEvent policy.MessageTemplateRemovedEvent
+	switch e := event.(type) {
+	case *org.MessageTemplateRemovedEvent:
+		templateEvent = e.MessageTemplateRemovedEvent
+	case *instance.MessageTemplateRemovedEvent:
+		templateEvent = e.MessageTemplateRemovedEvent
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Mt2rm", "reduce.wrong.event.type %v", []eventstore.EventType{org.MessageTemplateRemovedEventType, instance.MessageTemplateRemovedEventType})
+	}
+	return handler.NewDeleteStatement(
+		&templateEvent,
+		[]handler.Condition{
+			handler.NewCond(MessageTemplateAggregateIDCol, templateEvent.Aggregate().ID),
+			handler.NewCond(MessageTemplateNameCol, templateEvent.Name),
+			handler.NewCond(MessageTemplateLanguageCol, templateEvent.Language.String()),
+			handler.NewCond(MessageTemplateInstanceIDCol, templateEvent.Aggregate().InstanceID),
+		}), nil
+}
+
+func (p *messageTemplateProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.OrgRemovedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Mt3or", "reduce.wrong.event.type %s", org.OrgRemovedEventType)
+	}
+
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(MessageTemplateInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(MessageTemplateAggregateIDCol, e.Aggregate().ID),
+		},
+	), nil
+}
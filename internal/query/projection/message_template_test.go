@@ -0,0 +1,133 @@
+package projection
+
+import (
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestMessageTemplateProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "org reduceSet",
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.MessageTemplateSetEventType,
+						org.AggregateType,
+						[]byte(`{
+						"name": "InitCode",
+						"language": "en",
+						"template": "e3suVGV4dH19"
+					}`),
+					), org.MessageTemplateSetEventMapper),
+			},
+			reduce: (&messageTemplateProjection{}).reduceSet,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.message_templates (aggregate_id, instance_id, creation_date, change_date, sequence, is_default, name, language, template) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9) ON CONFLICT (instance_id, aggregate_id, name, language) DO UPDATE SET (creation_date, change_date, sequence, is_default, template) = (projections.message_templates.creation_date, EXCLUDED.change_date, EXCLUDED.sequence, EXCLUDED.is_default, EXCLUDED.template)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								"instance-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								false,
+								"InitCode",
+								"en",
+								[]byte("{{.Text}}"),
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance reduceRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.MessageTemplateRemovedEventType,
+						instance.AggregateType,
+						[]byte(`{
+						"name": "header",
+						"language": "en"
+					}`),
+					), instance.MessageTemplateRemovedEventMapper),
+			},
+			reduce: (&messageTemplateProjection{}).reduceRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.message_templates WHERE (aggregate_id = $1) AND (name = $2) AND (language = $3) AND (instance_id = $4)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								"header",
+								"en",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:   "org reduceOwnerRemoved",
+			reduce: (&messageTemplateProjection{}).reduceOwnerRemoved,
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.OrgRemovedEventType,
+						org.AggregateType,
+						nil,
+					), org.OrgRemovedEventMapper),
+			},
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.message_templates WHERE (instance_id = $1) AND (aggregate_id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if ok := zerrors.IsErrorInvalidArgument(err); !ok {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, MessageTemplateTable, tt.want)
+		})
+	}
+}
@@ -270,9 +270,13 @@ func isMessageTemplate(template string) bool {
 		template == domain.VerifyPhoneMessageType ||
 		template == domain.VerifySMSOTPMessageType ||
 		template == domain.VerifyEmailOTPMessageType ||
+		template == domain.VerifyPushMessageType ||
 		template == domain.DomainClaimedMessageType ||
 		template == domain.PasswordlessRegistrationMessageType ||
-		template == domain.PasswordChangeMessageType
+		template == domain.PasswordChangeMessageType ||
+		template == domain.UserDeactivationWarningMessageType ||
+		template == domain.UserDeletionWarningMessageType ||
+		domain.IsSecurityAlertMessageType(template)
 }
 func isTitle(key string) bool {
 	return key == domain.MessageTitle
@@ -0,0 +1,192 @@
+package projection
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/notification"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	NotificationDeliveryTable = "projections.notification_deliveries"
+
+	NotificationDeliveryInstanceIDCol          = "instance_id"
+	NotificationDeliveryUserIDCol              = "user_id"
+	NotificationDeliverySequenceCol            = "sequence"
+	NotificationDeliveryCreationDateCol        = "creation_date"
+	NotificationDeliveryResourceOwnerCol       = "resource_owner"
+	NotificationDeliveryChannelCol             = "channel"
+	NotificationDeliveryMessageTypeCol         = "message_type"
+	NotificationDeliveryProviderIDCol          = "provider_id"
+	NotificationDeliveryProviderMessageIDCol   = "provider_message_id"
+	NotificationDeliveryCodeExpiryCol          = "code_expiry"
+	NotificationDeliveryResultCol              = "result"
+	NotificationDeliveryErrorCol               = "error"
+	NotificationDeliveryTriggeringEventTypeCol = "triggering_event_type"
+)
+
+// notificationDeliveryProjection is the delivery history of the notifications sent to the users.
+// Deliveries older than the audit log retention of the instance are removed whenever a new one is recorded.
+type notificationDeliveryProjection struct{}
+
+func newNotificationDeliveryProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(notificationDeliveryProjection))
+}
+
+func (*notificationDeliveryProjection) Name() string {
+	return NotificationDeliveryTable
+}
+
+func (*notificationDeliveryProjection) Init() *old_handler.Check {
+	return handler.NewTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(NotificationDeliveryInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(NotificationDeliveryUserIDCol, handler.ColumnTypeText),
+			handler.NewColumn(NotificationDeliverySequenceCol, handler.ColumnTypeInt64),
+			handler.NewColumn(NotificationDeliveryCreationDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(NotificationDeliveryResourceOwnerCol, handler.ColumnTypeText),
+			handler.NewColumn(NotificationDeliveryChannelCol, handler.ColumnTypeEnum),
+			handler.NewColumn(NotificationDeliveryMessageTypeCol, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(NotificationDeliveryProviderIDCol, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(NotificationDeliveryProviderMessageIDCol, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(NotificationDeliveryCodeExpiryCol, handler.ColumnTypeInterval, handler.Nullable()),
+			handler.NewColumn(NotificationDeliveryResultCol, handler.ColumnTypeEnum),
+			handler.NewColumn(NotificationDeliveryErrorCol, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(NotificationDeliveryTriggeringEventTypeCol, handler.ColumnTypeText, handler.Default("")),
+		},
+			handler.NewPrimaryKey(NotificationDeliveryInstanceIDCol, NotificationDeliveryUserIDCol, NotificationDeliverySequenceCol),
+			handler.WithIndex(handler.NewIndex("resource_owner", []string{NotificationDeliveryResourceOwnerCol})),
+			handler.WithIndex(handler.NewIndex("creation_date", []string{NotificationDeliveryCreationDateCol})),
+		),
+	)
+}
+
+func (p *notificationDeliveryProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: notification.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  notification.DeliverySucceededType,
+					Reduce: p.reduceSent,
+				},
+				{
+					Event:  notification.DeliveryFailedType,
+					Reduce: p.reduceFailed,
+				},
+			},
+		},
+		{
+			Aggregate: user.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  user.UserRemovedType,
+					Reduce: p.reduceUserRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(NotificationDeliveryInstanceIDCol),
+				},
+			},
+		},
+	}
+}
+
+func (p *notificationDeliveryProjection) reduceSent(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*notification.DeliverySucceededEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.createDelivery(e, e.NotificationDelivery, e.TriggeringEventType, e.Retention, domain.NotificationDeliveryResultSent, ""), nil
+}
+
+func (p *notificationDeliveryProjection) reduceFailed(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*notification.DeliveryFailedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.createDelivery(e, e.NotificationDelivery, e.TriggeringEventType, e.Retention, domain.NotificationDeliveryResultFailed, e.Error), nil
+}
+
+func (p *notificationDeliveryProjection) createDelivery(event eventstore.Event, delivery domain.NotificationDelivery, triggeringEventType eventstore.EventType, retention time.Duration, result domain.NotificationDeliveryResult, errMsg string) *handler.Statement {
+	columns := []handler.Column{
+		handler.NewCol(NotificationDeliveryInstanceIDCol, event.Aggregate().InstanceID),
+		handler.NewCol(NotificationDeliveryUserIDCol, event.Aggregate().ID),
+		handler.NewCol(NotificationDeliverySequenceCol, event.Sequence()),
+		handler.NewCol(NotificationDeliveryCreationDateCol, event.CreatedAt()),
+		handler.NewCol(NotificationDeliveryResourceOwnerCol, event.Aggregate().ResourceOwner),
+		handler.NewCol(NotificationDeliveryChannelCol, delivery.Channel),
+		handler.NewCol(NotificationDeliveryMessageTypeCol, delivery.MessageType),
+		handler.NewCol(NotificationDeliveryProviderIDCol, delivery.ProviderID),
+		handler.NewCol(NotificationDeliveryProviderMessageIDCol, delivery.ProviderMessageID),
+		handler.NewCol(NotificationDeliveryResultCol, result),
+		handler.NewCol(NotificationDeliveryErrorCol, errMsg),
+		handler.NewCol(NotificationDeliveryTriggeringEventTypeCol, triggeringEventType),
+	}
+	if delivery.CodeExpiry > 0 {
+		columns = append(columns, handler.NewCol(NotificationDeliveryCodeExpiryCol, delivery.CodeExpiry))
+	}
+	if retention == 0 {
+		return handler.NewCreateStatement(event, columns)
+	}
+	return handler.NewMultiStatement(
+		event,
+		handler.AddCreateStatement(columns),
+		handler.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(NotificationDeliveryInstanceIDCol, event.Aggregate().InstanceID),
+				handler.NewLessThanCond(NotificationDeliveryCreationDateCol, event.CreatedAt().Add(-retention)),
+			},
+		),
+	)
+}
+
+func (p *notificationDeliveryProjection) reduceUserRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.UserRemovedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Nd3ur", "reduce.wrong.event.type %s", user.UserRemovedType)
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(NotificationDeliveryInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(NotificationDeliveryUserIDCol, e.Aggregate().ID),
+		},
+	), nil
+}
+
+func (p *notificationDeliveryProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.OrgRemovedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Nd4or", "reduce.wrong.event.type %s", org.OrgRemovedEventType)
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(NotificationDeliveryInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(NotificationDeliveryResourceOwnerCol, e.Aggregate().ID),
+		},
+	), nil
+}
@@ -0,0 +1,214 @@
+package projection
+
+import (
+	"testing"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/notification"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestNotificationDeliveryProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceSent",
+			args: args{
+				event: getEvent(testEvent(
+					notification.DeliverySucceededType,
+					notification.AggregateType,
+					[]byte(`{
+						"channel": 0,
+						"messageType": "VerifyEmail",
+						"providerId": "provider-id",
+						"providerMessageId": "<message-id@zitadel.cloud>",
+						"codeExpiry": 3600000000000,
+						"triggeringEventType": "user.human.email.code.added",
+						"retention": 2592000000000000
+					}`),
+				), eventstore.GenericEventMapper[notification.DeliverySucceededEvent]),
+			},
+			reduce: (&notificationDeliveryProjection{}).reduceSent,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("notification"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.notification_deliveries (instance_id, user_id, sequence, creation_date, resource_owner, channel, message_type, provider_id, provider_message_id, result, error, triggering_event_type, code_expiry) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+								uint64(15),
+								anyArg{},
+								"ro-id",
+								domain.NotificationTypeEmail,
+								"VerifyEmail",
+								"provider-id",
+								"<message-id@zitadel.cloud>",
+								domain.NotificationDeliveryResultSent,
+								"",
+								eventstore.EventType("user.human.email.code.added"),
+								time.Hour,
+							},
+						},
+						{
+							expectedStmt: "DELETE FROM projections.notification_deliveries WHERE (instance_id = $1) AND (creation_date < $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								anyArg{},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceFailed",
+			args: args{
+				event: getEvent(testEvent(
+					notification.DeliveryFailedType,
+					notification.AggregateType,
+					[]byte(`{
+						"channel": 1,
+						"messageType": "VerifyPhone",
+						"providerId": "provider-id",
+						"triggeringEventType": "user.human.phone.code.added",
+						"error": "provider unavailable"
+					}`),
+				), eventstore.GenericEventMapper[notification.DeliveryFailedEvent]),
+			},
+			reduce: (&notificationDeliveryProjection{}).reduceFailed,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("notification"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.notification_deliveries (instance_id, user_id, sequence, creation_date, resource_owner, channel, message_type, provider_id, provider_message_id, result, error, triggering_event_type) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+								uint64(15),
+								anyArg{},
+								"ro-id",
+								domain.NotificationTypeSms,
+								"VerifyPhone",
+								"provider-id",
+								"",
+								domain.NotificationDeliveryResultFailed,
+								"provider unavailable",
+								eventstore.EventType("user.human.phone.code.added"),
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceUserRemoved",
+			args: args{
+				event: getEvent(testEvent(
+					user.UserRemovedType,
+					user.AggregateType,
+					nil,
+				), user.UserRemovedEventMapper),
+			},
+			reduce: (&notificationDeliveryProjection{}).reduceUserRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("user"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.notification_deliveries WHERE (instance_id = $1) AND (user_id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceOwnerRemoved",
+			args: args{
+				event: getEvent(testEvent(
+					org.OrgRemovedEventType,
+					org.AggregateType,
+					nil,
+				), org.OrgRemovedEventMapper),
+			},
+			reduce: (&notificationDeliveryProjection{}).reduceOwnerRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.notification_deliveries WHERE (instance_id = $1) AND (resource_owner = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceInstanceRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.InstanceRemovedEventType,
+						instance.AggregateType,
+						nil,
+					),
+					instance.InstanceRemovedEventMapper,
+				),
+			},
+			reduce: reduceInstanceRemovedHelper(NotificationDeliveryInstanceIDCol),
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.notification_deliveries WHERE (instance_id = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if !zerrors.IsErrorInvalidArgument(err) {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, NotificationDeliveryTable, tt.want)
+		})
+	}
+}
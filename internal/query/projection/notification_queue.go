@@ -0,0 +1,189 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/notification"
+)
+
+const (
+	NotificationQueueProjectionTable = "projections.notification_queue"
+
+	NotificationQueueColumnID                    = "id"
+	NotificationQueueColumnCreationDate          = "creation_date"
+	NotificationQueueColumnChangeDate            = "change_date"
+	NotificationQueueColumnSequence              = "sequence"
+	NotificationQueueColumnResourceOwner         = "resource_owner"
+	NotificationQueueColumnInstanceID            = "instance_id"
+	NotificationQueueColumnState                 = "state"
+	NotificationQueueColumnChannel               = "channel"
+	NotificationQueueColumnTriggeringAggregateID = "triggering_aggregate_id"
+	NotificationQueueColumnTriggeringEventType   = "triggering_event_type"
+	NotificationQueueColumnAttempts              = "attempts"
+	NotificationQueueColumnNextAttemptDate       = "next_attempt_date"
+	NotificationQueueColumnLastError             = "last_error"
+)
+
+type notificationQueueProjection struct{}
+
+func newNotificationQueueProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(notificationQueueProjection))
+}
+
+func (*notificationQueueProjection) Name() string {
+	return NotificationQueueProjectionTable
+}
+
+func (*notificationQueueProjection) Init() *old_handler.Check {
+	return handler.NewTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(NotificationQueueColumnID, handler.ColumnTypeText),
+			handler.NewColumn(NotificationQueueColumnCreationDate, handler.ColumnTypeTimestamp),
+			handler.NewColumn(NotificationQueueColumnChangeDate, handler.ColumnTypeTimestamp),
+			handler.NewColumn(NotificationQueueColumnSequence, handler.ColumnTypeInt64),
+			handler.NewColumn(NotificationQueueColumnResourceOwner, handler.ColumnTypeText),
+			handler.NewColumn(NotificationQueueColumnInstanceID, handler.ColumnTypeText),
+			handler.NewColumn(NotificationQueueColumnState, handler.ColumnTypeEnum),
+			handler.NewColumn(NotificationQueueColumnChannel, handler.ColumnTypeEnum),
+			handler.NewColumn(NotificationQueueColumnTriggeringAggregateID, handler.ColumnTypeText),
+			handler.NewColumn(NotificationQueueColumnTriggeringEventType, handler.ColumnTypeText),
+			handler.NewColumn(NotificationQueueColumnAttempts, handler.ColumnTypeInt64, handler.Default(0)),
+			handler.NewColumn(NotificationQueueColumnNextAttemptDate, handler.ColumnTypeTimestamp, handler.Nullable()),
+			handler.NewColumn(NotificationQueueColumnLastError, handler.ColumnTypeText, handler.Nullable()),
+		},
+			handler.NewPrimaryKey(NotificationQueueColumnInstanceID, NotificationQueueColumnID),
+			handler.WithIndex(handler.NewIndex("due", []string{NotificationQueueColumnState, NotificationQueueColumnNextAttemptDate})),
+		),
+	)
+}
+
+func (p *notificationQueueProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: notification.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  notification.RequestedType,
+					Reduce: p.reduceRequested,
+				},
+				{
+					Event:  notification.RetryRequestedType,
+					Reduce: p.reduceRetryRequested,
+				},
+				{
+					Event:  notification.SentType,
+					Reduce: p.reduceSent,
+				},
+				{
+					Event:  notification.CanceledType,
+					Reduce: p.reduceCanceled,
+				},
+				{
+					Event:  notification.FailedType,
+					Reduce: p.reduceFailed,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(NotificationQueueColumnInstanceID),
+				},
+			},
+		},
+	}
+}
+
+func (p *notificationQueueProjection) reduceRequested(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*notification.RequestedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewCreateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(NotificationQueueColumnID, e.Aggregate().ID),
+			handler.NewCol(NotificationQueueColumnCreationDate, e.CreationDate()),
+			handler.NewCol(NotificationQueueColumnChangeDate, e.CreationDate()),
+			handler.NewCol(NotificationQueueColumnSequence, e.Sequence()),
+			handler.NewCol(NotificationQueueColumnResourceOwner, e.TriggeringResourceOwner),
+			handler.NewCol(NotificationQueueColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCol(NotificationQueueColumnState, domain.NotificationStateRequested),
+			handler.NewCol(NotificationQueueColumnChannel, e.NotificationType),
+			handler.NewCol(NotificationQueueColumnTriggeringAggregateID, e.TriggeringAggregateID),
+			handler.NewCol(NotificationQueueColumnTriggeringEventType, e.TriggeringEventType),
+			handler.NewCol(NotificationQueueColumnNextAttemptDate, e.CreationDate()),
+		},
+	), nil
+}
+
+func (p *notificationQueueProjection) reduceRetryRequested(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*notification.RetryRequestedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.updateStatement(e,
+		handler.NewCol(NotificationQueueColumnState, domain.NotificationStateRetrying),
+		handler.NewCol(NotificationQueueColumnAttempts, e.Attempt),
+		handler.NewCol(NotificationQueueColumnNextAttemptDate, e.NextAttempt),
+		handler.NewCol(NotificationQueueColumnLastError, e.Error),
+	), nil
+}
+
+func (p *notificationQueueProjection) reduceSent(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*notification.SentEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.updateStatement(e,
+		handler.NewCol(NotificationQueueColumnState, domain.NotificationStateSent),
+		handler.NewCol(NotificationQueueColumnAttempts, e.Attempt),
+		handler.NewCol(NotificationQueueColumnNextAttemptDate, nil),
+	), nil
+}
+
+func (p *notificationQueueProjection) reduceCanceled(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*notification.CanceledEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.updateStatement(e,
+		handler.NewCol(NotificationQueueColumnState, domain.NotificationStateCanceled),
+		handler.NewCol(NotificationQueueColumnNextAttemptDate, nil),
+		handler.NewCol(NotificationQueueColumnLastError, e.Reason),
+	), nil
+}
+
+func (p *notificationQueueProjection) reduceFailed(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*notification.FailedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.updateStatement(e,
+		handler.NewCol(NotificationQueueColumnState, domain.NotificationStateFailed),
+		handler.NewCol(NotificationQueueColumnAttempts, e.Attempt),
+		handler.NewCol(NotificationQueueColumnNextAttemptDate, nil),
+		handler.NewCol(NotificationQueueColumnLastError, e.Error),
+	), nil
+}
+
+func (p *notificationQueueProjection) updateStatement(e eventstore.Event, cols ...handler.Column) *handler.Statement {
+	return handler.NewUpdateStatement(
+		e,
+		append([]handler.Column{
+			handler.NewCol(NotificationQueueColumnChangeDate, e.CreatedAt()),
+			handler.NewCol(NotificationQueueColumnSequence, e.Sequence()),
+		}, cols...),
+		[]handler.Condition{
+			handler.NewCond(NotificationQueueColumnID, e.Aggregate().ID),
+			handler.NewCond(NotificationQueueColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	)
+}
@@ -0,0 +1,247 @@
+package projection
+
+import (
+	"testing"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/notification"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestNotificationQueueProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceRequested",
+			args: args{
+				event: getEvent(testEvent(
+					notification.RequestedType,
+					notification.AggregateType,
+					[]byte(`{
+						"request": {
+							"triggeringAggregateType": "user",
+							"triggeringAggregateId": "user-id",
+							"triggeringEventType": "user.human.email.code.added",
+							"triggeringSequence": 5,
+							"triggeringResourceOwner": "org-id",
+							"notificationType": 0
+						}
+					}`),
+				), eventstore.GenericEventMapper[notification.RequestedEvent]),
+			},
+			reduce: (&notificationQueueProjection{}).reduceRequested,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("notification"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.notification_queue (id, creation_date, change_date, sequence, resource_owner, instance_id, state, channel, triggering_aggregate_id, triggering_event_type, next_attempt_date) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"org-id",
+								"instance-id",
+								domain.NotificationStateRequested,
+								domain.NotificationTypeEmail,
+								"user-id",
+								eventstore.EventType("user.human.email.code.added"),
+								anyArg{},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceRetryRequested",
+			args: args{
+				event: getEvent(testEvent(
+					notification.RetryRequestedType,
+					notification.AggregateType,
+					[]byte(`{
+						"attempt": 2,
+						"nextAttempt": "2024-01-01T00:00:00Z",
+						"error": "smtp unavailable"
+					}`),
+				), eventstore.GenericEventMapper[notification.RetryRequestedEvent]),
+			},
+			reduce: (&notificationQueueProjection{}).reduceRetryRequested,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("notification"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.notification_queue SET (change_date, sequence, state, attempts, next_attempt_date, last_error) = ($1, $2, $3, $4, $5, $6) WHERE (id = $7) AND (instance_id = $8)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								domain.NotificationStateRetrying,
+								uint16(2),
+								time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+								"smtp unavailable",
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceSent",
+			args: args{
+				event: getEvent(testEvent(
+					notification.SentType,
+					notification.AggregateType,
+					[]byte(`{
+						"attempt": 1
+					}`),
+				), eventstore.GenericEventMapper[notification.SentEvent]),
+			},
+			reduce: (&notificationQueueProjection{}).reduceSent,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("notification"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.notification_queue SET (change_date, sequence, state, attempts, next_attempt_date) = ($1, $2, $3, $4, $5) WHERE (id = $6) AND (instance_id = $7)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								domain.NotificationStateSent,
+								uint16(1),
+								nil,
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceCanceled",
+			args: args{
+				event: getEvent(testEvent(
+					notification.CanceledType,
+					notification.AggregateType,
+					[]byte(`{
+						"reason": "code expired"
+					}`),
+				), eventstore.GenericEventMapper[notification.CanceledEvent]),
+			},
+			reduce: (&notificationQueueProjection{}).reduceCanceled,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("notification"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.notification_queue SET (change_date, sequence, state, next_attempt_date, last_error) = ($1, $2, $3, $4, $5) WHERE (id = $6) AND (instance_id = $7)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								domain.NotificationStateCanceled,
+								nil,
+								"code expired",
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceFailed",
+			args: args{
+				event: getEvent(testEvent(
+					notification.FailedType,
+					notification.AggregateType,
+					[]byte(`{
+						"attempt": 3,
+						"error": "smtp unavailable"
+					}`),
+				), eventstore.GenericEventMapper[notification.FailedEvent]),
+			},
+			reduce: (&notificationQueueProjection{}).reduceFailed,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("notification"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.notification_queue SET (change_date, sequence, state, attempts, next_attempt_date, last_error) = ($1, $2, $3, $4, $5, $6) WHERE (id = $7) AND (instance_id = $8)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								domain.NotificationStateFailed,
+								uint16(3),
+								nil,
+								"smtp unavailable",
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceInstanceRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.InstanceRemovedEventType,
+						instance.AggregateType,
+						nil,
+					),
+					instance.InstanceRemovedEventMapper,
+				),
+			},
+			reduce: reduceInstanceRemovedHelper(NotificationQueueColumnInstanceID),
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.notification_queue WHERE (instance_id = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if !zerrors.IsErrorInvalidArgument(err) {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, NotificationQueueProjectionTable, tt.want)
+		})
+	}
+}
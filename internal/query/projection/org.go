@@ -13,7 +13,7 @@ import (
 )
 
 const (
-	OrgProjectionTable = "projections.orgs1"
+	OrgProjectionTable = "projections.orgs2"
 
 	OrgColumnID            = "id"
 	OrgColumnCreationDate  = "creation_date"
@@ -24,6 +24,7 @@ const (
 	OrgColumnSequence      = "sequence"
 	OrgColumnName          = "name"
 	OrgColumnDomain        = "primary_domain"
+	OrgColumnParentID      = "parent_id"
 )
 
 type orgProjection struct{}
@@ -49,10 +50,12 @@ func (p *orgProjection) Init() *old_handler.Check {
 			handler.NewColumn(OrgColumnSequence, handler.ColumnTypeInt64),
 			handler.NewColumn(OrgColumnName, handler.ColumnTypeText),
 			handler.NewColumn(OrgColumnDomain, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(OrgColumnParentID, handler.ColumnTypeText, handler.Default("")),
 		},
 			handler.NewPrimaryKey(OrgColumnInstanceID, OrgColumnID),
 			handler.WithIndex(handler.NewIndex("domain", []string{OrgColumnDomain})),
 			handler.WithIndex(handler.NewIndex("name", []string{OrgColumnName})),
+			handler.WithIndex(handler.NewIndex("parent_id", []string{OrgColumnParentID})),
 		),
 	)
 }
@@ -86,6 +89,14 @@ func (p *orgProjection) Reducers() []handler.AggregateReducer {
 					Event:  org.OrgDomainPrimarySetEventType,
 					Reduce: p.reducePrimaryDomainSet,
 				},
+				{
+					Event:  org.OrgParentSetEventType,
+					Reduce: p.reduceParentSet,
+				},
+				{
+					Event:  org.OrgParentRemovedEventType,
+					Reduce: p.reduceParentRemoved,
+				},
 			},
 		},
 		{
@@ -212,3 +223,41 @@ func (p *orgProjection) reduceOrgRemoved(event eventstore.Event) (*handler.State
 		},
 	), nil
 }
+
+func (p *orgProjection) reduceParentSet(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.OrgParentSetEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Pa2sE", "reduce.wrong.event.type %s", org.OrgParentSetEventType)
+	}
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(OrgColumnChangeDate, e.CreationDate()),
+			handler.NewCol(OrgColumnSequence, e.Sequence()),
+			handler.NewCol(OrgColumnParentID, e.ParentID),
+		},
+		[]handler.Condition{
+			handler.NewCond(OrgColumnID, e.Aggregate().ID),
+			handler.NewCond(OrgColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *orgProjection) reduceParentRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.OrgParentRemovedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Pa3rE", "reduce.wrong.event.type %s", org.OrgParentRemovedEventType)
+	}
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(OrgColumnChangeDate, e.CreationDate()),
+			handler.NewCol(OrgColumnSequence, e.Sequence()),
+			handler.NewCol(OrgColumnParentID, ""),
+		},
+		[]handler.Condition{
+			handler.NewCond(OrgColumnID, e.Aggregate().ID),
+			handler.NewCond(OrgColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
@@ -13,7 +13,7 @@ import (
 )
 
 const (
-	OrgDomainTable = "projections.org_domains2"
+	OrgDomainTable = "projections.org_domains3"
 
 	OrgDomainOrgIDCol          = "org_id"
 	OrgDomainInstanceIDCol     = "instance_id"
@@ -25,6 +25,7 @@ const (
 	OrgDomainIsPrimaryCol      = "is_primary"
 	OrgDomainValidationTypeCol = "validation_type"
 	OrgDomainOwnerRemovedCol   = "owner_removed"
+	OrgDomainIDPIDCol          = "idp_id"
 )
 
 type orgDomainProjection struct{}
@@ -50,6 +51,7 @@ func (*orgDomainProjection) Init() *old_handler.Check {
 			handler.NewColumn(OrgDomainIsPrimaryCol, handler.ColumnTypeBool),
 			handler.NewColumn(OrgDomainValidationTypeCol, handler.ColumnTypeEnum),
 			handler.NewColumn(OrgDomainOwnerRemovedCol, handler.ColumnTypeBool, handler.Default(false)),
+			handler.NewColumn(OrgDomainIDPIDCol, handler.ColumnTypeText, handler.Default("")),
 		},
 			handler.NewPrimaryKey(OrgDomainOrgIDCol, OrgDomainDomainCol, OrgDomainInstanceIDCol),
 			handler.WithIndex(handler.NewIndex("owner_removed", []string{OrgDomainOwnerRemovedCol})),
@@ -78,6 +80,10 @@ func (p *orgDomainProjection) Reducers() []handler.AggregateReducer {
 					Event:  org.OrgDomainPrimarySetEventType,
 					Reduce: p.reducePrimaryDomainSet,
 				},
+				{
+					Event:  org.OrgDomainIDPSetEventType,
+					Reduce: p.reduceDomainIDPSet,
+				},
 				{
 					Event:  org.OrgDomainRemovedEventType,
 					Reduce: p.reduceDomainRemoved,
@@ -195,6 +201,26 @@ func (p *orgDomainProjection) reducePrimaryDomainSet(event eventstore.Event) (*h
 	), nil
 }
 
+func (p *orgDomainProjection) reduceDomainIDPSet(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.DomainIDPSetEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Kx9vb", "reduce.wrong.event.type %s", org.OrgDomainIDPSetEventType)
+	}
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(OrgDomainChangeDateCol, e.CreationDate()),
+			handler.NewCol(OrgDomainSequenceCol, e.Sequence()),
+			handler.NewCol(OrgDomainIDPIDCol, e.IDPID),
+		},
+		[]handler.Condition{
+			handler.NewCond(OrgDomainDomainCol, e.Domain),
+			handler.NewCond(OrgDomainOrgIDCol, e.Aggregate().ID),
+			handler.NewCond(OrgDomainInstanceIDCol, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
 func (p *orgDomainProjection) reduceDomainRemoved(event eventstore.Event) (*handler.Statement, error) {
 	e, ok := event.(*org.DomainRemovedEvent)
 	if !ok {
@@ -13,7 +13,7 @@ import (
 )
 
 const (
-	OrgMemberProjectionTable = "projections.org_members4"
+	OrgMemberProjectionTable = "projections.org_members5"
 	OrgMemberOrgIDCol        = "org_id"
 )
 
@@ -63,6 +63,14 @@ func (p *orgMemberProjection) Reducers() []handler.AggregateReducer {
 					Event:  org.MemberChangedEventType,
 					Reduce: p.reduceChanged,
 				},
+				{
+					Event:  org.MemberValiditySetEventType,
+					Reduce: p.reduceValiditySet,
+				},
+				{
+					Event:  org.MemberExpiredEventType,
+					Reduce: p.reduceExpired,
+				},
 				{
 					Event:  org.MemberCascadeRemovedEventType,
 					Reduce: p.reduceCascadeRemoved,
@@ -119,6 +127,22 @@ func (p *orgMemberProjection) reduceChanged(event eventstore.Event) (*handler.St
 	return reduceMemberChanged(e.MemberChangedEvent, withMemberCond(OrgMemberOrgIDCol, e.Aggregate().ID))
 }
 
+func (p *orgMemberProjection) reduceValiditySet(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.MemberValiditySetEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Ov1ts", "reduce.wrong.event.type %s", org.MemberValiditySetEventType)
+	}
+	return reduceMemberValiditySet(e.MemberValiditySetEvent, withMemberCond(OrgMemberOrgIDCol, e.Aggregate().ID))
+}
+
+func (p *orgMemberProjection) reduceExpired(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.MemberExpiredEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Oe2xp", "reduce.wrong.event.type %s", org.MemberExpiredEventType)
+	}
+	return reduceMemberExpired(e.MemberExpiredEvent, withMemberCond(OrgMemberOrgIDCol, e.Aggregate().ID))
+}
+
 func (p *orgMemberProjection) reduceCascadeRemoved(event eventstore.Event) (*handler.Statement, error) {
 	e, ok := event.(*org.MemberCascadeRemovedEvent)
 	if !ok {
@@ -0,0 +1,164 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	OrgMemberScopeProjectionTable = "projections.org_member_scopes"
+
+	OrgMemberScopeOrgIDCol         = "org_id"
+	OrgMemberScopeUserIDCol        = "user_id"
+	OrgMemberScopeCreationDateCol  = "creation_date"
+	OrgMemberScopeChangeDateCol    = "change_date"
+	OrgMemberScopeSequenceCol      = "sequence"
+	OrgMemberScopeInstanceIDCol    = "instance_id"
+	OrgMemberScopeMetadataKeyCol   = "metadata_key"
+	OrgMemberScopeMetadataValueCol = "metadata_value"
+	OrgMemberScopeGroupIDCol       = "group_id"
+)
+
+// orgMemberScopeProjection holds the scopes restricting the permissions of organization members on users.
+type orgMemberScopeProjection struct{}
+
+func newOrgMemberScopeProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(orgMemberScopeProjection))
+}
+
+func (*orgMemberScopeProjection) Name() string {
+	return OrgMemberScopeProjectionTable
+}
+
+func (*orgMemberScopeProjection) Init() *old_handler.Check {
+	return handler.NewTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(OrgMemberScopeOrgIDCol, handler.ColumnTypeText),
+			handler.NewColumn(OrgMemberScopeUserIDCol, handler.ColumnTypeText),
+			handler.NewColumn(OrgMemberScopeCreationDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(OrgMemberScopeChangeDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(OrgMemberScopeSequenceCol, handler.ColumnTypeInt64),
+			handler.NewColumn(OrgMemberScopeInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(OrgMemberScopeMetadataKeyCol, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(OrgMemberScopeMetadataValueCol, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(OrgMemberScopeGroupIDCol, handler.ColumnTypeText, handler.Default("")),
+		},
+			handler.NewPrimaryKey(OrgMemberScopeInstanceIDCol, OrgMemberScopeOrgIDCol, OrgMemberScopeUserIDCol),
+			handler.WithIndex(handler.NewIndex("user_id", []string{OrgMemberScopeUserIDCol})),
+		),
+	)
+}
+
+func (p *orgMemberScopeProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.MemberScopeSetEventType,
+					Reduce: p.reduceScopeSet,
+				},
+				{
+					Event:  org.MemberRemovedEventType,
+					Reduce: p.reduceMemberRemoved,
+				},
+				{
+					Event:  org.MemberCascadeRemovedEventType,
+					Reduce: p.reduceMemberRemoved,
+				},
+				{
+					Event:  org.MemberExpiredEventType,
+					Reduce: p.reduceMemberRemoved,
+				},
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOrgRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(OrgMemberScopeInstanceIDCol),
+				},
+			},
+		},
+	}
+}
+
+func (p *orgMemberScopeProjection) reduceScopeSet(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.MemberScopeSetEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	if e.Scope().IsEmpty() {
+		return p.deleteScope(e, e.UserID), nil
+	}
+	return handler.NewUpsertStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(OrgMemberScopeInstanceIDCol, nil),
+			handler.NewCol(OrgMemberScopeOrgIDCol, nil),
+			handler.NewCol(OrgMemberScopeUserIDCol, nil),
+		},
+		[]handler.Column{
+			handler.NewCol(OrgMemberScopeInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCol(OrgMemberScopeOrgIDCol, e.Aggregate().ID),
+			handler.NewCol(OrgMemberScopeUserIDCol, e.UserID),
+			handler.NewCol(OrgMemberScopeCreationDateCol, handler.OnlySetValueOnInsert(OrgMemberScopeProjectionTable, e.CreatedAt())),
+			handler.NewCol(OrgMemberScopeChangeDateCol, e.CreatedAt()),
+			handler.NewCol(OrgMemberScopeSequenceCol, e.Sequence()),
+			handler.NewCol(OrgMemberScopeMetadataKeyCol, e.MetadataKey),
+			handler.NewCol(OrgMemberScopeMetadataValueCol, e.MetadataValue),
+			handler.NewCol(OrgMemberScopeGroupIDCol, e.GroupID),
+		},
+	), nil
+}
+
+func (p *orgMemberScopeProjection) reduceMemberRemoved(event eventstore.Event) (*handler.Statement, error) {
+	var userID string
+	switch e := event.(type) {
+	case *org.MemberRemovedEvent:
+		userID = e.UserID
+	case *org.MemberCascadeRemovedEvent:
+		userID = e.UserID
+	case *org.MemberExpiredEvent:
+		userID = e.UserID
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Ms5rm", "reduce.wrong.event.type %v", []eventstore.EventType{org.MemberRemovedEventType, org.MemberCascadeRemovedEventType, org.MemberExpiredEventType})
+	}
+	return p.deleteScope(event, userID), nil
+}
+
+func (p *orgMemberScopeProjection) reduceOrgRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.OrgRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(OrgMemberScopeOrgIDCol, e.Aggregate().ID),
+			handler.NewCond(OrgMemberScopeInstanceIDCol, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *orgMemberScopeProjection) deleteScope(event eventstore.Event, userID string) *handler.Statement {
+	return handler.NewDeleteStatement(
+		event,
+		[]handler.Condition{
+			handler.NewCond(OrgMemberScopeOrgIDCol, event.Aggregate().ID),
+			handler.NewCond(OrgMemberScopeUserIDCol, userID),
+			handler.NewCond(OrgMemberScopeInstanceIDCol, event.Aggregate().InstanceID),
+		},
+	)
+}
@@ -0,0 +1,206 @@
+package projection
+
+import (
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestOrgMemberScopeProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceScopeSet",
+			args: args{
+				event: getEvent(testEvent(
+					org.MemberScopeSetEventType,
+					org.AggregateType,
+					[]byte(`{
+						"userId": "user-id",
+						"metadataKey": "region",
+						"metadataValue": "EMEA",
+						"groupId": "group-id"
+					}`),
+				), eventstore.GenericEventMapper[org.MemberScopeSetEvent]),
+			},
+			reduce: (&orgMemberScopeProjection{}).reduceScopeSet,
+			want: wantReduce{
+				aggregateType: org.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.org_member_scopes (instance_id, org_id, user_id, creation_date, change_date, sequence, metadata_key, metadata_value, group_id) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9) ON CONFLICT (instance_id, org_id, user_id) DO UPDATE SET (creation_date, change_date, sequence, metadata_key, metadata_value, group_id) = (projections.org_member_scopes.creation_date, EXCLUDED.change_date, EXCLUDED.sequence, EXCLUDED.metadata_key, EXCLUDED.metadata_value, EXCLUDED.group_id)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+								"user-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"region",
+								"EMEA",
+								"group-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceScopeSet empty",
+			args: args{
+				event: getEvent(testEvent(
+					org.MemberScopeSetEventType,
+					org.AggregateType,
+					[]byte(`{"userId": "user-id"}`),
+				), eventstore.GenericEventMapper[org.MemberScopeSetEvent]),
+			},
+			reduce: (&orgMemberScopeProjection{}).reduceScopeSet,
+			want: wantReduce{
+				aggregateType: org.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.org_member_scopes WHERE (org_id = $1) AND (user_id = $2) AND (instance_id = $3)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								"user-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceMemberRemoved",
+			args: args{
+				event: getEvent(testEvent(
+					org.MemberRemovedEventType,
+					org.AggregateType,
+					[]byte(`{"userId": "user-id"}`),
+				), org.MemberRemovedEventMapper),
+			},
+			reduce: (&orgMemberScopeProjection{}).reduceMemberRemoved,
+			want: wantReduce{
+				aggregateType: org.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.org_member_scopes WHERE (org_id = $1) AND (user_id = $2) AND (instance_id = $3)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								"user-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceMemberRemoved cascade",
+			args: args{
+				event: getEvent(testEvent(
+					org.MemberCascadeRemovedEventType,
+					org.AggregateType,
+					[]byte(`{"userId": "user-id"}`),
+				), org.MemberCascadeRemovedEventMapper),
+			},
+			reduce: (&orgMemberScopeProjection{}).reduceMemberRemoved,
+			want: wantReduce{
+				aggregateType: org.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.org_member_scopes WHERE (org_id = $1) AND (user_id = $2) AND (instance_id = $3)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								"user-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceOrgRemoved",
+			args: args{
+				event: getEvent(testEvent(
+					org.OrgRemovedEventType,
+					org.AggregateType,
+					nil,
+				), org.OrgRemovedEventMapper),
+			},
+			reduce: (&orgMemberScopeProjection{}).reduceOrgRemoved,
+			want: wantReduce{
+				aggregateType: org.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.org_member_scopes WHERE (org_id = $1) AND (instance_id = $2)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceInstanceRemoved",
+			args: args{
+				event: getEvent(testEvent(
+					instance.InstanceRemovedEventType,
+					instance.AggregateType,
+					nil,
+				), instance.InstanceRemovedEventMapper),
+			},
+			reduce: reduceInstanceRemovedHelper(OrgMemberScopeInstanceIDCol),
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.org_member_scopes WHERE (instance_id = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if !zerrors.IsErrorInvalidArgument(err) {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, OrgMemberScopeProjectionTable, tt.want)
+		})
+	}
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
+	"github.com/muhlemmer/gu"
 	"golang.org/x/text/language"
 
 	"github.com/zitadel/zitadel/internal/database"
@@ -83,7 +85,7 @@ func TestOrgMemberProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "INSERT INTO projections.org_members4 (user_id, user_resource_owner, roles, creation_date, change_date, sequence, resource_owner, instance_id, org_id) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)",
+							expectedStmt: "INSERT INTO projections.org_members5 (user_id, user_resource_owner, roles, creation_date, change_date, sequence, resource_owner, instance_id, org_id) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)",
 							expectedArgs: []interface{}{
 								"user-id",
 								"org1",
@@ -147,7 +149,7 @@ func TestOrgMemberProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "INSERT INTO projections.org_members4 (user_id, user_resource_owner, roles, creation_date, change_date, sequence, resource_owner, instance_id, org_id) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)",
+							expectedStmt: "INSERT INTO projections.org_members5 (user_id, user_resource_owner, roles, creation_date, change_date, sequence, resource_owner, instance_id, org_id) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)",
 							expectedArgs: []interface{}{
 								"user-id",
 								"org1",
@@ -184,7 +186,7 @@ func TestOrgMemberProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "UPDATE projections.org_members4 SET (roles, change_date, sequence) = ($1, $2, $3) WHERE (instance_id = $4) AND (user_id = $5) AND (org_id = $6)",
+							expectedStmt: "UPDATE projections.org_members5 SET (roles, change_date, sequence) = ($1, $2, $3) WHERE (instance_id = $4) AND (user_id = $5) AND (org_id = $6)",
 							expectedArgs: []interface{}{
 								database.TextArray[string]{"role", "changed"},
 								anyArg{},
@@ -198,6 +200,72 @@ func TestOrgMemberProjection_reduces(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "org MemberValiditySetType",
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.MemberValiditySetEventType,
+						org.AggregateType,
+						[]byte(`{
+					"userId": "user-id",
+					"validUntil": "2024-01-01T00:00:00Z"
+				}`),
+					), org.MemberValiditySetEventMapper),
+			},
+			reduce: (&orgMemberProjection{}).reduceValiditySet,
+			want: wantReduce{
+				aggregateType: org.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.org_members5 SET (valid_from, valid_until, change_date, sequence) = ($1, $2, $3, $4) WHERE (instance_id = $5) AND (user_id = $6) AND (org_id = $7)",
+							expectedArgs: []interface{}{
+								(*time.Time)(nil),
+								gu.Ptr(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+								anyArg{},
+								uint64(15),
+								"instance-id",
+								"user-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "org MemberExpiredType",
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.MemberExpiredEventType,
+						org.AggregateType,
+						[]byte(`{
+					"userId": "user-id",
+					"validUntil": "2024-01-01T00:00:00Z"
+				}`),
+					), org.MemberExpiredEventMapper),
+			},
+			reduce: (&orgMemberProjection{}).reduceExpired,
+			want: wantReduce{
+				aggregateType: org.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.org_members5 WHERE (instance_id = $1) AND (user_id = $2) AND (org_id = $3)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"user-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "org MemberCascadeRemovedType",
 			args: args{
@@ -217,7 +285,7 @@ func TestOrgMemberProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "DELETE FROM projections.org_members4 WHERE (instance_id = $1) AND (user_id = $2) AND (org_id = $3)",
+							expectedStmt: "DELETE FROM projections.org_members5 WHERE (instance_id = $1) AND (user_id = $2) AND (org_id = $3)",
 							expectedArgs: []interface{}{
 								"instance-id",
 								"user-id",
@@ -247,7 +315,7 @@ func TestOrgMemberProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "DELETE FROM projections.org_members4 WHERE (instance_id = $1) AND (user_id = $2) AND (org_id = $3)",
+							expectedStmt: "DELETE FROM projections.org_members5 WHERE (instance_id = $1) AND (user_id = $2) AND (org_id = $3)",
 							expectedArgs: []interface{}{
 								"instance-id",
 								"user-id",
@@ -275,7 +343,7 @@ func TestOrgMemberProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "DELETE FROM projections.org_members4 WHERE (instance_id = $1) AND (user_id = $2)",
+							expectedStmt: "DELETE FROM projections.org_members5 WHERE (instance_id = $1) AND (user_id = $2)",
 							expectedArgs: []interface{}{
 								"instance-id",
 								"agg-id",
@@ -302,14 +370,14 @@ func TestOrgMemberProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "DELETE FROM projections.org_members4 WHERE (instance_id = $1) AND (resource_owner = $2)",
+							expectedStmt: "DELETE FROM projections.org_members5 WHERE (instance_id = $1) AND (resource_owner = $2)",
 							expectedArgs: []interface{}{
 								"instance-id",
 								"agg-id",
 							},
 						},
 						{
-							expectedStmt: "DELETE FROM projections.org_members4 WHERE (instance_id = $1) AND (user_resource_owner = $2)",
+							expectedStmt: "DELETE FROM projections.org_members5 WHERE (instance_id = $1) AND (user_resource_owner = $2)",
 							expectedArgs: []interface{}{
 								"instance-id",
 								"agg-id",
@@ -336,7 +404,7 @@ func TestOrgMemberProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "DELETE FROM projections.org_members4 WHERE (instance_id = $1)",
+							expectedStmt: "DELETE FROM projections.org_members5 WHERE (instance_id = $1)",
 							expectedArgs: []interface{}{
 								"agg-id",
 							},
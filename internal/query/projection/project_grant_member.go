@@ -15,7 +15,7 @@ import (
 )
 
 const (
-	ProjectGrantMemberProjectionTable = "projections.project_grant_members4"
+	ProjectGrantMemberProjectionTable = "projections.project_grant_members5"
 	ProjectGrantMemberProjectIDCol    = "project_id"
 	ProjectGrantMemberGrantIDCol      = "grant_id"
 	ProjectGrantMemberGrantedOrg      = "granted_org"
@@ -71,6 +71,14 @@ func (p *projectGrantMemberProjection) Reducers() []handler.AggregateReducer {
 					Event:  project.GrantMemberChangedType,
 					Reduce: p.reduceChanged,
 				},
+				{
+					Event:  project.GrantMemberValiditySetType,
+					Reduce: p.reduceValiditySet,
+				},
+				{
+					Event:  project.GrantMemberExpiredType,
+					Reduce: p.reduceExpired,
+				},
 				{
 					Event:  project.GrantMemberCascadeRemovedType,
 					Reduce: p.reduceCascadeRemoved,
@@ -156,6 +164,30 @@ func (p *projectGrantMemberProjection) reduceChanged(event eventstore.Event) (*h
 	)
 }
 
+func (p *projectGrantMemberProjection) reduceValiditySet(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*project.GrantMemberValiditySetEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Gv1ts", "reduce.wrong.event.type %s", project.GrantMemberValiditySetType)
+	}
+	return reduceMemberValiditySet(
+		*member.NewMemberValiditySetEvent(&e.BaseEvent, e.UserID, e.ValidFrom, e.ValidUntil),
+		withMemberCond(ProjectGrantMemberProjectIDCol, e.Aggregate().ID),
+		withMemberCond(ProjectGrantMemberGrantIDCol, e.GrantID),
+	)
+}
+
+func (p *projectGrantMemberProjection) reduceExpired(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*project.GrantMemberExpiredEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Ge2xp", "reduce.wrong.event.type %s", project.GrantMemberExpiredType)
+	}
+	return reduceMemberExpired(
+		*member.NewMemberExpiredEvent(&e.BaseEvent, e.UserID, e.ValidUntil),
+		withMemberCond(ProjectGrantMemberProjectIDCol, e.Aggregate().ID),
+		withMemberCond(ProjectGrantMemberGrantIDCol, e.GrantID),
+	)
+}
+
 func (p *projectGrantMemberProjection) reduceCascadeRemoved(event eventstore.Event) (*handler.Statement, error) {
 	e, ok := event.(*project.GrantMemberCascadeRemovedEvent)
 	if !ok {
@@ -15,7 +15,7 @@ import (
 )
 
 const (
-	ProjectMemberProjectionTable = "projections.project_members4"
+	ProjectMemberProjectionTable = "projections.project_members5"
 	ProjectMemberProjectIDCol    = "project_id"
 )
 
@@ -67,6 +67,14 @@ func (p *projectMemberProjection) Reducers() []handler.AggregateReducer {
 					Event:  project.MemberChangedType,
 					Reduce: p.reduceChanged,
 				},
+				{
+					Event:  project.MemberValiditySetType,
+					Reduce: p.reduceValiditySet,
+				},
+				{
+					Event:  project.MemberExpiredType,
+					Reduce: p.reduceExpired,
+				},
 				{
 					Event:  project.MemberCascadeRemovedType,
 					Reduce: p.reduceCascadeRemoved,
@@ -139,6 +147,28 @@ func (p *projectMemberProjection) reduceChanged(event eventstore.Event) (*handle
 	)
 }
 
+func (p *projectMemberProjection) reduceValiditySet(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*project.MemberValiditySetEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Pv1ts", "reduce.wrong.event.type %s", project.MemberValiditySetType)
+	}
+	return reduceMemberValiditySet(
+		e.MemberValiditySetEvent,
+		withMemberCond(ProjectMemberProjectIDCol, e.Aggregate().ID),
+	)
+}
+
+func (p *projectMemberProjection) reduceExpired(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*project.MemberExpiredEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Pe2xp", "reduce.wrong.event.type %s", project.MemberExpiredType)
+	}
+	return reduceMemberExpired(
+		e.MemberExpiredEvent,
+		withMemberCond(ProjectMemberProjectIDCol, e.Aggregate().ID),
+	)
+}
+
 func (p *projectMemberProjection) reduceCascadeRemoved(event eventstore.Event) (*handler.Statement, error) {
 	e, ok := event.(*project.MemberCascadeRemovedEvent)
 	if !ok {
@@ -0,0 +1,318 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/project"
+)
+
+const (
+	ProjectPermissionProjectionTable = "projections.project_permissions"
+	ProjectRoleInclusionTable        = ProjectPermissionProjectionTable + "_" + ProjectRoleInclusionSuffix
+
+	ProjectPermissionProjectIDCol     = "project_id"
+	ProjectPermissionKeyCol           = "permission_key"
+	ProjectPermissionCreationDateCol  = "creation_date"
+	ProjectPermissionChangeDateCol    = "change_date"
+	ProjectPermissionSequenceCol      = "sequence"
+	ProjectPermissionResourceOwnerCol = "resource_owner"
+	ProjectPermissionInstanceIDCol    = "instance_id"
+	ProjectPermissionDisplayNameCol   = "display_name"
+	ProjectPermissionRoleKeysCol      = "role_keys"
+
+	ProjectRoleInclusionSuffix           = "role_inclusions"
+	ProjectRoleInclusionInstanceIDCol    = "instance_id"
+	ProjectRoleInclusionProjectIDCol     = "project_id"
+	ProjectRoleInclusionRoleKeyCol       = "role_key"
+	ProjectRoleInclusionIncludedKeyCol   = "included_role_key"
+	ProjectRoleInclusionResourceOwnerCol = "resource_owner"
+)
+
+// projectPermissionProjection holds the permissions of the projects and the roles included in other roles,
+// which are needed to answer authorization checks for users of an application.
+type projectPermissionProjection struct{}
+
+func newProjectPermissionProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(projectPermissionProjection))
+}
+
+func (*projectPermissionProjection) Name() string {
+	return ProjectPermissionProjectionTable
+}
+
+func (*projectPermissionProjection) Init() *old_handler.Check {
+	return handler.NewMultiTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(ProjectPermissionProjectIDCol, handler.ColumnTypeText),
+			handler.NewColumn(ProjectPermissionKeyCol, handler.ColumnTypeText),
+			handler.NewColumn(ProjectPermissionCreationDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(ProjectPermissionChangeDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(ProjectPermissionSequenceCol, handler.ColumnTypeInt64),
+			handler.NewColumn(ProjectPermissionResourceOwnerCol, handler.ColumnTypeText),
+			handler.NewColumn(ProjectPermissionInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(ProjectPermissionDisplayNameCol, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(ProjectPermissionRoleKeysCol, handler.ColumnTypeTextArray, handler.Nullable()),
+		},
+			handler.NewPrimaryKey(ProjectPermissionInstanceIDCol, ProjectPermissionProjectIDCol, ProjectPermissionKeyCol),
+			handler.WithIndex(handler.NewIndex("resource_owner", []string{ProjectPermissionResourceOwnerCol})),
+		),
+		handler.NewSuffixedTable([]*handler.InitColumn{
+			handler.NewColumn(ProjectRoleInclusionInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(ProjectRoleInclusionProjectIDCol, handler.ColumnTypeText),
+			handler.NewColumn(ProjectRoleInclusionRoleKeyCol, handler.ColumnTypeText),
+			handler.NewColumn(ProjectRoleInclusionIncludedKeyCol, handler.ColumnTypeText),
+			handler.NewColumn(ProjectRoleInclusionResourceOwnerCol, handler.ColumnTypeText),
+		},
+			handler.NewPrimaryKey(ProjectRoleInclusionInstanceIDCol, ProjectRoleInclusionProjectIDCol, ProjectRoleInclusionRoleKeyCol, ProjectRoleInclusionIncludedKeyCol),
+			ProjectRoleInclusionSuffix,
+			handler.WithIndex(handler.NewIndex("resource_owner", []string{ProjectRoleInclusionResourceOwnerCol})),
+		),
+	)
+}
+
+func (p *projectPermissionProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: project.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  project.PermissionAddedType,
+					Reduce: p.reducePermissionAdded,
+				},
+				{
+					Event:  project.PermissionChangedType,
+					Reduce: p.reducePermissionChanged,
+				},
+				{
+					Event:  project.PermissionRemovedType,
+					Reduce: p.reducePermissionRemoved,
+				},
+				{
+					Event:  project.RoleInclusionsSetType,
+					Reduce: p.reduceRoleInclusionsSet,
+				},
+				{
+					Event:  project.RoleRemovedType,
+					Reduce: p.reduceRoleRemoved,
+				},
+				{
+					Event:  project.ProjectRemovedType,
+					Reduce: p.reduceProjectRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: p.reduceInstanceRemoved,
+				},
+			},
+		},
+	}
+}
+
+func (p *projectPermissionProjection) reducePermissionAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*project.PermissionAddedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewCreateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(ProjectPermissionProjectIDCol, e.Aggregate().ID),
+			handler.NewCol(ProjectPermissionKeyCol, e.Key),
+			handler.NewCol(ProjectPermissionCreationDateCol, e.CreatedAt()),
+			handler.NewCol(ProjectPermissionChangeDateCol, e.CreatedAt()),
+			handler.NewCol(ProjectPermissionSequenceCol, e.Sequence()),
+			handler.NewCol(ProjectPermissionResourceOwnerCol, e.Aggregate().ResourceOwner),
+			handler.NewCol(ProjectPermissionInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCol(ProjectPermissionDisplayNameCol, e.DisplayName),
+			handler.NewCol(ProjectPermissionRoleKeysCol, database.TextArray[string](e.RoleKeys)),
+		},
+	), nil
+}
+
+func (p *projectPermissionProjection) reducePermissionChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*project.PermissionChangedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(ProjectPermissionChangeDateCol, e.CreatedAt()),
+			handler.NewCol(ProjectPermissionSequenceCol, e.Sequence()),
+			handler.NewCol(ProjectPermissionDisplayNameCol, e.DisplayName),
+			handler.NewCol(ProjectPermissionRoleKeysCol, database.TextArray[string](e.RoleKeys)),
+		},
+		[]handler.Condition{
+			handler.NewCond(ProjectPermissionProjectIDCol, e.Aggregate().ID),
+			handler.NewCond(ProjectPermissionKeyCol, e.Key),
+			handler.NewCond(ProjectPermissionInstanceIDCol, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *projectPermissionProjection) reducePermissionRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*project.PermissionRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(ProjectPermissionProjectIDCol, e.Aggregate().ID),
+			handler.NewCond(ProjectPermissionKeyCol, e.Key),
+			handler.NewCond(ProjectPermissionInstanceIDCol, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *projectPermissionProjection) reduceRoleInclusionsSet(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*project.RoleInclusionsSetEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	stmts := make([]func(eventstore.Event) handler.Exec, 0, len(e.IncludedRoleKeys)+1)
+	stmts = append(stmts, handler.AddDeleteStatement(
+		[]handler.Condition{
+			handler.NewCond(ProjectRoleInclusionProjectIDCol, e.Aggregate().ID),
+			handler.NewCond(ProjectRoleInclusionRoleKeyCol, e.Key),
+			handler.NewCond(ProjectRoleInclusionInstanceIDCol, e.Aggregate().InstanceID),
+		},
+		handler.WithTableSuffix(ProjectRoleInclusionSuffix),
+	))
+	for _, included := range e.IncludedRoleKeys {
+		stmts = append(stmts, handler.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(ProjectRoleInclusionInstanceIDCol, e.Aggregate().InstanceID),
+				handler.NewCol(ProjectRoleInclusionProjectIDCol, e.Aggregate().ID),
+				handler.NewCol(ProjectRoleInclusionRoleKeyCol, e.Key),
+				handler.NewCol(ProjectRoleInclusionIncludedKeyCol, included),
+				handler.NewCol(ProjectRoleInclusionResourceOwnerCol, e.Aggregate().ResourceOwner),
+			},
+			handler.WithTableSuffix(ProjectRoleInclusionSuffix),
+		))
+	}
+	return handler.NewMultiStatement(e, stmts...), nil
+}
+
+// reduceRoleRemoved removes the role from the permissions and from the inclusions in both directions.
+func (p *projectPermissionProjection) reduceRoleRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*project.RoleRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewMultiStatement(
+		e,
+		handler.AddUpdateStatement(
+			[]handler.Column{
+				handler.NewArrayRemoveCol(ProjectPermissionRoleKeysCol, e.Key),
+			},
+			[]handler.Condition{
+				handler.NewCond(ProjectPermissionProjectIDCol, e.Aggregate().ID),
+				handler.NewCond(ProjectPermissionInstanceIDCol, e.Aggregate().InstanceID),
+			},
+		),
+		handler.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(ProjectRoleInclusionProjectIDCol, e.Aggregate().ID),
+				handler.NewCond(ProjectRoleInclusionRoleKeyCol, e.Key),
+				handler.NewCond(ProjectRoleInclusionInstanceIDCol, e.Aggregate().InstanceID),
+			},
+			handler.WithTableSuffix(ProjectRoleInclusionSuffix),
+		),
+		handler.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(ProjectRoleInclusionProjectIDCol, e.Aggregate().ID),
+				handler.NewCond(ProjectRoleInclusionIncludedKeyCol, e.Key),
+				handler.NewCond(ProjectRoleInclusionInstanceIDCol, e.Aggregate().InstanceID),
+			},
+			handler.WithTableSuffix(ProjectRoleInclusionSuffix),
+		),
+	), nil
+}
+
+func (p *projectPermissionProjection) reduceProjectRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*project.ProjectRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewMultiStatement(
+		e,
+		handler.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(ProjectPermissionProjectIDCol, e.Aggregate().ID),
+				handler.NewCond(ProjectPermissionInstanceIDCol, e.Aggregate().InstanceID),
+			},
+		),
+		handler.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(ProjectRoleInclusionProjectIDCol, e.Aggregate().ID),
+				handler.NewCond(ProjectRoleInclusionInstanceIDCol, e.Aggregate().InstanceID),
+			},
+			handler.WithTableSuffix(ProjectRoleInclusionSuffix),
+		),
+	), nil
+}
+
+func (p *projectPermissionProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.OrgRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewMultiStatement(
+		e,
+		handler.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(ProjectPermissionResourceOwnerCol, e.Aggregate().ID),
+				handler.NewCond(ProjectPermissionInstanceIDCol, e.Aggregate().InstanceID),
+			},
+		),
+		handler.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(ProjectRoleInclusionResourceOwnerCol, e.Aggregate().ID),
+				handler.NewCond(ProjectRoleInclusionInstanceIDCol, e.Aggregate().InstanceID),
+			},
+			handler.WithTableSuffix(ProjectRoleInclusionSuffix),
+		),
+	), nil
+}
+
+func (p *projectPermissionProjection) reduceInstanceRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*instance.InstanceRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewMultiStatement(
+		e,
+		handler.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(ProjectPermissionInstanceIDCol, e.Aggregate().ID),
+			},
+		),
+		handler.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(ProjectRoleInclusionInstanceIDCol, e.Aggregate().ID),
+			},
+			handler.WithTableSuffix(ProjectRoleInclusionSuffix),
+		),
+	), nil
+}
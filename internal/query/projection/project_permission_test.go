@@ -0,0 +1,317 @@
+package projection
+
+import (
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestProjectPermissionProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reducePermissionAdded",
+			args: args{
+				event: getEvent(testEvent(
+					project.PermissionAddedType,
+					project.AggregateType,
+					[]byte(`{
+						"key": "document.read",
+						"displayName": "Read documents",
+						"roleKeys": ["reader"]
+					}`),
+				), eventstore.GenericEventMapper[project.PermissionAddedEvent]),
+			},
+			reduce: (&projectPermissionProjection{}).reducePermissionAdded,
+			want: wantReduce{
+				aggregateType: project.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.project_permissions (project_id, permission_key, creation_date, change_date, sequence, resource_owner, instance_id, display_name, role_keys) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								"document.read",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"ro-id",
+								"instance-id",
+								"Read documents",
+								database.TextArray[string]{"reader"},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reducePermissionChanged",
+			args: args{
+				event: getEvent(testEvent(
+					project.PermissionChangedType,
+					project.AggregateType,
+					[]byte(`{
+						"key": "document.read",
+						"displayName": "Read documents",
+						"roleKeys": ["reader", "writer"]
+					}`),
+				), eventstore.GenericEventMapper[project.PermissionChangedEvent]),
+			},
+			reduce: (&projectPermissionProjection{}).reducePermissionChanged,
+			want: wantReduce{
+				aggregateType: project.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.project_permissions SET (change_date, sequence, display_name, role_keys) = ($1, $2, $3, $4) WHERE (project_id = $5) AND (permission_key = $6) AND (instance_id = $7)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								"Read documents",
+								database.TextArray[string]{"reader", "writer"},
+								"agg-id",
+								"document.read",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reducePermissionRemoved",
+			args: args{
+				event: getEvent(testEvent(
+					project.PermissionRemovedType,
+					project.AggregateType,
+					[]byte(`{"key": "document.read"}`),
+				), eventstore.GenericEventMapper[project.PermissionRemovedEvent]),
+			},
+			reduce: (&projectPermissionProjection{}).reducePermissionRemoved,
+			want: wantReduce{
+				aggregateType: project.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.project_permissions WHERE (project_id = $1) AND (permission_key = $2) AND (instance_id = $3)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								"document.read",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceRoleInclusionsSet",
+			args: args{
+				event: getEvent(testEvent(
+					project.RoleInclusionsSetType,
+					project.AggregateType,
+					[]byte(`{
+						"key": "writer",
+						"includedRoleKeys": ["reader"]
+					}`),
+				), eventstore.GenericEventMapper[project.RoleInclusionsSetEvent]),
+			},
+			reduce: (&projectPermissionProjection{}).reduceRoleInclusionsSet,
+			want: wantReduce{
+				aggregateType: project.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.project_permissions_role_inclusions WHERE (project_id = $1) AND (role_key = $2) AND (instance_id = $3)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								"writer",
+								"instance-id",
+							},
+						},
+						{
+							expectedStmt: "INSERT INTO projections.project_permissions_role_inclusions (instance_id, project_id, role_key, included_role_key, resource_owner) VALUES ($1, $2, $3, $4, $5)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+								"writer",
+								"reader",
+								"ro-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceRoleRemoved",
+			args: args{
+				event: getEvent(testEvent(
+					project.RoleRemovedType,
+					project.AggregateType,
+					[]byte(`{"key": "reader"}`),
+				), project.RoleRemovedEventMapper),
+			},
+			reduce: (&projectPermissionProjection{}).reduceRoleRemoved,
+			want: wantReduce{
+				aggregateType: project.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.project_permissions SET role_keys = array_remove(role_keys, $1) WHERE (project_id = $2) AND (instance_id = $3)",
+							expectedArgs: []interface{}{
+								"reader",
+								"agg-id",
+								"instance-id",
+							},
+						},
+						{
+							expectedStmt: "DELETE FROM projections.project_permissions_role_inclusions WHERE (project_id = $1) AND (role_key = $2) AND (instance_id = $3)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								"reader",
+								"instance-id",
+							},
+						},
+						{
+							expectedStmt: "DELETE FROM projections.project_permissions_role_inclusions WHERE (project_id = $1) AND (included_role_key = $2) AND (instance_id = $3)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								"reader",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceProjectRemoved",
+			args: args{
+				event: getEvent(testEvent(
+					project.ProjectRemovedType,
+					project.AggregateType,
+					[]byte(`{}`),
+				), project.ProjectRemovedEventMapper),
+			},
+			reduce: (&projectPermissionProjection{}).reduceProjectRemoved,
+			want: wantReduce{
+				aggregateType: project.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.project_permissions WHERE (project_id = $1) AND (instance_id = $2)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								"instance-id",
+							},
+						},
+						{
+							expectedStmt: "DELETE FROM projections.project_permissions_role_inclusions WHERE (project_id = $1) AND (instance_id = $2)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceOwnerRemoved",
+			args: args{
+				event: getEvent(testEvent(
+					org.OrgRemovedEventType,
+					org.AggregateType,
+					nil,
+				), org.OrgRemovedEventMapper),
+			},
+			reduce: (&projectPermissionProjection{}).reduceOwnerRemoved,
+			want: wantReduce{
+				aggregateType: org.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.project_permissions WHERE (resource_owner = $1) AND (instance_id = $2)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								"instance-id",
+							},
+						},
+						{
+							expectedStmt: "DELETE FROM projections.project_permissions_role_inclusions WHERE (resource_owner = $1) AND (instance_id = $2)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceInstanceRemoved",
+			args: args{
+				event: getEvent(testEvent(
+					instance.InstanceRemovedEventType,
+					instance.AggregateType,
+					nil,
+				), instance.InstanceRemovedEventMapper),
+			},
+			reduce: (&projectPermissionProjection{}).reduceInstanceRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.project_permissions WHERE (instance_id = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+						{
+							expectedStmt: "DELETE FROM projections.project_permissions_role_inclusions WHERE (instance_id = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if !zerrors.IsErrorInvalidArgument(err) {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, ProjectPermissionProjectionTable, tt.want)
+		})
+	}
+}
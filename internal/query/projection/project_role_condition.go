@@ -0,0 +1,191 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/project"
+)
+
+const (
+	ProjectRoleConditionProjectionTable = "projections.project_role_conditions"
+
+	ProjectRoleConditionProjectIDCol      = "project_id"
+	ProjectRoleConditionRoleKeyCol        = "role_key"
+	ProjectRoleConditionCreationDateCol   = "creation_date"
+	ProjectRoleConditionChangeDateCol     = "change_date"
+	ProjectRoleConditionSequenceCol       = "sequence"
+	ProjectRoleConditionResourceOwnerCol  = "resource_owner"
+	ProjectRoleConditionInstanceIDCol     = "instance_id"
+	ProjectRoleConditionOrgIDsCol         = "org_ids"
+	ProjectRoleConditionMFALevelCol       = "mfa_level"
+	ProjectRoleConditionAMRCol            = "amr"
+	ProjectRoleConditionIPRangesCol       = "ip_ranges"
+	ProjectRoleConditionTimeOfDayStartCol = "time_of_day_start"
+	ProjectRoleConditionTimeOfDayEndCol   = "time_of_day_end"
+	ProjectRoleConditionTimeZoneCol       = "time_zone"
+)
+
+// projectRoleConditionProjection holds the conditions which must be satisfied to assert project roles in tokens.
+type projectRoleConditionProjection struct{}
+
+func newProjectRoleConditionProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(projectRoleConditionProjection))
+}
+
+func (*projectRoleConditionProjection) Name() string {
+	return ProjectRoleConditionProjectionTable
+}
+
+func (*projectRoleConditionProjection) Init() *old_handler.Check {
+	return handler.NewTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(ProjectRoleConditionProjectIDCol, handler.ColumnTypeText),
+			handler.NewColumn(ProjectRoleConditionRoleKeyCol, handler.ColumnTypeText),
+			handler.NewColumn(ProjectRoleConditionCreationDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(ProjectRoleConditionChangeDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(ProjectRoleConditionSequenceCol, handler.ColumnTypeInt64),
+			handler.NewColumn(ProjectRoleConditionResourceOwnerCol, handler.ColumnTypeText),
+			handler.NewColumn(ProjectRoleConditionInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(ProjectRoleConditionOrgIDsCol, handler.ColumnTypeTextArray, handler.Nullable()),
+			handler.NewColumn(ProjectRoleConditionMFALevelCol, handler.ColumnTypeEnum, handler.Default(0)),
+			handler.NewColumn(ProjectRoleConditionAMRCol, handler.ColumnTypeTextArray, handler.Nullable()),
+			handler.NewColumn(ProjectRoleConditionIPRangesCol, handler.ColumnTypeTextArray, handler.Nullable()),
+			handler.NewColumn(ProjectRoleConditionTimeOfDayStartCol, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(ProjectRoleConditionTimeOfDayEndCol, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(ProjectRoleConditionTimeZoneCol, handler.ColumnTypeText, handler.Default("")),
+		},
+			handler.NewPrimaryKey(ProjectRoleConditionInstanceIDCol, ProjectRoleConditionProjectIDCol, ProjectRoleConditionRoleKeyCol),
+			handler.WithIndex(handler.NewIndex("resource_owner", []string{ProjectRoleConditionResourceOwnerCol})),
+		),
+	)
+}
+
+func (p *projectRoleConditionProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: project.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  project.RoleConditionSetType,
+					Reduce: p.reduceConditionSet,
+				},
+				{
+					Event:  project.RoleRemovedType,
+					Reduce: p.reduceRoleRemoved,
+				},
+				{
+					Event:  project.ProjectRemovedType,
+					Reduce: p.reduceProjectRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(ProjectRoleConditionInstanceIDCol),
+				},
+			},
+		},
+	}
+}
+
+func (p *projectRoleConditionProjection) reduceConditionSet(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*project.RoleConditionSetEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	if e.Condition().IsEmpty() {
+		return handler.NewDeleteStatement(
+			e,
+			[]handler.Condition{
+				handler.NewCond(ProjectRoleConditionProjectIDCol, e.Aggregate().ID),
+				handler.NewCond(ProjectRoleConditionRoleKeyCol, e.Key),
+				handler.NewCond(ProjectRoleConditionInstanceIDCol, e.Aggregate().InstanceID),
+			},
+		), nil
+	}
+	return handler.NewUpsertStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(ProjectRoleConditionInstanceIDCol, nil),
+			handler.NewCol(ProjectRoleConditionProjectIDCol, nil),
+			handler.NewCol(ProjectRoleConditionRoleKeyCol, nil),
+		},
+		[]handler.Column{
+			handler.NewCol(ProjectRoleConditionInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCol(ProjectRoleConditionProjectIDCol, e.Aggregate().ID),
+			handler.NewCol(ProjectRoleConditionRoleKeyCol, e.Key),
+			handler.NewCol(ProjectRoleConditionCreationDateCol, handler.OnlySetValueOnInsert(ProjectRoleConditionProjectionTable, e.CreatedAt())),
+			handler.NewCol(ProjectRoleConditionChangeDateCol, e.CreatedAt()),
+			handler.NewCol(ProjectRoleConditionSequenceCol, e.Sequence()),
+			handler.NewCol(ProjectRoleConditionResourceOwnerCol, e.Aggregate().ResourceOwner),
+			handler.NewCol(ProjectRoleConditionOrgIDsCol, database.TextArray[string](e.OrgIDs)),
+			handler.NewCol(ProjectRoleConditionMFALevelCol, e.MFALevel),
+			handler.NewCol(ProjectRoleConditionAMRCol, database.TextArray[string](e.AMR)),
+			handler.NewCol(ProjectRoleConditionIPRangesCol, database.TextArray[string](e.IPRanges)),
+			handler.NewCol(ProjectRoleConditionTimeOfDayStartCol, e.TimeOfDayStart),
+			handler.NewCol(ProjectRoleConditionTimeOfDayEndCol, e.TimeOfDayEnd),
+			handler.NewCol(ProjectRoleConditionTimeZoneCol, e.TimeZone),
+		},
+	), nil
+}
+
+func (p *projectRoleConditionProjection) reduceRoleRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*project.RoleRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(ProjectRoleConditionProjectIDCol, e.Aggregate().ID),
+			handler.NewCond(ProjectRoleConditionRoleKeyCol, e.Key),
+			handler.NewCond(ProjectRoleConditionInstanceIDCol, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *projectRoleConditionProjection) reduceProjectRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*project.ProjectRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(ProjectRoleConditionProjectIDCol, e.Aggregate().ID),
+			handler.NewCond(ProjectRoleConditionInstanceIDCol, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *projectRoleConditionProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.OrgRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(ProjectRoleConditionResourceOwnerCol, e.Aggregate().ID),
+			handler.NewCond(ProjectRoleConditionInstanceIDCol, e.Aggregate().InstanceID),
+		},
+	), nil
+}
@@ -0,0 +1,217 @@
+package projection
+
+import (
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestProjectRoleConditionProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceConditionSet",
+			args: args{
+				event: getEvent(testEvent(
+					project.RoleConditionSetType,
+					project.AggregateType,
+					[]byte(`{
+						"key": "admin",
+						"orgIds": ["org-id"],
+						"mfaLevel": 3,
+						"amr": ["user"],
+						"ipRanges": ["10.0.0.0/8"],
+						"timeOfDayStart": "08:00",
+						"timeOfDayEnd": "18:00",
+						"timeZone": "Europe/Zurich"
+					}`),
+				), eventstore.GenericEventMapper[project.RoleConditionSetEvent]),
+			},
+			reduce: (&projectRoleConditionProjection{}).reduceConditionSet,
+			want: wantReduce{
+				aggregateType: project.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.project_role_conditions (instance_id, project_id, role_key, creation_date, change_date, sequence, resource_owner, org_ids, mfa_level, amr, ip_ranges, time_of_day_start, time_of_day_end, time_zone) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14) ON CONFLICT (instance_id, project_id, role_key) DO UPDATE SET (creation_date, change_date, sequence, resource_owner, org_ids, mfa_level, amr, ip_ranges, time_of_day_start, time_of_day_end, time_zone) = (projections.project_role_conditions.creation_date, EXCLUDED.change_date, EXCLUDED.sequence, EXCLUDED.resource_owner, EXCLUDED.org_ids, EXCLUDED.mfa_level, EXCLUDED.amr, EXCLUDED.ip_ranges, EXCLUDED.time_of_day_start, EXCLUDED.time_of_day_end, EXCLUDED.time_zone)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+								"admin",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"ro-id",
+								database.TextArray[string]{"org-id"},
+								domain.MFALevelMultiFactorCertified,
+								database.TextArray[string]{"user"},
+								database.TextArray[string]{"10.0.0.0/8"},
+								"08:00",
+								"18:00",
+								"Europe/Zurich",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceConditionSet empty",
+			args: args{
+				event: getEvent(testEvent(
+					project.RoleConditionSetType,
+					project.AggregateType,
+					[]byte(`{"key": "admin"}`),
+				), eventstore.GenericEventMapper[project.RoleConditionSetEvent]),
+			},
+			reduce: (&projectRoleConditionProjection{}).reduceConditionSet,
+			want: wantReduce{
+				aggregateType: project.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.project_role_conditions WHERE (project_id = $1) AND (role_key = $2) AND (instance_id = $3)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								"admin",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceRoleRemoved",
+			args: args{
+				event: getEvent(testEvent(
+					project.RoleRemovedType,
+					project.AggregateType,
+					[]byte(`{"key": "admin"}`),
+				), project.RoleRemovedEventMapper),
+			},
+			reduce: (&projectRoleConditionProjection{}).reduceRoleRemoved,
+			want: wantReduce{
+				aggregateType: project.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.project_role_conditions WHERE (project_id = $1) AND (role_key = $2) AND (instance_id = $3)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								"admin",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceProjectRemoved",
+			args: args{
+				event: getEvent(testEvent(
+					project.ProjectRemovedType,
+					project.AggregateType,
+					[]byte(`{}`),
+				), project.ProjectRemovedEventMapper),
+			},
+			reduce: (&projectRoleConditionProjection{}).reduceProjectRemoved,
+			want: wantReduce{
+				aggregateType: project.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.project_role_conditions WHERE (project_id = $1) AND (instance_id = $2)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceOwnerRemoved",
+			args: args{
+				event: getEvent(testEvent(
+					org.OrgRemovedEventType,
+					org.AggregateType,
+					nil,
+				), org.OrgRemovedEventMapper),
+			},
+			reduce: (&projectRoleConditionProjection{}).reduceOwnerRemoved,
+			want: wantReduce{
+				aggregateType: org.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.project_role_conditions WHERE (resource_owner = $1) AND (instance_id = $2)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceInstanceRemoved",
+			args: args{
+				event: getEvent(testEvent(
+					instance.InstanceRemovedEventType,
+					instance.AggregateType,
+					nil,
+				), instance.InstanceRemovedEventMapper),
+			},
+			reduce: reduceInstanceRemovedHelper(ProjectRoleConditionInstanceIDCol),
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.project_role_conditions WHERE (instance_id = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if !zerrors.IsErrorInvalidArgument(err) {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, ProjectRoleConditionProjectionTable, tt.want)
+		})
+	}
+}
@@ -41,6 +41,7 @@ var (
 	MailTemplateProjection              *handler.Handler
 	MessageTextProjection               *handler.Handler
 	CustomTextProjection                *handler.Handler
+	MessageTemplateProjection           *handler.Handler
 	UserProjection                      *handler.Handler
 	LoginNameProjection                 *handler.Handler
 	OrgMemberProjection                 *handler.Handler
@@ -53,6 +54,8 @@ var (
 	UserGrantProjection                 *handler.Handler
 	UserMetadataProjection              *handler.Handler
 	UserAuthMethodProjection            *handler.Handler
+	UserActivityProjection              *handler.Handler
+	UserLifecyclePolicyProjection       *handler.Handler
 	InstanceProjection                  *handler.Handler
 	SecretGeneratorProjection           *handler.Handler
 	SMTPConfigProjection                *handler.Handler
@@ -77,6 +80,16 @@ var (
 	TargetProjection                    *handler.Handler
 	ExecutionProjection                 *handler.Handler
 	UserSchemaProjection                *handler.Handler
+	NotificationQueueProjection         *handler.Handler
+	NotificationDeliveryProjection      *handler.Handler
+	AdministratorRoleProjection         *handler.Handler
+	GroupProjection                     *handler.Handler
+	ProjectPermissionProjection         *handler.Handler
+	AccessRequestProjection             *handler.Handler
+	AccessReviewProjection              *handler.Handler
+	ProjectRoleConditionProjection      *handler.Handler
+	OrgMemberScopeProjection            *handler.Handler
+	BreakGlassSessionProjection         *handler.Handler
 
 	ProjectGrantFields      *handler.FieldHandler
 	OrgDomainVerifiedFields *handler.FieldHandler
@@ -128,6 +141,7 @@ func Create(ctx context.Context, sqlClient *database.DB, es handler.EventStore,
 	MailTemplateProjection = newMailTemplateProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["mail_templates"]))
 	MessageTextProjection = newMessageTextProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["message_texts"]))
 	CustomTextProjection = newCustomTextProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["custom_texts"]))
+	MessageTemplateProjection = newMessageTemplateProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["message_templates"]))
 	UserProjection = newUserProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["users"]))
 	LoginNameProjection = newLoginNameProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["login_names"]))
 	OrgMemberProjection = newOrgMemberProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["org_members"]))
@@ -140,6 +154,8 @@ func Create(ctx context.Context, sqlClient *database.DB, es handler.EventStore,
 	UserGrantProjection = newUserGrantProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["user_grants"]))
 	UserMetadataProjection = newUserMetadataProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["user_metadata"]))
 	UserAuthMethodProjection = newUserAuthMethodProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["user_auth_method"]))
+	UserActivityProjection = newUserActivityProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["user_activity"]))
+	UserLifecyclePolicyProjection = newUserLifecyclePolicyProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["user_lifecycle_policy"]))
 	InstanceProjection = newInstanceProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["instances"]))
 	SecretGeneratorProjection = newSecretGeneratorProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["secret_generators"]))
 	SMTPConfigProjection = newSMTPConfigProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["smtp_configs"]))
@@ -161,6 +177,16 @@ func Create(ctx context.Context, sqlClient *database.DB, es handler.EventStore,
 	TargetProjection = newTargetProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["targets"]))
 	ExecutionProjection = newExecutionProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["executions"]))
 	UserSchemaProjection = newUserSchemaProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["user_schemas"]))
+	NotificationQueueProjection = newNotificationQueueProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["notification_queue"]))
+	NotificationDeliveryProjection = newNotificationDeliveryProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["notification_deliveries"]))
+	AdministratorRoleProjection = newAdministratorRoleProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["administrator_roles"]))
+	GroupProjection = newGroupProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["groups"]))
+	ProjectPermissionProjection = newProjectPermissionProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["project_permissions"]))
+	AccessRequestProjection = newAccessRequestProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["access_requests"]))
+	AccessReviewProjection = newAccessReviewProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["access_reviews"]))
+	ProjectRoleConditionProjection = newProjectRoleConditionProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["project_role_conditions"]))
+	OrgMemberScopeProjection = newOrgMemberScopeProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["org_member_scopes"]))
+	BreakGlassSessionProjection = newBreakGlassSessionProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["break_glass_sessions"]))
 
 	ProjectGrantFields = newFillProjectGrantFields(applyCustomConfig(projectionConfig, config.Customizations[fieldsProjectGrant]))
 	OrgDomainVerifiedFields = newFillOrgDomainVerifiedFields(applyCustomConfig(projectionConfig, config.Customizations[fieldsOrgDomainVerified]))
@@ -256,6 +282,7 @@ func newProjectionsList() {
 		MailTemplateProjection,
 		MessageTextProjection,
 		CustomTextProjection,
+		MessageTemplateProjection,
 		UserProjection,
 		LoginNameProjection,
 		OrgMemberProjection,
@@ -268,6 +295,8 @@ func newProjectionsList() {
 		UserGrantProjection,
 		UserMetadataProjection,
 		UserAuthMethodProjection,
+		UserActivityProjection,
+		UserLifecyclePolicyProjection,
 		InstanceProjection,
 		SecretGeneratorProjection,
 		SMTPConfigProjection,
@@ -289,5 +318,15 @@ func newProjectionsList() {
 		TargetProjection,
 		ExecutionProjection,
 		UserSchemaProjection,
+		NotificationQueueProjection,
+		NotificationDeliveryProjection,
+		AdministratorRoleProjection,
+		GroupProjection,
+		ProjectPermissionProjection,
+		AccessRequestProjection,
+		AccessReviewProjection,
+		ProjectRoleConditionProjection,
+		OrgMemberScopeProjection,
+		BreakGlassSessionProjection,
 	}
 }
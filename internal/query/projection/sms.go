@@ -8,12 +8,14 @@ import (
 	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
 	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
 	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
 const (
-	SMSConfigProjectionTable = "projections.sms_configs2"
+	SMSConfigProjectionTable = "projections.sms_configs3"
 	SMSTwilioTable           = SMSConfigProjectionTable + "_" + smsTwilioTableSuffix
+	SMSHTTPTable             = SMSConfigProjectionTable + "_" + smsHTTPTableSuffix
 
 	SMSColumnID            = "id"
 	SMSColumnAggregateID   = "aggregate_id"
@@ -30,6 +32,13 @@ const (
 	SMSTwilioConfigColumnSID          = "sid"
 	SMSTwilioConfigColumnSenderNumber = "sender_number"
 	SMSTwilioConfigColumnToken        = "token"
+
+	smsHTTPTableSuffix            = "http"
+	SMSHTTPConfigColumnSMSID      = "sms_id"
+	SMSHTTPColumnInstanceID       = "instance_id"
+	SMSHTTPConfigColumnEndpoint   = "endpoint"
+	SMSHTTPConfigColumnHeaders    = "headers"
+	SMSHTTPConfigColumnSigningKey = "signing_key"
 )
 
 type smsConfigProjection struct{}
@@ -67,6 +76,17 @@ func (*smsConfigProjection) Init() *old_handler.Check {
 			smsTwilioTableSuffix,
 			handler.WithForeignKey(handler.NewForeignKeyOfPublicKeys()),
 		),
+		handler.NewSuffixedTable([]*handler.InitColumn{
+			handler.NewColumn(SMSHTTPConfigColumnSMSID, handler.ColumnTypeText),
+			handler.NewColumn(SMSHTTPColumnInstanceID, handler.ColumnTypeText),
+			handler.NewColumn(SMSHTTPConfigColumnEndpoint, handler.ColumnTypeText),
+			handler.NewColumn(SMSHTTPConfigColumnHeaders, handler.ColumnTypeJSONB, handler.Nullable()),
+			handler.NewColumn(SMSHTTPConfigColumnSigningKey, handler.ColumnTypeJSONB, handler.Nullable()),
+		},
+			handler.NewPrimaryKey(SMSHTTPColumnInstanceID, SMSHTTPConfigColumnSMSID),
+			smsHTTPTableSuffix,
+			handler.WithForeignKey(handler.NewForeignKeyOfPublicKeys()),
+		),
 	)
 }
 
@@ -87,6 +107,14 @@ func (p *smsConfigProjection) Reducers() []handler.AggregateReducer {
 					Event:  instance.SMSConfigTwilioTokenChangedEventType,
 					Reduce: p.reduceSMSConfigTwilioTokenChanged,
 				},
+				{
+					Event:  instance.SMSConfigHTTPAddedEventType,
+					Reduce: p.reduceSMSConfigHTTPAdded,
+				},
+				{
+					Event:  instance.SMSConfigHTTPChangedEventType,
+					Reduce: p.reduceSMSConfigHTTPChanged,
+				},
 				{
 					Event:  instance.SMSConfigActivatedEventType,
 					Reduce: p.reduceSMSConfigActivated,
@@ -105,13 +133,59 @@ func (p *smsConfigProjection) Reducers() []handler.AggregateReducer {
 				},
 			},
 		},
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.SMSConfigTwilioAddedEventType,
+					Reduce: p.reduceSMSConfigTwilioAdded,
+				},
+				{
+					Event:  org.SMSConfigTwilioChangedEventType,
+					Reduce: p.reduceSMSConfigTwilioChanged,
+				},
+				{
+					Event:  org.SMSConfigTwilioTokenChangedEventType,
+					Reduce: p.reduceSMSConfigTwilioTokenChanged,
+				},
+				{
+					Event:  org.SMSConfigHTTPAddedEventType,
+					Reduce: p.reduceSMSConfigHTTPAdded,
+				},
+				{
+					Event:  org.SMSConfigHTTPChangedEventType,
+					Reduce: p.reduceSMSConfigHTTPChanged,
+				},
+				{
+					Event:  org.SMSConfigActivatedEventType,
+					Reduce: p.reduceSMSConfigActivated,
+				},
+				{
+					Event:  org.SMSConfigDeactivatedEventType,
+					Reduce: p.reduceSMSConfigDeactivated,
+				},
+				{
+					Event:  org.SMSConfigRemovedEventType,
+					Reduce: p.reduceSMSConfigRemoved,
+				},
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
 	}
 }
 
 func (p *smsConfigProjection) reduceSMSConfigTwilioAdded(event eventstore.Event) (*handler.Statement, error) {
-	e, ok := event.(*instance.SMSConfigTwilioAddedEvent)
-	if !ok {
-		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-s8efs", "reduce.wrong.event.type %s", instance.SMSConfigTwilioAddedEventType)
+	var e *instance.SMSConfigTwilioAddedEvent
+	switch ev := event.(type) {
+	case *instance.SMSConfigTwilioAddedEvent:
+		e = ev
+	case *org.SMSConfigTwilioAddedEvent:
+		e = &ev.SMSConfigTwilioAddedEvent
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-s8efs", "reduce.wrong.event.type %v", []eventstore.EventType{instance.SMSConfigTwilioAddedEventType, org.SMSConfigTwilioAddedEventType})
 	}
 
 	return handler.NewMultiStatement(
@@ -142,9 +216,14 @@ func (p *smsConfigProjection) reduceSMSConfigTwilioAdded(event eventstore.Event)
 }
 
 func (p *smsConfigProjection) reduceSMSConfigTwilioChanged(event eventstore.Event) (*handler.Statement, error) {
-	e, ok := event.(*instance.SMSConfigTwilioChangedEvent)
-	if !ok {
-		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-fi99F", "reduce.wrong.event.type %s", instance.SMSConfigTwilioChangedEventType)
+	var e *instance.SMSConfigTwilioChangedEvent
+	switch ev := event.(type) {
+	case *instance.SMSConfigTwilioChangedEvent:
+		e = ev
+	case *org.SMSConfigTwilioChangedEvent:
+		e = &ev.SMSConfigTwilioChangedEvent
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-fi99F", "reduce.wrong.event.type %v", []eventstore.EventType{instance.SMSConfigTwilioChangedEventType, org.SMSConfigTwilioChangedEventType})
 	}
 	columns := make([]handler.Column, 0)
 	if e.SID != nil {
@@ -178,9 +257,14 @@ func (p *smsConfigProjection) reduceSMSConfigTwilioChanged(event eventstore.Even
 }
 
 func (p *smsConfigProjection) reduceSMSConfigTwilioTokenChanged(event eventstore.Event) (*handler.Statement, error) {
-	e, ok := event.(*instance.SMSConfigTwilioTokenChangedEvent)
-	if !ok {
-		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-fi99F", "reduce.wrong.event.type %s", instance.SMSConfigTwilioTokenChangedEventType)
+	var e *instance.SMSConfigTwilioTokenChangedEvent
+	switch ev := event.(type) {
+	case *instance.SMSConfigTwilioTokenChangedEvent:
+		e = ev
+	case *org.SMSConfigTwilioTokenChangedEvent:
+		e = &ev.SMSConfigTwilioTokenChangedEvent
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-fi99F", "reduce.wrong.event.type %v", []eventstore.EventType{instance.SMSConfigTwilioTokenChangedEventType, org.SMSConfigTwilioTokenChangedEventType})
 	}
 	columns := make([]handler.Column, 0)
 	if e.Token != nil {
@@ -210,10 +294,97 @@ func (p *smsConfigProjection) reduceSMSConfigTwilioTokenChanged(event eventstore
 	), nil
 }
 
+func (p *smsConfigProjection) reduceSMSConfigHTTPAdded(event eventstore.Event) (*handler.Statement, error) {
+	var e *instance.SMSConfigHTTPAddedEvent
+	switch ev := event.(type) {
+	case *instance.SMSConfigHTTPAddedEvent:
+		e = ev
+	case *org.SMSConfigHTTPAddedEvent:
+		e = &ev.SMSConfigHTTPAddedEvent
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Hq2ds", "reduce.wrong.event.type %v", []eventstore.EventType{instance.SMSConfigHTTPAddedEventType, org.SMSConfigHTTPAddedEventType})
+	}
+
+	return handler.NewMultiStatement(
+		e,
+		handler.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(SMSColumnID, e.ID),
+				handler.NewCol(SMSColumnAggregateID, e.Aggregate().ID),
+				handler.NewCol(SMSColumnCreationDate, e.CreationDate()),
+				handler.NewCol(SMSColumnChangeDate, e.CreationDate()),
+				handler.NewCol(SMSColumnResourceOwner, e.Aggregate().ResourceOwner),
+				handler.NewCol(SMSColumnInstanceID, e.Aggregate().InstanceID),
+				handler.NewCol(SMSColumnState, domain.SMSConfigStateInactive),
+				handler.NewCol(SMSColumnSequence, e.Sequence()),
+			},
+		),
+		handler.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(SMSHTTPConfigColumnSMSID, e.ID),
+				handler.NewCol(SMSHTTPColumnInstanceID, e.Aggregate().InstanceID),
+				handler.NewCol(SMSHTTPConfigColumnEndpoint, e.Endpoint),
+				handler.NewCol(SMSHTTPConfigColumnHeaders, e.Headers),
+				handler.NewCol(SMSHTTPConfigColumnSigningKey, e.SigningKey),
+			},
+			handler.WithTableSuffix(smsHTTPTableSuffix),
+		),
+	), nil
+}
+
+func (p *smsConfigProjection) reduceSMSConfigHTTPChanged(event eventstore.Event) (*handler.Statement, error) {
+	var e *instance.SMSConfigHTTPChangedEvent
+	switch ev := event.(type) {
+	case *instance.SMSConfigHTTPChangedEvent:
+		e = ev
+	case *org.SMSConfigHTTPChangedEvent:
+		e = &ev.SMSConfigHTTPChangedEvent
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Jr8cs", "reduce.wrong.event.type %v", []eventstore.EventType{instance.SMSConfigHTTPChangedEventType, org.SMSConfigHTTPChangedEventType})
+	}
+	columns := make([]handler.Column, 0)
+	if e.Endpoint != nil {
+		columns = append(columns, handler.NewCol(SMSHTTPConfigColumnEndpoint, *e.Endpoint))
+	}
+	if e.Headers != nil {
+		columns = append(columns, handler.NewCol(SMSHTTPConfigColumnHeaders, e.Headers))
+	}
+	if e.SigningKey != nil {
+		columns = append(columns, handler.NewCol(SMSHTTPConfigColumnSigningKey, e.SigningKey))
+	}
+
+	return handler.NewMultiStatement(
+		e,
+		handler.AddUpdateStatement(
+			columns,
+			[]handler.Condition{
+				handler.NewCond(SMSHTTPConfigColumnSMSID, e.ID),
+				handler.NewCond(SMSHTTPColumnInstanceID, e.Aggregate().InstanceID),
+			},
+			handler.WithTableSuffix(smsHTTPTableSuffix),
+		),
+		handler.AddUpdateStatement(
+			[]handler.Column{
+				handler.NewCol(SMSColumnChangeDate, e.CreationDate()),
+				handler.NewCol(SMSColumnSequence, e.Sequence()),
+			},
+			[]handler.Condition{
+				handler.NewCond(SMSColumnID, e.ID),
+				handler.NewCond(SMSColumnInstanceID, e.Aggregate().InstanceID),
+			},
+		),
+	), nil
+}
+
 func (p *smsConfigProjection) reduceSMSConfigActivated(event eventstore.Event) (*handler.Statement, error) {
-	e, ok := event.(*instance.SMSConfigActivatedEvent)
-	if !ok {
-		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-fj9Ef", "reduce.wrong.event.type %s", instance.SMSConfigActivatedEventType)
+	var e *instance.SMSConfigActivatedEvent
+	switch ev := event.(type) {
+	case *instance.SMSConfigActivatedEvent:
+		e = ev
+	case *org.SMSConfigActivatedEvent:
+		e = &ev.SMSConfigActivatedEvent
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-fj9Ef", "reduce.wrong.event.type %v", []eventstore.EventType{instance.SMSConfigActivatedEventType, org.SMSConfigActivatedEventType})
 	}
 	return handler.NewUpdateStatement(
 		e,
@@ -230,9 +401,14 @@ func (p *smsConfigProjection) reduceSMSConfigActivated(event eventstore.Event) (
 }
 
 func (p *smsConfigProjection) reduceSMSConfigDeactivated(event eventstore.Event) (*handler.Statement, error) {
-	e, ok := event.(*instance.SMSConfigDeactivatedEvent)
-	if !ok {
-		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-dj9Js", "reduce.wrong.event.type %s", instance.SMSConfigDeactivatedEventType)
+	var e *instance.SMSConfigDeactivatedEvent
+	switch ev := event.(type) {
+	case *instance.SMSConfigDeactivatedEvent:
+		e = ev
+	case *org.SMSConfigDeactivatedEvent:
+		e = &ev.SMSConfigDeactivatedEvent
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-dj9Js", "reduce.wrong.event.type %v", []eventstore.EventType{instance.SMSConfigDeactivatedEventType, org.SMSConfigDeactivatedEventType})
 	}
 	return handler.NewUpdateStatement(
 		e,
@@ -249,9 +425,14 @@ func (p *smsConfigProjection) reduceSMSConfigDeactivated(event eventstore.Event)
 }
 
 func (p *smsConfigProjection) reduceSMSConfigRemoved(event eventstore.Event) (*handler.Statement, error) {
-	e, ok := event.(*instance.SMSConfigRemovedEvent)
-	if !ok {
-		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-s9JJf", "reduce.wrong.event.type %s", instance.SMSConfigRemovedEventType)
+	var e *instance.SMSConfigRemovedEvent
+	switch ev := event.(type) {
+	case *instance.SMSConfigRemovedEvent:
+		e = ev
+	case *org.SMSConfigRemovedEvent:
+		e = &ev.SMSConfigRemovedEvent
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-s9JJf", "reduce.wrong.event.type %v", []eventstore.EventType{instance.SMSConfigRemovedEventType, org.SMSConfigRemovedEventType})
 	}
 	return handler.NewDeleteStatement(
 		e,
@@ -261,3 +442,18 @@ func (p *smsConfigProjection) reduceSMSConfigRemoved(event eventstore.Event) (*h
 		},
 	), nil
 }
+
+func (p *smsConfigProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.OrgRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(SMSColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(SMSColumnResourceOwner, e.Aggregate().ID),
+		},
+	), nil
+}
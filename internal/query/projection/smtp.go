@@ -3,16 +3,19 @@ package projection
 import (
 	"context"
 
+	"github.com/zitadel/zitadel/internal/database"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
 	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
 	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
 const (
-	SMTPConfigProjectionTable      = "projections.smtp_configs2"
+	SMTPConfigProjectionTable      = "projections.smtp_configs4"
+	SMTPConfigHTTPTable            = SMTPConfigProjectionTable + "_" + smtpHTTPTableSuffix
 	SMTPConfigColumnInstanceID     = "instance_id"
 	SMTPConfigColumnResourceOwner  = "resource_owner"
 	SMTPConfigColumnID             = "id"
@@ -28,6 +31,18 @@ const (
 	SMTPConfigColumnSMTPPassword   = "password"
 	SMTPConfigColumnState          = "state"
 	SMTPConfigColumnDescription    = "description"
+	SMTPConfigColumnDKIMDomain     = "dkim_domain"
+	SMTPConfigColumnDKIMSelector   = "dkim_selector"
+	SMTPConfigColumnDKIMPrivateKey = "dkim_private_key"
+	SMTPConfigColumnHeaders        = "headers"
+
+	smtpHTTPTableSuffix               = "http"
+	SMTPConfigHTTPColumnSMTPID        = "smtp_id"
+	SMTPConfigHTTPColumnInstanceID    = "instance_id"
+	SMTPConfigHTTPColumnResourceOwner = "resource_owner"
+	SMTPConfigHTTPColumnEndpoint      = "endpoint"
+	SMTPConfigHTTPColumnHeaders       = "headers"
+	SMTPConfigHTTPColumnSigningKey    = "signing_key"
 )
 
 type smtpConfigProjection struct{}
@@ -41,7 +56,7 @@ func (*smtpConfigProjection) Name() string {
 }
 
 func (*smtpConfigProjection) Init() *old_handler.Check {
-	return handler.NewTableCheck(
+	return handler.NewMultiTableCheck(
 		handler.NewTable([]*handler.InitColumn{
 			handler.NewColumn(SMTPConfigColumnID, handler.ColumnTypeText),
 			handler.NewColumn(SMTPConfigColumnCreationDate, handler.ColumnTypeTimestamp),
@@ -58,9 +73,25 @@ func (*smtpConfigProjection) Init() *old_handler.Check {
 			handler.NewColumn(SMTPConfigColumnSMTPPassword, handler.ColumnTypeJSONB, handler.Nullable()),
 			handler.NewColumn(SMTPConfigColumnState, handler.ColumnTypeEnum),
 			handler.NewColumn(SMTPConfigColumnDescription, handler.ColumnTypeText),
+			handler.NewColumn(SMTPConfigColumnDKIMDomain, handler.ColumnTypeText, handler.Nullable()),
+			handler.NewColumn(SMTPConfigColumnDKIMSelector, handler.ColumnTypeText, handler.Nullable()),
+			handler.NewColumn(SMTPConfigColumnDKIMPrivateKey, handler.ColumnTypeJSONB, handler.Nullable()),
+			handler.NewColumn(SMTPConfigColumnHeaders, handler.ColumnTypeJSONB, handler.Nullable()),
 		},
 			handler.NewPrimaryKey(SMTPConfigColumnInstanceID, SMTPConfigColumnResourceOwner, SMTPConfigColumnID),
 		),
+		handler.NewSuffixedTable([]*handler.InitColumn{
+			handler.NewColumn(SMTPConfigHTTPColumnSMTPID, handler.ColumnTypeText),
+			handler.NewColumn(SMTPConfigHTTPColumnInstanceID, handler.ColumnTypeText),
+			handler.NewColumn(SMTPConfigHTTPColumnResourceOwner, handler.ColumnTypeText),
+			handler.NewColumn(SMTPConfigHTTPColumnEndpoint, handler.ColumnTypeText),
+			handler.NewColumn(SMTPConfigHTTPColumnHeaders, handler.ColumnTypeJSONB, handler.Nullable()),
+			handler.NewColumn(SMTPConfigHTTPColumnSigningKey, handler.ColumnTypeJSONB, handler.Nullable()),
+		},
+			handler.NewPrimaryKey(SMTPConfigHTTPColumnInstanceID, SMTPConfigHTTPColumnResourceOwner, SMTPConfigHTTPColumnSMTPID),
+			smtpHTTPTableSuffix,
+			handler.WithForeignKey(handler.NewForeignKeyOfPublicKeys()),
+		),
 	)
 }
 
@@ -77,10 +108,30 @@ func (p *smtpConfigProjection) Reducers() []handler.AggregateReducer {
 					Event:  instance.SMTPConfigChangedEventType,
 					Reduce: p.reduceSMTPConfigChanged,
 				},
+				{
+					Event:  instance.SMTPConfigHTTPAddedEventType,
+					Reduce: p.reduceSMTPConfigHTTPAdded,
+				},
+				{
+					Event:  instance.SMTPConfigHTTPChangedEventType,
+					Reduce: p.reduceSMTPConfigHTTPChanged,
+				},
 				{
 					Event:  instance.SMTPConfigPasswordChangedEventType,
 					Reduce: p.reduceSMTPConfigPasswordChanged,
 				},
+				{
+					Event:  instance.SMTPConfigDKIMSetEventType,
+					Reduce: p.reduceSMTPConfigDKIMSet,
+				},
+				{
+					Event:  instance.SMTPConfigDKIMRemovedEventType,
+					Reduce: p.reduceSMTPConfigDKIMRemoved,
+				},
+				{
+					Event:  instance.SMTPConfigHeadersSetEventType,
+					Reduce: p.reduceSMTPConfigHeadersSet,
+				},
 				{
 					Event:  instance.SMTPConfigActivatedEventType,
 					Reduce: p.reduceSMTPConfigActivated,
@@ -99,13 +150,71 @@ func (p *smtpConfigProjection) Reducers() []handler.AggregateReducer {
 				},
 			},
 		},
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.SMTPConfigAddedEventType,
+					Reduce: p.reduceSMTPConfigAdded,
+				},
+				{
+					Event:  org.SMTPConfigChangedEventType,
+					Reduce: p.reduceSMTPConfigChanged,
+				},
+				{
+					Event:  org.SMTPConfigHTTPAddedEventType,
+					Reduce: p.reduceSMTPConfigHTTPAdded,
+				},
+				{
+					Event:  org.SMTPConfigHTTPChangedEventType,
+					Reduce: p.reduceSMTPConfigHTTPChanged,
+				},
+				{
+					Event:  org.SMTPConfigPasswordChangedEventType,
+					Reduce: p.reduceSMTPConfigPasswordChanged,
+				},
+				{
+					Event:  org.SMTPConfigDKIMSetEventType,
+					Reduce: p.reduceSMTPConfigDKIMSet,
+				},
+				{
+					Event:  org.SMTPConfigDKIMRemovedEventType,
+					Reduce: p.reduceSMTPConfigDKIMRemoved,
+				},
+				{
+					Event:  org.SMTPConfigHeadersSetEventType,
+					Reduce: p.reduceSMTPConfigHeadersSet,
+				},
+				{
+					Event:  org.SMTPConfigActivatedEventType,
+					Reduce: p.reduceSMTPConfigActivated,
+				},
+				{
+					Event:  org.SMTPConfigDeactivatedEventType,
+					Reduce: p.reduceSMTPConfigDeactivated,
+				},
+				{
+					Event:  org.SMTPConfigRemovedEventType,
+					Reduce: p.reduceSMTPConfigRemoved,
+				},
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
 	}
 }
 
 func (p *smtpConfigProjection) reduceSMTPConfigAdded(event eventstore.Event) (*handler.Statement, error) {
-	e, ok := event.(*instance.SMTPConfigAddedEvent)
-	if !ok {
-		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-sk99F", "reduce.wrong.event.type %s", instance.SMTPConfigAddedEventType)
+	var e *instance.SMTPConfigAddedEvent
+	switch ev := event.(type) {
+	case *instance.SMTPConfigAddedEvent:
+		e = ev
+	case *org.SMTPConfigAddedEvent:
+		e = &ev.SMTPConfigAddedEvent
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-sk99F", "reduce.wrong.event.type %v", []eventstore.EventType{instance.SMTPConfigAddedEventType, org.SMTPConfigAddedEventType})
 	}
 
 	// Deal with old and unique SMTP settings (empty ID)
@@ -141,9 +250,14 @@ func (p *smtpConfigProjection) reduceSMTPConfigAdded(event eventstore.Event) (*h
 }
 
 func (p *smtpConfigProjection) reduceSMTPConfigChanged(event eventstore.Event) (*handler.Statement, error) {
-	e, ok := event.(*instance.SMTPConfigChangedEvent)
-	if !ok {
-		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-wl0wd", "reduce.wrong.event.type %s", instance.SMTPConfigChangedEventType)
+	var e *instance.SMTPConfigChangedEvent
+	switch ev := event.(type) {
+	case *instance.SMTPConfigChangedEvent:
+		e = ev
+	case *org.SMTPConfigChangedEvent:
+		e = &ev.SMTPConfigChangedEvent
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-wl0wd", "reduce.wrong.event.type %v", []eventstore.EventType{instance.SMTPConfigChangedEventType, org.SMTPConfigChangedEventType})
 	}
 
 	columns := make([]handler.Column, 0, 8)
@@ -191,10 +305,113 @@ func (p *smtpConfigProjection) reduceSMTPConfigChanged(event eventstore.Event) (
 	), nil
 }
 
+func (p *smtpConfigProjection) reduceSMTPConfigHTTPAdded(event eventstore.Event) (*handler.Statement, error) {
+	var e *instance.SMTPConfigHTTPAddedEvent
+	switch ev := event.(type) {
+	case *instance.SMTPConfigHTTPAddedEvent:
+		e = ev
+	case *org.SMTPConfigHTTPAddedEvent:
+		e = &ev.SMTPConfigHTTPAddedEvent
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Ks8rm", "reduce.wrong.event.type %v", []eventstore.EventType{instance.SMTPConfigHTTPAddedEventType, org.SMTPConfigHTTPAddedEventType})
+	}
+
+	return handler.NewMultiStatement(
+		e,
+		handler.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(SMTPConfigColumnCreationDate, e.CreationDate()),
+				handler.NewCol(SMTPConfigColumnChangeDate, e.CreationDate()),
+				handler.NewCol(SMTPConfigColumnResourceOwner, e.Aggregate().ResourceOwner),
+				handler.NewCol(SMTPConfigColumnInstanceID, e.Aggregate().InstanceID),
+				handler.NewCol(SMTPConfigColumnSequence, e.Sequence()),
+				handler.NewCol(SMTPConfigColumnID, e.ID),
+				handler.NewCol(SMTPConfigColumnTLS, false),
+				handler.NewCol(SMTPConfigColumnSenderAddress, ""),
+				handler.NewCol(SMTPConfigColumnSenderName, ""),
+				handler.NewCol(SMTPConfigColumnReplyToAddress, ""),
+				handler.NewCol(SMTPConfigColumnSMTPHost, ""),
+				handler.NewCol(SMTPConfigColumnSMTPUser, ""),
+				handler.NewCol(SMTPConfigColumnState, domain.SMTPConfigStateInactive),
+				handler.NewCol(SMTPConfigColumnDescription, e.Description),
+			},
+		),
+		handler.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(SMTPConfigHTTPColumnSMTPID, e.ID),
+				handler.NewCol(SMTPConfigHTTPColumnInstanceID, e.Aggregate().InstanceID),
+				handler.NewCol(SMTPConfigHTTPColumnResourceOwner, e.Aggregate().ResourceOwner),
+				handler.NewCol(SMTPConfigHTTPColumnEndpoint, e.Endpoint),
+				handler.NewCol(SMTPConfigHTTPColumnHeaders, e.Headers),
+				handler.NewCol(SMTPConfigHTTPColumnSigningKey, e.SigningKey),
+			},
+			handler.WithTableSuffix(smtpHTTPTableSuffix),
+		),
+	), nil
+}
+
+func (p *smtpConfigProjection) reduceSMTPConfigHTTPChanged(event eventstore.Event) (*handler.Statement, error) {
+	var e *instance.SMTPConfigHTTPChangedEvent
+	switch ev := event.(type) {
+	case *instance.SMTPConfigHTTPChangedEvent:
+		e = ev
+	case *org.SMTPConfigHTTPChangedEvent:
+		e = &ev.SMTPConfigHTTPChangedEvent
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Pw3vn", "reduce.wrong.event.type %v", []eventstore.EventType{instance.SMTPConfigHTTPChangedEventType, org.SMTPConfigHTTPChangedEventType})
+	}
+
+	columns := []handler.Column{
+		handler.NewCol(SMTPConfigColumnChangeDate, e.CreationDate()),
+		handler.NewCol(SMTPConfigColumnSequence, e.Sequence()),
+	}
+	if e.Description != nil {
+		columns = append(columns, handler.NewCol(SMTPConfigColumnDescription, *e.Description))
+	}
+	stmts := []func(eventstore.Event) handler.Exec{
+		handler.AddUpdateStatement(
+			columns,
+			[]handler.Condition{
+				handler.NewCond(SMTPConfigColumnID, e.ID),
+				handler.NewCond(SMTPConfigColumnResourceOwner, e.Aggregate().ResourceOwner),
+				handler.NewCond(SMTPConfigColumnInstanceID, e.Aggregate().InstanceID),
+			},
+		),
+	}
+
+	httpColumns := make([]handler.Column, 0, 3)
+	if e.Endpoint != nil {
+		httpColumns = append(httpColumns, handler.NewCol(SMTPConfigHTTPColumnEndpoint, *e.Endpoint))
+	}
+	if e.Headers != nil {
+		httpColumns = append(httpColumns, handler.NewCol(SMTPConfigHTTPColumnHeaders, e.Headers))
+	}
+	if e.SigningKey != nil {
+		httpColumns = append(httpColumns, handler.NewCol(SMTPConfigHTTPColumnSigningKey, e.SigningKey))
+	}
+	if len(httpColumns) > 0 {
+		stmts = append(stmts, handler.AddUpdateStatement(
+			httpColumns,
+			[]handler.Condition{
+				handler.NewCond(SMTPConfigHTTPColumnSMTPID, e.ID),
+				handler.NewCond(SMTPConfigHTTPColumnResourceOwner, e.Aggregate().ResourceOwner),
+				handler.NewCond(SMTPConfigHTTPColumnInstanceID, e.Aggregate().InstanceID),
+			},
+			handler.WithTableSuffix(smtpHTTPTableSuffix),
+		))
+	}
+	return handler.NewMultiStatement(e, stmts...), nil
+}
+
 func (p *smtpConfigProjection) reduceSMTPConfigPasswordChanged(event eventstore.Event) (*handler.Statement, error) {
-	e, ok := event.(*instance.SMTPConfigPasswordChangedEvent)
-	if !ok {
-		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-fk02f", "reduce.wrong.event.type %s", instance.SMTPConfigChangedEventType)
+	var e *instance.SMTPConfigPasswordChangedEvent
+	switch ev := event.(type) {
+	case *instance.SMTPConfigPasswordChangedEvent:
+		e = ev
+	case *org.SMTPConfigPasswordChangedEvent:
+		e = &ev.SMTPConfigPasswordChangedEvent
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-fk02f", "reduce.wrong.event.type %v", []eventstore.EventType{instance.SMTPConfigPasswordChangedEventType, org.SMTPConfigPasswordChangedEventType})
 	}
 
 	// Deal with old and unique SMTP settings (empty ID)
@@ -218,10 +435,105 @@ func (p *smtpConfigProjection) reduceSMTPConfigPasswordChanged(event eventstore.
 	), nil
 }
 
+func (p *smtpConfigProjection) reduceSMTPConfigDKIMSet(event eventstore.Event) (*handler.Statement, error) {
+	var e *instance.SMTPConfigDKIMSetEvent
+	switch ev := event.(type) {
+	case *instance.SMTPConfigDKIMSetEvent:
+		e = ev
+	case *org.SMTPConfigDKIMSetEvent:
+		e = &ev.SMTPConfigDKIMSetEvent
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Dk3ps", "reduce.wrong.event.type %v", []eventstore.EventType{instance.SMTPConfigDKIMSetEventType, org.SMTPConfigDKIMSetEventType})
+	}
+
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(SMTPConfigColumnChangeDate, e.CreationDate()),
+			handler.NewCol(SMTPConfigColumnSequence, e.Sequence()),
+			handler.NewCol(SMTPConfigColumnDKIMDomain, e.Domain),
+			handler.NewCol(SMTPConfigColumnDKIMSelector, e.Selector),
+			handler.NewCol(SMTPConfigColumnDKIMPrivateKey, e.PrivateKey),
+		},
+		[]handler.Condition{
+			handler.NewCond(SMTPConfigColumnID, smtpConfigID(e.ID, e.Aggregate())),
+			handler.NewCond(SMTPConfigColumnResourceOwner, e.Aggregate().ResourceOwner),
+			handler.NewCond(SMTPConfigColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *smtpConfigProjection) reduceSMTPConfigDKIMRemoved(event eventstore.Event) (*handler.Statement, error) {
+	var e *instance.SMTPConfigDKIMRemovedEvent
+	switch ev := event.(type) {
+	case *instance.SMTPConfigDKIMRemovedEvent:
+		e = ev
+	case *org.SMTPConfigDKIMRemovedEvent:
+		e = &ev.SMTPConfigDKIMRemovedEvent
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Dk4rm", "reduce.wrong.event.type %v", []eventstore.EventType{instance.SMTPConfigDKIMRemovedEventType, org.SMTPConfigDKIMRemovedEventType})
+	}
+
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(SMTPConfigColumnChangeDate, e.CreationDate()),
+			handler.NewCol(SMTPConfigColumnSequence, e.Sequence()),
+			handler.NewCol(SMTPConfigColumnDKIMDomain, nil),
+			handler.NewCol(SMTPConfigColumnDKIMSelector, nil),
+			handler.NewCol(SMTPConfigColumnDKIMPrivateKey, nil),
+		},
+		[]handler.Condition{
+			handler.NewCond(SMTPConfigColumnID, smtpConfigID(e.ID, e.Aggregate())),
+			handler.NewCond(SMTPConfigColumnResourceOwner, e.Aggregate().ResourceOwner),
+			handler.NewCond(SMTPConfigColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *smtpConfigProjection) reduceSMTPConfigHeadersSet(event eventstore.Event) (*handler.Statement, error) {
+	var e *instance.SMTPConfigHeadersSetEvent
+	switch ev := event.(type) {
+	case *instance.SMTPConfigHeadersSetEvent:
+		e = ev
+	case *org.SMTPConfigHeadersSetEvent:
+		e = &ev.SMTPConfigHeadersSetEvent
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Hd5st", "reduce.wrong.event.type %v", []eventstore.EventType{instance.SMTPConfigHeadersSetEventType, org.SMTPConfigHeadersSetEventType})
+	}
+
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(SMTPConfigColumnChangeDate, e.CreationDate()),
+			handler.NewCol(SMTPConfigColumnSequence, e.Sequence()),
+			handler.NewCol(SMTPConfigColumnHeaders, database.Map[string](e.Headers)),
+		},
+		[]handler.Condition{
+			handler.NewCond(SMTPConfigColumnID, smtpConfigID(e.ID, e.Aggregate())),
+			handler.NewCond(SMTPConfigColumnResourceOwner, e.Aggregate().ResourceOwner),
+			handler.NewCond(SMTPConfigColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+// smtpConfigID deals with the old and unique SMTP settings (empty ID)
+func smtpConfigID(id string, aggregate *eventstore.Aggregate) string {
+	if id == "" {
+		return aggregate.ResourceOwner
+	}
+	return id
+}
+
 func (p *smtpConfigProjection) reduceSMTPConfigActivated(event eventstore.Event) (*handler.Statement, error) {
-	e, ok := event.(*instance.SMTPConfigActivatedEvent)
-	if !ok {
-		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-fq92r", "reduce.wrong.event.type %s", instance.SMTPConfigActivatedEventType)
+	var e *instance.SMTPConfigActivatedEvent
+	switch ev := event.(type) {
+	case *instance.SMTPConfigActivatedEvent:
+		e = ev
+	case *org.SMTPConfigActivatedEvent:
+		e = &ev.SMTPConfigActivatedEvent
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-fq92r", "reduce.wrong.event.type %v", []eventstore.EventType{instance.SMTPConfigActivatedEventType, org.SMTPConfigActivatedEventType})
 	}
 
 	// Deal with old and unique SMTP settings (empty ID)
@@ -246,9 +558,14 @@ func (p *smtpConfigProjection) reduceSMTPConfigActivated(event eventstore.Event)
 }
 
 func (p *smtpConfigProjection) reduceSMTPConfigDeactivated(event eventstore.Event) (*handler.Statement, error) {
-	e, ok := event.(*instance.SMTPConfigDeactivatedEvent)
-	if !ok {
-		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-hv89j", "reduce.wrong.event.type %s", instance.SMTPConfigDeactivatedEventType)
+	var e *instance.SMTPConfigDeactivatedEvent
+	switch ev := event.(type) {
+	case *instance.SMTPConfigDeactivatedEvent:
+		e = ev
+	case *org.SMTPConfigDeactivatedEvent:
+		e = &ev.SMTPConfigDeactivatedEvent
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-hv89j", "reduce.wrong.event.type %v", []eventstore.EventType{instance.SMTPConfigDeactivatedEventType, org.SMTPConfigDeactivatedEventType})
 	}
 
 	// Deal with old and unique SMTP settings (empty ID)
@@ -273,9 +590,14 @@ func (p *smtpConfigProjection) reduceSMTPConfigDeactivated(event eventstore.Even
 }
 
 func (p *smtpConfigProjection) reduceSMTPConfigRemoved(event eventstore.Event) (*handler.Statement, error) {
-	e, err := assertEvent[*instance.SMTPConfigRemovedEvent](event)
-	if err != nil {
-		return nil, err
+	var e *instance.SMTPConfigRemovedEvent
+	switch ev := event.(type) {
+	case *instance.SMTPConfigRemovedEvent:
+		e = ev
+	case *org.SMTPConfigRemovedEvent:
+		e = &ev.SMTPConfigRemovedEvent
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Vq1ar", "reduce.wrong.event.type %v", []eventstore.EventType{instance.SMTPConfigRemovedEventType, org.SMTPConfigRemovedEventType})
 	}
 
 	// Deal with old and unique SMTP settings (empty ID)
@@ -293,3 +615,18 @@ func (p *smtpConfigProjection) reduceSMTPConfigRemoved(event eventstore.Event) (
 		},
 	), nil
 }
+
+func (p *smtpConfigProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.OrgRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(SMTPConfigColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(SMTPConfigColumnResourceOwner, e.Aggregate().ID),
+		},
+	), nil
+}
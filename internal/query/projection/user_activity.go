@@ -0,0 +1,480 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/idpintent"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/session"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	UserActivityTable = "projections.user_activities"
+
+	UserActivityUserIDCol               = "user_id"
+	UserActivityCreationDateCol         = "creation_date"
+	UserActivityChangeDateCol           = "change_date"
+	UserActivitySequenceCol             = "sequence"
+	UserActivityResourceOwnerCol        = "resource_owner"
+	UserActivityInstanceIDCol           = "instance_id"
+	UserActivityStateCol                = "state"
+	UserActivityLastLoginCol            = "last_login"
+	UserActivityLastActivityCol         = "last_activity"
+	UserActivityDeactivatedAtCol        = "deactivated_at"
+	UserActivityDeactivationWarnedAtCol = "deactivation_warned_at"
+	UserActivityDeletionWarnedAtCol     = "deletion_warned_at"
+
+	UserActivitySessionSuffix           = "sessions"
+	UserActivitySessionTable            = UserActivityTable + "_" + UserActivitySessionSuffix
+	UserActivitySessionInstanceIDCol    = "instance_id"
+	UserActivitySessionIDCol            = "session_id"
+	UserActivitySessionUserIDCol        = "user_id"
+	UserActivitySessionResourceOwnerCol = "resource_owner"
+)
+
+// userActivityProjection keeps track of the last successful login of human users.
+// The last activity is the last successful login, the creation or the reactivation of the user,
+// whichever happened last, and is used to evaluate the user lifecycle policy.
+// Logins through the session API are recorded as well, as the session checks don't contain the user,
+// the user of each session is kept in a separate table.
+type userActivityProjection struct{}
+
+func newUserActivityProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(userActivityProjection))
+}
+
+func (*userActivityProjection) Name() string {
+	return UserActivityTable
+}
+
+func (*userActivityProjection) Init() *old_handler.Check {
+	return handler.NewMultiTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(UserActivityUserIDCol, handler.ColumnTypeText),
+			handler.NewColumn(UserActivityCreationDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(UserActivityChangeDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(UserActivitySequenceCol, handler.ColumnTypeInt64),
+			handler.NewColumn(UserActivityResourceOwnerCol, handler.ColumnTypeText),
+			handler.NewColumn(UserActivityInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(UserActivityStateCol, handler.ColumnTypeEnum),
+			handler.NewColumn(UserActivityLastLoginCol, handler.ColumnTypeTimestamp, handler.Nullable()),
+			handler.NewColumn(UserActivityLastActivityCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(UserActivityDeactivatedAtCol, handler.ColumnTypeTimestamp, handler.Nullable()),
+			handler.NewColumn(UserActivityDeactivationWarnedAtCol, handler.ColumnTypeTimestamp, handler.Nullable()),
+			handler.NewColumn(UserActivityDeletionWarnedAtCol, handler.ColumnTypeTimestamp, handler.Nullable()),
+		},
+			handler.NewPrimaryKey(UserActivityInstanceIDCol, UserActivityUserIDCol),
+			handler.WithIndex(handler.NewIndex("resource_owner", []string{UserActivityResourceOwnerCol})),
+		),
+		handler.NewSuffixedTable([]*handler.InitColumn{
+			handler.NewColumn(UserActivitySessionInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(UserActivitySessionIDCol, handler.ColumnTypeText),
+			handler.NewColumn(UserActivitySessionUserIDCol, handler.ColumnTypeText),
+			handler.NewColumn(UserActivitySessionResourceOwnerCol, handler.ColumnTypeText),
+		},
+			handler.NewPrimaryKey(UserActivitySessionInstanceIDCol, UserActivitySessionIDCol),
+			UserActivitySessionSuffix,
+			handler.WithIndex(handler.NewIndex("user_id", []string{UserActivitySessionUserIDCol})),
+		),
+	)
+}
+
+func (p *userActivityProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: user.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  user.UserV1AddedType,
+					Reduce: p.reduceHumanAdded,
+				},
+				{
+					Event:  user.HumanAddedType,
+					Reduce: p.reduceHumanAdded,
+				},
+				{
+					Event:  user.UserV1RegisteredType,
+					Reduce: p.reduceHumanAdded,
+				},
+				{
+					Event:  user.HumanRegisteredType,
+					Reduce: p.reduceHumanAdded,
+				},
+				{
+					Event:  user.UserV1PasswordCheckSucceededType,
+					Reduce: p.reduceLoginSucceeded,
+				},
+				{
+					Event:  user.HumanPasswordCheckSucceededType,
+					Reduce: p.reduceLoginSucceeded,
+				},
+				{
+					Event:  user.HumanPasswordlessTokenCheckSucceededType,
+					Reduce: p.reduceLoginSucceeded,
+				},
+				{
+					Event:  user.UserIDPLoginCheckSucceededType,
+					Reduce: p.reduceLoginSucceeded,
+				},
+				{
+					Event:  user.UserDeactivatedType,
+					Reduce: p.reduceDeactivated,
+				},
+				{
+					Event:  user.UserReactivatedType,
+					Reduce: p.reduceReactivated,
+				},
+				{
+					Event:  user.UserDeactivationWarningAddedType,
+					Reduce: p.reduceDeactivationWarningAdded,
+				},
+				{
+					Event:  user.UserDeletionWarningAddedType,
+					Reduce: p.reduceDeletionWarningAdded,
+				},
+				{
+					Event:  user.UserRemovedType,
+					Reduce: p.reduceUserRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: idpintent.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  idpintent.SucceededEventType,
+					Reduce: p.reduceIntentSucceeded,
+				},
+			},
+		},
+		{
+			Aggregate: session.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  session.UserCheckedType,
+					Reduce: p.reduceSessionUserChecked,
+				},
+				{
+					Event:  session.PasswordCheckedType,
+					Reduce: p.reduceSessionChecked,
+				},
+				{
+					Event:  session.IntentCheckedType,
+					Reduce: p.reduceSessionChecked,
+				},
+				{
+					Event:  session.WebAuthNCheckedType,
+					Reduce: p.reduceSessionChecked,
+				},
+				{
+					Event:  session.TOTPCheckedType,
+					Reduce: p.reduceSessionChecked,
+				},
+				{
+					Event:  session.TerminateType,
+					Reduce: p.reduceSessionTerminated,
+				},
+			},
+		},
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: p.reduceInstanceRemoved,
+				},
+			},
+		},
+	}
+}
+
+func (p *userActivityProjection) reduceHumanAdded(event eventstore.Event) (*handler.Statement, error) {
+	switch event.(type) {
+	case *user.HumanAddedEvent, *user.HumanRegisteredEvent:
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Vd1qa", "reduce.wrong.event.type %v", []eventstore.EventType{user.HumanAddedType, user.HumanRegisteredType})
+	}
+	return handler.NewCreateStatement(
+		event,
+		[]handler.Column{
+			handler.NewCol(UserActivityUserIDCol, event.Aggregate().ID),
+			handler.NewCol(UserActivityCreationDateCol, event.CreatedAt()),
+			handler.NewCol(UserActivityChangeDateCol, event.CreatedAt()),
+			handler.NewCol(UserActivitySequenceCol, event.Sequence()),
+			handler.NewCol(UserActivityResourceOwnerCol, event.Aggregate().ResourceOwner),
+			handler.NewCol(UserActivityInstanceIDCol, event.Aggregate().InstanceID),
+			handler.NewCol(UserActivityStateCol, domain.UserStateActive),
+			handler.NewCol(UserActivityLastActivityCol, event.CreatedAt()),
+		},
+	), nil
+}
+
+func (p *userActivityProjection) reduceLoginSucceeded(event eventstore.Event) (*handler.Statement, error) {
+	switch event.(type) {
+	case *user.HumanPasswordCheckSucceededEvent, *user.HumanPasswordlessCheckSucceededEvent, *user.UserIDPCheckSucceededEvent:
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-c0Rfq", "reduce.wrong.event.type %v", []eventstore.EventType{user.HumanPasswordCheckSucceededType, user.HumanPasswordlessTokenCheckSucceededType, user.UserIDPLoginCheckSucceededType})
+	}
+	return p.loginStatement(event, event.Aggregate().ID), nil
+}
+
+func (p *userActivityProjection) reduceIntentSucceeded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*idpintent.SucceededEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Ss3bk", "reduce.wrong.event.type %s", idpintent.SucceededEventType)
+	}
+	// intents of unknown users are used to register or link a user, which is no login
+	if e.UserID == "" {
+		return handler.NewNoOpStatement(e), nil
+	}
+	return p.loginStatement(e, e.UserID), nil
+}
+
+// reduceSessionUserChecked keeps the user of the session for the following checks.
+// The identification of the user itself is no login.
+func (p *userActivityProjection) reduceSessionUserChecked(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*session.UserCheckedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Ua1sc", "reduce.wrong.event.type %s", session.UserCheckedType)
+	}
+	return handler.NewUpsertStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(UserActivitySessionInstanceIDCol, nil),
+			handler.NewCol(UserActivitySessionIDCol, nil),
+		},
+		[]handler.Column{
+			handler.NewCol(UserActivitySessionInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCol(UserActivitySessionIDCol, e.Aggregate().ID),
+			handler.NewCol(UserActivitySessionUserIDCol, e.UserID),
+			handler.NewCol(UserActivitySessionResourceOwnerCol, e.UserResourceOwner),
+		},
+		handler.WithTableSuffix(UserActivitySessionSuffix),
+	), nil
+}
+
+func (p *userActivityProjection) reduceSessionChecked(event eventstore.Event) (*handler.Statement, error) {
+	switch event.(type) {
+	case *session.PasswordCheckedEvent, *session.IntentCheckedEvent, *session.WebAuthNCheckedEvent, *session.TOTPCheckedEvent:
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Ua2sc", "reduce.wrong.event.type %v", []eventstore.EventType{session.PasswordCheckedType, session.IntentCheckedType, session.WebAuthNCheckedType, session.TOTPCheckedType})
+	}
+	return handler.NewUpdateStatement(
+		event,
+		p.loginColumns(event),
+		[]handler.Condition{
+			newSessionUserCond(event.Aggregate().ID),
+			handler.NewCond(UserActivityInstanceIDCol, event.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *userActivityProjection) reduceSessionTerminated(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*session.TerminateEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Ua3st", "reduce.wrong.event.type %s", session.TerminateType)
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(UserActivitySessionInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(UserActivitySessionIDCol, e.Aggregate().ID),
+		},
+		handler.WithTableSuffix(UserActivitySessionSuffix),
+	), nil
+}
+
+// newSessionUserCond matches the user of the session
+func newSessionUserCond(sessionID string) handler.Condition {
+	return func(param string) (string, []any) {
+		return UserActivityUserIDCol + " = (SELECT " + UserActivitySessionUserIDCol + " FROM " + UserActivitySessionTable +
+			" WHERE " + UserActivitySessionTable + "." + UserActivitySessionInstanceIDCol + " = " + UserActivityTable + "." + UserActivityInstanceIDCol +
+			" AND " + UserActivitySessionTable + "." + UserActivitySessionIDCol + " = " + param + ")", []any{sessionID}
+	}
+}
+
+func (p *userActivityProjection) loginStatement(event eventstore.Event, userID string) *handler.Statement {
+	return handler.NewUpdateStatement(
+		event,
+		p.loginColumns(event),
+		[]handler.Condition{
+			handler.NewCond(UserActivityUserIDCol, userID),
+			handler.NewCond(UserActivityInstanceIDCol, event.Aggregate().InstanceID),
+		},
+	)
+}
+
+func (p *userActivityProjection) loginColumns(event eventstore.Event) []handler.Column {
+	return []handler.Column{
+		handler.NewCol(UserActivityChangeDateCol, event.CreatedAt()),
+		handler.NewCol(UserActivitySequenceCol, event.Sequence()),
+		handler.NewCol(UserActivityLastLoginCol, event.CreatedAt()),
+		handler.NewCol(UserActivityLastActivityCol, event.CreatedAt()),
+		handler.NewCol(UserActivityDeactivationWarnedAtCol, nil),
+	}
+}
+
+func (p *userActivityProjection) reduceDeactivated(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.UserDeactivatedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Jp8wn", "reduce.wrong.event.type %s", user.UserDeactivatedType)
+	}
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(UserActivityChangeDateCol, e.CreatedAt()),
+			handler.NewCol(UserActivitySequenceCol, e.Sequence()),
+			handler.NewCol(UserActivityStateCol, domain.UserStateInactive),
+			handler.NewCol(UserActivityDeactivatedAtCol, e.CreatedAt()),
+			handler.NewCol(UserActivityDeletionWarnedAtCol, nil),
+		},
+		[]handler.Condition{
+			handler.NewCond(UserActivityUserIDCol, e.Aggregate().ID),
+			handler.NewCond(UserActivityInstanceIDCol, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *userActivityProjection) reduceReactivated(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.UserReactivatedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Gn5tc", "reduce.wrong.event.type %s", user.UserReactivatedType)
+	}
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(UserActivityChangeDateCol, e.CreatedAt()),
+			handler.NewCol(UserActivitySequenceCol, e.Sequence()),
+			handler.NewCol(UserActivityStateCol, domain.UserStateActive),
+			handler.NewCol(UserActivityLastActivityCol, e.CreatedAt()),
+			handler.NewCol(UserActivityDeactivatedAtCol, nil),
+			handler.NewCol(UserActivityDeactivationWarnedAtCol, nil),
+			handler.NewCol(UserActivityDeletionWarnedAtCol, nil),
+		},
+		[]handler.Condition{
+			handler.NewCond(UserActivityUserIDCol, e.Aggregate().ID),
+			handler.NewCond(UserActivityInstanceIDCol, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *userActivityProjection) reduceDeactivationWarningAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.UserDeactivationWarningAddedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Uh4sl", "reduce.wrong.event.type %s", user.UserDeactivationWarningAddedType)
+	}
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(UserActivityChangeDateCol, e.CreatedAt()),
+			handler.NewCol(UserActivitySequenceCol, e.Sequence()),
+			handler.NewCol(UserActivityDeactivationWarnedAtCol, e.CreatedAt()),
+		},
+		[]handler.Condition{
+			handler.NewCond(UserActivityUserIDCol, e.Aggregate().ID),
+			handler.NewCond(UserActivityInstanceIDCol, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *userActivityProjection) reduceDeletionWarningAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.UserDeletionWarningAddedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Ow7xd", "reduce.wrong.event.type %s", user.UserDeletionWarningAddedType)
+	}
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(UserActivityChangeDateCol, e.CreatedAt()),
+			handler.NewCol(UserActivitySequenceCol, e.Sequence()),
+			handler.NewCol(UserActivityDeletionWarnedAtCol, e.CreatedAt()),
+		},
+		[]handler.Condition{
+			handler.NewCond(UserActivityUserIDCol, e.Aggregate().ID),
+			handler.NewCond(UserActivityInstanceIDCol, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *userActivityProjection) reduceUserRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.UserRemovedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Zx6mu", "reduce.wrong.event.type %s", user.UserRemovedType)
+	}
+	return handler.NewMultiStatement(
+		e,
+		handler.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(UserActivityUserIDCol, e.Aggregate().ID),
+				handler.NewCond(UserActivityInstanceIDCol, e.Aggregate().InstanceID),
+			},
+		),
+		handler.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(UserActivitySessionUserIDCol, e.Aggregate().ID),
+				handler.NewCond(UserActivitySessionInstanceIDCol, e.Aggregate().InstanceID),
+			},
+			handler.WithTableSuffix(UserActivitySessionSuffix),
+		),
+	), nil
+}
+
+func (p *userActivityProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.OrgRemovedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Ek9vr", "reduce.wrong.event.type %s", org.OrgRemovedEventType)
+	}
+	return handler.NewMultiStatement(
+		e,
+		handler.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(UserActivityInstanceIDCol, e.Aggregate().InstanceID),
+				handler.NewCond(UserActivityResourceOwnerCol, e.Aggregate().ID),
+			},
+		),
+		handler.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(UserActivitySessionInstanceIDCol, e.Aggregate().InstanceID),
+				handler.NewCond(UserActivitySessionResourceOwnerCol, e.Aggregate().ID),
+			},
+			handler.WithTableSuffix(UserActivitySessionSuffix),
+		),
+	), nil
+}
+
+func (p *userActivityProjection) reduceInstanceRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*instance.InstanceRemovedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Ua4ir", "reduce.wrong.event.type %s", instance.InstanceRemovedEventType)
+	}
+	return handler.NewMultiStatement(
+		e,
+		handler.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(UserActivityInstanceIDCol, e.Aggregate().ID),
+			},
+		),
+		handler.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(UserActivitySessionInstanceIDCol, e.Aggregate().ID),
+			},
+			handler.WithTableSuffix(UserActivitySessionSuffix),
+		),
+	), nil
+}
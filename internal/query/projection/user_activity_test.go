@@ -0,0 +1,348 @@
+package projection
+
+import (
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/idpintent"
+	"github.com/zitadel/zitadel/internal/repository/session"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestUserActivityProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceHumanAdded",
+			args: args{
+				event: getEvent(
+					testEvent(
+						user.HumanAddedType,
+						user.AggregateType,
+						[]byte(`{
+							"username": "user-name"
+						}`),
+					), user.HumanAddedEventMapper),
+			},
+			reduce: (&userActivityProjection{}).reduceHumanAdded,
+			want: wantReduce{
+				aggregateType: user.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.user_activities (user_id, creation_date, change_date, sequence, resource_owner, instance_id, state, last_activity) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"ro-id",
+								"instance-id",
+								domain.UserStateActive,
+								anyArg{},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceLoginSucceeded",
+			args: args{
+				event: getEvent(
+					testEvent(
+						user.HumanPasswordCheckSucceededType,
+						user.AggregateType,
+						[]byte(`{}`),
+					), user.HumanPasswordCheckSucceededEventMapper),
+			},
+			reduce: (&userActivityProjection{}).reduceLoginSucceeded,
+			want: wantReduce{
+				aggregateType: user.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.user_activities SET (change_date, sequence, last_login, last_activity, deactivation_warned_at) = ($1, $2, $3, $4, $5) WHERE (user_id = $6) AND (instance_id = $7)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								anyArg{},
+								anyArg{},
+								nil,
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceIntentSucceeded",
+			args: args{
+				event: getEvent(
+					testEvent(
+						idpintent.SucceededEventType,
+						idpintent.AggregateType,
+						[]byte(`{
+							"userId": "user-id"
+						}`),
+					), idpintent.SucceededEventMapper),
+			},
+			reduce: (&userActivityProjection{}).reduceIntentSucceeded,
+			want: wantReduce{
+				aggregateType: idpintent.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.user_activities SET (change_date, sequence, last_login, last_activity, deactivation_warned_at) = ($1, $2, $3, $4, $5) WHERE (user_id = $6) AND (instance_id = $7)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								anyArg{},
+								anyArg{},
+								nil,
+								"user-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceIntentSucceeded without user",
+			args: args{
+				event: getEvent(
+					testEvent(
+						idpintent.SucceededEventType,
+						idpintent.AggregateType,
+						[]byte(`{}`),
+					), idpintent.SucceededEventMapper),
+			},
+			reduce: (&userActivityProjection{}).reduceIntentSucceeded,
+			want: wantReduce{
+				aggregateType: idpintent.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{},
+				},
+			},
+		},
+		{
+			name: "reduceDeactivated",
+			args: args{
+				event: getEvent(
+					testEvent(
+						user.UserDeactivatedType,
+						user.AggregateType,
+						nil,
+					), user.UserDeactivatedEventMapper),
+			},
+			reduce: (&userActivityProjection{}).reduceDeactivated,
+			want: wantReduce{
+				aggregateType: user.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.user_activities SET (change_date, sequence, state, deactivated_at, deletion_warned_at) = ($1, $2, $3, $4, $5) WHERE (user_id = $6) AND (instance_id = $7)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								domain.UserStateInactive,
+								anyArg{},
+								nil,
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceDeactivationWarningAdded",
+			args: args{
+				event: getEvent(
+					testEvent(
+						user.UserDeactivationWarningAddedType,
+						user.AggregateType,
+						[]byte(`{
+							"deactivationDate": "2024-01-01T00:00:00Z"
+						}`),
+					), eventstore.GenericEventMapper[user.UserDeactivationWarningAddedEvent]),
+			},
+			reduce: (&userActivityProjection{}).reduceDeactivationWarningAdded,
+			want: wantReduce{
+				aggregateType: user.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.user_activities SET (change_date, sequence, deactivation_warned_at) = ($1, $2, $3) WHERE (user_id = $4) AND (instance_id = $5)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								anyArg{},
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceSessionUserChecked",
+			args: args{
+				event: getEvent(
+					testEvent(
+						session.UserCheckedType,
+						session.AggregateType,
+						[]byte(`{
+							"userID": "user-id",
+							"userResourceOwner": "org-id"
+						}`),
+					), session.UserCheckedEventMapper),
+			},
+			reduce: (&userActivityProjection{}).reduceSessionUserChecked,
+			want: wantReduce{
+				aggregateType: session.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.user_activities_sessions (instance_id, session_id, user_id, resource_owner) VALUES ($1, $2, $3, $4) ON CONFLICT (instance_id, session_id) DO UPDATE SET (user_id, resource_owner) = (EXCLUDED.user_id, EXCLUDED.resource_owner)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+								"user-id",
+								"org-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceSessionChecked",
+			args: args{
+				event: getEvent(
+					testEvent(
+						session.PasswordCheckedType,
+						session.AggregateType,
+						[]byte(`{}`),
+					), session.PasswordCheckedEventMapper),
+			},
+			reduce: (&userActivityProjection{}).reduceSessionChecked,
+			want: wantReduce{
+				aggregateType: session.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.user_activities SET (change_date, sequence, last_login, last_activity, deactivation_warned_at) = ($1, $2, $3, $4, $5) WHERE (user_id = (SELECT user_id FROM projections.user_activities_sessions WHERE projections.user_activities_sessions.instance_id = projections.user_activities.instance_id AND projections.user_activities_sessions.session_id = $6)) AND (instance_id = $7)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								anyArg{},
+								anyArg{},
+								nil,
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceSessionTerminated",
+			args: args{
+				event: getEvent(
+					testEvent(
+						session.TerminateType,
+						session.AggregateType,
+						[]byte(`{}`),
+					), session.TerminateEventMapper),
+			},
+			reduce: (&userActivityProjection{}).reduceSessionTerminated,
+			want: wantReduce{
+				aggregateType: session.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.user_activities_sessions WHERE (instance_id = $1) AND (session_id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceUserRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						user.UserRemovedType,
+						user.AggregateType,
+						nil,
+					), user.UserRemovedEventMapper),
+			},
+			reduce: (&userActivityProjection{}).reduceUserRemoved,
+			want: wantReduce{
+				aggregateType: user.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.user_activities WHERE (user_id = $1) AND (instance_id = $2)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								"instance-id",
+							},
+						},
+						{
+							expectedStmt: "DELETE FROM projections.user_activities_sessions WHERE (user_id = $1) AND (instance_id = $2)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if ok := zerrors.IsErrorInvalidArgument(err); !ok {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, UserActivityTable, tt.want)
+		})
+	}
+}
@@ -18,7 +18,7 @@ import (
 )
 
 const (
-	UserGrantProjectionTable = "projections.user_grants5"
+	UserGrantProjectionTable = "projections.user_grants6"
 
 	UserGrantID                   = "id"
 	UserGrantCreationDate         = "creation_date"
@@ -34,6 +34,8 @@ const (
 	UserGrantGrantID              = "grant_id"
 	UserGrantGrantedOrg           = "granted_org"
 	UserGrantRoles                = "roles"
+	UserGrantValidFrom            = "valid_from"
+	UserGrantValidUntil           = "valid_until"
 )
 
 type userGrantProjection struct {
@@ -65,6 +67,8 @@ func (*userGrantProjection) Init() *old_handler.Check {
 			handler.NewColumn(UserGrantGrantID, handler.ColumnTypeText),
 			handler.NewColumn(UserGrantGrantedOrg, handler.ColumnTypeText),
 			handler.NewColumn(UserGrantRoles, handler.ColumnTypeTextArray, handler.Nullable()),
+			handler.NewColumn(UserGrantValidFrom, handler.ColumnTypeTimestamp, handler.Nullable()),
+			handler.NewColumn(UserGrantValidUntil, handler.ColumnTypeTimestamp, handler.Nullable()),
 		},
 			handler.NewPrimaryKey(UserGrantInstanceID, UserGrantID),
 			handler.WithIndex(handler.NewIndex("user_id", []string{UserGrantUserID})),
@@ -98,6 +102,14 @@ func (p *userGrantProjection) Reducers() []handler.AggregateReducer {
 					Event:  usergrant.UserGrantCascadeRemovedType,
 					Reduce: p.reduceRemoved,
 				},
+				{
+					Event:  usergrant.UserGrantExpiredType,
+					Reduce: p.reduceRemoved,
+				},
+				{
+					Event:  usergrant.UserGrantValiditySetType,
+					Reduce: p.reduceValiditySet,
+				},
 				{
 					Event:  usergrant.UserGrantDeactivatedType,
 					Reduce: p.reduceDeactivated,
@@ -224,10 +236,10 @@ func (p *userGrantProjection) reduceChanged(event eventstore.Event) (*handler.St
 
 func (p *userGrantProjection) reduceRemoved(event eventstore.Event) (*handler.Statement, error) {
 	switch event.(type) {
-	case *usergrant.UserGrantRemovedEvent, *usergrant.UserGrantCascadeRemovedEvent:
+	case *usergrant.UserGrantRemovedEvent, *usergrant.UserGrantCascadeRemovedEvent, *usergrant.UserGrantExpiredEvent:
 		// ok
 	default:
-		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-7OBEC", "reduce.wrong.event.type %v", []eventstore.EventType{usergrant.UserGrantRemovedType, usergrant.UserGrantCascadeRemovedType})
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-7OBEC", "reduce.wrong.event.type %v", []eventstore.EventType{usergrant.UserGrantRemovedType, usergrant.UserGrantCascadeRemovedType, usergrant.UserGrantExpiredType})
 	}
 
 	return handler.NewDeleteStatement(
@@ -239,6 +251,27 @@ func (p *userGrantProjection) reduceRemoved(event eventstore.Event) (*handler.St
 	), nil
 }
 
+func (p *userGrantProjection) reduceValiditySet(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*usergrant.UserGrantValiditySetEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Vd3sT", "reduce.wrong.event.type %s", usergrant.UserGrantValiditySetType)
+	}
+
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(UserGrantChangeDate, e.CreatedAt()),
+			handler.NewCol(UserGrantValidFrom, e.ValidFrom),
+			handler.NewCol(UserGrantValidUntil, e.ValidUntil),
+			handler.NewCol(UserGrantSequence, e.Sequence()),
+		},
+		[]handler.Condition{
+			handler.NewCond(UserGrantID, e.Aggregate().ID),
+			handler.NewCond(UserGrantInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
 func (p *userGrantProjection) reduceDeactivated(event eventstore.Event) (*handler.Statement, error) {
 	if _, ok := event.(*usergrant.UserGrantDeactivatedEvent); !ok {
 		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-oP7Gm", "reduce.wrong.event.type %s", usergrant.UserGrantDeactivatedType)
@@ -0,0 +1,163 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	UserLifecyclePolicyTable = "projections.user_lifecycle_policies"
+
+	UserLifecyclePolicyIDCol                  = "id"
+	UserLifecyclePolicyCreationDateCol        = "creation_date"
+	UserLifecyclePolicyChangeDateCol          = "change_date"
+	UserLifecyclePolicySequenceCol            = "sequence"
+	UserLifecyclePolicyResourceOwnerCol       = "resource_owner"
+	UserLifecyclePolicyInstanceIDCol          = "instance_id"
+	UserLifecyclePolicyDeactivateAfterDaysCol = "deactivate_after_days"
+	UserLifecyclePolicyDeleteAfterDaysCol     = "delete_after_days"
+	UserLifecyclePolicyWarnDaysBeforeCol      = "warn_days_before"
+)
+
+type userLifecyclePolicyProjection struct{}
+
+func newUserLifecyclePolicyProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(userLifecyclePolicyProjection))
+}
+
+func (*userLifecyclePolicyProjection) Name() string {
+	return UserLifecyclePolicyTable
+}
+
+func (*userLifecyclePolicyProjection) Init() *old_handler.Check {
+	return handler.NewTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(UserLifecyclePolicyIDCol, handler.ColumnTypeText),
+			handler.NewColumn(UserLifecyclePolicyCreationDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(UserLifecyclePolicyChangeDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(UserLifecyclePolicySequenceCol, handler.ColumnTypeInt64),
+			handler.NewColumn(UserLifecyclePolicyResourceOwnerCol, handler.ColumnTypeText),
+			handler.NewColumn(UserLifecyclePolicyInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(UserLifecyclePolicyDeactivateAfterDaysCol, handler.ColumnTypeInt64),
+			handler.NewColumn(UserLifecyclePolicyDeleteAfterDaysCol, handler.ColumnTypeInt64),
+			handler.NewColumn(UserLifecyclePolicyWarnDaysBeforeCol, handler.ColumnTypeInt64),
+		},
+			handler.NewPrimaryKey(UserLifecyclePolicyInstanceIDCol, UserLifecyclePolicyIDCol),
+		),
+	)
+}
+
+func (p *userLifecyclePolicyProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.UserLifecyclePolicyAddedEventType,
+					Reduce: p.reduceAdded,
+				},
+				{
+					Event:  org.UserLifecyclePolicyChangedEventType,
+					Reduce: p.reduceChanged,
+				},
+				{
+					Event:  org.UserLifecyclePolicyRemovedEventType,
+					Reduce: p.reduceRemoved,
+				},
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(UserLifecyclePolicyInstanceIDCol),
+				},
+			},
+		},
+	}
+}
+
+func (p *userLifecyclePolicyProjection) reduceAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.UserLifecyclePolicyAddedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Hs8vo", "reduce.wrong.event.type %s", org.UserLifecyclePolicyAddedEventType)
+	}
+	return handler.NewCreateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(UserLifecyclePolicyCreationDateCol, e.CreationDate()),
+			handler.NewCol(UserLifecyclePolicyChangeDateCol, e.CreationDate()),
+			handler.NewCol(UserLifecyclePolicySequenceCol, e.Sequence()),
+			handler.NewCol(UserLifecyclePolicyIDCol, e.Aggregate().ID),
+			handler.NewCol(UserLifecyclePolicyDeactivateAfterDaysCol, e.DeactivateAfterDays),
+			handler.NewCol(UserLifecyclePolicyDeleteAfterDaysCol, e.DeleteAfterDays),
+			handler.NewCol(UserLifecyclePolicyWarnDaysBeforeCol, e.WarnDaysBefore),
+			handler.NewCol(UserLifecyclePolicyResourceOwnerCol, e.Aggregate().ResourceOwner),
+			handler.NewCol(UserLifecyclePolicyInstanceIDCol, e.Aggregate().InstanceID),
+		}), nil
+}
+
+func (p *userLifecyclePolicyProjection) reduceChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.UserLifecyclePolicyChangedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-n3Wcs", "reduce.wrong.event.type %s", org.UserLifecyclePolicyChangedEventType)
+	}
+	cols := []handler.Column{
+		handler.NewCol(UserLifecyclePolicyChangeDateCol, e.CreationDate()),
+		handler.NewCol(UserLifecyclePolicySequenceCol, e.Sequence()),
+	}
+	if e.DeactivateAfterDays != nil {
+		cols = append(cols, handler.NewCol(UserLifecyclePolicyDeactivateAfterDaysCol, *e.DeactivateAfterDays))
+	}
+	if e.DeleteAfterDays != nil {
+		cols = append(cols, handler.NewCol(UserLifecyclePolicyDeleteAfterDaysCol, *e.DeleteAfterDays))
+	}
+	if e.WarnDaysBefore != nil {
+		cols = append(cols, handler.NewCol(UserLifecyclePolicyWarnDaysBeforeCol, *e.WarnDaysBefore))
+	}
+	return handler.NewUpdateStatement(
+		e,
+		cols,
+		[]handler.Condition{
+			handler.NewCond(UserLifecyclePolicyIDCol, e.Aggregate().ID),
+			handler.NewCond(UserLifecyclePolicyInstanceIDCol, e.Aggregate().InstanceID),
+		}), nil
+}
+
+func (p *userLifecyclePolicyProjection) reduceRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.UserLifecyclePolicyRemovedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Rk0ma", "reduce.wrong.event.type %s", org.UserLifecyclePolicyRemovedEventType)
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(UserLifecyclePolicyIDCol, e.Aggregate().ID),
+			handler.NewCond(UserLifecyclePolicyInstanceIDCol, e.Aggregate().InstanceID),
+		}), nil
+}
+
+func (p *userLifecyclePolicyProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.OrgRemovedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Yq2eb", "reduce.wrong.event.type %s", org.OrgRemovedEventType)
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(UserLifecyclePolicyInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(UserLifecyclePolicyResourceOwnerCol, e.Aggregate().ID),
+		},
+	), nil
+}
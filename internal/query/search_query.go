@@ -636,6 +636,17 @@ func (q *TimestampQuery) comp() sq.Sqlizer {
 	return nil
 }
 
+// newValidAtQuery matches the rows whose validity window, represented by the nullable columns, contains the time.
+func newValidAtQuery(from, until Column, at time.Time) (SearchQuery, error) {
+	if from.isZero() || until.isZero() {
+		return nil, ErrMissingColumn
+	}
+	return NewAndQuery(
+		Or(&IsNullQuery{Column: from}, &TimestampQuery{Column: from, Compare: TimestampLessOrEquals, Value: at}),
+		Or(&IsNullQuery{Column: until}, &TimestampQuery{Column: until, Compare: TimestampGreater, Value: at}),
+	)
+}
+
 var (
 	// countColumn represents the default counter for search responses
 	countColumn = Column{
@@ -742,3 +753,55 @@ func (q *listContains) ToSql() (string, []interface{}, error) {
 func (q *listContains) comp() sq.Sqlizer {
 	return q
 }
+
+func (c Column) replaceTable(from, to table) Column {
+	if c.table != from {
+		return c
+	}
+	c.table = to
+	return c
+}
+
+// replaceTable returns the search query with the columns of the table from bound to the table to.
+// It allows to apply search queries of a table to a subquery which selects the same columns.
+func replaceTable(query SearchQuery, from, to table) SearchQuery {
+	switch q := query.(type) {
+	case *NotNullQuery:
+		return &NotNullQuery{Column: q.Column.replaceTable(from, to)}
+	case *IsNullQuery:
+		return &IsNullQuery{Column: q.Column.replaceTable(from, to)}
+	case *OrQuery:
+		return &OrQuery{queries: replaceTables(q.queries, from, to)}
+	case *AndQuery:
+		return &AndQuery{queries: replaceTables(q.queries, from, to)}
+	case *or:
+		return &or{queries: replaceTables(q.queries, from, to)}
+	case *NotQuery:
+		return &NotQuery{query: replaceTable(q.query, from, to)}
+	case *ColumnComparisonQuery:
+		return &ColumnComparisonQuery{Column1: q.Column1.replaceTable(from, to), Compare: q.Compare, Column2: q.Column2.replaceTable(from, to)}
+	case *InTextQuery:
+		return &InTextQuery{Column: q.Column.replaceTable(from, to), Values: q.Values}
+	case *textQuery:
+		return &textQuery{Column: q.Column.replaceTable(from, to), Text: q.Text, Compare: q.Compare}
+	case *NumberQuery:
+		return &NumberQuery{Column: q.Column.replaceTable(from, to), Number: q.Number, Compare: q.Compare}
+	case *listQuery:
+		return &listQuery{Column: q.Column.replaceTable(from, to), Data: q.Data, Compare: q.Compare}
+	case *BoolQuery:
+		return &BoolQuery{Column: q.Column.replaceTable(from, to), Value: q.Value}
+	case *TimestampQuery:
+		return &TimestampQuery{Column: q.Column.replaceTable(from, to), Compare: q.Compare, Value: q.Value}
+	case *listContains:
+		return &listContains{col: q.col.replaceTable(from, to), args: q.args}
+	}
+	return query
+}
+
+func replaceTables(queries []SearchQuery, from, to table) []SearchQuery {
+	replaced := make([]SearchQuery, len(queries))
+	for i, query := range queries {
+		replaced[i] = replaceTable(query, from, to)
+	}
+	return replaced
+}
@@ -2193,3 +2193,41 @@ func TestInTextQuery_comp(t *testing.T) {
 		})
 	}
 }
+
+func TestReplaceTable(t *testing.T) {
+	tests := []struct {
+		name  string
+		query SearchQuery
+		want  interface{}
+	}{
+		{
+			name:  "text query",
+			query: &textQuery{Column: testCol, Text: "Hurst", Compare: TextEquals},
+			want:  sq.Eq{"test_table2.test_col": "Hurst"},
+		},
+		{
+			name:  "other table",
+			query: &textQuery{Column: testCol2, Text: "Hurst", Compare: TextEquals},
+			want:  sq.Eq{"test_table2.test_col2": "Hurst"},
+		},
+		{
+			name: "or query",
+			query: &OrQuery{queries: []SearchQuery{
+				&NumberQuery{Column: testCol, Number: 1, Compare: NumberEquals},
+				&IsNullQuery{Column: testCol},
+			}},
+			want: sq.Or{
+				sq.Eq{"test_table2.test_col": 1},
+				sq.Eq{"test_table2.test_col": nil},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query := replaceTable(tt.query, testTable, testTable2).comp()
+			if !reflect.DeepEqual(query, tt.want) {
+				t.Errorf("wrong query: want: %v, (%T), got: %v, (%T)", tt.want, tt.want, query, query)
+			}
+		})
+	}
+}
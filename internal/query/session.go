@@ -41,6 +41,7 @@ type Session struct {
 	TOTPFactor     SessionTOTPFactor
 	OTPSMSFactor   SessionOTPFactor
 	OTPEmailFactor SessionOTPFactor
+	PushFactor     SessionPushFactor
 	Metadata       map[string][]byte
 	UserAgent      domain.UserAgent
 	Expiration     time.Time
@@ -67,6 +68,10 @@ type SessionWebAuthNFactor struct {
 	UserVerified      bool
 }
 
+type SessionPushFactor struct {
+	PushCheckedAt time.Time
+}
+
 type SessionTOTPFactor struct {
 	TOTPCheckedAt time.Time
 }
@@ -165,6 +170,10 @@ var (
 		name:  projection.SessionColumnOTPEmailCheckedAt,
 		table: sessionsTable,
 	}
+	SessionColumnPushCheckedAt = Column{
+		name:  projection.SessionColumnPushCheckedAt,
+		table: sessionsTable,
+	}
 	SessionColumnMetadata = Column{
 		name:  projection.SessionColumnMetadata,
 		table: sessionsTable,
@@ -301,6 +310,7 @@ func prepareSessionQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuil
 			SessionColumnTOTPCheckedAt.identifier(),
 			SessionColumnOTPSMSCheckedAt.identifier(),
 			SessionColumnOTPEmailCheckedAt.identifier(),
+			SessionColumnPushCheckedAt.identifier(),
 			SessionColumnMetadata.identifier(),
 			SessionColumnToken.identifier(),
 			SessionColumnUserAgentFingerprintID.identifier(),
@@ -328,6 +338,7 @@ func prepareSessionQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuil
 				totpCheckedAt       sql.NullTime
 				otpSMSCheckedAt     sql.NullTime
 				otpEmailCheckedAt   sql.NullTime
+				pushCheckedAt       sql.NullTime
 				metadata            database.Map[[]byte]
 				token               sql.NullString
 				userAgentIP         sql.NullString
@@ -355,6 +366,7 @@ func prepareSessionQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuil
 				&totpCheckedAt,
 				&otpSMSCheckedAt,
 				&otpEmailCheckedAt,
+				&pushCheckedAt,
 				&metadata,
 				&token,
 				&session.UserAgent.FingerprintID,
@@ -383,6 +395,7 @@ func prepareSessionQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuil
 			session.TOTPFactor.TOTPCheckedAt = totpCheckedAt.Time
 			session.OTPSMSFactor.OTPCheckedAt = otpSMSCheckedAt.Time
 			session.OTPEmailFactor.OTPCheckedAt = otpEmailCheckedAt.Time
+			session.PushFactor.PushCheckedAt = pushCheckedAt.Time
 			session.Metadata = metadata
 			session.UserAgent.Header = http.Header(userAgentHeader)
 			if userAgentIP.Valid {
@@ -414,6 +427,7 @@ func prepareSessionsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBui
 			SessionColumnTOTPCheckedAt.identifier(),
 			SessionColumnOTPSMSCheckedAt.identifier(),
 			SessionColumnOTPEmailCheckedAt.identifier(),
+			SessionColumnPushCheckedAt.identifier(),
 			SessionColumnMetadata.identifier(),
 			SessionColumnExpiration.identifier(),
 			countColumn.identifier(),
@@ -440,6 +454,7 @@ func prepareSessionsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBui
 					totpCheckedAt       sql.NullTime
 					otpSMSCheckedAt     sql.NullTime
 					otpEmailCheckedAt   sql.NullTime
+					pushCheckedAt       sql.NullTime
 					metadata            database.Map[[]byte]
 					expiration          sql.NullTime
 				)
@@ -464,6 +479,7 @@ func prepareSessionsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBui
 					&totpCheckedAt,
 					&otpSMSCheckedAt,
 					&otpEmailCheckedAt,
+					&pushCheckedAt,
 					&metadata,
 					&expiration,
 					&sessions.Count,
@@ -484,6 +500,7 @@ func prepareSessionsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBui
 				session.TOTPFactor.TOTPCheckedAt = totpCheckedAt.Time
 				session.OTPSMSFactor.OTPCheckedAt = otpSMSCheckedAt.Time
 				session.OTPEmailFactor.OTPCheckedAt = otpEmailCheckedAt.Time
+				session.PushFactor.PushCheckedAt = pushCheckedAt.Time
 				session.Metadata = metadata
 				session.Expiration = expiration.Time
 
@@ -20,63 +20,65 @@ import (
 )
 
 var (
-	expectedSessionQuery = regexp.QuoteMeta(`SELECT projections.sessions8.id,` +
-		` projections.sessions8.creation_date,` +
-		` projections.sessions8.change_date,` +
-		` projections.sessions8.sequence,` +
-		` projections.sessions8.state,` +
-		` projections.sessions8.resource_owner,` +
-		` projections.sessions8.creator,` +
-		` projections.sessions8.user_id,` +
-		` projections.sessions8.user_resource_owner,` +
-		` projections.sessions8.user_checked_at,` +
+	expectedSessionQuery = regexp.QuoteMeta(`SELECT projections.sessions9.id,` +
+		` projections.sessions9.creation_date,` +
+		` projections.sessions9.change_date,` +
+		` projections.sessions9.sequence,` +
+		` projections.sessions9.state,` +
+		` projections.sessions9.resource_owner,` +
+		` projections.sessions9.creator,` +
+		` projections.sessions9.user_id,` +
+		` projections.sessions9.user_resource_owner,` +
+		` projections.sessions9.user_checked_at,` +
 		` projections.login_names3.login_name,` +
 		` projections.users13_humans.display_name,` +
-		` projections.sessions8.password_checked_at,` +
-		` projections.sessions8.intent_checked_at,` +
-		` projections.sessions8.webauthn_checked_at,` +
-		` projections.sessions8.webauthn_user_verified,` +
-		` projections.sessions8.totp_checked_at,` +
-		` projections.sessions8.otp_sms_checked_at,` +
-		` projections.sessions8.otp_email_checked_at,` +
-		` projections.sessions8.metadata,` +
-		` projections.sessions8.token_id,` +
-		` projections.sessions8.user_agent_fingerprint_id,` +
-		` projections.sessions8.user_agent_ip,` +
-		` projections.sessions8.user_agent_description,` +
-		` projections.sessions8.user_agent_header,` +
-		` projections.sessions8.expiration` +
-		` FROM projections.sessions8` +
-		` LEFT JOIN projections.login_names3 ON projections.sessions8.user_id = projections.login_names3.user_id AND projections.sessions8.instance_id = projections.login_names3.instance_id` +
-		` LEFT JOIN projections.users13_humans ON projections.sessions8.user_id = projections.users13_humans.user_id AND projections.sessions8.instance_id = projections.users13_humans.instance_id` +
-		` LEFT JOIN projections.users13 ON projections.sessions8.user_id = projections.users13.id AND projections.sessions8.instance_id = projections.users13.instance_id` +
+		` projections.sessions9.password_checked_at,` +
+		` projections.sessions9.intent_checked_at,` +
+		` projections.sessions9.webauthn_checked_at,` +
+		` projections.sessions9.webauthn_user_verified,` +
+		` projections.sessions9.totp_checked_at,` +
+		` projections.sessions9.otp_sms_checked_at,` +
+		` projections.sessions9.otp_email_checked_at,` +
+		` projections.sessions9.push_checked_at,` +
+		` projections.sessions9.metadata,` +
+		` projections.sessions9.token_id,` +
+		` projections.sessions9.user_agent_fingerprint_id,` +
+		` projections.sessions9.user_agent_ip,` +
+		` projections.sessions9.user_agent_description,` +
+		` projections.sessions9.user_agent_header,` +
+		` projections.sessions9.expiration` +
+		` FROM projections.sessions9` +
+		` LEFT JOIN projections.login_names3 ON projections.sessions9.user_id = projections.login_names3.user_id AND projections.sessions9.instance_id = projections.login_names3.instance_id` +
+		` LEFT JOIN projections.users13_humans ON projections.sessions9.user_id = projections.users13_humans.user_id AND projections.sessions9.instance_id = projections.users13_humans.instance_id` +
+		` LEFT JOIN projections.users13 ON projections.sessions9.user_id = projections.users13.id AND projections.sessions9.instance_id = projections.users13.instance_id` +
 		` AS OF SYSTEM TIME '-1 ms'`)
-	expectedSessionsQuery = regexp.QuoteMeta(`SELECT projections.sessions8.id,` +
-		` projections.sessions8.creation_date,` +
-		` projections.sessions8.change_date,` +
-		` projections.sessions8.sequence,` +
-		` projections.sessions8.state,` +
-		` projections.sessions8.resource_owner,` +
-		` projections.sessions8.creator,` +
-		` projections.sessions8.user_id,` +
-		` projections.sessions8.user_resource_owner,` +
-		` projections.sessions8.user_checked_at,` +
+	expectedSessionsQuery = regexp.QuoteMeta(`SELECT projections.sessions9.id,` +
+		` projections.sessions9.creation_date,` +
+		` projections.sessions9.change_date,` +
+		` projections.sessions9.sequence,` +
+		` projections.sessions9.state,` +
+		` projections.sessions9.resource_owner,` +
+		` projections.sessions9.creator,` +
+		` projections.sessions9.user_id,` +
+		` projections.sessions9.user_resource_owner,` +
+		` projections.sessions9.user_checked_at,` +
 		` projections.login_names3.login_name,` +
 		` projections.users13_humans.display_name,` +
-		` projections.sessions8.password_checked_at,` +
-		` projections.sessions8.intent_checked_at,` +
-		` projections.sessions8.webauthn_checked_at,` +
-		` projections.sessions8.webauthn_user_verified,` +
-		` projections.sessions8.totp_checked_at,` +
-		` projections.sessions8.otp_sms_checked_at,` +
-		` projections.sessions8.otp_email_checked_at,` +
-		` projections.sessions8.metadata,` +
-		` projections.sessions8.expiration,` +
+		` projections.sessions9.password_checked_at,` +
+		` projections.sessions9.intent_checked_at,` +
+		` projections.sessions9.webauthn_checked_at,` +
+		` projections.sessions9.webauthn_user_verified,` +
+		` projections.sessions9.totp_checked_at,` +
+		` projections.sessions9.otp_sms_checked_at,` +
+		` projections.sessions9.otp_email_checked_at,` +
+		` projections.sessions9.push_checked_at,` +
+		` projections.sessions9.metadata,` +
+		` projections.sessions9.expiration,` +
 		` COUNT(*) OVER ()` +
-		` FROM projections.sessions8` +
-		` LEFT JOIN projections.login_names3 ON projections.sessions8.user_id = projections.login_names3.user_id AND projections.sessions8.instance_id = projections.login_names3.instance_id` +
-		` LEFT JOIN projections.users13_humans ON projections.sessions8.user_id = projections.users13_humans.user_id AND projections.sessions8.instance_id = projections.users13_humans.instance_id` +
-		` LEFT JOIN projections.users13 ON projections.sessions8.user_id = projections.users13.id AND projections.sessions8.instance_id = projections.users13.instance_id` +
+		` FROM projections.sessions9` +
+		` LEFT JOIN projections.login_names3 ON projections.sessions9.user_id = projections.login_names3.user_id AND projections.sessions9.instance_id = projections.login_names3.instance_id` +
+		` LEFT JOIN projections.users13_humans ON projections.sessions9.user_id = projections.users13_humans.user_id AND projections.sessions9.instance_id = projections.users13_humans.instance_id` +
+		` LEFT JOIN projections.users13 ON projections.sessions9.user_id = projections.users13.id AND projections.sessions9.instance_id = projections.users13.instance_id` +
 		` AS OF SYSTEM TIME '-1 ms'`)
 
 	sessionCols = []string{
@@ -99,6 +101,7 @@ var (
 		"totp_checked_at",
 		"otp_sms_checked_at",
 		"otp_email_checked_at",
+		"push_checked_at",
 		"metadata",
 		"token",
 		"user_agent_fingerprint_id",
@@ -128,6 +131,7 @@ var (
 		"totp_checked_at",
 		"otp_sms_checked_at",
 		"otp_email_checked_at",
+		"push_checked_at",
 		"metadata",
 		"expiration",
 		"count",
@@ -185,6 +189,7 @@ func Test_SessionsPrepare(t *testing.T) {
 							testNow,
 							testNow,
 							testNow,
+							testNow,
 							[]byte(`{"key": "dmFsdWU="}`),
 							testNow,
 						},
@@ -230,6 +235,9 @@ func Test_SessionsPrepare(t *testing.T) {
 						OTPEmailFactor: SessionOTPFactor{
 							OTPCheckedAt: testNow,
 						},
+						PushFactor: SessionPushFactor{
+							PushCheckedAt: testNow,
+						},
 						Metadata: map[string][]byte{
 							"key": []byte("value"),
 						},
@@ -266,6 +274,7 @@ func Test_SessionsPrepare(t *testing.T) {
 							testNow,
 							testNow,
 							testNow,
+							testNow,
 							[]byte(`{"key": "dmFsdWU="}`),
 							testNow,
 						},
@@ -289,6 +298,7 @@ func Test_SessionsPrepare(t *testing.T) {
 							testNow,
 							testNow,
 							testNow,
+							testNow,
 							[]byte(`{"key": "dmFsdWU="}`),
 							testNow,
 						},
@@ -334,6 +344,9 @@ func Test_SessionsPrepare(t *testing.T) {
 						OTPEmailFactor: SessionOTPFactor{
 							OTPCheckedAt: testNow,
 						},
+						PushFactor: SessionPushFactor{
+							PushCheckedAt: testNow,
+						},
 						Metadata: map[string][]byte{
 							"key": []byte("value"),
 						},
@@ -373,6 +386,9 @@ func Test_SessionsPrepare(t *testing.T) {
 						OTPEmailFactor: SessionOTPFactor{
 							OTPCheckedAt: testNow,
 						},
+						PushFactor: SessionPushFactor{
+							PushCheckedAt: testNow,
+						},
 						Metadata: map[string][]byte{
 							"key": []byte("value"),
 						},
@@ -462,6 +478,7 @@ func Test_SessionPrepare(t *testing.T) {
 						testNow,
 						testNow,
 						testNow,
+						testNow,
 						[]byte(`{"key": "dmFsdWU="}`),
 						"tokenID",
 						"fingerPrintID",
@@ -506,6 +523,9 @@ func Test_SessionPrepare(t *testing.T) {
 				OTPEmailFactor: SessionOTPFactor{
 					OTPCheckedAt: testNow,
 				},
+				PushFactor: SessionPushFactor{
+					PushCheckedAt: testNow,
+				},
 				Metadata: map[string][]byte{
 					"key": []byte("value"),
 				},
@@ -32,6 +32,7 @@ type SMSConfig struct {
 	Sequence      uint64
 
 	TwilioConfig *Twilio
+	HTTPConfig   *HTTP
 }
 
 type Twilio struct {
@@ -40,6 +41,13 @@ type Twilio struct {
 	SenderNumber string
 }
 
+// HTTP is the configuration of a generic HTTP provider for SMS and email
+type HTTP struct {
+	Endpoint   string
+	Headers    *crypto.CryptoValue
+	SigningKey *crypto.CryptoValue
+}
+
 type SMSConfigsSearchQueries struct {
 	SearchRequest
 	Queries []SearchQuery
@@ -115,15 +123,39 @@ var (
 	}
 )
 
-func (q *Queries) SMSProviderConfigByID(ctx context.Context, id string) (config *SMSConfig, err error) {
+var (
+	smsHTTPConfigsTable = table{
+		name:          projection.SMSHTTPTable,
+		instanceIDCol: projection.SMSHTTPColumnInstanceID,
+	}
+	SMSHTTPConfigColumnSMSID = Column{
+		name:  projection.SMSHTTPConfigColumnSMSID,
+		table: smsHTTPConfigsTable,
+	}
+	SMSHTTPConfigColumnEndpoint = Column{
+		name:  projection.SMSHTTPConfigColumnEndpoint,
+		table: smsHTTPConfigsTable,
+	}
+	SMSHTTPConfigColumnHeaders = Column{
+		name:  projection.SMSHTTPConfigColumnHeaders,
+		table: smsHTTPConfigsTable,
+	}
+	SMSHTTPConfigColumnSigningKey = Column{
+		name:  projection.SMSHTTPConfigColumnSigningKey,
+		table: smsHTTPConfigsTable,
+	}
+)
+
+func (q *Queries) SMSProviderConfigByID(ctx context.Context, resourceOwner, id string) (config *SMSConfig, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
 	query, scan := prepareSMSConfigQuery(ctx, q.client)
 	stmt, args, err := query.Where(
 		sq.Eq{
-			SMSConfigColumnID.identifier():         id,
-			SMSConfigColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+			SMSConfigColumnID.identifier():            id,
+			SMSConfigColumnResourceOwner.identifier(): resourceOwner,
+			SMSConfigColumnInstanceID.identifier():    authz.GetInstance(ctx).InstanceID(),
 		},
 	).ToSql()
 	if err != nil {
@@ -189,6 +221,10 @@ func NewSMSProviderStateQuery(state domain.SMSConfigState) (SearchQuery, error)
 	return NewNumberQuery(SMSConfigColumnState, state, NumberEquals)
 }
 
+func NewSMSProviderResourceOwnerQuery(resourceOwner string) (SearchQuery, error) {
+	return NewTextQuery(SMSConfigColumnResourceOwner, resourceOwner, TextEquals)
+}
+
 func prepareSMSConfigQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Row) (*SMSConfig, error)) {
 	return sq.Select(
 			SMSConfigColumnID.identifier(),
@@ -203,13 +239,20 @@ func prepareSMSConfigQuery(ctx context.Context, db prepareDatabase) (sq.SelectBu
 			SMSTwilioConfigColumnSID.identifier(),
 			SMSTwilioConfigColumnToken.identifier(),
 			SMSTwilioConfigColumnSenderNumber.identifier(),
+
+			SMSHTTPConfigColumnSMSID.identifier(),
+			SMSHTTPConfigColumnEndpoint.identifier(),
+			SMSHTTPConfigColumnHeaders.identifier(),
+			SMSHTTPConfigColumnSigningKey.identifier(),
 		).From(smsConfigsTable.identifier()).
-			LeftJoin(join(SMSTwilioConfigColumnSMSID, SMSConfigColumnID) + db.Timetravel(call.Took(ctx))).
+			LeftJoin(join(SMSTwilioConfigColumnSMSID, SMSConfigColumnID)).
+			LeftJoin(join(SMSHTTPConfigColumnSMSID, SMSConfigColumnID) + db.Timetravel(call.Took(ctx))).
 			PlaceholderFormat(sq.Dollar), func(row *sql.Row) (*SMSConfig, error) {
 			config := new(SMSConfig)
 
 			var (
 				twilioConfig = sqlTwilioConfig{}
+				httpConfig   = sqlHTTPConfig{}
 			)
 
 			err := row.Scan(
@@ -225,6 +268,11 @@ func prepareSMSConfigQuery(ctx context.Context, db prepareDatabase) (sq.SelectBu
 				&twilioConfig.sid,
 				&twilioConfig.token,
 				&twilioConfig.senderNumber,
+
+				&httpConfig.id,
+				&httpConfig.endpoint,
+				&httpConfig.headers,
+				&httpConfig.signingKey,
 			)
 
 			if err != nil {
@@ -235,6 +283,7 @@ func prepareSMSConfigQuery(ctx context.Context, db prepareDatabase) (sq.SelectBu
 			}
 
 			twilioConfig.set(config)
+			httpConfig.setSMS(config)
 
 			return config, nil
 		}
@@ -254,9 +303,15 @@ func prepareSMSConfigsQuery(ctx context.Context, db prepareDatabase) (sq.SelectB
 			SMSTwilioConfigColumnSID.identifier(),
 			SMSTwilioConfigColumnToken.identifier(),
 			SMSTwilioConfigColumnSenderNumber.identifier(),
+
+			SMSHTTPConfigColumnSMSID.identifier(),
+			SMSHTTPConfigColumnEndpoint.identifier(),
+			SMSHTTPConfigColumnHeaders.identifier(),
+			SMSHTTPConfigColumnSigningKey.identifier(),
 			countColumn.identifier(),
 		).From(smsConfigsTable.identifier()).
-			LeftJoin(join(SMSTwilioConfigColumnSMSID, SMSConfigColumnID) + db.Timetravel(call.Took(ctx))).
+			LeftJoin(join(SMSTwilioConfigColumnSMSID, SMSConfigColumnID)).
+			LeftJoin(join(SMSHTTPConfigColumnSMSID, SMSConfigColumnID) + db.Timetravel(call.Took(ctx))).
 			PlaceholderFormat(sq.Dollar), func(row *sql.Rows) (*SMSConfigs, error) {
 			configs := &SMSConfigs{Configs: []*SMSConfig{}}
 
@@ -264,6 +319,7 @@ func prepareSMSConfigsQuery(ctx context.Context, db prepareDatabase) (sq.SelectB
 				config := new(SMSConfig)
 				var (
 					twilioConfig = sqlTwilioConfig{}
+					httpConfig   = sqlHTTPConfig{}
 				)
 
 				err := row.Scan(
@@ -279,6 +335,11 @@ func prepareSMSConfigsQuery(ctx context.Context, db prepareDatabase) (sq.SelectB
 					&twilioConfig.sid,
 					&twilioConfig.token,
 					&twilioConfig.senderNumber,
+
+					&httpConfig.id,
+					&httpConfig.endpoint,
+					&httpConfig.headers,
+					&httpConfig.signingKey,
 					&configs.Count,
 				)
 
@@ -287,6 +348,7 @@ func prepareSMSConfigsQuery(ctx context.Context, db prepareDatabase) (sq.SelectB
 				}
 
 				twilioConfig.set(config)
+				httpConfig.setSMS(config)
 
 				configs.Configs = append(configs.Configs, config)
 			}
@@ -312,3 +374,29 @@ func (c sqlTwilioConfig) set(smsConfig *SMSConfig) {
 		SenderNumber: c.senderNumber.String,
 	}
 }
+
+type sqlHTTPConfig struct {
+	id         sql.NullString
+	endpoint   sql.NullString
+	headers    *crypto.CryptoValue
+	signingKey *crypto.CryptoValue
+}
+
+func (c sqlHTTPConfig) config() *HTTP {
+	if !c.id.Valid {
+		return nil
+	}
+	return &HTTP{
+		Endpoint:   c.endpoint.String,
+		Headers:    c.headers,
+		SigningKey: c.signingKey,
+	}
+}
+
+func (c sqlHTTPConfig) setSMS(smsConfig *SMSConfig) {
+	smsConfig.HTTPConfig = c.config()
+}
+
+func (c sqlHTTPConfig) setSMTP(smtpConfig *SMTPConfig) {
+	smtpConfig.HTTPConfig = c.config()
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/api/call"
 	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/database"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/query/projection"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
@@ -22,6 +23,14 @@ type SMTPConfigsSearchQueries struct {
 	Queries []SearchQuery
 }
 
+func (q *SMTPConfigsSearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
 type SMTPConfigs struct {
 	SearchResponse
 	Configs []*SMTPConfig
@@ -92,6 +101,45 @@ var (
 		name:  projection.SMTPConfigColumnDescription,
 		table: smtpConfigsTable,
 	}
+	SMTPConfigColumnDKIMDomain = Column{
+		name:  projection.SMTPConfigColumnDKIMDomain,
+		table: smtpConfigsTable,
+	}
+	SMTPConfigColumnDKIMSelector = Column{
+		name:  projection.SMTPConfigColumnDKIMSelector,
+		table: smtpConfigsTable,
+	}
+	SMTPConfigColumnDKIMPrivateKey = Column{
+		name:  projection.SMTPConfigColumnDKIMPrivateKey,
+		table: smtpConfigsTable,
+	}
+	SMTPConfigColumnHeaders = Column{
+		name:  projection.SMTPConfigColumnHeaders,
+		table: smtpConfigsTable,
+	}
+)
+
+var (
+	smtpConfigsHTTPTable = table{
+		name:          projection.SMTPConfigHTTPTable,
+		instanceIDCol: projection.SMTPConfigHTTPColumnInstanceID,
+	}
+	SMTPConfigHTTPColumnID = Column{
+		name:  projection.SMTPConfigHTTPColumnSMTPID,
+		table: smtpConfigsHTTPTable,
+	}
+	SMTPConfigHTTPColumnEndpoint = Column{
+		name:  projection.SMTPConfigHTTPColumnEndpoint,
+		table: smtpConfigsHTTPTable,
+	}
+	SMTPConfigHTTPColumnHeaders = Column{
+		name:  projection.SMTPConfigHTTPColumnHeaders,
+		table: smtpConfigsHTTPTable,
+	}
+	SMTPConfigHTTPColumnSigningKey = Column{
+		name:  projection.SMTPConfigHTTPColumnSigningKey,
+		table: smtpConfigsHTTPTable,
+	}
 )
 
 type SMTPConfig struct {
@@ -109,8 +157,38 @@ type SMTPConfig struct {
 	ID             string
 	State          domain.SMTPConfigState
 	Description    string
+	DKIM           *SMTPConfigDKIM
+	Headers        map[string]string
+
+	HTTPConfig *HTTP
 }
 
+// SMTPConfigDKIM is set if the messages sent through the SMTP configuration are signed
+type SMTPConfigDKIM struct {
+	Domain     string
+	Selector   string
+	PrivateKey *crypto.CryptoValue
+}
+
+type sqlSMTPConfigDKIM struct {
+	domain     sql.NullString
+	selector   sql.NullString
+	privateKey *crypto.CryptoValue
+}
+
+func (d sqlSMTPConfigDKIM) setSMTP(config *SMTPConfig) {
+	if d.privateKey == nil {
+		return
+	}
+	config.DKIM = &SMTPConfigDKIM{
+		Domain:     d.domain.String,
+		Selector:   d.selector.String,
+		PrivateKey: d.privateKey,
+	}
+}
+
+// SMTPConfigActive returns the active SMTP configuration of the resource owner,
+// which is either the instance or an organization of it.
 func (q *Queries) SMTPConfigActive(ctx context.Context, resourceOwner string) (config *SMTPConfig, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
@@ -118,7 +196,7 @@ func (q *Queries) SMTPConfigActive(ctx context.Context, resourceOwner string) (c
 	stmt, scan := prepareSMTPConfigQuery(ctx, q.client)
 	query, args, err := stmt.Where(sq.Eq{
 		SMTPConfigColumnResourceOwner.identifier(): resourceOwner,
-		SMTPConfigColumnInstanceID.identifier():    resourceOwner,
+		SMTPConfigColumnInstanceID.identifier():    authz.GetInstance(ctx).InstanceID(),
 		SMTPConfigColumnState.identifier():         domain.SMTPConfigStateActive,
 	}).ToSql()
 	if err != nil {
@@ -170,11 +248,24 @@ func prepareSMTPConfigQuery(ctx context.Context, db prepareDatabase) (sq.SelectB
 			SMTPConfigColumnSMTPPassword.identifier(),
 			SMTPConfigColumnID.identifier(),
 			SMTPConfigColumnState.identifier(),
-			SMTPConfigColumnDescription.identifier()).
-			From(smtpConfigsTable.identifier() + db.Timetravel(call.Took(ctx))).
+			SMTPConfigColumnDescription.identifier(),
+			SMTPConfigColumnDKIMDomain.identifier(),
+			SMTPConfigColumnDKIMSelector.identifier(),
+			SMTPConfigColumnDKIMPrivateKey.identifier(),
+			SMTPConfigColumnHeaders.identifier(),
+
+			SMTPConfigHTTPColumnID.identifier(),
+			SMTPConfigHTTPColumnEndpoint.identifier(),
+			SMTPConfigHTTPColumnHeaders.identifier(),
+			SMTPConfigHTTPColumnSigningKey.identifier()).
+			From(smtpConfigsTable.identifier()).
+			LeftJoin(join(SMTPConfigHTTPColumnID, SMTPConfigColumnID) + db.Timetravel(call.Took(ctx))).
 			PlaceholderFormat(sq.Dollar),
 		func(row *sql.Row) (*SMTPConfig, error) {
 			config := new(SMTPConfig)
+			dkim := sqlSMTPConfigDKIM{}
+			var headers database.Map[string]
+			httpConfig := sqlHTTPConfig{}
 			err := row.Scan(
 				&config.CreationDate,
 				&config.ChangeDate,
@@ -190,6 +281,15 @@ func prepareSMTPConfigQuery(ctx context.Context, db prepareDatabase) (sq.SelectB
 				&config.ID,
 				&config.State,
 				&config.Description,
+				&dkim.domain,
+				&dkim.selector,
+				&dkim.privateKey,
+				&headers,
+
+				&httpConfig.id,
+				&httpConfig.endpoint,
+				&httpConfig.headers,
+				&httpConfig.signingKey,
 			)
 			if err != nil {
 				if errors.Is(err, sql.ErrNoRows) {
@@ -198,6 +298,9 @@ func prepareSMTPConfigQuery(ctx context.Context, db prepareDatabase) (sq.SelectB
 				return nil, zerrors.ThrowInternal(err, "QUERY-9k87F", "Errors.Internal")
 			}
 			config.Password = password
+			config.Headers = headers
+			dkim.setSMTP(config)
+			httpConfig.setSMTP(config)
 			return config, nil
 		}
 }
@@ -218,13 +321,26 @@ func prepareSMTPConfigsQuery(ctx context.Context, db prepareDatabase) (sq.Select
 			SMTPConfigColumnID.identifier(),
 			SMTPConfigColumnState.identifier(),
 			SMTPConfigColumnDescription.identifier(),
+			SMTPConfigColumnDKIMDomain.identifier(),
+			SMTPConfigColumnDKIMSelector.identifier(),
+			SMTPConfigColumnDKIMPrivateKey.identifier(),
+			SMTPConfigColumnHeaders.identifier(),
+
+			SMTPConfigHTTPColumnID.identifier(),
+			SMTPConfigHTTPColumnEndpoint.identifier(),
+			SMTPConfigHTTPColumnHeaders.identifier(),
+			SMTPConfigHTTPColumnSigningKey.identifier(),
 			countColumn.identifier()).
-			From(smtpConfigsTable.identifier() + db.Timetravel(call.Took(ctx))).
+			From(smtpConfigsTable.identifier()).
+			LeftJoin(join(SMTPConfigHTTPColumnID, SMTPConfigColumnID) + db.Timetravel(call.Took(ctx))).
 			PlaceholderFormat(sq.Dollar),
 		func(rows *sql.Rows) (*SMTPConfigs, error) {
 			configs := &SMTPConfigs{Configs: []*SMTPConfig{}}
 			for rows.Next() {
 				config := new(SMTPConfig)
+				dkim := sqlSMTPConfigDKIM{}
+				var headers database.Map[string]
+				httpConfig := sqlHTTPConfig{}
 				err := rows.Scan(
 					&config.CreationDate,
 					&config.ChangeDate,
@@ -240,6 +356,15 @@ func prepareSMTPConfigsQuery(ctx context.Context, db prepareDatabase) (sq.Select
 					&config.ID,
 					&config.State,
 					&config.Description,
+					&dkim.domain,
+					&dkim.selector,
+					&dkim.privateKey,
+					&headers,
+
+					&httpConfig.id,
+					&httpConfig.endpoint,
+					&httpConfig.headers,
+					&httpConfig.signingKey,
 					&configs.Count,
 				)
 				if err != nil {
@@ -248,12 +373,19 @@ func prepareSMTPConfigsQuery(ctx context.Context, db prepareDatabase) (sq.Select
 					}
 					return nil, zerrors.ThrowInternal(err, "QUERY-9k87F", "Errors.Internal")
 				}
+				config.Headers = headers
+				dkim.setSMTP(config)
+				httpConfig.setSMTP(config)
 				configs.Configs = append(configs.Configs, config)
 			}
 			return configs, nil
 		}
 }
 
+func NewSMTPConfigResourceOwnerSearchQuery(resourceOwner string) (SearchQuery, error) {
+	return NewTextQuery(SMTPConfigColumnResourceOwner, resourceOwner, TextEquals)
+}
+
 func (q *Queries) SearchSMTPConfigs(ctx context.Context, queries *SMTPConfigsSearchQueries) (configs *SMTPConfigs, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
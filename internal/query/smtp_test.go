@@ -14,21 +14,30 @@ import (
 )
 
 var (
-	prepareSMTPConfigStmt = `SELECT projections.smtp_configs2.creation_date,` +
-		` projections.smtp_configs2.change_date,` +
-		` projections.smtp_configs2.resource_owner,` +
-		` projections.smtp_configs2.sequence,` +
-		` projections.smtp_configs2.tls,` +
-		` projections.smtp_configs2.sender_address,` +
-		` projections.smtp_configs2.sender_name,` +
-		` projections.smtp_configs2.reply_to_address,` +
-		` projections.smtp_configs2.host,` +
-		` projections.smtp_configs2.username,` +
-		` projections.smtp_configs2.password,` +
-		` projections.smtp_configs2.id,` +
-		` projections.smtp_configs2.state,` +
-		` projections.smtp_configs2.description` +
-		` FROM projections.smtp_configs2` +
+	prepareSMTPConfigStmt = `SELECT projections.smtp_configs4.creation_date,` +
+		` projections.smtp_configs4.change_date,` +
+		` projections.smtp_configs4.resource_owner,` +
+		` projections.smtp_configs4.sequence,` +
+		` projections.smtp_configs4.tls,` +
+		` projections.smtp_configs4.sender_address,` +
+		` projections.smtp_configs4.sender_name,` +
+		` projections.smtp_configs4.reply_to_address,` +
+		` projections.smtp_configs4.host,` +
+		` projections.smtp_configs4.username,` +
+		` projections.smtp_configs4.password,` +
+		` projections.smtp_configs4.id,` +
+		` projections.smtp_configs4.state,` +
+		` projections.smtp_configs4.description,` +
+		` projections.smtp_configs4.dkim_domain,` +
+		` projections.smtp_configs4.dkim_selector,` +
+		` projections.smtp_configs4.dkim_private_key,` +
+		` projections.smtp_configs4.headers,` +
+		` projections.smtp_configs4_http.smtp_id,` +
+		` projections.smtp_configs4_http.endpoint,` +
+		` projections.smtp_configs4_http.headers,` +
+		` projections.smtp_configs4_http.signing_key` +
+		` FROM projections.smtp_configs4` +
+		` LEFT JOIN projections.smtp_configs4_http ON projections.smtp_configs4.id = projections.smtp_configs4_http.smtp_id AND projections.smtp_configs4.instance_id = projections.smtp_configs4_http.instance_id` +
 		` AS OF SYSTEM TIME '-1 ms'`
 	prepareSMTPConfigCols = []string{
 		"creation_date",
@@ -45,6 +54,14 @@ var (
 		"id",
 		"state",
 		"description",
+		"dkim_domain",
+		"dkim_selector",
+		"dkim_private_key",
+		"headers",
+		"smtp_id",
+		"endpoint",
+		"headers",
+		"signing_key",
 	}
 )
 
@@ -99,6 +116,14 @@ func Test_SMTPConfigsPrepares(t *testing.T) {
 						"2232323",
 						domain.SMTPConfigStateActive,
 						"test",
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
 					},
 				),
 			},
@@ -141,6 +166,14 @@ func Test_SMTPConfigsPrepares(t *testing.T) {
 						"44442323",
 						domain.SMTPConfigStateInactive,
 						"test2",
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
 					},
 				),
 			},
@@ -183,6 +216,14 @@ func Test_SMTPConfigsPrepares(t *testing.T) {
 						"23234444",
 						domain.SMTPConfigStateInactive,
 						"test3",
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
 					},
 				),
 			},
@@ -203,6 +244,109 @@ func Test_SMTPConfigsPrepares(t *testing.T) {
 				Description:    "test3",
 			},
 		},
+		{
+			name:    "prepareSMTPConfigQuery dkim config found",
+			prepare: prepareSMTPConfigQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					regexp.QuoteMeta(prepareSMTPConfigStmt),
+					prepareSMTPConfigCols,
+					[]driver.Value{
+						testNow,
+						testNow,
+						"ro",
+						uint64(20211109),
+						true,
+						"sender4",
+						"name4",
+						"reply-to4",
+						"host4",
+						"user4",
+						&crypto.CryptoValue{},
+						"34344444",
+						domain.SMTPConfigStateActive,
+						"test4",
+						"example.com",
+						"zitadel",
+						&crypto.CryptoValue{},
+						[]byte(`{"List-Unsubscribe": "<https://example.com/unsubscribe>"}`),
+						nil,
+						nil,
+						nil,
+						nil,
+					},
+				),
+			},
+			object: &SMTPConfig{
+				CreationDate:   testNow,
+				ChangeDate:     testNow,
+				ResourceOwner:  "ro",
+				Sequence:       20211109,
+				TLS:            true,
+				SenderAddress:  "sender4",
+				SenderName:     "name4",
+				ReplyToAddress: "reply-to4",
+				Host:           "host4",
+				User:           "user4",
+				Password:       &crypto.CryptoValue{},
+				ID:             "34344444",
+				State:          domain.SMTPConfigStateActive,
+				Description:    "test4",
+				DKIM: &SMTPConfigDKIM{
+					Domain:     "example.com",
+					Selector:   "zitadel",
+					PrivateKey: &crypto.CryptoValue{},
+				},
+				Headers: map[string]string{"List-Unsubscribe": "<https://example.com/unsubscribe>"},
+			},
+		},
+		{
+			name:    "prepareSMTPConfigQuery http config found",
+			prepare: prepareSMTPConfigQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					regexp.QuoteMeta(prepareSMTPConfigStmt),
+					prepareSMTPConfigCols,
+					[]driver.Value{
+						testNow,
+						testNow,
+						"ro",
+						uint64(20211109),
+						false,
+						"",
+						"",
+						"",
+						"",
+						"",
+						nil,
+						"23234445",
+						domain.SMTPConfigStateActive,
+						"http",
+						nil,
+						nil,
+						nil,
+						nil,
+						"23234445",
+						"https://example.com/email",
+						&crypto.CryptoValue{},
+						nil,
+					},
+				),
+			},
+			object: &SMTPConfig{
+				CreationDate:  testNow,
+				ChangeDate:    testNow,
+				ResourceOwner: "ro",
+				Sequence:      20211109,
+				ID:            "23234445",
+				State:         domain.SMTPConfigStateActive,
+				Description:   "http",
+				HTTPConfig: &HTTP{
+					Endpoint: "https://example.com/email",
+					Headers:  &crypto.CryptoValue{},
+				},
+			},
+		},
 		{
 			name:    "prepareSMTPConfigQuery sql err",
 			prepare: prepareSMTPConfigQuery,
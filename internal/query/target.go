@@ -85,6 +85,22 @@ type Target struct {
 	InterruptOnError bool
 }
 
+func (t *Target) GetTargetID() string {
+	return t.ID
+}
+func (t *Target) IsInterruptOnError() bool {
+	return t.InterruptOnError
+}
+func (t *Target) GetEndpoint() string {
+	return t.Endpoint
+}
+func (t *Target) GetTargetType() domain.TargetType {
+	return t.TargetType
+}
+func (t *Target) GetTimeout() time.Duration {
+	return t.Timeout
+}
+
 type TargetSearchQueries struct {
 	SearchRequest
 	Queries []SearchQuery
@@ -0,0 +1,199 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// UserLifecycleCandidate is a user for which an action of the user lifecycle policy is due.
+type UserLifecycleCandidate struct {
+	UserID        string
+	ResourceOwner string
+	State         domain.UserState
+	LastLogin     time.Time
+	LastActivity  time.Time
+	DeactivatedAt time.Time
+
+	Action domain.UserLifecycleAction
+	// DueDate is the date the user is (going to be) deactivated or deleted.
+	DueDate time.Time
+}
+
+var (
+	userActivityTable = table{
+		name:          projection.UserActivityTable,
+		instanceIDCol: projection.UserActivityInstanceIDCol,
+	}
+	UserActivityColUserID = Column{
+		name:  projection.UserActivityUserIDCol,
+		table: userActivityTable,
+	}
+	UserActivityColResourceOwner = Column{
+		name:  projection.UserActivityResourceOwnerCol,
+		table: userActivityTable,
+	}
+	UserActivityColInstanceID = Column{
+		name:  projection.UserActivityInstanceIDCol,
+		table: userActivityTable,
+	}
+	UserActivityColState = Column{
+		name:  projection.UserActivityStateCol,
+		table: userActivityTable,
+	}
+	UserActivityColLastLogin = Column{
+		name:  projection.UserActivityLastLoginCol,
+		table: userActivityTable,
+	}
+	UserActivityColLastActivity = Column{
+		name:  projection.UserActivityLastActivityCol,
+		table: userActivityTable,
+	}
+	UserActivityColDeactivatedAt = Column{
+		name:  projection.UserActivityDeactivatedAtCol,
+		table: userActivityTable,
+	}
+	UserActivityColDeactivationWarnedAt = Column{
+		name:  projection.UserActivityDeactivationWarnedAtCol,
+		table: userActivityTable,
+	}
+	UserActivityColDeletionWarnedAt = Column{
+		name:  projection.UserActivityDeletionWarnedAtCol,
+		table: userActivityTable,
+	}
+)
+
+type userActivity struct {
+	UserLifecycleCandidate
+	deactivationWarnedAt time.Time
+	deletionWarnedAt     time.Time
+}
+
+// UserLifecycleCandidates returns the users of the organization of the policy for which an action is due at now.
+// Nothing is changed, so the result can be used as a dry run of the policy.
+func (q *Queries) UserLifecycleCandidates(ctx context.Context, shouldTriggerBulk bool, policy *UserLifecyclePolicy, now time.Time) (candidates []*UserLifecycleCandidate, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if shouldTriggerBulk {
+		_, traceSpan := tracing.NewNamedSpan(ctx, "TriggerUserActivityProjection")
+		ctx, err = projection.UserActivityProjection.Trigger(ctx, handler.WithAwaitRunning())
+		logging.OnError(err).Debug("trigger failed")
+		traceSpan.EndWithError(err)
+	}
+
+	domainPolicy := policy.ToDomain()
+	// only load users for which a warning could be due, the final decision is made by the policy
+	due := sq.Or{}
+	if domainPolicy.DeactivateAfterDays > 0 {
+		due = append(due, sq.And{
+			sq.Eq{UserActivityColState.identifier(): domain.UserStateActive},
+			sq.LtOrEq{UserActivityColLastActivity.identifier(): now.AddDate(0, 0, -int(domainPolicy.DeactivateAfterDays-domainPolicy.WarnDaysBefore))},
+		})
+	}
+	if domainPolicy.DeleteAfterDays > 0 {
+		due = append(due, sq.And{
+			sq.Eq{UserActivityColState.identifier(): domain.UserStateInactive},
+			sq.LtOrEq{UserActivityColDeactivatedAt.identifier(): now.AddDate(0, 0, -int(domainPolicy.DeleteAfterDays-domainPolicy.WarnDaysBefore))},
+		})
+	}
+	if len(due) == 0 {
+		return nil, nil
+	}
+
+	stmt, scan := prepareUserActivitiesQuery(ctx, q.client)
+	query, args, err := stmt.Where(sq.And{
+		sq.Eq{
+			UserActivityColInstanceID.identifier():    authz.GetInstance(ctx).InstanceID(),
+			UserActivityColResourceOwner.identifier(): policy.ID,
+		},
+		due,
+	}).OrderBy(UserActivityColUserID.identifier()).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Rf4hx", "Errors.Query.SQLStatement")
+	}
+
+	var activities []*userActivity
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		activities, err = scan(rows)
+		return err
+	}, query, args...)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Hx0oq", "Errors.Internal")
+	}
+
+	candidates = make([]*UserLifecycleCandidate, 0, len(activities))
+	for _, activity := range activities {
+		switch activity.State {
+		case domain.UserStateActive:
+			activity.Action, activity.DueDate = domainPolicy.DeactivationAction(activity.LastActivity, activity.deactivationWarnedAt, now)
+		case domain.UserStateInactive:
+			activity.Action, activity.DueDate = domainPolicy.DeletionAction(activity.DeactivatedAt, activity.deletionWarnedAt, now)
+		}
+		if activity.Action == domain.UserLifecycleActionUnspecified {
+			continue
+		}
+		candidates = append(candidates, &activity.UserLifecycleCandidate)
+	}
+	return candidates, nil
+}
+
+func prepareUserActivitiesQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) ([]*userActivity, error)) {
+	return sq.Select(
+			UserActivityColUserID.identifier(),
+			UserActivityColResourceOwner.identifier(),
+			UserActivityColState.identifier(),
+			UserActivityColLastLogin.identifier(),
+			UserActivityColLastActivity.identifier(),
+			UserActivityColDeactivatedAt.identifier(),
+			UserActivityColDeactivationWarnedAt.identifier(),
+			UserActivityColDeletionWarnedAt.identifier(),
+		).
+			From(userActivityTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) ([]*userActivity, error) {
+			activities := make([]*userActivity, 0)
+			for rows.Next() {
+				var (
+					activity             = new(userActivity)
+					lastLogin            sql.NullTime
+					deactivatedAt        sql.NullTime
+					deactivationWarnedAt sql.NullTime
+					deletionWarnedAt     sql.NullTime
+				)
+				err := rows.Scan(
+					&activity.UserID,
+					&activity.ResourceOwner,
+					&activity.State,
+					&lastLogin,
+					&activity.LastActivity,
+					&deactivatedAt,
+					&deactivationWarnedAt,
+					&deletionWarnedAt,
+				)
+				if err != nil {
+					return nil, err
+				}
+				activity.LastLogin = lastLogin.Time
+				activity.DeactivatedAt = deactivatedAt.Time
+				activity.deactivationWarnedAt = deactivationWarnedAt.Time
+				activity.deletionWarnedAt = deletionWarnedAt.Time
+				activities = append(activities, activity)
+			}
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Kc3mw", "Errors.Query.CloseRows")
+			}
+			return activities, nil
+		}
+}
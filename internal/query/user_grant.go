@@ -29,6 +29,9 @@ type UserGrant struct {
 	// GrantID represents the project grant id
 	GrantID string                `json:"grant_id,omitempty"`
 	State   domain.UserGrantState `json:"state,omitempty"`
+	// ValidFrom and ValidUntil are zero if the grant is not time-bound
+	ValidFrom  time.Time `json:"valid_from,omitempty"`
+	ValidUntil time.Time `json:"valid_until,omitempty"`
 
 	UserID             string          `json:"user_id,omitempty"`
 	Username           string          `json:"username,omitempty"`
@@ -51,6 +54,10 @@ type UserGrant struct {
 	GrantedOrgID     string `json:"granted_org_id,omitempty"`
 	GrantedOrgName   string `json:"granted_org_name,omitempty"`
 	GrantedOrgDomain string `json:"granted_org_domain,omitempty"`
+
+	// GroupID is set if the roles are granted to a group the user is a member of
+	GroupID   string `json:"group_id,omitempty"`
+	GroupName string `json:"group_name,omitempty"`
 }
 
 type UserGrants struct {
@@ -155,6 +162,16 @@ func NewUserGrantWithGrantedQuery(owner string) (SearchQuery, error) {
 	return NewOrQuery(orgQuery, projectQuery)
 }
 
+// NewUserGrantValidAtQuery filters out the time-bound grants which are not valid at the time.
+func NewUserGrantValidAtQuery(at time.Time) (SearchQuery, error) {
+	return newValidAtQuery(UserGrantValidFrom, UserGrantValidUntil, at)
+}
+
+// NewUserGrantExpiredQuery returns the time-bound grants which ended before the time.
+func NewUserGrantExpiredQuery(at time.Time) (SearchQuery, error) {
+	return NewTimestampQuery(UserGrantValidUntil, at, TimestampLessOrEquals)
+}
+
 func NewUserGrantContainsRolesSearchQuery(roles ...string) (SearchQuery, error) {
 	r := make([]interface{}, len(roles))
 	for i, role := range roles {
@@ -212,6 +229,14 @@ var (
 		name:  projection.UserGrantState,
 		table: userGrantTable,
 	}
+	UserGrantValidFrom = Column{
+		name:  projection.UserGrantValidFrom,
+		table: userGrantTable,
+	}
+	UserGrantValidUntil = Column{
+		name:  projection.UserGrantValidUntil,
+		table: userGrantTable,
+	}
 	GrantedOrgsTable = table{
 		name:          projection.OrgProjectionTable,
 		alias:         "granted_orgs",
@@ -229,6 +254,20 @@ var (
 		name:  projection.OrgColumnDomain,
 		table: GrantedOrgsTable,
 	}
+	// userGrantsWithGroupsTable is the subquery combining the user grants
+	// with the grants the user receives through its groups
+	userGrantsWithGroupsTable = table{
+		name:          "user_grants",
+		instanceIDCol: projection.UserGrantInstanceID,
+	}
+	userGrantGroupID = Column{
+		name:  "group_id",
+		table: userGrantsWithGroupsTable,
+	}
+	userGrantGroupName = Column{
+		name:  "group_name",
+		table: userGrantsWithGroupsTable,
+	}
 )
 
 func (q *Queries) UserGrant(ctx context.Context, shouldTriggerBulk bool, queries ...SearchQuery) (grant *UserGrant, err error) {
@@ -294,6 +333,102 @@ func (q *Queries) UserGrants(ctx context.Context, queries *UserGrantsQueries, sh
 	return grants, nil
 }
 
+// UserGrantsWithGroupGrants returns the grants of the user including the grants the user receives through its groups.
+// The search queries, the paging and the count apply to both kinds of grants.
+func (q *Queries) UserGrantsWithGroupGrants(ctx context.Context, userID string, queries *UserGrantsQueries, shouldTriggerBulk bool) (grants *UserGrants, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if shouldTriggerBulk {
+		_, traceSpan := tracing.NewNamedSpan(ctx, "TriggerUserGrantProjection")
+		ctx, err = projection.UserGrantProjection.Trigger(ctx, handler.WithAwaitRunning())
+		logging.OnError(err).Debug("unable to trigger")
+		traceSpan.EndWithError(err)
+	}
+
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	from, fromArgs := getUserGrantsWithGroupGrantsFromQuery(userID, instanceID)
+	query, scan := prepareUserGrantsFromQuery(ctx, q.client, userGrantsWithGroupsTable, from, true)
+	grantsQueries := &UserGrantsQueries{
+		SearchRequest: queries.SearchRequest,
+		Queries:       replaceTables(queries.Queries, userGrantTable, userGrantsWithGroupsTable),
+	}
+	grantsQueries.SortingColumn = grantsQueries.SortingColumn.replaceTable(userGrantTable, userGrantsWithGroupsTable)
+	eq := sq.Eq{UserGrantInstanceID.setTable(userGrantsWithGroupsTable).identifier(): instanceID}
+	stmt, args, err := grantsQueries.toQuery(query).Where(eq).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Ug3wg", "Errors.Query.SQLStatement")
+	}
+
+	latestSequence, err := q.latestState(ctx, userGrantTable, groupTable)
+	if err != nil {
+		return nil, err
+	}
+
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		grants, err = scan(rows)
+		return err
+	}, stmt, append(fromArgs, args...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	grants.State = latestSequence
+	return grants, nil
+}
+
+// userGroupGrantsStmt selects the grants the user receives through its groups, including the groups the user's groups are nested in.
+// The grants have the columns of the user grant projection followed by the group id and name.
+const userGroupGrantsStmt = "WITH RECURSIVE user_groups AS (" +
+	"SELECT group_id, instance_id FROM " + projection.GroupMemberTable + " WHERE member_id = ? AND member_type = ? AND instance_id = ?" +
+	" UNION" +
+	" SELECT m.group_id, m.instance_id FROM " + projection.GroupMemberTable + " m" +
+	" JOIN user_groups s ON m.member_id = s.group_id AND m.instance_id = s.instance_id" +
+	" WHERE m.member_type = ?" +
+	") SELECT gg.group_id AS id, gg.creation_date, gg.change_date, gg.sequence, gg.grant_id, gg.roles," +
+	" ?::SMALLINT AS state, NULL::TIMESTAMPTZ AS valid_from, NULL::TIMESTAMPTZ AS valid_until," +
+	" ?::TEXT AS user_id, g.resource_owner, gg.project_id, gg.instance_id, g.id AS group_id, g.name AS group_name" +
+	" FROM " + projection.GroupTable + " g" +
+	" JOIN user_groups s ON g.id = s.group_id AND g.instance_id = s.instance_id" +
+	" JOIN " + projection.GroupGrantTable + " gg ON gg.group_id = g.id AND gg.instance_id = g.instance_id"
+
+// getUserGrantsWithGroupGrantsFromQuery returns the subquery of the user's grants and the grants the user receives through its groups.
+// The group grants are identified by the id of the group.
+func getUserGrantsWithGroupGrantsFromQuery(userID, instanceID string) (string, []interface{}) {
+	userGrants, userGrantsArgs := sq.Select(
+		UserGrantID.identifier(),
+		UserGrantCreationDate.identifier(),
+		UserGrantChangeDate.identifier(),
+		UserGrantSequence.identifier(),
+		UserGrantGrantID.identifier(),
+		UserGrantRoles.identifier(),
+		UserGrantState.identifier(),
+		UserGrantValidFrom.identifier(),
+		UserGrantValidUntil.identifier(),
+		UserGrantUserID.identifier(),
+		UserGrantResourceOwner.identifier(),
+		UserGrantProjectID.identifier(),
+		UserGrantInstanceID.identifier(),
+		"NULL::TEXT AS "+userGrantGroupID.name,
+		"NULL::TEXT AS "+userGrantGroupName.name,
+	).From(userGrantTable.identifier()).
+		Where(sq.Eq{
+			UserGrantUserID.identifier():     userID,
+			UserGrantInstanceID.identifier(): instanceID,
+		}).MustSql()
+	args := append(userGrantsArgs,
+		userID, domain.GroupMemberTypeUser, instanceID, domain.GroupMemberTypeGroup,
+		domain.UserGrantStateActive, userID,
+	)
+
+	return "(" +
+			userGrants +
+			" UNION ALL (" +
+			userGroupGrantsStmt +
+			")) AS " + userGrantsWithGroupsTable.identifier(),
+		args
+}
+
 func prepareUserGrantQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Row) (*UserGrant, error)) {
 	return sq.Select(
 			UserGrantID.identifier(),
@@ -303,6 +438,8 @@ func prepareUserGrantQuery(ctx context.Context, db prepareDatabase) (sq.SelectBu
 			UserGrantGrantID.identifier(),
 			UserGrantRoles.identifier(),
 			UserGrantState.identifier(),
+			UserGrantValidFrom.identifier(),
+			UserGrantValidUntil.identifier(),
 
 			UserGrantUserID.identifier(),
 			UserUsernameCol.identifier(),
@@ -340,6 +477,9 @@ func prepareUserGrantQuery(ctx context.Context, db prepareDatabase) (sq.SelectBu
 			g := new(UserGrant)
 
 			var (
+				validFrom  sql.NullTime
+				validUntil sql.NullTime
+
 				username           sql.NullString
 				firstName          sql.NullString
 				userType           sql.NullInt32
@@ -368,6 +508,8 @@ func prepareUserGrantQuery(ctx context.Context, db prepareDatabase) (sq.SelectBu
 				&g.GrantID,
 				&g.Roles,
 				&g.State,
+				&validFrom,
+				&validUntil,
 
 				&g.UserID,
 				&username,
@@ -398,6 +540,8 @@ func prepareUserGrantQuery(ctx context.Context, db prepareDatabase) (sq.SelectBu
 				return nil, zerrors.ThrowInternal(err, "QUERY-oQPcP", "Errors.Internal")
 			}
 
+			g.ValidFrom = validFrom.Time
+			g.ValidUntil = validUntil.Time
 			g.Username = username.String
 			g.UserType = domain.UserType(userType.Int32)
 			g.UserResourceOwner = userOwner.String
@@ -418,46 +562,65 @@ func prepareUserGrantQuery(ctx context.Context, db prepareDatabase) (sq.SelectBu
 }
 
 func prepareUserGrantsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) (*UserGrants, error)) {
-	return sq.Select(
-			UserGrantID.identifier(),
-			UserGrantCreationDate.identifier(),
-			UserGrantChangeDate.identifier(),
-			UserGrantSequence.identifier(),
-			UserGrantGrantID.identifier(),
-			UserGrantRoles.identifier(),
-			UserGrantState.identifier(),
-
-			UserGrantUserID.identifier(),
-			UserUsernameCol.identifier(),
-			UserTypeCol.identifier(),
-			UserResourceOwnerCol.identifier(),
-			HumanFirstNameCol.identifier(),
-			HumanLastNameCol.identifier(),
-			HumanEmailCol.identifier(),
-			HumanDisplayNameCol.identifier(),
-			HumanAvatarURLCol.identifier(),
-			LoginNameNameCol.identifier(),
-
-			UserGrantResourceOwner.identifier(),
-			OrgColumnName.identifier(),
-			OrgColumnDomain.identifier(),
-
-			UserGrantProjectID.identifier(),
-			ProjectColumnName.identifier(),
-
-			GrantedOrgColumnId.identifier(),
-			GrantedOrgColumnName.identifier(),
-			GrantedOrgColumnDomain.identifier(),
+	return prepareUserGrantsFromQuery(ctx, db, userGrantTable, userGrantTable.identifier(), false)
+}
 
-			countColumn.identifier(),
-		).
-			From(userGrantTable.identifier()).
-			LeftJoin(join(UserIDCol, UserGrantUserID)).
-			LeftJoin(join(HumanUserIDCol, UserGrantUserID)).
-			LeftJoin(join(OrgColumnID, UserGrantResourceOwner)).
-			LeftJoin(join(ProjectColumnID, UserGrantProjectID)).
+// prepareUserGrantsFromQuery selects the user grants from the table, which is either the user grant projection
+// or a subquery selecting the same columns.
+// If withGroups is set, the table must also select the group id and name of the grants.
+func prepareUserGrantsFromQuery(ctx context.Context, db prepareDatabase, grants table, from string, withGroups bool) (sq.SelectBuilder, func(*sql.Rows) (*UserGrants, error)) {
+	userID := UserGrantUserID.setTable(grants)
+	resourceOwner := UserGrantResourceOwner.setTable(grants)
+	projectID := UserGrantProjectID.setTable(grants)
+	columns := []string{
+		UserGrantID.setTable(grants).identifier(),
+		UserGrantCreationDate.setTable(grants).identifier(),
+		UserGrantChangeDate.setTable(grants).identifier(),
+		UserGrantSequence.setTable(grants).identifier(),
+		UserGrantGrantID.setTable(grants).identifier(),
+		UserGrantRoles.setTable(grants).identifier(),
+		UserGrantState.setTable(grants).identifier(),
+		UserGrantValidFrom.setTable(grants).identifier(),
+		UserGrantValidUntil.setTable(grants).identifier(),
+
+		userID.identifier(),
+		UserUsernameCol.identifier(),
+		UserTypeCol.identifier(),
+		UserResourceOwnerCol.identifier(),
+		HumanFirstNameCol.identifier(),
+		HumanLastNameCol.identifier(),
+		HumanEmailCol.identifier(),
+		HumanDisplayNameCol.identifier(),
+		HumanAvatarURLCol.identifier(),
+		LoginNameNameCol.identifier(),
+
+		resourceOwner.identifier(),
+		OrgColumnName.identifier(),
+		OrgColumnDomain.identifier(),
+
+		projectID.identifier(),
+		ProjectColumnName.identifier(),
+
+		GrantedOrgColumnId.identifier(),
+		GrantedOrgColumnName.identifier(),
+		GrantedOrgColumnDomain.identifier(),
+	}
+	if withGroups {
+		columns = append(columns,
+			userGrantGroupID.identifier(),
+			userGrantGroupName.identifier(),
+		)
+	}
+	columns = append(columns, countColumn.identifier())
+
+	return sq.Select(columns...).
+			From(from).
+			LeftJoin(join(UserIDCol, userID)).
+			LeftJoin(join(HumanUserIDCol, userID)).
+			LeftJoin(join(OrgColumnID, resourceOwner)).
+			LeftJoin(join(ProjectColumnID, projectID)).
 			LeftJoin(join(GrantedOrgColumnId, UserResourceOwnerCol)).
-			LeftJoin(join(LoginNameUserIDCol, UserGrantUserID) + db.Timetravel(call.Took(ctx))).
+			LeftJoin(join(LoginNameUserIDCol, userID) + db.Timetravel(call.Took(ctx))).
 			Where(
 				sq.Eq{LoginNameIsPrimaryCol.identifier(): true},
 			).PlaceholderFormat(sq.Dollar),
@@ -468,6 +631,9 @@ func prepareUserGrantsQuery(ctx context.Context, db prepareDatabase) (sq.SelectB
 				g := new(UserGrant)
 
 				var (
+					validFrom  sql.NullTime
+					validUntil sql.NullTime
+
 					username           sql.NullString
 					userType           sql.NullInt32
 					userOwner          sql.NullString
@@ -486,9 +652,12 @@ func prepareUserGrantsQuery(ctx context.Context, db prepareDatabase) (sq.SelectB
 					grantedOrgDomain sql.NullString
 
 					projectName sql.NullString
+
+					groupID   sql.NullString
+					groupName sql.NullString
 				)
 
-				err := rows.Scan(
+				dest := []any{
 					&g.ID,
 					&g.CreationDate,
 					&g.ChangeDate,
@@ -496,6 +665,8 @@ func prepareUserGrantsQuery(ctx context.Context, db prepareDatabase) (sq.SelectB
 					&g.GrantID,
 					&g.Roles,
 					&g.State,
+					&validFrom,
+					&validUntil,
 
 					&g.UserID,
 					&username,
@@ -518,13 +689,17 @@ func prepareUserGrantsQuery(ctx context.Context, db prepareDatabase) (sq.SelectB
 					&grantedOrgID,
 					&grantedOrgName,
 					&grantedOrgDomain,
-
-					&count,
-				)
+				}
+				if withGroups {
+					dest = append(dest, &groupID, &groupName)
+				}
+				err := rows.Scan(append(dest, &count)...)
 				if err != nil {
 					return nil, err
 				}
 
+				g.ValidFrom = validFrom.Time
+				g.ValidUntil = validUntil.Time
 				g.Username = username.String
 				g.UserType = domain.UserType(userType.Int32)
 				g.UserResourceOwner = userOwner.String
@@ -540,6 +715,8 @@ func prepareUserGrantsQuery(ctx context.Context, db prepareDatabase) (sq.SelectB
 				g.GrantedOrgID = grantedOrgID.String
 				g.GrantedOrgName = grantedOrgName.String
 				g.GrantedOrgDomain = grantedOrgDomain.String
+				g.GroupID = groupID.String
+				g.GroupName = groupName.String
 
 				userGrants = append(userGrants, g)
 			}
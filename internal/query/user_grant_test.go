@@ -1,6 +1,7 @@
 package query
 
 import (
+	"context"
 	"database/sql"
 	"database/sql/driver"
 	"errors"
@@ -8,6 +9,8 @@ import (
 	"regexp"
 	"testing"
 
+	sq "github.com/Masterminds/squirrel"
+
 	"github.com/zitadel/zitadel/internal/database"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/zerrors"
@@ -15,14 +18,16 @@ import (
 
 var (
 	userGrantStmt = regexp.QuoteMeta(
-		"SELECT projections.user_grants5.id" +
-			", projections.user_grants5.creation_date" +
-			", projections.user_grants5.change_date" +
-			", projections.user_grants5.sequence" +
-			", projections.user_grants5.grant_id" +
-			", projections.user_grants5.roles" +
-			", projections.user_grants5.state" +
-			", projections.user_grants5.user_id" +
+		"SELECT projections.user_grants6.id" +
+			", projections.user_grants6.creation_date" +
+			", projections.user_grants6.change_date" +
+			", projections.user_grants6.sequence" +
+			", projections.user_grants6.grant_id" +
+			", projections.user_grants6.roles" +
+			", projections.user_grants6.state" +
+			", projections.user_grants6.valid_from" +
+			", projections.user_grants6.valid_until" +
+			", projections.user_grants6.user_id" +
 			", projections.users13.username" +
 			", projections.users13.type" +
 			", projections.users13.resource_owner" +
@@ -32,21 +37,21 @@ var (
 			", projections.users13_humans.display_name" +
 			", projections.users13_humans.avatar_key" +
 			", projections.login_names3.login_name" +
-			", projections.user_grants5.resource_owner" +
-			", projections.orgs1.name" +
-			", projections.orgs1.primary_domain" +
-			", projections.user_grants5.project_id" +
+			", projections.user_grants6.resource_owner" +
+			", projections.orgs2.name" +
+			", projections.orgs2.primary_domain" +
+			", projections.user_grants6.project_id" +
 			", projections.projects4.name" +
 			", granted_orgs.id" +
 			", granted_orgs.name" +
 			", granted_orgs.primary_domain" +
-			" FROM projections.user_grants5" +
-			" LEFT JOIN projections.users13 ON projections.user_grants5.user_id = projections.users13.id AND projections.user_grants5.instance_id = projections.users13.instance_id" +
-			" LEFT JOIN projections.users13_humans ON projections.user_grants5.user_id = projections.users13_humans.user_id AND projections.user_grants5.instance_id = projections.users13_humans.instance_id" +
-			" LEFT JOIN projections.orgs1 ON projections.user_grants5.resource_owner = projections.orgs1.id AND projections.user_grants5.instance_id = projections.orgs1.instance_id" +
-			" LEFT JOIN projections.projects4 ON projections.user_grants5.project_id = projections.projects4.id AND projections.user_grants5.instance_id = projections.projects4.instance_id" +
-			" LEFT JOIN projections.orgs1 AS granted_orgs ON projections.users13.resource_owner = granted_orgs.id AND projections.users13.instance_id = granted_orgs.instance_id" +
-			" LEFT JOIN projections.login_names3 ON projections.user_grants5.user_id = projections.login_names3.user_id AND projections.user_grants5.instance_id = projections.login_names3.instance_id" +
+			" FROM projections.user_grants6" +
+			" LEFT JOIN projections.users13 ON projections.user_grants6.user_id = projections.users13.id AND projections.user_grants6.instance_id = projections.users13.instance_id" +
+			" LEFT JOIN projections.users13_humans ON projections.user_grants6.user_id = projections.users13_humans.user_id AND projections.user_grants6.instance_id = projections.users13_humans.instance_id" +
+			" LEFT JOIN projections.orgs2 ON projections.user_grants6.resource_owner = projections.orgs2.id AND projections.user_grants6.instance_id = projections.orgs2.instance_id" +
+			" LEFT JOIN projections.projects4 ON projections.user_grants6.project_id = projections.projects4.id AND projections.user_grants6.instance_id = projections.projects4.instance_id" +
+			" LEFT JOIN projections.orgs2 AS granted_orgs ON projections.users13.resource_owner = granted_orgs.id AND projections.users13.instance_id = granted_orgs.instance_id" +
+			" LEFT JOIN projections.login_names3 ON projections.user_grants6.user_id = projections.login_names3.user_id AND projections.user_grants6.instance_id = projections.login_names3.instance_id" +
 			` AS OF SYSTEM TIME '-1 ms' ` +
 			" WHERE projections.login_names3.is_primary = $1")
 	userGrantCols = []string{
@@ -57,6 +62,8 @@ var (
 		"grant_id",
 		"roles",
 		"state",
+		"valid_from",
+		"valid_until",
 		"user_id",
 		"username",
 		"type",
@@ -77,14 +84,16 @@ var (
 		"primary_domain", // granted org domain
 	}
 	userGrantsStmt = regexp.QuoteMeta(
-		"SELECT projections.user_grants5.id" +
-			", projections.user_grants5.creation_date" +
-			", projections.user_grants5.change_date" +
-			", projections.user_grants5.sequence" +
-			", projections.user_grants5.grant_id" +
-			", projections.user_grants5.roles" +
-			", projections.user_grants5.state" +
-			", projections.user_grants5.user_id" +
+		"SELECT projections.user_grants6.id" +
+			", projections.user_grants6.creation_date" +
+			", projections.user_grants6.change_date" +
+			", projections.user_grants6.sequence" +
+			", projections.user_grants6.grant_id" +
+			", projections.user_grants6.roles" +
+			", projections.user_grants6.state" +
+			", projections.user_grants6.valid_from" +
+			", projections.user_grants6.valid_until" +
+			", projections.user_grants6.user_id" +
 			", projections.users13.username" +
 			", projections.users13.type" +
 			", projections.users13.resource_owner" +
@@ -94,28 +103,78 @@ var (
 			", projections.users13_humans.display_name" +
 			", projections.users13_humans.avatar_key" +
 			", projections.login_names3.login_name" +
-			", projections.user_grants5.resource_owner" +
-			", projections.orgs1.name" +
-			", projections.orgs1.primary_domain" +
-			", projections.user_grants5.project_id" +
+			", projections.user_grants6.resource_owner" +
+			", projections.orgs2.name" +
+			", projections.orgs2.primary_domain" +
+			", projections.user_grants6.project_id" +
 			", projections.projects4.name" +
 			", granted_orgs.id" +
 			", granted_orgs.name" +
 			", granted_orgs.primary_domain" +
 			", COUNT(*) OVER ()" +
-			" FROM projections.user_grants5" +
-			" LEFT JOIN projections.users13 ON projections.user_grants5.user_id = projections.users13.id AND projections.user_grants5.instance_id = projections.users13.instance_id" +
-			" LEFT JOIN projections.users13_humans ON projections.user_grants5.user_id = projections.users13_humans.user_id AND projections.user_grants5.instance_id = projections.users13_humans.instance_id" +
-			" LEFT JOIN projections.orgs1 ON projections.user_grants5.resource_owner = projections.orgs1.id AND projections.user_grants5.instance_id = projections.orgs1.instance_id" +
-			" LEFT JOIN projections.projects4 ON projections.user_grants5.project_id = projections.projects4.id AND projections.user_grants5.instance_id = projections.projects4.instance_id" +
-			" LEFT JOIN projections.orgs1 AS granted_orgs ON projections.users13.resource_owner = granted_orgs.id AND projections.users13.instance_id = granted_orgs.instance_id" +
-			" LEFT JOIN projections.login_names3 ON projections.user_grants5.user_id = projections.login_names3.user_id AND projections.user_grants5.instance_id = projections.login_names3.instance_id" +
+			" FROM projections.user_grants6" +
+			" LEFT JOIN projections.users13 ON projections.user_grants6.user_id = projections.users13.id AND projections.user_grants6.instance_id = projections.users13.instance_id" +
+			" LEFT JOIN projections.users13_humans ON projections.user_grants6.user_id = projections.users13_humans.user_id AND projections.user_grants6.instance_id = projections.users13_humans.instance_id" +
+			" LEFT JOIN projections.orgs2 ON projections.user_grants6.resource_owner = projections.orgs2.id AND projections.user_grants6.instance_id = projections.orgs2.instance_id" +
+			" LEFT JOIN projections.projects4 ON projections.user_grants6.project_id = projections.projects4.id AND projections.user_grants6.instance_id = projections.projects4.instance_id" +
+			" LEFT JOIN projections.orgs2 AS granted_orgs ON projections.users13.resource_owner = granted_orgs.id AND projections.users13.instance_id = granted_orgs.instance_id" +
+			" LEFT JOIN projections.login_names3 ON projections.user_grants6.user_id = projections.login_names3.user_id AND projections.user_grants6.instance_id = projections.login_names3.instance_id" +
 			` AS OF SYSTEM TIME '-1 ms' ` +
 			" WHERE projections.login_names3.is_primary = $1")
 	userGrantsCols = append(
 		userGrantCols,
 		"count",
 	)
+	userGrantsWithGroupGrantsStmt = regexp.QuoteMeta(
+		"SELECT user_grants.id" +
+			", user_grants.creation_date" +
+			", user_grants.change_date" +
+			", user_grants.sequence" +
+			", user_grants.grant_id" +
+			", user_grants.roles" +
+			", user_grants.state" +
+			", user_grants.valid_from" +
+			", user_grants.valid_until" +
+			", user_grants.user_id" +
+			", projections.users13.username" +
+			", projections.users13.type" +
+			", projections.users13.resource_owner" +
+			", projections.users13_humans.first_name" +
+			", projections.users13_humans.last_name" +
+			", projections.users13_humans.email" +
+			", projections.users13_humans.display_name" +
+			", projections.users13_humans.avatar_key" +
+			", projections.login_names3.login_name" +
+			", user_grants.resource_owner" +
+			", projections.orgs2.name" +
+			", projections.orgs2.primary_domain" +
+			", user_grants.project_id" +
+			", projections.projects4.name" +
+			", granted_orgs.id" +
+			", granted_orgs.name" +
+			", granted_orgs.primary_domain" +
+			", user_grants.group_id" +
+			", user_grants.group_name" +
+			", COUNT(*) OVER ()" +
+			" FROM (SELECT projections.user_grants6.id, projections.user_grants6.creation_date, projections.user_grants6.change_date, projections.user_grants6.sequence, projections.user_grants6.grant_id, projections.user_grants6.roles, projections.user_grants6.state, projections.user_grants6.valid_from, projections.user_grants6.valid_until, projections.user_grants6.user_id, projections.user_grants6.resource_owner, projections.user_grants6.project_id, projections.user_grants6.instance_id, NULL::TEXT AS group_id, NULL::TEXT AS group_name" +
+			" FROM projections.user_grants6 WHERE projections.user_grants6.instance_id = $1 AND projections.user_grants6.user_id = $2" +
+			" UNION ALL (WITH RECURSIVE user_groups AS (SELECT group_id, instance_id FROM projections.groups_members WHERE member_id = $3 AND member_type = $4 AND instance_id = $5" +
+			" UNION SELECT m.group_id, m.instance_id FROM projections.groups_members m JOIN user_groups s ON m.member_id = s.group_id AND m.instance_id = s.instance_id WHERE m.member_type = $6)" +
+			" SELECT gg.group_id AS id, gg.creation_date, gg.change_date, gg.sequence, gg.grant_id, gg.roles, $7::SMALLINT AS state, NULL::TIMESTAMPTZ AS valid_from, NULL::TIMESTAMPTZ AS valid_until, $8::TEXT AS user_id, g.resource_owner, gg.project_id, gg.instance_id, g.id AS group_id, g.name AS group_name" +
+			" FROM projections.groups g JOIN user_groups s ON g.id = s.group_id AND g.instance_id = s.instance_id" +
+			" JOIN projections.groups_grants gg ON gg.group_id = g.id AND gg.instance_id = g.instance_id)) AS user_grants" +
+			" LEFT JOIN projections.users13 ON user_grants.user_id = projections.users13.id AND user_grants.instance_id = projections.users13.instance_id" +
+			" LEFT JOIN projections.users13_humans ON user_grants.user_id = projections.users13_humans.user_id AND user_grants.instance_id = projections.users13_humans.instance_id" +
+			" LEFT JOIN projections.orgs2 ON user_grants.resource_owner = projections.orgs2.id AND user_grants.instance_id = projections.orgs2.instance_id" +
+			" LEFT JOIN projections.projects4 ON user_grants.project_id = projections.projects4.id AND user_grants.instance_id = projections.projects4.instance_id" +
+			" LEFT JOIN projections.orgs2 AS granted_orgs ON projections.users13.resource_owner = granted_orgs.id AND projections.users13.instance_id = granted_orgs.instance_id" +
+			" LEFT JOIN projections.login_names3 ON user_grants.user_id = projections.login_names3.user_id AND user_grants.instance_id = projections.login_names3.instance_id" +
+			` AS OF SYSTEM TIME '-1 ms' ` +
+			" WHERE projections.login_names3.is_primary = $9")
+	userGrantsWithGroupGrantsCols = append(
+		append(userGrantCols[:len(userGrantCols):len(userGrantCols)], "group_id", "group_name"),
+		"count",
+	)
 )
 
 func Test_UserGrantPrepares(t *testing.T) {
@@ -162,6 +221,8 @@ func Test_UserGrantPrepares(t *testing.T) {
 						"grant-id",
 						database.TextArray[string]{"role-key"},
 						domain.UserGrantStateActive,
+						nil,
+						nil,
 						"user-id",
 						"username",
 						domain.UserTypeHuman,
@@ -226,6 +287,8 @@ func Test_UserGrantPrepares(t *testing.T) {
 						"grant-id",
 						database.TextArray[string]{"role-key"},
 						domain.UserGrantStateActive,
+						nil,
+						nil,
 						"user-id",
 						"username",
 						domain.UserTypeMachine,
@@ -290,6 +353,8 @@ func Test_UserGrantPrepares(t *testing.T) {
 						"grant-id",
 						database.TextArray[string]{"role-key"},
 						domain.UserGrantStateActive,
+						nil,
+						nil,
 						"user-id",
 						"username",
 						domain.UserTypeHuman,
@@ -354,6 +419,8 @@ func Test_UserGrantPrepares(t *testing.T) {
 						"grant-id",
 						database.TextArray[string]{"role-key"},
 						domain.UserGrantStateActive,
+						nil,
+						nil,
 						"user-id",
 						"username",
 						domain.UserTypeHuman,
@@ -418,6 +485,8 @@ func Test_UserGrantPrepares(t *testing.T) {
 						"grant-id",
 						database.TextArray[string]{"role-key"},
 						domain.UserGrantStateActive,
+						nil,
+						nil,
 						"user-id",
 						"username",
 						domain.UserTypeHuman,
@@ -512,6 +581,8 @@ func Test_UserGrantPrepares(t *testing.T) {
 							"grant-id",
 							database.TextArray[string]{"role-key"},
 							domain.UserGrantStateActive,
+							nil,
+							nil,
 							"user-id",
 							"username",
 							domain.UserTypeHuman,
@@ -585,6 +656,8 @@ func Test_UserGrantPrepares(t *testing.T) {
 							"grant-id",
 							database.TextArray[string]{"role-key"},
 							domain.UserGrantStateActive,
+							nil,
+							nil,
 							"user-id",
 							"username",
 							domain.UserTypeMachine,
@@ -658,6 +731,8 @@ func Test_UserGrantPrepares(t *testing.T) {
 							"grant-id",
 							database.TextArray[string]{"role-key"},
 							domain.UserGrantStateActive,
+							nil,
+							nil,
 							"user-id",
 							"username",
 							domain.UserTypeMachine,
@@ -731,6 +806,8 @@ func Test_UserGrantPrepares(t *testing.T) {
 							"grant-id",
 							database.TextArray[string]{"role-key"},
 							domain.UserGrantStateActive,
+							nil,
+							nil,
 							"user-id",
 							"username",
 							domain.UserTypeHuman,
@@ -804,6 +881,8 @@ func Test_UserGrantPrepares(t *testing.T) {
 							"grant-id",
 							database.TextArray[string]{"role-key"},
 							domain.UserGrantStateActive,
+							nil,
+							nil,
 							"user-id",
 							"username",
 							domain.UserTypeHuman,
@@ -877,6 +956,8 @@ func Test_UserGrantPrepares(t *testing.T) {
 							"grant-id",
 							database.TextArray[string]{"role-key"},
 							domain.UserGrantStateActive,
+							nil,
+							nil,
 							"user-id",
 							"username",
 							domain.UserTypeHuman,
@@ -904,6 +985,8 @@ func Test_UserGrantPrepares(t *testing.T) {
 							"grant-id",
 							database.TextArray[string]{"role-key"},
 							domain.UserGrantStateActive,
+							nil,
+							nil,
 							"user-id",
 							"username",
 							domain.UserTypeHuman,
@@ -988,6 +1071,142 @@ func Test_UserGrantPrepares(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:    "prepareUserGrantsFromQuery with group grants",
+			prepare: prepareUserGrantsWithGroupGrantsWrapper(),
+			want: want{
+				sqlExpectations: mockQueries(
+					userGrantsWithGroupGrantsStmt,
+					userGrantsWithGroupGrantsCols,
+					[][]driver.Value{
+						{
+							"id",
+							testNow,
+							testNow,
+							20211111,
+							"grant-id",
+							database.TextArray[string]{"role-key"},
+							domain.UserGrantStateActive,
+							nil,
+							nil,
+							"user-id",
+							"username",
+							domain.UserTypeHuman,
+							"resource-owner",
+							"first-name",
+							"last-name",
+							"email",
+							"display-name",
+							"avatar-key",
+							"login-name",
+							"ro",
+							"org-name",
+							"primary-domain",
+							"project-id",
+							"project-name",
+							"granted-org-id",
+							"granted-org-name",
+							"granted-org-domain",
+							nil,
+							nil,
+						},
+						{
+							"group-id",
+							testNow,
+							testNow,
+							20211112,
+							"",
+							database.TextArray[string]{"group-role-key"},
+							domain.UserGrantStateActive,
+							nil,
+							nil,
+							"user-id",
+							"username",
+							domain.UserTypeHuman,
+							"resource-owner",
+							"first-name",
+							"last-name",
+							"email",
+							"display-name",
+							"avatar-key",
+							"login-name",
+							"ro",
+							"org-name",
+							"primary-domain",
+							"project-id",
+							"project-name",
+							"granted-org-id",
+							"granted-org-name",
+							"granted-org-domain",
+							"group-id",
+							"group-name",
+						},
+					},
+				),
+			},
+			object: &UserGrants{
+				SearchResponse: SearchResponse{
+					Count: 2,
+				},
+				UserGrants: []*UserGrant{
+					{
+						ID:                 "id",
+						CreationDate:       testNow,
+						ChangeDate:         testNow,
+						Sequence:           20211111,
+						Roles:              database.TextArray[string]{"role-key"},
+						GrantID:            "grant-id",
+						State:              domain.UserGrantStateActive,
+						UserID:             "user-id",
+						Username:           "username",
+						UserType:           domain.UserTypeHuman,
+						UserResourceOwner:  "resource-owner",
+						FirstName:          "first-name",
+						LastName:           "last-name",
+						Email:              "email",
+						DisplayName:        "display-name",
+						AvatarURL:          "avatar-key",
+						PreferredLoginName: "login-name",
+						ResourceOwner:      "ro",
+						OrgName:            "org-name",
+						OrgPrimaryDomain:   "primary-domain",
+						ProjectID:          "project-id",
+						ProjectName:        "project-name",
+						GrantedOrgID:       "granted-org-id",
+						GrantedOrgName:     "granted-org-name",
+						GrantedOrgDomain:   "granted-org-domain",
+					},
+					{
+						ID:                 "group-id",
+						CreationDate:       testNow,
+						ChangeDate:         testNow,
+						Sequence:           20211112,
+						Roles:              database.TextArray[string]{"group-role-key"},
+						State:              domain.UserGrantStateActive,
+						UserID:             "user-id",
+						Username:           "username",
+						UserType:           domain.UserTypeHuman,
+						UserResourceOwner:  "resource-owner",
+						FirstName:          "first-name",
+						LastName:           "last-name",
+						Email:              "email",
+						DisplayName:        "display-name",
+						AvatarURL:          "avatar-key",
+						PreferredLoginName: "login-name",
+						ResourceOwner:      "ro",
+						OrgName:            "org-name",
+						OrgPrimaryDomain:   "primary-domain",
+						ProjectID:          "project-id",
+						ProjectName:        "project-name",
+						GrantedOrgID:       "granted-org-id",
+						GrantedOrgName:     "granted-org-name",
+						GrantedOrgDomain:   "granted-org-domain",
+						GroupID:            "group-id",
+						GroupName:          "group-name",
+					},
+				},
+			},
+		},
 		{
 			name:    "prepareUserGrantsQuery sql err",
 			prepare: prepareUserGrantsQuery,
@@ -1012,3 +1231,10 @@ func Test_UserGrantPrepares(t *testing.T) {
 		})
 	}
 }
+
+func prepareUserGrantsWithGroupGrantsWrapper() func(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) (*UserGrants, error)) {
+	return func(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) (*UserGrants, error)) {
+		from, _ := getUserGrantsWithGroupGrantsFromQuery("user-id", "instance-id")
+		return prepareUserGrantsFromQuery(ctx, db, userGrantsWithGroupsTable, from, true)
+	}
+}
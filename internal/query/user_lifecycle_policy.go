@@ -0,0 +1,204 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type UserLifecyclePolicy struct {
+	ID            string
+	Sequence      uint64
+	CreationDate  time.Time
+	ChangeDate    time.Time
+	ResourceOwner string
+
+	DeactivateAfterDays uint64
+	DeleteAfterDays     uint64
+	WarnDaysBefore      uint64
+}
+
+func (p *UserLifecyclePolicy) ToDomain() *domain.UserLifecyclePolicy {
+	return &domain.UserLifecyclePolicy{
+		ObjectRoot: models.ObjectRoot{
+			AggregateID:   p.ID,
+			Sequence:      p.Sequence,
+			CreationDate:  p.CreationDate,
+			ChangeDate:    p.ChangeDate,
+			ResourceOwner: p.ResourceOwner,
+		},
+		DeactivateAfterDays: p.DeactivateAfterDays,
+		DeleteAfterDays:     p.DeleteAfterDays,
+		WarnDaysBefore:      p.WarnDaysBefore,
+	}
+}
+
+var (
+	userLifecyclePolicyTable = table{
+		name:          projection.UserLifecyclePolicyTable,
+		instanceIDCol: projection.UserLifecyclePolicyInstanceIDCol,
+	}
+	UserLifecyclePolicyColID = Column{
+		name:  projection.UserLifecyclePolicyIDCol,
+		table: userLifecyclePolicyTable,
+	}
+	UserLifecyclePolicyColSequence = Column{
+		name:  projection.UserLifecyclePolicySequenceCol,
+		table: userLifecyclePolicyTable,
+	}
+	UserLifecyclePolicyColCreationDate = Column{
+		name:  projection.UserLifecyclePolicyCreationDateCol,
+		table: userLifecyclePolicyTable,
+	}
+	UserLifecyclePolicyColChangeDate = Column{
+		name:  projection.UserLifecyclePolicyChangeDateCol,
+		table: userLifecyclePolicyTable,
+	}
+	UserLifecyclePolicyColResourceOwner = Column{
+		name:  projection.UserLifecyclePolicyResourceOwnerCol,
+		table: userLifecyclePolicyTable,
+	}
+	UserLifecyclePolicyColInstanceID = Column{
+		name:  projection.UserLifecyclePolicyInstanceIDCol,
+		table: userLifecyclePolicyTable,
+	}
+	UserLifecyclePolicyColDeactivateAfterDays = Column{
+		name:  projection.UserLifecyclePolicyDeactivateAfterDaysCol,
+		table: userLifecyclePolicyTable,
+	}
+	UserLifecyclePolicyColDeleteAfterDays = Column{
+		name:  projection.UserLifecyclePolicyDeleteAfterDaysCol,
+		table: userLifecyclePolicyTable,
+	}
+	UserLifecyclePolicyColWarnDaysBefore = Column{
+		name:  projection.UserLifecyclePolicyWarnDaysBeforeCol,
+		table: userLifecyclePolicyTable,
+	}
+)
+
+func (q *Queries) UserLifecyclePolicyByOrg(ctx context.Context, shouldTriggerBulk bool, orgID string) (policy *UserLifecyclePolicy, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if shouldTriggerBulk {
+		_, traceSpan := tracing.NewNamedSpan(ctx, "TriggerUserLifecyclePolicyProjection")
+		ctx, err = projection.UserLifecyclePolicyProjection.Trigger(ctx, handler.WithAwaitRunning())
+		logging.OnError(err).Debug("trigger failed")
+		traceSpan.EndWithError(err)
+	}
+
+	stmt, scan := prepareUserLifecyclePolicyQuery(ctx, q.client)
+	query, args, err := stmt.Where(sq.Eq{
+		UserLifecyclePolicyColInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+		UserLifecyclePolicyColID.identifier():         orgID,
+	}).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Ud7kc", "Errors.Query.SQLStatement")
+	}
+
+	err = q.client.QueryRowContext(ctx, func(row *sql.Row) error {
+		policy, err = scan(row)
+		return err
+	}, query, args...)
+	return policy, err
+}
+
+// UserLifecyclePolicies returns the user lifecycle policies of all organizations of the instance.
+func (q *Queries) UserLifecyclePolicies(ctx context.Context) (policies []*UserLifecyclePolicy, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	stmt, scan := prepareUserLifecyclePoliciesQuery(ctx, q.client)
+	query, args, err := stmt.Where(sq.Eq{
+		UserLifecyclePolicyColInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-k2Fwe", "Errors.Query.SQLStatement")
+	}
+
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		policies, err = scan(rows)
+		return err
+	}, query, args...)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Mn3ol", "Errors.Internal")
+	}
+	return policies, nil
+}
+
+func userLifecyclePolicyColumns() []string {
+	return []string{
+		UserLifecyclePolicyColID.identifier(),
+		UserLifecyclePolicyColSequence.identifier(),
+		UserLifecyclePolicyColCreationDate.identifier(),
+		UserLifecyclePolicyColChangeDate.identifier(),
+		UserLifecyclePolicyColResourceOwner.identifier(),
+		UserLifecyclePolicyColDeactivateAfterDays.identifier(),
+		UserLifecyclePolicyColDeleteAfterDays.identifier(),
+		UserLifecyclePolicyColWarnDaysBefore.identifier(),
+	}
+}
+
+func scanUserLifecyclePolicy(scan func(dest ...any) error) (*UserLifecyclePolicy, error) {
+	policy := new(UserLifecyclePolicy)
+	err := scan(
+		&policy.ID,
+		&policy.Sequence,
+		&policy.CreationDate,
+		&policy.ChangeDate,
+		&policy.ResourceOwner,
+		&policy.DeactivateAfterDays,
+		&policy.DeleteAfterDays,
+		&policy.WarnDaysBefore,
+	)
+	return policy, err
+}
+
+func prepareUserLifecyclePolicyQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Row) (*UserLifecyclePolicy, error)) {
+	return sq.Select(userLifecyclePolicyColumns()...).
+			From(userLifecyclePolicyTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(row *sql.Row) (*UserLifecyclePolicy, error) {
+			policy, err := scanUserLifecyclePolicy(row.Scan)
+			if err != nil {
+				if errors.Is(err, sql.ErrNoRows) {
+					return nil, zerrors.ThrowNotFound(err, "QUERY-Ox1ns", "Errors.Org.UserLifecyclePolicy.NotFound")
+				}
+				return nil, zerrors.ThrowInternal(err, "QUERY-c8Ejq", "Errors.Internal")
+			}
+			return policy, nil
+		}
+}
+
+func prepareUserLifecyclePoliciesQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) ([]*UserLifecyclePolicy, error)) {
+	return sq.Select(userLifecyclePolicyColumns()...).
+			From(userLifecyclePolicyTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) ([]*UserLifecyclePolicy, error) {
+			policies := make([]*UserLifecyclePolicy, 0)
+			for rows.Next() {
+				policy, err := scanUserLifecyclePolicy(rows.Scan)
+				if err != nil {
+					return nil, err
+				}
+				policies = append(policies, policy)
+			}
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Wn2ex", "Errors.Query.CloseRows")
+			}
+			return policies, nil
+		}
+}
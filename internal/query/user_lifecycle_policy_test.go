@@ -0,0 +1,151 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	prepareUserLifecyclePolicyStmt = `SELECT projections.user_lifecycle_policies.id,` +
+		` projections.user_lifecycle_policies.sequence,` +
+		` projections.user_lifecycle_policies.creation_date,` +
+		` projections.user_lifecycle_policies.change_date,` +
+		` projections.user_lifecycle_policies.resource_owner,` +
+		` projections.user_lifecycle_policies.deactivate_after_days,` +
+		` projections.user_lifecycle_policies.delete_after_days,` +
+		` projections.user_lifecycle_policies.warn_days_before` +
+		` FROM projections.user_lifecycle_policies` +
+		` AS OF SYSTEM TIME '-1 ms'`
+	prepareUserLifecyclePolicyCols = []string{
+		"id",
+		"sequence",
+		"creation_date",
+		"change_date",
+		"resource_owner",
+		"deactivate_after_days",
+		"delete_after_days",
+		"warn_days_before",
+	}
+)
+
+func Test_UserLifecyclePolicyPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareUserLifecyclePolicyQuery no result",
+			prepare: prepareUserLifecyclePolicyQuery,
+			want: want{
+				sqlExpectations: mockQueriesScanErr(
+					regexp.QuoteMeta(prepareUserLifecyclePolicyStmt),
+					nil,
+					nil,
+				),
+				err: func(err error) (error, bool) {
+					if !zerrors.IsNotFound(err) {
+						return fmt.Errorf("err should be zitadel.NotFoundError got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*UserLifecyclePolicy)(nil),
+		},
+		{
+			name:    "prepareUserLifecyclePolicyQuery found",
+			prepare: prepareUserLifecyclePolicyQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					regexp.QuoteMeta(prepareUserLifecyclePolicyStmt),
+					prepareUserLifecyclePolicyCols,
+					[]driver.Value{
+						"pol-id",
+						uint64(20211109),
+						testNow,
+						testNow,
+						"ro",
+						90,
+						30,
+						7,
+					},
+				),
+			},
+			object: &UserLifecyclePolicy{
+				ID:                  "pol-id",
+				CreationDate:        testNow,
+				ChangeDate:          testNow,
+				Sequence:            20211109,
+				ResourceOwner:       "ro",
+				DeactivateAfterDays: 90,
+				DeleteAfterDays:     30,
+				WarnDaysBefore:      7,
+			},
+		},
+		{
+			name:    "prepareUserLifecyclePolicyQuery sql err",
+			prepare: prepareUserLifecyclePolicyQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareUserLifecyclePolicyStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*UserLifecyclePolicy)(nil),
+		},
+		{
+			name:    "prepareUserLifecyclePoliciesQuery found",
+			prepare: prepareUserLifecyclePoliciesQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareUserLifecyclePolicyStmt),
+					prepareUserLifecyclePolicyCols,
+					[][]driver.Value{
+						{
+							"pol-id",
+							uint64(20211109),
+							testNow,
+							testNow,
+							"ro",
+							90,
+							0,
+							0,
+						},
+					},
+				),
+			},
+			object: []*UserLifecyclePolicy{
+				{
+					ID:                  "pol-id",
+					CreationDate:        testNow,
+					ChangeDate:          testNow,
+					Sequence:            20211109,
+					ResourceOwner:       "ro",
+					DeactivateAfterDays: 90,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err, defaultPrepareArgs...)
+		})
+	}
+}
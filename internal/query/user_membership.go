@@ -11,6 +11,7 @@ import (
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/api/call"
 	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
 	"github.com/zitadel/zitadel/internal/query/projection"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
@@ -29,6 +30,9 @@ type Membership struct {
 	ChangeDate    time.Time
 	Sequence      uint64
 	ResourceOwner string
+	// ValidFrom and ValidUntil are zero if the membership is not time-bound
+	ValidFrom  time.Time
+	ValidUntil time.Time
 
 	Org          *OrgMembership
 	IAM          *IAMMembership
@@ -79,6 +83,15 @@ func NewMembershipResourceOwnersSearchQuery(ids ...string) (SearchQuery, error)
 	return NewListQuery(membershipResourceOwner, list, ListIn)
 }
 
+// NewMembershipOrgIDsQuery only matches organization memberships of the given organizations
+func NewMembershipOrgIDsQuery(ids ...string) (SearchQuery, error) {
+	list := make([]interface{}, len(ids))
+	for i, value := range ids {
+		list[i] = value
+	}
+	return NewListQuery(membershipOrgID, list, ListIn)
+}
+
 func NewMembershipGrantedOrgIDSearchQuery(id string) (SearchQuery, error) {
 	return NewTextQuery(ProjectGrantColumnGrantedOrgID, id, TextEquals)
 }
@@ -95,6 +108,16 @@ func NewMembershipIsIAMQuery() (SearchQuery, error) {
 	return NewNotNullQuery(InstanceMemberIAMID)
 }
 
+// NewMembershipValidAtQuery filters out the time-bound memberships which are not valid at the time.
+func NewMembershipValidAtQuery(at time.Time) (SearchQuery, error) {
+	return newValidAtQuery(membershipValidFrom, membershipValidUntil, at)
+}
+
+// NewMembershipExpiredQuery returns the time-bound memberships which ended before the time.
+func NewMembershipExpiredQuery(at time.Time) (SearchQuery, error) {
+	return NewTimestampQuery(membershipValidUntil, at, TimestampLessOrEquals)
+}
+
 func (q *MembershipSearchQuery) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
 	query = q.SearchRequest.toQuery(query)
 	for _, q := range q.Queries {
@@ -109,7 +132,7 @@ func (q *Queries) Memberships(ctx context.Context, queries *MembershipSearchQuer
 
 	if shouldTrigger {
 		wg := sync.WaitGroup{}
-		wg.Add(4)
+		wg.Add(5)
 		go func() {
 			spanCtx, triggerSpan := tracing.NewNamedSpan(ctx, "TriggerOrgMemberProjection")
 			_, _ = projection.OrgMemberProjection.Trigger(spanCtx, handler.WithAwaitRunning())
@@ -134,6 +157,12 @@ func (q *Queries) Memberships(ctx context.Context, queries *MembershipSearchQuer
 			triggerSpan.End()
 			wg.Done()
 		}()
+		go func() {
+			spanCtx, triggerSpan := tracing.NewNamedSpan(ctx, "TriggerAccessRequestProjection")
+			_, _ = projection.AccessRequestProjection.Trigger(spanCtx, handler.WithAwaitRunning())
+			triggerSpan.End()
+			wg.Done()
+		}()
 
 		wg.Wait()
 	}
@@ -144,7 +173,7 @@ func (q *Queries) Memberships(ctx context.Context, queries *MembershipSearchQuer
 	if err != nil {
 		return nil, zerrors.ThrowInvalidArgument(err, "QUERY-T84X9", "Errors.Query.InvalidRequest")
 	}
-	latestSequence, err := q.latestState(ctx, orgMemberTable, instanceMemberTable, projectMemberTable, projectGrantMemberTable)
+	latestSequence, err := q.latestState(ctx, orgMemberTable, instanceMemberTable, projectMemberTable, projectGrantMemberTable, accessRequestTable)
 	if err != nil {
 		return nil, err
 	}
@@ -191,6 +220,14 @@ var (
 		name:  projection.MemberResourceOwner,
 		table: membershipAlias,
 	}
+	membershipValidFrom = Column{
+		name:  projection.MemberValidFrom,
+		table: membershipAlias,
+	}
+	membershipValidUntil = Column{
+		name:  projection.MemberValidUntil,
+		table: membershipAlias,
+	}
 	membershipInstanceID = Column{
 		name:  projection.MemberInstanceID,
 		table: membershipAlias,
@@ -222,8 +259,9 @@ func getMembershipFromQuery(queries *MembershipSearchQuery) (string, []interface
 	iamMembers, iamMembersArgs := prepareIAMMember(queries)
 	projectMembers, projectMembersArgs := prepareProjectMember(queries)
 	projectGrantMembers, projectGrantMembersArgs := prepareProjectGrantMember(queries)
+	elevations, elevationsArgs := prepareAccessRequestElevation(queries)
 	args := make([]interface{}, 0)
-	args = append(append(append(append(args, orgMembersArgs...), iamMembersArgs...), projectMembersArgs...), projectGrantMembersArgs...)
+	args = append(append(append(append(append(args, orgMembersArgs...), iamMembersArgs...), projectMembersArgs...), projectGrantMembersArgs...), elevationsArgs...)
 
 	return "(" +
 			orgMembers +
@@ -233,6 +271,8 @@ func getMembershipFromQuery(queries *MembershipSearchQuery) (string, []interface
 			projectMembers +
 			" UNION ALL " +
 			projectGrantMembers +
+			" UNION ALL " +
+			elevations +
 			") AS " + membershipAlias.identifier(),
 		args
 }
@@ -246,6 +286,8 @@ func prepareMembershipsQuery(ctx context.Context, db prepareDatabase, queries *M
 			membershipChangeDate.identifier(),
 			membershipSequence.identifier(),
 			membershipResourceOwner.identifier(),
+			membershipValidFrom.identifier(),
+			membershipValidUntil.identifier(),
 			membershipOrgID.identifier(),
 			membershipIAMID.identifier(),
 			membershipProjectID.identifier(),
@@ -277,6 +319,8 @@ func prepareMembershipsQuery(ctx context.Context, db prepareDatabase, queries *M
 					projectName  = sql.NullString{}
 					orgName      = sql.NullString{}
 					instanceName = sql.NullString{}
+					validFrom    = sql.NullTime{}
+					validUntil   = sql.NullTime{}
 				)
 
 				err := rows.Scan(
@@ -286,6 +330,8 @@ func prepareMembershipsQuery(ctx context.Context, db prepareDatabase, queries *M
 					&membership.ChangeDate,
 					&membership.Sequence,
 					&membership.ResourceOwner,
+					&validFrom,
+					&validUntil,
 					&orgID,
 					&instanceID,
 					&projectID,
@@ -301,6 +347,9 @@ func prepareMembershipsQuery(ctx context.Context, db prepareDatabase, queries *M
 					return nil, err
 				}
 
+				membership.ValidFrom = validFrom.Time
+				membership.ValidUntil = validUntil.Time
+
 				if orgID.Valid {
 					membership.Org = &OrgMembership{
 						OrgID: orgID.String,
@@ -350,6 +399,8 @@ func prepareOrgMember(query *MembershipSearchQuery) (string, []interface{}) {
 		OrgMemberSequence.identifier(),
 		OrgMemberResourceOwner.identifier(),
 		OrgMemberInstanceID.identifier(),
+		membershipValidFrom.identifier(),
+		membershipValidUntil.identifier(),
 		OrgMemberOrgID.identifier(),
 		"NULL::TEXT AS "+membershipIAMID.name,
 		"NULL::TEXT AS "+membershipProjectID.name,
@@ -373,6 +424,8 @@ func prepareIAMMember(query *MembershipSearchQuery) (string, []interface{}) {
 		InstanceMemberSequence.identifier(),
 		InstanceMemberResourceOwner.identifier(),
 		InstanceMemberInstanceID.identifier(),
+		membershipValidFrom.identifier(),
+		membershipValidUntil.identifier(),
 		"NULL::TEXT AS "+membershipOrgID.name,
 		InstanceMemberIAMID.identifier(),
 		"NULL::TEXT AS "+membershipProjectID.name,
@@ -396,6 +449,8 @@ func prepareProjectMember(query *MembershipSearchQuery) (string, []interface{})
 		ProjectMemberSequence.identifier(),
 		ProjectMemberResourceOwner.identifier(),
 		ProjectMemberInstanceID.identifier(),
+		membershipValidFrom.identifier(),
+		membershipValidUntil.identifier(),
 		"NULL::TEXT AS "+membershipOrgID.name,
 		"NULL::TEXT AS "+membershipIAMID.name,
 		ProjectMemberProjectID.identifier(),
@@ -420,6 +475,8 @@ func prepareProjectGrantMember(query *MembershipSearchQuery) (string, []interfac
 		ProjectGrantMemberSequence.identifier(),
 		ProjectGrantMemberResourceOwner.identifier(),
 		ProjectGrantMemberInstanceID.identifier(),
+		membershipValidFrom.identifier(),
+		membershipValidUntil.identifier(),
 		"NULL::TEXT AS "+membershipOrgID.name,
 		"NULL::TEXT AS "+membershipIAMID.name,
 		ProjectGrantMemberProjectID.identifier(),
@@ -433,3 +490,60 @@ func prepareProjectGrantMember(query *MembershipSearchQuery) (string, []interfac
 	}
 	return builder.MustSql()
 }
+
+// prepareAccessRequestElevation selects the roles granted to existing instance and organization members by approved access requests.
+// Each elevation is a time-bound membership of its own, the membership of the user is left unchanged.
+// Elevations only apply as long as the user is a member.
+func prepareAccessRequestElevation(query *MembershipSearchQuery) (string, []interface{}) {
+	memberExists := func(table, objectIDCol string) string {
+		return "EXISTS (SELECT 1 FROM " + table + " AS m" +
+			" WHERE m." + projection.MemberInstanceID + " = " + AccessRequestColumnInstanceID.identifier() +
+			" AND m." + objectIDCol + " = " + AccessRequestColumnResourceOwner.identifier() +
+			" AND m." + projection.MemberUserIDCol + " = " + AccessRequestColumnUserID.identifier() + ")"
+	}
+	elevations := sq.Select(
+		AccessRequestColumnUserID.identifier(),
+		AccessRequestColumnRoles.identifier(),
+		AccessRequestColumnChangeDate.identifier()+" AS "+projection.MemberCreationDate,
+		AccessRequestColumnChangeDate.identifier(),
+		AccessRequestColumnSequence.identifier(),
+		AccessRequestColumnResourceOwner.identifier(),
+		AccessRequestColumnInstanceID.identifier(),
+		AccessRequestColumnChangeDate.identifier()+" AS "+projection.MemberValidFrom,
+		AccessRequestColumnValidUntil.identifier(),
+	).
+		Column(sq.Alias(sq.Case().When(sq.Eq{AccessRequestColumnScope.identifier(): domain.AccessRequestScopeOrg}, AccessRequestColumnResourceOwner.identifier()), projection.OrgMemberOrgIDCol)).
+		Column(sq.Alias(sq.Case().When(sq.Eq{AccessRequestColumnScope.identifier(): domain.AccessRequestScopeInstance}, AccessRequestColumnResourceOwner.identifier()), projection.InstanceMemberIAMIDCol)).
+		From(accessRequestTable.identifier()).
+		Where(sq.Eq{
+			AccessRequestColumnState.identifier():     domain.AccessRequestStateApproved,
+			AccessRequestColumnElevation.identifier(): true,
+		}).
+		Where(sq.Or{
+			sq.Expr(memberExists(projection.OrgMemberProjectionTable, projection.OrgMemberOrgIDCol)),
+			sq.Expr(memberExists(projection.InstanceMemberProjectionTable, projection.InstanceMemberIAMIDCol)),
+		})
+
+	builder := sq.Select(
+		membershipUserID.identifier(),
+		membershipRoles.identifier(),
+		membershipCreationDate.identifier(),
+		membershipChangeDate.identifier(),
+		membershipSequence.identifier(),
+		membershipResourceOwner.identifier(),
+		membershipInstanceID.identifier(),
+		membershipValidFrom.identifier(),
+		membershipValidUntil.identifier(),
+		membershipOrgID.identifier(),
+		membershipIAMID.identifier(),
+		"NULL::TEXT AS "+membershipProjectID.name,
+		"NULL::TEXT AS "+membershipGrantID.name,
+	).FromSelect(elevations, membershipAlias.name)
+
+	for _, q := range query.Queries {
+		if q.Col().table.name == membershipAlias.name || q.Col().table.name == orgMemberTable.name || q.Col().table.name == instanceMemberTable.name {
+			builder = q.toQuery(builder)
+		}
+	}
+	return builder.MustSql()
+}
@@ -22,13 +22,15 @@ var (
 			", members.change_date" +
 			", members.sequence" +
 			", members.resource_owner" +
+			", members.valid_from" +
+			", members.valid_until" +
 			", members.org_id" +
 			", members.id" +
 			", members.project_id" +
 			", members.grant_id" +
 			", projections.project_grants4.granted_org_id" +
 			", projections.projects4.name" +
-			", projections.orgs1.name" +
+			", projections.orgs2.name" +
 			", projections.instances.name" +
 			", COUNT(*) OVER ()" +
 			" FROM (" +
@@ -39,11 +41,13 @@ var (
 			", members.sequence" +
 			", members.resource_owner" +
 			", members.instance_id" +
+			", members.valid_from" +
+			", members.valid_until" +
 			", members.org_id" +
 			", NULL::TEXT AS id" +
 			", NULL::TEXT AS project_id" +
 			", NULL::TEXT AS grant_id" +
-			" FROM projections.org_members4 AS members" +
+			" FROM projections.org_members5 AS members" +
 			" UNION ALL " +
 			"SELECT members.user_id" +
 			", members.roles" +
@@ -52,11 +56,13 @@ var (
 			", members.sequence" +
 			", members.resource_owner" +
 			", members.instance_id" +
+			", members.valid_from" +
+			", members.valid_until" +
 			", NULL::TEXT AS org_id" +
 			", members.id" +
 			", NULL::TEXT AS project_id" +
 			", NULL::TEXT AS grant_id" +
-			" FROM projections.instance_members4 AS members" +
+			" FROM projections.instance_members5 AS members" +
 			" UNION ALL " +
 			"SELECT members.user_id" +
 			", members.roles" +
@@ -65,11 +71,13 @@ var (
 			", members.sequence" +
 			", members.resource_owner" +
 			", members.instance_id" +
+			", members.valid_from" +
+			", members.valid_until" +
 			", NULL::TEXT AS org_id" +
 			", NULL::TEXT AS id" +
 			", members.project_id" +
 			", NULL::TEXT AS grant_id" +
-			" FROM projections.project_members4 AS members" +
+			" FROM projections.project_members5 AS members" +
 			" UNION ALL " +
 			"SELECT members.user_id" +
 			", members.roles" +
@@ -78,14 +86,46 @@ var (
 			", members.sequence" +
 			", members.resource_owner" +
 			", members.instance_id" +
+			", members.valid_from" +
+			", members.valid_until" +
 			", NULL::TEXT AS org_id" +
 			", NULL::TEXT AS id" +
 			", members.project_id" +
 			", members.grant_id" +
-			" FROM projections.project_grant_members4 AS members" +
+			" FROM projections.project_grant_members5 AS members" +
+			" UNION ALL " +
+			"SELECT members.user_id" +
+			", members.roles" +
+			", members.creation_date" +
+			", members.change_date" +
+			", members.sequence" +
+			", members.resource_owner" +
+			", members.instance_id" +
+			", members.valid_from" +
+			", members.valid_until" +
+			", members.org_id" +
+			", members.id" +
+			", NULL::TEXT AS project_id" +
+			", NULL::TEXT AS grant_id" +
+			" FROM (SELECT projections.access_requests3.user_id" +
+			", projections.access_requests3.roles" +
+			", projections.access_requests3.change_date AS creation_date" +
+			", projections.access_requests3.change_date" +
+			", projections.access_requests3.sequence" +
+			", projections.access_requests3.resource_owner" +
+			", projections.access_requests3.instance_id" +
+			", projections.access_requests3.change_date AS valid_from" +
+			", projections.access_requests3.valid_until" +
+			", (CASE WHEN projections.access_requests3.scope = $1 THEN projections.access_requests3.resource_owner END) AS org_id" +
+			", (CASE WHEN projections.access_requests3.scope = $2 THEN projections.access_requests3.resource_owner END) AS id" +
+			" FROM projections.access_requests3" +
+			" WHERE projections.access_requests3.elevation = $3 AND projections.access_requests3.state = $4" +
+			" AND (EXISTS (SELECT 1 FROM projections.org_members5 AS m WHERE m.instance_id = projections.access_requests3.instance_id AND m.org_id = projections.access_requests3.resource_owner AND m.user_id = projections.access_requests3.user_id)" +
+			" OR EXISTS (SELECT 1 FROM projections.instance_members5 AS m WHERE m.instance_id = projections.access_requests3.instance_id AND m.id = projections.access_requests3.resource_owner AND m.user_id = projections.access_requests3.user_id))" +
+			") AS members" +
 			") AS members" +
 			" LEFT JOIN projections.projects4 ON members.project_id = projections.projects4.id AND members.instance_id = projections.projects4.instance_id" +
-			" LEFT JOIN projections.orgs1 ON members.org_id = projections.orgs1.id AND members.instance_id = projections.orgs1.instance_id" +
+			" LEFT JOIN projections.orgs2 ON members.org_id = projections.orgs2.id AND members.instance_id = projections.orgs2.instance_id" +
 			" LEFT JOIN projections.project_grants4 ON members.grant_id = projections.project_grants4.grant_id AND members.instance_id = projections.project_grants4.instance_id" +
 			" LEFT JOIN projections.instances ON members.instance_id = projections.instances.id" +
 			` AS OF SYSTEM TIME '-1 ms'`)
@@ -96,6 +136,8 @@ var (
 		"change_date",
 		"sequence",
 		"resource_owner",
+		"valid_from",
+		"valid_until",
 		"org_id",
 		"instance_id",
 		"project_id",
@@ -146,6 +188,8 @@ func Test_MembershipPrepares(t *testing.T) {
 							testNow,
 							uint64(20211202),
 							"ro",
+							nil,
+							testNow,
 							"org-id",
 							nil,
 							nil,
@@ -170,6 +214,7 @@ func Test_MembershipPrepares(t *testing.T) {
 						ChangeDate:    testNow,
 						Sequence:      20211202,
 						ResourceOwner: "ro",
+						ValidUntil:    testNow,
 						Org:           &OrgMembership{OrgID: "org-id", Name: "org-name"},
 					},
 				},
@@ -191,6 +236,8 @@ func Test_MembershipPrepares(t *testing.T) {
 							uint64(20211202),
 							"ro",
 							nil,
+							nil,
+							nil,
 							"iam-id",
 							nil,
 							nil,
@@ -236,6 +283,8 @@ func Test_MembershipPrepares(t *testing.T) {
 							"ro",
 							nil,
 							nil,
+							nil,
+							nil,
 							"project-id",
 							nil,
 							nil,
@@ -280,6 +329,8 @@ func Test_MembershipPrepares(t *testing.T) {
 							"ro",
 							nil,
 							nil,
+							nil,
+							nil,
 							"project-id",
 							"grant-id",
 							"granted-org-id",
@@ -327,6 +378,8 @@ func Test_MembershipPrepares(t *testing.T) {
 							testNow,
 							uint64(20211202),
 							"ro",
+							nil,
+							nil,
 							"org-id",
 							nil,
 							nil,
@@ -344,6 +397,8 @@ func Test_MembershipPrepares(t *testing.T) {
 							uint64(20211202),
 							"ro",
 							nil,
+							nil,
+							nil,
 							"iam-id",
 							nil,
 							nil,
@@ -361,6 +416,8 @@ func Test_MembershipPrepares(t *testing.T) {
 							"ro",
 							nil,
 							nil,
+							nil,
+							nil,
 							"project-id",
 							nil,
 							nil,
@@ -377,6 +434,8 @@ func Test_MembershipPrepares(t *testing.T) {
 							"ro",
 							nil,
 							nil,
+							nil,
+							nil,
 							"project-id",
 							"grant-id",
 							"granted-org-id",
@@ -88,10 +88,18 @@ func (q *Queries) GetOIDCUserInfo(ctx context.Context, userID string, roleAudien
 }
 
 type OIDCUserInfo struct {
-	User       *User          `json:"user,omitempty"`
-	Metadata   []UserMetadata `json:"metadata,omitempty"`
-	Org        *UserInfoOrg   `json:"org,omitempty"`
-	UserGrants []UserGrant    `json:"user_grants,omitempty"`
+	User       *User           `json:"user,omitempty"`
+	Metadata   []UserMetadata  `json:"metadata,omitempty"`
+	Org        *UserInfoOrg    `json:"org,omitempty"`
+	UserGrants []UserGrant     `json:"user_grants,omitempty"`
+	Groups     []UserInfoGroup `json:"groups,omitempty"`
+}
+
+// UserInfoGroup is a group the user is a member of,
+// either directly or through one of its subgroups.
+type UserInfoGroup struct {
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
 }
 
 type UserInfoOrg struct {
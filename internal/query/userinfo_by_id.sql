@@ -31,21 +31,53 @@ metadata as (
 		and instance_id = $2
 	) r
 ),
--- get all user grants, needed for the orgs query
+-- find the user's groups, including the groups they are nested in
+user_groups as (
+	with recursive memberships as (
+		select group_id
+		from projections.groups_members
+		where member_id = $1
+		and member_type = 1
+		and instance_id = $2
+		union
+		select m.group_id
+		from projections.groups_members m
+		join memberships s on m.member_id = s.group_id
+		where m.member_type = 2
+		and m.instance_id = $2
+	)
+	select g.id, g.name, g.resource_owner
+	from projections.groups g
+	join memberships s on g.id = s.group_id
+	where g.instance_id = $2
+),
+-- get all user grants and the grants of the user's groups, needed for the orgs query
 user_grants as (
-	select id, grant_id, state, creation_date, change_date, sequence, user_id, roles, resource_owner, project_id
-	from projections.user_grants5
+	select id, grant_id, state, creation_date, change_date, sequence, user_id, roles, resource_owner, project_id, null as group_id, null as group_name
+	from projections.user_grants6
 	where user_id = $1
 	and instance_id = $2
 	and project_id = any($3)
+	-- time-bound grants only count inside of their validity
+	and (valid_from is null or valid_from <= now())
+	and (valid_until is null or valid_until > now())
 	{{ if . -}}
 	and resource_owner = any($4)
 	{{- end }}
+	union all
+	select g.id, gg.grant_id, 1, gg.creation_date, gg.change_date, gg.sequence, $1, gg.roles, g.resource_owner, gg.project_id, g.id, g.name
+	from projections.groups_grants gg
+	join user_groups g on g.id = gg.group_id
+	where gg.instance_id = $2
+	and gg.project_id = any($3)
+	{{ if . -}}
+	and g.resource_owner = any($4)
+	{{- end }}
 ),
 -- filter all orgs we are interested in.
 orgs as (
 	select id, name, primary_domain
-	from projections.orgs1
+	from projections.orgs2
 	where id in (
 		select resource_owner from user_grants
 		union
@@ -86,5 +118,6 @@ select json_build_object(
 	),
 	'org', (select organization from user_org),
 	'metadata', (select metadata from metadata),
+	'groups', (select json_agg(json_build_object('id', id, 'name', name)) from user_groups),
 	'user_grants', (select grants from grants)
 );
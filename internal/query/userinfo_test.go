@@ -28,6 +28,8 @@ var (
 	testdataUserInfoHuman string
 	//go:embed testdata/userinfo_human_grants.json
 	testdataUserInfoHumanGrants string
+	//go:embed testdata/userinfo_human_groups.json
+	testdataUserInfoHumanGroups string
 	//go:embed testdata/userinfo_machine.json
 	testdataUserInfoMachine string
 
@@ -376,6 +378,75 @@ func TestQueries_GetOIDCUserInfo(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "human with group grants",
+			args: args{
+				userID:       "231965491734773762",
+				roleAudience: []string{"236645808328409090"},
+			},
+			mock: mockQuery(regexp.QuoteMeta(oidcUserInfoQuery),
+				[]string{"json_build_object"},
+				[]driver.Value{testdataUserInfoHumanGroups},
+				"231965491734773762", "instanceID", database.TextArray[string]{"236645808328409090"},
+			),
+			want: &OIDCUserInfo{
+				User: &User{
+					ID:                 "231965491734773762",
+					CreationDate:       time.Date(2023, time.September, 15, 6, 10, 7, 434142000, timeLocation),
+					ChangeDate:         time.Date(2023, time.November, 14, 13, 27, 2, 72318000, timeLocation),
+					Sequence:           1148,
+					State:              1,
+					ResourceOwner:      "231848297847848962",
+					Username:           "tim+tesmail@zitadel.com",
+					PreferredLoginName: "tim+tesmail@zitadel.com@demo.localhost",
+					Human: &Human{
+						FirstName:         "Tim",
+						LastName:          "Mohlmann",
+						NickName:          "muhlemmer",
+						DisplayName:       "Tim Mohlmann",
+						AvatarKey:         "",
+						PreferredLanguage: language.English,
+						Gender:            domain.GenderMale,
+						Email:             "tim+tesmail@zitadel.com",
+						IsEmailVerified:   true,
+						Phone:             "+40123456789",
+						IsPhoneVerified:   false,
+					},
+					Machine: nil,
+				},
+				Org: &UserInfoOrg{
+					ID:            "231848297847848962",
+					Name:          "demo",
+					PrimaryDomain: "demo.localhost",
+				},
+				UserGrants: []UserGrant{
+					{
+						ID:           "240770063282372610",
+						GrantID:      "",
+						State:        1,
+						CreationDate: time.Date(2023, time.November, 14, 23, 55, 41, 155542000, timeLocation),
+						ChangeDate:   time.Date(2023, time.November, 14, 23, 55, 41, 155542000, timeLocation),
+						Sequence:     3,
+						UserID:       "231965491734773762",
+						Roles: []string{
+							"role1",
+						},
+						ResourceOwner:     "231848297847848962",
+						ProjectID:         "236645808328409090",
+						OrgName:           "demo",
+						OrgPrimaryDomain:  "demo.localhost",
+						ProjectName:       "tests",
+						UserResourceOwner: "231848297847848962",
+						GroupID:           "240770063282372610",
+						GroupName:         "developers",
+					},
+				},
+				Groups: []UserInfoGroup{
+					{ID: "240770063282372610", Name: "developers"},
+					{ID: "240770105837781250", Name: "engineering"},
+				},
+			},
+		},
 		{
 			name: "machine with metadata",
 			args: args{
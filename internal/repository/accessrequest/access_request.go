@@ -0,0 +1,158 @@
+package accessrequest
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	eventTypePrefix         eventstore.EventType = "access_request."
+	RequestedEventType                           = eventTypePrefix + "requested"
+	ApprovedEventType                            = eventTypePrefix + "approved"
+	DeniedEventType                              = eventTypePrefix + "denied"
+	ElevationEndedEventType                      = eventTypePrefix + "elevation.ended"
+)
+
+type RequestedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	UserID   string                    `json:"userId"`
+	Scope    domain.AccessRequestScope `json:"scope"`
+	Roles    []string                  `json:"roles"`
+	Duration time.Duration             `json:"duration"`
+	Reason   string                    `json:"reason,omitempty"`
+}
+
+func (e *RequestedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *RequestedEvent) Payload() any {
+	return e
+}
+
+func (e *RequestedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewRequestedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	userID string,
+	scope domain.AccessRequestScope,
+	roles []string,
+	duration time.Duration,
+	reason string,
+) *RequestedEvent {
+	return &RequestedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx, aggregate, RequestedEventType,
+		),
+		UserID:   userID,
+		Scope:    scope,
+		Roles:    roles,
+		Duration: duration,
+		Reason:   reason,
+	}
+}
+
+// ApprovedEvent is pushed together with the time-bound membership granted by the approval.
+// The approver is the creator of the event.
+// Elevation is set if the user was already a member, the membership is left unchanged
+// and the requested roles are granted in addition until the validity ends.
+type ApprovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ValidUntil time.Time `json:"validUntil"`
+	Elevation  bool      `json:"elevation,omitempty"`
+}
+
+func (e *ApprovedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *ApprovedEvent) Payload() any {
+	return e
+}
+
+func (e *ApprovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewApprovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	validUntil time.Time,
+	elevation bool,
+) *ApprovedEvent {
+	return &ApprovedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx, aggregate, ApprovedEventType,
+		),
+		ValidUntil: validUntil,
+		Elevation:  elevation,
+	}
+}
+
+type DeniedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Reason string `json:"reason,omitempty"`
+}
+
+func (e *DeniedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *DeniedEvent) Payload() any {
+	return e
+}
+
+func (e *DeniedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewDeniedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	reason string,
+) *DeniedEvent {
+	return &DeniedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx, aggregate, DeniedEventType,
+		),
+		Reason: reason,
+	}
+}
+
+// ElevationEndedEvent records the end of the elevation of a member by the approval.
+// The elevated roles are not granted after the validity, regardless of the event.
+type ElevationEndedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *ElevationEndedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *ElevationEndedEvent) Payload() any {
+	return e
+}
+
+func (e *ElevationEndedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewElevationEndedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+) *ElevationEndedEvent {
+	return &ElevationEndedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx, aggregate, ElevationEndedEventType,
+		),
+	}
+}
@@ -0,0 +1,10 @@
+package accessrequest
+
+import "github.com/zitadel/zitadel/internal/eventstore"
+
+func init() {
+	eventstore.RegisterFilterEventMapper(AggregateType, RequestedEventType, eventstore.GenericEventMapper[RequestedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, ApprovedEventType, eventstore.GenericEventMapper[ApprovedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, DeniedEventType, eventstore.GenericEventMapper[DeniedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, ElevationEndedEventType, eventstore.GenericEventMapper[ElevationEndedEvent])
+}
@@ -0,0 +1,202 @@
+package accessreview
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	eventTypePrefix      eventstore.EventType = "access_review."
+	StartedEventType                          = eventTypePrefix + "started"
+	ItemAddedEventType                        = eventTypePrefix + "item.added"
+	ItemDecidedEventType                      = eventTypePrefix + "item.decided"
+	CompletedEventType                        = eventTypePrefix + "completed"
+	CancelledEventType                        = eventTypePrefix + "cancelled"
+)
+
+type StartedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Name               string                            `json:"name"`
+	Scope              domain.AccessReviewScope          `json:"scope"`
+	ProjectID          string                            `json:"projectId,omitempty"`
+	RoleKey            string                            `json:"roleKey,omitempty"`
+	ReviewerType       domain.AccessReviewerType         `json:"reviewerType"`
+	ManagerMetadataKey string                            `json:"managerMetadataKey,omitempty"`
+	Deadline           time.Time                         `json:"deadline"`
+	DeadlineAction     domain.AccessReviewDeadlineAction `json:"deadlineAction"`
+}
+
+func (e *StartedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *StartedEvent) Payload() any {
+	return e
+}
+
+func (e *StartedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewStartedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	review *domain.AccessReview,
+) *StartedEvent {
+	return &StartedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx, aggregate, StartedEventType,
+		),
+		Name:               review.Name,
+		Scope:              review.Scope,
+		ProjectID:          review.ProjectID,
+		RoleKey:            review.RoleKey,
+		ReviewerType:       review.ReviewerType,
+		ManagerMetadataKey: review.ManagerMetadataKey,
+		Deadline:           review.Deadline,
+		DeadlineAction:     review.DeadlineAction,
+	}
+}
+
+// ItemAddedEvent records the state of a user grant or membership at the start of the campaign.
+type ItemAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ItemID            string                      `json:"itemId"`
+	ItemType          domain.AccessReviewItemType `json:"itemType"`
+	ObjectID          string                      `json:"objectId"`
+	ItemResourceOwner string                      `json:"itemResourceOwner"`
+	UserID            string                      `json:"userId"`
+	ProjectID         string                      `json:"projectId,omitempty"`
+	Roles             []string                    `json:"roles,omitempty"`
+	Reviewers         []string                    `json:"reviewers,omitempty"`
+}
+
+func (e *ItemAddedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *ItemAddedEvent) Payload() any {
+	return e
+}
+
+func (e *ItemAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewItemAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	item *domain.AccessReviewItem,
+) *ItemAddedEvent {
+	return &ItemAddedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx, aggregate, ItemAddedEventType,
+		),
+		ItemID:            item.ID,
+		ItemType:          item.Type,
+		ObjectID:          item.ObjectID,
+		ItemResourceOwner: item.ResourceOwner,
+		UserID:            item.UserID,
+		ProjectID:         item.ProjectID,
+		Roles:             item.Roles,
+		Reviewers:         item.Reviewers,
+	}
+}
+
+// ItemDecidedEvent is pushed together with the removal of the user grant or membership, if it is revoked.
+// The reviewer is the creator of the event.
+type ItemDecidedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ItemID   string                      `json:"itemId"`
+	Decision domain.AccessReviewDecision `json:"decision"`
+	Comment  string                      `json:"comment,omitempty"`
+}
+
+func (e *ItemDecidedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *ItemDecidedEvent) Payload() any {
+	return e
+}
+
+func (e *ItemDecidedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewItemDecidedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	itemID string,
+	decision domain.AccessReviewDecision,
+	comment string,
+) *ItemDecidedEvent {
+	return &ItemDecidedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx, aggregate, ItemDecidedEventType,
+		),
+		ItemID:   itemID,
+		Decision: decision,
+		Comment:  comment,
+	}
+}
+
+type CompletedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *CompletedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *CompletedEvent) Payload() any {
+	return nil
+}
+
+func (e *CompletedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewCompletedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+) *CompletedEvent {
+	return &CompletedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx, aggregate, CompletedEventType,
+		),
+	}
+}
+
+type CancelledEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *CancelledEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *CancelledEvent) Payload() any {
+	return nil
+}
+
+func (e *CancelledEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewCancelledEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+) *CancelledEvent {
+	return &CancelledEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx, aggregate, CancelledEventType,
+		),
+	}
+}
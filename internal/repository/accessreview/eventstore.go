@@ -0,0 +1,11 @@
+package accessreview
+
+import "github.com/zitadel/zitadel/internal/eventstore"
+
+func init() {
+	eventstore.RegisterFilterEventMapper(AggregateType, StartedEventType, eventstore.GenericEventMapper[StartedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, ItemAddedEventType, eventstore.GenericEventMapper[ItemAddedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, ItemDecidedEventType, eventstore.GenericEventMapper[ItemDecidedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, CompletedEventType, eventstore.GenericEventMapper[CompletedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, CancelledEventType, eventstore.GenericEventMapper[CancelledEvent])
+}
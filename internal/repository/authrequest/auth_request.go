@@ -38,6 +38,8 @@ type AddedEvent struct {
 	LoginHint        *string                   `json:"login_hint,omitempty"`
 	HintUserID       *string                   `json:"hint_user_id,omitempty"`
 	NeedRefreshToken bool                      `json:"need_refresh_token,omitempty"`
+	// RequiredLevelOfAssurance is requested by the client or the application and checked when linking the session
+	RequiredLevelOfAssurance domain.LevelOfAssurance `json:"required_level_of_assurance,omitempty"`
 }
 
 func (e *AddedEvent) Payload() interface{} {
@@ -66,6 +68,7 @@ func NewAddedEvent(ctx context.Context,
 	loginHint,
 	hintUserID *string,
 	needRefreshToken bool,
+	requiredLevelOfAssurance domain.LevelOfAssurance,
 ) *AddedEvent {
 	return &AddedEvent{
 		BaseEvent: *eventstore.NewBaseEventForPush(
@@ -89,6 +92,8 @@ func NewAddedEvent(ctx context.Context,
 		LoginHint:        loginHint,
 		HintUserID:       hintUserID,
 		NeedRefreshToken: needRefreshToken,
+
+		RequiredLevelOfAssurance: requiredLevelOfAssurance,
 	}
 }
 
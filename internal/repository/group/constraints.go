@@ -0,0 +1,25 @@
+package group
+
+import (
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	UniqueGroupName = "group_name"
+	DuplicateGroup  = "Errors.Group.AlreadyExists"
+)
+
+func NewAddGroupNameUniqueConstraint(name, resourceOwner string) *eventstore.UniqueConstraint {
+	return eventstore.NewAddEventUniqueConstraint(
+		UniqueGroupName,
+		name+resourceOwner,
+		DuplicateGroup,
+	)
+}
+
+func NewRemoveGroupNameUniqueConstraint(name, resourceOwner string) *eventstore.UniqueConstraint {
+	return eventstore.NewRemoveUniqueConstraint(
+		UniqueGroupName,
+		name+resourceOwner,
+	)
+}
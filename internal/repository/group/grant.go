@@ -0,0 +1,102 @@
+package group
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	grantEventTypePrefix  = eventTypePrefix + "grant."
+	GrantAddedEventType   = grantEventTypePrefix + "added"
+	GrantChangedEventType = grantEventTypePrefix + "changed"
+	GrantRemovedEventType = grantEventTypePrefix + "removed"
+)
+
+// GrantAddedEvent grants roles of a project to all members of the group.
+// A group can only have one grant per project (or project grant).
+type GrantAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ProjectID      string   `json:"projectId"`
+	ProjectGrantID string   `json:"grantId,omitempty"`
+	RoleKeys       []string `json:"roleKeys"`
+}
+
+func (e *GrantAddedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *GrantAddedEvent) Payload() any {
+	return e
+}
+
+func (e *GrantAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewGrantAddedEvent(ctx context.Context, aggregate *eventstore.Aggregate, projectID, projectGrantID string, roleKeys []string) *GrantAddedEvent {
+	return &GrantAddedEvent{
+		BaseEvent:      *eventstore.NewBaseEventForPush(ctx, aggregate, GrantAddedEventType),
+		ProjectID:      projectID,
+		ProjectGrantID: projectGrantID,
+		RoleKeys:       roleKeys,
+	}
+}
+
+// GrantChangedEvent replaces the roles of the grant.
+type GrantChangedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ProjectID      string   `json:"projectId"`
+	ProjectGrantID string   `json:"grantId,omitempty"`
+	RoleKeys       []string `json:"roleKeys"`
+}
+
+func (e *GrantChangedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *GrantChangedEvent) Payload() any {
+	return e
+}
+
+func (e *GrantChangedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewGrantChangedEvent(ctx context.Context, aggregate *eventstore.Aggregate, projectID, projectGrantID string, roleKeys []string) *GrantChangedEvent {
+	return &GrantChangedEvent{
+		BaseEvent:      *eventstore.NewBaseEventForPush(ctx, aggregate, GrantChangedEventType),
+		ProjectID:      projectID,
+		ProjectGrantID: projectGrantID,
+		RoleKeys:       roleKeys,
+	}
+}
+
+type GrantRemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ProjectID      string `json:"projectId"`
+	ProjectGrantID string `json:"grantId,omitempty"`
+}
+
+func (e *GrantRemovedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *GrantRemovedEvent) Payload() any {
+	return e
+}
+
+func (e *GrantRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewGrantRemovedEvent(ctx context.Context, aggregate *eventstore.Aggregate, projectID, projectGrantID string) *GrantRemovedEvent {
+	return &GrantRemovedEvent{
+		BaseEvent:      *eventstore.NewBaseEventForPush(ctx, aggregate, GrantRemovedEventType),
+		ProjectID:      projectID,
+		ProjectGrantID: projectGrantID,
+	}
+}
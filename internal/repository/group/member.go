@@ -0,0 +1,119 @@
+package group
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	memberEventTypePrefix    = eventTypePrefix + "member."
+	MemberAddedEventType     = memberEventTypePrefix + "added"
+	MemberRemovedEventType   = memberEventTypePrefix + "removed"
+	subgroupEventTypePrefix  = eventTypePrefix + "subgroup."
+	SubgroupAddedEventType   = subgroupEventTypePrefix + "added"
+	SubgroupRemovedEventType = subgroupEventTypePrefix + "removed"
+)
+
+// MemberAddedEvent adds a user to the group.
+type MemberAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	UserID string `json:"userId"`
+}
+
+func (e *MemberAddedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *MemberAddedEvent) Payload() any {
+	return e
+}
+
+func (e *MemberAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewMemberAddedEvent(ctx context.Context, aggregate *eventstore.Aggregate, userID string) *MemberAddedEvent {
+	return &MemberAddedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(ctx, aggregate, MemberAddedEventType),
+		UserID:    userID,
+	}
+}
+
+type MemberRemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	UserID string `json:"userId"`
+}
+
+func (e *MemberRemovedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *MemberRemovedEvent) Payload() any {
+	return e
+}
+
+func (e *MemberRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewMemberRemovedEvent(ctx context.Context, aggregate *eventstore.Aggregate, userID string) *MemberRemovedEvent {
+	return &MemberRemovedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(ctx, aggregate, MemberRemovedEventType),
+		UserID:    userID,
+	}
+}
+
+// SubgroupAddedEvent nests another group of the same organization in the group.
+// The members of the subgroup are members of the group as well.
+type SubgroupAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	GroupID string `json:"groupId"`
+}
+
+func (e *SubgroupAddedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *SubgroupAddedEvent) Payload() any {
+	return e
+}
+
+func (e *SubgroupAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewSubgroupAddedEvent(ctx context.Context, aggregate *eventstore.Aggregate, groupID string) *SubgroupAddedEvent {
+	return &SubgroupAddedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(ctx, aggregate, SubgroupAddedEventType),
+		GroupID:   groupID,
+	}
+}
+
+type SubgroupRemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	GroupID string `json:"groupId"`
+}
+
+func (e *SubgroupRemovedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *SubgroupRemovedEvent) Payload() any {
+	return e
+}
+
+func (e *SubgroupRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewSubgroupRemovedEvent(ctx context.Context, aggregate *eventstore.Aggregate, groupID string) *SubgroupRemovedEvent {
+	return &SubgroupRemovedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(ctx, aggregate, SubgroupRemovedEventType),
+		GroupID:   groupID,
+	}
+}
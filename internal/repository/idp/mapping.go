@@ -0,0 +1,84 @@
+package idp
+
+import (
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// MappingRules define how the information of an external user is applied
+// to the linked ZITADEL user on every successful login.
+// Claims are referenced by their (dot separated) path in the raw information of the external user,
+// e.g. `groups`, `RawInfo.department` or `attributes.memberOf` (SAML and LDAP).
+type MappingRules struct {
+	ProfileMappings  []ProfileMapping  `json:"profileMappings,omitempty"`
+	MetadataMappings []MetadataMapping `json:"metadataMappings,omitempty"`
+	// GroupsClaim is the claim containing the groups of the external user
+	GroupsClaim   string         `json:"groupsClaim,omitempty"`
+	GroupMappings []GroupMapping `json:"groupMappings,omitempty"`
+}
+
+// ProfileMapping maps a claim to a field of the user profile
+type ProfileMapping struct {
+	Claim string                        `json:"claim"`
+	Field domain.IDPMappingProfileField `json:"field"`
+}
+
+// MetadataMapping maps a claim to a metadata key of the user
+type MetadataMapping struct {
+	Claim string `json:"claim"`
+	Key   string `json:"key"`
+}
+
+// GroupMapping grants the roles of the project (grant) to users in the external group.
+// The roles are removed again, as soon as the user is no longer in the group.
+type GroupMapping struct {
+	Group          string   `json:"group"`
+	ProjectID      string   `json:"projectId"`
+	ProjectGrantID string   `json:"projectGrantId,omitempty"`
+	RoleKeys       []string `json:"roleKeys"`
+}
+
+func (r *MappingRules) IsZero() bool {
+	return len(r.ProfileMappings) == 0 && len(r.MetadataMappings) == 0 && len(r.GroupMappings) == 0
+}
+
+type MappingRulesSetEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ID    string       `json:"id"`
+	Rules MappingRules `json:"rules"`
+}
+
+func NewMappingRulesSetEvent(
+	base *eventstore.BaseEvent,
+	id string,
+	rules MappingRules,
+) *MappingRulesSetEvent {
+	return &MappingRulesSetEvent{
+		BaseEvent: *base,
+		ID:        id,
+		Rules:     rules,
+	}
+}
+
+func (e *MappingRulesSetEvent) Payload() interface{} {
+	return e
+}
+
+func (e *MappingRulesSetEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func MappingRulesSetEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &MappingRulesSetEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "IDP-Mz7ga", "unable to unmarshal event")
+	}
+
+	return e, nil
+}
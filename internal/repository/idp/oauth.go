@@ -18,6 +18,8 @@ type OAuthIDPAddedEvent struct {
 	UserEndpoint          string              `json:"userEndpoint,omitempty"`
 	Scopes                []string            `json:"scopes,omitempty"`
 	IDAttribute           string              `json:"idAttribute,omitempty"`
+	UsePKCE               bool                `json:"usePKCE,omitempty"`
+	CodeVerifierKey       *crypto.CryptoValue `json:"codeVerifierKey,omitempty"`
 	Options
 }
 
@@ -32,6 +34,8 @@ func NewOAuthIDPAddedEvent(
 	userEndpoint,
 	idAttribute string,
 	scopes []string,
+	usePKCE bool,
+	codeVerifierKey *crypto.CryptoValue,
 	options Options,
 ) *OAuthIDPAddedEvent {
 	return &OAuthIDPAddedEvent{
@@ -45,6 +49,8 @@ func NewOAuthIDPAddedEvent(
 		UserEndpoint:          userEndpoint,
 		Scopes:                scopes,
 		IDAttribute:           idAttribute,
+		UsePKCE:               usePKCE,
+		CodeVerifierKey:       codeVerifierKey,
 		Options:               options,
 	}
 }
@@ -82,6 +88,8 @@ type OAuthIDPChangedEvent struct {
 	UserEndpoint          *string             `json:"userEndpoint,omitempty"`
 	Scopes                []string            `json:"scopes,omitempty"`
 	IDAttribute           *string             `json:"idAttribute,omitempty"`
+	UsePKCE               *bool               `json:"usePKCE,omitempty"`
+	CodeVerifierKey       *crypto.CryptoValue `json:"codeVerifierKey,omitempty"`
 	OptionChanges
 }
 
@@ -158,6 +166,18 @@ func ChangeOAuthIDAttribute(idAttribute string) func(*OAuthIDPChangedEvent) {
 	}
 }
 
+func ChangeOAuthUsePKCE(usePKCE bool) func(*OAuthIDPChangedEvent) {
+	return func(e *OAuthIDPChangedEvent) {
+		e.UsePKCE = &usePKCE
+	}
+}
+
+func ChangeOAuthCodeVerifierKey(codeVerifierKey *crypto.CryptoValue) func(*OAuthIDPChangedEvent) {
+	return func(e *OAuthIDPChangedEvent) {
+		e.CodeVerifierKey = codeVerifierKey
+	}
+}
+
 func (e *OAuthIDPChangedEvent) Payload() interface{} {
 	return e
 }
@@ -0,0 +1,151 @@
+package idp
+
+import (
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type OktaIDPAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ID           string              `json:"id"`
+	Name         string              `json:"name"`
+	Issuer       string              `json:"issuer"`
+	ClientID     string              `json:"client_id"`
+	ClientSecret *crypto.CryptoValue `json:"client_secret"`
+	Scopes       []string            `json:"scopes,omitempty"`
+	Options
+}
+
+func NewOktaIDPAddedEvent(
+	base *eventstore.BaseEvent,
+	id,
+	name,
+	issuer,
+	clientID string,
+	clientSecret *crypto.CryptoValue,
+	scopes []string,
+	options Options,
+) *OktaIDPAddedEvent {
+	return &OktaIDPAddedEvent{
+		BaseEvent:    *base,
+		ID:           id,
+		Name:         name,
+		Issuer:       issuer,
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		Scopes:       scopes,
+		Options:      options,
+	}
+}
+
+func (e *OktaIDPAddedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *OktaIDPAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func OktaIDPAddedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &OktaIDPAddedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "IDP-deF2E", "unable to unmarshal event")
+	}
+
+	return e, nil
+}
+
+type OktaIDPChangedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ID           string              `json:"id"`
+	Name         *string             `json:"name,omitempty"`
+	Issuer       *string             `json:"issuer,omitempty"`
+	ClientID     *string             `json:"client_id,omitempty"`
+	ClientSecret *crypto.CryptoValue `json:"client_secret,omitempty"`
+	Scopes       []string            `json:"scopes,omitempty"`
+	OptionChanges
+}
+
+func NewOktaIDPChangedEvent(
+	base *eventstore.BaseEvent,
+	id string,
+	changes []OktaIDPChanges,
+) (*OktaIDPChangedEvent, error) {
+	if len(changes) == 0 {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "IDP-NZ4ZR", "Errors.NoChangesFound")
+	}
+	changedEvent := &OktaIDPChangedEvent{
+		BaseEvent: *base,
+		ID:        id,
+	}
+	for _, change := range changes {
+		change(changedEvent)
+	}
+	return changedEvent, nil
+}
+
+type OktaIDPChanges func(*OktaIDPChangedEvent)
+
+func ChangeOktaName(name string) func(*OktaIDPChangedEvent) {
+	return func(e *OktaIDPChangedEvent) {
+		e.Name = &name
+	}
+}
+
+func ChangeOktaIssuer(issuer string) func(*OktaIDPChangedEvent) {
+	return func(e *OktaIDPChangedEvent) {
+		e.Issuer = &issuer
+	}
+}
+
+func ChangeOktaClientID(clientID string) func(*OktaIDPChangedEvent) {
+	return func(e *OktaIDPChangedEvent) {
+		e.ClientID = &clientID
+	}
+}
+
+func ChangeOktaClientSecret(clientSecret *crypto.CryptoValue) func(*OktaIDPChangedEvent) {
+	return func(e *OktaIDPChangedEvent) {
+		e.ClientSecret = clientSecret
+	}
+}
+
+func ChangeOktaScopes(scopes []string) func(*OktaIDPChangedEvent) {
+	return func(e *OktaIDPChangedEvent) {
+		e.Scopes = scopes
+	}
+}
+
+func ChangeOktaOptions(options OptionChanges) func(*OktaIDPChangedEvent) {
+	return func(e *OktaIDPChangedEvent) {
+		e.OptionChanges = options
+	}
+}
+
+func (e *OktaIDPChangedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *OktaIDPChangedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func OktaIDPChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &OktaIDPChangedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "IDP-laTMe", "unable to unmarshal event")
+	}
+
+	return e, nil
+}
@@ -0,0 +1,143 @@
+package instance
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	UniqueAdministratorRole           = "administrator_role"
+	administratorRoleEventPrefix      = instanceEventTypePrefix + "administrator.role."
+	AdministratorRoleAddedEventType   = administratorRoleEventPrefix + "added"
+	AdministratorRoleChangedEventType = administratorRoleEventPrefix + "changed"
+	AdministratorRoleRemovedEventType = administratorRoleEventPrefix + "removed"
+)
+
+func NewAddAdministratorRoleUniqueConstraint(key string) *eventstore.UniqueConstraint {
+	return eventstore.NewAddEventUniqueConstraint(
+		UniqueAdministratorRole,
+		key,
+		"Errors.AdministratorRole.AlreadyExists")
+}
+
+func NewRemoveAdministratorRoleUniqueConstraint(key string) *eventstore.UniqueConstraint {
+	return eventstore.NewRemoveUniqueConstraint(
+		UniqueAdministratorRole,
+		key)
+}
+
+// AdministratorRoleAddedEvent adds a custom role, which can be assigned to members like the roles of the config.
+type AdministratorRoleAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Key         string   `json:"key"`
+	DisplayName string   `json:"displayName,omitempty"`
+	Permissions []string `json:"permissions"`
+}
+
+func (e *AdministratorRoleAddedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *AdministratorRoleAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return []*eventstore.UniqueConstraint{NewAddAdministratorRoleUniqueConstraint(e.Key)}
+}
+
+func (e *AdministratorRoleAddedEvent) SetBaseEvent(base *eventstore.BaseEvent) {
+	e.BaseEvent = *base
+}
+
+func NewAdministratorRoleAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	key,
+	displayName string,
+	permissions []string,
+) *AdministratorRoleAddedEvent {
+	return &AdministratorRoleAddedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			AdministratorRoleAddedEventType,
+		),
+		Key:         key,
+		DisplayName: displayName,
+		Permissions: permissions,
+	}
+}
+
+// AdministratorRoleChangedEvent replaces the display name and the permissions of the custom role.
+type AdministratorRoleChangedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Key         string   `json:"key"`
+	DisplayName string   `json:"displayName,omitempty"`
+	Permissions []string `json:"permissions"`
+}
+
+func (e *AdministratorRoleChangedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *AdministratorRoleChangedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *AdministratorRoleChangedEvent) SetBaseEvent(base *eventstore.BaseEvent) {
+	e.BaseEvent = *base
+}
+
+func NewAdministratorRoleChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	key,
+	displayName string,
+	permissions []string,
+) *AdministratorRoleChangedEvent {
+	return &AdministratorRoleChangedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			AdministratorRoleChangedEventType,
+		),
+		Key:         key,
+		DisplayName: displayName,
+		Permissions: permissions,
+	}
+}
+
+// AdministratorRoleRemovedEvent removes the custom role.
+// Members keep the role, but it doesn't grant any permissions anymore.
+type AdministratorRoleRemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Key string `json:"key"`
+}
+
+func (e *AdministratorRoleRemovedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *AdministratorRoleRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return []*eventstore.UniqueConstraint{NewRemoveAdministratorRoleUniqueConstraint(e.Key)}
+}
+
+func (e *AdministratorRoleRemovedEvent) SetBaseEvent(base *eventstore.BaseEvent) {
+	e.BaseEvent = *base
+}
+
+func NewAdministratorRoleRemovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	key string,
+) *AdministratorRoleRemovedEvent {
+	return &AdministratorRoleRemovedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			AdministratorRoleRemovedEventType,
+		),
+		Key: key,
+	}
+}
@@ -0,0 +1,59 @@
+package instance
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	BreakGlassPolicySetEventType = instanceEventTypePrefix + "policy.breakglass.set"
+)
+
+// BreakGlassPolicySetEvent replaces the monitoring of the emergency access accounts of the instance.
+type BreakGlassPolicySetEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	RecipientUserIDs []string      `json:"recipientUserIds,omitempty"`
+	TargetIDs        []string      `json:"targetIds,omitempty"`
+	SessionLifetime  time.Duration `json:"sessionLifetime,omitempty"`
+}
+
+func (e *BreakGlassPolicySetEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *BreakGlassPolicySetEvent) Payload() interface{} {
+	return e
+}
+
+func (e *BreakGlassPolicySetEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *BreakGlassPolicySetEvent) Policy() *domain.BreakGlassPolicy {
+	return &domain.BreakGlassPolicy{
+		RecipientUserIDs: e.RecipientUserIDs,
+		TargetIDs:        e.TargetIDs,
+		SessionLifetime:  e.SessionLifetime,
+	}
+}
+
+func NewBreakGlassPolicySetEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	policy *domain.BreakGlassPolicy,
+) *BreakGlassPolicySetEvent {
+	return &BreakGlassPolicySetEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			BreakGlassPolicySetEventType,
+		),
+		RecipientUserIDs: policy.RecipientUserIDs,
+		TargetIDs:        policy.TargetIDs,
+		SessionLifetime:  policy.SessionLifetime,
+	}
+}
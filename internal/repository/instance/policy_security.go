@@ -21,6 +21,7 @@ type SecurityPolicySetEvent struct {
 	EnableIframeEmbedding *bool     `json:"enable_iframe_embedding,omitempty"`
 	AllowedOrigins        *[]string `json:"allowedOrigins,omitempty"`
 	EnableImpersonation   *bool     `json:"enable_impersonation,omitempty"`
+	SMSAllowedCountries   *[]string `json:"smsAllowedCountries,omitempty"`
 }
 
 func NewSecurityPolicySetEvent(
@@ -67,6 +68,15 @@ func ChangeSecurityPolicyEnableImpersonation(enabled bool) func(event *SecurityP
 	}
 }
 
+func ChangeSecurityPolicySMSAllowedCountries(countries []string) func(event *SecurityPolicySetEvent) {
+	return func(e *SecurityPolicySetEvent) {
+		if len(countries) == 0 {
+			countries = []string{}
+		}
+		e.SMSAllowedCountries = &countries
+	}
+}
+
 func (e *SecurityPolicySetEvent) Payload() interface{} {
 	return e
 }
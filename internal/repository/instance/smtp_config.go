@@ -16,6 +16,11 @@ const (
 	SMTPConfigRemovedEventType         = instanceEventTypePrefix + smtpConfigPrefix + "removed"
 	SMTPConfigActivatedEventType       = instanceEventTypePrefix + smtpConfigPrefix + "activated"
 	SMTPConfigDeactivatedEventType     = instanceEventTypePrefix + smtpConfigPrefix + "deactivated"
+	SMTPConfigHTTPAddedEventType       = instanceEventTypePrefix + smtpConfigPrefix + "http.added"
+	SMTPConfigHTTPChangedEventType     = instanceEventTypePrefix + smtpConfigPrefix + "http.changed"
+	SMTPConfigDKIMSetEventType         = instanceEventTypePrefix + smtpConfigPrefix + "dkim.set"
+	SMTPConfigDKIMRemovedEventType     = instanceEventTypePrefix + smtpConfigPrefix + "dkim.removed"
+	SMTPConfigHeadersSetEventType      = instanceEventTypePrefix + smtpConfigPrefix + "headers.set"
 )
 
 type SMTPConfigAddedEvent struct {
@@ -195,6 +200,137 @@ func SMTPConfigChangedEventMapper(event eventstore.Event) (eventstore.Event, err
 	return e, nil
 }
 
+type SMTPConfigHTTPAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ID          string              `json:"id,omitempty"`
+	Description string              `json:"description,omitempty"`
+	Endpoint    string              `json:"endpoint,omitempty"`
+	Headers     *crypto.CryptoValue `json:"headers,omitempty"`
+	SigningKey  *crypto.CryptoValue `json:"signingKey,omitempty"`
+}
+
+func NewSMTPConfigHTTPAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id, description,
+	endpoint string,
+	headers,
+	signingKey *crypto.CryptoValue,
+) *SMTPConfigHTTPAddedEvent {
+	return &SMTPConfigHTTPAddedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SMTPConfigHTTPAddedEventType,
+		),
+		ID:          id,
+		Description: description,
+		Endpoint:    endpoint,
+		Headers:     headers,
+		SigningKey:  signingKey,
+	}
+}
+
+func (e *SMTPConfigHTTPAddedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *SMTPConfigHTTPAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func SMTPConfigHTTPAddedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	smtpConfigAdded := &SMTPConfigHTTPAddedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(smtpConfigAdded)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "IAM-Wl3fs", "unable to unmarshal smtp config http added")
+	}
+
+	return smtpConfigAdded, nil
+}
+
+type SMTPConfigHTTPChangedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ID          string              `json:"id,omitempty"`
+	Description *string             `json:"description,omitempty"`
+	Endpoint    *string             `json:"endpoint,omitempty"`
+	Headers     *crypto.CryptoValue `json:"headers,omitempty"`
+	SigningKey  *crypto.CryptoValue `json:"signingKey,omitempty"`
+}
+
+func NewSMTPConfigHTTPChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	changes []SMTPConfigHTTPChanges,
+) (*SMTPConfigHTTPChangedEvent, error) {
+	if len(changes) == 0 {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "IAM-Ptn3c", "Errors.NoChangesFound")
+	}
+	changeEvent := &SMTPConfigHTTPChangedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SMTPConfigHTTPChangedEventType,
+		),
+		ID: id,
+	}
+	for _, change := range changes {
+		change(changeEvent)
+	}
+	return changeEvent, nil
+}
+
+type SMTPConfigHTTPChanges func(event *SMTPConfigHTTPChangedEvent)
+
+func ChangeSMTPConfigHTTPDescription(description string) func(event *SMTPConfigHTTPChangedEvent) {
+	return func(e *SMTPConfigHTTPChangedEvent) {
+		e.Description = &description
+	}
+}
+
+func ChangeSMTPConfigHTTPEndpoint(endpoint string) func(event *SMTPConfigHTTPChangedEvent) {
+	return func(e *SMTPConfigHTTPChangedEvent) {
+		e.Endpoint = &endpoint
+	}
+}
+
+func ChangeSMTPConfigHTTPHeaders(headers *crypto.CryptoValue) func(event *SMTPConfigHTTPChangedEvent) {
+	return func(e *SMTPConfigHTTPChangedEvent) {
+		e.Headers = headers
+	}
+}
+
+func ChangeSMTPConfigHTTPSigningKey(signingKey *crypto.CryptoValue) func(event *SMTPConfigHTTPChangedEvent) {
+	return func(e *SMTPConfigHTTPChangedEvent) {
+		e.SigningKey = signingKey
+	}
+}
+
+func (e *SMTPConfigHTTPChangedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *SMTPConfigHTTPChangedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func SMTPConfigHTTPChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	smtpConfigChanged := &SMTPConfigHTTPChangedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(smtpConfigChanged)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "IAM-Ku2sd", "unable to unmarshal smtp config http changed")
+	}
+
+	return smtpConfigChanged, nil
+}
+
 type SMTPConfigPasswordChangedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 	ID                   string              `json:"id,omitempty"`
@@ -356,3 +492,138 @@ func SMTPConfigRemovedEventMapper(event eventstore.Event) (eventstore.Event, err
 
 	return smtpConfigRemoved, nil
 }
+
+type SMTPConfigDKIMSetEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ID         string              `json:"id,omitempty"`
+	Domain     string              `json:"domain,omitempty"`
+	Selector   string              `json:"selector,omitempty"`
+	PrivateKey *crypto.CryptoValue `json:"privateKey,omitempty"`
+}
+
+func NewSMTPConfigDKIMSetEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id,
+	domain,
+	selector string,
+	privateKey *crypto.CryptoValue,
+) *SMTPConfigDKIMSetEvent {
+	return &SMTPConfigDKIMSetEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SMTPConfigDKIMSetEventType,
+		),
+		ID:         id,
+		Domain:     domain,
+		Selector:   selector,
+		PrivateKey: privateKey,
+	}
+}
+
+func (e *SMTPConfigDKIMSetEvent) Payload() interface{} {
+	return e
+}
+
+func (e *SMTPConfigDKIMSetEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func SMTPConfigDKIMSetEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	smtpConfigDKIMSet := &SMTPConfigDKIMSetEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(smtpConfigDKIMSet)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "IAM-Dk3ls", "unable to unmarshal smtp config dkim set")
+	}
+
+	return smtpConfigDKIMSet, nil
+}
+
+type SMTPConfigDKIMRemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+	ID                   string `json:"id,omitempty"`
+}
+
+func NewSMTPConfigDKIMRemovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+) *SMTPConfigDKIMRemovedEvent {
+	return &SMTPConfigDKIMRemovedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SMTPConfigDKIMRemovedEventType,
+		),
+		ID: id,
+	}
+}
+
+func (e *SMTPConfigDKIMRemovedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *SMTPConfigDKIMRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func SMTPConfigDKIMRemovedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	smtpConfigDKIMRemoved := &SMTPConfigDKIMRemovedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(smtpConfigDKIMRemoved)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "IAM-Dk4rm", "unable to unmarshal smtp config dkim removed")
+	}
+
+	return smtpConfigDKIMRemoved, nil
+}
+
+// SMTPConfigHeadersSetEvent replaces the additional headers of the messages sent through the SMTP configuration,
+// an empty list removes all of them.
+type SMTPConfigHeadersSetEvent struct {
+	eventstore.BaseEvent `json:"-"`
+	ID                   string            `json:"id,omitempty"`
+	Headers              map[string]string `json:"headers,omitempty"`
+}
+
+func NewSMTPConfigHeadersSetEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	headers map[string]string,
+) *SMTPConfigHeadersSetEvent {
+	return &SMTPConfigHeadersSetEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SMTPConfigHeadersSetEventType,
+		),
+		ID:      id,
+		Headers: headers,
+	}
+}
+
+func (e *SMTPConfigHeadersSetEvent) Payload() interface{} {
+	return e
+}
+
+func (e *SMTPConfigHeadersSetEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func SMTPConfigHeadersSetEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	smtpConfigHeadersSet := &SMTPConfigHeadersSetEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(smtpConfigHeadersSet)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "IAM-Hd5st", "unable to unmarshal smtp config headers set")
+	}
+
+	return smtpConfigHeadersSet, nil
+}
@@ -0,0 +1,44 @@
+package notification
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	AggregateType    = "notification"
+	AggregateVersion = "v1"
+)
+
+type Aggregate struct {
+	eventstore.Aggregate
+}
+
+func NewAggregate(ctx context.Context, id string) *Aggregate {
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	return &Aggregate{
+		Aggregate: eventstore.Aggregate{
+			Type:          AggregateType,
+			Version:       AggregateVersion,
+			ID:            id,
+			ResourceOwner: instanceID,
+			InstanceID:    instanceID,
+		},
+	}
+}
+
+// NewDeliveryAggregate returns the aggregate on which the deliveries to the user are recorded.
+// It's separated from the user aggregate, so that sending notifications doesn't grow the events of the user.
+func NewDeliveryAggregate(ctx context.Context, userID, resourceOwner string) *Aggregate {
+	return &Aggregate{
+		Aggregate: eventstore.Aggregate{
+			Type:          AggregateType,
+			Version:       AggregateVersion,
+			ID:            userID,
+			ResourceOwner: resourceOwner,
+			InstanceID:    authz.GetInstance(ctx).InstanceID(),
+		},
+	}
+}
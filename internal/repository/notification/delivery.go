@@ -0,0 +1,107 @@
+package notification
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	deliveryEventPrefix   = notificationEventPrefix + "delivery."
+	DeliverySucceededType = deliveryEventPrefix + "succeeded"
+	DeliveryFailedType    = deliveryEventPrefix + "failed"
+	deliveryErrorMaxSize  = 500
+)
+
+// DeliverySucceededEvent records the delivery of a notification to the user,
+// so support can trace which messages the user received.
+// The retention is the audit log retention of the instance at the time of the delivery,
+// after which the recorded delivery is removed from the history.
+type DeliverySucceededEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	domain.NotificationDelivery
+	TriggeringEventType eventstore.EventType `json:"triggeringEventType,omitempty"`
+	Retention           time.Duration        `json:"retention,omitempty"`
+}
+
+func (e *DeliverySucceededEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = event
+}
+
+func (e *DeliverySucceededEvent) Payload() interface{} {
+	return e
+}
+
+func (e *DeliverySucceededEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewDeliverySucceededEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	delivery domain.NotificationDelivery,
+	triggeringEventType eventstore.EventType,
+	retention time.Duration,
+) *DeliverySucceededEvent {
+	return &DeliverySucceededEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			DeliverySucceededType,
+		),
+		NotificationDelivery: delivery,
+		TriggeringEventType:  triggeringEventType,
+		Retention:            retention,
+	}
+}
+
+// DeliveryFailedEvent records a failed attempt to send a notification to the user.
+type DeliveryFailedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	domain.NotificationDelivery
+	TriggeringEventType eventstore.EventType `json:"triggeringEventType,omitempty"`
+	Retention           time.Duration        `json:"retention,omitempty"`
+	Error               string               `json:"error,omitempty"`
+}
+
+func (e *DeliveryFailedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = event
+}
+
+func (e *DeliveryFailedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *DeliveryFailedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewDeliveryFailedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	delivery domain.NotificationDelivery,
+	triggeringEventType eventstore.EventType,
+	retention time.Duration,
+	sendErr error,
+) *DeliveryFailedEvent {
+	errMsg := errorMessage(sendErr)
+	// provider errors might contain whole responses
+	if len(errMsg) > deliveryErrorMaxSize {
+		errMsg = errMsg[:deliveryErrorMaxSize]
+	}
+	return &DeliveryFailedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			DeliveryFailedType,
+		),
+		NotificationDelivery: delivery,
+		TriggeringEventType:  triggeringEventType,
+		Retention:            retention,
+		Error:                errMsg,
+	}
+}
@@ -0,0 +1,232 @@
+package notification
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	notificationEventPrefix = "notification."
+	RequestedType           = notificationEventPrefix + "requested"
+	RetryRequestedType      = notificationEventPrefix + "retry.requested"
+	SentType                = notificationEventPrefix + "sent"
+	CanceledType            = notificationEventPrefix + "canceled"
+	FailedType              = notificationEventPrefix + "failed"
+
+	uniqueRequest = "notification_request"
+)
+
+// Request references the event which triggered the notification.
+// The notification itself is rendered from the triggering event when it is delivered,
+// so that no secrets (e.g. codes) have to be stored in plain text on the notification.
+type Request struct {
+	TriggeringAggregateType eventstore.AggregateType `json:"triggeringAggregateType"`
+	TriggeringAggregateID   string                   `json:"triggeringAggregateId"`
+	TriggeringEventType     eventstore.EventType     `json:"triggeringEventType"`
+	TriggeringSequence      uint64                   `json:"triggeringSequence"`
+	TriggeringResourceOwner string                   `json:"triggeringResourceOwner"`
+	NotificationType        domain.NotificationType  `json:"notificationType"`
+}
+
+// key identifies the request per triggering event and notification type.
+func (r *Request) key() string {
+	return string(r.TriggeringAggregateType) + ":" +
+		r.TriggeringAggregateID + ":" +
+		string(r.TriggeringEventType) + ":" +
+		strconv.FormatUint(r.TriggeringSequence, 10) + ":" +
+		strconv.Itoa(int(r.NotificationType))
+}
+
+// NewAddRequestUniqueConstraint ensures that a triggering event enqueues only one notification per type,
+// even if the event is reduced again (e.g. on a projection replay).
+func NewAddRequestUniqueConstraint(request *Request) *eventstore.UniqueConstraint {
+	return eventstore.NewAddEventUniqueConstraint(
+		uniqueRequest,
+		request.key(),
+		"Errors.Notification.AlreadyRequested")
+}
+
+type RequestedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	Request `json:"request"`
+}
+
+func (e *RequestedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = event
+}
+
+func (e *RequestedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *RequestedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return []*eventstore.UniqueConstraint{NewAddRequestUniqueConstraint(&e.Request)}
+}
+
+func NewRequestedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	request Request,
+) *RequestedEvent {
+	return &RequestedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			RequestedType,
+		),
+		Request: request,
+	}
+}
+
+type RetryRequestedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	Attempt     uint16    `json:"attempt"`
+	NextAttempt time.Time `json:"nextAttempt"`
+	Error       string    `json:"error"`
+}
+
+func (e *RetryRequestedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = event
+}
+
+func (e *RetryRequestedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *RetryRequestedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewRetryRequestedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	attempt uint16,
+	nextAttempt time.Time,
+	err error,
+) *RetryRequestedEvent {
+	return &RetryRequestedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			RetryRequestedType,
+		),
+		Attempt:     attempt,
+		NextAttempt: nextAttempt,
+		Error:       errorMessage(err),
+	}
+}
+
+type SentEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	Attempt uint16 `json:"attempt"`
+}
+
+func (e *SentEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = event
+}
+
+func (e *SentEvent) Payload() interface{} {
+	return e
+}
+
+func (e *SentEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewSentEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	attempt uint16,
+) *SentEvent {
+	return &SentEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SentType,
+		),
+		Attempt: attempt,
+	}
+}
+
+type CanceledEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	Reason string `json:"reason"`
+}
+
+func (e *CanceledEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = event
+}
+
+func (e *CanceledEvent) Payload() interface{} {
+	return e
+}
+
+func (e *CanceledEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewCanceledEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	reason string,
+) *CanceledEvent {
+	return &CanceledEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			CanceledType,
+		),
+		Reason: reason,
+	}
+}
+
+type FailedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	Attempt uint16 `json:"attempt"`
+	Error   string `json:"error"`
+}
+
+func (e *FailedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = event
+}
+
+func (e *FailedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *FailedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewFailedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	attempt uint16,
+	err error,
+) *FailedEvent {
+	return &FailedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			FailedType,
+		),
+		Attempt: attempt,
+		Error:   errorMessage(err),
+	}
+}
+
+func errorMessage(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
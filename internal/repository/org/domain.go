@@ -18,6 +18,7 @@ const (
 	OrgDomainVerifiedEventType           = domainEventPrefix + "verified"
 	OrgDomainPrimarySetEventType         = domainEventPrefix + "primary.set"
 	OrgDomainRemovedEventType            = domainEventPrefix + "removed"
+	OrgDomainIDPSetEventType             = domainEventPrefix + "idp.set"
 
 	OrgDomainSearchType          = "org_domain"
 	OrgDomainVerifiedSearchField = "verified"
@@ -339,6 +340,47 @@ func DomainRemovedEventMapper(event eventstore.Event) (eventstore.Event, error)
 	return orgDomainRemoved, nil
 }
 
+// DomainIDPSetEvent routes users with a login name of the (verified) domain
+// directly to the identity provider. An empty IDPID removes the routing.
+type DomainIDPSetEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Domain string `json:"domain,omitempty"`
+	IDPID  string `json:"idpId,omitempty"`
+}
+
+func (e *DomainIDPSetEvent) Payload() interface{} {
+	return e
+}
+
+func (e *DomainIDPSetEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewDomainIDPSetEvent(ctx context.Context, aggregate *eventstore.Aggregate, domain, idpID string) *DomainIDPSetEvent {
+	return &DomainIDPSetEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			OrgDomainIDPSetEventType,
+		),
+		Domain: domain,
+		IDPID:  idpID,
+	}
+}
+
+func DomainIDPSetEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	orgDomainIDPSet := &DomainIDPSetEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(orgDomainIDPSet)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "ORG-Hq3vd", "unable to unmarshal org domain idp set")
+	}
+
+	return orgDomainIDPSet, nil
+}
+
 func domainSearchObject(domain string) eventstore.Object {
 	return eventstore.Object{
 		Type:     OrgDomainSearchType,
@@ -2,6 +2,7 @@ package org
 
 import (
 	"context"
+	"time"
 
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/repository/member"
@@ -12,6 +13,8 @@ var (
 	MemberChangedEventType        = orgEventTypePrefix + member.ChangedEventType
 	MemberRemovedEventType        = orgEventTypePrefix + member.RemovedEventType
 	MemberCascadeRemovedEventType = orgEventTypePrefix + member.CascadeRemovedEventType
+	MemberValiditySetEventType    = orgEventTypePrefix + member.ValiditySetEventType
+	MemberExpiredEventType        = orgEventTypePrefix + member.ExpiredEventType
 )
 
 type MemberAddedEvent struct {
@@ -138,3 +141,69 @@ func MemberCascadeRemovedEventMapper(event eventstore.Event) (eventstore.Event,
 
 	return &MemberCascadeRemovedEvent{MemberCascadeRemovedEvent: *e.(*member.MemberCascadeRemovedEvent)}, nil
 }
+
+type MemberValiditySetEvent struct {
+	member.MemberValiditySetEvent
+}
+
+func NewMemberValiditySetEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	userID string,
+	validFrom,
+	validUntil *time.Time,
+) *MemberValiditySetEvent {
+	return &MemberValiditySetEvent{
+		MemberValiditySetEvent: *member.NewMemberValiditySetEvent(
+			eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				MemberValiditySetEventType,
+			),
+			userID,
+			validFrom,
+			validUntil,
+		),
+	}
+}
+
+func MemberValiditySetEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := member.ValiditySetEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MemberValiditySetEvent{MemberValiditySetEvent: *e.(*member.MemberValiditySetEvent)}, nil
+}
+
+type MemberExpiredEvent struct {
+	member.MemberExpiredEvent
+}
+
+func NewMemberExpiredEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	userID string,
+	validUntil time.Time,
+) *MemberExpiredEvent {
+	return &MemberExpiredEvent{
+		MemberExpiredEvent: *member.NewMemberExpiredEvent(
+			eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				MemberExpiredEventType,
+			),
+			userID,
+			validUntil,
+		),
+	}
+}
+
+func MemberExpiredEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := member.ExpiredEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MemberExpiredEvent{MemberExpiredEvent: *e.(*member.MemberExpiredEvent)}, nil
+}
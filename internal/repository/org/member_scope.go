@@ -0,0 +1,65 @@
+package org
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	MemberScopeSetEventType = orgEventTypePrefix + "member.scope.set"
+)
+
+// MemberScopeSetEvent replaces the users the member has permissions on.
+// An event without any restriction removes the scope.
+type MemberScopeSetEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	UserID        string `json:"userId"`
+	MetadataKey   string `json:"metadataKey,omitempty"`
+	MetadataValue string `json:"metadataValue,omitempty"`
+	GroupID       string `json:"groupId,omitempty"`
+}
+
+func (e *MemberScopeSetEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *MemberScopeSetEvent) Payload() interface{} {
+	return e
+}
+
+func (e *MemberScopeSetEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *MemberScopeSetEvent) Scope() *domain.OrgMemberScope {
+	return &domain.OrgMemberScope{
+		MetadataKey:   e.MetadataKey,
+		MetadataValue: e.MetadataValue,
+		GroupID:       e.GroupID,
+	}
+}
+
+func NewMemberScopeSetEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	userID string,
+	scope *domain.OrgMemberScope,
+) *MemberScopeSetEvent {
+	e := &MemberScopeSetEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			MemberScopeSetEventType,
+		),
+		UserID: userID,
+	}
+	if scope != nil {
+		e.MetadataKey = scope.MetadataKey
+		e.MetadataValue = scope.MetadataValue
+		e.GroupID = scope.GroupID
+	}
+	return e
+}
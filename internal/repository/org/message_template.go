@@ -0,0 +1,73 @@
+package org
+
+import (
+	"context"
+
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/policy"
+)
+
+var (
+	MessageTemplateSetEventType     = orgEventTypePrefix + policy.MessageTemplateSetEventType
+	MessageTemplateRemovedEventType = orgEventTypePrefix + policy.MessageTemplateRemovedEventType
+)
+
+type MessageTemplateSetEvent struct {
+	policy.MessageTemplateSetEvent
+}
+
+func NewMessageTemplateSetEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	name string,
+	language language.Tag,
+	template []byte,
+) *MessageTemplateSetEvent {
+	return &MessageTemplateSetEvent{
+		MessageTemplateSetEvent: *policy.NewMessageTemplateSetEvent(
+			eventstore.NewBaseEventForPush(ctx, aggregate, MessageTemplateSetEventType),
+			name,
+			language,
+			template,
+		),
+	}
+}
+
+func MessageTemplateSetEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := policy.MessageTemplateSetEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MessageTemplateSetEvent{MessageTemplateSetEvent: *e.(*policy.MessageTemplateSetEvent)}, nil
+}
+
+type MessageTemplateRemovedEvent struct {
+	policy.MessageTemplateRemovedEvent
+}
+
+func NewMessageTemplateRemovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	name string,
+	language language.Tag,
+) *MessageTemplateRemovedEvent {
+	return &MessageTemplateRemovedEvent{
+		MessageTemplateRemovedEvent: *policy.NewMessageTemplateRemovedEvent(
+			eventstore.NewBaseEventForPush(ctx, aggregate, MessageTemplateRemovedEventType),
+			name,
+			language,
+		),
+	}
+}
+
+func MessageTemplateRemovedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := policy.MessageTemplateRemovedEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MessageTemplateRemovedEvent{MessageTemplateRemovedEvent: *e.(*policy.MessageTemplateRemovedEvent)}, nil
+}
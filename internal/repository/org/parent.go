@@ -0,0 +1,81 @@
+package org
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	OrgParentSetEventType     = orgEventTypePrefix + "parent.set"
+	OrgParentRemovedEventType = orgEventTypePrefix + "parent.removed"
+)
+
+// OrgParentSetEvent places the organization below the parent organization.
+// Policies and the administration of the parent are inherited by its descendants.
+type OrgParentSetEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ParentID string `json:"parentId"`
+}
+
+func (e *OrgParentSetEvent) Payload() interface{} {
+	return e
+}
+
+func (e *OrgParentSetEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewOrgParentSetEvent(ctx context.Context, aggregate *eventstore.Aggregate, parentID string) *OrgParentSetEvent {
+	return &OrgParentSetEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			OrgParentSetEventType,
+		),
+		ParentID: parentID,
+	}
+}
+
+func OrgParentSetEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	parentSet := &OrgParentSetEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(parentSet)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "ORG-Pa1uM", "unable to unmarshal org parent set")
+	}
+
+	return parentSet, nil
+}
+
+// OrgParentRemovedEvent makes the organization a top level organization of the instance again.
+type OrgParentRemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *OrgParentRemovedEvent) Payload() interface{} {
+	return nil
+}
+
+func (e *OrgParentRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewOrgParentRemovedEvent(ctx context.Context, aggregate *eventstore.Aggregate) *OrgParentRemovedEvent {
+	return &OrgParentRemovedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			OrgParentRemovedEventType,
+		),
+	}
+}
+
+func OrgParentRemovedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	return &OrgParentRemovedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}, nil
+}
@@ -0,0 +1,104 @@
+package org
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/policy"
+)
+
+var (
+	UserLifecyclePolicyAddedEventType   = orgEventTypePrefix + policy.UserLifecyclePolicyAddedEventType
+	UserLifecyclePolicyChangedEventType = orgEventTypePrefix + policy.UserLifecyclePolicyChangedEventType
+	UserLifecyclePolicyRemovedEventType = orgEventTypePrefix + policy.UserLifecyclePolicyRemovedEventType
+)
+
+type UserLifecyclePolicyAddedEvent struct {
+	policy.UserLifecyclePolicyAddedEvent
+}
+
+func NewUserLifecyclePolicyAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	deactivateAfterDays,
+	deleteAfterDays,
+	warnDaysBefore uint64,
+) *UserLifecyclePolicyAddedEvent {
+	return &UserLifecyclePolicyAddedEvent{
+		UserLifecyclePolicyAddedEvent: *policy.NewUserLifecyclePolicyAddedEvent(
+			eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				UserLifecyclePolicyAddedEventType),
+			deactivateAfterDays,
+			deleteAfterDays,
+			warnDaysBefore),
+	}
+}
+
+func UserLifecyclePolicyAddedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := policy.UserLifecyclePolicyAddedEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &UserLifecyclePolicyAddedEvent{UserLifecyclePolicyAddedEvent: *e.(*policy.UserLifecyclePolicyAddedEvent)}, nil
+}
+
+type UserLifecyclePolicyChangedEvent struct {
+	policy.UserLifecyclePolicyChangedEvent
+}
+
+func NewUserLifecyclePolicyChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	changes []policy.UserLifecyclePolicyChanges,
+) (*UserLifecyclePolicyChangedEvent, error) {
+	changedEvent, err := policy.NewUserLifecyclePolicyChangedEvent(
+		eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			UserLifecyclePolicyChangedEventType),
+		changes,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &UserLifecyclePolicyChangedEvent{UserLifecyclePolicyChangedEvent: *changedEvent}, nil
+}
+
+func UserLifecyclePolicyChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := policy.UserLifecyclePolicyChangedEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &UserLifecyclePolicyChangedEvent{UserLifecyclePolicyChangedEvent: *e.(*policy.UserLifecyclePolicyChangedEvent)}, nil
+}
+
+type UserLifecyclePolicyRemovedEvent struct {
+	policy.UserLifecyclePolicyRemovedEvent
+}
+
+func NewUserLifecyclePolicyRemovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+) *UserLifecyclePolicyRemovedEvent {
+	return &UserLifecyclePolicyRemovedEvent{
+		UserLifecyclePolicyRemovedEvent: *policy.NewUserLifecyclePolicyRemovedEvent(
+			eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				UserLifecyclePolicyRemovedEventType),
+		),
+	}
+}
+
+func UserLifecyclePolicyRemovedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := policy.UserLifecyclePolicyRemovedEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &UserLifecyclePolicyRemovedEvent{UserLifecyclePolicyRemovedEvent: *e.(*policy.UserLifecyclePolicyRemovedEvent)}, nil
+}
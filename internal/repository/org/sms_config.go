@@ -0,0 +1,296 @@
+package org
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// The SMS configurations of an organization share the payload of the instance events,
+// so the same projection can handle both of them.
+const (
+	smsConfigPrefix                      = "sms.config."
+	smsConfigTwilioPrefix                = "twilio."
+	smsConfigHTTPPrefix                  = "http."
+	SMSConfigTwilioAddedEventType        = orgEventTypePrefix + smsConfigPrefix + smsConfigTwilioPrefix + "added"
+	SMSConfigTwilioChangedEventType      = orgEventTypePrefix + smsConfigPrefix + smsConfigTwilioPrefix + "changed"
+	SMSConfigTwilioTokenChangedEventType = orgEventTypePrefix + smsConfigPrefix + smsConfigTwilioPrefix + "token.changed"
+	SMSConfigHTTPAddedEventType          = orgEventTypePrefix + smsConfigPrefix + smsConfigHTTPPrefix + "added"
+	SMSConfigHTTPChangedEventType        = orgEventTypePrefix + smsConfigPrefix + smsConfigHTTPPrefix + "changed"
+	SMSConfigActivatedEventType          = orgEventTypePrefix + smsConfigPrefix + "activated"
+	SMSConfigDeactivatedEventType        = orgEventTypePrefix + smsConfigPrefix + "deactivated"
+	SMSConfigRemovedEventType            = orgEventTypePrefix + smsConfigPrefix + "removed"
+)
+
+type SMSConfigTwilioAddedEvent struct {
+	instance.SMSConfigTwilioAddedEvent
+}
+
+func NewSMSConfigTwilioAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id,
+	sid,
+	senderNumber string,
+	token *crypto.CryptoValue,
+) *SMSConfigTwilioAddedEvent {
+	return &SMSConfigTwilioAddedEvent{
+		SMSConfigTwilioAddedEvent: instance.SMSConfigTwilioAddedEvent{
+			BaseEvent: *eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				SMSConfigTwilioAddedEventType,
+			),
+			ID:           id,
+			SID:          sid,
+			Token:        token,
+			SenderNumber: senderNumber,
+		},
+	}
+}
+
+func SMSConfigTwilioAddedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := instance.SMSConfigTwilioAddedEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SMSConfigTwilioAddedEvent{SMSConfigTwilioAddedEvent: *e.(*instance.SMSConfigTwilioAddedEvent)}, nil
+}
+
+type SMSConfigTwilioChangedEvent struct {
+	instance.SMSConfigTwilioChangedEvent
+}
+
+func NewSMSConfigTwilioChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	changes []instance.SMSConfigTwilioChanges,
+) (*SMSConfigTwilioChangedEvent, error) {
+	if len(changes) == 0 {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "ORG-Tq9fw", "Errors.NoChangesFound")
+	}
+	changeEvent := &SMSConfigTwilioChangedEvent{
+		SMSConfigTwilioChangedEvent: instance.SMSConfigTwilioChangedEvent{
+			BaseEvent: *eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				SMSConfigTwilioChangedEventType,
+			),
+			ID: id,
+		},
+	}
+	for _, change := range changes {
+		change(&changeEvent.SMSConfigTwilioChangedEvent)
+	}
+	return changeEvent, nil
+}
+
+func SMSConfigTwilioChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := instance.SMSConfigTwilioChangedEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SMSConfigTwilioChangedEvent{SMSConfigTwilioChangedEvent: *e.(*instance.SMSConfigTwilioChangedEvent)}, nil
+}
+
+type SMSConfigTwilioTokenChangedEvent struct {
+	instance.SMSConfigTwilioTokenChangedEvent
+}
+
+func NewSMSConfigTokenChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	token *crypto.CryptoValue,
+) *SMSConfigTwilioTokenChangedEvent {
+	return &SMSConfigTwilioTokenChangedEvent{
+		SMSConfigTwilioTokenChangedEvent: instance.SMSConfigTwilioTokenChangedEvent{
+			BaseEvent: *eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				SMSConfigTwilioTokenChangedEventType,
+			),
+			ID:    id,
+			Token: token,
+		},
+	}
+}
+
+func SMSConfigTwilioTokenChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := instance.SMSConfigTwilioTokenChangedEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SMSConfigTwilioTokenChangedEvent{SMSConfigTwilioTokenChangedEvent: *e.(*instance.SMSConfigTwilioTokenChangedEvent)}, nil
+}
+
+type SMSConfigHTTPAddedEvent struct {
+	instance.SMSConfigHTTPAddedEvent
+}
+
+func NewSMSConfigHTTPAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id,
+	endpoint string,
+	headers,
+	signingKey *crypto.CryptoValue,
+) *SMSConfigHTTPAddedEvent {
+	return &SMSConfigHTTPAddedEvent{
+		SMSConfigHTTPAddedEvent: instance.SMSConfigHTTPAddedEvent{
+			BaseEvent: *eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				SMSConfigHTTPAddedEventType,
+			),
+			ID:         id,
+			Endpoint:   endpoint,
+			Headers:    headers,
+			SigningKey: signingKey,
+		},
+	}
+}
+
+func SMSConfigHTTPAddedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := instance.SMSConfigHTTPAddedEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SMSConfigHTTPAddedEvent{SMSConfigHTTPAddedEvent: *e.(*instance.SMSConfigHTTPAddedEvent)}, nil
+}
+
+type SMSConfigHTTPChangedEvent struct {
+	instance.SMSConfigHTTPChangedEvent
+}
+
+func NewSMSConfigHTTPChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	changes []instance.SMSConfigHTTPChanges,
+) (*SMSConfigHTTPChangedEvent, error) {
+	if len(changes) == 0 {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "ORG-Hs7kq", "Errors.NoChangesFound")
+	}
+	changeEvent := &SMSConfigHTTPChangedEvent{
+		SMSConfigHTTPChangedEvent: instance.SMSConfigHTTPChangedEvent{
+			BaseEvent: *eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				SMSConfigHTTPChangedEventType,
+			),
+			ID: id,
+		},
+	}
+	for _, change := range changes {
+		change(&changeEvent.SMSConfigHTTPChangedEvent)
+	}
+	return changeEvent, nil
+}
+
+func SMSConfigHTTPChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := instance.SMSConfigHTTPChangedEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SMSConfigHTTPChangedEvent{SMSConfigHTTPChangedEvent: *e.(*instance.SMSConfigHTTPChangedEvent)}, nil
+}
+
+type SMSConfigActivatedEvent struct {
+	instance.SMSConfigActivatedEvent
+}
+
+func NewSMSConfigActivatedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+) *SMSConfigActivatedEvent {
+	return &SMSConfigActivatedEvent{
+		SMSConfigActivatedEvent: instance.SMSConfigActivatedEvent{
+			BaseEvent: *eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				SMSConfigActivatedEventType,
+			),
+			ID: id,
+		},
+	}
+}
+
+func SMSConfigActivatedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := instance.SMSConfigActivatedEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SMSConfigActivatedEvent{SMSConfigActivatedEvent: *e.(*instance.SMSConfigActivatedEvent)}, nil
+}
+
+type SMSConfigDeactivatedEvent struct {
+	instance.SMSConfigDeactivatedEvent
+}
+
+func NewSMSConfigDeactivatedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+) *SMSConfigDeactivatedEvent {
+	return &SMSConfigDeactivatedEvent{
+		SMSConfigDeactivatedEvent: instance.SMSConfigDeactivatedEvent{
+			BaseEvent: *eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				SMSConfigDeactivatedEventType,
+			),
+			ID: id,
+		},
+	}
+}
+
+func SMSConfigDeactivatedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := instance.SMSConfigDeactivatedEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SMSConfigDeactivatedEvent{SMSConfigDeactivatedEvent: *e.(*instance.SMSConfigDeactivatedEvent)}, nil
+}
+
+type SMSConfigRemovedEvent struct {
+	instance.SMSConfigRemovedEvent
+}
+
+func NewSMSConfigRemovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+) *SMSConfigRemovedEvent {
+	return &SMSConfigRemovedEvent{
+		SMSConfigRemovedEvent: instance.SMSConfigRemovedEvent{
+			BaseEvent: *eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				SMSConfigRemovedEventType,
+			),
+			ID: id,
+		},
+	}
+}
+
+func SMSConfigRemovedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := instance.SMSConfigRemovedEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SMSConfigRemovedEvent{SMSConfigRemovedEvent: *e.(*instance.SMSConfigRemovedEvent)}, nil
+}
@@ -0,0 +1,405 @@
+package org
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// The SMTP configurations of an organization share the payload of the instance events,
+// so the same projection can handle both of them.
+const (
+	smtpConfigPrefix                   = "smtp.config."
+	SMTPConfigAddedEventType           = orgEventTypePrefix + smtpConfigPrefix + "added"
+	SMTPConfigChangedEventType         = orgEventTypePrefix + smtpConfigPrefix + "changed"
+	SMTPConfigPasswordChangedEventType = orgEventTypePrefix + smtpConfigPrefix + "password.changed"
+	SMTPConfigRemovedEventType         = orgEventTypePrefix + smtpConfigPrefix + "removed"
+	SMTPConfigActivatedEventType       = orgEventTypePrefix + smtpConfigPrefix + "activated"
+	SMTPConfigDeactivatedEventType     = orgEventTypePrefix + smtpConfigPrefix + "deactivated"
+	SMTPConfigHTTPAddedEventType       = orgEventTypePrefix + smtpConfigPrefix + "http.added"
+	SMTPConfigHTTPChangedEventType     = orgEventTypePrefix + smtpConfigPrefix + "http.changed"
+	SMTPConfigDKIMSetEventType         = orgEventTypePrefix + smtpConfigPrefix + "dkim.set"
+	SMTPConfigDKIMRemovedEventType     = orgEventTypePrefix + smtpConfigPrefix + "dkim.removed"
+	SMTPConfigHeadersSetEventType      = orgEventTypePrefix + smtpConfigPrefix + "headers.set"
+)
+
+type SMTPConfigAddedEvent struct {
+	instance.SMTPConfigAddedEvent
+}
+
+func NewSMTPConfigAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id, description string,
+	tls bool,
+	senderAddress,
+	senderName,
+	replyToAddress,
+	host,
+	user string,
+	password *crypto.CryptoValue,
+) *SMTPConfigAddedEvent {
+	return &SMTPConfigAddedEvent{
+		SMTPConfigAddedEvent: instance.SMTPConfigAddedEvent{
+			BaseEvent: *eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				SMTPConfigAddedEventType,
+			),
+			ID:             id,
+			Description:    description,
+			TLS:            tls,
+			SenderAddress:  senderAddress,
+			SenderName:     senderName,
+			ReplyToAddress: replyToAddress,
+			Host:           host,
+			User:           user,
+			Password:       password,
+		},
+	}
+}
+
+func SMTPConfigAddedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := instance.SMTPConfigAddedEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SMTPConfigAddedEvent{SMTPConfigAddedEvent: *e.(*instance.SMTPConfigAddedEvent)}, nil
+}
+
+type SMTPConfigChangedEvent struct {
+	instance.SMTPConfigChangedEvent
+}
+
+func NewSMTPConfigChangeEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	changes []instance.SMTPConfigChanges,
+) (*SMTPConfigChangedEvent, error) {
+	if len(changes) == 0 {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "ORG-Sm3pd", "Errors.NoChangesFound")
+	}
+	changeEvent := &SMTPConfigChangedEvent{
+		SMTPConfigChangedEvent: instance.SMTPConfigChangedEvent{
+			BaseEvent: *eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				SMTPConfigChangedEventType,
+			),
+			ID: id,
+		},
+	}
+	for _, change := range changes {
+		change(&changeEvent.SMTPConfigChangedEvent)
+	}
+	return changeEvent, nil
+}
+
+func SMTPConfigChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := instance.SMTPConfigChangedEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SMTPConfigChangedEvent{SMTPConfigChangedEvent: *e.(*instance.SMTPConfigChangedEvent)}, nil
+}
+
+type SMTPConfigHTTPAddedEvent struct {
+	instance.SMTPConfigHTTPAddedEvent
+}
+
+func NewSMTPConfigHTTPAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id, description,
+	endpoint string,
+	headers,
+	signingKey *crypto.CryptoValue,
+) *SMTPConfigHTTPAddedEvent {
+	return &SMTPConfigHTTPAddedEvent{
+		SMTPConfigHTTPAddedEvent: instance.SMTPConfigHTTPAddedEvent{
+			BaseEvent: *eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				SMTPConfigHTTPAddedEventType,
+			),
+			ID:          id,
+			Description: description,
+			Endpoint:    endpoint,
+			Headers:     headers,
+			SigningKey:  signingKey,
+		},
+	}
+}
+
+func SMTPConfigHTTPAddedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := instance.SMTPConfigHTTPAddedEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SMTPConfigHTTPAddedEvent{SMTPConfigHTTPAddedEvent: *e.(*instance.SMTPConfigHTTPAddedEvent)}, nil
+}
+
+type SMTPConfigHTTPChangedEvent struct {
+	instance.SMTPConfigHTTPChangedEvent
+}
+
+func NewSMTPConfigHTTPChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	changes []instance.SMTPConfigHTTPChanges,
+) (*SMTPConfigHTTPChangedEvent, error) {
+	if len(changes) == 0 {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "ORG-Hm2qa", "Errors.NoChangesFound")
+	}
+	changeEvent := &SMTPConfigHTTPChangedEvent{
+		SMTPConfigHTTPChangedEvent: instance.SMTPConfigHTTPChangedEvent{
+			BaseEvent: *eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				SMTPConfigHTTPChangedEventType,
+			),
+			ID: id,
+		},
+	}
+	for _, change := range changes {
+		change(&changeEvent.SMTPConfigHTTPChangedEvent)
+	}
+	return changeEvent, nil
+}
+
+func SMTPConfigHTTPChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := instance.SMTPConfigHTTPChangedEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SMTPConfigHTTPChangedEvent{SMTPConfigHTTPChangedEvent: *e.(*instance.SMTPConfigHTTPChangedEvent)}, nil
+}
+
+type SMTPConfigPasswordChangedEvent struct {
+	instance.SMTPConfigPasswordChangedEvent
+}
+
+func NewSMTPConfigPasswordChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	password *crypto.CryptoValue,
+) *SMTPConfigPasswordChangedEvent {
+	return &SMTPConfigPasswordChangedEvent{
+		SMTPConfigPasswordChangedEvent: instance.SMTPConfigPasswordChangedEvent{
+			BaseEvent: *eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				SMTPConfigPasswordChangedEventType,
+			),
+			ID:       id,
+			Password: password,
+		},
+	}
+}
+
+func SMTPConfigPasswordChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := instance.SMTPConfigPasswordChangedEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SMTPConfigPasswordChangedEvent{SMTPConfigPasswordChangedEvent: *e.(*instance.SMTPConfigPasswordChangedEvent)}, nil
+}
+
+type SMTPConfigActivatedEvent struct {
+	instance.SMTPConfigActivatedEvent
+}
+
+func NewSMTPConfigActivatedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+) *SMTPConfigActivatedEvent {
+	return &SMTPConfigActivatedEvent{
+		SMTPConfigActivatedEvent: instance.SMTPConfigActivatedEvent{
+			BaseEvent: *eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				SMTPConfigActivatedEventType,
+			),
+			ID: id,
+		},
+	}
+}
+
+func SMTPConfigActivatedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := instance.SMTPConfigActivatedEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SMTPConfigActivatedEvent{SMTPConfigActivatedEvent: *e.(*instance.SMTPConfigActivatedEvent)}, nil
+}
+
+type SMTPConfigDeactivatedEvent struct {
+	instance.SMTPConfigDeactivatedEvent
+}
+
+func NewSMTPConfigDeactivatedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+) *SMTPConfigDeactivatedEvent {
+	return &SMTPConfigDeactivatedEvent{
+		SMTPConfigDeactivatedEvent: instance.SMTPConfigDeactivatedEvent{
+			BaseEvent: *eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				SMTPConfigDeactivatedEventType,
+			),
+			ID: id,
+		},
+	}
+}
+
+func SMTPConfigDeactivatedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := instance.SMTPConfigDeactivatedEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SMTPConfigDeactivatedEvent{SMTPConfigDeactivatedEvent: *e.(*instance.SMTPConfigDeactivatedEvent)}, nil
+}
+
+type SMTPConfigRemovedEvent struct {
+	instance.SMTPConfigRemovedEvent
+}
+
+func NewSMTPConfigRemovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+) *SMTPConfigRemovedEvent {
+	return &SMTPConfigRemovedEvent{
+		SMTPConfigRemovedEvent: instance.SMTPConfigRemovedEvent{
+			BaseEvent: *eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				SMTPConfigRemovedEventType,
+			),
+			ID: id,
+		},
+	}
+}
+
+func SMTPConfigRemovedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := instance.SMTPConfigRemovedEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SMTPConfigRemovedEvent{SMTPConfigRemovedEvent: *e.(*instance.SMTPConfigRemovedEvent)}, nil
+}
+
+type SMTPConfigDKIMSetEvent struct {
+	instance.SMTPConfigDKIMSetEvent
+}
+
+func NewSMTPConfigDKIMSetEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id,
+	domain,
+	selector string,
+	privateKey *crypto.CryptoValue,
+) *SMTPConfigDKIMSetEvent {
+	return &SMTPConfigDKIMSetEvent{
+		SMTPConfigDKIMSetEvent: instance.SMTPConfigDKIMSetEvent{
+			BaseEvent: *eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				SMTPConfigDKIMSetEventType,
+			),
+			ID:         id,
+			Domain:     domain,
+			Selector:   selector,
+			PrivateKey: privateKey,
+		},
+	}
+}
+
+func SMTPConfigDKIMSetEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := instance.SMTPConfigDKIMSetEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SMTPConfigDKIMSetEvent{SMTPConfigDKIMSetEvent: *e.(*instance.SMTPConfigDKIMSetEvent)}, nil
+}
+
+type SMTPConfigDKIMRemovedEvent struct {
+	instance.SMTPConfigDKIMRemovedEvent
+}
+
+func NewSMTPConfigDKIMRemovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+) *SMTPConfigDKIMRemovedEvent {
+	return &SMTPConfigDKIMRemovedEvent{
+		SMTPConfigDKIMRemovedEvent: instance.SMTPConfigDKIMRemovedEvent{
+			BaseEvent: *eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				SMTPConfigDKIMRemovedEventType,
+			),
+			ID: id,
+		},
+	}
+}
+
+func SMTPConfigDKIMRemovedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := instance.SMTPConfigDKIMRemovedEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SMTPConfigDKIMRemovedEvent{SMTPConfigDKIMRemovedEvent: *e.(*instance.SMTPConfigDKIMRemovedEvent)}, nil
+}
+
+type SMTPConfigHeadersSetEvent struct {
+	instance.SMTPConfigHeadersSetEvent
+}
+
+func NewSMTPConfigHeadersSetEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	headers map[string]string,
+) *SMTPConfigHeadersSetEvent {
+	return &SMTPConfigHeadersSetEvent{
+		SMTPConfigHeadersSetEvent: instance.SMTPConfigHeadersSetEvent{
+			BaseEvent: *eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				SMTPConfigHeadersSetEventType,
+			),
+			ID:      id,
+			Headers: headers,
+		},
+	}
+}
+
+func SMTPConfigHeadersSetEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := instance.SMTPConfigHeadersSetEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SMTPConfigHeadersSetEvent{SMTPConfigHeadersSetEvent: *e.(*instance.SMTPConfigHeadersSetEvent)}, nil
+}
@@ -0,0 +1,116 @@
+package org
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	TestMessageRequestedType = orgEventTypePrefix + "message.test.requested"
+	TestMessageSentType      = orgEventTypePrefix + "message.test.sent"
+)
+
+// TestMessageRequestedEvent requests a message of the organization to be rendered
+// and sent to the recipient through the active provider of the organization.
+type TestMessageRequestedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	MessageType       string                  `json:"messageType,omitempty"`
+	Language          string                  `json:"language,omitempty"`
+	UserID            string                  `json:"userId,omitempty"`
+	NotificationType  domain.NotificationType `json:"notificationType"`
+	Recipient         string                  `json:"recipient,omitempty"`
+	TriggeredAtOrigin string                  `json:"triggerOrigin,omitempty"`
+}
+
+func (e *TestMessageRequestedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *TestMessageRequestedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *TestMessageRequestedEvent) TriggerOrigin() string {
+	return e.TriggeredAtOrigin
+}
+
+func NewTestMessageRequestedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	messageType,
+	language,
+	userID string,
+	notificationType domain.NotificationType,
+	recipient string,
+) *TestMessageRequestedEvent {
+	return &TestMessageRequestedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			TestMessageRequestedType,
+		),
+		MessageType:       messageType,
+		Language:          language,
+		UserID:            userID,
+		NotificationType:  notificationType,
+		Recipient:         recipient,
+		TriggeredAtOrigin: http.ComposedOrigin(ctx),
+	}
+}
+
+func TestMessageRequestedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &TestMessageRequestedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "ORG-Tm4ql", "unable to unmarshal test message requested")
+	}
+	return e, nil
+}
+
+// TestMessageSentEvent marks the test message requested by the event with the RequestSequence as sent.
+type TestMessageSentEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	RequestSequence uint64 `json:"requestSequence"`
+}
+
+func (e *TestMessageSentEvent) Payload() interface{} {
+	return e
+}
+
+func (e *TestMessageSentEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewTestMessageSentEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	requestSequence uint64,
+) *TestMessageSentEvent {
+	return &TestMessageSentEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			TestMessageSentType,
+		),
+		RequestSequence: requestSequence,
+	}
+}
+
+func TestMessageSentEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &TestMessageSentEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "ORG-Tm5ql", "unable to unmarshal test message sent")
+	}
+	return e, nil
+}
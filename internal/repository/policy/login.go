@@ -28,6 +28,7 @@ type LoginPolicyAddedEvent struct {
 	AllowDomainDiscovery       bool                    `json:"allowDomainDiscovery,omitempty"`
 	DisableLoginWithEmail      bool                    `json:"disableLoginWithEmail,omitempty"`
 	DisableLoginWithPhone      bool                    `json:"disableLoginWithPhone,omitempty"`
+	ForcePasskeyRegistration   bool                    `json:"forcePasskeyRegistration,omitempty"`
 	PasswordlessType           domain.PasswordlessType `json:"passwordlessType,omitempty"`
 	DefaultRedirectURI         string                  `json:"defaultRedirectURI,omitempty"`
 	PasswordCheckLifetime      time.Duration           `json:"passwordCheckLifetime,omitempty"`
@@ -56,7 +57,8 @@ func NewLoginPolicyAddedEvent(
 	ignoreUnknownUsernames,
 	allowDomainDiscovery,
 	disableLoginWithEmail,
-	disableLoginWithPhone bool,
+	disableLoginWithPhone,
+	forcePasskeyRegistration bool,
 	passwordlessType domain.PasswordlessType,
 	defaultRedirectURI string,
 	passwordCheckLifetime,
@@ -84,6 +86,7 @@ func NewLoginPolicyAddedEvent(
 		MultiFactorCheckLifetime:   multiFactorCheckLifetime,
 		DisableLoginWithEmail:      disableLoginWithEmail,
 		DisableLoginWithPhone:      disableLoginWithPhone,
+		ForcePasskeyRegistration:   forcePasskeyRegistration,
 	}
 }
 
@@ -113,6 +116,7 @@ type LoginPolicyChangedEvent struct {
 	AllowDomainDiscovery       *bool                    `json:"allowDomainDiscovery,omitempty"`
 	DisableLoginWithEmail      *bool                    `json:"disableLoginWithEmail,omitempty"`
 	DisableLoginWithPhone      *bool                    `json:"disableLoginWithPhone,omitempty"`
+	ForcePasskeyRegistration   *bool                    `json:"forcePasskeyRegistration,omitempty"`
 	PasswordlessType           *domain.PasswordlessType `json:"passwordlessType,omitempty"`
 	DefaultRedirectURI         *string                  `json:"defaultRedirectURI,omitempty"`
 	PasswordCheckLifetime      *time.Duration           `json:"passwordCheckLifetime,omitempty"`
@@ -250,6 +254,12 @@ func ChangeDisableLoginWithPhone(DisableLoginWithPhone bool) func(*LoginPolicyCh
 	}
 }
 
+func ChangeForcePasskeyRegistration(forcePasskeyRegistration bool) func(*LoginPolicyChangedEvent) {
+	return func(e *LoginPolicyChangedEvent) {
+		e.ForcePasskeyRegistration = &forcePasskeyRegistration
+	}
+}
+
 func LoginPolicyChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
 	e := &LoginPolicyChangedEvent{
 		BaseEvent: *eventstore.BaseEventFromRepo(event),
@@ -0,0 +1,98 @@
+package policy
+
+import (
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	messageTemplatePrefix           = mailTemplatePolicyPrefix + "message."
+	MessageTemplateSetEventType     = messageTemplatePrefix + "set"
+	MessageTemplateRemovedEventType = messageTemplatePrefix + "removed"
+)
+
+// MessageTemplateSetEvent sets the HTML template of a message type or a partial (header or footer) for a language.
+type MessageTemplateSetEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Name     string       `json:"name,omitempty"`
+	Language language.Tag `json:"language,omitempty"`
+	Template []byte       `json:"template,omitempty"`
+}
+
+func (e *MessageTemplateSetEvent) Payload() interface{} {
+	return e
+}
+
+func (e *MessageTemplateSetEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewMessageTemplateSetEvent(
+	base *eventstore.BaseEvent,
+	name string,
+	language language.Tag,
+	template []byte,
+) *MessageTemplateSetEvent {
+	return &MessageTemplateSetEvent{
+		BaseEvent: *base,
+		Name:      name,
+		Language:  language,
+		Template:  template,
+	}
+}
+
+func MessageTemplateSetEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &MessageTemplateSetEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "POLIC-Mt2sx", "unable to unmarshal message template")
+	}
+
+	return e, nil
+}
+
+type MessageTemplateRemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Name     string       `json:"name,omitempty"`
+	Language language.Tag `json:"language,omitempty"`
+}
+
+func (e *MessageTemplateRemovedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *MessageTemplateRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewMessageTemplateRemovedEvent(
+	base *eventstore.BaseEvent,
+	name string,
+	language language.Tag,
+) *MessageTemplateRemovedEvent {
+	return &MessageTemplateRemovedEvent{
+		BaseEvent: *base,
+		Name:      name,
+		Language:  language,
+	}
+}
+
+func MessageTemplateRemovedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &MessageTemplateRemovedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "POLIC-Mt3rx", "unable to unmarshal message template removed")
+	}
+
+	return e, nil
+}
@@ -0,0 +1,144 @@
+package policy
+
+import (
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	UserLifecyclePolicyAddedEventType   = "policy.user.lifecycle.added"
+	UserLifecyclePolicyChangedEventType = "policy.user.lifecycle.changed"
+	UserLifecyclePolicyRemovedEventType = "policy.user.lifecycle.removed"
+)
+
+type UserLifecyclePolicyAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	DeactivateAfterDays uint64 `json:"deactivateAfterDays,omitempty"`
+	DeleteAfterDays     uint64 `json:"deleteAfterDays,omitempty"`
+	WarnDaysBefore      uint64 `json:"warnDaysBefore,omitempty"`
+}
+
+func (e *UserLifecyclePolicyAddedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *UserLifecyclePolicyAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewUserLifecyclePolicyAddedEvent(
+	base *eventstore.BaseEvent,
+	deactivateAfterDays,
+	deleteAfterDays,
+	warnDaysBefore uint64,
+) *UserLifecyclePolicyAddedEvent {
+	return &UserLifecyclePolicyAddedEvent{
+		BaseEvent:           *base,
+		DeactivateAfterDays: deactivateAfterDays,
+		DeleteAfterDays:     deleteAfterDays,
+		WarnDaysBefore:      warnDaysBefore,
+	}
+}
+
+func UserLifecyclePolicyAddedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &UserLifecyclePolicyAddedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "POLIC-c7Hnq", "unable to unmarshal policy")
+	}
+
+	return e, nil
+}
+
+type UserLifecyclePolicyChangedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	DeactivateAfterDays *uint64 `json:"deactivateAfterDays,omitempty"`
+	DeleteAfterDays     *uint64 `json:"deleteAfterDays,omitempty"`
+	WarnDaysBefore      *uint64 `json:"warnDaysBefore,omitempty"`
+}
+
+func (e *UserLifecyclePolicyChangedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *UserLifecyclePolicyChangedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewUserLifecyclePolicyChangedEvent(
+	base *eventstore.BaseEvent,
+	changes []UserLifecyclePolicyChanges,
+) (*UserLifecyclePolicyChangedEvent, error) {
+	if len(changes) == 0 {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "POLICY-Wm3sx", "Errors.NoChangesFound")
+	}
+	changeEvent := &UserLifecyclePolicyChangedEvent{
+		BaseEvent: *base,
+	}
+	for _, change := range changes {
+		change(changeEvent)
+	}
+	return changeEvent, nil
+}
+
+type UserLifecyclePolicyChanges func(*UserLifecyclePolicyChangedEvent)
+
+func ChangeDeactivateAfterDays(deactivateAfterDays uint64) func(*UserLifecyclePolicyChangedEvent) {
+	return func(e *UserLifecyclePolicyChangedEvent) {
+		e.DeactivateAfterDays = &deactivateAfterDays
+	}
+}
+
+func ChangeDeleteAfterDays(deleteAfterDays uint64) func(*UserLifecyclePolicyChangedEvent) {
+	return func(e *UserLifecyclePolicyChangedEvent) {
+		e.DeleteAfterDays = &deleteAfterDays
+	}
+}
+
+func ChangeWarnDaysBefore(warnDaysBefore uint64) func(*UserLifecyclePolicyChangedEvent) {
+	return func(e *UserLifecyclePolicyChangedEvent) {
+		e.WarnDaysBefore = &warnDaysBefore
+	}
+}
+
+func UserLifecyclePolicyChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &UserLifecyclePolicyChangedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "POLIC-Xk2pe", "unable to unmarshal policy")
+	}
+
+	return e, nil
+}
+
+type UserLifecyclePolicyRemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *UserLifecyclePolicyRemovedEvent) Payload() interface{} {
+	return nil
+}
+
+func (e *UserLifecyclePolicyRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewUserLifecyclePolicyRemovedEvent(base *eventstore.BaseEvent) *UserLifecyclePolicyRemovedEvent {
+	return &UserLifecyclePolicyRemovedEvent{
+		BaseEvent: *base,
+	}
+}
+
+func UserLifecyclePolicyRemovedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	return &UserLifecyclePolicyRemovedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}, nil
+}
@@ -14,9 +14,16 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, MemberChangedType, MemberChangedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, MemberRemovedType, MemberRemovedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, MemberCascadeRemovedType, MemberCascadeRemovedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, MemberValiditySetType, MemberValiditySetEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, MemberExpiredType, MemberExpiredEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, RoleAddedType, RoleAddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, RoleChangedType, RoleChangedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, RoleRemovedType, RoleRemovedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, RoleInclusionsSetType, eventstore.GenericEventMapper[RoleInclusionsSetEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, RoleConditionSetType, eventstore.GenericEventMapper[RoleConditionSetEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, PermissionAddedType, eventstore.GenericEventMapper[PermissionAddedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, PermissionChangedType, eventstore.GenericEventMapper[PermissionChangedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, PermissionRemovedType, eventstore.GenericEventMapper[PermissionRemovedEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, GrantAddedType, GrantAddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, GrantChangedType, GrantChangedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, GrantCascadeChangedType, GrantCascadeChangedEventMapper)
@@ -27,6 +34,8 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, GrantMemberChangedType, GrantMemberChangedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, GrantMemberRemovedType, GrantMemberRemovedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, GrantMemberCascadeRemovedType, GrantMemberCascadeRemovedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, GrantMemberValiditySetType, GrantMemberValiditySetEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, GrantMemberExpiredType, GrantMemberExpiredEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, ApplicationAddedType, ApplicationAddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, ApplicationChangedType, ApplicationChangedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, ApplicationRemovedType, ApplicationRemovedEventMapper)
@@ -3,6 +3,7 @@ package project
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/repository/member"
@@ -15,6 +16,8 @@ var (
 	GrantMemberChangedType        = grantEventTypePrefix + member.ChangedEventType
 	GrantMemberRemovedType        = grantEventTypePrefix + member.RemovedEventType
 	GrantMemberCascadeRemovedType = grantEventTypePrefix + member.CascadeRemovedEventType
+	GrantMemberValiditySetType    = grantEventTypePrefix + member.ValiditySetEventType
+	GrantMemberExpiredType        = grantEventTypePrefix + member.ExpiredEventType
 )
 
 func NewAddProjectGrantMemberUniqueConstraint(projectID, userID, grantID string) *eventstore.UniqueConstraint {
@@ -216,3 +219,102 @@ func GrantMemberCascadeRemovedEventMapper(event eventstore.Event) (eventstore.Ev
 
 	return e, nil
 }
+
+type GrantMemberValiditySetEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	UserID     string     `json:"userId"`
+	GrantID    string     `json:"grantId"`
+	ValidFrom  *time.Time `json:"validFrom,omitempty"`
+	ValidUntil *time.Time `json:"validUntil,omitempty"`
+}
+
+func (e *GrantMemberValiditySetEvent) Payload() interface{} {
+	return e
+}
+
+func (e *GrantMemberValiditySetEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewProjectGrantMemberValiditySetEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	userID,
+	grantID string,
+	validFrom,
+	validUntil *time.Time,
+) *GrantMemberValiditySetEvent {
+	return &GrantMemberValiditySetEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			GrantMemberValiditySetType,
+		),
+		UserID:     userID,
+		GrantID:    grantID,
+		ValidFrom:  validFrom,
+		ValidUntil: validUntil,
+	}
+}
+
+func GrantMemberValiditySetEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &GrantMemberValiditySetEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "PROJECT-Vd8rf", "unable to unmarshal grant member validity")
+	}
+
+	return e, nil
+}
+
+type GrantMemberExpiredEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	UserID     string    `json:"userId"`
+	GrantID    string    `json:"grantId"`
+	ValidUntil time.Time `json:"validUntil"`
+}
+
+func (e *GrantMemberExpiredEvent) Payload() interface{} {
+	return e
+}
+
+func (e *GrantMemberExpiredEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return []*eventstore.UniqueConstraint{NewRemoveProjectGrantMemberUniqueConstraint(e.Aggregate().ID, e.UserID, e.GrantID)}
+}
+
+func NewProjectGrantMemberExpiredEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	userID,
+	grantID string,
+	validUntil time.Time,
+) *GrantMemberExpiredEvent {
+	return &GrantMemberExpiredEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			GrantMemberExpiredType,
+		),
+		UserID:     userID,
+		GrantID:    grantID,
+		ValidUntil: validUntil,
+	}
+}
+
+func GrantMemberExpiredEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &GrantMemberExpiredEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "PROJECT-Ge7xp", "unable to unmarshal grant member expiry")
+	}
+
+	return e, nil
+}
@@ -0,0 +1,184 @@
+package project
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	UniquePermissionType      = "project_permission"
+	permissionEventTypePrefix = projectEventTypePrefix + "permission."
+	PermissionAddedType       = permissionEventTypePrefix + "added"
+	PermissionChangedType     = permissionEventTypePrefix + "changed"
+	PermissionRemovedType     = permissionEventTypePrefix + "removed"
+
+	RoleInclusionsSetType = roleEventTypePrefix + "inclusions.set"
+)
+
+func NewAddProjectPermissionUniqueConstraint(key, projectID string) *eventstore.UniqueConstraint {
+	return eventstore.NewAddEventUniqueConstraint(
+		UniquePermissionType,
+		fmt.Sprintf("%s:%s", key, projectID),
+		"Errors.Project.Permission.AlreadyExists")
+}
+
+func NewRemoveProjectPermissionUniqueConstraint(key, projectID string) *eventstore.UniqueConstraint {
+	return eventstore.NewRemoveUniqueConstraint(
+		UniquePermissionType,
+		fmt.Sprintf("%s:%s", key, projectID))
+}
+
+// PermissionAddedEvent defines a permission of the project,
+// which is granted to every user having at least one of the roles.
+type PermissionAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Key         string   `json:"key"`
+	DisplayName string   `json:"displayName,omitempty"`
+	RoleKeys    []string `json:"roleKeys,omitempty"`
+}
+
+func (e *PermissionAddedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *PermissionAddedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *PermissionAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return []*eventstore.UniqueConstraint{NewAddProjectPermissionUniqueConstraint(e.Key, e.Aggregate().ID)}
+}
+
+func NewPermissionAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	key,
+	displayName string,
+	roleKeys []string,
+) *PermissionAddedEvent {
+	return &PermissionAddedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			PermissionAddedType,
+		),
+		Key:         key,
+		DisplayName: displayName,
+		RoleKeys:    roleKeys,
+	}
+}
+
+// PermissionChangedEvent replaces the display name and the roles of the permission.
+type PermissionChangedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Key         string   `json:"key"`
+	DisplayName string   `json:"displayName,omitempty"`
+	RoleKeys    []string `json:"roleKeys,omitempty"`
+}
+
+func (e *PermissionChangedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *PermissionChangedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *PermissionChangedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewPermissionChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	key,
+	displayName string,
+	roleKeys []string,
+) *PermissionChangedEvent {
+	return &PermissionChangedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			PermissionChangedType,
+		),
+		Key:         key,
+		DisplayName: displayName,
+		RoleKeys:    roleKeys,
+	}
+}
+
+type PermissionRemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Key string `json:"key"`
+}
+
+func (e *PermissionRemovedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *PermissionRemovedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *PermissionRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return []*eventstore.UniqueConstraint{NewRemoveProjectPermissionUniqueConstraint(e.Key, e.Aggregate().ID)}
+}
+
+func NewPermissionRemovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	key string,
+) *PermissionRemovedEvent {
+	return &PermissionRemovedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			PermissionRemovedType,
+		),
+		Key: key,
+	}
+}
+
+// RoleInclusionsSetEvent replaces the roles included in a role.
+// A user granted the role is also granted all included roles (composite role),
+// included roles can include further roles (role hierarchy).
+type RoleInclusionsSetEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Key              string   `json:"key"`
+	IncludedRoleKeys []string `json:"includedRoleKeys,omitempty"`
+}
+
+func (e *RoleInclusionsSetEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *RoleInclusionsSetEvent) Payload() interface{} {
+	return e
+}
+
+func (e *RoleInclusionsSetEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewRoleInclusionsSetEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	key string,
+	includedRoleKeys []string,
+) *RoleInclusionsSetEvent {
+	return &RoleInclusionsSetEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			RoleInclusionsSetType,
+		),
+		Key:              key,
+		IncludedRoleKeys: includedRoleKeys,
+	}
+}
@@ -0,0 +1,77 @@
+package project
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	RoleConditionSetType = roleEventTypePrefix + "condition.set"
+)
+
+// RoleConditionSetEvent replaces the condition which must be satisfied to assert the role in tokens.
+// An event without any restriction removes the condition.
+type RoleConditionSetEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Key            string          `json:"key"`
+	OrgIDs         []string        `json:"orgIds,omitempty"`
+	MFALevel       domain.MFALevel `json:"mfaLevel,omitempty"`
+	AMR            []string        `json:"amr,omitempty"`
+	IPRanges       []string        `json:"ipRanges,omitempty"`
+	TimeOfDayStart string          `json:"timeOfDayStart,omitempty"`
+	TimeOfDayEnd   string          `json:"timeOfDayEnd,omitempty"`
+	TimeZone       string          `json:"timeZone,omitempty"`
+}
+
+func (e *RoleConditionSetEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *RoleConditionSetEvent) Payload() interface{} {
+	return e
+}
+
+func (e *RoleConditionSetEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *RoleConditionSetEvent) Condition() *domain.ProjectRoleCondition {
+	return &domain.ProjectRoleCondition{
+		OrgIDs:         e.OrgIDs,
+		MFALevel:       e.MFALevel,
+		AMR:            e.AMR,
+		IPRanges:       e.IPRanges,
+		TimeOfDayStart: e.TimeOfDayStart,
+		TimeOfDayEnd:   e.TimeOfDayEnd,
+		TimeZone:       e.TimeZone,
+	}
+}
+
+func NewRoleConditionSetEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	key string,
+	condition *domain.ProjectRoleCondition,
+) *RoleConditionSetEvent {
+	e := &RoleConditionSetEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			RoleConditionSetType,
+		),
+		Key: key,
+	}
+	if condition != nil {
+		e.OrgIDs = condition.OrgIDs
+		e.MFALevel = condition.MFALevel
+		e.AMR = condition.AMR
+		e.IPRanges = condition.IPRanges
+		e.TimeOfDayStart = condition.TimeOfDayStart
+		e.TimeOfDayEnd = condition.TimeOfDayEnd
+		e.TimeZone = condition.TimeZone
+	}
+	return e
+}
@@ -28,6 +28,9 @@ const (
 	OTPEmailChallengedType = sessionEventPrefix + "otp.email.challenged"
 	OTPEmailSentType       = sessionEventPrefix + "otp.email.sent"
 	OTPEmailCheckedType    = sessionEventPrefix + "otp.email.checked"
+	PushChallengedType     = sessionEventPrefix + "push.challenged"
+	PushSentType           = sessionEventPrefix + "push.sent"
+	PushCheckedType        = sessionEventPrefix + "push.checked"
 	TokenSetType           = sessionEventPrefix + "token.set"
 	MetadataSetType        = sessionEventPrefix + "metadata.set"
 	LifetimeSetType        = sessionEventPrefix + "lifetime.set"
@@ -324,6 +327,8 @@ type OTPSMSChallengedEvent struct {
 	Expiry            time.Duration       `json:"expiry"`
 	CodeReturned      bool                `json:"codeReturned,omitempty"`
 	TriggeredAtOrigin string              `json:"triggerOrigin,omitempty"`
+	// Delivery is set if the code is sent by ZITADEL and the deliveries are rate limited
+	Delivery *domain.OTPDelivery `json:"delivery,omitempty"`
 }
 
 func (e *OTPSMSChallengedEvent) Payload() interface{} {
@@ -348,6 +353,7 @@ func NewOTPSMSChallengedEvent(
 	code *crypto.CryptoValue,
 	expiry time.Duration,
 	codeReturned bool,
+	delivery *domain.OTPDelivery,
 ) *OTPSMSChallengedEvent {
 	return &OTPSMSChallengedEvent{
 		BaseEvent: *eventstore.NewBaseEventForPush(
@@ -359,6 +365,7 @@ func NewOTPSMSChallengedEvent(
 		Expiry:            expiry,
 		CodeReturned:      codeReturned,
 		TriggeredAtOrigin: http.ComposedOrigin(ctx),
+		Delivery:          delivery,
 	}
 }
 
@@ -432,6 +439,8 @@ type OTPEmailChallengedEvent struct {
 	ReturnCode        bool                `json:"returnCode,omitempty"`
 	URLTmpl           string              `json:"urlTmpl,omitempty"`
 	TriggeredAtOrigin string              `json:"triggerOrigin,omitempty"`
+	// Delivery is set if the code is sent by ZITADEL and the deliveries are rate limited
+	Delivery *domain.OTPDelivery `json:"delivery,omitempty"`
 }
 
 func (e *OTPEmailChallengedEvent) Payload() interface{} {
@@ -457,6 +466,7 @@ func NewOTPEmailChallengedEvent(
 	expiry time.Duration,
 	returnCode bool,
 	urlTmpl string,
+	delivery *domain.OTPDelivery,
 ) *OTPEmailChallengedEvent {
 	return &OTPEmailChallengedEvent{
 		BaseEvent: *eventstore.NewBaseEventForPush(
@@ -469,6 +479,7 @@ func NewOTPEmailChallengedEvent(
 		ReturnCode:        returnCode,
 		URLTmpl:           urlTmpl,
 		TriggeredAtOrigin: http.ComposedOrigin(ctx),
+		Delivery:          delivery,
 	}
 }
 
@@ -534,6 +545,123 @@ func NewOTPEmailCheckedEvent(
 	}
 }
 
+type PushChallengedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Challenge         string        `json:"challenge"`
+	Expiry            time.Duration `json:"expiry"`
+	Devices           []*PushDevice `json:"devices"`
+	TriggeredAtOrigin string        `json:"triggerOrigin,omitempty"`
+}
+
+// PushDevice is a device of the user the push challenge is sent to.
+type PushDevice struct {
+	DeviceID  string              `json:"deviceId"`
+	Platform  domain.PushPlatform `json:"platform"`
+	PushToken string              `json:"pushToken"`
+}
+
+func (e *PushChallengedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *PushChallengedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *PushChallengedEvent) SetBaseEvent(base *eventstore.BaseEvent) {
+	e.BaseEvent = *base
+}
+
+func (e *PushChallengedEvent) TriggerOrigin() string {
+	return e.TriggeredAtOrigin
+}
+
+func NewPushChallengedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	challenge string,
+	expiry time.Duration,
+	devices []*PushDevice,
+) *PushChallengedEvent {
+	return &PushChallengedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			PushChallengedType,
+		),
+		Challenge:         challenge,
+		Expiry:            expiry,
+		Devices:           devices,
+		TriggeredAtOrigin: http.ComposedOrigin(ctx),
+	}
+}
+
+type PushSentEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *PushSentEvent) Payload() interface{} {
+	return e
+}
+
+func (e *PushSentEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *PushSentEvent) SetBaseEvent(base *eventstore.BaseEvent) {
+	e.BaseEvent = *base
+}
+
+func NewPushSentEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+) *PushSentEvent {
+	return &PushSentEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			PushSentType,
+		),
+	}
+}
+
+type PushCheckedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	CheckedAt time.Time `json:"checkedAt"`
+	DeviceID  string    `json:"deviceId"`
+}
+
+func (e *PushCheckedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *PushCheckedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *PushCheckedEvent) SetBaseEvent(base *eventstore.BaseEvent) {
+	e.BaseEvent = *base
+}
+
+func NewPushCheckedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	checkedAt time.Time,
+	deviceID string,
+) *PushCheckedEvent {
+	return &PushCheckedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			PushCheckedType,
+		),
+		CheckedAt: checkedAt,
+		DeviceID:  deviceID,
+	}
+}
+
 type TokenSetEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
@@ -44,6 +44,13 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, UserTokenAddedType, UserTokenAddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, UserTokenV2AddedType, eventstore.GenericEventMapper[UserTokenV2AddedEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, UserImpersonatedType, eventstore.GenericEventMapper[UserImpersonatedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, UserDeactivationWarningAddedType, eventstore.GenericEventMapper[UserDeactivationWarningAddedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, UserDeactivationWarningSentType, eventstore.GenericEventMapper[UserDeactivationWarningSentEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, UserDeletionWarningAddedType, eventstore.GenericEventMapper[UserDeletionWarningAddedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, UserDeletionWarningSentType, eventstore.GenericEventMapper[UserDeletionWarningSentEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanSecurityAlertSentType, eventstore.GenericEventMapper[HumanSecurityAlertSentEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanBreakGlassSetType, eventstore.GenericEventMapper[HumanBreakGlassSetEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanBreakGlassUsedType, eventstore.GenericEventMapper[HumanBreakGlassUsedEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, UserTokenRemovedType, UserTokenRemovedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, UserDomainClaimedType, DomainClaimedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, UserDomainClaimedSentType, DomainClaimedSentEventMapper)
@@ -92,6 +99,10 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanMFAOTPRemovedType, HumanOTPRemovedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanMFAOTPCheckSucceededType, HumanOTPCheckSucceededEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanMFAOTPCheckFailedType, HumanOTPCheckFailedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanPushDeviceAddedType, eventstore.GenericEventMapper[HumanPushDeviceAddedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanPushDeviceRemovedType, eventstore.GenericEventMapper[HumanPushDeviceRemovedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanPushCheckSucceededType, eventstore.GenericEventMapper[HumanPushCheckSucceededEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanPushCheckFailedType, eventstore.GenericEventMapper[HumanPushCheckFailedEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanOTPSMSAddedType, eventstore.GenericEventMapper[HumanOTPSMSAddedEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanOTPSMSRemovedType, eventstore.GenericEventMapper[HumanOTPSMSRemovedEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanOTPSMSCodeAddedType, eventstore.GenericEventMapper[HumanOTPSMSCodeAddedEvent])
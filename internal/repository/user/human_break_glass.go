@@ -0,0 +1,91 @@
+package user
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	humanBreakGlassEventPrefix = humanEventPrefix + "breakglass."
+	HumanBreakGlassSetType     = humanBreakGlassEventPrefix + "set"
+	HumanBreakGlassUsedType    = humanBreakGlassEventPrefix + "used"
+)
+
+// HumanBreakGlassSetEvent marks the user as (or removes the mark of) an emergency access account,
+// which is allowed to bypass the login policy, but must authenticate with a hardware key.
+type HumanBreakGlassSetEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	BreakGlass bool `json:"breakGlass"`
+}
+
+func (e *HumanBreakGlassSetEvent) Payload() interface{} {
+	return e
+}
+
+func (e *HumanBreakGlassSetEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *HumanBreakGlassSetEvent) SetBaseEvent(base *eventstore.BaseEvent) {
+	e.BaseEvent = *base
+}
+
+func NewHumanBreakGlassSetEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	breakGlass bool,
+) *HumanBreakGlassSetEvent {
+	return &HumanBreakGlassSetEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanBreakGlassSetType,
+		),
+		BreakGlass: breakGlass,
+	}
+}
+
+// HumanBreakGlassUsedEvent is pushed on every login of an emergency access account.
+// SessionID is the id of the session (or user agent for the login UI) and
+// Expiration the time the session and its checks expire.
+type HumanBreakGlassUsedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+	*AuthRequestInfo
+
+	SessionID  string    `json:"sessionID,omitempty"`
+	Expiration time.Time `json:"expiration,omitempty"`
+}
+
+func (e *HumanBreakGlassUsedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *HumanBreakGlassUsedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *HumanBreakGlassUsedEvent) SetBaseEvent(base *eventstore.BaseEvent) {
+	e.BaseEvent = *base
+}
+
+func NewHumanBreakGlassUsedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	sessionID string,
+	expiration time.Time,
+	info *AuthRequestInfo,
+) *HumanBreakGlassUsedEvent {
+	return &HumanBreakGlassUsedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanBreakGlassUsedType,
+		),
+		AuthRequestInfo: info,
+		SessionID:       sessionID,
+		Expiration:      expiration,
+	}
+}
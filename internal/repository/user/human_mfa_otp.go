@@ -6,6 +6,7 @@ import (
 
 	"github.com/zitadel/zitadel/internal/api/http"
 	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
@@ -280,6 +281,8 @@ type HumanOTPSMSCodeAddedEvent struct {
 	Code              *crypto.CryptoValue `json:"code,omitempty"`
 	Expiry            time.Duration       `json:"expiry,omitempty"`
 	TriggeredAtOrigin string              `json:"triggerOrigin,omitempty"`
+	// Delivery is set if the deliveries of the codes are rate limited
+	Delivery *domain.OTPDelivery `json:"delivery,omitempty"`
 	*AuthRequestInfo
 }
 
@@ -305,6 +308,7 @@ func NewHumanOTPSMSCodeAddedEvent(
 	code *crypto.CryptoValue,
 	expiry time.Duration,
 	info *AuthRequestInfo,
+	delivery *domain.OTPDelivery,
 ) *HumanOTPSMSCodeAddedEvent {
 	return &HumanOTPSMSCodeAddedEvent{
 		BaseEvent: *eventstore.NewBaseEventForPush(
@@ -315,6 +319,7 @@ func NewHumanOTPSMSCodeAddedEvent(
 		Code:              code,
 		Expiry:            expiry,
 		TriggeredAtOrigin: http.ComposedOrigin(ctx),
+		Delivery:          delivery,
 		AuthRequestInfo:   info,
 	}
 }
@@ -480,6 +485,8 @@ type HumanOTPEmailCodeAddedEvent struct {
 	Code              *crypto.CryptoValue `json:"code,omitempty"`
 	Expiry            time.Duration       `json:"expiry,omitempty"`
 	TriggeredAtOrigin string              `json:"triggerOrigin,omitempty"`
+	// Delivery is set if the deliveries of the codes are rate limited
+	Delivery *domain.OTPDelivery `json:"delivery,omitempty"`
 	*AuthRequestInfo
 }
 
@@ -505,6 +512,7 @@ func NewHumanOTPEmailCodeAddedEvent(
 	code *crypto.CryptoValue,
 	expiry time.Duration,
 	info *AuthRequestInfo,
+	delivery *domain.OTPDelivery,
 ) *HumanOTPEmailCodeAddedEvent {
 	return &HumanOTPEmailCodeAddedEvent{
 		BaseEvent: *eventstore.NewBaseEventForPush(
@@ -514,6 +522,7 @@ func NewHumanOTPEmailCodeAddedEvent(
 		),
 		Code:              code,
 		Expiry:            expiry,
+		Delivery:          delivery,
 		AuthRequestInfo:   info,
 		TriggeredAtOrigin: http.ComposedOrigin(ctx),
 	}
@@ -0,0 +1,160 @@
+package user
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	pushEventPrefix             = mfaEventPrefix + "push."
+	HumanPushDeviceAddedType    = pushEventPrefix + "device.added"
+	HumanPushDeviceRemovedType  = pushEventPrefix + "device.removed"
+	HumanPushCheckSucceededType = pushEventPrefix + "check.succeeded"
+	HumanPushCheckFailedType    = pushEventPrefix + "check.failed"
+)
+
+type HumanPushDeviceAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	DeviceID  string              `json:"deviceId"`
+	Name      string              `json:"name,omitempty"`
+	Platform  domain.PushPlatform `json:"platform"`
+	PublicKey []byte              `json:"publicKey"`
+	PushToken string              `json:"pushToken"`
+}
+
+func (e *HumanPushDeviceAddedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *HumanPushDeviceAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *HumanPushDeviceAddedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = *event
+}
+
+func NewHumanPushDeviceAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	deviceID,
+	name string,
+	platform domain.PushPlatform,
+	publicKey []byte,
+	pushToken string,
+) *HumanPushDeviceAddedEvent {
+	return &HumanPushDeviceAddedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanPushDeviceAddedType,
+		),
+		DeviceID:  deviceID,
+		Name:      name,
+		Platform:  platform,
+		PublicKey: publicKey,
+		PushToken: pushToken,
+	}
+}
+
+type HumanPushDeviceRemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	DeviceID string `json:"deviceId"`
+}
+
+func (e *HumanPushDeviceRemovedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *HumanPushDeviceRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *HumanPushDeviceRemovedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = *event
+}
+
+func NewHumanPushDeviceRemovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	deviceID string,
+) *HumanPushDeviceRemovedEvent {
+	return &HumanPushDeviceRemovedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanPushDeviceRemovedType,
+		),
+		DeviceID: deviceID,
+	}
+}
+
+type HumanPushCheckSucceededEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	DeviceID string `json:"deviceId"`
+}
+
+func (e *HumanPushCheckSucceededEvent) Payload() interface{} {
+	return e
+}
+
+func (e *HumanPushCheckSucceededEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *HumanPushCheckSucceededEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = *event
+}
+
+func NewHumanPushCheckSucceededEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	deviceID string,
+) *HumanPushCheckSucceededEvent {
+	return &HumanPushCheckSucceededEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanPushCheckSucceededType,
+		),
+		DeviceID: deviceID,
+	}
+}
+
+type HumanPushCheckFailedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	DeviceID string `json:"deviceId"`
+}
+
+func (e *HumanPushCheckFailedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *HumanPushCheckFailedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *HumanPushCheckFailedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = *event
+}
+
+func NewHumanPushCheckFailedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	deviceID string,
+) *HumanPushCheckFailedEvent {
+	return &HumanPushCheckFailedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanPushCheckFailedType,
+		),
+		DeviceID: deviceID,
+	}
+}
@@ -0,0 +1,68 @@
+package user
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	HumanSecurityAlertSentType = humanEventPrefix + "security.alert.sent"
+)
+
+// HumanSecurityAlertSentEvent is pushed after the user was notified about a security relevant change
+// of their account. AlertType is the message text type of the notification,
+// TriggeringSequence the sequence of the event which caused it.
+// Alerts with multiple deliveries (e.g. about the login of a break-glass account)
+// are marked per delivery by RecipientID or TargetID.
+type HumanSecurityAlertSentEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	AlertType          string `json:"alertType,omitempty"`
+	TriggeringSequence uint64 `json:"triggeringSequence,omitempty"`
+	RecipientID        string `json:"recipientID,omitempty"`
+	TargetID           string `json:"targetID,omitempty"`
+}
+
+func (e *HumanSecurityAlertSentEvent) Payload() interface{} {
+	return e
+}
+
+func (e *HumanSecurityAlertSentEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *HumanSecurityAlertSentEvent) SetBaseEvent(base *eventstore.BaseEvent) {
+	e.BaseEvent = *base
+}
+
+func NewHumanSecurityAlertSentEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	alertType string,
+	triggeringSequence uint64,
+) *HumanSecurityAlertSentEvent {
+	return &HumanSecurityAlertSentEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanSecurityAlertSentType,
+		),
+		AlertType:          alertType,
+		TriggeringSequence: triggeringSequence,
+	}
+}
+
+func NewHumanSecurityAlertDeliveredEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	alertType string,
+	triggeringSequence uint64,
+	recipientID,
+	targetID string,
+) *HumanSecurityAlertSentEvent {
+	event := NewHumanSecurityAlertSentEvent(ctx, aggregate, alertType, triggeringSequence)
+	event.RecipientID = recipientID
+	event.TargetID = targetID
+	return event
+}
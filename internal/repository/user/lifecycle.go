@@ -0,0 +1,138 @@
+package user
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	lifecycleEventPrefix             = userEventTypePrefix + "lifecycle."
+	UserDeactivationWarningAddedType = lifecycleEventPrefix + "deactivation.warning.added"
+	UserDeactivationWarningSentType  = lifecycleEventPrefix + "deactivation.warning.sent"
+	UserDeletionWarningAddedType     = lifecycleEventPrefix + "deletion.warning.added"
+	UserDeletionWarningSentType      = lifecycleEventPrefix + "deletion.warning.sent"
+)
+
+// UserDeactivationWarningAddedEvent is pushed by the user lifecycle policy
+// when an inactive user is going to be deactivated at DeactivationDate.
+type UserDeactivationWarningAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	DeactivationDate time.Time `json:"deactivationDate,omitempty"`
+}
+
+func (e *UserDeactivationWarningAddedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *UserDeactivationWarningAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *UserDeactivationWarningAddedEvent) SetBaseEvent(base *eventstore.BaseEvent) {
+	e.BaseEvent = *base
+}
+
+func NewUserDeactivationWarningAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	deactivationDate time.Time,
+) *UserDeactivationWarningAddedEvent {
+	return &UserDeactivationWarningAddedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			UserDeactivationWarningAddedType,
+		),
+		DeactivationDate: deactivationDate,
+	}
+}
+
+type UserDeactivationWarningSentEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *UserDeactivationWarningSentEvent) Payload() interface{} {
+	return nil
+}
+
+func (e *UserDeactivationWarningSentEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *UserDeactivationWarningSentEvent) SetBaseEvent(base *eventstore.BaseEvent) {
+	e.BaseEvent = *base
+}
+
+func NewUserDeactivationWarningSentEvent(ctx context.Context, aggregate *eventstore.Aggregate) *UserDeactivationWarningSentEvent {
+	return &UserDeactivationWarningSentEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			UserDeactivationWarningSentType,
+		),
+	}
+}
+
+// UserDeletionWarningAddedEvent is pushed by the user lifecycle policy
+// when a deactivated user is going to be deleted at DeletionDate.
+type UserDeletionWarningAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	DeletionDate time.Time `json:"deletionDate,omitempty"`
+}
+
+func (e *UserDeletionWarningAddedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *UserDeletionWarningAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *UserDeletionWarningAddedEvent) SetBaseEvent(base *eventstore.BaseEvent) {
+	e.BaseEvent = *base
+}
+
+func NewUserDeletionWarningAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	deletionDate time.Time,
+) *UserDeletionWarningAddedEvent {
+	return &UserDeletionWarningAddedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			UserDeletionWarningAddedType,
+		),
+		DeletionDate: deletionDate,
+	}
+}
+
+type UserDeletionWarningSentEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *UserDeletionWarningSentEvent) Payload() interface{} {
+	return nil
+}
+
+func (e *UserDeletionWarningSentEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *UserDeletionWarningSentEvent) SetBaseEvent(base *eventstore.BaseEvent) {
+	e.BaseEvent = *base
+}
+
+func NewUserDeletionWarningSentEvent(ctx context.Context, aggregate *eventstore.Aggregate) *UserDeletionWarningSentEvent {
+	return &UserDeletionWarningSentEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			UserDeletionWarningSentType,
+		),
+	}
+}
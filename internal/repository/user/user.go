@@ -50,10 +50,16 @@ func NewRemoveUsernameUniqueConstraint(userName, resourceOwner string, userLogin
 
 type UserLockedEvent struct {
 	eventstore.BaseEvent `json:"-"`
+
+	// Lockout is set if the user was locked by the lockout policy after too many failed checks
+	Lockout bool `json:"lockout,omitempty"`
 }
 
 func (e *UserLockedEvent) Payload() interface{} {
-	return nil
+	if !e.Lockout {
+		return nil
+	}
+	return e
 }
 
 func (e *UserLockedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
@@ -70,10 +76,21 @@ func NewUserLockedEvent(ctx context.Context, aggregate *eventstore.Aggregate) *U
 	}
 }
 
+// NewUserLockoutEvent locks the user because the maximum attempts of the lockout policy were reached.
+func NewUserLockoutEvent(ctx context.Context, aggregate *eventstore.Aggregate) *UserLockedEvent {
+	e := NewUserLockedEvent(ctx, aggregate)
+	e.Lockout = true
+	return e
+}
+
 func UserLockedEventMapper(event eventstore.Event) (eventstore.Event, error) {
-	return &UserLockedEvent{
+	e := &UserLockedEvent{
 		BaseEvent: *eventstore.BaseEventFromRepo(event),
-	}, nil
+	}
+	if err := event.Unmarshal(e); err != nil {
+		return nil, zerrors.ThrowInternal(err, "USER-Lo1um", "unable to unmarshal user locked")
+	}
+	return e, nil
 }
 
 type UserUnlockedEvent struct {
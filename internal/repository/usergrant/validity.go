@@ -0,0 +1,95 @@
+package usergrant
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	UserGrantValiditySetType = userGrantEventTypePrefix + "validity.set"
+	UserGrantExpiredType     = userGrantEventTypePrefix + "expired"
+)
+
+// UserGrantValiditySetEvent restricts the user grant to the time window.
+// A nil bound leaves the window open on its side.
+type UserGrantValiditySetEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ValidFrom  *time.Time `json:"validFrom,omitempty"`
+	ValidUntil *time.Time `json:"validUntil,omitempty"`
+}
+
+func (e *UserGrantValiditySetEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = *event
+}
+
+func (e *UserGrantValiditySetEvent) Payload() interface{} {
+	return e
+}
+
+func (e *UserGrantValiditySetEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewUserGrantValiditySetEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	validFrom,
+	validUntil *time.Time,
+) *UserGrantValiditySetEvent {
+	return &UserGrantValiditySetEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			UserGrantValiditySetType,
+		),
+		ValidFrom:  validFrom,
+		ValidUntil: validUntil,
+	}
+}
+
+// UserGrantExpiredEvent is pushed as soon as the validity of the user grant ended.
+// The grant is removed, so the same grant can be added again.
+type UserGrantExpiredEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ValidUntil     time.Time `json:"validUntil"`
+	userID         string    `json:"-"`
+	projectID      string    `json:"-"`
+	projectGrantID string    `json:"-"`
+}
+
+func (e *UserGrantExpiredEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = *event
+}
+
+func (e *UserGrantExpiredEvent) Payload() interface{} {
+	return e
+}
+
+func (e *UserGrantExpiredEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return []*eventstore.UniqueConstraint{NewRemoveUserGrantUniqueConstraint(e.Aggregate().ResourceOwner, e.userID, e.projectID, e.projectGrantID)}
+}
+
+func NewUserGrantExpiredEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	userID,
+	projectID,
+	projectGrantID string,
+	validUntil time.Time,
+) *UserGrantExpiredEvent {
+	return &UserGrantExpiredEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			UserGrantExpiredType,
+		),
+		ValidUntil:     validUntil,
+		userID:         userID,
+		projectID:      projectID,
+		projectGrantID: projectGrantID,
+	}
+}
@@ -0,0 +1,84 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/crdb"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// lockInstanceID is used to lock the jobs, which process all instances
+const lockInstanceID = ""
+
+// Job is run periodically by the [Scheduler]
+type Job func(ctx context.Context) error
+
+// Scheduler runs a job periodically on only one of the running replicas.
+// The replica running the job keeps the lock for the interval,
+// so the other replicas skip the job until the next interval.
+type Scheduler struct {
+	name     string
+	interval time.Duration
+	locker   crdb.Locker
+	job      Job
+}
+
+func New(client *database.DB, name string, interval time.Duration, job Job) *Scheduler {
+	return &Scheduler{
+		name:     name,
+		interval: interval,
+		locker:   crdb.NewLocker(client.DB, projection.LocksTable, name),
+		job:      job,
+	}
+}
+
+// Start runs the job in the interval until the context is done.
+func (s *Scheduler) Start(ctx context.Context) {
+	go s.run(ctx)
+}
+
+func (s *Scheduler) run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.runLocked(ctx)
+		}
+	}
+}
+
+// runLocked runs the job if the lock could be acquired.
+// The lock is renewed until the job is done.
+func (s *Scheduler) runLocked(ctx context.Context) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	errs := s.locker.Lock(ctx, s.interval, lockInstanceID)
+	err, ok := <-errs
+	if !ok {
+		return
+	}
+	if zerrors.IsErrorAlreadyExists(err) {
+		logging.WithFields("job", s.name).Debug("job locked by another replica")
+		return
+	}
+	if err != nil {
+		logging.WithFields("job", s.name).WithError(err).Warn("unable to lock job")
+		return
+	}
+	go func() {
+		for err := range errs {
+			logging.WithFields("job", s.name).OnError(err).Warn("unable to renew lock of job")
+		}
+	}()
+	err = s.job(ctx)
+	logging.WithFields("job", s.name).OnError(err).Warn("job failed")
+}
@@ -0,0 +1,79 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type testLocker struct {
+	err error
+}
+
+func (l *testLocker) Lock(ctx context.Context, _ time.Duration, _ ...string) <-chan error {
+	errs := make(chan error)
+	go func() {
+		defer close(errs)
+		select {
+		case errs <- l.err:
+		case <-ctx.Done():
+			return
+		}
+		<-ctx.Done()
+	}()
+	return errs
+}
+
+func (l *testLocker) Unlock(...string) error {
+	return nil
+}
+
+func TestScheduler_runLocked(t *testing.T) {
+	tests := []struct {
+		name    string
+		lockErr error
+		jobErr  error
+		wantRun bool
+	}{
+		{
+			name:    "locked by other replica",
+			lockErr: zerrors.ThrowAlreadyExists(nil, "CRDB-mmi4J", "projection already locked"),
+			wantRun: false,
+		},
+		{
+			name:    "lock failed",
+			lockErr: zerrors.ThrowInternal(errors.New("connection lost"), "CRDB-uaDoR", "unable to execute lock"),
+			wantRun: false,
+		},
+		{
+			name:    "locked",
+			wantRun: true,
+		},
+		{
+			name:    "locked, job failed",
+			jobErr:  errors.New("failed"),
+			wantRun: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var run bool
+			s := &Scheduler{
+				name:     "test",
+				interval: time.Minute,
+				locker:   &testLocker{err: tt.lockErr},
+				job: func(ctx context.Context) error {
+					run = true
+					return tt.jobErr
+				},
+			}
+			s.runLocked(context.Background())
+			assert.Equal(t, tt.wantRun, run)
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package model
 
 import (
+	"slices"
 	"time"
 
 	"golang.org/x/text/language"
@@ -151,6 +152,11 @@ func (r *UserSearchRequest) AppendMyOrgQuery(orgID string) {
 func (u *UserView) MFATypesSetupPossible(level domain.MFALevel, policy *domain.LoginPolicy) []domain.MFAType {
 	types := make([]domain.MFAType, 0)
 	switch level {
+	case domain.MFALevelMultiFactorCertified:
+		// only security keys are phishing resistant
+		if slices.Contains(policy.SecondFactors, domain.SecondFactorTypeU2F) {
+			types = append(types, domain.MFATypeU2F)
+		}
 	default:
 		fallthrough
 	case domain.MFALevelSecondFactor:
@@ -182,6 +188,11 @@ func (u *UserView) MFATypesAllowed(level domain.MFALevel, policy *domain.LoginPo
 	types := make([]domain.MFAType, 0)
 	required := true
 	switch level {
+	case domain.MFALevelMultiFactorCertified:
+		// only security keys are phishing resistant
+		if slices.Contains(policy.SecondFactors, domain.SecondFactorTypeU2F) && u.IsU2FReady() {
+			types = append(types, domain.MFATypeU2F)
+		}
 	default:
 		required = domain.RequiresMFA(policy.ForceMFA, policy.ForceMFALocalOnly, isInternalAuthentication)
 		fallthrough
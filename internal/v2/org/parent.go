@@ -0,0 +1,40 @@
+package org
+
+import (
+	"github.com/zitadel/zitadel/internal/v2/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	ParentSetType     = eventTypePrefix + "parent.set"
+	ParentRemovedType = eventTypePrefix + "parent.removed"
+)
+
+type parentSetPayload struct {
+	ParentID string `json:"parentId"`
+}
+
+type ParentSetEvent eventstore.Event[parentSetPayload]
+
+var _ eventstore.TypeChecker = (*ParentSetEvent)(nil)
+
+// ActionType implements eventstore.Typer.
+func (c *ParentSetEvent) ActionType() string {
+	return ParentSetType
+}
+
+func ParentSetEventFromStorage(event *eventstore.StorageEvent) (e *ParentSetEvent, _ error) {
+	if event.Type != e.ActionType() {
+		return nil, zerrors.ThrowInvalidArgument(nil, "ORG-Pa4ty", "Errors.Invalid.Event.Type")
+	}
+
+	payload, err := eventstore.UnmarshalPayload[parentSetPayload](event.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ParentSetEvent{
+		StorageEvent: event,
+		Payload:      payload,
+	}, nil
+}
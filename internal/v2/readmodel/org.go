@@ -11,6 +11,7 @@ import (
 type Org struct {
 	ID            string
 	Name          string
+	ParentID      string
 	PrimaryDomain *projection.OrgPrimaryDomain
 	State         *projection.OrgState
 
@@ -57,6 +58,14 @@ func (rm *Org) Reduce(events ...*eventstore.StorageEvent) error {
 				return err
 			}
 			rm.Name = changed.Payload.Name
+		case org.ParentSetType:
+			parentSet, err := org.ParentSetEventFromStorage(event)
+			if err != nil {
+				return err
+			}
+			rm.ParentID = parentSet.Payload.ParentID
+		case org.ParentRemovedType:
+			rm.ParentID = ""
 		}
 		rm.Sequence = event.Sequence
 		rm.ChangeDate = event.CreatedAt